@@ -598,9 +598,15 @@ func (a *AppKeepers) InitKeepers(
 		runtime.NewKVStoreService(a.keys[agenttypes.ModuleName]),
 		tee.NewVerifier(),
 		a.BankKeeper,
+		a.DymNSKeeper,
+		a.StakingKeeper,
+		a.LockupKeeper,
 		govModuleAddress,
 	)
 	a.EIBCKeeper.SetAgentKeeper(a.AgentKeeper)
+	a.EIBCKeeper.SetSequencerKeeper(a.SequencerKeeper)
+	a.EIBCKeeper.SetLightClientKeeper(a.LightClientKeeper)
+	a.RollappKeeper.SetPolicyRegistry(a.AgentKeeper)
 
 	a.BridgingFeeKeeper = bridgingfeekeeper.NewKeeper(
 		appCodec,
@@ -202,6 +202,7 @@ var maccPerms = map[string][]string{
 	govtypes.ModuleName:                                {authtypes.Burner},
 	ibctransfertypes.ModuleName:                        {authtypes.Minter, authtypes.Burner},
 	sequencertypes.ModuleName:                          {authtypes.Minter, authtypes.Burner, authtypes.Staking},
+	sequencertypes.DelegatorRewardsPoolName:            nil,
 	rollappmoduletypes.ModuleName:                      {authtypes.Burner},
 	sponsorshiptypes.ModuleName:                        nil,
 	streamermoduletypes.ModuleName:                     {authtypes.Burner},
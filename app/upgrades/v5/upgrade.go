@@ -508,11 +508,12 @@ func migrateDeprecatedParamsKeeperSubspaces(ctx sdk.Context, keepers *upgrades.U
 }
 
 var newTeeConfig = rollappmoduletypes.TEEConfig{
-	Enabled:         false, // will require gov prop to enable, and set the policy info
-	Verify:          false,
-	PolicyValues:    "",
-	PolicyQuery:     "",
-	PolicyStructure: "",
+	Enabled:           false, // will require gov prop to enable, and set the policy info
+	Verify:            false,
+	PolicyValues:      "",
+	PolicyQuery:       "",
+	PolicyStructure:   "",
+	MaxAttestationAge: rollappmoduletypes.DefaultMaxAttestationAge,
 }
 
 const (
@@ -21,12 +21,23 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdRegisterAgent())
 	cmd.AddCommand(CmdDeactivateAgent())
 	cmd.AddCommand(CmdUpdateAgentPolicy())
+	cmd.AddCommand(CmdUpdateAgentPolicySet())
 	cmd.AddCommand(CmdFundAgentEscrow())
 	cmd.AddCommand(CmdWithdrawAgentEscrow())
+	cmd.AddCommand(CmdCancelAgentWithdrawal())
+	cmd.AddCommand(CmdUpdateAgentSecurityPolicy())
+	cmd.AddCommand(CmdFreezeAgent())
+	cmd.AddCommand(CmdUnfreezeAgent())
+	cmd.AddCommand(CmdCreatePaymentStream())
+	cmd.AddCommand(CmdCancelPaymentStream())
 	cmd.AddCommand(CmdUpdateAgentSpendPolicy())
+	cmd.AddCommand(CmdUpdateAgentRecipientPolicy())
 	cmd.AddCommand(CmdSubmitAttestedTransfer())
+	cmd.AddCommand(CmdCreateSubAgent())
 	cmd.AddCommand(CmdRevokePolicy())
 	cmd.AddCommand(CmdUnrevokePolicy())
+	cmd.AddCommand(CmdRegisterPolicy())
+	cmd.AddCommand(CmdSupersedePolicy())
 	cmd.AddCommand(CmdSubmitFeedback())
 	cmd.AddCommand(CmdRevokeFeedback())
 
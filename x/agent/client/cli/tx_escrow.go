@@ -2,6 +2,8 @@ package cli
 
 import (
 	"strconv"
+	"strings"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -14,6 +16,12 @@ import (
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
 )
 
+const (
+	FlagSpendBudget   = "spend-budget"
+	FlagDenom         = "denom"
+	FlagCoAttestation = "co-attestation"
+)
+
 func CmdFundAgentEscrow() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fund-escrow [agent-id] [amount]",
@@ -66,10 +74,13 @@ func CmdWithdrawAgentEscrow() *cobra.Command {
 
 func CmdUpdateAgentSpendPolicy() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-spend-policy [agent-id] [spend-denom] [spend-limit-per-window] [spend-window-blocks]",
+		Use:   "update-spend-policy [agent-id] [spend-denom] [spend-limit-per-window] [spend-window]",
 		Short: "Set an agent's spend policy (owner only, effective immediately)",
-		Long:  "Set an agent's spend policy. Pass an empty spend-denom ('') with limit 0 and window 0 to disable spending.",
-		Args:  cobra.ExactArgs(4),
+		Long: `Set an agent's spend policy. Pass an empty spend-denom ('') with limit 0 and window 0 to disable spending.
+
+A window is a block count (600), a block-time duration that resets on aligned boundaries (24h),
+or a rolling block-time duration prefixed with "rolling:" (rolling:6h).`,
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -80,20 +91,73 @@ func CmdUpdateAgentSpendPolicy() *cobra.Command {
 			if !ok {
 				return gerrc.ErrInvalidArgument.Wrap("spend limit per window")
 			}
-			windowBlocks, err := strconv.ParseUint(args[3], 10, 64)
+			window, err := parseSpendWindow(args[3])
+			if err != nil {
+				return err
+			}
+
+			rawBudgets, err := cmd.Flags().GetStringArray(FlagSpendBudget)
+			if err != nil {
+				return err
+			}
+			budgets, err := parseSpendBudgets(rawBudgets)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgUpdateAgentSpendPolicy(clientCtx.GetFromAddress().String(), args[0], args[1], limit, windowBlocks)
+			msg := types.NewMsgUpdateAgentSpendPolicy(clientCtx.GetFromAddress().String(), args[0], args[1], limit, window.WindowBlocks, budgets...)
+			msg.SpendWindowMode = window.WindowMode
+			msg.SpendWindowDuration = window.WindowDuration
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringArray(FlagSpendBudget, nil, "Per-denom budget for a denom other than spend-denom, as <limit><denom>:<window>, e.g. 1000uusdc:600 or 1000uusdc:rolling:24h (repeatable)")
 	return cmd
 }
 
+// parseSpendBudgets parses <limit><denom>:<window> budget specs.
+func parseSpendBudgets(raw []string) ([]types.SpendBudget, error) {
+	budgets := make([]types.SpendBudget, 0, len(raw))
+	for _, r := range raw {
+		limitStr, windowStr, ok := strings.Cut(r, ":")
+		if !ok {
+			return nil, gerrc.ErrInvalidArgument.Wrapf("spend budget %q: expected <limit><denom>:<window>", r)
+		}
+		limit, err := sdk.ParseCoinNormalized(limitStr)
+		if err != nil {
+			return nil, gerrc.ErrInvalidArgument.Wrapf("spend budget %q: limit: %s", r, err)
+		}
+		b, err := parseSpendWindow(windowStr)
+		if err != nil {
+			return nil, gerrc.ErrInvalidArgument.Wrapf("spend budget %q: %s", r, err)
+		}
+		b.Denom = limit.Denom
+		b.LimitPerWindow = limit.Amount
+		budgets = append(budgets, b)
+	}
+	return budgets, nil
+}
+
+// parseSpendWindow parses a window spec into the window fields of a budget: a
+// block count, a duration for a time window, or rolling:<duration>.
+func parseSpendWindow(raw string) (types.SpendBudget, error) {
+	if blocks, err := strconv.ParseUint(raw, 10, 64); err == nil {
+		return types.SpendBudget{WindowBlocks: blocks}, nil
+	}
+	mode := types.SpendWindowMode_SPEND_WINDOW_MODE_TIME
+	if d, ok := strings.CutPrefix(raw, "rolling:"); ok {
+		mode = types.SpendWindowMode_SPEND_WINDOW_MODE_ROLLING
+		raw = d
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return types.SpendBudget{}, gerrc.ErrInvalidArgument.Wrapf("window %q: expected block count, duration or rolling:<duration>", raw)
+	}
+	return types.SpendBudget{WindowMode: mode, WindowDuration: d}, nil
+}
+
 func CmdSubmitAttestedTransfer() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-attested-transfer [agent-id] [recipient] [amount] [memo] [token]",
@@ -109,14 +173,43 @@ func CmdSubmitAttestedTransfer() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			if msg.Denom, err = cmd.Flags().GetString(FlagDenom); err != nil {
+				return err
+			}
+			coAttestations, err := cmd.Flags().GetStringArray(FlagCoAttestation)
+			if err != nil {
+				return err
+			}
+			if msg.CoAttestations, err = parseCoAttestations(coAttestations); err != nil {
+				return err
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagDenom, "", "Denom to pay out; defaults to the agent's spend denom")
+	cmd.Flags().StringArray(FlagCoAttestation, nil, "Co-policy attestation as policy-index=token; repeat for each co-policy")
 	return cmd
 }
 
+// parseCoAttestations parses policy-index=token pairs.
+func parseCoAttestations(raw []string) ([]types.CoAttestation, error) {
+	atts := make([]types.CoAttestation, 0, len(raw))
+	for _, r := range raw {
+		index, token, ok := strings.Cut(r, "=")
+		if !ok {
+			return nil, gerrc.ErrInvalidArgument.Wrapf("co-attestation %q: want policy-index=token", r)
+		}
+		i, err := strconv.ParseUint(index, 10, 32)
+		if err != nil {
+			return nil, gerrc.ErrInvalidArgument.Wrapf("co-attestation %q: policy index", r)
+		}
+		atts = append(atts, types.CoAttestation{PolicyIndex: uint32(i), Token: token})
+	}
+	return atts, nil
+}
+
 func newMsgSubmitAttestedTransfer(submitter string, args []string) (*types.MsgSubmitAttestedTransfer, error) {
 	amount, ok := math.NewIntFromString(args[2])
 	if !ok {
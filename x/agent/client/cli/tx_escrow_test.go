@@ -2,9 +2,12 @@ package cli
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
 )
 
 func TestSubmitAttestedTransferCommand(t *testing.T) {
@@ -39,3 +42,39 @@ func TestSubmitAttestedTransferCommand(t *testing.T) {
 		require.ErrorContains(t, err, "amount")
 	})
 }
+
+func TestParseSpendBudgets(t *testing.T) {
+	budgets, err := parseSpendBudgets([]string{"1000uusdc:600", "5ibc/ABC:10"})
+	require.NoError(t, err)
+	require.Len(t, budgets, 2)
+	require.Equal(t, "uusdc", budgets[0].Denom)
+	require.True(t, math.NewInt(1000).Equal(budgets[0].LimitPerWindow))
+	require.Equal(t, uint64(600), budgets[0].WindowBlocks)
+	require.Equal(t, "ibc/ABC", budgets[1].Denom)
+
+	_, err = parseSpendBudgets([]string{"1000uusdc"})
+	require.ErrorContains(t, err, "expected")
+	_, err = parseSpendBudgets([]string{"1000uusdc:x"})
+	require.ErrorContains(t, err, "window")
+	_, err = parseSpendBudgets([]string{"1000uusdc:rolling:x"})
+	require.ErrorContains(t, err, "window")
+}
+
+func TestParseSpendWindow(t *testing.T) {
+	b, err := parseSpendWindow("600")
+	require.NoError(t, err)
+	require.Equal(t, types.SpendBudget{WindowBlocks: 600}, b)
+
+	b, err = parseSpendWindow("24h")
+	require.NoError(t, err)
+	require.Equal(t, types.SpendBudget{WindowMode: types.SpendWindowMode_SPEND_WINDOW_MODE_TIME, WindowDuration: 24 * time.Hour}, b)
+
+	b, err = parseSpendWindow("rolling:6h")
+	require.NoError(t, err)
+	require.Equal(t, types.SpendBudget{WindowMode: types.SpendWindowMode_SPEND_WINDOW_MODE_ROLLING, WindowDuration: 6 * time.Hour}, b)
+
+	budgets, err := parseSpendBudgets([]string{"1000uusdc:rolling:24h"})
+	require.NoError(t, err)
+	require.Equal(t, types.SpendWindowMode_SPEND_WINDOW_MODE_ROLLING, budgets[0].WindowMode)
+	require.Equal(t, "uusdc", budgets[0].Denom)
+}
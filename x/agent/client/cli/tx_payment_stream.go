@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+const FlagStartTime = "start-time"
+
+func CmdCreatePaymentStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-payment-stream [agent-id] [recipient] [amount-per-period] [period] [end-time]",
+		Short: "Create a recurring payout from an agent's escrow (owner only)",
+		Long:  "Create a recurring payout from an agent's escrow, paid every period until end-time (RFC3339, exclusive). Payouts count against the agent's spend budget and recipient policy. The first period falls due at --start-time, or now if unset.",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			period, err := time.ParseDuration(args[3])
+			if err != nil {
+				return err
+			}
+			end, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return err
+			}
+			var start time.Time
+			if raw, _ := cmd.Flags().GetString(FlagStartTime); raw != "" {
+				if start, err = time.Parse(time.RFC3339, raw); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreatePaymentStream(clientCtx.GetFromAddress().String(), args[0], args[1], amount, period, start, end)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagStartTime, "", "RFC3339 time the first period falls due; defaults to the block time")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelPaymentStream() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-payment-stream [agent-id] [stream-id]",
+		Short: "Cancel a payment stream (owner, guardian or governance)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelPaymentStream(clientCtx.GetFromAddress().String(), args[0], id)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
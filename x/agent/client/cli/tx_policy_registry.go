@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+// FlagPolicyID names a registered policy to use in place of a policy file.
+const FlagPolicyID = "policy-id"
+
+func CmdRegisterPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-policy [policy-json-file]",
+		Short: "Register a TEE policy in the shared registry, administered by the sender",
+		Long:  "Register a TEE policy as version 1 of a new lineage. The policy id is its fingerprint; agents and rollapps reference it by id and follow its supersessions.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy, err := readPolicy(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterPolicy(clientCtx.GetFromAddress().String(), policy)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSupersedePolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supersede-policy [id] [policy-json-file]",
+		Short: "Supersede the latest version of a registered policy (lineage admin or governance authority only)",
+		Long:  "Register the policy in policy-json-file as the next version of the lineage whose head is id. Every agent and rollapp referencing the lineage moves to it immediately.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			policy, err := readPolicy(clientCtx, args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSupersedePolicy(clientCtx.GetFromAddress().String(), args[0], policy)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func readPolicy(clientCtx client.Context, path string) (tee.Policy, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return tee.Policy{}, err
+	}
+	var policy tee.Policy
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &policy); err != nil {
+		return tee.Policy{}, err
+	}
+	return policy, nil
+}
+
+// readPolicyOrID returns the policy in the file at args[0], or the registered
+// policy id given by FlagPolicyID, exactly one of which must be present.
+func readPolicyOrID(cmd *cobra.Command, clientCtx client.Context, args []string) (tee.Policy, string, error) {
+	id, err := cmd.Flags().GetString(FlagPolicyID)
+	if err != nil {
+		return tee.Policy{}, "", err
+	}
+	if (id == "") == (len(args) == 0) {
+		return tee.Policy{}, "", fmt.Errorf("give exactly one of a policy json file and --%s", FlagPolicyID)
+	}
+	if id != "" {
+		return tee.Policy{}, id, nil
+	}
+	policy, err := readPolicy(clientCtx, args[0])
+	return policy, "", err
+}
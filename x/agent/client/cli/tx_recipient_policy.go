@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+func CmdUpdateAgentRecipientPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-recipient-policy [agent-id] [policy-json-file]",
+		Short: "Set an agent's recipient policy (owner only, effective immediately)",
+		Long:  "Set an agent's recipient policy. policy-json-file is a JSON file holding the RecipientPolicy (allowlist, per_recipient_cap, cap_window_blocks, dym_name_resolved_only). An empty object ({}) removes the policy.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var policy types.RecipientPolicy
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &policy); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAgentRecipientPolicy(clientCtx.GetFromAddress().String(), args[0], policy)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -9,35 +9,56 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
-	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 )
 
+// FlagPolicySet names a JSON file holding an agent's PolicySet.
+const FlagPolicySet = "policy-set"
+
 func CmdRegisterAgent() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "register-agent [agent-id] [policy-json-file]",
 		Short: "Register a new agent with an immutable attestation policy",
-		Long:  "Register a new agent. policy-json-file is a JSON file holding the tee.Policy (gcp_root_cert_pem, policy_values, policy_query, policy_structure).",
-		Args:  cobra.ExactArgs(2),
+		Long: "Register a new agent. policy-json-file is a JSON file holding the tee.Policy (type, gcp_root_cert_pem, policy_values, policy_query, policy_structure). " +
+			"With --policy-id instead, the agent binds to a registered policy and follows its supersessions.",
+		Args: cobra.RangeArgs(1, 2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			bz, err := os.ReadFile(args[1])
+			policy, policyID, err := readPolicyOrID(cmd, clientCtx, args[1:])
 			if err != nil {
 				return err
 			}
-			var policy tee.Policy
-			if err := clientCtx.Codec.UnmarshalJSON(bz, &policy); err != nil {
-				return err
-			}
 
 			msg := types.NewMsgRegisterAgent(clientCtx.GetFromAddress().String(), args[0], policy)
+			msg.PolicyId = policyID
+			if path, err := cmd.Flags().GetString(FlagPolicySet); err != nil {
+				return err
+			} else if path != "" {
+				if msg.PolicySet, err = readPolicySet(clientCtx, path); err != nil {
+					return err
+				}
+			}
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagPolicySet, "", "JSON file holding the agent's PolicySet (co_policies, quorum)")
+	cmd.Flags().String(FlagPolicyID, "", "Registered policy id to bind the agent to, in place of policy-json-file")
 	return cmd
 }
+
+func readPolicySet(clientCtx client.Context, path string) (types.PolicySet, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return types.PolicySet{}, err
+	}
+	var set types.PolicySet
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &set); err != nil {
+		return types.PolicySet{}, err
+	}
+	return set, nil
+}
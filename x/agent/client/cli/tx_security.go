@@ -0,0 +1,106 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+func CmdCancelAgentWithdrawal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-withdrawal [agent-id] [withdrawal-id]",
+		Short: "Cancel a pending escrow withdrawal and return it to the escrow (owner only)",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAgentWithdrawal(clientCtx.GetFromAddress().String(), args[0], id)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUpdateAgentSecurityPolicy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-security-policy [agent-id] [guardian] [withdrawal-delay-blocks]",
+		Short: "Set an agent's guardian and withdrawal delay (owner only)",
+		Long:  "Set an agent's guardian and withdrawal delay. Pass an empty guardian ('') for none. Adding a guardian or lengthening the delay applies immediately; any other change waits out the current withdrawal delay.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			delay, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAgentSecurityPolicy(clientCtx.GetFromAddress().String(), args[0], types.SecurityPolicy{
+				Guardian:              args[1],
+				WithdrawalDelayBlocks: delay,
+			})
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdFreezeAgent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-agent [agent-id]",
+		Short: "Freeze an agent's actions, transfers and withdrawals (guardian only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFreezeAgent(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUnfreezeAgent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-agent [agent-id]",
+		Short: "Lift an agent freeze (guardian only)",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUnfreezeAgent(clientCtx.GetFromAddress().String(), args[0])
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+func CmdCreateSubAgent() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-sub-agent [parent-id] [spec-json-file] [token]",
+		Short: "Create a sub-agent of an agent, authorized by the parent's attestation",
+		Long:  "Create a sub-agent. spec-json-file is a JSON file holding the SubAgentSpec (agent_id, policy, policy_set, budget, spend_budgets); token is the parent's attestation over it.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var spec types.SubAgentSpec
+			if err := clientCtx.Codec.UnmarshalJSON(bz, &spec); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateSubAgent(clientCtx.GetFromAddress().String(), args[0], spec, args[2])
+			coAttestations, err := cmd.Flags().GetStringArray(FlagCoAttestation)
+			if err != nil {
+				return err
+			}
+			if msg.CoAttestations, err = parseCoAttestations(coAttestations); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringArray(FlagCoAttestation, nil, "Co-policy attestation as policy-index=token; repeat for each co-policy")
+	return cmd
+}
@@ -11,7 +11,10 @@ import (
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
 )
 
-const flagTag2 = "tag2"
+const (
+	flagTag2     = "tag2"
+	flagVerified = "verified"
+)
 
 func CmdSubmitFeedback() *cobra.Command {
 	cmd := &cobra.Command{
@@ -39,12 +42,19 @@ func CmdSubmitFeedback() *cobra.Command {
 				return err
 			}
 
+			verified, err := cmd.Flags().GetBool(flagVerified)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgSubmitFeedback(clientCtx.GetFromAddress().String(), args[0], uint32(score), args[2], tag2, evidenceSeq)
+			msg.Verified = verified
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(flagTag2, "", "optional secondary dimension tag")
+	cmd.Flags().Bool(flagVerified, false, "submit as verified feedback: evidence-seq must be an action you submitted or a transfer that paid you")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
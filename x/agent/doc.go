@@ -2,9 +2,28 @@
 // attested action log, reputation, and per-agent escrows.
 // Agents register with an attestation policy, may rotate it after a timelock,
 // append actions verified against the policy currently in force, and pay
-// attested transfers under an owner-set, per-window spend budget. A
+// attested transfers under owner-set, per-denom, per-window spend budgets. A
 // governance-gated denylist prevents byte-identical policy fingerprints from
 // registering new agents, appending attested actions, or fulfilling eIBC orders
 // through agent-bound on-demand LPs. Unrevoking is fully reversible since agent
 // state is never mutated by revocation.
+// Action log entries are pruned from state under governance retention params,
+// while a per-agent Merkle mountain range over every entry keeps pruned
+// entries provable to off-chain archivers.
+// An agent may create sub-agents under its own attestation, carving their
+// escrows out of its own. A sub-agent's spends also count against the budgets
+// of every ancestor, and deactivation cascades down the tree, returning each
+// sub-agent's escrow to its parent.
+// Policies may also be registered in a shared registry, under ids scoped to
+// their admin, that other modules resolve by id. The lineage admin or
+// governance supersedes a registered policy with a new version. Agents bound
+// to the lineage rotate onto it after the policy rotation delay; rollapp TEE
+// configs, which may only reference lineages governance administers, pick it
+// up immediately.
+// Every accepted attestation token is consumed: tokens issued more than
+// attestation_max_age before the block time are rejected, and the digests of
+// consumed ones are kept until they would be too old anyway, so a leaked token
+// cannot be re-submitted. Intel DCAP quotes carry no issue time; they are
+// remembered for attestation_max_age from submission and otherwise rely on
+// their nonce.
 package agent
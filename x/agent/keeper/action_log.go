@@ -4,17 +4,51 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
 )
 
+// appendActionLogEntry stores entry, accumulates its leaf into the agent's
+// action log commitment and prunes the agent's entries beyond the retention
+// count.
+func (k Keeper) appendActionLogEntry(ctx sdk.Context, entry types.ActionLogEntry) error {
+	c := k.GetActionLogCommitment(ctx, entry.AgentId)
+	if c.LeafCount != entry.Seq {
+		return gerrc.ErrInternal.Wrapf("action log accumulator of agent %s at %d, entry seq %d", entry.AgentId, c.LeafCount, entry.Seq)
+	}
+	if err := k.accumulateActionLogLeaf(ctx, &c, types.ActionLogLeafHash(entry)); err != nil {
+		return errorsmod.Wrap(err, "accumulate leaf")
+	}
+	if err := k.setActionLogEntry(ctx, entry); err != nil {
+		return err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "get params")
+	}
+	if retention := params.ActionLogRetentionEntries; retention != 0 && c.Retained() > retention {
+		n := min(c.Retained()-retention, params.ActionLogPruneLimit)
+		if err := k.pruneActionLog(ctx, &c, n); err != nil {
+			return errorsmod.Wrap(err, "prune action log")
+		}
+	}
+	return k.actionLogCommitments.Set(ctx, c.AgentId, c)
+}
+
 func (k Keeper) setActionLogEntry(ctx sdk.Context, entry types.ActionLogEntry) error {
-	return k.actionLog.Set(ctx, collections.Join(entry.AgentId, entry.Seq), entry)
+	if err := k.actionLog.Set(ctx, collections.Join(entry.AgentId, entry.Seq), entry); err != nil {
+		return err
+	}
+	return k.actionLogQueue.Set(ctx, collections.Join3(entry.Height, entry.AgentId, entry.Seq))
 }
 
 // GetActionLogEntry returns the log entry for (agentID, seq) and whether it
-// was found.
+// was found. Pruned entries are not found.
 func (k Keeper) GetActionLogEntry(ctx sdk.Context, agentID string, seq uint64) (types.ActionLogEntry, bool) {
 	entry, err := k.actionLog.Get(ctx, collections.Join(agentID, seq))
 	if err != nil {
@@ -25,3 +59,175 @@ func (k Keeper) GetActionLogEntry(ctx sdk.Context, agentID string, seq uint64) (
 	}
 	return entry, true
 }
+
+// GetActionLogCommitment returns the agent's action log accumulator, empty if
+// the agent has not logged anything.
+func (k Keeper) GetActionLogCommitment(ctx sdk.Context, agentID string) types.ActionLogCommitment {
+	c, err := k.actionLogCommitments.Get(ctx, agentID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ActionLogCommitment{AgentId: agentID}
+		}
+		panic(err)
+	}
+	return c
+}
+
+func (k Keeper) getActionLogNode(ctx sdk.Context, agentID string, level uint32, index uint64) ([]byte, error) {
+	hash, err := k.actionLogNodes.Get(ctx, collections.Join3(agentID, level, index))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "action log node: agent %s level %d index %d", agentID, level, index)
+	}
+	return hash, nil
+}
+
+// accumulateActionLogLeaf appends leaf to c, storing it and every subtree
+// root it completes, and recomputes the root. The caller persists c.
+func (k Keeper) accumulateActionLogLeaf(ctx sdk.Context, c *types.ActionLogCommitment, leaf []byte) error {
+	node, index, level := leaf, c.LeafCount, uint32(0)
+	if err := k.actionLogNodes.Set(ctx, collections.Join3(c.AgentId, level, index), node); err != nil {
+		return err
+	}
+	// a right child completes its parent
+	for index&1 == 1 {
+		left, err := k.getActionLogNode(ctx, c.AgentId, level, index-1)
+		if err != nil {
+			return err
+		}
+		node, index, level = types.ActionLogNodeHash(left, node), index>>1, level+1
+		if err := k.actionLogNodes.Set(ctx, collections.Join3(c.AgentId, level, index), node); err != nil {
+			return err
+		}
+	}
+	c.LeafCount++
+
+	peaks, err := k.actionLogPeakHashes(ctx, c.AgentId, c.LeafCount)
+	if err != nil {
+		return err
+	}
+	c.Root = types.BagActionLogPeaks(peaks)
+	return nil
+}
+
+func (k Keeper) actionLogPeakHashes(ctx sdk.Context, agentID string, leafCount uint64) ([][]byte, error) {
+	var hashes [][]byte
+	for _, p := range types.ActionLogPeaks(leafCount) {
+		hash, err := k.getActionLogNode(ctx, agentID, p.Level, p.Index)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
+
+// actionLogProof returns an inclusion proof of the agent's entry at seq
+// against the current commitment root. Pruned entries are provable too.
+func (k Keeper) actionLogProof(ctx sdk.Context, agentID string, seq uint64) (types.ActionLogProof, []byte, error) {
+	c := k.GetActionLogCommitment(ctx, agentID)
+	if c.LeafCount <= seq {
+		return types.ActionLogProof{}, nil, errorsmod.Wrapf(types.ErrActionNotFound, "agent %s seq %d", agentID, seq)
+	}
+
+	peaks := types.ActionLogPeaks(c.LeafCount)
+	proof := types.ActionLogProof{Seq: seq, LeafCount: c.LeafCount}
+	for i, p := range peaks {
+		if p.Covers(seq) {
+			proof.PeakIndex = uint32(i)
+		}
+	}
+
+	leaf, err := k.getActionLogNode(ctx, agentID, 0, seq)
+	if err != nil {
+		return types.ActionLogProof{}, nil, err
+	}
+	proof.LeafHash = leaf
+	index := seq
+	for level := uint32(0); level < peaks[proof.PeakIndex].Level; level++ {
+		sibling, err := k.getActionLogNode(ctx, agentID, level, index^1)
+		if err != nil {
+			return types.ActionLogProof{}, nil, err
+		}
+		proof.Siblings = append(proof.Siblings, sibling)
+		index >>= 1
+	}
+
+	proof.Peaks, err = k.actionLogPeakHashes(ctx, agentID, c.LeafCount)
+	if err != nil {
+		return types.ActionLogProof{}, nil, err
+	}
+	return proof, c.Root, nil
+}
+
+// pruneActionLog removes the agent's n oldest retained entries from state.
+// Their leaves stay in the accumulator. The caller persists c.
+func (k Keeper) pruneActionLog(ctx sdk.Context, c *types.ActionLogCommitment, n uint64) error {
+	if n == 0 {
+		return nil
+	}
+	if c.Retained() < n {
+		return gerrc.ErrInternal.Wrapf("prune %d action log entries of agent %s, %d retained", n, c.AgentId, c.Retained())
+	}
+	from := c.PrunedBefore
+	for seq := from; seq < from+n; seq++ {
+		entry, found := k.GetActionLogEntry(ctx, c.AgentId, seq)
+		if !found {
+			return errorsmod.Wrapf(types.ErrActionNotFound, "retained entry: agent %s seq %d", c.AgentId, seq)
+		}
+		if err := k.actionLog.Remove(ctx, collections.Join(c.AgentId, seq)); err != nil {
+			return err
+		}
+		if err := k.actionLogQueue.Remove(ctx, collections.Join3(entry.Height, c.AgentId, seq)); err != nil {
+			return err
+		}
+	}
+	c.PrunedBefore = from + n
+	return uevent.EmitTypedEvent(ctx, &types.EventPruneActionLog{
+		AgentId: c.AgentId,
+		FromSeq: from,
+		ToSeq:   c.PrunedBefore,
+	})
+}
+
+// PruneExpiredActionLogs prunes entries logged at least
+// action_log_retention_blocks ago, oldest first and at most
+// action_log_prune_limit per block; the rest are left for later blocks.
+func (k Keeper) PruneExpiredActionLogs(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "get params")
+	}
+	retention := params.ActionLogRetentionBlocks
+	if retention == 0 || uint64(ctx.BlockHeight()) < retention {
+		return nil
+	}
+
+	// Per agent, queue order is seq order, so the expired entries of an
+	// agent are always its oldest retained ones.
+	var agentIDs []string
+	counts := make(map[string]uint64)
+	rng := collections.NewPrefixUntilTripleRange[int64, string, uint64](ctx.BlockHeight() - int64(retention))
+	budget := params.ActionLogPruneLimit
+	err = k.actionLogQueue.Walk(ctx, rng, func(key collections.Triple[int64, string, uint64]) (stop bool, err error) {
+		if _, ok := counts[key.K2()]; !ok {
+			agentIDs = append(agentIDs, key.K2())
+		}
+		counts[key.K2()]++
+		budget--
+		return budget == 0, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "walk action log queue")
+	}
+
+	for _, agentID := range agentIDs {
+		c := k.GetActionLogCommitment(ctx, agentID)
+		if err := k.pruneActionLog(ctx, &c, counts[agentID]); err != nil {
+			return errorsmod.Wrapf(err, "prune action log of agent %s", agentID)
+		}
+		if err := k.actionLogCommitments.Set(ctx, agentID, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
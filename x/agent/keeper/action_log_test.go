@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+func setupActionLogRetention(t *testing.T, entries, blocks, pruneLimit uint64) (sdk.Context, *keeper.Keeper) {
+	t.Helper()
+	ctx, k, _ := setup(t)
+	params := types.DefaultParams()
+	params.ActionLogRetentionEntries = entries
+	params.ActionLogRetentionBlocks = blocks
+	params.ActionLogPruneLimit = pruneLimit
+	require.NoError(t, k.SetParams(ctx, params))
+	seedAgent(t, ctx, k, "agent1", true)
+	return ctx, k
+}
+
+// submitActions appends n actions starting at seq from and returns the
+// entries as logged.
+func submitActions(t *testing.T, ctx sdk.Context, k *keeper.Keeper, from, n uint64) []types.ActionLogEntry {
+	t.Helper()
+	var entries []types.ActionLogEntry
+	for seq := from; seq < from+n; seq++ {
+		submitAction(t, ctx, k, "agent1", []byte(fmt.Sprintf("p%d", seq)), seq)
+		e, found := k.GetActionLogEntry(ctx, "agent1", seq)
+		require.True(t, found)
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func requireProvable(t *testing.T, ctx sdk.Context, k *keeper.Keeper, e types.ActionLogEntry) {
+	t.Helper()
+	res, err := k.ActionLogProof(ctx, &types.QueryActionLogProofRequest{AgentId: e.AgentId, Seq: e.Seq})
+	require.NoError(t, err)
+	require.NoError(t, res.Proof.VerifyEntry(e, res.Root), "seq %d", e.Seq)
+}
+
+func TestActionLogProof_EveryEntryAgainstCurrentRoot(t *testing.T) {
+	ctx, k := setupActionLogRetention(t, 0, 0, 10)
+
+	var roots [][]byte
+	var entries []types.ActionLogEntry
+	for seq := uint64(0); seq < 11; seq++ {
+		entries = append(entries, submitActions(t, ctx, k, seq, 1)...)
+		res, err := k.ActionLogCommitment(ctx, &types.QueryActionLogCommitmentRequest{AgentId: "agent1"})
+		require.NoError(t, err)
+		require.Equal(t, seq+1, res.Commitment.LeafCount)
+		roots = append(roots, res.Commitment.Root)
+		for _, e := range entries {
+			requireProvable(t, ctx, k, e)
+		}
+	}
+	// every append moves the root
+	for i := 1; i < len(roots); i++ {
+		require.NotEqual(t, roots[i-1], roots[i])
+	}
+
+	_, err := k.ActionLogProof(ctx, &types.QueryActionLogProofRequest{AgentId: "agent1", Seq: 11})
+	require.ErrorIs(t, err, types.ErrActionNotFound)
+}
+
+func TestActionLog_PrunesBeyondRetentionCount(t *testing.T) {
+	ctx, k := setupActionLogRetention(t, 3, 0, 10)
+
+	entries := submitActions(t, ctx, k, 0, 5)
+
+	c := k.GetActionLogCommitment(ctx, "agent1")
+	require.Equal(t, uint64(5), c.LeafCount)
+	require.Equal(t, uint64(2), c.PrunedBefore)
+	for seq := uint64(0); seq < 5; seq++ {
+		_, found := k.GetActionLogEntry(ctx, "agent1", seq)
+		require.Equal(t, seq >= 2, found, "seq %d", seq)
+	}
+	_, err := k.AgentAction(ctx, &types.QueryAgentActionRequest{AgentId: "agent1", Seq: 0})
+	require.ErrorContains(t, err, "pruned")
+
+	// pruned entries stay provable; retained ones come with the proof
+	for _, e := range entries {
+		requireProvable(t, ctx, k, e)
+	}
+	res, err := k.ActionLogProof(ctx, &types.QueryActionLogProofRequest{AgentId: "agent1", Seq: 0})
+	require.NoError(t, err)
+	require.Nil(t, res.Action)
+	res, err = k.ActionLogProof(ctx, &types.QueryActionLogProofRequest{AgentId: "agent1", Seq: 4})
+	require.NoError(t, err)
+	require.Equal(t, &entries[4], res.Action)
+}
+
+func TestActionLog_PruneLimitDrainsGradually(t *testing.T) {
+	ctx, k := setupActionLogRetention(t, 0, 0, 2)
+	submitActions(t, ctx, k, 0, 6)
+
+	// lowering retention leaves a backlog that each append drains by the
+	// prune limit
+	params, err := k.GetParams(ctx)
+	require.NoError(t, err)
+	params.ActionLogRetentionEntries = 1
+	require.NoError(t, k.SetParams(ctx, params))
+
+	submitActions(t, ctx, k, 6, 1)
+	require.Equal(t, uint64(2), k.GetActionLogCommitment(ctx, "agent1").PrunedBefore)
+	submitActions(t, ctx, k, 7, 1)
+	require.Equal(t, uint64(4), k.GetActionLogCommitment(ctx, "agent1").PrunedBefore)
+}
+
+func TestActionLog_PrunesExpiredInEndBlock(t *testing.T) {
+	ctx, k := setupActionLogRetention(t, 0, 5, 2)
+
+	entries := submitActions(t, ctx.WithBlockHeight(10), k, 0, 3)
+	entries = append(entries, submitActions(t, ctx.WithBlockHeight(12), k, 3, 1)...)
+
+	// nothing has been kept for 5 blocks yet
+	require.NoError(t, k.PruneExpiredActionLogs(ctx.WithBlockHeight(14)))
+	require.Equal(t, uint64(0), k.GetActionLogCommitment(ctx, "agent1").PrunedBefore)
+
+	// three entries expired; the prune limit takes two per block
+	require.NoError(t, k.PruneExpiredActionLogs(ctx.WithBlockHeight(15)))
+	require.Equal(t, uint64(2), k.GetActionLogCommitment(ctx, "agent1").PrunedBefore)
+	require.NoError(t, k.PruneExpiredActionLogs(ctx.WithBlockHeight(16)))
+	require.Equal(t, uint64(3), k.GetActionLogCommitment(ctx, "agent1").PrunedBefore)
+	_, found := k.GetActionLogEntry(ctx, "agent1", 3)
+	require.True(t, found)
+
+	require.NoError(t, k.PruneExpiredActionLogs(ctx.WithBlockHeight(17)))
+	c := k.GetActionLogCommitment(ctx, "agent1")
+	require.Equal(t, uint64(4), c.PrunedBefore)
+	require.Zero(t, c.Retained())
+	for _, e := range entries {
+		requireProvable(t, ctx, k, e)
+	}
+
+	// the log keeps growing on top of the pruned accumulator
+	requireProvable(t, ctx, k, submitActions(t, ctx.WithBlockHeight(17), k, 4, 1)[0])
+}
+
+func TestActionLog_GenesisRoundTripAfterPruning(t *testing.T) {
+	ctx, k := setupActionLogRetention(t, 2, 0, 10)
+	entries := submitActions(t, ctx, k, 0, 5)
+
+	exported := keeper.ExportGenesis(ctx, k)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.ActionLog, 2)
+	require.Len(t, exported.ActionLogCommitments, 1)
+
+	ctx2, k2, _ := setup(t)
+	keeper.InitGenesis(ctx2, k2, *exported)
+	require.Equal(t, exported, keeper.ExportGenesis(ctx2, k2))
+	for _, e := range entries {
+		requireProvable(t, ctx2, k2, e)
+	}
+	// the imported accumulator keeps accepting entries
+	requireProvable(t, ctx2, k2, submitActions(t, ctx2, k2, 5, 1)[0])
+
+	// a root that does not bag from the nodes is rejected
+	exported.ActionLogCommitments[0].Root = exported.ActionLogNodes[0].Hash
+	require.ErrorContains(t, exported.Validate(), "root does not match peaks")
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"errors"
+	"slices"
+	"strings"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
@@ -81,6 +83,9 @@ func (k msgServer) WithdrawAgentEscrow(goCtx context.Context, msg *types.MsgWith
 	if agent.Owner != msg.Owner {
 		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not the agent owner")
 	}
+	if err := k.notFrozen(ctx, agent); err != nil {
+		return nil, err
+	}
 
 	balance, negative := k.GetEscrowBalance(ctx, msg.AgentId).SafeSub(msg.Amount...)
 	if negative {
@@ -90,22 +95,77 @@ func (k msgServer) WithdrawAgentEscrow(goCtx context.Context, msg *types.MsgWith
 		return nil, errorsmod.Wrap(err, "set escrow balance")
 	}
 
-	owner := sdk.MustAccAddressFromBech32(msg.Owner)
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, msg.Amount); err != nil {
-		return nil, errorsmod.Wrap(err, "send coins to owner")
+	// With a timelock the amount is debited now, so attested transfers cannot
+	// spend it while it waits, and paid out by the end blocker.
+	if delay := agent.EffectiveSecurity(ctx.BlockHeight()).WithdrawalDelayBlocks; delay > 0 {
+		w, err := k.queueWithdrawal(ctx, msg.AgentId, msg.Owner, msg.Amount, ctx.BlockHeight()+int64(delay)) //nolint:gosec // delay is bounded by MaxWithdrawalDelayBlocks
+		if err != nil {
+			return nil, err
+		}
+		return &types.MsgWithdrawAgentEscrowResponse{Pending: &w}, nil
 	}
 
-	if err := uevent.EmitTypedEvent(ctx, &types.EventWithdrawAgentEscrow{
-		AgentId: msg.AgentId,
-		Owner:   msg.Owner,
-		Amount:  msg.Amount,
-	}); err != nil {
+	if err := k.payWithdrawal(ctx, msg.AgentId, msg.Owner, msg.Amount); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgWithdrawAgentEscrowResponse{}, nil
 }
 
+// spendFromEscrow pays amount of the budget's denom from the agent's escrow
+// to recipient, enforcing the spend budget, the budgets of a sub-agent's
+// ancestors and the recipient policy, and records the spend on the agent. The
+// caller persists the agent; ancestors are persisted here.
+func (k Keeper) spendFromEscrow(ctx sdk.Context, agent *types.Agent, budget types.SpendBudget, recipient string, amount math.Int) error {
+	now := types.NewSpendClock(ctx)
+	if !budget.Allows(now, amount) {
+		return errorsmod.Wrapf(types.ErrSpendBudgetExceeded, "amount %s%s, remaining %s", amount, budget.Denom, budget.Remaining(now))
+	}
+	if agent.IsSubAgent() {
+		if err := k.chargeAncestors(ctx, *agent, budget.Denom, now, amount); err != nil {
+			return err
+		}
+	}
+
+	usage, err := k.checkRecipient(ctx, agent.Id, recipient, sdk.NewCoin(budget.Denom, amount), now.Height)
+	if err != nil {
+		return err
+	}
+
+	payout := sdk.NewCoins(sdk.NewCoin(budget.Denom, amount))
+	balance, negative := k.GetEscrowBalance(ctx, agent.Id).SafeSub(payout...)
+	if negative {
+		return errorsmod.Wrap(types.ErrInsufficientEscrow, payout.String())
+	}
+	if err := k.setEscrowBalance(ctx, agent.Id, balance); err != nil {
+		return errorsmod.Wrap(err, "set escrow balance")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(recipient), payout); err != nil {
+		return errorsmod.Wrap(err, "send coins to recipient")
+	}
+
+	if usage != nil {
+		if err := k.recipientUsage.Set(ctx, collections.Join(agent.Id, recipient), *usage); err != nil {
+			return errorsmod.Wrap(err, "set recipient usage")
+		}
+	}
+	agent.RecordDenomSpend(budget.Denom, now, amount)
+	agent.TotalSpent = agent.TotalSpent.Add(payout...)
+	return nil
+}
+
+// payWithdrawal sends an already-debited withdrawal to the owner.
+func (k Keeper) payWithdrawal(ctx sdk.Context, agentID, owner string, amount sdk.Coins) error {
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(owner), amount); err != nil {
+		return errorsmod.Wrap(err, "send coins to owner")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventWithdrawAgentEscrow{
+		AgentId: agentID,
+		Owner:   owner,
+		Amount:  amount,
+	})
+}
+
 func (k msgServer) UpdateAgentSpendPolicy(goCtx context.Context, msg *types.MsgUpdateAgentSpendPolicy) (*types.MsgUpdateAgentSpendPolicyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -128,8 +188,13 @@ func (k msgServer) UpdateAgentSpendPolicy(goCtx context.Context, msg *types.MsgU
 	agent.SpendDenom = msg.SpendDenom
 	agent.SpendLimitPerWindow = msg.SpendLimitPerWindow
 	agent.SpendWindowBlocks = msg.SpendWindowBlocks
-	agent.SpendWindowStartHeight = 0
-	agent.SpendWindowSpent = math.ZeroInt()
+	agent.SpendWindowMode = msg.SpendWindowMode
+	agent.SpendWindowDuration = msg.SpendWindowDuration
+	agent.SpendBudgets = slices.Clone(msg.SpendBudgets)
+	slices.SortFunc(agent.SpendBudgets, func(a, b types.SpendBudget) int {
+		return strings.Compare(a.Denom, b.Denom)
+	})
+	agent.ResetSpendWindows()
 	if err := k.SetAgent(ctx, agent); err != nil {
 		return nil, errorsmod.Wrap(err, "set agent")
 	}
@@ -139,6 +204,9 @@ func (k msgServer) UpdateAgentSpendPolicy(goCtx context.Context, msg *types.MsgU
 		SpendDenom:          msg.SpendDenom,
 		SpendLimitPerWindow: msg.SpendLimitPerWindow,
 		SpendWindowBlocks:   msg.SpendWindowBlocks,
+		SpendBudgets:        agent.SpendBudgets,
+		SpendWindowMode:     msg.SpendWindowMode,
+		SpendWindowDuration: msg.SpendWindowDuration,
 	}); err != nil {
 		return nil, err
 	}
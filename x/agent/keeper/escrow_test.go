@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/runtime"
@@ -31,6 +32,7 @@ type EscrowTestSuite struct {
 	k         *keeper.Keeper
 	msgServer types.MsgServer
 	verifier  *fakeVerifier
+	dymns     *fakeDymNS
 }
 
 func TestEscrowTestSuite(t *testing.T) {
@@ -41,12 +43,16 @@ func (s *EscrowTestSuite) SetupTest() {
 	s.App = apptesting.Setup(s.T())
 	s.Ctx = s.App.NewContext(false)
 	s.verifier = &fakeVerifier{}
+	s.dymns = &fakeDymNS{}
 	key := s.App.GetKVStoreKeys()[types.StoreKey]
 	s.k = keeper.NewKeeper(
 		s.App.AppCodec(),
 		runtime.NewKVStoreService(key),
 		s.verifier,
 		s.App.BankKeeper,
+		s.dymns,
+		s.App.StakingKeeper,
+		s.App.LockupKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	s.msgServer = keeper.NewMsgServerImpl(*s.k)
@@ -190,6 +196,74 @@ func (s *EscrowTestSuite) TestTransfer_WindowRollsOver() {
 	s.Require().Equal(math.NewInt(2*windowLimit), s.App.BankKeeper.GetBalance(s.Ctx, recipient, spendDenom).Amount)
 }
 
+// TestTransfer_TimeWindowIgnoresBlockCount checks that a time-based window
+// resets on block time, however many blocks were produced in between.
+func (s *EscrowTestSuite) TestTransfer_TimeWindowIgnoresBlockCount() {
+	owner := s.registerAgent("a1")
+	msg := types.NewMsgUpdateAgentSpendPolicy(owner.String(), "a1", spendDenom, math.NewInt(windowLimit), 0)
+	msg.SpendWindowMode = types.SpendWindowMode_SPEND_WINDOW_MODE_TIME
+	msg.SpendWindowDuration = 24 * time.Hour
+	_, err := s.msgServer.UpdateAgentSpendPolicy(s.Ctx, msg)
+	s.Require().NoError(err)
+	s.fundEscrow("a1", 5000)
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	day := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockHeight(15).WithBlockTime(day.Add(8 * time.Hour))
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, windowLimit, 0))
+	s.Require().NoError(err)
+
+	// many blocks later, same day: still exhausted
+	s.Ctx = s.Ctx.WithBlockHeight(100_000).WithBlockTime(day.Add(23 * time.Hour))
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 1, 1))
+	s.Require().ErrorIs(err, types.ErrSpendBudgetExceeded)
+
+	// next day, one block later: full budget available again
+	s.Ctx = s.Ctx.WithBlockHeight(100_001).WithBlockTime(day.Add(24 * time.Hour))
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, windowLimit, 1))
+	s.Require().NoError(err)
+
+	agent, _ := s.k.GetAgent(s.Ctx, "a1")
+	s.Require().Equal(day.Add(24*time.Hour), agent.SpendWindowStartTime)
+	s.Require().NoError(agent.ValidateSpendState())
+}
+
+// TestTransfer_RollingWindow checks that a rolling window frees budget only as
+// old spends age out, not at a fixed boundary.
+func (s *EscrowTestSuite) TestTransfer_RollingWindow() {
+	owner := s.registerAgent("a1")
+	msg := types.NewMsgUpdateAgentSpendPolicy(owner.String(), "a1", spendDenom, math.NewInt(windowLimit), 0)
+	msg.SpendWindowMode = types.SpendWindowMode_SPEND_WINDOW_MODE_ROLLING
+	msg.SpendWindowDuration = 24 * time.Hour
+	_, err := s.msgServer.UpdateAgentSpendPolicy(s.Ctx, msg)
+	s.Require().NoError(err)
+	s.fundEscrow("a1", 5000)
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	t0 := time.Date(2024, 5, 1, 20, 0, 0, 0, time.UTC)
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 600, 0))
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(t0.Add(6 * time.Hour))
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 400, 1))
+	s.Require().NoError(err)
+
+	// the first spend still counts the next day
+	s.Ctx = s.Ctx.WithBlockTime(t0.Add(23 * time.Hour))
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 1, 2))
+	s.Require().ErrorIs(err, types.ErrSpendBudgetExceeded)
+
+	// once it has aged out, exactly its amount is available again
+	s.Ctx = s.Ctx.WithBlockTime(t0.Add(25 * time.Hour))
+	res, err := s.k.EscrowBalance(s.Ctx, &types.QueryEscrowBalanceRequest{AgentId: "a1"})
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(600), res.RemainingWindowBudget)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 601, 2))
+	s.Require().ErrorIs(err, types.ErrSpendBudgetExceeded)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 600, 2))
+	s.Require().NoError(err)
+}
+
 func (s *EscrowTestSuite) TestTransfer_NonceBindsRecipientAndAmount() {
 	s.spendingAgent("a1")
 	s.fundEscrow("a1", 500)
@@ -414,3 +488,105 @@ func (s *EscrowTestSuite) TestEscrowBalanceQuery() {
 	s.Require().NoError(err)
 	s.Require().Equal(math.NewInt(windowLimit), res.RemainingWindowBudget)
 }
+
+const budgetDenom = "uusdc"
+
+// multiDenomAgent registers a spending agent with an extra budget for
+// budgetDenom and funds both denoms.
+func (s *EscrowTestSuite) multiDenomAgent(id string) sdk.AccAddress {
+	owner := s.registerAgent(id)
+	_, err := s.msgServer.UpdateAgentSpendPolicy(s.Ctx, types.NewMsgUpdateAgentSpendPolicy(
+		owner.String(), id, spendDenom, math.NewInt(windowLimit), windowLen,
+		types.SpendBudget{Denom: budgetDenom, LimitPerWindow: math.NewInt(300), WindowBlocks: 5},
+	))
+	s.Require().NoError(err)
+
+	_, _, funder := testdata.KeyTestPubAddr()
+	coins := sdk.NewCoins(sdk.NewCoin(spendDenom, math.NewInt(5000)), sdk.NewCoin(budgetDenom, math.NewInt(5000)))
+	s.FundAcc(funder, coins)
+	_, err = s.msgServer.FundAgentEscrow(s.Ctx, types.NewMsgFundAgentEscrow(funder.String(), id, coins))
+	s.Require().NoError(err)
+	return owner
+}
+
+// denomTransferMsg is transferMsg for an explicit payout denom.
+func (s *EscrowTestSuite) denomTransferMsg(id, denom string, recipient sdk.AccAddress, amt int64, seq uint64) *types.MsgSubmitAttestedTransfer {
+	msg := s.transferMsg(id, recipient, amt, seq)
+	msg.Denom = denom
+	msg.Token = types.TransferNonce(id, types.AttestedTransferBytes(recipient.String(), denom, msg.Amount, msg.Memo), seq)
+	return msg
+}
+
+func (s *EscrowTestSuite) TestTransfer_MultiDenomBudgets() {
+	s.multiDenomAgent("a1")
+	_, _, recipient := testdata.KeyTestPubAddr()
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+
+	// each denom is metered against its own budget
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.denomTransferMsg("a1", budgetDenom, recipient, 300, 0))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.denomTransferMsg("a1", budgetDenom, recipient, 1, 1))
+	s.Require().ErrorIs(err, types.ErrSpendBudgetExceeded)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, windowLimit, 1))
+	s.Require().NoError(err)
+
+	s.Require().Equal(math.NewInt(300), s.App.BankKeeper.GetBalance(s.Ctx, recipient, budgetDenom).Amount)
+	s.Require().Equal(math.NewInt(windowLimit), s.App.BankKeeper.GetBalance(s.Ctx, recipient, spendDenom).Amount)
+	s.Require().Equal(math.NewInt(4700), s.k.GetEscrowBalance(s.Ctx, "a1").AmountOf(budgetDenom))
+
+	// the budgeted denom has its own, shorter window
+	s.Ctx = s.Ctx.WithBlockHeight(15)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.denomTransferMsg("a1", budgetDenom, recipient, 300, 2))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 1, 3))
+	s.Require().ErrorIs(err, types.ErrSpendBudgetExceeded)
+}
+
+func (s *EscrowTestSuite) TestTransfer_UnbudgetedDenomRejected() {
+	s.multiDenomAgent("a1")
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.denomTransferMsg("a1", "uatom", recipient, 1, 0))
+	s.Require().ErrorIs(err, types.ErrSpendingDisabled)
+}
+
+func (s *EscrowTestSuite) TestUpdateSpendPolicy_ReplacesBudgetsAndResetsWindows() {
+	owner := s.multiDenomAgent("a1")
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.denomTransferMsg("a1", budgetDenom, recipient, 100, 0))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.UpdateAgentSpendPolicy(s.Ctx, types.NewMsgUpdateAgentSpendPolicy(
+		owner.String(), "a1", spendDenom, math.NewInt(windowLimit), windowLen,
+		types.SpendBudget{Denom: "uatom", LimitPerWindow: math.NewInt(7), WindowBlocks: 3},
+		types.SpendBudget{Denom: budgetDenom, LimitPerWindow: math.NewInt(300), WindowBlocks: 5},
+	))
+	s.Require().NoError(err)
+
+	agent, _ := s.k.GetAgent(s.Ctx, "a1")
+	s.Require().Len(agent.SpendBudgets, 2)
+	s.Require().Equal("uatom", agent.SpendBudgets[0].Denom)
+	s.Require().Equal(budgetDenom, agent.SpendBudgets[1].Denom)
+	s.Require().True(agent.SpendBudgets[1].WindowSpent.IsZero())
+
+	// a budget for the primary denom is rejected
+	_, err = s.msgServer.UpdateAgentSpendPolicy(s.Ctx, types.NewMsgUpdateAgentSpendPolicy(
+		owner.String(), "a1", spendDenom, math.NewInt(windowLimit), windowLen,
+		types.SpendBudget{Denom: spendDenom, LimitPerWindow: math.NewInt(1), WindowBlocks: 1},
+	))
+	s.Require().Error(err)
+}
+
+func (s *EscrowTestSuite) TestEscrowBalanceQuery_RemainingPerDenom() {
+	s.multiDenomAgent("a1")
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.denomTransferMsg("a1", budgetDenom, recipient, 120, 0))
+	s.Require().NoError(err)
+
+	res, err := s.k.EscrowBalance(s.Ctx, &types.QueryEscrowBalanceRequest{AgentId: "a1"})
+	s.Require().NoError(err)
+	s.Require().Equal([]sdk.Coin{
+		sdk.NewCoin(spendDenom, math.NewInt(windowLimit)),
+		sdk.NewCoin(budgetDenom, math.NewInt(180)),
+	}, res.RemainingBudgets)
+}
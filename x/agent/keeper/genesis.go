@@ -15,9 +15,24 @@ func InitGenesis(ctx sdk.Context, k *Keeper, g types.GenesisState) {
 		if err := k.agents.Set(ctx, a.Id, a); err != nil {
 			panic(err)
 		}
+		if a.IsSubAgent() {
+			if err := k.subAgents.Set(ctx, collections.Join(a.ParentId, a.Id)); err != nil {
+				panic(err)
+			}
+		}
 	}
 	for _, e := range g.ActionLog {
-		if err := k.actionLog.Set(ctx, collections.Join(e.AgentId, e.Seq), e); err != nil {
+		if err := k.setActionLogEntry(ctx, e); err != nil {
+			panic(err)
+		}
+	}
+	for _, c := range g.ActionLogCommitments {
+		if err := k.actionLogCommitments.Set(ctx, c.AgentId, c); err != nil {
+			panic(err)
+		}
+	}
+	for _, n := range g.ActionLogNodes {
+		if err := k.actionLogNodes.Set(ctx, collections.Join3(n.AgentId, n.Level, n.Index), n.Hash); err != nil {
 			panic(err)
 		}
 	}
@@ -26,11 +41,45 @@ func InitGenesis(ctx sdk.Context, k *Keeper, g types.GenesisState) {
 			panic(err)
 		}
 	}
+	for _, p := range g.RecipientPolicies {
+		if err := k.recipientPolicies.Set(ctx, p.AgentId, p.Policy); err != nil {
+			panic(err)
+		}
+	}
+	for _, u := range g.RecipientUsages {
+		if err := k.recipientUsage.Set(ctx, collections.Join(u.AgentId, u.Recipient), u); err != nil {
+			panic(err)
+		}
+	}
+	for _, w := range g.PendingWithdrawals {
+		if err := k.setPendingWithdrawal(ctx, w); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.nextWithdrawalID.Set(ctx, g.NextWithdrawalId); err != nil {
+		panic(err)
+	}
+	for _, st := range g.PaymentStreams {
+		if err := k.setPaymentStream(ctx, st); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.nextStreamID.Set(ctx, g.NextStreamId); err != nil {
+		panic(err)
+	}
 	for _, fp := range g.RevokedPolicies {
 		if err := k.revokedPolicies.Set(ctx, fp); err != nil {
 			panic(err)
 		}
 	}
+	for _, p := range g.RegisteredPolicies {
+		if err := k.registeredPolicies.Set(ctx, p.Id, p); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.replay.Import(ctx, g.ConsumedAttestations); err != nil {
+		panic(err)
+	}
 	// Reputation aggregates are rebuilt from the feedback set rather than
 	// imported, so they cannot drift from the records.
 	for _, f := range g.Feedbacks {
@@ -41,8 +90,7 @@ func InitGenesis(ctx sdk.Context, k *Keeper, g types.GenesisState) {
 		if !found {
 			rep = types.Reputation{AgentId: f.AgentId}
 		}
-		rep.Count++
-		rep.ScoreSum += uint64(f.Score)
+		rep.Add(f)
 		if err := k.reputation.Set(ctx, f.AgentId, rep); err != nil {
 			panic(err)
 		}
@@ -71,6 +119,23 @@ func ExportGenesis(ctx sdk.Context, k *Keeper) *types.GenesisState {
 	}); err != nil {
 		panic(err)
 	}
+	if err := k.actionLogCommitments.Walk(ctx, nil, func(_ string, c types.ActionLogCommitment) (stop bool, err error) {
+		g.ActionLogCommitments = append(g.ActionLogCommitments, c)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	if err := k.actionLogNodes.Walk(ctx, nil, func(key collections.Triple[string, uint32, uint64], hash []byte) (stop bool, err error) {
+		g.ActionLogNodes = append(g.ActionLogNodes, types.ActionLogNode{
+			AgentId: key.K1(),
+			Level:   key.K2(),
+			Index:   key.K3(),
+			Hash:    hash,
+		})
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
 
 	if err := k.escrows.Walk(ctx, nil, func(_ string, e types.AgentEscrow) (stop bool, err error) {
 		g.Escrows = append(g.Escrows, e)
@@ -79,12 +144,63 @@ func ExportGenesis(ctx sdk.Context, k *Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	if err := k.recipientPolicies.Walk(ctx, nil, func(agentID string, p types.RecipientPolicy) (stop bool, err error) {
+		g.RecipientPolicies = append(g.RecipientPolicies, types.GenesisRecipientPolicy{AgentId: agentID, Policy: p})
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	if err := k.recipientUsage.Walk(ctx, nil, func(_ collections.Pair[string, string], u types.RecipientUsage) (stop bool, err error) {
+		g.RecipientUsages = append(g.RecipientUsages, u)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	if err := k.pendingWithdrawals.Walk(ctx, nil, func(_ collections.Pair[string, uint64], w types.PendingWithdrawal) (stop bool, err error) {
+		g.PendingWithdrawals = append(g.PendingWithdrawals, w)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	nextWithdrawalID, err := k.nextWithdrawalID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	g.NextWithdrawalId = nextWithdrawalID
+
+	if err := k.paymentStreams.Walk(ctx, nil, func(_ collections.Pair[string, uint64], st types.PaymentStream) (stop bool, err error) {
+		g.PaymentStreams = append(g.PaymentStreams, st)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+	nextStreamID, err := k.nextStreamID.Peek(ctx)
+	if err != nil {
+		panic(err)
+	}
+	g.NextStreamId = nextStreamID
+
 	revoked, err := k.AllRevokedPolicies(ctx)
 	if err != nil {
 		panic(err)
 	}
 	g.RevokedPolicies = revoked
 
+	if err := k.registeredPolicies.Walk(ctx, nil, func(_ string, p types.RegisteredPolicy) (stop bool, err error) {
+		g.RegisteredPolicies = append(g.RegisteredPolicies, p)
+		return false, nil
+	}); err != nil {
+		panic(err)
+	}
+
+	consumed, err := k.replay.Export(ctx)
+	if err != nil {
+		panic(err)
+	}
+	g.ConsumedAttestations = consumed
+
 	// key order == (agent_id, client) order, so the export is deterministic
 	if err := k.feedback.Walk(ctx, nil, func(_ collections.Pair[string, string], f types.Feedback) (stop bool, err error) {
 		g.Feedbacks = append(g.Feedbacks, f)
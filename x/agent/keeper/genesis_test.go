@@ -31,17 +31,32 @@ func TestGenesis_EscrowAndWindowStateRoundTrip(t *testing.T) {
 			AgentId: "a1",
 			Balance: sdk.NewCoins(sdk.NewInt64Coin("adym", 600)),
 		}},
+		RecipientPolicies: []types.GenesisRecipientPolicy{{
+			AgentId: "a1",
+			Policy: types.RecipientPolicy{
+				PerRecipientCap: sdk.NewCoins(sdk.NewInt64Coin("adym", 300)),
+				CapWindowBlocks: 10,
+			},
+		}},
+		RecipientUsages: []types.RecipientUsage{{
+			AgentId:           "a1",
+			Recipient:         owner(t),
+			WindowStartHeight: 20,
+			Received:          sdk.NewCoins(sdk.NewInt64Coin("adym", 100)),
+		}},
 	}
 	keeper.InitGenesis(ctx, k, g)
 
 	out := keeper.ExportGenesis(ctx, k)
 	require.Equal(t, g.Agents, out.Agents)
 	require.Equal(t, g.Escrows, out.Escrows)
+	require.Equal(t, g.RecipientPolicies, out.RecipientPolicies)
+	require.Equal(t, g.RecipientUsages, out.RecipientUsages)
 
 	// the imported window state is live: 600 remaining in escrow, 600 left in
 	// the window budget at heights within the stored bucket
 	agent, found := k.GetAgent(ctx, "a1")
 	require.True(t, found)
-	require.Equal(t, math.NewInt(600), agent.RemainingWindowBudget(25))
+	require.Equal(t, math.NewInt(600), agent.RemainingWindowBudget(types.SpendClock{Height: 25}))
 	require.Equal(t, math.NewInt(600), k.GetEscrowBalance(ctx, "a1").AmountOf("adym"))
 }
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
@@ -31,7 +32,11 @@ func (k Keeper) Agent(goCtx context.Context, req *types.QueryAgentRequest) (*typ
 	}
 	// Fingerprint the effective policy so the reported revocation status
 	// matches what submit-time enforcement would apply right now.
-	fp, err := types.PolicyFingerprint(agent.EffectivePolicy(ctx.BlockHeight()))
+	policy, err := k.effectivePolicy(ctx, agent)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "effective policy")
+	}
+	fp, err := types.PolicyFingerprint(policy)
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "policy fingerprint")
 	}
@@ -39,7 +44,11 @@ func (k Keeper) Agent(goCtx context.Context, req *types.QueryAgentRequest) (*typ
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "is policy revoked")
 	}
-	return &types.QueryAgentResponse{Agent: agent, Fingerprint: fp, Revoked: revoked}, nil
+	coFps, err := agent.EffectivePolicySet(ctx.BlockHeight()).CoFingerprints()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "co-policy fingerprints")
+	}
+	return &types.QueryAgentResponse{Agent: agent, Fingerprint: fp, Revoked: revoked, CoFingerprints: coFps}, nil
 }
 
 func (k Keeper) Agents(goCtx context.Context, req *types.QueryAgentsRequest) (*types.QueryAgentsResponse, error) {
@@ -75,11 +84,41 @@ func (k Keeper) AgentAction(goCtx context.Context, req *types.QueryAgentActionRe
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	entry, found := k.GetActionLogEntry(ctx, req.AgentId, req.Seq)
 	if !found {
+		if req.Seq < k.GetActionLogCommitment(ctx, req.AgentId).PrunedBefore {
+			return nil, errorsmod.Wrapf(types.ErrActionNotFound, "agent %s seq %d is pruned", req.AgentId, req.Seq)
+		}
 		return nil, errorsmod.Wrapf(types.ErrActionNotFound, "agent %s seq %d", req.AgentId, req.Seq)
 	}
 	return &types.QueryAgentActionResponse{Action: entry}, nil
 }
 
+func (k Keeper) ActionLogCommitment(goCtx context.Context, req *types.QueryActionLogCommitmentRequest) (*types.QueryActionLogCommitmentResponse, error) {
+	if req.AgentId == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetAgent(ctx, req.AgentId); !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, req.AgentId)
+	}
+	return &types.QueryActionLogCommitmentResponse{Commitment: k.GetActionLogCommitment(ctx, req.AgentId)}, nil
+}
+
+func (k Keeper) ActionLogProof(goCtx context.Context, req *types.QueryActionLogProofRequest) (*types.QueryActionLogProofResponse, error) {
+	if req.AgentId == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	proof, root, err := k.actionLogProof(ctx, req.AgentId, req.Seq)
+	if err != nil {
+		return nil, err
+	}
+	resp := &types.QueryActionLogProofResponse{Proof: proof, Root: root}
+	if entry, found := k.GetActionLogEntry(ctx, req.AgentId, req.Seq); found {
+		resp.Action = &entry
+	}
+	return resp, nil
+}
+
 func (k Keeper) EscrowBalance(goCtx context.Context, req *types.QueryEscrowBalanceRequest) (*types.QueryEscrowBalanceResponse, error) {
 	if req.AgentId == "" {
 		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
@@ -89,12 +128,91 @@ func (k Keeper) EscrowBalance(goCtx context.Context, req *types.QueryEscrowBalan
 	if !found {
 		return nil, errorsmod.Wrap(types.ErrAgentNotFound, req.AgentId)
 	}
+	now := types.NewSpendClock(ctx)
+	budgets := agent.AllSpendBudgets()
+	remaining := make([]sdk.Coin, 0, len(budgets))
+	for _, b := range budgets {
+		remaining = append(remaining, sdk.NewCoin(b.Denom, b.Remaining(now)))
+	}
 	return &types.QueryEscrowBalanceResponse{
 		Balance:               k.GetEscrowBalance(ctx, req.AgentId),
-		RemainingWindowBudget: agent.RemainingWindowBudget(uint64(ctx.BlockHeight())), //nolint:gosec // block height is never negative
+		RemainingWindowBudget: agent.RemainingWindowBudget(now),
+		RemainingBudgets:      remaining,
 	}, nil
 }
 
+func (k Keeper) RecipientPolicy(goCtx context.Context, req *types.QueryRecipientPolicyRequest) (*types.QueryRecipientPolicyResponse, error) {
+	if req.AgentId == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetAgent(ctx, req.AgentId); !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, req.AgentId)
+	}
+	policy := k.GetRecipientPolicy(ctx, req.AgentId)
+	height := uint64(ctx.BlockHeight()) //nolint:gosec // block height is never negative
+	usages, pageResp, err := collcompat.CollectionPaginate(ctx, k.recipientUsage, req.Pagination,
+		func(_ collections.Pair[string, string], u types.RecipientUsage) (types.RecipientUsage, error) {
+			u.Received = u.CurrentReceived(policy, height)
+			return u, nil
+		},
+		collcompat.WithCollectionPaginationPairPrefix[string, string](req.AgentId))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRecipientPolicyResponse{Policy: policy, Usages: usages, Pagination: pageResp}, nil
+}
+
+func (k Keeper) PendingWithdrawals(goCtx context.Context, req *types.QueryPendingWithdrawalsRequest) (*types.QueryPendingWithdrawalsResponse, error) {
+	if req.AgentId == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetAgent(ctx, req.AgentId); !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, req.AgentId)
+	}
+	ws, pageResp, err := collcompat.CollectionPaginate(ctx, k.pendingWithdrawals, req.Pagination,
+		func(_ collections.Pair[string, uint64], w types.PendingWithdrawal) (types.PendingWithdrawal, error) {
+			return w, nil
+		},
+		collcompat.WithCollectionPaginationPairPrefix[string, uint64](req.AgentId))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingWithdrawalsResponse{Withdrawals: ws, Pagination: pageResp}, nil
+}
+
+func (k Keeper) PaymentStreams(goCtx context.Context, req *types.QueryPaymentStreamsRequest) (*types.QueryPaymentStreamsResponse, error) {
+	if req.AgentId == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetAgent(ctx, req.AgentId); !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, req.AgentId)
+	}
+	ss, pageResp, err := collcompat.CollectionPaginate(ctx, k.paymentStreams, req.Pagination,
+		func(_ collections.Pair[string, uint64], s types.PaymentStream) (types.PaymentStream, error) {
+			return s, nil
+		},
+		collcompat.WithCollectionPaginationPairPrefix[string, uint64](req.AgentId))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPaymentStreamsResponse{Streams: ss, Pagination: pageResp}, nil
+}
+
+func (k Keeper) PaymentStream(goCtx context.Context, req *types.QueryPaymentStreamRequest) (*types.QueryPaymentStreamResponse, error) {
+	if req.AgentId == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	s, found := k.GetPaymentStream(ctx, req.AgentId, req.StreamId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPaymentStreamNotFound, "agent %s id %d", req.AgentId, req.StreamId)
+	}
+	return &types.QueryPaymentStreamResponse{Stream: s}, nil
+}
+
 func (k Keeper) RevokedPolicies(goCtx context.Context, _ *types.QueryRevokedPoliciesRequest) (*types.QueryRevokedPoliciesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	fps, err := k.AllRevokedPolicies(ctx)
@@ -123,11 +241,24 @@ func (k Keeper) AgentReputation(goCtx context.Context, req *types.QueryAgentRepu
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	rep, found := k.GetReputation(ctx, req.AgentId)
 	if !found {
-		return &types.QueryAgentReputationResponse{}, nil
+		return &types.QueryAgentReputationResponse{
+			WeightedScore: math.LegacyZeroDec(),
+			TotalWeight:   math.LegacyZeroDec(),
+		}, nil
 	}
 	// average fits uint32: every score is <= MaxFeedbackScore, so the mean is too.
 	avg := uint32(rep.ScoreSum / rep.Count) //nolint:gosec
-	return &types.QueryAgentReputationResponse{Reputation: rep, AverageScore: avg}, nil
+	weighted, total, err := k.WeightedReputation(ctx, req.AgentId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "weighted reputation")
+	}
+	return &types.QueryAgentReputationResponse{
+		Reputation:           rep,
+		AverageScore:         avg,
+		WeightedScore:        weighted,
+		TotalWeight:          total,
+		VerifiedAverageScore: rep.VerifiedAverageScore(),
+	}, nil
 }
 
 func (k Keeper) AgentFeedback(goCtx context.Context, req *types.QueryAgentFeedbackRequest) (*types.QueryAgentFeedbackResponse, error) {
@@ -160,3 +291,81 @@ func (k Keeper) AgentFeedbacks(goCtx context.Context, req *types.QueryAgentFeedb
 	}
 	return &types.QueryAgentFeedbacksResponse{Feedbacks: feedbacks, Pagination: pageResp}, nil
 }
+
+func (k Keeper) SubAgents(goCtx context.Context, req *types.QuerySubAgentsRequest) (*types.QuerySubAgentsResponse, error) {
+	if req.AgentId == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetAgent(ctx, req.AgentId); !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, req.AgentId)
+	}
+	agents, pageResp, err := collcompat.CollectionPaginate(ctx, k.subAgents, req.Pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.Agent, error) {
+			child, found := k.GetAgent(ctx, key.K2())
+			if !found {
+				return types.Agent{}, gerrc.ErrInternal.Wrapf("indexed sub-agent %s of %s not found", key.K2(), key.K1())
+			}
+			return child, nil
+		},
+		collcompat.WithCollectionPaginationPairPrefix[string, string](req.AgentId))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySubAgentsResponse{Agents: agents, Pagination: pageResp}, nil
+}
+
+// AggregateSpend walks the agent's subtree, which MaxSubAgentDepth and
+// MaxSubAgents bound.
+func (k Keeper) AggregateSpend(goCtx context.Context, req *types.QueryAggregateSpendRequest) (*types.QueryAggregateSpendResponse, error) {
+	if req.AgentId == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	agent, found := k.GetAgent(ctx, req.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, req.AgentId)
+	}
+
+	res := &types.QueryAggregateSpendResponse{Spent: agent.TotalSpent}
+	queue := []types.Agent{agent}
+	for len(queue) > 0 {
+		a := queue[0]
+		queue = queue[1:]
+		res.TotalSpent = res.TotalSpent.Add(a.TotalSpent...)
+		res.TotalEscrow = res.TotalEscrow.Add(k.GetEscrowBalance(ctx, a.Id)...)
+		children, err := k.getSubAgents(ctx, a.Id)
+		if err != nil {
+			return nil, err
+		}
+		res.Descendants += uint64(len(children))
+		queue = append(queue, children...)
+	}
+	return res, nil
+}
+
+func (k Keeper) RegisteredPolicy(goCtx context.Context, req *types.QueryRegisteredPolicyRequest) (*types.QueryRegisteredPolicyResponse, error) {
+	if req.Id == "" {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty id")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	p, found := k.GetRegisteredPolicy(ctx, req.Id)
+	if !found {
+		return nil, gerrc.ErrNotFound.Wrapf("registered policy: %s", req.Id)
+	}
+	head, err := k.registeredHead(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRegisteredPolicyResponse{Policy: p, Head: head}, nil
+}
+
+func (k Keeper) RegisteredPolicies(goCtx context.Context, req *types.QueryRegisteredPoliciesRequest) (*types.QueryRegisteredPoliciesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	policies, pageResp, err := collcompat.CollectionPaginate(ctx, k.registeredPolicies, req.Pagination,
+		func(_ string, p types.RegisteredPolicy) (types.RegisteredPolicy, error) { return p, nil })
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRegisteredPoliciesResponse{Policies: policies, Pagination: pageResp}, nil
+}
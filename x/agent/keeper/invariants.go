@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -24,8 +25,8 @@ func AllInvariants(k Keeper) sdk.Invariant {
 }
 
 // InvariantEscrowSolvency checks that the agent module account holds at least
-// the sum of all per-agent escrow ledger balances, and that no ledger entry is
-// invalid or empty (runtime removes zero balances).
+// the sum of all per-agent escrow ledger balances and pending withdrawals, and
+// that no ledger entry is invalid or empty (runtime removes zero balances).
 func InvariantEscrowSolvency(k Keeper) uinv.Func {
 	return uinv.AnyErrorIsBreaking(func(ctx sdk.Context) error {
 		total := sdk.NewCoins()
@@ -47,6 +48,14 @@ func InvariantEscrowSolvency(k Keeper) uinv.Func {
 		if len(errs) > 0 {
 			return fmt.Errorf("escrow ledger: %v", errs)
 		}
+		// pending withdrawals are debited from the ledger but still held by the
+		// module account until paid out
+		if err := k.pendingWithdrawals.Walk(ctx, nil, func(_ collections.Pair[string, uint64], w types.PendingWithdrawal) (stop bool, err error) {
+			total = total.Add(w.Amount...)
+			return false, nil
+		}); err != nil {
+			return err
+		}
 
 		moduleBalance := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(types.ModuleName))
 		if !total.IsAllLTE(moduleBalance) {
@@ -2,8 +2,10 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	collcodec "cosmossdk.io/collections/codec"
 	"cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -19,18 +21,51 @@ type Keeper struct {
 
 	// verifier is injected so the attestation seam can be faked in tests; the
 	// real verifier does GCP PKI + rego evaluation.
-	verifier   tee.Verifier
-	bankKeeper types.BankKeeper
+	verifier      tee.Verifier
+	bankKeeper    types.BankKeeper
+	dymnsKeeper   types.DymNSKeeper
+	stakingKeeper types.StakingKeeper
+	lockupKeeper  types.LockupKeeper
 
-	params          collections.Item[types.Params]
-	agents          collections.Map[string, types.Agent]
-	actionLog       collections.Map[collections.Pair[string, uint64], types.ActionLogEntry]
-	revokedPolicies collections.KeySet[string]
-	feedback        collections.Map[collections.Pair[string, string], types.Feedback]
-	reputation      collections.Map[string, types.Reputation]
+	params    collections.Item[types.Params]
+	agents    collections.Map[string, types.Agent]
+	actionLog collections.Map[collections.Pair[string, uint64], types.ActionLogEntry]
+	// actionLogCommitments and actionLogNodes hold each agent's action log
+	// accumulator, which outlives pruned entries; actionLogQueue indexes
+	// retained entries by height for block-based pruning.
+	actionLogCommitments collections.Map[string, types.ActionLogCommitment]
+	actionLogNodes       collections.Map[collections.Triple[string, uint32, uint64], []byte]
+	actionLogQueue       collections.KeySet[collections.Triple[int64, string, uint64]]
+	revokedPolicies      collections.KeySet[string]
+	feedback             collections.Map[collections.Pair[string, string], types.Feedback]
+	reputation           collections.Map[string, types.Reputation]
 	// escrows tracks per-agent balances of the pooled funds held in the agent
 	// module account.
 	escrows collections.Map[string, types.AgentEscrow]
+	// recipientPolicies holds the owner-set recipient restrictions; agents
+	// without an entry may pay anyone.
+	recipientPolicies collections.Map[string, types.RecipientPolicy]
+	recipientUsage    collections.Map[collections.Pair[string, string], types.RecipientUsage]
+	// pendingWithdrawals are timelocked withdrawals by (agent, id);
+	// withdrawalQueue indexes them by mature height for the end blocker.
+	pendingWithdrawals collections.Map[collections.Pair[string, uint64], types.PendingWithdrawal]
+	withdrawalQueue    collections.KeySet[collections.Triple[int64, string, uint64]]
+	nextWithdrawalID   collections.Sequence
+	// paymentStreams are owner-approved recurring payouts by (agent, id);
+	// streamQueue indexes them by next payment time for the end blocker, and
+	// streamQueueCursor is where the end blocker resumes when it stopped at
+	// its limit.
+	paymentStreams    collections.Map[collections.Pair[string, uint64], types.PaymentStream]
+	streamQueue       collections.KeySet[collections.Triple[time.Time, string, uint64]]
+	streamQueueCursor collections.Item[collections.Triple[time.Time, string, uint64]]
+	nextStreamID      collections.Sequence
+	// subAgents indexes sub-agents by (parent, child). It is derived from the
+	// agents' parent ids and rebuilt on genesis import.
+	subAgents collections.KeySet[collections.Pair[string, string]]
+	// registeredPolicies is the shared policy registry by fingerprint.
+	registeredPolicies collections.Map[string, types.RegisteredPolicy]
+	// replay remembers consumed attestation tokens until they expire.
+	replay tee.ReplayGuard
 }
 
 func NewKeeper(
@@ -38,6 +73,9 @@ func NewKeeper(
 	service store.KVStoreService,
 	verifier tee.Verifier,
 	bankKeeper types.BankKeeper,
+	dymnsKeeper types.DymNSKeeper,
+	stakingKeeper types.StakingKeeper,
+	lockupKeeper types.LockupKeeper,
 	authority string,
 ) *Keeper {
 	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
@@ -47,9 +85,12 @@ func NewKeeper(
 	sb := collections.NewSchemaBuilder(service)
 
 	k := &Keeper{
-		authority:  authority,
-		verifier:   verifier,
-		bankKeeper: bankKeeper,
+		authority:     authority,
+		verifier:      verifier,
+		bankKeeper:    bankKeeper,
+		dymnsKeeper:   dymnsKeeper,
+		stakingKeeper: stakingKeeper,
+		lockupKeeper:  lockupKeeper,
 		params: collections.NewItem(sb, collections.NewPrefix(types.KeyParams),
 			"params", collcompat.ProtoValue[types.Params](cdc)),
 		agents: collections.NewMap(sb, collections.NewPrefix(types.KeyAgents),
@@ -57,8 +98,40 @@ func NewKeeper(
 		actionLog: collections.NewMap(sb, collections.NewPrefix(types.KeyActionLog),
 			"action_log", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
 			collcompat.ProtoValue[types.ActionLogEntry](cdc)),
+		actionLogCommitments: collections.NewMap(sb, collections.NewPrefix(types.KeyActionLogCommitments),
+			"action_log_commitments", collections.StringKey, collcompat.ProtoValue[types.ActionLogCommitment](cdc)),
+		actionLogNodes: collections.NewMap(sb, collections.NewPrefix(types.KeyActionLogNodes),
+			"action_log_nodes", collections.TripleKeyCodec(collections.StringKey, collections.Uint32Key, collections.Uint64Key),
+			collections.BytesValue),
+		actionLogQueue: collections.NewKeySet(sb, collections.NewPrefix(types.KeyActionLogQueue),
+			"action_log_queue", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.Uint64Key)),
 		escrows: collections.NewMap(sb, collections.NewPrefix(types.KeyAgentEscrow),
 			"escrows", collections.StringKey, collcompat.ProtoValue[types.AgentEscrow](cdc)),
+		recipientPolicies: collections.NewMap(sb, collections.NewPrefix(types.KeyRecipientPolicy),
+			"recipient_policies", collections.StringKey, collcompat.ProtoValue[types.RecipientPolicy](cdc)),
+		recipientUsage: collections.NewMap(sb, collections.NewPrefix(types.KeyRecipientUsage),
+			"recipient_usage", collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			collcompat.ProtoValue[types.RecipientUsage](cdc)),
+		pendingWithdrawals: collections.NewMap(sb, collections.NewPrefix(types.KeyPendingWithdrawals),
+			"pending_withdrawals", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collcompat.ProtoValue[types.PendingWithdrawal](cdc)),
+		withdrawalQueue: collections.NewKeySet(sb, collections.NewPrefix(types.KeyWithdrawalQueue),
+			"withdrawal_queue", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.Uint64Key)),
+		nextWithdrawalID: collections.NewSequence(sb, collections.NewPrefix(types.KeyNextWithdrawalID), "next_withdrawal_id"),
+		paymentStreams: collections.NewMap(sb, collections.NewPrefix(types.KeyPaymentStreams),
+			"payment_streams", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collcompat.ProtoValue[types.PaymentStream](cdc)),
+		streamQueue: collections.NewKeySet(sb, collections.NewPrefix(types.KeyStreamQueue),
+			"stream_queue", collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.Uint64Key)),
+		streamQueueCursor: collections.NewItem(sb, collections.NewPrefix(types.KeyStreamQueueCursor),
+			"stream_queue_cursor", collcodec.KeyToValueCodec(collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.Uint64Key))),
+		nextStreamID: collections.NewSequence(sb, collections.NewPrefix(types.KeyNextStreamID), "next_stream_id"),
+		subAgents: collections.NewKeySet(sb, collections.NewPrefix(types.KeySubAgents),
+			"sub_agents", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		registeredPolicies: collections.NewMap(sb, collections.NewPrefix(types.KeyRegisteredPolicies),
+			"registered_policies", collections.StringKey, collcompat.ProtoValue[types.RegisteredPolicy](cdc)),
+		replay: tee.NewReplayGuard(sb, collections.NewPrefix(types.KeyConsumedAttestations),
+			collections.NewPrefix(types.KeyConsumedAttestationsByExpiry)),
 		revokedPolicies: collections.NewKeySet(sb, collections.NewPrefix(types.KeyRevokedPolicies),
 			"revoked_policies", collections.StringKey),
 		feedback: collections.NewMap(sb, collections.NewPrefix(types.KeyFeedback),
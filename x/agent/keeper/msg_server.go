@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,7 @@ import (
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 )
 
 type msgServer struct {
@@ -24,8 +26,9 @@ func NewMsgServerImpl(k Keeper) types.MsgServer {
 var _ types.MsgServer = msgServer{}
 
 // loadAttestingAgent returns the active agent with any matured pending policy
-// promoted, so verification uses the effective policy; the caller's final
-// SetAgent persists the promotion in the same write.
+// promoted, so verification uses the effective policy, and the rotation onto
+// a new head of its bound lineage scheduled; the caller's final SetAgent
+// persists both in the same write.
 func (k msgServer) loadAttestingAgent(ctx sdk.Context, agentID string) (types.Agent, error) {
 	agent, found := k.GetAgent(ctx, agentID)
 	if !found {
@@ -34,7 +37,13 @@ func (k msgServer) loadAttestingAgent(ctx sdk.Context, agentID string) (types.Ag
 	if !agent.Active {
 		return types.Agent{}, gerrc.ErrFailedPrecondition.Wrapf("agent is not active: %s", agentID)
 	}
+	if err := k.notFrozen(ctx, agent); err != nil {
+		return types.Agent{}, err
+	}
 	agent.PromotePendingPolicy(ctx.BlockHeight())
+	if err := k.syncBoundPolicy(ctx, &agent); err != nil {
+		return types.Agent{}, err
+	}
 	// The denylist applies to every attested operation, including transfers.
 	if _, err := k.fingerprintNotRevoked(ctx, agent.Policy); err != nil {
 		return types.Agent{}, err
@@ -42,11 +51,56 @@ func (k msgServer) loadAttestingAgent(ctx sdk.Context, agentID string) (types.Ag
 	return agent, nil
 }
 
+// verifyAttestations verifies token against the agent's primary policy and
+// each co-attestation against the co-policy it names, all over the same
+// nonce, and checks that the distinct attesting policies reach required.
+// Every attestation submitted must verify, even beyond the quorum. The caller
+// consumes the returned attestations once its own checks pass.
+func (k msgServer) verifyAttestations(ctx sdk.Context, agent types.Agent, nonce, token string, coAttestations []types.CoAttestation, required uint32) ([]tee.Attestation, error) {
+	att, err := k.verifier.Verify(ctx, agent.Policy, nonce, token)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "verify attestation")
+	}
+	verified := []tee.Attestation{att}
+	for _, co := range coAttestations {
+		policy, ok := agent.CoPolicy(co.PolicyIndex)
+		if !ok {
+			return nil, gerrc.ErrInvalidArgument.Wrapf("no co-policy at index %d", co.PolicyIndex)
+		}
+		if _, err := k.fingerprintNotRevoked(ctx, policy); err != nil {
+			return nil, errorsmod.Wrapf(err, "co-policy %d", co.PolicyIndex)
+		}
+		att, err := k.verifier.Verify(ctx, policy, nonce, co.Token)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "verify co-attestation %d", co.PolicyIndex)
+		}
+		verified = append(verified, att)
+	}
+	// indices are distinct (ValidateBasic), so each attestation is a
+	// distinct policy
+	if attested := uint32(len(coAttestations)) + 1; attested < required { //nolint:gosec // bounded by MaxCoPolicies
+		return nil, errorsmod.Wrapf(types.ErrQuorumNotMet, "%d of %d policies attested", attested, required)
+	}
+	return verified, nil
+}
+
+// consumeAttestations rejects any token older than attestation_max_age or
+// already consumed, including twice in verified, and records the rest so
+// they can never be submitted again.
+func (k msgServer) consumeAttestations(ctx sdk.Context, verified []tee.Attestation, maxAge time.Duration) error {
+	for _, att := range verified {
+		if err := k.replay.Consume(ctx, att, maxAge); err != nil {
+			return errorsmod.Wrap(err, "consume attestation")
+		}
+	}
+	return nil
+}
+
 // appendAttested writes the action log entry for payload at the agent's
 // current seq, advances the seq and persists the agent. Shared by attested
 // actions and attested transfers so both feed the same auditable, monotonic
-// log.
-func (k msgServer) appendAttested(ctx sdk.Context, agent *types.Agent, payload, payloadHash []byte) error {
+// log. recipient is empty for actions.
+func (k msgServer) appendAttested(ctx sdk.Context, agent *types.Agent, payload, payloadHash []byte, submitter, recipient string) error {
 	entry := types.ActionLogEntry{
 		AgentId:     agent.Id,
 		Seq:         agent.ActionSeq,
@@ -54,8 +108,10 @@ func (k msgServer) appendAttested(ctx sdk.Context, agent *types.Agent, payload,
 		PayloadHash: payloadHash,
 		Height:      ctx.BlockHeight(),
 		Time:        ctx.BlockTime(),
+		Submitter:   submitter,
+		Recipient:   recipient,
 	}
-	if err := k.setActionLogEntry(ctx, entry); err != nil {
+	if err := k.appendActionLogEntry(ctx, entry); err != nil {
 		return errorsmod.Wrap(err, "append action log entry")
 	}
 
@@ -95,12 +151,18 @@ func (k msgServer) SubmitAttestedAction(goCtx context.Context, msg *types.MsgSub
 	// On any failure the tx rolls back, so no state changes. Replay protection
 	// is structural: action_seq below advances, so re-submitting the same
 	// (payload, token) re-derives a different nonce and the verifier rejects.
-	if err := k.verifier.Verify(ctx, agent.Policy, nonce, msg.Token); err != nil {
-		return nil, errorsmod.Wrap(err, "verify attestation")
+	// Consumed tokens are also remembered until they are too old to verify.
+	required := agent.PolicySet.Quorum.RequiredAttestations(nil)
+	verified, err := k.verifyAttestations(ctx, agent, nonce, msg.Token, msg.CoAttestations, required)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.consumeAttestations(ctx, verified, params.AttestationMaxAge); err != nil {
+		return nil, err
 	}
 
 	payloadHash := sha256.Sum256(msg.Payload)
-	if err := k.appendAttested(ctx, &agent, msg.Payload, payloadHash[:]); err != nil {
+	if err := k.appendAttested(ctx, &agent, msg.Payload, payloadHash[:], msg.Submitter, ""); err != nil {
 		return nil, err
 	}
 
@@ -119,9 +181,10 @@ func (k msgServer) SubmitAttestedAction(goCtx context.Context, msg *types.MsgSub
 
 // SubmitAttestedTransfer pays out from the agent's escrow to a recipient,
 // authorized by the same TEE attestation + nonce machinery as
-// SubmitAttestedAction. The nonce commits to the exact (recipient, denom,
-// amount, memo) in the transfer domain, so the enclave — not the submitter —
-// authorizes the payment.
+// SubmitAttestedAction and bounded by the spend budget and recipient policy.
+// The nonce commits to the exact (recipient, denom, amount, memo) in the
+// transfer domain, so the enclave — not the submitter — authorizes the
+// payment.
 func (k msgServer) SubmitAttestedTransfer(goCtx context.Context, msg *types.MsgSubmitAttestedTransfer) (*types.MsgSubmitAttestedTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -138,12 +201,17 @@ func (k msgServer) SubmitAttestedTransfer(goCtx context.Context, msg *types.MsgS
 	if err != nil {
 		return nil, err
 	}
-	if !agent.SpendEnabled() {
-		return nil, errorsmod.Wrap(types.ErrSpendingDisabled, msg.AgentId)
+	denom := msg.Denom
+	if denom == "" {
+		denom = agent.SpendDenom
+	}
+	budget, ok := agent.SpendBudgetFor(denom)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrSpendingDisabled, "agent %s denom %s", msg.AgentId, denom)
 	}
 
 	seq := agent.ActionSeq
-	payload := types.AttestedTransferBytes(msg.Recipient, agent.SpendDenom, msg.Amount, msg.Memo)
+	payload := types.AttestedTransferBytes(msg.Recipient, denom, msg.Amount, msg.Memo)
 	// bound the stored log entry, not just the memo: the payload is what the
 	// log persists
 	if uint64(len(payload)) > params.MaxActionBytes {
@@ -154,31 +222,20 @@ func (k msgServer) SubmitAttestedTransfer(goCtx context.Context, msg *types.MsgS
 	// On any failure the tx rolls back, so no state changes. Replay protection
 	// is structural, as in SubmitAttestedAction: action_seq below advances, so
 	// re-submitting the same transfer re-derives a different nonce.
-	if err := k.verifier.Verify(ctx, agent.Policy, nonce, msg.Token); err != nil {
-		return nil, errorsmod.Wrap(err, "verify attestation")
-	}
-
-	height := uint64(ctx.BlockHeight()) //nolint:gosec // block height is never negative
-	if !agent.SpendAllows(height, msg.Amount) {
-		return nil, errorsmod.Wrapf(types.ErrSpendBudgetExceeded, "amount %s, remaining %s", msg.Amount, agent.RemainingWindowBudget(height))
+	required := agent.PolicySet.Quorum.RequiredAttestations(&sdk.Coin{Denom: denom, Amount: msg.Amount})
+	verified, err := k.verifyAttestations(ctx, agent, nonce, msg.Token, msg.CoAttestations, required)
+	if err != nil {
+		return nil, err
 	}
 
-	payout := sdk.NewCoins(sdk.NewCoin(agent.SpendDenom, msg.Amount))
-	balance, negative := k.GetEscrowBalance(ctx, msg.AgentId).SafeSub(payout...)
-	if negative {
-		return nil, errorsmod.Wrap(types.ErrInsufficientEscrow, payout.String())
-	}
-	if err := k.setEscrowBalance(ctx, msg.AgentId, balance); err != nil {
-		return nil, errorsmod.Wrap(err, "set escrow balance")
+	if err := k.spendFromEscrow(ctx, &agent, budget, msg.Recipient, msg.Amount); err != nil {
+		return nil, err
 	}
-	recipient := sdk.MustAccAddressFromBech32(msg.Recipient)
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, payout); err != nil {
-		return nil, errorsmod.Wrap(err, "send coins to recipient")
+	if err := k.consumeAttestations(ctx, verified, params.AttestationMaxAge); err != nil {
+		return nil, err
 	}
-
-	agent.RecordSpend(height, msg.Amount)
 	payloadHash := sha256.Sum256(payload)
-	if err := k.appendAttested(ctx, &agent, payload, payloadHash[:]); err != nil {
+	if err := k.appendAttested(ctx, &agent, payload, payloadHash[:], msg.Submitter, msg.Recipient); err != nil {
 		return nil, err
 	}
 
@@ -186,7 +243,7 @@ func (k msgServer) SubmitAttestedTransfer(goCtx context.Context, msg *types.MsgS
 		AgentId:   msg.AgentId,
 		Seq:       seq,
 		Recipient: msg.Recipient,
-		Amount:    sdk.NewCoin(agent.SpendDenom, msg.Amount),
+		Amount:    sdk.NewCoin(denom, msg.Amount),
 		Submitter: msg.Submitter,
 	}); err != nil {
 		return nil, err
@@ -11,6 +11,20 @@ import (
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
 )
 
+// checkInteraction checks that the agent's action log entry at seq is an
+// interaction with client: an action or transfer it submitted, or a transfer
+// that paid it.
+func (k msgServer) checkInteraction(ctx sdk.Context, agentID string, seq uint64, client string) error {
+	entry, found := k.GetActionLogEntry(ctx, agentID, seq)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidEvidence, "no action log entry: agent %s seq %d", agentID, seq)
+	}
+	if entry.Submitter != client && entry.Recipient != client {
+		return errorsmod.Wrapf(types.ErrInvalidEvidence, "action log entry %d is not an interaction with %s", seq, client)
+	}
+	return nil
+}
+
 func (k msgServer) SubmitFeedback(goCtx context.Context, msg *types.MsgSubmitFeedback) (*types.MsgSubmitFeedbackResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -39,6 +53,11 @@ func (k msgServer) SubmitFeedback(goCtx context.Context, msg *types.MsgSubmitFee
 	if msg.EvidenceSeq >= agent.ActionSeq {
 		return nil, errorsmod.Wrapf(types.ErrInvalidEvidence, "evidence seq %d, agent action seq %d", msg.EvidenceSeq, agent.ActionSeq)
 	}
+	if msg.Verified {
+		if err := k.checkInteraction(ctx, msg.AgentId, msg.EvidenceSeq, msg.Client); err != nil {
+			return nil, err
+		}
+	}
 
 	// charge the anti-spam fee: send to module then burn (mirrors agent registration)
 	if !params.FeedbackFee.IsNil() && !params.FeedbackFee.IsZero() {
@@ -52,15 +71,17 @@ func (k msgServer) SubmitFeedback(goCtx context.Context, msg *types.MsgSubmitFee
 		}
 	}
 
+	weight, err := k.stakeWeight(ctx, sdk.MustAccAddressFromBech32(msg.Client))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "stake weight")
+	}
+
 	rep, found := k.GetReputation(ctx, msg.AgentId)
 	if !found {
 		rep = types.Reputation{AgentId: msg.AgentId}
 	}
 	if old, hadOld := k.GetFeedback(ctx, msg.AgentId, msg.Client); hadOld {
-		rep.ScoreSum = rep.ScoreSum - uint64(old.Score) + uint64(msg.Score)
-	} else {
-		rep.Count++
-		rep.ScoreSum += uint64(msg.Score)
+		rep.Remove(old)
 	}
 
 	fb := types.Feedback{
@@ -72,7 +93,10 @@ func (k msgServer) SubmitFeedback(goCtx context.Context, msg *types.MsgSubmitFee
 		EvidenceSeq: msg.EvidenceSeq,
 		Height:      ctx.BlockHeight(),
 		Time:        ctx.BlockTime(),
+		Weight:      weight,
+		Verified:    msg.Verified,
 	}
+	rep.Add(fb)
 	if err := k.SetFeedback(ctx, fb); err != nil {
 		return nil, errorsmod.Wrap(err, "set feedback")
 	}
@@ -81,9 +105,11 @@ func (k msgServer) SubmitFeedback(goCtx context.Context, msg *types.MsgSubmitFee
 	}
 
 	if err := uevent.EmitTypedEvent(ctx, &types.EventSubmitFeedback{
-		AgentId: msg.AgentId,
-		Client:  msg.Client,
-		Score:   msg.Score,
+		AgentId:  msg.AgentId,
+		Client:   msg.Client,
+		Score:    msg.Score,
+		Weight:   weight,
+		Verified: msg.Verified,
 	}); err != nil {
 		return nil, err
 	}
@@ -109,8 +135,7 @@ func (k msgServer) RevokeFeedback(goCtx context.Context, msg *types.MsgRevokeFee
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "get reputation")
 	}
-	rep.Count--
-	rep.ScoreSum -= uint64(old.Score)
+	rep.Remove(old)
 	if rep.Count == 0 {
 		if err := k.reputation.Remove(ctx, msg.AgentId); err != nil {
 			return nil, errorsmod.Wrap(err, "remove reputation")
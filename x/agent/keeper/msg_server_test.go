@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -9,9 +10,12 @@ import (
 	"encoding/pem"
 	"errors"
 	"math/big"
+	"strings"
 	"testing"
+	"time"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
 	storetypes "cosmossdk.io/store/types"
@@ -36,27 +40,57 @@ var govAuthority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
 
 // fakeVerifier stands in for the real GCP verifier: a valid GCP-signed token
 // can't be produced locally, so the fake asserts the nonce the handler derived
-// matches the nonce embedded in the (fake) token. token == expected nonce means
+// matches the nonce embedded in the (fake) token. token == expected nonce, or
+// nonce#anything for further distinct tokens over the same nonce, means
 // accept; anything else rejects, exactly as the real verifier rejects a token
-// whose nonce claim doesn't match.
+// whose nonce claim doesn't match. Tokens are issued at issuedAt, or at block
+// time if unset.
 type fakeVerifier struct {
 	gotNonce  string
 	gotPolicy tee.Policy
 	calls     int
+	issuedAt  time.Time
 }
 
-func (f *fakeVerifier) Verify(_ sdk.Context, policy tee.Policy, nonce, token string) error {
+func (f *fakeVerifier) Verify(_ sdk.Context, policy tee.Policy, nonce, token string) (tee.Attestation, error) {
 	f.calls++
 	f.gotNonce = nonce
 	f.gotPolicy = policy
-	if token != nonce {
-		return errors.New("nonce mismatch")
+	if claimed, _, _ := strings.Cut(token, "#"); claimed != nonce {
+		return tee.Attestation{}, errors.New("nonce mismatch")
 	}
-	return nil
+	return tee.Attestation{IssuedAt: f.issuedAt, Digest: tee.SignedDigest([]byte(token))}, nil
+}
+
+// fakeStake reports bonded stake per address and no locks, standing in for
+// the staking and lockup keepers.
+type fakeStake struct {
+	bonded map[string]math.Int
+}
+
+func (f *fakeStake) BondDenom(context.Context) (string, error) {
+	return "adym", nil
+}
+
+func (f *fakeStake) GetDelegatorBonded(_ context.Context, delegator sdk.AccAddress) (math.Int, error) {
+	if b, ok := f.bonded[delegator.String()]; ok {
+		return b, nil
+	}
+	return math.ZeroInt(), nil
+}
+
+func (f *fakeStake) GetAccountLockedCoins(sdk.Context, sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
 }
 
 func setup(t *testing.T) (sdk.Context, *keeper.Keeper, *fakeVerifier) {
 	t.Helper()
+	return setupWithStake(t, &fakeStake{})
+}
+
+// setupWithStake is setup with the clients' stake weights supplied by stake.
+func setupWithStake(t *testing.T, stake *fakeStake) (sdk.Context, *keeper.Keeper, *fakeVerifier) {
+	t.Helper()
 
 	key := storetypes.NewKVStoreKey(types.StoreKey)
 	db := dbm.NewMemDB()
@@ -70,14 +104,17 @@ func setup(t *testing.T) (sdk.Context, *keeper.Keeper, *fakeVerifier) {
 	v := &fakeVerifier{}
 	// bankKeeper is nil: SubmitAttestedAction never charges fees. Registration
 	// fee burning is covered by the apptesting-based suite in registry_test.go.
-	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), v, nil, govAuthority)
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), v, nil, nil, stake, stake, govAuthority)
 
 	ctx := sdk.NewContext(cms, cmtproto.Header{Height: 10}, false, log.NewNopLogger())
-	require.NoError(t, k.SetParams(ctx, types.Params{MaxActionBytes: 1024, PolicyRotationDelayBlocks: rotationDelay}))
+	require.NoError(t, k.SetParams(ctx, types.Params{MaxActionBytes: 1024, PolicyRotationDelayBlocks: rotationDelay, AttestationMaxAge: attestationMaxAge}))
 	return ctx, k, v
 }
 
-const rotationDelay = 100
+const (
+	rotationDelay     = 100
+	attestationMaxAge = 10 * time.Minute
+)
 
 // validCertPEM generates a parseable self-signed cert so validatePolicy passes.
 func validCertPEM(t *testing.T) string {
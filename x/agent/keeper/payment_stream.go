@@ -0,0 +1,328 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+const (
+	cancelReasonGuardian   = "cancelled by guardian"
+	cancelReasonGovernance = "cancelled by governance"
+	closeReasonCompleted   = "completed"
+)
+
+// GetPaymentStream returns the payment stream and whether it was found.
+func (k Keeper) GetPaymentStream(ctx sdk.Context, agentID string, id uint64) (types.PaymentStream, bool) {
+	s, err := k.paymentStreams.Get(ctx, collections.Join(agentID, id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PaymentStream{}, false
+		}
+		panic(err)
+	}
+	return s, true
+}
+
+func (k Keeper) setPaymentStream(ctx sdk.Context, s types.PaymentStream) error {
+	if err := k.paymentStreams.Set(ctx, collections.Join(s.AgentId, s.Id), s); err != nil {
+		return err
+	}
+	return k.streamQueue.Set(ctx, collections.Join3(s.NextPaymentTime, s.AgentId, s.Id))
+}
+
+func (k Keeper) removePaymentStream(ctx sdk.Context, s types.PaymentStream) error {
+	if err := k.paymentStreams.Remove(ctx, collections.Join(s.AgentId, s.Id)); err != nil {
+		return err
+	}
+	return k.streamQueue.Remove(ctx, collections.Join3(s.NextPaymentTime, s.AgentId, s.Id))
+}
+
+func (k Keeper) closePaymentStream(ctx sdk.Context, s types.PaymentStream, reason string) error {
+	if err := k.removePaymentStream(ctx, s); err != nil {
+		return errorsmod.Wrap(err, "remove payment stream")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventClosePaymentStream{
+		Stream: s,
+		Reason: reason,
+	})
+}
+
+// AgentPaymentStreams returns the agent's payment streams in id order.
+func (k Keeper) AgentPaymentStreams(ctx sdk.Context, agentID string) ([]types.PaymentStream, error) {
+	var ss []types.PaymentStream
+	rng := collections.NewPrefixedPairRange[string, uint64](agentID)
+	err := k.paymentStreams.Walk(ctx, rng, func(_ collections.Pair[string, uint64], s types.PaymentStream) (stop bool, err error) {
+		ss = append(ss, s)
+		return false, nil
+	})
+	return ss, err
+}
+
+// payStream pays one due period of the stream from the agent's escrow, as an
+// attested transfer would.
+func (k Keeper) payStream(ctx sdk.Context, s types.PaymentStream) error {
+	agent, found := k.GetAgent(ctx, s.AgentId)
+	if !found {
+		return errorsmod.Wrap(types.ErrAgentNotFound, s.AgentId)
+	}
+	if !agent.Active {
+		return gerrc.ErrFailedPrecondition.Wrapf("agent is not active: %s", s.AgentId)
+	}
+	if err := k.notFrozen(ctx, agent); err != nil {
+		return err
+	}
+	budget, ok := agent.SpendBudgetFor(s.AmountPerPeriod.Denom)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrSpendingDisabled, "agent %s denom %s", s.AgentId, s.AmountPerPeriod.Denom)
+	}
+
+	amount := s.AmountPerPeriod
+	if err := k.spendFromEscrow(ctx, &agent, budget, s.Recipient, amount.Amount); err != nil {
+		return err
+	}
+	if err := k.SetAgent(ctx, agent); err != nil {
+		return errorsmod.Wrap(err, "set agent")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventPaymentStreamPayout{
+		AgentId:   s.AgentId,
+		StreamId:  s.Id,
+		Recipient: s.Recipient,
+		Amount:    amount,
+		Periods:   1,
+	})
+}
+
+// ProcessPaymentStreams pays every active payment stream with periods due at
+// the block time. Periods that fall due together (e.g. after a halt) are paid
+// one at a time until one fails. The failed period and the ones after it are
+// skipped rather than retried, so an exhausted budget or an empty escrow
+// cannot back up the queue; they count as missed. Neither the budget window
+// nor the escrow refills within a block, so the later periods would fail too.
+// Streams past their end time are closed.
+//
+// At most payment_stream_process_limit due streams are looked at per block,
+// streams still waiting for their activation height included. The next block
+// resumes after the last one, and starts over once the end of the due streams
+// is reached, so all of them are processed in turn.
+func (k Keeper) ProcessPaymentStreams(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "get params")
+	}
+	rng := new(collections.Range[collections.Triple[time.Time, string, uint64]])
+	cursor, err := k.streamQueueCursor.Get(ctx)
+	switch {
+	case err == nil:
+		rng = rng.StartExclusive(cursor)
+	case !errors.Is(err, collections.ErrNotFound):
+		return errorsmod.Wrap(err, "get stream queue cursor")
+	}
+
+	var (
+		due     []types.PaymentStream
+		last    collections.Triple[time.Time, string, uint64]
+		limited bool
+	)
+	err = k.streamQueue.Walk(ctx, rng, func(key collections.Triple[time.Time, string, uint64]) (stop bool, err error) {
+		if key.K1().After(ctx.BlockTime()) {
+			return true, nil
+		}
+		if uint64(len(due)) == params.PaymentStreamProcessLimit {
+			limited = true
+			return true, nil
+		}
+		s, found := k.GetPaymentStream(ctx, key.K2(), key.K3())
+		if !found {
+			return true, errorsmod.Wrapf(types.ErrPaymentStreamNotFound, "queued stream %d of agent %s", key.K3(), key.K2())
+		}
+		due = append(due, s)
+		last = key
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "walk stream queue")
+	}
+	if limited {
+		err = k.streamQueueCursor.Set(ctx, last)
+	} else {
+		err = k.streamQueueCursor.Remove(ctx)
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "update stream queue cursor")
+	}
+
+	for _, s := range due {
+		// not yet past the withdrawal delay: leave the periods to accrue
+		if ctx.BlockHeight() < s.ActivationHeight {
+			continue
+		}
+		periods := s.DuePeriods(ctx.BlockTime())
+		if periods == 0 {
+			continue
+		}
+
+		var paid uint64
+		for ; paid < periods; paid++ {
+			err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.payStream(ctx, s)
+			})
+			if err != nil {
+				missed := periods - paid
+				s.MissedPeriods += missed
+				if err := uevent.EmitTypedEvent(ctx, &types.EventPaymentStreamMissed{
+					AgentId:  s.AgentId,
+					StreamId: s.Id,
+					Periods:  missed,
+					Reason:   err.Error(),
+				}); err != nil {
+					return err
+				}
+				break
+			}
+			s.Paid = s.Paid.Add(s.AmountPerPeriod.Amount)
+		}
+
+		if err := k.streamQueue.Remove(ctx, collections.Join3(s.NextPaymentTime, s.AgentId, s.Id)); err != nil {
+			return errorsmod.Wrap(err, "remove stream queue entry")
+		}
+		s.Advance(periods)
+		if s.Done() {
+			if err := k.closePaymentStream(ctx, s, closeReasonCompleted); err != nil {
+				return errorsmod.Wrapf(err, "close stream %d of agent %s", s.Id, s.AgentId)
+			}
+			continue
+		}
+		if err := k.setPaymentStream(ctx, s); err != nil {
+			return errorsmod.Wrapf(err, "set stream %d of agent %s", s.Id, s.AgentId)
+		}
+	}
+	return nil
+}
+
+// CreatePaymentStream creates an owner-approved recurring payout from the
+// agent's escrow. It does not move funds: each period is paid by the end
+// blocker and counts against the denom's spend budget and the recipient
+// policy. Under a withdrawal delay the stream only starts paying once the
+// delay has passed, so the guardian can cancel a stream opened with a stolen
+// owner key before it pays anything.
+func (k msgServer) CreatePaymentStream(goCtx context.Context, msg *types.MsgCreatePaymentStream) (*types.MsgCreatePaymentStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	agent, found := k.GetAgent(ctx, msg.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, msg.AgentId)
+	}
+	if agent.Owner != msg.Owner {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not the agent owner")
+	}
+	if !agent.Active {
+		return nil, gerrc.ErrFailedPrecondition.Wrapf("agent is not active: %s", msg.AgentId)
+	}
+	if agent.Frozen {
+		return nil, errorsmod.Wrap(types.ErrAgentFrozen, msg.AgentId)
+	}
+	if _, ok := agent.SpendBudgetFor(msg.AmountPerPeriod.Denom); !ok {
+		return nil, errorsmod.Wrapf(types.ErrSpendingDisabled, "agent %s denom %s", msg.AgentId, msg.AmountPerPeriod.Denom)
+	}
+	if !k.GetRecipientPolicy(ctx, msg.AgentId).Allows(msg.Recipient) {
+		return nil, errorsmod.Wrapf(types.ErrRecipientNotAllowed, "not on allowlist: %s", msg.Recipient)
+	}
+
+	start := msg.StartTime
+	if start.IsZero() {
+		start = ctx.BlockTime()
+	}
+	// a backdated start would make its past periods due at once
+	if start.Before(ctx.BlockTime()) {
+		return nil, gerrc.ErrInvalidArgument.Wrapf("start time %s before the block time", start)
+	}
+	if !msg.EndTime.After(ctx.BlockTime()) || !start.Before(msg.EndTime) {
+		return nil, gerrc.ErrInvalidArgument.Wrapf("end time %s must be after the start time and the block time", msg.EndTime)
+	}
+
+	existing, err := k.AgentPaymentStreams(ctx, msg.AgentId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "payment streams")
+	}
+	if len(existing) >= types.MaxPaymentStreamsPerAgent {
+		return nil, gerrc.ErrResourceExhausted.Wrapf("agent %s has %d payment streams, max %d", msg.AgentId, len(existing), types.MaxPaymentStreamsPerAgent)
+	}
+
+	id, err := k.nextStreamID.Next(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "next stream id")
+	}
+	delay := agent.EffectiveSecurity(ctx.BlockHeight()).WithdrawalDelayBlocks
+	s := types.PaymentStream{
+		Id:               id,
+		AgentId:          msg.AgentId,
+		Recipient:        msg.Recipient,
+		AmountPerPeriod:  msg.AmountPerPeriod,
+		Period:           msg.Period,
+		NextPaymentTime:  start,
+		EndTime:          msg.EndTime,
+		ActivationHeight: ctx.BlockHeight() + int64(delay), //nolint:gosec // delay is bounded by MaxWithdrawalDelayBlocks
+		Paid:             math.ZeroInt(),
+	}
+	if err := k.setPaymentStream(ctx, s); err != nil {
+		return nil, errorsmod.Wrap(err, "set payment stream")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventCreatePaymentStream{Stream: s}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreatePaymentStreamResponse{StreamId: id, ActivationHeight: s.ActivationHeight}, nil
+}
+
+// CancelPaymentStream closes a payment stream. Unlike the owner's other
+// settings it may also be cancelled by the guardian or governance, including
+// while the agent is frozen.
+func (k msgServer) CancelPaymentStream(goCtx context.Context, msg *types.MsgCancelPaymentStream) (*types.MsgCancelPaymentStreamResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	agent, found := k.GetAgent(ctx, msg.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, msg.AgentId)
+	}
+	reason := cancelReasonOwner
+	if msg.Signer != agent.Owner {
+		if err := k.authorizeGuardian(ctx, agent, msg.Signer); err != nil {
+			return nil, errorsmod.Wrap(types.ErrUnauthorized, "not the agent owner or guardian")
+		}
+		reason = cancelReasonGuardian
+		if msg.Signer == k.authority {
+			reason = cancelReasonGovernance
+		}
+	}
+
+	s, found := k.GetPaymentStream(ctx, msg.AgentId, msg.StreamId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrPaymentStreamNotFound, "agent %s id %d", msg.AgentId, msg.StreamId)
+	}
+	if err := k.closePaymentStream(ctx, s, reason); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelPaymentStreamResponse{}, nil
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+var streamT0 = time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// streamAt moves the context to height h, d after streamT0, and runs the
+// stream end blocker.
+func (s *EscrowTestSuite) streamAt(h int64, d time.Duration) {
+	s.Ctx = s.Ctx.WithBlockHeight(h).WithBlockTime(streamT0.Add(d))
+	s.Require().NoError(s.k.ProcessPaymentStreams(s.Ctx))
+}
+
+// createStream opens an hourly stream of amt starting now and ending after
+// the given number of periods.
+func (s *EscrowTestSuite) createStream(owner sdk.AccAddress, id string, recipient sdk.AccAddress, amt int64, periods int) *types.MsgCreatePaymentStreamResponse {
+	res, err := s.msgServer.CreatePaymentStream(s.Ctx, types.NewMsgCreatePaymentStream(owner.String(), id, recipient.String(),
+		sdk.NewCoin(spendDenom, math.NewInt(amt)), time.Hour, time.Time{}, s.Ctx.BlockTime().Add(time.Duration(periods)*time.Hour)))
+	s.Require().NoError(err)
+	return res
+}
+
+func (s *EscrowTestSuite) recipientBalance(addr sdk.AccAddress) math.Int {
+	return s.App.BankKeeper.GetBalance(s.Ctx, addr, spendDenom).Amount
+}
+
+func (s *EscrowTestSuite) TestPaymentStream_PaysPeriodsAndCompletes() {
+	owner := s.spendingAgent("a1")
+	s.fundEscrow("a1", 1000)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	s.Ctx = s.Ctx.WithBlockHeight(100).WithBlockTime(streamT0)
+	res := s.createStream(owner, "a1", recipient, 100, 4)
+
+	// the first period is due at creation
+	s.streamAt(100, 0)
+	s.Require().Equal(math.NewInt(100), s.recipientBalance(recipient))
+
+	s.streamAt(101, 30*time.Minute)
+	s.Require().Equal(math.NewInt(100), s.recipientBalance(recipient))
+
+	// periods missed by the end blocker (e.g. a halt) are paid together
+	s.streamAt(102, 3*time.Hour)
+	s.Require().Equal(math.NewInt(400), s.recipientBalance(recipient))
+	s.Require().Equal(math.NewInt(600), s.k.GetEscrowBalance(s.Ctx, "a1").AmountOf(spendDenom))
+
+	// the last period was paid, so the stream closed
+	_, found := s.k.GetPaymentStream(s.Ctx, "a1", res.StreamId)
+	s.Require().False(found)
+	s.streamAt(103, 10*time.Hour)
+	s.Require().Equal(math.NewInt(400), s.recipientBalance(recipient))
+}
+
+func (s *EscrowTestSuite) TestPaymentStream_CountsAgainstBudget() {
+	owner := s.spendingAgent("a1")
+	s.fundEscrow("a1", 5000)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	s.Ctx = s.Ctx.WithBlockHeight(100).WithBlockTime(streamT0)
+	res := s.createStream(owner, "a1", recipient, 600, 10)
+
+	s.streamAt(100, 0)
+	s.Require().Equal(math.NewInt(600), s.recipientBalance(recipient))
+	agent, _ := s.k.GetAgent(s.Ctx, "a1")
+	s.Require().Equal(math.NewInt(windowLimit-600), agent.RemainingWindowBudget(types.NewSpendClock(s.Ctx)))
+
+	// same budget window: 600 more would exceed the limit, so the period is
+	// missed rather than retried
+	s.streamAt(101, time.Hour)
+	s.Require().Equal(math.NewInt(600), s.recipientBalance(recipient))
+	st, found := s.k.GetPaymentStream(s.Ctx, "a1", res.StreamId)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), st.MissedPeriods)
+	s.Require().Equal(streamT0.Add(2*time.Hour), st.NextPaymentTime)
+
+	s.streamAt(110, 2*time.Hour)
+	s.Require().Equal(math.NewInt(1200), s.recipientBalance(recipient))
+	st, _ = s.k.GetPaymentStream(s.Ctx, "a1", res.StreamId)
+	s.Require().Equal(math.NewInt(1200), st.Paid)
+
+	q, err := s.k.PaymentStreams(s.Ctx, &types.QueryPaymentStreamsRequest{AgentId: "a1"})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PaymentStream{st}, q.Streams)
+}
+
+// TestPaymentStream_PaysDuePeriodsWithinBudget checks that of periods due
+// together only those over the budget are missed.
+func (s *EscrowTestSuite) TestPaymentStream_PaysDuePeriodsWithinBudget() {
+	owner := s.spendingAgent("a1")
+	s.fundEscrow("a1", 5000)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	s.Ctx = s.Ctx.WithBlockHeight(100).WithBlockTime(streamT0)
+	res := s.createStream(owner, "a1", recipient, 300, 10)
+
+	s.streamAt(100, 0)
+	s.Require().Equal(math.NewInt(300), s.recipientBalance(recipient))
+
+	// three periods due in the same budget window, which has 700 left
+	s.streamAt(101, 3*time.Hour)
+	s.Require().Equal(math.NewInt(900), s.recipientBalance(recipient))
+	st, found := s.k.GetPaymentStream(s.Ctx, "a1", res.StreamId)
+	s.Require().True(found)
+	s.Require().Equal(uint64(1), st.MissedPeriods)
+	s.Require().Equal(math.NewInt(900), st.Paid)
+	s.Require().Equal(streamT0.Add(4*time.Hour), st.NextPaymentTime)
+}
+
+func (s *EscrowTestSuite) TestPaymentStream_ProcessLimit() {
+	params, err := s.k.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	params.PaymentStreamProcessLimit = 2
+	s.Require().NoError(s.k.SetParams(s.Ctx, params))
+
+	owner := s.spendingAgent("a1")
+	s.fundEscrow("a1", 5000)
+	s.Ctx = s.Ctx.WithBlockHeight(100).WithBlockTime(streamT0)
+	recipients := make([]sdk.AccAddress, 3)
+	for i := range recipients {
+		_, _, recipients[i] = testdata.KeyTestPubAddr()
+		s.createStream(owner, "a1", recipients[i], 100, 10)
+	}
+	paid := func() []int64 {
+		var ret []int64
+		for _, r := range recipients {
+			ret = append(ret, s.recipientBalance(r).Int64())
+		}
+		return ret
+	}
+
+	// the limit is reached, the last stream waits for the next block
+	s.streamAt(100, 0)
+	s.Require().Equal([]int64{100, 100, 0}, paid())
+	s.streamAt(101, time.Minute)
+	s.Require().Equal([]int64{100, 100, 100}, paid())
+
+	// having gone through, the next sweep starts over
+	s.streamAt(102, 2*time.Minute)
+	s.Require().Equal([]int64{100, 100, 100}, paid())
+	s.streamAt(103, time.Hour)
+	s.Require().Equal([]int64{200, 200, 100}, paid())
+	s.streamAt(104, time.Hour+time.Minute)
+	s.Require().Equal([]int64{200, 200, 200}, paid())
+}
+
+func (s *EscrowTestSuite) TestPaymentStream_FrozenAgentMissesPeriods() {
+	owner, guardian := s.guardedAgent("a1")
+	_, _, recipient := testdata.KeyTestPubAddr()
+	s.Ctx = s.Ctx.WithBlockHeight(100).WithBlockTime(streamT0)
+	res := s.createStream(owner, "a1", recipient, 100, 10)
+
+	// nothing is paid until the withdrawal delay has passed
+	s.Require().Equal(int64(100+withdrawalDelay), res.ActivationHeight)
+	s.streamAt(100+withdrawalDelay-1, time.Hour)
+	s.Require().True(s.recipientBalance(recipient).IsZero())
+	s.streamAt(100+withdrawalDelay, 2*time.Hour)
+	s.Require().Equal(math.NewInt(300), s.recipientBalance(recipient))
+
+	_, err := s.msgServer.FreezeAgent(s.Ctx, types.NewMsgFreezeAgent(guardian.String(), "a1"))
+	s.Require().NoError(err)
+	s.streamAt(130, 3*time.Hour)
+	s.Require().Equal(math.NewInt(300), s.recipientBalance(recipient))
+	st, _ := s.k.GetPaymentStream(s.Ctx, "a1", res.StreamId)
+	s.Require().Equal(uint64(1), st.MissedPeriods)
+}
+
+func (s *EscrowTestSuite) TestPaymentStream_Cancel() {
+	owner, guardian := s.guardedAgent("a1")
+	_, _, recipient := testdata.KeyTestPubAddr()
+	s.Ctx = s.Ctx.WithBlockHeight(100).WithBlockTime(streamT0)
+	first := s.createStream(owner, "a1", recipient, 100, 10)
+	second := s.createStream(owner, "a1", recipient, 100, 10)
+	third := s.createStream(owner, "a1", recipient, 100, 10)
+
+	_, _, other := testdata.KeyTestPubAddr()
+	_, err := s.msgServer.CancelPaymentStream(s.Ctx, types.NewMsgCancelPaymentStream(other.String(), "a1", first.StreamId))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.CancelPaymentStream(s.Ctx, types.NewMsgCancelPaymentStream(owner.String(), "a1", first.StreamId))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CancelPaymentStream(s.Ctx, types.NewMsgCancelPaymentStream(owner.String(), "a1", first.StreamId))
+	s.Require().ErrorIs(err, types.ErrPaymentStreamNotFound)
+
+	// the guardian and governance may cancel, even while frozen
+	_, err = s.msgServer.FreezeAgent(s.Ctx, types.NewMsgFreezeAgent(guardian.String(), "a1"))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CancelPaymentStream(s.Ctx, types.NewMsgCancelPaymentStream(guardian.String(), "a1", second.StreamId))
+	s.Require().NoError(err)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = s.msgServer.CancelPaymentStream(s.Ctx, types.NewMsgCancelPaymentStream(gov, "a1", third.StreamId))
+	s.Require().NoError(err)
+
+	s.streamAt(200, 5*time.Hour)
+	s.Require().True(s.recipientBalance(recipient).IsZero())
+	streams, err := s.k.AgentPaymentStreams(s.Ctx, "a1")
+	s.Require().NoError(err)
+	s.Require().Empty(streams)
+}
+
+func (s *EscrowTestSuite) TestCreatePaymentStream_Rejected() {
+	owner := s.spendingAgent("a1")
+	_, _, recipient := testdata.KeyTestPubAddr()
+	s.Ctx = s.Ctx.WithBlockHeight(100).WithBlockTime(streamT0)
+	end := streamT0.Add(10 * time.Hour)
+
+	// no budget for the denom
+	_, err := s.msgServer.CreatePaymentStream(s.Ctx, types.NewMsgCreatePaymentStream(owner.String(), "a1", recipient.String(),
+		sdk.NewCoin("uother", math.NewInt(1)), time.Hour, time.Time{}, end))
+	s.Require().ErrorIs(err, types.ErrSpendingDisabled)
+
+	// already ended
+	_, err = s.msgServer.CreatePaymentStream(s.Ctx, types.NewMsgCreatePaymentStream(owner.String(), "a1", recipient.String(),
+		sdk.NewCoin(spendDenom, math.NewInt(1)), time.Hour, time.Time{}, streamT0))
+	s.Require().Error(err)
+
+	// the start may not be in the past
+	_, err = s.msgServer.CreatePaymentStream(s.Ctx, types.NewMsgCreatePaymentStream(owner.String(), "a1", recipient.String(),
+		sdk.NewCoin(spendDenom, math.NewInt(1)), time.Hour, streamT0.Add(-5*time.Hour), end))
+	s.Require().ErrorIs(err, gerrc.ErrInvalidArgument)
+
+	_, _, other := testdata.KeyTestPubAddr()
+	_, err = s.msgServer.CreatePaymentStream(s.Ctx, types.NewMsgCreatePaymentStream(other.String(), "a1", recipient.String(),
+		sdk.NewCoin(spendDenom, math.NewInt(1)), time.Hour, time.Time{}, end))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.UpdateAgentRecipientPolicy(s.Ctx, types.NewMsgUpdateAgentRecipientPolicy(owner.String(), "a1",
+		types.RecipientPolicy{Allowlist: []string{other.String()}}))
+	s.Require().NoError(err)
+	_, err = s.msgServer.CreatePaymentStream(s.Ctx, types.NewMsgCreatePaymentStream(owner.String(), "a1", recipient.String(),
+		sdk.NewCoin(spendDenom, math.NewInt(1)), time.Hour, time.Time{}, end))
+	s.Require().ErrorIs(err, types.ErrRecipientNotAllowed)
+
+	for range types.MaxPaymentStreamsPerAgent {
+		s.createStream(owner, "a1", other, 1, 10)
+	}
+	_, err = s.msgServer.CreatePaymentStream(s.Ctx, types.NewMsgCreatePaymentStream(owner.String(), "a1", other.String(),
+		sdk.NewCoin(spendDenom, math.NewInt(1)), time.Hour, time.Time{}, end))
+	s.Require().Error(err)
+}
@@ -0,0 +1,238 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+func (k Keeper) GetRegisteredPolicy(ctx sdk.Context, id string) (types.RegisteredPolicy, bool) {
+	p, err := k.registeredPolicies.Get(ctx, id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RegisteredPolicy{}, false
+		}
+		panic(err)
+	}
+	return p, true
+}
+
+// registeredHead follows the lineage of the registered policy id to its
+// latest version.
+func (k Keeper) registeredHead(ctx sdk.Context, id string) (types.RegisteredPolicy, error) {
+	p, found := k.GetRegisteredPolicy(ctx, id)
+	if !found {
+		return types.RegisteredPolicy{}, gerrc.ErrNotFound.Wrapf("registered policy: %s", id)
+	}
+	for !p.IsHead() {
+		next, found := k.GetRegisteredPolicy(ctx, p.SupersededBy)
+		if !found {
+			return types.RegisteredPolicy{}, gerrc.ErrInternal.Wrapf("successor of %s: %s", p.Id, p.SupersededBy)
+		}
+		p = next
+	}
+	return p, nil
+}
+
+// ResolvePolicy returns the latest version of the registered policy lineage
+// id, for modules that reference policies by id rather than embedding them. A
+// revoked head does not resolve.
+func (k Keeper) ResolvePolicy(ctx sdk.Context, id string) (tee.Policy, error) {
+	head, err := k.registeredHead(ctx, id)
+	if err != nil {
+		return tee.Policy{}, err
+	}
+	if _, err := k.fingerprintNotRevoked(ctx, head.Policy); err != nil {
+		return tee.Policy{}, err
+	}
+	return head.Policy, nil
+}
+
+// resolvePolicyArg returns the policy a message names, either embedded or by
+// registered id, with the id of the lineage head it resolved to, if any.
+func (k Keeper) resolvePolicyArg(ctx sdk.Context, p tee.Policy, id string) (tee.Policy, string, error) {
+	if id == "" {
+		return p, "", nil
+	}
+	head, err := k.registeredHead(ctx, id)
+	if err != nil {
+		return tee.Policy{}, "", err
+	}
+	return head.Policy, head.Id, nil
+}
+
+// boundHead returns the head of the lineage the agent is bound to, if any.
+func (k Keeper) boundHead(ctx sdk.Context, agent types.Agent) (types.RegisteredPolicy, bool, error) {
+	if agent.PolicyId == "" {
+		return types.RegisteredPolicy{}, false, nil
+	}
+	head, err := k.registeredHead(ctx, agent.PolicyId)
+	if err != nil {
+		return types.RegisteredPolicy{}, false, err
+	}
+	// a co-policy as primary would count twice toward the quorum
+	listed, err := agent.PolicySet.Includes(head.Policy)
+	if err != nil {
+		return types.RegisteredPolicy{}, false, err
+	}
+	if listed {
+		return types.RegisteredPolicy{}, false, errorsmod.Wrapf(types.ErrInvalidPolicy, "registered policy %s is a co-policy of the agent", head.Id)
+	}
+	return head, true, nil
+}
+
+// syncBoundPolicy schedules the rotation of an agent bound to a registered
+// policy onto the head of its lineage, once the lineage moved on. Like a
+// rotation by the owner it waits out the policy rotation delay, so the owner
+// and the guardian can react to a supersession before the agent trusts it. A
+// rotation already pending is left alone; the lineage is followed once it is
+// applied. Callers persist the agent.
+func (k Keeper) syncBoundPolicy(ctx sdk.Context, agent *types.Agent) error {
+	head, bound, err := k.boundHead(ctx, *agent)
+	if err != nil || !bound || head.Id == agent.PolicyId || agent.PendingPolicy != nil {
+		return err
+	}
+	delay, err := k.PolicyRotationDelayBlocks(ctx)
+	if err != nil {
+		return err
+	}
+	agent.PendingPolicy = &head.Policy
+	agent.PendingPolicyId = head.Id
+	agent.PendingPolicyHeight = ctx.BlockHeight() + int64(delay) //nolint:gosec // delay is a small governance param, no realistic overflow
+	return uevent.EmitTypedEvent(ctx, &types.EventUpdateAgentPolicy{
+		AgentId:          agent.Id,
+		ActivationHeight: agent.PendingPolicyHeight,
+	})
+}
+
+// effectivePolicy returns the policy the agent attests under now, with any
+// matured rotation applied. A bound lineage whose head is a co-policy of the
+// agent fails.
+func (k Keeper) effectivePolicy(ctx sdk.Context, agent types.Agent) (tee.Policy, error) {
+	agent.PromotePendingPolicy(ctx.BlockHeight())
+	if _, _, err := k.boundHead(ctx, agent); err != nil {
+		return tee.Policy{}, err
+	}
+	return agent.Policy, nil
+}
+
+// RegisteredPolicyAdmin returns the admin of the registered policy lineage id.
+func (k Keeper) RegisteredPolicyAdmin(ctx sdk.Context, id string) (string, error) {
+	p, found := k.GetRegisteredPolicy(ctx, id)
+	if !found {
+		return "", gerrc.ErrNotFound.Wrapf("registered policy: %s", id)
+	}
+	return p.Admin, nil
+}
+
+func (k msgServer) RegisterPolicy(goCtx context.Context, msg *types.MsgRegisterPolicy) (*types.MsgRegisterPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	p, err := k.newRegisteredPolicy(ctx, msg.Policy, msg.Admin)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.registeredPolicies.Set(ctx, p.Id, p); err != nil {
+		return nil, errorsmod.Wrap(err, "set registered policy")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventPolicyRegistered{
+		Id:      p.Id,
+		Admin:   p.Admin,
+		Version: p.Version,
+		TeeType: p.Policy.Type,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterPolicyResponse{Id: p.Id}, nil
+}
+
+func (k msgServer) SupersedePolicy(goCtx context.Context, msg *types.MsgSupersedePolicy) (*types.MsgSupersedePolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	prev, found := k.GetRegisteredPolicy(ctx, msg.Id)
+	if !found {
+		return nil, gerrc.ErrNotFound.Wrapf("registered policy: %s", msg.Id)
+	}
+	if msg.Authority != prev.Admin && msg.Authority != k.authority {
+		return nil, gerrc.ErrPermissionDenied.Wrap("not the policy admin or governance")
+	}
+	// lineages stay linear: only the head has a successor to give
+	if !prev.IsHead() {
+		return nil, gerrc.ErrFailedPrecondition.Wrapf("policy already superseded by %s", prev.SupersededBy)
+	}
+
+	next, err := k.newRegisteredPolicy(ctx, msg.NewPolicy, prev.Admin)
+	if err != nil {
+		return nil, err
+	}
+	next.Version = prev.Version + 1
+	next.Supersedes = prev.Id
+	prev.SupersededBy = next.Id
+	if err := k.registeredPolicies.Set(ctx, next.Id, next); err != nil {
+		return nil, errorsmod.Wrap(err, "set registered policy")
+	}
+	if err := k.registeredPolicies.Set(ctx, prev.Id, prev); err != nil {
+		return nil, errorsmod.Wrap(err, "set registered policy")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventPolicyRegistered{
+		Id:      next.Id,
+		Admin:   next.Admin,
+		Version: next.Version,
+		TeeType: next.Policy.Type,
+	}); err != nil {
+		return nil, err
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventPolicySuperseded{
+		Id:           prev.Id,
+		SupersededBy: next.Id,
+		Authority:    msg.Authority,
+		Version:      next.Version,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSupersedePolicyResponse{Id: next.Id, Version: next.Version}, nil
+}
+
+// newRegisteredPolicy returns policy as version 1 of a new lineage. Ids are
+// scoped to the admin, so an admin registers a policy at most once, and a
+// revoked policy not at all, but registering a policy first does not make
+// one the admin of everyone's lineage of it.
+func (k Keeper) newRegisteredPolicy(ctx sdk.Context, policy tee.Policy, admin string) (types.RegisteredPolicy, error) {
+	if _, err := k.fingerprintNotRevoked(ctx, policy); err != nil {
+		return types.RegisteredPolicy{}, err
+	}
+	id, err := types.RegisteredPolicyID(admin, policy)
+	if err != nil {
+		return types.RegisteredPolicy{}, err
+	}
+	if _, found := k.GetRegisteredPolicy(ctx, id); found {
+		return types.RegisteredPolicy{}, gerrc.ErrAlreadyExists.Wrapf("registered policy: %s", id)
+	}
+	return types.RegisteredPolicy{
+		Id:            id,
+		Policy:        policy,
+		Admin:         admin,
+		Version:       1,
+		CreatedHeight: ctx.BlockHeight(),
+	}, nil
+}
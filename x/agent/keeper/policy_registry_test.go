@@ -0,0 +1,228 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+func registerPolicy(t *testing.T, ctx sdk.Context, k *keeper.Keeper, admin string, p tee.Policy) string {
+	t.Helper()
+	res, err := keeper.NewMsgServerImpl(*k).RegisterPolicy(ctx, types.NewMsgRegisterPolicy(admin, p))
+	require.NoError(t, err)
+	return res.Id
+}
+
+func TestRegisterPolicy(t *testing.T) {
+	ctx, k, _ := setup(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	admin := owner(t)
+	p := validPolicyT(t, "v1")
+
+	id := registerPolicy(t, ctx, k, admin, p)
+	expect, err := types.RegisteredPolicyID(admin, p)
+	require.NoError(t, err)
+	require.Equal(t, expect, id)
+	got, found := k.GetRegisteredPolicy(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.RegisteredPolicy{Id: id, Policy: p, Admin: admin, Version: 1, CreatedHeight: ctx.BlockHeight()}, got)
+
+	// an admin registers a policy once
+	_, err = ms.RegisterPolicy(ctx, types.NewMsgRegisterPolicy(admin, p))
+	require.ErrorIs(t, err, gerrc.ErrAlreadyExists)
+
+	// registering first does not claim the policy: others get their own lineage
+	other := owner(t)
+	otherID := registerPolicy(t, ctx, k, other, p)
+	require.NotEqual(t, id, otherID)
+	otherAdmin, err := k.RegisteredPolicyAdmin(ctx, otherID)
+	require.NoError(t, err)
+	require.Equal(t, other, otherAdmin)
+
+	revoked := validPolicyT(t, "revoked")
+	_, err = ms.RevokePolicy(ctx, types.NewMsgRevokePolicy(govAuthority, fingerprint(t, revoked), "cve"))
+	require.NoError(t, err)
+	_, err = ms.RegisterPolicy(ctx, types.NewMsgRegisterPolicy(admin, revoked))
+	require.ErrorContains(t, err, "policy revoked")
+}
+
+func TestSupersedePolicy(t *testing.T) {
+	ctx, k, _ := setup(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	admin := owner(t)
+	v1, v2, v3 := validPolicyT(t, "v1"), validPolicyT(t, "v2"), validPolicyT(t, "v3")
+	id1 := registerPolicy(t, ctx, k, admin, v1)
+
+	_, err := ms.SupersedePolicy(ctx, types.NewMsgSupersedePolicy(owner(t), id1, v2))
+	require.ErrorIs(t, err, gerrc.ErrPermissionDenied)
+
+	res, err := ms.SupersedePolicy(ctx, types.NewMsgSupersedePolicy(admin, id1, v2))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.Version)
+	id2 := res.Id
+
+	prev, _ := k.GetRegisteredPolicy(ctx, id1)
+	require.Equal(t, id2, prev.SupersededBy)
+	next, _ := k.GetRegisteredPolicy(ctx, id2)
+	require.Equal(t, id1, next.Supersedes)
+	require.Equal(t, admin, next.Admin)
+
+	// only the head can be superseded, so lineages stay linear
+	_, err = ms.SupersedePolicy(ctx, types.NewMsgSupersedePolicy(admin, id1, v3))
+	require.ErrorIs(t, err, gerrc.ErrFailedPrecondition)
+
+	// governance may supersede any lineage; the admin carries over
+	res, err = ms.SupersedePolicy(ctx, types.NewMsgSupersedePolicy(govAuthority, id2, v3))
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), res.Version)
+	head, _ := k.GetRegisteredPolicy(ctx, res.Id)
+	require.Equal(t, admin, head.Admin)
+
+	// every version resolves to the head
+	for _, id := range []string{id1, id2, res.Id} {
+		got, err := k.ResolvePolicy(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, v3, got)
+	}
+	q, err := k.RegisteredPolicy(ctx, &types.QueryRegisteredPolicyRequest{Id: id1})
+	require.NoError(t, err)
+	require.Equal(t, id1, q.Policy.Id)
+	require.Equal(t, res.Id, q.Head.Id)
+
+	_, err = k.ResolvePolicy(ctx, fingerprint(t, validPolicyT(t, "unknown")))
+	require.ErrorIs(t, err, gerrc.ErrNotFound)
+}
+
+func TestBoundAgentFollowsSupersession(t *testing.T) {
+	ctx, k, v := setup(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	admin, own := owner(t), owner(t)
+	v1, v2 := validPolicyT(t, "v1"), validPolicyT(t, "v2")
+	id1 := registerPolicy(t, ctx, k, admin, v1)
+	require.NoError(t, k.SetAgent(ctx, types.Agent{Id: "a1", Owner: own, Policy: v1, PolicyId: id1, Active: true}))
+	delay, err := k.PolicyRotationDelayBlocks(ctx)
+	require.NoError(t, err)
+
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 0))
+	require.NoError(t, err)
+	require.Equal(t, v1, v.gotPolicy)
+
+	// supersession is scheduled as a rotation under the timelock, the agent
+	// keeps attesting under its policy meanwhile
+	res, err := ms.SupersedePolicy(ctx, types.NewMsgSupersedePolicy(admin, id1, v2))
+	require.NoError(t, err)
+	q, err := k.Agent(ctx, &types.QueryAgentRequest{AgentId: "a1"})
+	require.NoError(t, err)
+	require.Equal(t, fingerprint(t, v1), q.Fingerprint)
+
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 1))
+	require.NoError(t, err)
+	require.Equal(t, v1, v.gotPolicy)
+	agent, _ := k.GetAgent(ctx, "a1")
+	require.Equal(t, v1, agent.Policy)
+	require.Equal(t, id1, agent.PolicyId)
+	require.Equal(t, v2, *agent.PendingPolicy)
+	require.Equal(t, res.Id, agent.PendingPolicyId)
+	activation := ctx.BlockHeight() + int64(delay) //nolint:gosec
+	require.Equal(t, activation, agent.PendingPolicyHeight)
+
+	// the schedule does not restart on later attestations
+	ctx = ctx.WithBlockHeight(activation - 1)
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 2))
+	require.NoError(t, err)
+	require.Equal(t, v1, v.gotPolicy)
+
+	ctx = ctx.WithBlockHeight(activation)
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 3))
+	require.NoError(t, err)
+	require.Equal(t, v2, v.gotPolicy)
+	agent, _ = k.GetAgent(ctx, "a1")
+	require.Equal(t, res.Id, agent.PolicyId)
+	require.Nil(t, agent.PendingPolicy)
+
+	// a revoked policy stops the agent, until its owner rotates it onto the
+	// lineage's new head
+	_, err = ms.RevokePolicy(ctx, types.NewMsgRevokePolicy(govAuthority, fingerprint(t, v2), "cve"))
+	require.NoError(t, err)
+	require.False(t, k.IsAgentLive(ctx, "a1"))
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 4))
+	require.ErrorContains(t, err, "policy revoked")
+	_, err = k.ResolvePolicy(ctx, id1)
+	require.ErrorContains(t, err, "policy revoked")
+
+	v3 := validPolicyT(t, "v3")
+	_, err = ms.SupersedePolicy(ctx, types.NewMsgSupersedePolicy(admin, res.Id, v3))
+	require.NoError(t, err)
+	require.False(t, k.IsAgentLive(ctx, "a1"))
+	msg := types.NewMsgUpdateAgentPolicy(own, "a1", tee.Policy{})
+	msg.NewPolicyId = id1
+	upd, err := ms.UpdateAgentPolicy(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, k.IsAgentLive(ctx.WithBlockHeight(upd.ActivationHeight), "a1"))
+}
+
+func TestBoundAgent_HeadIsCoPolicy(t *testing.T) {
+	ctx, k, _ := setup(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	admin := owner(t)
+	v1, co := validPolicyT(t, "v1"), validPolicyT(t, "co")
+	id1 := registerPolicy(t, ctx, k, admin, v1)
+	require.NoError(t, k.SetAgent(ctx, types.Agent{
+		Id: "a1", Owner: owner(t), Policy: v1, PolicyId: id1, Active: true,
+		PolicySet: types.PolicySet{CoPolicies: []tee.Policy{co}},
+	}))
+
+	// the admin cannot make a co-policy count twice toward the agent's quorum
+	_, err := ms.SupersedePolicy(ctx, types.NewMsgSupersedePolicy(admin, id1, co))
+	require.NoError(t, err)
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 0))
+	require.ErrorIs(t, err, types.ErrInvalidPolicy)
+	require.False(t, k.IsAgentLive(ctx, "a1"))
+}
+
+func TestUpdateAgentPolicy_RegisteredID(t *testing.T) {
+	ctx, k, v := setup(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	own := owner(t)
+	seedOwnedAgent(t, ctx, k, "a1", own, validPolicyT(t, "old"))
+
+	msg := types.NewMsgUpdateAgentPolicy(own, "a1", tee.Policy{})
+	msg.NewPolicyId = fingerprint(t, validPolicyT(t, "unregistered"))
+	_, err := ms.UpdateAgentPolicy(ctx, msg)
+	require.ErrorIs(t, err, gerrc.ErrNotFound)
+
+	admin := owner(t)
+	v1, v2 := validPolicyT(t, "v1"), validPolicyT(t, "v2")
+	msg.NewPolicyId = registerPolicy(t, ctx, k, admin, v1)
+	res, err := ms.UpdateAgentPolicy(ctx, msg)
+	require.NoError(t, err)
+	agent, _ := k.GetAgent(ctx, "a1")
+	require.Equal(t, v1, *agent.PendingPolicy)
+	require.Equal(t, msg.NewPolicyId, agent.PendingPolicyId)
+
+	// superseded while pending: the agent binds at activation, then follows the
+	// lineage to its head after another delay
+	sup, err := ms.SupersedePolicy(ctx, types.NewMsgSupersedePolicy(admin, msg.NewPolicyId, v2))
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(res.ActivationHeight)
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 0))
+	require.NoError(t, err)
+	require.Equal(t, v1, v.gotPolicy)
+	agent, _ = k.GetAgent(ctx, "a1")
+	require.Equal(t, msg.NewPolicyId, agent.PolicyId)
+	require.Equal(t, sup.Id, agent.PendingPolicyId)
+
+	ctx = ctx.WithBlockHeight(agent.PendingPolicyHeight)
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 1))
+	require.NoError(t, err)
+	require.Equal(t, v2, v.gotPolicy)
+	agent, _ = k.GetAgent(ctx, "a1")
+	require.Equal(t, sup.Id, agent.PolicyId)
+	require.Empty(t, agent.PendingPolicyId)
+}
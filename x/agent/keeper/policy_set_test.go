@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+// seedQuorumAgent seeds an agent attesting under a primary and two
+// co-policies with the given quorum, and returns its owner and the agent.
+func seedQuorumAgent(t *testing.T, ctx sdk.Context, k *keeper.Keeper, quorum types.AttestationQuorum) (string, types.Agent) {
+	t.Helper()
+	agent := types.Agent{
+		Id:     "agent1",
+		Owner:  owner(t),
+		Policy: validPolicyT(t, "primary"),
+		Active: true,
+		PolicySet: types.PolicySet{
+			CoPolicies: []tee.Policy{validPolicyT(t, "co1"), validPolicyT(t, "co2")},
+			Quorum:     quorum,
+		},
+	}
+	require.NoError(t, k.SetAgent(ctx, agent))
+	return agent.Owner, agent
+}
+
+func TestSubmitAttestedAction_Quorum(t *testing.T) {
+	ctx, k, v := setup(t)
+	seedQuorumAgent(t, ctx, k, types.AttestationQuorum{Required: 2, Actions: true})
+	ms := keeper.NewMsgServerImpl(*k)
+
+	// the primary attestation alone is one of two
+	_, err := ms.SubmitAttestedAction(ctx, validMsg(t, "agent1", []byte("p"), 0))
+	require.ErrorIs(t, err, types.ErrQuorumNotMet)
+
+	// a co-attestation naming a policy the agent does not have
+	msg := validMsg(t, "agent1", []byte("p"), 0)
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 3, Token: msg.Token + "#3"}}
+	_, err = ms.SubmitAttestedAction(ctx, msg)
+	require.ErrorContains(t, err, "no co-policy at index 3")
+
+	// every submitted attestation must verify, even beyond the quorum
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 1, Token: msg.Token + "#1"}, {PolicyIndex: 2, Token: "bad"}}
+	_, err = ms.SubmitAttestedAction(ctx, msg)
+	require.ErrorContains(t, err, "verify co-attestation 2")
+
+	v.calls = 0
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 2, Token: msg.Token + "#2"}}
+	res, err := ms.SubmitAttestedAction(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Seq)
+	require.Equal(t, 2, v.calls)
+	require.Equal(t, "co2", v.gotPolicy.PolicyQuery)
+}
+
+func TestSubmitAttestedAction_QuorumNotRequiredForActions(t *testing.T) {
+	ctx, k, _ := setup(t)
+	seedQuorumAgent(t, ctx, k, types.AttestationQuorum{Required: 3})
+	ms := keeper.NewMsgServerImpl(*k)
+
+	_, err := ms.SubmitAttestedAction(ctx, validMsg(t, "agent1", []byte("p"), 0))
+	require.NoError(t, err)
+}
+
+func TestSubmitAttestedAction_RevokedCoPolicy(t *testing.T) {
+	ctx, k, _ := setup(t)
+	_, agent := seedQuorumAgent(t, ctx, k, types.AttestationQuorum{Required: 2, Actions: true})
+	ms := keeper.NewMsgServerImpl(*k)
+
+	fp, err := types.PolicyFingerprint(agent.PolicySet.CoPolicies[0])
+	require.NoError(t, err)
+	_, err = ms.RevokePolicy(ctx, &types.MsgRevokePolicy{Authority: govAuthority, Fingerprint: fp, Reason: "cve"})
+	require.NoError(t, err)
+
+	msg := validMsg(t, "agent1", []byte("p"), 0)
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 1, Token: msg.Token + "#1"}}
+	_, err = ms.SubmitAttestedAction(ctx, msg)
+	require.ErrorContains(t, err, "policy revoked")
+
+	// the other co-policy still reaches the quorum
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 2, Token: msg.Token + "#2"}}
+	_, err = ms.SubmitAttestedAction(ctx, msg)
+	require.NoError(t, err)
+}
+
+func TestUpdateAgentPolicySet_Timelocked(t *testing.T) {
+	ctx, k, _ := setup(t)
+	own, _ := seedQuorumAgent(t, ctx, k, types.AttestationQuorum{})
+	ms := keeper.NewMsgServerImpl(*k)
+
+	set := types.PolicySet{
+		CoPolicies: []tee.Policy{validPolicyT(t, "co3")},
+		Quorum:     types.AttestationQuorum{Required: 2, Actions: true},
+	}
+	_, err := ms.UpdateAgentPolicySet(ctx, types.NewMsgUpdateAgentPolicySet(owner(t), "agent1", set))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := ms.UpdateAgentPolicySet(ctx, types.NewMsgUpdateAgentPolicySet(own, "agent1", set))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockHeight()+rotationDelay, res.ActivationHeight)
+
+	// the current set applies until the timelock elapses
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "agent1", []byte("p0"), 0))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(res.ActivationHeight)
+	q, err := k.Agent(ctx, &types.QueryAgentRequest{AgentId: "agent1"})
+	require.NoError(t, err)
+	fp, err := types.PolicyFingerprint(set.CoPolicies[0])
+	require.NoError(t, err)
+	require.Equal(t, []string{fp}, q.CoFingerprints)
+
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "agent1", []byte("p1"), 1))
+	require.ErrorIs(t, err, types.ErrQuorumNotMet)
+	msg := validMsg(t, "agent1", []byte("p1"), 1)
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 1, Token: msg.Token + "#1"}}
+	_, err = ms.SubmitAttestedAction(ctx, msg)
+	require.NoError(t, err)
+
+	agent, found := k.GetAgent(ctx, "agent1")
+	require.True(t, found)
+	require.Nil(t, agent.PendingPolicySet)
+	require.Equal(t, set, agent.PolicySet)
+}
+
+func TestUpdateAgentPolicySet_PrimaryNotCoPolicy(t *testing.T) {
+	ctx, k, _ := setup(t)
+	own, agent := seedQuorumAgent(t, ctx, k, types.AttestationQuorum{})
+	ms := keeper.NewMsgServerImpl(*k)
+
+	set := types.PolicySet{CoPolicies: []tee.Policy{agent.Policy}}
+	_, err := ms.UpdateAgentPolicySet(ctx, types.NewMsgUpdateAgentPolicySet(own, "agent1", set))
+	require.ErrorIs(t, err, types.ErrInvalidPolicy)
+
+	// nor can a co-policy be rotated in as the primary
+	_, err = ms.UpdateAgentPolicy(ctx, types.NewMsgUpdateAgentPolicy(own, "agent1", agent.PolicySet.CoPolicies[0]))
+	require.ErrorIs(t, err, types.ErrInvalidPolicy)
+}
+
+func (s *EscrowTestSuite) TestTransfer_QuorumAboveThreshold() {
+	s.spendingAgent("a1")
+	s.fundEscrow("a1", 1000)
+	agent, found := s.k.GetAgent(s.Ctx, "a1")
+	s.Require().True(found)
+	agent.PolicySet = types.PolicySet{
+		CoPolicies: []tee.Policy{validPolicyT(s.T(), "co1")},
+		Quorum: types.AttestationQuorum{
+			Required:          2,
+			TransferThreshold: sdk.NewCoins(sdk.NewInt64Coin(spendDenom, 100)),
+		},
+	}
+	s.Require().NoError(s.k.SetAgent(s.Ctx, agent))
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	// below the threshold one attestation pays
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 99, 0))
+	s.Require().NoError(err)
+
+	// at the threshold the co-policy must attest too
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 100, 1))
+	s.Require().ErrorIs(err, types.ErrQuorumNotMet)
+
+	msg := s.transferMsg("a1", recipient, 100, 1)
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 1, Token: msg.Token + "#1"}}
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(int64(199), s.recipientBalance(recipient).Int64())
+}
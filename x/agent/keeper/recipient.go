@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+// GetRecipientPolicy returns the agent's recipient policy; a missing entry is
+// the zero (unrestricted) policy.
+func (k Keeper) GetRecipientPolicy(ctx sdk.Context, agentID string) types.RecipientPolicy {
+	p, err := k.recipientPolicies.Get(ctx, agentID)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RecipientPolicy{}
+		}
+		panic(err)
+	}
+	return p
+}
+
+// getRecipientUsage returns the recipient's usage record; a missing entry is
+// an empty record.
+func (k Keeper) getRecipientUsage(ctx sdk.Context, agentID, recipient string) types.RecipientUsage {
+	u, err := k.recipientUsage.Get(ctx, collections.Join(agentID, recipient))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.RecipientUsage{AgentId: agentID, Recipient: recipient}
+		}
+		panic(err)
+	}
+	return u
+}
+
+// setRecipientPolicy replaces the agent's policy and drops its usage records:
+// as with the spend policy, the old window bookkeeping is meaningless under a
+// new cap. The zero policy removes the entry.
+func (k Keeper) setRecipientPolicy(ctx sdk.Context, agentID string, policy types.RecipientPolicy) error {
+	rng := collections.NewPrefixedPairRange[string, string](agentID)
+	if err := k.recipientUsage.Clear(ctx, rng); err != nil {
+		return errorsmod.Wrap(err, "clear recipient usage")
+	}
+	if policy.IsEmpty() {
+		return k.recipientPolicies.Remove(ctx, agentID)
+	}
+	return k.recipientPolicies.Set(ctx, agentID, policy)
+}
+
+// checkRecipient enforces the agent's recipient policy for paying amount to
+// recipient at height. It returns the usage record to persist once the
+// payout succeeds, or nil when the policy caps nothing.
+func (k Keeper) checkRecipient(ctx sdk.Context, agentID, recipient string, amount sdk.Coin, height uint64) (*types.RecipientUsage, error) {
+	policy := k.GetRecipientPolicy(ctx, agentID)
+	if policy.IsEmpty() {
+		return nil, nil
+	}
+	if !policy.Allows(recipient) {
+		return nil, errorsmod.Wrapf(types.ErrRecipientNotAllowed, "not on allowlist: %s", recipient)
+	}
+	if policy.DymNameResolvedOnly {
+		names, err := k.dymnsKeeper.ReverseResolveDymNameAddress(ctx, recipient, ctx.ChainID())
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "reverse resolve dym name")
+		}
+		if len(names) == 0 {
+			return nil, errorsmod.Wrapf(types.ErrRecipientNotAllowed, "no Dym-Name resolves to: %s", recipient)
+		}
+	}
+	if policy.CapWindowBlocks == 0 {
+		return nil, nil
+	}
+	usage := k.getRecipientUsage(ctx, agentID, recipient)
+	if !usage.CapAllows(policy, height, amount) {
+		return nil, errorsmod.Wrapf(types.ErrRecipientCapExceeded, "recipient %s amount %s received %s", recipient, amount, usage.CurrentReceived(policy, height))
+	}
+	usage.RecordReceived(policy, height, amount)
+	return &usage, nil
+}
+
+func (k msgServer) UpdateAgentRecipientPolicy(goCtx context.Context, msg *types.MsgUpdateAgentRecipientPolicy) (*types.MsgUpdateAgentRecipientPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	agent, found := k.GetAgent(ctx, msg.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, msg.AgentId)
+	}
+	if agent.Owner != msg.Owner {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not the agent owner")
+	}
+
+	// Like the spend policy, this is the owner's own guardrail over its own
+	// escrow, so it is deliberately not timelocked.
+	if err := k.setRecipientPolicy(ctx, msg.AgentId, msg.Policy); err != nil {
+		return nil, errorsmod.Wrap(err, "set recipient policy")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUpdateAgentRecipientPolicy{
+		AgentId: msg.AgentId,
+		Policy:  msg.Policy,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAgentRecipientPolicyResponse{}, nil
+}
@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	dymnstypes "github.com/dymensionxyz/dymension/v3/x/dymns/types"
+)
+
+// fakeDymNS reverse-resolves only the addresses in resolved, standing in for
+// registering and configuring real Dym-Names.
+type fakeDymNS struct {
+	resolved map[string]bool
+}
+
+func (f *fakeDymNS) ReverseResolveDymNameAddress(_ sdk.Context, inputAddress, _ string) (dymnstypes.ReverseResolvedDymNameAddresses, error) {
+	if f.resolved[inputAddress] {
+		return dymnstypes.ReverseResolvedDymNameAddresses{{SubName: "", Name: "agent-payee", ChainIdOrAlias: "dym"}}, nil
+	}
+	return nil, nil
+}
+
+func (s *EscrowTestSuite) setRecipientPolicy(id string, owner sdk.AccAddress, policy types.RecipientPolicy) {
+	_, err := s.msgServer.UpdateAgentRecipientPolicy(s.Ctx, types.NewMsgUpdateAgentRecipientPolicy(owner.String(), id, policy))
+	s.Require().NoError(err)
+}
+
+func (s *EscrowTestSuite) TestRecipientPolicy_Allowlist() {
+	owner := s.spendingAgent("a1")
+	s.fundEscrow("a1", 500)
+	_, _, allowed := testdata.KeyTestPubAddr()
+	_, _, other := testdata.KeyTestPubAddr()
+	s.setRecipientPolicy("a1", owner, types.RecipientPolicy{Allowlist: []string{allowed.String()}})
+
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", other, 100, 0))
+	s.Require().ErrorIs(err, types.ErrRecipientNotAllowed)
+	s.Require().Equal(math.NewInt(500), s.k.GetEscrowBalance(s.Ctx, "a1").AmountOf(spendDenom))
+
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", allowed, 100, 0))
+	s.Require().NoError(err)
+
+	// removing the policy lifts the restriction
+	s.setRecipientPolicy("a1", owner, types.RecipientPolicy{})
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", other, 100, 1))
+	s.Require().NoError(err)
+}
+
+func (s *EscrowTestSuite) TestRecipientPolicy_PerRecipientCap() {
+	owner := s.spendingAgent("a1")
+	s.fundEscrow("a1", 900)
+	_, _, r1 := testdata.KeyTestPubAddr()
+	_, _, r2 := testdata.KeyTestPubAddr()
+	s.setRecipientPolicy("a1", owner, types.RecipientPolicy{
+		PerRecipientCap: sdk.NewCoins(sdk.NewCoin(spendDenom, math.NewInt(150))),
+		CapWindowBlocks: 20,
+	})
+	s.Ctx = s.Ctx.WithBlockHeight(20)
+
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", r1, 100, 0))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", r1, 51, 1))
+	s.Require().ErrorIs(err, types.ErrRecipientCapExceeded)
+
+	// the cap is per recipient
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", r2, 150, 1))
+	s.Require().NoError(err)
+
+	res, err := s.k.RecipientPolicy(s.Ctx, &types.QueryRecipientPolicyRequest{AgentId: "a1"})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(20), res.Policy.CapWindowBlocks)
+	s.Require().Len(res.Usages, 2)
+	received := map[string]math.Int{}
+	for _, u := range res.Usages {
+		received[u.Recipient] = u.Received.AmountOf(spendDenom)
+	}
+	s.Require().Equal(math.NewInt(100), received[r1.String()])
+	s.Require().Equal(math.NewInt(150), received[r2.String()])
+
+	// next cap window: r1 can receive again and the query reports a fresh window
+	s.Ctx = s.Ctx.WithBlockHeight(40)
+	res, err = s.k.RecipientPolicy(s.Ctx, &types.QueryRecipientPolicyRequest{AgentId: "a1"})
+	s.Require().NoError(err)
+	for _, u := range res.Usages {
+		s.Require().True(u.Received.IsZero())
+	}
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", r1, 150, 2))
+	s.Require().NoError(err)
+}
+
+func (s *EscrowTestSuite) TestRecipientPolicy_DymNameResolvedOnly() {
+	owner := s.spendingAgent("a1")
+	s.fundEscrow("a1", 500)
+	_, _, named := testdata.KeyTestPubAddr()
+	_, _, anon := testdata.KeyTestPubAddr()
+	s.dymns.resolved = map[string]bool{named.String(): true}
+	s.setRecipientPolicy("a1", owner, types.RecipientPolicy{DymNameResolvedOnly: true})
+
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", anon, 100, 0))
+	s.Require().ErrorIs(err, types.ErrRecipientNotAllowed)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", named, 100, 0))
+	s.Require().NoError(err)
+}
+
+func (s *EscrowTestSuite) TestRecipientPolicy_OwnerOnlyAndResetsUsage() {
+	owner := s.spendingAgent("a1")
+	s.fundEscrow("a1", 500)
+	_, _, r := testdata.KeyTestPubAddr()
+	policy := types.RecipientPolicy{
+		PerRecipientCap: sdk.NewCoins(sdk.NewCoin(spendDenom, math.NewInt(100))),
+		CapWindowBlocks: 50,
+	}
+
+	_, _, other := testdata.KeyTestPubAddr()
+	_, err := s.msgServer.UpdateAgentRecipientPolicy(s.Ctx, types.NewMsgUpdateAgentRecipientPolicy(other.String(), "a1", policy))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	s.setRecipientPolicy("a1", owner, policy)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", r, 100, 0))
+	s.Require().NoError(err)
+
+	s.setRecipientPolicy("a1", owner, policy)
+	res, err := s.k.RecipientPolicy(s.Ctx, &types.QueryRecipientPolicyRequest{AgentId: "a1"})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Usages)
+}
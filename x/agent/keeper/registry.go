@@ -18,29 +18,36 @@ func (k msgServer) RegisterAgent(goCtx context.Context, msg *types.MsgRegisterAg
 		return nil, errorsmod.Wrap(types.ErrAgentExists, msg.AgentId)
 	}
 
-	fp, err := k.fingerprintNotRevoked(ctx, msg.Policy)
+	policy, policyID, err := k.resolvePolicyArg(ctx, msg.Policy, msg.PolicyId)
 	if err != nil {
 		return nil, err
 	}
-
-	// charge the registration fee: send to module then burn (mirrors rollapp app registration)
-	fee, err := k.AgentRegistrationFee(ctx)
+	fp, err := k.fingerprintNotRevoked(ctx, policy)
 	if err != nil {
 		return nil, err
 	}
-	owner := sdk.MustAccAddressFromBech32(msg.Owner)
-	coins := sdk.NewCoins(fee)
-	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
-		return nil, errorsmod.Wrap(types.ErrRegistrationFeePayment, err.Error())
+	if err := k.coPoliciesNotRevoked(ctx, msg.PolicySet); err != nil {
+		return nil, err
 	}
-	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
-		return nil, errorsmod.Wrap(types.ErrRegistrationFeePayment, err.Error())
+	// ValidateBasic could not check a policy it only knew by id
+	listed, err := msg.PolicySet.Includes(policy)
+	if err != nil {
+		return nil, err
+	}
+	if listed {
+		return nil, errorsmod.Wrap(types.ErrInvalidPolicy, "primary policy listed as co-policy")
+	}
+
+	if err := k.chargeRegistrationFee(ctx, sdk.MustAccAddressFromBech32(msg.Owner)); err != nil {
+		return nil, err
 	}
 
 	agent := types.Agent{
 		Id:        msg.AgentId,
 		Owner:     msg.Owner,
-		Policy:    msg.Policy,
+		Policy:    policy,
+		PolicyId:  policyID,
+		PolicySet: msg.PolicySet,
 		Active:    true,
 		ActionSeq: 0,
 	}
@@ -59,6 +66,23 @@ func (k msgServer) RegisterAgent(goCtx context.Context, msg *types.MsgRegisterAg
 	return &types.MsgRegisterAgentResponse{}, nil
 }
 
+// chargeRegistrationFee charges payer the agent registration fee: send to
+// module then burn (mirrors rollapp app registration).
+func (k Keeper) chargeRegistrationFee(ctx sdk.Context, payer sdk.AccAddress) error {
+	fee, err := k.AgentRegistrationFee(ctx)
+	if err != nil {
+		return err
+	}
+	coins := sdk.NewCoins(fee)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(types.ErrRegistrationFeePayment, err.Error())
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return errorsmod.Wrap(types.ErrRegistrationFeePayment, err.Error())
+	}
+	return nil
+}
+
 func (k msgServer) DeactivateAgent(goCtx context.Context, msg *types.MsgDeactivateAgent) (*types.MsgDeactivateAgentResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -70,15 +94,8 @@ func (k msgServer) DeactivateAgent(goCtx context.Context, msg *types.MsgDeactiva
 		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not the agent owner")
 	}
 
-	agent.Active = false
-	if err := k.SetAgent(ctx, agent); err != nil {
-		return nil, err
-	}
-
-	if err := uevent.EmitTypedEvent(ctx, &types.EventDeactivateAgent{
-		AgentId: agent.Id,
-		Owner:   agent.Owner,
-	}); err != nil {
+	// Deactivation cascades: a sub-agent never outlives its parent.
+	if err := k.deactivateAgentTree(ctx, agent); err != nil {
 		return nil, err
 	}
 
@@ -103,11 +120,25 @@ func (k msgServer) UpdateAgentPolicy(goCtx context.Context, msg *types.MsgUpdate
 		return nil, gerrc.ErrFailedPrecondition.Wrap("agent is not active")
 	}
 
+	newPolicy, newPolicyID, err := k.resolvePolicyArg(ctx, msg.NewPolicy, msg.NewPolicyId)
+	if err != nil {
+		return nil, err
+	}
 	// No point scheduling a rotation to a known-bad image. The submit-time
 	// check remains the backstop for policies revoked after scheduling.
-	if _, err := k.fingerprintNotRevoked(ctx, msg.NewPolicy); err != nil {
+	if _, err := k.fingerprintNotRevoked(ctx, newPolicy); err != nil {
 		return nil, err
 	}
+	// a co-policy promoted to primary would count twice toward the quorum
+	for _, set := range agent.PolicySets() {
+		listed, err := set.Includes(newPolicy)
+		if err != nil {
+			return nil, err
+		}
+		if listed {
+			return nil, errorsmod.Wrap(types.ErrInvalidPolicy, "new policy is a co-policy of the agent")
+		}
+	}
 
 	delay, err := k.PolicyRotationDelayBlocks(ctx)
 	if err != nil {
@@ -116,7 +147,8 @@ func (k msgServer) UpdateAgentPolicy(goCtx context.Context, msg *types.MsgUpdate
 
 	// Re-proposing overwrites any existing pending rotation and restarts the
 	// timelock, doubling as the cancel/replace path.
-	agent.PendingPolicy = &msg.NewPolicy
+	agent.PendingPolicy = &newPolicy
+	agent.PendingPolicyId = newPolicyID
 	agent.PendingPolicyHeight = ctx.BlockHeight() + int64(delay) //nolint:gosec // delay is a small governance param, no realistic overflow
 	if err := k.SetAgent(ctx, agent); err != nil {
 		return nil, err
@@ -131,3 +163,78 @@ func (k msgServer) UpdateAgentPolicy(goCtx context.Context, msg *types.MsgUpdate
 
 	return &types.MsgUpdateAgentPolicyResponse{ActivationHeight: agent.PendingPolicyHeight}, nil
 }
+
+// coPoliciesNotRevoked returns ErrFailedPrecondition if any co-policy of set is on
+// the denylist.
+func (k Keeper) coPoliciesNotRevoked(ctx sdk.Context, set types.PolicySet) error {
+	for i, p := range set.CoPolicies {
+		if _, err := k.fingerprintNotRevoked(ctx, p); err != nil {
+			return errorsmod.Wrapf(err, "co-policy %d", i+1)
+		}
+	}
+	return nil
+}
+
+// UpdateAgentPolicySet schedules a timelocked replacement of the agent's
+// co-policies and quorum. Lowering the quorum and adding a co-policy both
+// weaken the agent's trust assumptions, so every update waits out the same
+// delay as a primary policy rotation.
+func (k msgServer) UpdateAgentPolicySet(goCtx context.Context, msg *types.MsgUpdateAgentPolicySet) (*types.MsgUpdateAgentPolicySetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	agent, found := k.GetAgent(ctx, msg.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, msg.AgentId)
+	}
+	if agent.Owner != msg.Owner {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not the agent owner")
+	}
+	if !agent.Active {
+		return nil, gerrc.ErrFailedPrecondition.Wrap("agent is not active")
+	}
+
+	if err := k.coPoliciesNotRevoked(ctx, msg.PolicySet); err != nil {
+		return nil, err
+	}
+	for _, primary := range agent.PrimaryPolicies() {
+		listed, err := msg.PolicySet.Includes(primary)
+		if err != nil {
+			return nil, err
+		}
+		if listed {
+			return nil, errorsmod.Wrap(types.ErrInvalidPolicy, "primary policy listed as co-policy")
+		}
+	}
+	fps, err := msg.PolicySet.CoFingerprints()
+	if err != nil {
+		return nil, err
+	}
+
+	delay, err := k.PolicyRotationDelayBlocks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// As with policy rotation, re-proposing overwrites any pending update and
+	// restarts the timelock.
+	agent.PendingPolicySet = &msg.PolicySet
+	agent.PendingPolicySetHeight = ctx.BlockHeight() + int64(delay) //nolint:gosec // delay is a small governance param, no realistic overflow
+	if err := k.SetAgent(ctx, agent); err != nil {
+		return nil, err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUpdateAgentPolicySet{
+		AgentId:          agent.Id,
+		ActivationHeight: agent.PendingPolicySetHeight,
+		CoFingerprints:   fps,
+		Quorum:           msg.PolicySet.Quorum,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAgentPolicySetResponse{ActivationHeight: agent.PendingPolicySetHeight}, nil
+}
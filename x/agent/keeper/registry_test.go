@@ -9,15 +9,19 @@ import (
 	"encoding/pem"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	"github.com/dymensionxyz/dymension/v3/x/agent/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
 	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
 type RegistryTestSuite struct {
@@ -120,3 +124,53 @@ func (s *RegistryTestSuite) TestDeactivateAgent_OwnerSucceeds() {
 	s.Require().True(found)
 	s.Require().False(agent.Active)
 }
+
+func (s *RegistryTestSuite) TestRegisterAgent_ByRegisteredPolicy() {
+	owner := s.fundedOwner()
+	policy := validPolicy(s)
+	res, err := s.msgServer.RegisterPolicy(s.Ctx, types.NewMsgRegisterPolicy(owner.String(), policy))
+	s.Require().NoError(err)
+
+	msg := types.NewMsgRegisterAgent(owner.String(), "bound", tee.Policy{})
+	msg.PolicyId = res.Id
+	_, err = s.msgServer.RegisterAgent(s.Ctx, msg)
+	s.Require().NoError(err)
+
+	agent, found := s.App.AgentKeeper.GetAgent(s.Ctx, "bound")
+	s.Require().True(found)
+	s.Require().Equal(res.Id, agent.PolicyId)
+	s.Require().Equal(policy, agent.Policy)
+}
+
+func (s *RegistryTestSuite) TestRollappTeeConfig_ResolvesRegisteredPolicy() {
+	rollappMsgServer := rollappkeeper.NewMsgServerImpl(s.App.RollappKeeper)
+	setTeeConfig := func(id string) error {
+		params := s.App.RollappKeeper.GetParams(s.Ctx)
+		params.TeeConfig = rollapptypes.TEEConfig{Enabled: true, Verify: true, PolicyId: id, MaxAttestationAge: time.Minute}
+		_, err := rollappMsgServer.UpdateParams(s.Ctx, &rollapptypes.MsgUpdateParams{Authority: govAuthority, Params: params})
+		return err
+	}
+
+	// a lineage whose admin is not governance is refused
+	owner := s.fundedOwner()
+	res, err := s.msgServer.RegisterPolicy(s.Ctx, types.NewMsgRegisterPolicy(owner.String(), validPolicy(s)))
+	s.Require().NoError(err)
+	s.Require().ErrorIs(setTeeConfig(res.Id), gerrc.ErrPermissionDenied)
+
+	policy := validPolicy(s)
+	res, err = s.msgServer.RegisterPolicy(s.Ctx, types.NewMsgRegisterPolicy(govAuthority, policy))
+	s.Require().NoError(err)
+	s.Require().NoError(setTeeConfig(res.Id))
+
+	// resolves, then fails verification of the bogus token rather than lookup
+	err = s.App.RollappKeeper.ValidateAttestation(s.Ctx, "nonce", "token")
+	s.Require().Error(err)
+	s.Require().NotContains(err.Error(), "resolve registered policy")
+
+	fp, err := types.PolicyFingerprint(policy)
+	s.Require().NoError(err)
+	_, err = s.msgServer.RevokePolicy(s.Ctx, types.NewMsgRevokePolicy(govAuthority, fp, "cve"))
+	s.Require().NoError(err)
+	err = s.App.RollappKeeper.ValidateAttestation(s.Ctx, "nonce", "token")
+	s.Require().ErrorContains(err, "policy revoked")
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// consumedAttestationPruneLimit bounds the consumed attestations forgotten per
+// block. At most one token per policy is consumed per attested message, so
+// the backlog drains quickly.
+const consumedAttestationPruneLimit = 100
+
+// IsAttestationConsumed reports whether the attestation with the digest was
+// accepted and is still remembered.
+func (k Keeper) IsAttestationConsumed(ctx sdk.Context, digest []byte) (bool, error) {
+	return k.replay.IsConsumed(ctx, digest)
+}
+
+// PruneConsumedAttestations forgets consumed attestations old enough to fail
+// the attestation_max_age check on their own.
+func (k Keeper) PruneConsumedAttestations(ctx sdk.Context) error {
+	_, err := k.replay.Prune(ctx, consumedAttestationPruneLimit)
+	return errorsmod.Wrap(err, "prune consumed attestations")
+}
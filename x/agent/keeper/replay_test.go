@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+func TestSubmitAttestedAction_ReplayRejected(t *testing.T) {
+	ctx, k, _ := setup(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	seedOwnedAgent(t, ctx, k, "a1", owner(t), validPolicyT(t, "p"))
+
+	msg := validMsg(t, "a1", []byte("p"), 0)
+	_, err := ms.SubmitAttestedAction(ctx, msg)
+	require.NoError(t, err)
+	consumed, err := k.IsAttestationConsumed(ctx, tee.SignedDigest([]byte(msg.Token)))
+	require.NoError(t, err)
+	require.True(t, consumed)
+
+	// were the seq to re-derive the same nonce, the token is still spent
+	agent, _ := k.GetAgent(ctx, "a1")
+	agent.ActionSeq = 0
+	require.NoError(t, k.SetAgent(ctx, agent))
+	_, err = ms.SubmitAttestedAction(ctx, msg)
+	require.ErrorIs(t, err, gerrc.ErrAlreadyExists)
+}
+
+func TestSubmitAttestedAction_TokenCountsOnce(t *testing.T) {
+	ctx, k, _ := setup(t)
+	seedQuorumAgent(t, ctx, k, types.AttestationQuorum{Required: 2, Actions: true})
+	ms := keeper.NewMsgServerImpl(*k)
+
+	// one token cannot attest for two policies
+	msg := validMsg(t, "agent1", []byte("p"), 0)
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 1, Token: msg.Token}}
+	_, err := ms.SubmitAttestedAction(ctx, msg)
+	require.ErrorIs(t, err, gerrc.ErrAlreadyExists)
+}
+
+func TestSubmitAttestedAction_StaleTokenRejected(t *testing.T) {
+	ctx, k, v := setup(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	seedOwnedAgent(t, ctx, k, "a1", owner(t), validPolicyT(t, "p"))
+	ctx = ctx.WithBlockTime(time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC))
+
+	v.issuedAt = ctx.BlockTime().Add(-attestationMaxAge - time.Second)
+	_, err := ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 0))
+	require.ErrorContains(t, err, "attestation too old")
+
+	v.issuedAt = ctx.BlockTime().Add(-attestationMaxAge)
+	_, err = ms.SubmitAttestedAction(ctx, validMsg(t, "a1", []byte("p"), 0))
+	require.NoError(t, err)
+}
+
+func TestPruneConsumedAttestations(t *testing.T) {
+	ctx, k, v := setup(t)
+	ms := keeper.NewMsgServerImpl(*k)
+	seedOwnedAgent(t, ctx, k, "a1", owner(t), validPolicyT(t, "p"))
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	v.issuedAt = now.Add(-time.Minute)
+
+	msg := validMsg(t, "a1", []byte("p"), 0)
+	_, err := ms.SubmitAttestedAction(ctx, msg)
+	require.NoError(t, err)
+
+	// remembered while it could still pass the age check
+	require.NoError(t, k.PruneConsumedAttestations(ctx.WithBlockTime(v.issuedAt.Add(attestationMaxAge))))
+	consumed, err := k.IsAttestationConsumed(ctx, tee.SignedDigest([]byte(msg.Token)))
+	require.NoError(t, err)
+	require.True(t, consumed)
+
+	exported := keeper.ExportGenesis(ctx, k)
+	require.Equal(t, []tee.ConsumedAttestation{{
+		Digest: tee.SignedDigest([]byte(msg.Token)),
+		Expiry: v.issuedAt.Add(attestationMaxAge),
+	}}, exported.ConsumedAttestations)
+	require.NoError(t, tee.ValidateConsumedAttestations(exported.ConsumedAttestations))
+
+	require.NoError(t, k.PruneConsumedAttestations(ctx.WithBlockTime(v.issuedAt.Add(attestationMaxAge+time.Nanosecond))))
+	consumed, err = k.IsAttestationConsumed(ctx, tee.SignedDigest([]byte(msg.Token)))
+	require.NoError(t, err)
+	require.False(t, consumed)
+
+	// genesis restores the replay state
+	ctx2, k2, _ := setup(t)
+	keeper.InitGenesis(ctx2, k2, *exported)
+	consumed, err = k2.IsAttestationConsumed(ctx2, tee.SignedDigest([]byte(msg.Token)))
+	require.NoError(t, err)
+	require.True(t, consumed)
+}
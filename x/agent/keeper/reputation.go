@@ -2,9 +2,13 @@ package keeper
 
 import (
 	"errors"
+	"time"
 
 	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/osmosis-labs/osmosis/osmomath"
 
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
 )
@@ -42,3 +46,79 @@ func (k Keeper) GetReputation(ctx sdk.Context, agentID string) (types.Reputation
 	}
 	return rep, true
 }
+
+// maxDecayHalvings is where decay stops being computed: a feedback older
+// than this many half-lives weighs less than 2^-64 of its stake and is
+// dropped.
+const maxDecayHalvings = 64
+
+// stakeWeight is the client's bonded stake plus its locked coins in the bond
+// denom. Both take an unbonding or unlock period to move, so the same stake
+// cannot quickly be recycled across sybil accounts.
+func (k Keeper) stakeWeight(ctx sdk.Context, client sdk.AccAddress) (math.Int, error) {
+	bondDenom, err := k.stakingKeeper.BondDenom(ctx)
+	if err != nil {
+		return math.Int{}, errorsmod.Wrap(err, "bond denom")
+	}
+	bonded, err := k.stakingKeeper.GetDelegatorBonded(ctx, client)
+	if err != nil {
+		return math.Int{}, errorsmod.Wrap(err, "delegator bonded")
+	}
+	locked := k.lockupKeeper.GetAccountLockedCoins(ctx, client).AmountOf(bondDenom)
+	return bonded.Add(locked), nil
+}
+
+// decayFactor is 2^(-age/halfLife), or 1 when decay is disabled.
+func decayFactor(age, halfLife time.Duration) (math.LegacyDec, bool) {
+	if halfLife <= 0 || age <= 0 {
+		return math.LegacyOneDec(), true
+	}
+	halvings := osmomath.NewBigDec(int64(age)).QuoInt64(int64(halfLife))
+	if halvings.GT(osmomath.NewBigDec(maxDecayHalvings)) {
+		return math.LegacyDec{}, false
+	}
+	return osmomath.OneDec().Quo(osmomath.Exp2(halvings)).SDKDec(), true
+}
+
+// WeightedReputation returns the agent's stake-weighted, time-decayed average
+// score and the total decayed weight behind it. Each feedback weighs at most
+// its client's current stake, so stake which unbonded or unlocked since stops
+// counting. It walks the agent's feedback, so it is meant for queries rather
+// than transactions.
+func (k Keeper) WeightedReputation(ctx sdk.Context, agentID string) (score, totalWeight math.LegacyDec, err error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, errorsmod.Wrap(err, "get params")
+	}
+	weightedSum := math.LegacyZeroDec()
+	totalWeight = math.LegacyZeroDec()
+	rng := collections.NewPrefixedPairRange[string, string](agentID)
+	err = k.feedback.Walk(ctx, rng, func(_ collections.Pair[string, string], fb types.Feedback) (stop bool, err error) {
+		if fb.Weight.IsNil() || !fb.Weight.IsPositive() {
+			return false, nil
+		}
+		decay, ok := decayFactor(ctx.BlockTime().Sub(fb.Time), params.FeedbackDecayHalfLife)
+		if !ok {
+			return false, nil
+		}
+		client, err := sdk.AccAddressFromBech32(fb.Client)
+		if err != nil {
+			return true, errorsmod.Wrapf(err, "feedback client %s", fb.Client)
+		}
+		stake, err := k.stakeWeight(ctx, client)
+		if err != nil {
+			return true, errorsmod.Wrapf(err, "stake weight of %s", fb.Client)
+		}
+		w := decay.MulInt(math.MinInt(fb.Weight, stake))
+		totalWeight = totalWeight.Add(w)
+		weightedSum = weightedSum.Add(w.MulInt64(int64(fb.Score)))
+		return false, nil
+	})
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+	if totalWeight.IsZero() {
+		return math.LegacyZeroDec(), totalWeight, nil
+	}
+	return weightedSum.Quo(totalWeight), totalWeight, nil
+}
@@ -24,7 +24,12 @@ import (
 // is covered by ReputationFeeTestSuite).
 func setupReputation(t *testing.T) (sdk.Context, *keeper.Keeper, types.MsgServer, string) {
 	t.Helper()
-	ctx, k, _ := setup(t)
+	return setupReputationWithStake(t, &fakeStake{})
+}
+
+func setupReputationWithStake(t *testing.T, stake *fakeStake) (sdk.Context, *keeper.Keeper, types.MsgServer, string) {
+	t.Helper()
+	ctx, k, _ := setupWithStake(t, stake)
 	params := types.DefaultParams()
 	params.FeedbackFee = sdk.NewCoin(params.FeedbackFee.Denom, math.ZeroInt())
 	require.NoError(t, k.SetParams(ctx, params))
@@ -246,6 +251,85 @@ func TestAgentFeedbacksQueryScopedAndPaginated(t *testing.T) {
 	require.True(t, errors.Is(err, gerrc.ErrInvalidArgument))
 }
 
+// TestWeightedReputation_StakeAndDecay checks that zero-stake sybil clients
+// move only the raw score and that old feedback decays by the half-life.
+func TestWeightedReputation_StakeAndDecay(t *testing.T) {
+	whale, small := client(t), client(t)
+	stake := &fakeStake{bonded: map[string]math.Int{
+		whale: math.NewInt(300),
+		small: math.NewInt(100),
+	}}
+	ctx, k, ms, _ := setupReputationWithStake(t, stake)
+
+	_, err := ms.SubmitFeedback(ctx, feedbackMsg(whale, 9000))
+	require.NoError(t, err)
+	_, err = ms.SubmitFeedback(ctx, feedbackMsg(small, 1000))
+	require.NoError(t, err)
+	for range 5 {
+		_, err = ms.SubmitFeedback(ctx, feedbackMsg(client(t), 0))
+		require.NoError(t, err)
+	}
+
+	fb, found := k.GetFeedback(ctx, "agent1", whale)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(300), fb.Weight)
+
+	res, err := k.AgentReputation(ctx, &types.QueryAgentReputationRequest{AgentId: "agent1"})
+	require.NoError(t, err)
+	require.Equal(t, uint32(10000/7), res.AverageScore)
+	require.Equal(t, math.LegacyNewDec(7000), res.WeightedScore)
+	require.Equal(t, math.LegacyNewDec(400), res.TotalWeight)
+
+	// one half-life later the whale's feedback counts half; a resubmission
+	// is fresh
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultFeedbackDecayHalfLife))
+	_, err = ms.SubmitFeedback(ctx, feedbackMsg(small, 1000))
+	require.NoError(t, err)
+	res, err = k.AgentReputation(ctx, &types.QueryAgentReputationRequest{AgentId: "agent1"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(250), res.TotalWeight)
+	require.Equal(t, math.LegacyNewDec(5800), res.WeightedScore)
+
+	// feedback older than maxDecayHalvings half-lives is dropped
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(100 * types.DefaultFeedbackDecayHalfLife))
+	res, err = k.AgentReputation(ctx, &types.QueryAgentReputationRequest{AgentId: "agent1"})
+	require.NoError(t, err)
+	require.True(t, res.TotalWeight.IsZero())
+	require.True(t, res.WeightedScore.IsZero())
+}
+
+// TestWeightedReputation_CappedAtCurrentStake checks that stake moved away
+// after submitting stops weighting the feedback.
+func TestWeightedReputation_CappedAtCurrentStake(t *testing.T) {
+	whale, small := client(t), client(t)
+	stake := &fakeStake{bonded: map[string]math.Int{
+		whale: math.NewInt(300),
+		small: math.NewInt(100),
+	}}
+	ctx, k, ms, _ := setupReputationWithStake(t, stake)
+
+	_, err := ms.SubmitFeedback(ctx, feedbackMsg(whale, 9000))
+	require.NoError(t, err)
+	_, err = ms.SubmitFeedback(ctx, feedbackMsg(small, 1000))
+	require.NoError(t, err)
+
+	// the whale unbonds most of its stake; its recorded weight is unchanged
+	stake.bonded[whale] = math.NewInt(100)
+	fb, found := k.GetFeedback(ctx, "agent1", whale)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(300), fb.Weight)
+	res, err := k.AgentReputation(ctx, &types.QueryAgentReputationRequest{AgentId: "agent1"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(200), res.TotalWeight)
+	require.Equal(t, math.LegacyNewDec(5000), res.WeightedScore)
+
+	// new stake does not raise an old feedback's weight
+	stake.bonded[whale] = math.NewInt(900)
+	res, err = k.AgentReputation(ctx, &types.QueryAgentReputationRequest{AgentId: "agent1"})
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(400), res.TotalWeight)
+}
+
 func TestGenesisRoundTripWithFeedback(t *testing.T) {
 	ctx, k, ms, _ := setupReputation(t)
 
@@ -353,3 +437,54 @@ func (s *ReputationFeeTestSuite) TestSubmitFeedback_InsufficientFee() {
 	_, found := k.GetFeedback(s.Ctx, "agent1", cl.String())
 	s.Require().False(found)
 }
+
+// TestSubmitFeedback_Verified checks that verified feedback must reference an
+// action log entry the client submitted or was paid by, and is counted
+// separately.
+func (s *EscrowTestSuite) TestSubmitFeedback_Verified() {
+	s.spendingAgent("a1")
+	s.fundEscrow("a1", 1000)
+	_, _, recipient := testdata.KeyTestPubAddr()
+	transfer := s.transferMsg("a1", recipient, 100, 0)
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, transfer)
+	s.Require().NoError(err)
+
+	params, err := s.k.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	_, _, stranger := testdata.KeyTestPubAddr()
+	submitter := sdk.MustAccAddressFromBech32(transfer.Submitter)
+	for _, cl := range []sdk.AccAddress{recipient, submitter, stranger} {
+		s.FundAcc(cl, sdk.NewCoins(params.FeedbackFee.Add(params.FeedbackFee)))
+	}
+	verified := func(cl sdk.AccAddress, score uint32) *types.MsgSubmitFeedback {
+		msg := types.NewMsgSubmitFeedback(cl.String(), "a1", score, "liveness", "", 0)
+		msg.Verified = true
+		return msg
+	}
+
+	_, err = s.msgServer.SubmitFeedback(s.Ctx, verified(recipient, 9000))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitFeedback(s.Ctx, verified(submitter, 7000))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitFeedback(s.Ctx, verified(stranger, 0))
+	s.Require().ErrorIs(err, types.ErrInvalidEvidence)
+	_, err = s.msgServer.SubmitFeedback(s.Ctx, types.NewMsgSubmitFeedback(stranger.String(), "a1", 0, "liveness", "", 0))
+	s.Require().NoError(err)
+
+	fb, _ := s.k.GetFeedback(s.Ctx, "a1", recipient.String())
+	s.Require().True(fb.Verified)
+	res, err := s.k.AgentReputation(s.Ctx, &types.QueryAgentReputationRequest{AgentId: "a1"})
+	s.Require().NoError(err)
+	s.Require().Equal(types.Reputation{AgentId: "a1", Count: 3, ScoreSum: 16000, VerifiedCount: 2, VerifiedScoreSum: 16000}, res.Reputation)
+	s.Require().Equal(uint32(5333), res.AverageScore)
+	s.Require().Equal(uint32(8000), res.VerifiedAverageScore)
+
+	// overwriting with unverified feedback and revoking both drop the
+	// verified aggregate
+	_, err = s.msgServer.SubmitFeedback(s.Ctx, types.NewMsgSubmitFeedback(recipient.String(), "a1", 5000, "liveness", "", 0))
+	s.Require().NoError(err)
+	_, err = s.msgServer.RevokeFeedback(s.Ctx, types.NewMsgRevokeFeedback(submitter.String(), "a1"))
+	s.Require().NoError(err)
+	rep, _ := s.k.GetReputation(s.Ctx, "a1")
+	s.Require().Equal(types.Reputation{AgentId: "a1", Count: 2, ScoreSum: 5000}, rep)
+}
@@ -45,10 +45,14 @@ func (k Keeper) IsPolicyRevoked(ctx sdk.Context, fp string) (bool, error) {
 // policy check aligned with SubmitAttestedAction and the Agent query.
 func (k Keeper) IsAgentLive(ctx sdk.Context, agentID string) bool {
 	agent, err := k.agents.Get(ctx, agentID)
-	if err != nil || !agent.Active {
+	if err != nil || !agent.Active || k.notFrozen(ctx, agent) != nil {
 		return false
 	}
-	fp, err := types.PolicyFingerprint(agent.EffectivePolicy(ctx.BlockHeight()))
+	policy, err := k.effectivePolicy(ctx, agent)
+	if err != nil {
+		return false
+	}
+	fp, err := types.PolicyFingerprint(policy)
 	if err != nil {
 		return false
 	}
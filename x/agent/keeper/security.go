@@ -0,0 +1,308 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+const (
+	cancelReasonOwner  = "cancelled by owner"
+	cancelReasonFrozen = "agent frozen"
+)
+
+// GetPendingWithdrawal returns the pending withdrawal and whether it was found.
+func (k Keeper) GetPendingWithdrawal(ctx sdk.Context, agentID string, id uint64) (types.PendingWithdrawal, bool) {
+	w, err := k.pendingWithdrawals.Get(ctx, collections.Join(agentID, id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.PendingWithdrawal{}, false
+		}
+		panic(err)
+	}
+	return w, true
+}
+
+func (k Keeper) setPendingWithdrawal(ctx sdk.Context, w types.PendingWithdrawal) error {
+	if err := k.pendingWithdrawals.Set(ctx, collections.Join(w.AgentId, w.Id), w); err != nil {
+		return err
+	}
+	return k.withdrawalQueue.Set(ctx, collections.Join3(w.MatureHeight, w.AgentId, w.Id))
+}
+
+func (k Keeper) removePendingWithdrawal(ctx sdk.Context, w types.PendingWithdrawal) error {
+	if err := k.pendingWithdrawals.Remove(ctx, collections.Join(w.AgentId, w.Id)); err != nil {
+		return err
+	}
+	return k.withdrawalQueue.Remove(ctx, collections.Join3(w.MatureHeight, w.AgentId, w.Id))
+}
+
+// queueWithdrawal records a withdrawal the caller already debited from the
+// escrow ledger, payable at the end block of matureHeight.
+func (k Keeper) queueWithdrawal(ctx sdk.Context, agentID, owner string, amount sdk.Coins, matureHeight int64) (types.PendingWithdrawal, error) {
+	id, err := k.nextWithdrawalID.Next(ctx)
+	if err != nil {
+		return types.PendingWithdrawal{}, errorsmod.Wrap(err, "next withdrawal id")
+	}
+	w := types.PendingWithdrawal{
+		Id:           id,
+		AgentId:      agentID,
+		Owner:        owner,
+		Amount:       amount,
+		MatureHeight: matureHeight,
+	}
+	if err := k.setPendingWithdrawal(ctx, w); err != nil {
+		return types.PendingWithdrawal{}, errorsmod.Wrap(err, "set pending withdrawal")
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventQueueAgentWithdrawal{Withdrawal: w}); err != nil {
+		return types.PendingWithdrawal{}, err
+	}
+	return w, nil
+}
+
+// cancelWithdrawal drops a pending withdrawal and credits its amount back to
+// the agent's escrow.
+func (k Keeper) cancelWithdrawal(ctx sdk.Context, w types.PendingWithdrawal, reason string) error {
+	if err := k.removePendingWithdrawal(ctx, w); err != nil {
+		return errorsmod.Wrap(err, "remove pending withdrawal")
+	}
+	balance := k.GetEscrowBalance(ctx, w.AgentId).Add(w.Amount...)
+	if err := k.setEscrowBalance(ctx, w.AgentId, balance); err != nil {
+		return errorsmod.Wrap(err, "set escrow balance")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventCancelAgentWithdrawal{
+		Withdrawal: w,
+		Reason:     reason,
+	})
+}
+
+// AgentPendingWithdrawals returns the agent's pending withdrawals in id order.
+func (k Keeper) AgentPendingWithdrawals(ctx sdk.Context, agentID string) ([]types.PendingWithdrawal, error) {
+	var ws []types.PendingWithdrawal
+	rng := collections.NewPrefixedPairRange[string, uint64](agentID)
+	err := k.pendingWithdrawals.Walk(ctx, rng, func(_ collections.Pair[string, uint64], w types.PendingWithdrawal) (stop bool, err error) {
+		ws = append(ws, w)
+		return false, nil
+	})
+	return ws, err
+}
+
+// ProcessMatureWithdrawals pays out every pending withdrawal whose mature
+// height has been reached. A withdrawal of an agent that is frozen, itself or
+// through an ancestor, is cancelled back into the escrow, as is a payout that
+// fails, rather than halting the chain or being retried every block.
+func (k Keeper) ProcessMatureWithdrawals(ctx sdk.Context) error {
+	var mature []types.PendingWithdrawal
+	rng := collections.NewPrefixUntilTripleRange[int64, string, uint64](ctx.BlockHeight())
+	err := k.withdrawalQueue.Walk(ctx, rng, func(key collections.Triple[int64, string, uint64]) (stop bool, err error) {
+		w, found := k.GetPendingWithdrawal(ctx, key.K2(), key.K3())
+		if !found {
+			return true, errorsmod.Wrapf(types.ErrWithdrawalNotFound, "queued withdrawal %d of agent %s", key.K3(), key.K2())
+		}
+		mature = append(mature, w)
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "walk withdrawal queue")
+	}
+
+	for _, w := range mature {
+		if agent, found := k.GetAgent(ctx, w.AgentId); found {
+			if err := k.notFrozen(ctx, agent); err != nil {
+				if err := k.cancelWithdrawal(ctx, w, cancelReasonFrozen); err != nil {
+					return errorsmod.Wrapf(err, "cancel frozen withdrawal %d of agent %s", w.Id, w.AgentId)
+				}
+				continue
+			}
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			if err := k.removePendingWithdrawal(ctx, w); err != nil {
+				return errorsmod.Wrap(err, "remove pending withdrawal")
+			}
+			return k.payWithdrawal(ctx, w.AgentId, w.Owner, w.Amount)
+		})
+		if err == nil {
+			continue
+		}
+		k.Logger(ctx).Error("pay mature withdrawal", "agent", w.AgentId, "id", w.Id, "error", err)
+		if err := k.cancelWithdrawal(ctx, w, err.Error()); err != nil {
+			return errorsmod.Wrapf(err, "cancel failed withdrawal %d of agent %s", w.Id, w.AgentId)
+		}
+	}
+	return nil
+}
+
+// CancelAgentWithdrawal cancels one of the owner's pending withdrawals.
+func (k msgServer) CancelAgentWithdrawal(goCtx context.Context, msg *types.MsgCancelAgentWithdrawal) (*types.MsgCancelAgentWithdrawalResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	agent, found := k.GetAgent(ctx, msg.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, msg.AgentId)
+	}
+	if agent.Owner != msg.Owner {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not the agent owner")
+	}
+	w, found := k.GetPendingWithdrawal(ctx, msg.AgentId, msg.WithdrawalId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrWithdrawalNotFound, "agent %s id %d", msg.AgentId, msg.WithdrawalId)
+	}
+	if err := k.cancelWithdrawal(ctx, w, cancelReasonOwner); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelAgentWithdrawalResponse{}, nil
+}
+
+// UpdateAgentSecurityPolicy sets the agent's guardian and withdrawal delay.
+// Tightening updates apply immediately; loosening ones wait out the current
+// withdrawal delay, so a stolen owner key cannot disarm the guardian or the
+// timelock and withdraw before the guardian reacts. A new update supersedes
+// any pending one.
+func (k msgServer) UpdateAgentSecurityPolicy(goCtx context.Context, msg *types.MsgUpdateAgentSecurityPolicy) (*types.MsgUpdateAgentSecurityPolicyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	agent, found := k.GetAgent(ctx, msg.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, msg.AgentId)
+	}
+	if agent.Owner != msg.Owner {
+		return nil, errorsmod.Wrap(types.ErrUnauthorized, "not the agent owner")
+	}
+	if agent.Frozen {
+		return nil, errorsmod.Wrap(types.ErrAgentFrozen, msg.AgentId)
+	}
+
+	agent.PromotePendingSecurity(ctx.BlockHeight())
+	activation := ctx.BlockHeight()
+	if agent.Security.Tightens(msg.Policy) || agent.Security.WithdrawalDelayBlocks == 0 {
+		agent.Security = msg.Policy
+		agent.PendingSecurity = nil
+		agent.PendingSecurityHeight = 0
+	} else {
+		activation += int64(agent.Security.WithdrawalDelayBlocks) //nolint:gosec // bounded by MaxWithdrawalDelayBlocks
+		policy := msg.Policy
+		agent.PendingSecurity = &policy
+		agent.PendingSecurityHeight = activation
+	}
+	if err := k.SetAgent(ctx, agent); err != nil {
+		return nil, errorsmod.Wrap(err, "set agent")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUpdateAgentSecurityPolicy{
+		AgentId:          msg.AgentId,
+		Policy:           msg.Policy,
+		ActivationHeight: activation,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateAgentSecurityPolicyResponse{ActivationHeight: activation}, nil
+}
+
+// authorizeGuardian checks that signer may freeze or unfreeze the agent: its
+// guardian in force, or governance.
+func (k Keeper) authorizeGuardian(ctx sdk.Context, agent types.Agent, signer string) error {
+	if signer == k.authority {
+		return nil
+	}
+	guardian := agent.EffectiveSecurity(ctx.BlockHeight()).Guardian
+	if guardian == "" || guardian != signer {
+		return errorsmod.Wrap(types.ErrUnauthorized, "not the agent guardian")
+	}
+	return nil
+}
+
+// FreezeAgent blocks the agent's attested operations and withdrawals and
+// cancels its pending withdrawals, leaving the funds in escrow.
+func (k msgServer) FreezeAgent(goCtx context.Context, msg *types.MsgFreezeAgent) (*types.MsgFreezeAgentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	agent, found := k.GetAgent(ctx, msg.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, msg.AgentId)
+	}
+	if err := k.authorizeGuardian(ctx, agent, msg.Signer); err != nil {
+		return nil, err
+	}
+	if agent.Frozen {
+		return nil, errorsmod.Wrap(types.ErrAgentFrozen, msg.AgentId)
+	}
+
+	pending, err := k.AgentPendingWithdrawals(ctx, msg.AgentId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "pending withdrawals")
+	}
+	for _, w := range pending {
+		if err := k.cancelWithdrawal(ctx, w, cancelReasonFrozen); err != nil {
+			return nil, err
+		}
+	}
+
+	agent.Frozen = true
+	if err := k.SetAgent(ctx, agent); err != nil {
+		return nil, errorsmod.Wrap(err, "set agent")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFreezeAgent{
+		AgentId: msg.AgentId,
+		Signer:  msg.Signer,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeAgentResponse{}, nil
+}
+
+func (k msgServer) UnfreezeAgent(goCtx context.Context, msg *types.MsgUnfreezeAgent) (*types.MsgUnfreezeAgentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	agent, found := k.GetAgent(ctx, msg.AgentId)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrAgentNotFound, msg.AgentId)
+	}
+	if err := k.authorizeGuardian(ctx, agent, msg.Signer); err != nil {
+		return nil, err
+	}
+	if !agent.Frozen {
+		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "agent is not frozen: %s", msg.AgentId)
+	}
+
+	agent.Frozen = false
+	if err := k.SetAgent(ctx, agent); err != nil {
+		return nil, errorsmod.Wrap(err, "set agent")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUnfreezeAgent{
+		AgentId: msg.AgentId,
+		Signer:  msg.Signer,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeAgentResponse{}, nil
+}
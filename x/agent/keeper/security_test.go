@@ -0,0 +1,175 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+const withdrawalDelay = 20
+
+func coinsOf(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewCoin(spendDenom, math.NewInt(amt)))
+}
+
+// guardedAgent registers a funded spending agent with a guardian and a
+// withdrawal delay.
+func (s *EscrowTestSuite) guardedAgent(id string) (owner, guardian sdk.AccAddress) {
+	owner = s.spendingAgent(id)
+	s.fundEscrow(id, 1000)
+	_, _, guardian = testdata.KeyTestPubAddr()
+	_, err := s.msgServer.UpdateAgentSecurityPolicy(s.Ctx, types.NewMsgUpdateAgentSecurityPolicy(owner.String(), id, types.SecurityPolicy{
+		Guardian:              guardian.String(),
+		WithdrawalDelayBlocks: withdrawalDelay,
+	}))
+	s.Require().NoError(err)
+	return owner, guardian
+}
+
+func (s *EscrowTestSuite) endBlockAt(height int64) {
+	s.Ctx = s.Ctx.WithBlockHeight(height)
+	s.Require().NoError(s.k.ProcessMatureWithdrawals(s.Ctx))
+}
+
+func (s *EscrowTestSuite) TestWithdraw_TimelockedPaidInEndBlock() {
+	owner, _ := s.guardedAgent("a1")
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+	before := s.App.BankKeeper.GetBalance(s.Ctx, owner, spendDenom).Amount
+
+	res, err := s.msgServer.WithdrawAgentEscrow(s.Ctx, types.NewMsgWithdrawAgentEscrow(owner.String(), "a1", coinsOf(400)))
+	s.Require().NoError(err)
+	s.Require().NotNil(res.Pending)
+	s.Require().Equal(int64(100+withdrawalDelay), res.Pending.MatureHeight)
+
+	// debited from the ledger at once, so transfers cannot spend it meanwhile
+	s.Require().Equal(math.NewInt(600), s.k.GetEscrowBalance(s.Ctx, "a1").AmountOf(spendDenom))
+	s.Require().Equal(before, s.App.BankKeeper.GetBalance(s.Ctx, owner, spendDenom).Amount)
+	msg, broken := keeper.AllInvariants(*s.k)(s.Ctx)
+	s.Require().False(broken, msg)
+
+	q, err := s.k.PendingWithdrawals(s.Ctx, &types.QueryPendingWithdrawalsRequest{AgentId: "a1"})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.PendingWithdrawal{*res.Pending}, q.Withdrawals)
+
+	s.endBlockAt(100 + withdrawalDelay - 1)
+	s.Require().Equal(before, s.App.BankKeeper.GetBalance(s.Ctx, owner, spendDenom).Amount)
+
+	s.endBlockAt(100 + withdrawalDelay)
+	s.Require().Equal(before.AddRaw(400), s.App.BankKeeper.GetBalance(s.Ctx, owner, spendDenom).Amount)
+	_, found := s.k.GetPendingWithdrawal(s.Ctx, "a1", res.Pending.Id)
+	s.Require().False(found)
+	s.Require().Equal(math.NewInt(600), s.moduleBalance())
+}
+
+func (s *EscrowTestSuite) TestWithdraw_OwnerCancels() {
+	owner, _ := s.guardedAgent("a1")
+	res, err := s.msgServer.WithdrawAgentEscrow(s.Ctx, types.NewMsgWithdrawAgentEscrow(owner.String(), "a1", coinsOf(400)))
+	s.Require().NoError(err)
+
+	_, _, other := testdata.KeyTestPubAddr()
+	_, err = s.msgServer.CancelAgentWithdrawal(s.Ctx, types.NewMsgCancelAgentWithdrawal(other.String(), "a1", res.Pending.Id))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = s.msgServer.CancelAgentWithdrawal(s.Ctx, types.NewMsgCancelAgentWithdrawal(owner.String(), "a1", res.Pending.Id))
+	s.Require().NoError(err)
+	s.Require().Equal(math.NewInt(1000), s.k.GetEscrowBalance(s.Ctx, "a1").AmountOf(spendDenom))
+
+	_, err = s.msgServer.CancelAgentWithdrawal(s.Ctx, types.NewMsgCancelAgentWithdrawal(owner.String(), "a1", res.Pending.Id))
+	s.Require().ErrorIs(err, types.ErrWithdrawalNotFound)
+}
+
+// TestFreeze_BlocksAgentAndCancelsWithdrawals covers the compromised-owner
+// scenario: the attacker queues a withdrawal, the guardian freezes the agent
+// and the funds stay in escrow.
+func (s *EscrowTestSuite) TestFreeze_BlocksAgentAndCancelsWithdrawals() {
+	owner, guardian := s.guardedAgent("a1")
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+	_, err := s.msgServer.WithdrawAgentEscrow(s.Ctx, types.NewMsgWithdrawAgentEscrow(owner.String(), "a1", coinsOf(400)))
+	s.Require().NoError(err)
+
+	// only the guardian (or governance) may freeze
+	_, err = s.msgServer.FreezeAgent(s.Ctx, types.NewMsgFreezeAgent(owner.String(), "a1"))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = s.msgServer.FreezeAgent(s.Ctx, types.NewMsgFreezeAgent(guardian.String(), "a1"))
+	s.Require().NoError(err)
+	s.Require().False(s.k.IsAgentLive(s.Ctx, "a1"))
+
+	s.Require().Equal(math.NewInt(1000), s.k.GetEscrowBalance(s.Ctx, "a1").AmountOf(spendDenom))
+	ws, err := s.k.AgentPendingWithdrawals(s.Ctx, "a1")
+	s.Require().NoError(err)
+	s.Require().Empty(ws)
+
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 1, 0))
+	s.Require().ErrorIs(err, types.ErrAgentFrozen)
+	_, err = s.msgServer.WithdrawAgentEscrow(s.Ctx, types.NewMsgWithdrawAgentEscrow(owner.String(), "a1", coinsOf(1)))
+	s.Require().ErrorIs(err, types.ErrAgentFrozen)
+	_, err = s.msgServer.UpdateAgentSecurityPolicy(s.Ctx, types.NewMsgUpdateAgentSecurityPolicy(owner.String(), "a1", types.SecurityPolicy{}))
+	s.Require().ErrorIs(err, types.ErrAgentFrozen)
+
+	s.endBlockAt(100 + withdrawalDelay)
+	s.Require().Equal(math.NewInt(1000), s.moduleBalance())
+
+	// the owner cannot lift the freeze; governance can
+	_, err = s.msgServer.UnfreezeAgent(s.Ctx, types.NewMsgUnfreezeAgent(owner.String(), "a1"))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+	gov := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	_, err = s.msgServer.UnfreezeAgent(s.Ctx, types.NewMsgUnfreezeAgent(gov, "a1"))
+	s.Require().NoError(err)
+	s.Require().True(s.k.IsAgentLive(s.Ctx, "a1"))
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("a1", recipient, 1, 0))
+	s.Require().NoError(err)
+}
+
+// TestUpdateSecurityPolicy_LooseningIsTimelocked checks that a stolen owner key
+// cannot immediately drop the guardian or shorten the delay.
+func (s *EscrowTestSuite) TestUpdateSecurityPolicy_LooseningIsTimelocked() {
+	owner, guardian := s.guardedAgent("a1")
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+
+	// lengthening the delay applies at once
+	res, err := s.msgServer.UpdateAgentSecurityPolicy(s.Ctx, types.NewMsgUpdateAgentSecurityPolicy(owner.String(), "a1", types.SecurityPolicy{
+		Guardian:              guardian.String(),
+		WithdrawalDelayBlocks: 2 * withdrawalDelay,
+	}))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100), res.ActivationHeight)
+
+	// removing the guardian waits out the current delay
+	res, err = s.msgServer.UpdateAgentSecurityPolicy(s.Ctx, types.NewMsgUpdateAgentSecurityPolicy(owner.String(), "a1", types.SecurityPolicy{}))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(100+2*withdrawalDelay), res.ActivationHeight)
+
+	agent, _ := s.k.GetAgent(s.Ctx, "a1")
+	s.Require().Equal(guardian.String(), agent.EffectiveSecurity(s.Ctx.BlockHeight()).Guardian)
+
+	// the guardian still in force can freeze before the update lands
+	_, err = s.msgServer.FreezeAgent(s.Ctx.WithBlockHeight(100+2*withdrawalDelay-1), types.NewMsgFreezeAgent(guardian.String(), "a1"))
+	s.Require().NoError(err)
+
+	// the guardian cannot be the owner
+	_, err = s.msgServer.UpdateAgentSecurityPolicy(s.Ctx, types.NewMsgUpdateAgentSecurityPolicy(owner.String(), "a1", types.SecurityPolicy{Guardian: owner.String()}))
+	s.Require().Error(err)
+}
+
+func (s *EscrowTestSuite) TestUpdateSecurityPolicy_PendingTakesEffect() {
+	owner, guardian := s.guardedAgent("a1")
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+
+	_, err := s.msgServer.UpdateAgentSecurityPolicy(s.Ctx, types.NewMsgUpdateAgentSecurityPolicy(owner.String(), "a1", types.SecurityPolicy{}))
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithBlockHeight(100 + withdrawalDelay)
+	_, err = s.msgServer.FreezeAgent(s.Ctx, types.NewMsgFreezeAgent(guardian.String(), "a1"))
+	s.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	// no delay any more: withdrawals are paid out immediately
+	res, err := s.msgServer.WithdrawAgentEscrow(s.Ctx, types.NewMsgWithdrawAgentEscrow(owner.String(), "a1", coinsOf(100)))
+	s.Require().NoError(err)
+	s.Require().Nil(res.Pending)
+}
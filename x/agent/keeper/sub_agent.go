@@ -0,0 +1,307 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+// SubAgentIDs returns the ids of the agent's direct sub-agents in id order.
+func (k Keeper) SubAgentIDs(ctx sdk.Context, parentID string) ([]string, error) {
+	var ids []string
+	rng := collections.NewPrefixedPairRange[string, string](parentID)
+	err := k.subAgents.Walk(ctx, rng, func(key collections.Pair[string, string]) (stop bool, err error) {
+		ids = append(ids, key.K2())
+		return false, nil
+	})
+	return ids, err
+}
+
+// getSubAgents returns the agent's direct sub-agents in id order.
+func (k Keeper) getSubAgents(ctx sdk.Context, parentID string) ([]types.Agent, error) {
+	ids, err := k.SubAgentIDs(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	children := make([]types.Agent, 0, len(ids))
+	for _, id := range ids {
+		child, found := k.GetAgent(ctx, id)
+		if !found {
+			return nil, gerrc.ErrInternal.Wrapf("indexed sub-agent %s of %s not found", id, parentID)
+		}
+		children = append(children, child)
+	}
+	return children, nil
+}
+
+// ancestors returns the agent's parent, its parent's parent and so on up to
+// the root agent.
+func (k Keeper) ancestors(ctx sdk.Context, agent types.Agent) ([]types.Agent, error) {
+	var as []types.Agent
+	for agent.IsSubAgent() {
+		parent, found := k.GetAgent(ctx, agent.ParentId)
+		if !found {
+			return nil, gerrc.ErrInternal.Wrapf("parent %s of agent %s not found", agent.ParentId, agent.Id)
+		}
+		as = append(as, parent)
+		agent = parent
+	}
+	return as, nil
+}
+
+// notFrozen returns ErrAgentFrozen if the agent or any of its ancestors is
+// frozen: freezing an agent freezes its whole subtree, so a guardian can stop
+// a compromised tree from the root.
+func (k Keeper) notFrozen(ctx sdk.Context, agent types.Agent) error {
+	if agent.Frozen {
+		return errorsmod.Wrap(types.ErrAgentFrozen, agent.Id)
+	}
+	ancestors, err := k.ancestors(ctx, agent)
+	if err != nil {
+		return err
+	}
+	for _, a := range ancestors {
+		if a.Frozen {
+			return errorsmod.Wrapf(types.ErrAgentFrozen, "ancestor %s of %s", a.Id, agent.Id)
+		}
+	}
+	return nil
+}
+
+// chargeAncestors accounts a sub-agent's spend of amount in denom against
+// the budget of every ancestor, so an ancestor's limit bounds the spend of
+// its whole subtree. An ancestor without a budget for denom blocks the spend.
+func (k Keeper) chargeAncestors(ctx sdk.Context, agent types.Agent, denom string, now types.SpendClock, amount math.Int) error {
+	ancestors, err := k.ancestors(ctx, agent)
+	if err != nil {
+		return err
+	}
+	for _, a := range ancestors {
+		budget, ok := a.SpendBudgetFor(denom)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrSpendingDisabled, "ancestor %s denom %s", a.Id, denom)
+		}
+		if !budget.Allows(now, amount) {
+			return errorsmod.Wrapf(types.ErrSpendBudgetExceeded, "ancestor %s: amount %s%s, remaining %s", a.Id, amount, denom, budget.Remaining(now))
+		}
+		a.RecordDenomSpend(denom, now, amount)
+		if err := k.SetAgent(ctx, a); err != nil {
+			return errorsmod.Wrap(err, "set agent")
+		}
+	}
+	return nil
+}
+
+// subAgentDepth returns how many ancestors the agent has.
+func (k Keeper) subAgentDepth(ctx sdk.Context, agent types.Agent) (uint64, error) {
+	ancestors, err := k.ancestors(ctx, agent)
+	return uint64(len(ancestors)), err
+}
+
+// validateDelegatedBudgets checks every spend budget of the spec is budgeted
+// on the parent with at least the same limit: a parent cannot delegate spend
+// authority it does not have.
+func validateDelegatedBudgets(parent types.Agent, spec types.SubAgentSpec) error {
+	for _, b := range spec.SpendBudgets {
+		pb, ok := parent.SpendBudgetFor(b.Denom)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrSpendingDisabled, "parent %s denom %s", parent.Id, b.Denom)
+		}
+		if b.LimitPerWindow.GT(pb.LimitPerWindow) {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "spend budget %s: limit %s exceeds parent limit %s", b.Denom, b.LimitPerWindow, pb.LimitPerWindow)
+		}
+	}
+	return nil
+}
+
+// CreateSubAgent creates a sub-agent authorized by its parent's attestation
+// over the spec. The sub-agent inherits the parent's owner and security
+// policy, and its budget moves from the parent's escrow to its own. Moving
+// the budget is not a spend: the funds stay in the tree, and every spend of
+// the sub-agent counts against the parent's budgets anyway. The submitter
+// pays the agent registration fee, as for a top-level agent, so sub-agents
+// are no cheaper a way to fill the registry.
+func (k msgServer) CreateSubAgent(goCtx context.Context, msg *types.MsgCreateSubAgent) (*types.MsgCreateSubAgentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(err, "validate basic")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get params")
+	}
+
+	parent, err := k.loadAttestingAgent(ctx, msg.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	if _, found := k.GetAgent(ctx, msg.Spec.AgentId); found {
+		return nil, errorsmod.Wrap(types.ErrAgentExists, msg.Spec.AgentId)
+	}
+
+	depth, err := k.subAgentDepth(ctx, parent)
+	if err != nil {
+		return nil, err
+	}
+	if depth+1 > params.MaxSubAgentDepth {
+		return nil, errorsmod.Wrapf(types.ErrSubAgentLimit, "depth %d exceeds max %d", depth+1, params.MaxSubAgentDepth)
+	}
+	siblings, err := k.SubAgentIDs(ctx, parent.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sub-agent ids")
+	}
+	if uint64(len(siblings)) >= params.MaxSubAgents {
+		return nil, errorsmod.Wrapf(types.ErrSubAgentLimit, "agent %s already has %d sub-agents", parent.Id, len(siblings))
+	}
+	if err := validateDelegatedBudgets(parent, msg.Spec); err != nil {
+		return nil, err
+	}
+
+	fp, err := k.fingerprintNotRevoked(ctx, msg.Spec.Policy)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.coPoliciesNotRevoked(ctx, msg.Spec.PolicySet); err != nil {
+		return nil, err
+	}
+
+	payload, err := types.SubAgentSpecBytes(msg.Spec)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "sub-agent spec bytes")
+	}
+	if uint64(len(payload)) > params.MaxActionBytes {
+		return nil, gerrc.ErrInvalidArgument.Wrapf("sub-agent spec exceeds max action bytes: got %d, max %d", len(payload), params.MaxActionBytes)
+	}
+	seq := parent.ActionSeq
+	nonce := types.SubAgentNonce(parent.Id, payload, seq)
+
+	// The budget leaves the parent's escrow, so it needs the quorum a
+	// transfer of the same amount would.
+	required := parent.PolicySet.Quorum.RequiredAttestationsFor(msg.Spec.Budget)
+	verified, err := k.verifyAttestations(ctx, parent, nonce, msg.Token, msg.CoAttestations, required)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.chargeRegistrationFee(ctx, sdk.MustAccAddressFromBech32(msg.Submitter)); err != nil {
+		return nil, err
+	}
+	if !msg.Spec.Budget.IsZero() {
+		balance, negative := k.GetEscrowBalance(ctx, parent.Id).SafeSub(msg.Spec.Budget...)
+		if negative {
+			return nil, errorsmod.Wrap(types.ErrInsufficientEscrow, msg.Spec.Budget.String())
+		}
+		if err := k.setEscrowBalance(ctx, parent.Id, balance); err != nil {
+			return nil, errorsmod.Wrap(err, "set escrow balance")
+		}
+		if err := k.setEscrowBalance(ctx, msg.Spec.AgentId, msg.Spec.Budget); err != nil {
+			return nil, errorsmod.Wrap(err, "set escrow balance")
+		}
+	}
+	if err := k.consumeAttestations(ctx, verified, params.AttestationMaxAge); err != nil {
+		return nil, err
+	}
+
+	child := types.Agent{
+		Id:           msg.Spec.AgentId,
+		Owner:        parent.Owner,
+		Policy:       msg.Spec.Policy,
+		PolicySet:    msg.Spec.PolicySet,
+		Active:       true,
+		ParentId:     parent.Id,
+		SpendBudgets: slices.Clone(msg.Spec.SpendBudgets),
+		Security:     parent.EffectiveSecurity(ctx.BlockHeight()),
+	}
+	slices.SortFunc(child.SpendBudgets, func(a, b types.SpendBudget) int {
+		return strings.Compare(a.Denom, b.Denom)
+	})
+	if err := k.SetAgent(ctx, child); err != nil {
+		return nil, errorsmod.Wrap(err, "set agent")
+	}
+	if err := k.subAgents.Set(ctx, collections.Join(parent.Id, child.Id)); err != nil {
+		return nil, errorsmod.Wrap(err, "set sub-agent")
+	}
+
+	payloadHash := sha256.Sum256(payload)
+	if err := k.appendAttested(ctx, &parent, payload, payloadHash[:], msg.Submitter, ""); err != nil {
+		return nil, err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventCreateSubAgent{
+		ParentId:    parent.Id,
+		AgentId:     child.Id,
+		Owner:       child.Owner,
+		Fingerprint: fp,
+		Budget:      msg.Spec.Budget,
+		Seq:         seq,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateSubAgentResponse{Seq: seq}, nil
+}
+
+// deactivateAgentTree deactivates the agent and all its descendants, leaves
+// first, and returns each deactivated sub-agent's escrow to its parent so the
+// budget flows back up the tree. A frozen sub-agent keeps its escrow, which
+// stays subject to the freeze.
+func (k Keeper) deactivateAgentTree(ctx sdk.Context, agent types.Agent) error {
+	children, err := k.getSubAgents(ctx, agent.Id)
+	if err != nil {
+		return err
+	}
+	for _, child := range children {
+		if err := k.deactivateAgentTree(ctx, child); err != nil {
+			return err
+		}
+	}
+
+	if agent.Active {
+		agent.Active = false
+		if err := k.SetAgent(ctx, agent); err != nil {
+			return errorsmod.Wrap(err, "set agent")
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventDeactivateAgent{
+			AgentId: agent.Id,
+			Owner:   agent.Owner,
+		}); err != nil {
+			return err
+		}
+	}
+
+	if !agent.IsSubAgent() || agent.Frozen {
+		return nil
+	}
+	return k.returnEscrowToParent(ctx, agent)
+}
+
+// returnEscrowToParent moves the sub-agent's whole escrow to its parent.
+func (k Keeper) returnEscrowToParent(ctx sdk.Context, agent types.Agent) error {
+	amount := k.GetEscrowBalance(ctx, agent.Id)
+	if amount.IsZero() {
+		return nil
+	}
+	if err := k.setEscrowBalance(ctx, agent.Id, sdk.NewCoins()); err != nil {
+		return errorsmod.Wrap(err, "set escrow balance")
+	}
+	if err := k.setEscrowBalance(ctx, agent.ParentId, k.GetEscrowBalance(ctx, agent.ParentId).Add(amount...)); err != nil {
+		return errorsmod.Wrap(err, "set escrow balance")
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventReturnSubAgentEscrow{
+		AgentId:  agent.Id,
+		ParentId: agent.ParentId,
+		Amount:   amount,
+	})
+}
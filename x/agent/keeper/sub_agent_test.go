@@ -0,0 +1,202 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+func (s *EscrowTestSuite) subAgentSpec(id string, budget, limit int64) types.SubAgentSpec {
+	return types.SubAgentSpec{
+		AgentId: id,
+		Policy:  validPolicyT(s.T(), "child"),
+		Budget:  coinsOf(budget),
+		SpendBudgets: []types.SpendBudget{
+			{Denom: spendDenom, LimitPerWindow: math.NewInt(limit), WindowBlocks: windowLen},
+		},
+	}
+}
+
+// createSubAgentMsg builds a creation whose token equals the nonce the
+// handler derives, so the fake verifier accepts it. The submitter is funded
+// for the registration fee.
+func (s *EscrowTestSuite) createSubAgentMsg(parentID string, spec types.SubAgentSpec, seq uint64) *types.MsgCreateSubAgent {
+	_, _, sub := testdata.KeyTestPubAddr()
+	fee, err := s.k.AgentRegistrationFee(s.Ctx)
+	s.Require().NoError(err)
+	s.FundAcc(sub, sdk.NewCoins(fee))
+	payload, err := types.SubAgentSpecBytes(spec)
+	s.Require().NoError(err)
+	return types.NewMsgCreateSubAgent(sub.String(), parentID, spec, types.SubAgentNonce(parentID, payload, seq))
+}
+
+func (s *EscrowTestSuite) createSubAgent(parentID string, spec types.SubAgentSpec) {
+	parent, found := s.k.GetAgent(s.Ctx, parentID)
+	s.Require().True(found)
+	_, err := s.msgServer.CreateSubAgent(s.Ctx, s.createSubAgentMsg(parentID, spec, parent.ActionSeq))
+	s.Require().NoError(err)
+}
+
+// childTransferMsg is transferMsg in an explicit denom: sub-agents have no
+// primary spend denom.
+func (s *EscrowTestSuite) childTransferMsg(id string, recipient sdk.AccAddress, amt int64, seq uint64) *types.MsgSubmitAttestedTransfer {
+	msg := s.transferMsg(id, recipient, amt, seq)
+	msg.Denom = spendDenom
+	return msg
+}
+
+func (s *EscrowTestSuite) TestCreateSubAgent_CarvesBudget() {
+	owner := s.spendingAgent("p")
+	s.fundEscrow("p", 1000)
+
+	msg := s.createSubAgentMsg("p", s.subAgentSpec("c", 300, 500), 0)
+	res, err := s.msgServer.CreateSubAgent(s.Ctx, msg)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), res.Seq)
+	// the submitter paid the registration fee
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, sdk.MustAccAddressFromBech32(msg.Submitter)).IsZero())
+
+	s.Require().Equal(coinsOf(700), s.k.GetEscrowBalance(s.Ctx, "p"))
+	s.Require().Equal(coinsOf(300), s.k.GetEscrowBalance(s.Ctx, "c"))
+	child, found := s.k.GetAgent(s.Ctx, "c")
+	s.Require().True(found)
+	s.Require().Equal(owner.String(), child.Owner)
+	s.Require().Equal("p", child.ParentId)
+	s.Require().True(child.Active)
+
+	// the creation is logged by the parent, so the same token cannot replay
+	_, found = s.k.GetActionLogEntry(s.Ctx, "p", 0)
+	s.Require().True(found)
+	msg.Spec.AgentId = "c2"
+	_, err = s.msgServer.CreateSubAgent(s.Ctx, msg)
+	s.Require().Error(err)
+
+	q, err := s.k.SubAgents(s.Ctx, &types.QuerySubAgentsRequest{AgentId: "p"})
+	s.Require().NoError(err)
+	s.Require().Len(q.Agents, 1)
+	s.Require().Equal("c", q.Agents[0].Id)
+}
+
+func (s *EscrowTestSuite) TestCreateSubAgent_Limits() {
+	s.spendingAgent("p")
+	s.fundEscrow("p", 1000)
+	params, err := s.k.GetParams(s.Ctx)
+	s.Require().NoError(err)
+	params.MaxSubAgentDepth = 1
+	params.MaxSubAgents = 1
+	s.Require().NoError(s.k.SetParams(s.Ctx, params))
+
+	// a parent cannot delegate more than it may spend, nor a denom it cannot
+	_, err = s.msgServer.CreateSubAgent(s.Ctx, s.createSubAgentMsg("p", s.subAgentSpec("c", 100, windowLimit+1), 0))
+	s.Require().ErrorContains(err, "exceeds parent limit")
+	spec := s.subAgentSpec("c", 100, 100)
+	spec.SpendBudgets[0].Denom = "uusdc"
+	_, err = s.msgServer.CreateSubAgent(s.Ctx, s.createSubAgentMsg("p", spec, 0))
+	s.Require().ErrorIs(err, types.ErrSpendingDisabled)
+	_, err = s.msgServer.CreateSubAgent(s.Ctx, s.createSubAgentMsg("p", s.subAgentSpec("c", 2000, 100), 0))
+	s.Require().ErrorIs(err, types.ErrInsufficientEscrow)
+
+	// the submitter must pay the registration fee
+	msg := s.createSubAgentMsg("p", s.subAgentSpec("c", 100, 100), 0)
+	_, _, broke := testdata.KeyTestPubAddr()
+	msg.Submitter = broke.String()
+	_, err = s.msgServer.CreateSubAgent(s.Ctx, msg)
+	s.Require().ErrorIs(err, types.ErrRegistrationFeePayment)
+
+	s.createSubAgent("p", s.subAgentSpec("c", 100, 100))
+	_, err = s.msgServer.CreateSubAgent(s.Ctx, s.createSubAgentMsg("p", s.subAgentSpec("c2", 100, 100), 1))
+	s.Require().ErrorIs(err, types.ErrSubAgentLimit)
+	_, err = s.msgServer.CreateSubAgent(s.Ctx, s.createSubAgentMsg("c", s.subAgentSpec("g", 10, 10), 0))
+	s.Require().ErrorIs(err, types.ErrSubAgentLimit)
+}
+
+func (s *EscrowTestSuite) TestSubAgentTransfer_CountsAgainstAncestors() {
+	s.spendingAgent("p")
+	s.fundEscrow("p", 2000)
+	s.createSubAgent("p", s.subAgentSpec("c1", 600, 800))
+	s.createSubAgent("p", s.subAgentSpec("c2", 600, 800))
+	_, _, recipient := testdata.KeyTestPubAddr()
+
+	_, err := s.msgServer.SubmitAttestedTransfer(s.Ctx, s.childTransferMsg("c1", recipient, 500, 0))
+	s.Require().NoError(err)
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.transferMsg("p", recipient, 300, 2))
+	s.Require().NoError(err)
+
+	// within c2's own limit, but the parent's window has only 200 left
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.childTransferMsg("c2", recipient, 201, 0))
+	s.Require().ErrorIs(err, types.ErrSpendBudgetExceeded)
+	s.Require().ErrorContains(err, "ancestor p")
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.childTransferMsg("c2", recipient, 200, 0))
+	s.Require().NoError(err)
+
+	res, err := s.k.AggregateSpend(s.Ctx, &types.QueryAggregateSpendRequest{AgentId: "p"})
+	s.Require().NoError(err)
+	s.Require().Equal(coinsOf(300), res.Spent)
+	s.Require().Equal(coinsOf(1000), res.TotalSpent)
+	s.Require().Equal(coinsOf(1000), res.TotalEscrow)
+	s.Require().Equal(uint64(2), res.Descendants)
+}
+
+func (s *EscrowTestSuite) TestSubAgent_FrozenAncestorBlocksSubtree() {
+	owner, guardian := s.guardedAgent("p")
+	s.createSubAgent("p", s.subAgentSpec("c", 300, 500))
+	child, found := s.k.GetAgent(s.Ctx, "c")
+	s.Require().True(found)
+	s.Require().Equal(guardian.String(), child.Security.Guardian)
+
+	_, err := s.msgServer.FreezeAgent(s.Ctx, types.NewMsgFreezeAgent(guardian.String(), "p"))
+	s.Require().NoError(err)
+	s.Require().False(s.k.IsAgentLive(s.Ctx, "c"))
+
+	_, _, recipient := testdata.KeyTestPubAddr()
+	_, err = s.msgServer.SubmitAttestedTransfer(s.Ctx, s.childTransferMsg("c", recipient, 1, 0))
+	s.Require().ErrorIs(err, types.ErrAgentFrozen)
+	_, err = s.msgServer.WithdrawAgentEscrow(s.Ctx, types.NewMsgWithdrawAgentEscrow(owner.String(), "c", coinsOf(1)))
+	s.Require().ErrorIs(err, types.ErrAgentFrozen)
+}
+
+// TestSubAgent_FrozenAncestorCancelsMatureWithdrawal checks that freezing a
+// parent stops a withdrawal already queued on its sub-agent from paying out.
+func (s *EscrowTestSuite) TestSubAgent_FrozenAncestorCancelsMatureWithdrawal() {
+	owner, guardian := s.guardedAgent("p")
+	s.createSubAgent("p", s.subAgentSpec("c", 300, 500))
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+	before := s.App.BankKeeper.GetBalance(s.Ctx, owner, spendDenom).Amount
+
+	res, err := s.msgServer.WithdrawAgentEscrow(s.Ctx, types.NewMsgWithdrawAgentEscrow(owner.String(), "c", coinsOf(200)))
+	s.Require().NoError(err)
+	s.Require().NotNil(res.Pending)
+	_, err = s.msgServer.FreezeAgent(s.Ctx, types.NewMsgFreezeAgent(guardian.String(), "p"))
+	s.Require().NoError(err)
+
+	s.endBlockAt(res.Pending.MatureHeight)
+	s.Require().Equal(before, s.App.BankKeeper.GetBalance(s.Ctx, owner, spendDenom).Amount)
+	s.Require().Equal(coinsOf(300), s.k.GetEscrowBalance(s.Ctx, "c"))
+	_, found := s.k.GetPendingWithdrawal(s.Ctx, "c", res.Pending.Id)
+	s.Require().False(found)
+}
+
+func (s *EscrowTestSuite) TestDeactivateAgent_Cascades() {
+	owner, guardian := s.guardedAgent("p")
+	s.createSubAgent("p", s.subAgentSpec("c", 400, 500))
+	s.createSubAgent("c", s.subAgentSpec("g", 100, 100))
+	s.createSubAgent("p", s.subAgentSpec("f", 200, 500))
+	_, err := s.msgServer.FreezeAgent(s.Ctx, types.NewMsgFreezeAgent(guardian.String(), "f"))
+	s.Require().NoError(err)
+
+	_, err = s.msgServer.DeactivateAgent(s.Ctx, types.NewMsgDeactivateAgent(owner.String(), "p"))
+	s.Require().NoError(err)
+	for _, id := range []string{"p", "c", "g", "f"} {
+		a, found := s.k.GetAgent(s.Ctx, id)
+		s.Require().True(found)
+		s.Require().False(a.Active, id)
+	}
+
+	// budgets flow back up the tree, except from the frozen sub-agent
+	s.Require().Equal(coinsOf(800), s.k.GetEscrowBalance(s.Ctx, "p"))
+	s.Require().True(s.k.GetEscrowBalance(s.Ctx, "c").IsZero())
+	s.Require().True(s.k.GetEscrowBalance(s.Ctx, "g").IsZero())
+	s.Require().Equal(coinsOf(200), s.k.GetEscrowBalance(s.Ctx, "f"))
+}
@@ -28,7 +28,8 @@ var (
 	_ module.HasServices    = AppModule{}
 	_ module.HasInvariants  = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = (*AppModule)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -128,6 +129,23 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock pays out escrow withdrawals whose timelock has elapsed and due
+// payment stream periods, and prunes expired action log entries and consumed
+// attestations.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := am.keeper.ProcessMatureWithdrawals(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.ProcessPaymentStreams(ctx); err != nil {
+		return err
+	}
+	if err := am.keeper.PruneExpiredActionLogs(ctx); err != nil {
+		return err
+	}
+	return am.keeper.PruneConsumedAttestations(ctx)
+}
+
 func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
@@ -158,12 +176,60 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Show a single attested action log entry",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}, {ProtoField: "seq"}},
 				},
+				{
+					RpcMethod:      "ActionLogCommitment",
+					Use:            "action-log-commitment [agent-id]",
+					Short:          "Show an agent's action log accumulator root, leaf count and pruning point",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}},
+				},
+				{
+					RpcMethod:      "ActionLogProof",
+					Use:            "action-log-proof [agent-id] [seq]",
+					Short:          "Show an inclusion proof of an action log entry, pruned or not, against the current root",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}, {ProtoField: "seq"}},
+				},
 				{
 					RpcMethod:      "EscrowBalance",
 					Use:            "escrow-balance [agent-id]",
-					Short:          "Show an agent's escrow balance and remaining window spend budget",
+					Short:          "Show an agent's escrow balance and remaining window spend budget per denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}},
+				},
+				{
+					RpcMethod:      "SubAgents",
+					Use:            "sub-agents [agent-id]",
+					Short:          "List an agent's direct sub-agents",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}},
+				},
+				{
+					RpcMethod:      "AggregateSpend",
+					Use:            "aggregate-spend [agent-id]",
+					Short:          "Show the lifetime spend and escrow of an agent and all its sub-agents",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}},
+				},
+				{
+					RpcMethod:      "RecipientPolicy",
+					Use:            "recipient-policy [agent-id]",
+					Short:          "Show an agent's recipient policy and per-recipient usage in the current window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}},
+				},
+				{
+					RpcMethod:      "PendingWithdrawals",
+					Use:            "pending-withdrawals [agent-id]",
+					Short:          "List an agent's timelocked escrow withdrawals",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}},
+				},
+				{
+					RpcMethod:      "PaymentStreams",
+					Use:            "payment-streams [agent-id]",
+					Short:          "List an agent's payment streams",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}},
 				},
+				{
+					RpcMethod:      "PaymentStream",
+					Use:            "payment-stream [agent-id] [stream-id]",
+					Short:          "Query a payment stream",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "agent_id"}, {ProtoField: "stream_id"}},
+				},
 				{
 					RpcMethod: "RevokedPolicies",
 					Use:       "revoked-policies",
@@ -175,6 +241,17 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Check whether a policy fingerprint is revoked",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "fingerprint"}},
 				},
+				{
+					RpcMethod:      "RegisteredPolicy",
+					Use:            "registered-policy [id]",
+					Short:          "Show a registered policy and the head of its lineage",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "RegisteredPolicies",
+					Use:       "registered-policies",
+					Short:     "List all registered policies",
+				},
 				{
 					RpcMethod:      "AgentReputation",
 					Use:            "agent-reputation [agent-id]",
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// The action log accumulator is a Merkle mountain range: leaves are appended
+// left to right, two equal-height subtrees merge as soon as both exist, and
+// the remaining subtree roots (peaks) are bagged into a single root. Only
+// 32-byte node hashes stay in state, so entries can be pruned while remaining
+// provable. Leaf and node hashes are domain separated so a node can never be
+// passed off as a leaf.
+const (
+	actionLogLeafDomain byte = 0x00
+	actionLogNodeDomain byte = 0x01
+)
+
+// ActionLogLeafHash is the accumulator leaf of an action log entry. It commits
+// to every field but the payload itself, which payload_hash covers, using raw
+// bytes and fixed-width big-endian integers so archivers can recompute it
+// without a protobuf encoder. Variable-length fields are length prefixed.
+func ActionLogLeafHash(e ActionLogEntry) []byte {
+	h := sha256.New()
+	h.Write([]byte{actionLogLeafDomain})
+	writeLenPrefixed(h, []byte(e.AgentId))
+	_ = binary.Write(h, binary.BigEndian, e.Seq)
+	writeLenPrefixed(h, e.PayloadHash)
+	_ = binary.Write(h, binary.BigEndian, e.Height)
+	_ = binary.Write(h, binary.BigEndian, e.Time.UnixNano())
+	writeLenPrefixed(h, []byte(e.Submitter))
+	writeLenPrefixed(h, []byte(e.Recipient))
+	return h.Sum(nil)
+}
+
+func writeLenPrefixed(h interface{ Write([]byte) (int, error) }, bz []byte) {
+	_ = binary.Write(h, binary.BigEndian, uint64(len(bz)))
+	_, _ = h.Write(bz)
+}
+
+// ActionLogNodeHash is the parent of two accumulator nodes. Bagging the peaks
+// into the root uses it too.
+func ActionLogNodeHash(left, right []byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{actionLogNodeDomain})
+	h.Write(left)
+	h.Write(right)
+	return h.Sum(nil)
+}
+
+// ActionLogPeak locates a peak of the accumulator: the node at Index on Level,
+// covering leaves [Index<<Level, (Index+1)<<Level).
+type ActionLogPeak struct {
+	Level uint32
+	Index uint64
+}
+
+// ActionLogPeaks returns the peaks of an accumulator with leafCount leaves,
+// tallest (leftmost) first. There is one peak per set bit of leafCount.
+func ActionLogPeaks(leafCount uint64) []ActionLogPeak {
+	var peaks []ActionLogPeak
+	for level := 63; level >= 0; level-- {
+		if leafCount&(1<<level) != 0 {
+			peaks = append(peaks, ActionLogPeak{Level: uint32(level), Index: leafCount>>level - 1})
+		}
+	}
+	return peaks
+}
+
+// Covers reports whether seq is a leaf under the peak.
+func (p ActionLogPeak) Covers(seq uint64) bool {
+	return seq>>p.Level == p.Index
+}
+
+// BagActionLogPeaks folds the peaks, tallest first, into the accumulator root,
+// right to left. The root of an empty accumulator is nil.
+func BagActionLogPeaks(peaks [][]byte) []byte {
+	if len(peaks) == 0 {
+		return nil
+	}
+	root := peaks[len(peaks)-1]
+	for i := len(peaks) - 2; i >= 0; i-- {
+		root = ActionLogNodeHash(peaks[i], root)
+	}
+	return root
+}
+
+// Verify checks that the proof places LeafHash at Seq under root.
+func (p ActionLogProof) Verify(root []byte) error {
+	if p.LeafCount <= p.Seq {
+		return errorsmod.Wrapf(ErrInvalidActionLogProof, "seq %d not below leaf count %d", p.Seq, p.LeafCount)
+	}
+	peaks := ActionLogPeaks(p.LeafCount)
+	if len(p.Peaks) != len(peaks) {
+		return errorsmod.Wrapf(ErrInvalidActionLogProof, "got %d peaks, want %d", len(p.Peaks), len(peaks))
+	}
+	if int(p.PeakIndex) >= len(peaks) {
+		return errorsmod.Wrapf(ErrInvalidActionLogProof, "peak index %d out of range", p.PeakIndex)
+	}
+	peak := peaks[p.PeakIndex]
+	if !peak.Covers(p.Seq) {
+		return errorsmod.Wrapf(ErrInvalidActionLogProof, "peak %d does not cover seq %d", p.PeakIndex, p.Seq)
+	}
+	if len(p.Siblings) != int(peak.Level) {
+		return errorsmod.Wrapf(ErrInvalidActionLogProof, "got %d siblings, want %d", len(p.Siblings), peak.Level)
+	}
+
+	node, idx := p.LeafHash, p.Seq
+	for _, sibling := range p.Siblings {
+		if idx&1 == 0 {
+			node = ActionLogNodeHash(node, sibling)
+		} else {
+			node = ActionLogNodeHash(sibling, node)
+		}
+		idx >>= 1
+	}
+	if !bytes.Equal(node, p.Peaks[p.PeakIndex]) {
+		return errorsmod.Wrap(ErrInvalidActionLogProof, "leaf does not hash to its peak")
+	}
+	if !bytes.Equal(BagActionLogPeaks(p.Peaks), root) {
+		return errorsmod.Wrap(ErrInvalidActionLogProof, "peaks do not bag to the root")
+	}
+	return nil
+}
+
+// VerifyEntry checks that the proof is for e and places it under root.
+func (p ActionLogProof) VerifyEntry(e ActionLogEntry, root []byte) error {
+	if e.Seq != p.Seq {
+		return errorsmod.Wrapf(ErrInvalidActionLogProof, "entry seq %d, proof seq %d", e.Seq, p.Seq)
+	}
+	if !bytes.Equal(ActionLogLeafHash(e), p.LeafHash) {
+		return errorsmod.Wrap(ErrInvalidActionLogProof, "entry does not match the proven leaf")
+	}
+	return p.Verify(root)
+}
+
+// Retained returns the number of entries still held in state.
+func (c ActionLogCommitment) Retained() uint64 {
+	return c.LeafCount - c.PrunedBefore
+}
+
+func (c ActionLogCommitment) Validate() error {
+	if c.AgentId == "" {
+		return fmt.Errorf("empty agent id")
+	}
+	if c.LeafCount < c.PrunedBefore {
+		return fmt.Errorf("pruned before %d exceeds leaf count %d", c.PrunedBefore, c.LeafCount)
+	}
+	if c.LeafCount == 0 && len(c.Root) != 0 {
+		return fmt.Errorf("root set without leaves")
+	}
+	if c.LeafCount != 0 && len(c.Root) != sha256.Size {
+		return fmt.Errorf("root must be %d bytes, got %d", sha256.Size, len(c.Root))
+	}
+	return nil
+}
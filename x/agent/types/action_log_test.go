@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+func TestActionLogPeaks(t *testing.T) {
+	require.Empty(t, types.ActionLogPeaks(0))
+	require.Equal(t, []types.ActionLogPeak{{Level: 0, Index: 0}}, types.ActionLogPeaks(1))
+	require.Equal(t, []types.ActionLogPeak{{Level: 2, Index: 0}, {Level: 1, Index: 2}}, types.ActionLogPeaks(6))
+	require.Equal(t, []types.ActionLogPeak{{Level: 1, Index: 0}, {Level: 0, Index: 2}}, types.ActionLogPeaks(3))
+}
+
+func TestActionLogProofVerify(t *testing.T) {
+	entries := make([]types.ActionLogEntry, 3)
+	leaves := make([][]byte, 3)
+	for i := range entries {
+		payloadHash := sha256.Sum256([]byte{byte(i)})
+		entries[i] = types.ActionLogEntry{
+			AgentId:     "a1",
+			Seq:         uint64(i),
+			PayloadHash: payloadHash[:],
+			Height:      int64(10 + i),
+			Time:        time.Unix(100, 0).UTC(),
+		}
+		leaves[i] = types.ActionLogLeafHash(entries[i])
+	}
+	// two peaks: (l0, l1) and l2
+	left := types.ActionLogNodeHash(leaves[0], leaves[1])
+	root := types.ActionLogNodeHash(left, leaves[2])
+	peaks := [][]byte{left, leaves[2]}
+	require.Equal(t, root, types.BagActionLogPeaks(peaks))
+
+	proof0 := types.ActionLogProof{Seq: 0, LeafCount: 3, LeafHash: leaves[0], Siblings: [][]byte{leaves[1]}, Peaks: peaks, PeakIndex: 0}
+	require.NoError(t, proof0.VerifyEntry(entries[0], root))
+	proof2 := types.ActionLogProof{Seq: 2, LeafCount: 3, LeafHash: leaves[2], Peaks: peaks, PeakIndex: 1}
+	require.NoError(t, proof2.VerifyEntry(entries[2], root))
+
+	tampered := entries[0]
+	tampered.Submitter = "someone"
+	require.ErrorIs(t, proof0.VerifyEntry(tampered, root), types.ErrInvalidActionLogProof)
+	require.ErrorIs(t, proof0.VerifyEntry(entries[1], root), types.ErrInvalidActionLogProof)
+
+	for name, mutate := range map[string]func(p *types.ActionLogProof){
+		"wrong sibling":    func(p *types.ActionLogProof) { p.Siblings = [][]byte{leaves[2]} },
+		"missing sibling":  func(p *types.ActionLogProof) { p.Siblings = nil },
+		"wrong peak":       func(p *types.ActionLogProof) { p.PeakIndex = 1 },
+		"seq out of range": func(p *types.ActionLogProof) { p.Seq = 3 },
+		"other leaf count": func(p *types.ActionLogProof) { p.LeafCount = 2 },
+		"swapped peaks":    func(p *types.ActionLogProof) { p.Peaks = [][]byte{leaves[2], left} },
+	} {
+		p := proof0
+		mutate(&p)
+		require.ErrorIs(t, p.Verify(root), types.ErrInvalidActionLogProof, name)
+	}
+	require.ErrorIs(t, proof0.Verify(left), types.ErrInvalidActionLogProof)
+}
@@ -1,22 +1,33 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
 	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 )
 
-// PromotePendingPolicy applies a matured pending policy in place, so
-// verification at or after the activation height uses the rotated policy.
-// Callers persist the agent to make the promotion durable.
+// PromotePendingPolicy applies a matured pending policy and policy set in
+// place, so verification at or after the activation height uses the rotated
+// policies. A rotation to an embedded policy unbinds the agent from its
+// registered policy. Callers persist the agent to make the promotion durable.
 func (a *Agent) PromotePendingPolicy(height int64) {
 	if a.PendingPolicy != nil && height >= a.PendingPolicyHeight {
 		a.Policy = *a.PendingPolicy
+		a.PolicyId = a.PendingPolicyId
 		a.PendingPolicy = nil
+		a.PendingPolicyId = ""
 		a.PendingPolicyHeight = 0
 	}
+	if a.PendingPolicySet != nil && height >= a.PendingPolicySetHeight {
+		a.PolicySet = *a.PendingPolicySet
+		a.PendingPolicySet = nil
+		a.PendingPolicySetHeight = 0
+	}
 }
 
 // EffectivePolicy returns the policy in force at height without mutating the
@@ -37,76 +48,321 @@ func (a Agent) SpendEnabled() bool {
 // imports against e.g. an enabled agent with a zero window length (which would
 // make SpendBucket divide by zero).
 func (a Agent) ValidateSpendState() error {
-	if err := ValidateSpendPolicy(a.SpendDenom, a.SpendLimitPerWindow, a.SpendWindowBlocks); err != nil {
+	primary := a.primaryBudget()
+	if err := ValidateSpendPolicy(primary); err != nil {
 		return err
 	}
-	spent := a.spendWindowSpentAmount()
-	if spent.IsNegative() {
-		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "negative spend window spent")
-	}
 	if !a.SpendEnabled() {
-		if !spent.IsZero() || a.SpendWindowStartHeight != 0 {
+		if primary.windowSpentAmount().IsNegative() {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "negative spend window spent")
+		}
+		if primary.hasWindowState() {
 			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window state set without spend denom")
 		}
-		return nil
+	} else if err := primary.validateWindowState(); err != nil {
+		return err
 	}
-	if spent.GT(a.SpendLimitPerWindow) {
-		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window spent greater than spend limit")
+	if err := ValidateSpendBudgets(a.SpendDenom, a.SpendBudgets); err != nil {
+		return err
 	}
-	// RecordSpend only ever writes bucket starts (multiples of the window
-	// length), so anything else is not a state the runtime can produce.
-	if a.SpendWindowStartHeight%a.SpendWindowBlocks != 0 {
-		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window start height not bucket-aligned")
+	for _, b := range a.SpendBudgets {
+		if err := b.validateWindowState(); err != nil {
+			return errorsmod.Wrapf(err, "spend budget %s", b.Denom)
+		}
 	}
 	return nil
 }
 
+// primaryBudget views the legacy single-denom spend fields as a SpendBudget so
+// the window arithmetic lives in one place.
+func (a Agent) primaryBudget() SpendBudget {
+	return SpendBudget{
+		Denom:             a.SpendDenom,
+		LimitPerWindow:    a.SpendLimitPerWindow,
+		WindowBlocks:      a.SpendWindowBlocks,
+		WindowStartHeight: a.SpendWindowStartHeight,
+		WindowSpent:       a.SpendWindowSpent,
+		WindowMode:        a.SpendWindowMode,
+		WindowDuration:    a.SpendWindowDuration,
+		WindowStartTime:   a.SpendWindowStartTime,
+		RecentSlots:       a.SpendRecentSlots,
+	}
+}
+
+// setPrimaryWindow writes the window bookkeeping of b back onto the legacy
+// single-denom spend fields.
+func (a *Agent) setPrimaryWindow(b SpendBudget) {
+	a.SpendWindowStartHeight = b.WindowStartHeight
+	a.SpendWindowSpent = b.WindowSpent
+	a.SpendWindowStartTime = b.WindowStartTime
+	a.SpendRecentSlots = b.RecentSlots
+}
+
+// SpendBudgetFor returns the budget governing denom and whether one exists.
+// spend_denom is served from the agent's primary spend fields, every other
+// denom from spend_budgets.
+func (a Agent) SpendBudgetFor(denom string) (SpendBudget, bool) {
+	if a.SpendEnabled() && denom == a.SpendDenom {
+		return a.primaryBudget(), true
+	}
+	for _, b := range a.SpendBudgets {
+		if b.Denom == denom {
+			return b, true
+		}
+	}
+	return SpendBudget{}, false
+}
+
+// AllSpendBudgets returns every budget of the agent, spend_denom first.
+func (a Agent) AllSpendBudgets() []SpendBudget {
+	budgets := make([]SpendBudget, 0, len(a.SpendBudgets)+1)
+	if a.SpendEnabled() {
+		budgets = append(budgets, a.primaryBudget())
+	}
+	return append(budgets, a.SpendBudgets...)
+}
+
+// RecordDenomSpend accounts a successful transfer of amount in denom at now
+// against that denom's budget. No-op for an unbudgeted denom; callers check
+// SpendBudgetFor first.
+func (a *Agent) RecordDenomSpend(denom string, now SpendClock, amount math.Int) {
+	if a.SpendEnabled() && denom == a.SpendDenom {
+		a.RecordSpend(now, amount)
+		return
+	}
+	for i := range a.SpendBudgets {
+		if a.SpendBudgets[i].Denom == denom {
+			a.SpendBudgets[i].RecordSpend(now, amount)
+			return
+		}
+	}
+}
+
+// ResetSpendWindows clears the window bookkeeping of every budget.
+func (a *Agent) ResetSpendWindows() {
+	primary := a.primaryBudget()
+	primary.resetWindow()
+	a.setPrimaryWindow(primary)
+	for i := range a.SpendBudgets {
+		a.SpendBudgets[i].resetWindow()
+	}
+}
+
 // SpendBucket returns the start height of the absolute-aligned tumbling
-// window that nowHeight falls into. The window arithmetic mirrors
-// x/eibc/types.OnDemandLP (Bucket/RateAllows/RecordSpend).
+// window that nowHeight falls into for the primary spend denom. Only
+// meaningful in SPEND_WINDOW_MODE_BLOCKS.
 func (a Agent) SpendBucket(nowHeight uint64) uint64 {
-	return nowHeight - (nowHeight % a.SpendWindowBlocks)
+	return a.primaryBudget().Bucket(nowHeight)
 }
 
-// spendWindowSpentAmount guards against a nil SpendWindowSpent decoded from
-// records written before spending was configured.
-func (a Agent) spendWindowSpentAmount() math.Int {
-	if a.SpendWindowSpent.IsNil() {
+// RemainingWindowBudget returns the unspent budget of the window containing
+// now for the primary spend denom. Zero when spending is disabled.
+func (a Agent) RemainingWindowBudget(now SpendClock) math.Int {
+	if !a.SpendEnabled() {
 		return math.ZeroInt()
 	}
-	return a.SpendWindowSpent
+	return a.primaryBudget().Remaining(now)
 }
 
-// RemainingWindowBudget returns the unspent budget of the window containing
-// nowHeight. Zero when spending is disabled.
-func (a Agent) RemainingWindowBudget(nowHeight uint64) math.Int {
-	if !a.SpendEnabled() {
+// SpendAllows reports whether spending amount of the primary spend denom at
+// now stays within the per-window cap.
+func (a Agent) SpendAllows(now SpendClock, amount math.Int) bool {
+	return amount.LTE(a.RemainingWindowBudget(now))
+}
+
+// RecordSpend accounts a successful transfer of amount of the primary spend
+// denom at now, rolling the window over as its window mode dictates.
+func (a *Agent) RecordSpend(now SpendClock, amount math.Int) {
+	b := a.primaryBudget()
+	b.RecordSpend(now, amount)
+	a.setPrimaryWindow(b)
+}
+
+// SpendClock is the chain position a spend window is evaluated at: block
+// windows read Height, time-based windows read Time.
+type SpendClock struct {
+	Height uint64
+	Time   time.Time
+}
+
+// NewSpendClock returns the spend clock of the current block.
+func NewSpendClock(ctx sdk.Context) SpendClock {
+	return SpendClock{
+		Height: uint64(ctx.BlockHeight()), //nolint:gosec // block height is never negative
+		Time:   ctx.BlockTime(),
+	}
+}
+
+// Bucket returns the start height of the absolute-aligned tumbling window
+// that nowHeight falls into. The window arithmetic mirrors
+// x/eibc/types.OnDemandLP (Bucket/RateAllows/RecordSpend).
+func (b SpendBudget) Bucket(nowHeight uint64) uint64 {
+	return nowHeight - (nowHeight % b.WindowBlocks)
+}
+
+// TimeBucket returns the start of the tumbling time window that now falls
+// into, aligned to multiples of the window duration since the zero time.
+func (b SpendBudget) TimeBucket(now time.Time) time.Time {
+	return now.UTC().Truncate(b.WindowDuration)
+}
+
+// rollingSlotLen is the granularity at which a rolling window ages spends.
+func (b SpendBudget) rollingSlotLen() time.Duration {
+	return b.WindowDuration / RollingWindowSlots
+}
+
+// slotLive reports whether spends recorded in slot still count at now. A slot
+// expires one slot length late rather than early, so no interval of
+// window_duration ever sees more than the limit spent.
+func (b SpendBudget) slotLive(slot SpendSlot, now time.Time) bool {
+	return now.Before(slot.Start.Add(b.rollingSlotLen() + b.WindowDuration))
+}
+
+// windowSpentAmount guards against a nil WindowSpent decoded from records
+// written before spending was configured.
+func (b SpendBudget) windowSpentAmount() math.Int {
+	if b.WindowSpent.IsNil() {
 		return math.ZeroInt()
 	}
-	spent := math.ZeroInt()
-	if a.SpendBucket(nowHeight) == a.SpendWindowStartHeight {
-		spent = a.spendWindowSpentAmount()
+	return b.WindowSpent
+}
+
+// Spent returns the amount counted against the window containing now.
+func (b SpendBudget) Spent(now SpendClock) math.Int {
+	switch b.WindowMode {
+	case SpendWindowMode_SPEND_WINDOW_MODE_TIME:
+		if b.TimeBucket(now.Time).Equal(b.WindowStartTime) {
+			return b.windowSpentAmount()
+		}
+	case SpendWindowMode_SPEND_WINDOW_MODE_ROLLING:
+		spent := math.ZeroInt()
+		for _, slot := range b.RecentSlots {
+			if b.slotLive(slot, now.Time) {
+				spent = spent.Add(slot.Spent)
+			}
+		}
+		return spent
+	default:
+		if b.Bucket(now.Height) == b.WindowStartHeight {
+			return b.windowSpentAmount()
+		}
 	}
-	limit := a.SpendLimitPerWindow
+	return math.ZeroInt()
+}
+
+// Remaining returns the unspent budget of the window containing now.
+func (b SpendBudget) Remaining(now SpendClock) math.Int {
+	limit := b.LimitPerWindow
 	if limit.IsNil() {
 		limit = math.ZeroInt()
 	}
-	return limit.Sub(spent)
+	return limit.Sub(b.Spent(now))
+}
+
+// Allows reports whether spending amount at now stays within the per-window
+// cap.
+func (b SpendBudget) Allows(now SpendClock, amount math.Int) bool {
+	return amount.LTE(b.Remaining(now))
+}
+
+// RecordSpend accounts a successful transfer of amount at now, rolling the
+// window over when now falls into a new bucket and dropping expired slots of a
+// rolling window.
+func (b *SpendBudget) RecordSpend(now SpendClock, amount math.Int) {
+	switch b.WindowMode {
+	case SpendWindowMode_SPEND_WINDOW_MODE_TIME:
+		if bucket := b.TimeBucket(now.Time); !bucket.Equal(b.WindowStartTime) {
+			b.WindowStartTime = bucket
+			b.WindowSpent = amount
+		} else {
+			b.WindowSpent = b.windowSpentAmount().Add(amount)
+		}
+	case SpendWindowMode_SPEND_WINDOW_MODE_ROLLING:
+		live := make([]SpendSlot, 0, len(b.RecentSlots)+1)
+		for _, slot := range b.RecentSlots {
+			if b.slotLive(slot, now.Time) {
+				live = append(live, slot)
+			}
+		}
+		start := now.Time.UTC().Truncate(b.rollingSlotLen())
+		if n := len(live); n > 0 && live[n-1].Start.Equal(start) {
+			live[n-1].Spent = live[n-1].Spent.Add(amount)
+		} else {
+			live = append(live, SpendSlot{Start: start, Spent: amount})
+		}
+		b.RecentSlots = live
+	default:
+		if bucket := b.Bucket(now.Height); bucket != b.WindowStartHeight {
+			b.WindowStartHeight = bucket
+			b.WindowSpent = amount
+		} else {
+			b.WindowSpent = b.windowSpentAmount().Add(amount)
+		}
+	}
 }
 
-// SpendAllows reports whether spending amount at nowHeight stays within the
-// per-window cap.
-func (a Agent) SpendAllows(nowHeight uint64, amount math.Int) bool {
-	return amount.LTE(a.RemainingWindowBudget(nowHeight))
+// hasWindowState reports whether any window bookkeeping field is set.
+func (b SpendBudget) hasWindowState() bool {
+	return b.WindowStartHeight != 0 || !b.windowSpentAmount().IsZero() ||
+		!b.WindowStartTime.IsZero() || len(b.RecentSlots) != 0
 }
 
-// RecordSpend accounts a successful transfer of amount at nowHeight, rolling
-// the window over when nowHeight falls into a new bucket.
-func (a *Agent) RecordSpend(nowHeight uint64, amount math.Int) {
-	if b := a.SpendBucket(nowHeight); b != a.SpendWindowStartHeight {
-		a.SpendWindowStartHeight = b
-		a.SpendWindowSpent = amount
-	} else {
-		a.SpendWindowSpent = a.spendWindowSpentAmount().Add(amount)
+// resetWindow clears the window bookkeeping.
+func (b *SpendBudget) resetWindow() {
+	b.WindowStartHeight = 0
+	b.WindowSpent = math.ZeroInt()
+	b.WindowStartTime = time.Time{}
+	b.RecentSlots = nil
+}
+
+// validateWindowState checks the bookkeeping of an enabled budget: only the
+// fields of its window mode are set, and they hold values RecordSpend could
+// have written.
+func (b SpendBudget) validateWindowState() error {
+	spent := b.windowSpentAmount()
+	if spent.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "negative spend window spent")
 	}
+	if spent.GT(b.LimitPerWindow) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window spent greater than spend limit")
+	}
+	switch b.WindowMode {
+	case SpendWindowMode_SPEND_WINDOW_MODE_TIME:
+		if b.WindowStartHeight != 0 || len(b.RecentSlots) != 0 {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "block or rolling window state set on time window")
+		}
+		if !b.WindowStartTime.IsZero() && !b.TimeBucket(b.WindowStartTime).Equal(b.WindowStartTime) {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window start time not bucket-aligned")
+		}
+	case SpendWindowMode_SPEND_WINDOW_MODE_ROLLING:
+		if b.WindowStartHeight != 0 || !spent.IsZero() || !b.WindowStartTime.IsZero() {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "tumbling window state set on rolling window")
+		}
+		total := math.ZeroInt()
+		for i, slot := range b.RecentSlots {
+			if slot.Spent.IsNil() || !slot.Spent.IsPositive() {
+				return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend slot amount must be positive")
+			}
+			if !slot.Start.UTC().Truncate(b.rollingSlotLen()).Equal(slot.Start) {
+				return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend slot start not aligned")
+			}
+			if i > 0 && !b.RecentSlots[i-1].Start.Before(slot.Start) {
+				return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend slots not strictly ordered")
+			}
+			total = total.Add(slot.Spent)
+		}
+		if total.GT(b.LimitPerWindow) {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rolling window spent greater than spend limit")
+		}
+	default:
+		if !b.WindowStartTime.IsZero() || len(b.RecentSlots) != 0 {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "time window state set on block window")
+		}
+		// RecordSpend only ever writes bucket starts (multiples of the window
+		// length), so anything else is not a state the runtime can produce.
+		if b.WindowStartHeight%b.WindowBlocks != 0 {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window start height not bucket-aligned")
+		}
+	}
+	return nil
 }
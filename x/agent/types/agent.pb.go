@@ -13,6 +13,7 @@ import (
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	tee "github.com/dymensionxyz/dymension/v3/x/common/tee"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -32,6 +33,42 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SpendWindowMode selects how a spend budget's window is measured.
+type SpendWindowMode int32
+
+const (
+	// SPEND_WINDOW_MODE_BLOCKS resets the budget every window_blocks blocks,
+	// aligned to multiples of window_blocks.
+	SpendWindowMode_SPEND_WINDOW_MODE_BLOCKS SpendWindowMode = 0
+	// SPEND_WINDOW_MODE_TIME resets the budget every window_duration of block
+	// time, aligned to multiples of window_duration since the zero time (so 24h
+	// windows reset at UTC midnight).
+	SpendWindowMode_SPEND_WINDOW_MODE_TIME SpendWindowMode = 1
+	// SPEND_WINDOW_MODE_ROLLING caps the sum spent over the trailing
+	// window_duration of block time instead of resetting at a boundary.
+	SpendWindowMode_SPEND_WINDOW_MODE_ROLLING SpendWindowMode = 2
+)
+
+var SpendWindowMode_name = map[int32]string{
+	0: "SPEND_WINDOW_MODE_BLOCKS",
+	1: "SPEND_WINDOW_MODE_TIME",
+	2: "SPEND_WINDOW_MODE_ROLLING",
+}
+
+var SpendWindowMode_value = map[string]int32{
+	"SPEND_WINDOW_MODE_BLOCKS":  0,
+	"SPEND_WINDOW_MODE_TIME":    1,
+	"SPEND_WINDOW_MODE_ROLLING": 2,
+}
+
+func (x SpendWindowMode) String() string {
+	return proto.EnumName(SpendWindowMode_name, int32(x))
+}
+
+func (SpendWindowMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{0}
+}
+
 // Params defines the x/agent module parameters.
 type Params struct {
 	// max_action_bytes is the maximum allowed size of an attested action payload.
@@ -47,6 +84,31 @@ type Params struct {
 	// feedback_tag_max_bytes is the maximum byte length of a feedback dimension
 	// tag.
 	FeedbackTagMaxBytes uint64 `protobuf:"varint,5,opt,name=feedback_tag_max_bytes,json=feedbackTagMaxBytes,proto3" json:"feedback_tag_max_bytes,omitempty"`
+	// feedback_decay_half_life is the age at which a feedback's weight in the
+	// weighted reputation score halves. Zero disables decay.
+	FeedbackDecayHalfLife time.Duration `protobuf:"bytes,6,opt,name=feedback_decay_half_life,json=feedbackDecayHalfLife,proto3,stdduration" json:"feedback_decay_half_life"`
+	// action_log_retention_entries is the number of most recent action log
+	// entries kept per agent. Zero keeps all.
+	ActionLogRetentionEntries uint64 `protobuf:"varint,7,opt,name=action_log_retention_entries,json=actionLogRetentionEntries,proto3" json:"action_log_retention_entries,omitempty"`
+	// action_log_retention_blocks is the number of blocks an action log entry
+	// is kept for. Zero keeps all.
+	ActionLogRetentionBlocks uint64 `protobuf:"varint,8,opt,name=action_log_retention_blocks,json=actionLogRetentionBlocks,proto3" json:"action_log_retention_blocks,omitempty"`
+	// action_log_prune_limit caps the number of action log entries pruned per
+	// block, and per appended entry.
+	ActionLogPruneLimit uint64 `protobuf:"varint,9,opt,name=action_log_prune_limit,json=actionLogPruneLimit,proto3" json:"action_log_prune_limit,omitempty"`
+	// max_sub_agent_depth is the maximum depth of a sub-agent below its root
+	// agent. Zero disables sub-agents.
+	MaxSubAgentDepth uint64 `protobuf:"varint,10,opt,name=max_sub_agent_depth,json=maxSubAgentDepth,proto3" json:"max_sub_agent_depth,omitempty"`
+	// max_sub_agents is the maximum number of direct sub-agents per agent.
+	MaxSubAgents uint64 `protobuf:"varint,11,opt,name=max_sub_agents,json=maxSubAgents,proto3" json:"max_sub_agents,omitempty"`
+	// attestation_max_age is the maximum age, at block time, of an attestation
+	// token. Accepted tokens are remembered for as long, so none is accepted
+	// twice.
+	AttestationMaxAge time.Duration `protobuf:"bytes,12,opt,name=attestation_max_age,json=attestationMaxAge,proto3,stdduration" json:"attestation_max_age"`
+	// payment_stream_process_limit caps the number of due payment streams
+	// looked at per block; the end blocker resumes after the last one in the
+	// next block.
+	PaymentStreamProcessLimit uint64 `protobuf:"varint,13,opt,name=payment_stream_process_limit,json=paymentStreamProcessLimit,proto3" json:"payment_stream_process_limit,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -117,6 +179,62 @@ func (m *Params) GetFeedbackTagMaxBytes() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeedbackDecayHalfLife() time.Duration {
+	if m != nil {
+		return m.FeedbackDecayHalfLife
+	}
+	return 0
+}
+
+func (m *Params) GetActionLogRetentionEntries() uint64 {
+	if m != nil {
+		return m.ActionLogRetentionEntries
+	}
+	return 0
+}
+
+func (m *Params) GetActionLogRetentionBlocks() uint64 {
+	if m != nil {
+		return m.ActionLogRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetActionLogPruneLimit() uint64 {
+	if m != nil {
+		return m.ActionLogPruneLimit
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSubAgentDepth() uint64 {
+	if m != nil {
+		return m.MaxSubAgentDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSubAgents() uint64 {
+	if m != nil {
+		return m.MaxSubAgents
+	}
+	return 0
+}
+
+func (m *Params) GetAttestationMaxAge() time.Duration {
+	if m != nil {
+		return m.AttestationMaxAge
+	}
+	return 0
+}
+
+func (m *Params) GetPaymentStreamProcessLimit() uint64 {
+	if m != nil {
+		return m.PaymentStreamProcessLimit
+	}
+	return 0
+}
+
 // Agent is a registered agent whose actions are attested by a TEE. Its action
 // log is an append-only sequence; action_seq is the next sequence number and
 // binds each attested action to a unique nonce.
@@ -136,13 +254,57 @@ type Agent struct {
 	// spend_limit_per_window is the max spendable per rate window.
 	SpendLimitPerWindow cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=spend_limit_per_window,json=spendLimitPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit_per_window"`
 	// spend_window_blocks is the rate window length in blocks. Must be > 0 iff
-	// spend_denom is set.
+	// spend_denom is set and spend_window_mode is SPEND_WINDOW_MODE_BLOCKS.
 	SpendWindowBlocks uint64 `protobuf:"varint,10,opt,name=spend_window_blocks,json=spendWindowBlocks,proto3" json:"spend_window_blocks,omitempty"`
 	// spend_window_start_height is the aligned bucket start of the current
 	// window.
 	SpendWindowStartHeight uint64 `protobuf:"varint,11,opt,name=spend_window_start_height,json=spendWindowStartHeight,proto3" json:"spend_window_start_height,omitempty"`
 	// spend_window_spent is the amount spent so far in the current window.
 	SpendWindowSpent cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=spend_window_spent,json=spendWindowSpent,proto3,customtype=cosmossdk.io/math.Int" json:"spend_window_spent"`
+	// spend_budgets are per-denom budgets for denoms other than spend_denom,
+	// each with its own limit and window. Sorted by denom; at most one per denom.
+	SpendBudgets []SpendBudget `protobuf:"bytes,13,rep,name=spend_budgets,json=spendBudgets,proto3" json:"spend_budgets"`
+	// spend_window_mode selects how the spend_denom window is measured.
+	SpendWindowMode SpendWindowMode `protobuf:"varint,14,opt,name=spend_window_mode,json=spendWindowMode,proto3,enum=dymensionxyz.dymension.agent.SpendWindowMode" json:"spend_window_mode,omitempty"`
+	// spend_window_duration is the window length in block time. Must be set iff
+	// spend_window_mode is time-based.
+	SpendWindowDuration time.Duration `protobuf:"bytes,15,opt,name=spend_window_duration,json=spendWindowDuration,proto3,stdduration" json:"spend_window_duration"`
+	// spend_window_start_time is the aligned start of the current window in
+	// SPEND_WINDOW_MODE_TIME.
+	SpendWindowStartTime time.Time `protobuf:"bytes,16,opt,name=spend_window_start_time,json=spendWindowStartTime,proto3,stdtime" json:"spend_window_start_time"`
+	// spend_recent_slots are the spends still inside the trailing window in
+	// SPEND_WINDOW_MODE_ROLLING, oldest first.
+	SpendRecentSlots []SpendSlot `protobuf:"bytes,17,rep,name=spend_recent_slots,json=spendRecentSlots,proto3" json:"spend_recent_slots"`
+	// security is the agent's guardian and withdrawal timelock.
+	Security SecurityPolicy `protobuf:"bytes,18,opt,name=security,proto3" json:"security"`
+	// pending_security, if set, replaces security at pending_security_height.
+	// Only updates that loosen security are deferred.
+	PendingSecurity       *SecurityPolicy `protobuf:"bytes,19,opt,name=pending_security,json=pendingSecurity,proto3" json:"pending_security,omitempty"`
+	PendingSecurityHeight int64           `protobuf:"varint,20,opt,name=pending_security_height,json=pendingSecurityHeight,proto3" json:"pending_security_height,omitempty"`
+	// frozen blocks attested actions, attested transfers and escrow
+	// withdrawals. Set and cleared by the guardian or governance.
+	Frozen bool `protobuf:"varint,21,opt,name=frozen,proto3" json:"frozen,omitempty"`
+	// policy_set holds the co-policies that attest alongside policy and the
+	// quorum of distinct policies required for high-value operations.
+	PolicySet PolicySet `protobuf:"bytes,22,opt,name=policy_set,json=policySet,proto3" json:"policy_set"`
+	// pending_policy_set, if set, replaces policy_set at
+	// pending_policy_set_height.
+	PendingPolicySet       *PolicySet `protobuf:"bytes,23,opt,name=pending_policy_set,json=pendingPolicySet,proto3" json:"pending_policy_set,omitempty"`
+	PendingPolicySetHeight int64      `protobuf:"varint,24,opt,name=pending_policy_set_height,json=pendingPolicySetHeight,proto3" json:"pending_policy_set_height,omitempty"`
+	// parent_id is the agent that created this one as a sub-agent; empty for
+	// agents registered by their owner. A sub-agent shares its parent's owner,
+	// and its transfers also count against the spend budgets of every ancestor.
+	ParentId string `protobuf:"bytes,25,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// total_spent is the lifetime total paid out of the agent's own escrow by
+	// attested transfers and payment streams.
+	TotalSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,26,rep,name=total_spent,json=totalSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_spent"`
+	// policy_id, if set, binds policy to a registered policy lineage: policy is
+	// the latest version of it, followed as the lineage is superseded. Empty
+	// when policy is embedded.
+	PolicyId string `protobuf:"bytes,27,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	// pending_policy_id is the registered policy pending_policy was resolved
+	// from; it becomes policy_id on promotion.
+	PendingPolicyId string `protobuf:"bytes,28,opt,name=pending_policy_id,json=pendingPolicyId,proto3" json:"pending_policy_id,omitempty"`
 }
 
 func (m *Agent) Reset()         { *m = Agent{} }
@@ -248,81 +410,150 @@ func (m *Agent) GetSpendWindowStartHeight() uint64 {
 	return 0
 }
 
-// AgentEscrow is one agent's escrow ledger entry: funds held in the agent
-// module account and spendable by attested transfers.
-type AgentEscrow struct {
-	AgentId string                                   `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+func (m *Agent) GetSpendBudgets() []SpendBudget {
+	if m != nil {
+		return m.SpendBudgets
+	}
+	return nil
 }
 
-func (m *AgentEscrow) Reset()         { *m = AgentEscrow{} }
-func (m *AgentEscrow) String() string { return proto.CompactTextString(m) }
-func (*AgentEscrow) ProtoMessage()    {}
-func (*AgentEscrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{2}
+func (m *Agent) GetSpendWindowMode() SpendWindowMode {
+	if m != nil {
+		return m.SpendWindowMode
+	}
+	return SpendWindowMode_SPEND_WINDOW_MODE_BLOCKS
 }
-func (m *AgentEscrow) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
+
+func (m *Agent) GetSpendWindowDuration() time.Duration {
+	if m != nil {
+		return m.SpendWindowDuration
+	}
+	return 0
 }
-func (m *AgentEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_AgentEscrow.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+
+func (m *Agent) GetSpendWindowStartTime() time.Time {
+	if m != nil {
+		return m.SpendWindowStartTime
 	}
+	return time.Time{}
 }
-func (m *AgentEscrow) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AgentEscrow.Merge(m, src)
+
+func (m *Agent) GetSpendRecentSlots() []SpendSlot {
+	if m != nil {
+		return m.SpendRecentSlots
+	}
+	return nil
 }
-func (m *AgentEscrow) XXX_Size() int {
-	return m.Size()
+
+func (m *Agent) GetSecurity() SecurityPolicy {
+	if m != nil {
+		return m.Security
+	}
+	return SecurityPolicy{}
 }
-func (m *AgentEscrow) XXX_DiscardUnknown() {
-	xxx_messageInfo_AgentEscrow.DiscardUnknown(m)
+
+func (m *Agent) GetPendingSecurity() *SecurityPolicy {
+	if m != nil {
+		return m.PendingSecurity
+	}
+	return nil
 }
 
-var xxx_messageInfo_AgentEscrow proto.InternalMessageInfo
+func (m *Agent) GetPendingSecurityHeight() int64 {
+	if m != nil {
+		return m.PendingSecurityHeight
+	}
+	return 0
+}
 
-func (m *AgentEscrow) GetAgentId() string {
+func (m *Agent) GetFrozen() bool {
 	if m != nil {
-		return m.AgentId
+		return m.Frozen
+	}
+	return false
+}
+
+func (m *Agent) GetPolicySet() PolicySet {
+	if m != nil {
+		return m.PolicySet
+	}
+	return PolicySet{}
+}
+
+func (m *Agent) GetPendingPolicySet() *PolicySet {
+	if m != nil {
+		return m.PendingPolicySet
+	}
+	return nil
+}
+
+func (m *Agent) GetPendingPolicySetHeight() int64 {
+	if m != nil {
+		return m.PendingPolicySetHeight
+	}
+	return 0
+}
+
+func (m *Agent) GetParentId() string {
+	if m != nil {
+		return m.ParentId
 	}
 	return ""
 }
 
-func (m *AgentEscrow) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *Agent) GetTotalSpent() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Balance
+		return m.TotalSpent
 	}
 	return nil
 }
 
-// EventRegisterAgent is emitted when an agent is registered.
-type EventRegisterAgent struct {
-	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Owner   string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	// fingerprint is the lowercase hex SHA-256 of the deterministic proto
-	// marshaling of the agent's policy; pass it to MsgRevokePolicy to revoke.
-	Fingerprint string `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+func (m *Agent) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
 }
 
-func (m *EventRegisterAgent) Reset()         { *m = EventRegisterAgent{} }
-func (m *EventRegisterAgent) String() string { return proto.CompactTextString(m) }
-func (*EventRegisterAgent) ProtoMessage()    {}
-func (*EventRegisterAgent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{3}
+func (m *Agent) GetPendingPolicyId() string {
+	if m != nil {
+		return m.PendingPolicyId
+	}
+	return ""
 }
-func (m *EventRegisterAgent) XXX_Unmarshal(b []byte) error {
+
+// RegisteredPolicy is a TEE policy in the shared policy registry, addressed by
+// its admin and fingerprint. Superseding a policy registers its successor as
+// the next version of the same lineage; referrers follow the lineage to its
+// head, bound agents after the policy rotation delay.
+type RegisteredPolicy struct {
+	// id is the hash of the admin and the policy fingerprint.
+	Id     string     `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Policy tee.Policy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	// admin may supersede the policy; governance always may.
+	Admin string `protobuf:"bytes,3,opt,name=admin,proto3" json:"admin,omitempty"`
+	// version is 1 for a newly registered policy and one more than its
+	// predecessor's for a successor.
+	Version uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
+	// supersedes is the predecessor's id; empty for version 1.
+	Supersedes string `protobuf:"bytes,5,opt,name=supersedes,proto3" json:"supersedes,omitempty"`
+	// superseded_by is the successor's id; empty for the lineage head.
+	SupersededBy  string `protobuf:"bytes,6,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	CreatedHeight int64  `protobuf:"varint,7,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+}
+
+func (m *RegisteredPolicy) Reset()         { *m = RegisteredPolicy{} }
+func (m *RegisteredPolicy) String() string { return proto.CompactTextString(m) }
+func (*RegisteredPolicy) ProtoMessage()    {}
+func (*RegisteredPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{2}
+}
+func (m *RegisteredPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventRegisterAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *RegisteredPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventRegisterAgent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_RegisteredPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -332,57 +563,93 @@ func (m *EventRegisterAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventRegisterAgent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventRegisterAgent.Merge(m, src)
+func (m *RegisteredPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredPolicy.Merge(m, src)
 }
-func (m *EventRegisterAgent) XXX_Size() int {
+func (m *RegisteredPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventRegisterAgent) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventRegisterAgent.DiscardUnknown(m)
+func (m *RegisteredPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventRegisterAgent proto.InternalMessageInfo
+var xxx_messageInfo_RegisteredPolicy proto.InternalMessageInfo
 
-func (m *EventRegisterAgent) GetAgentId() string {
+func (m *RegisteredPolicy) GetId() string {
 	if m != nil {
-		return m.AgentId
+		return m.Id
 	}
 	return ""
 }
 
-func (m *EventRegisterAgent) GetOwner() string {
+func (m *RegisteredPolicy) GetPolicy() tee.Policy {
 	if m != nil {
-		return m.Owner
+		return m.Policy
+	}
+	return tee.Policy{}
+}
+
+func (m *RegisteredPolicy) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
 	return ""
 }
 
-func (m *EventRegisterAgent) GetFingerprint() string {
+func (m *RegisteredPolicy) GetVersion() uint64 {
 	if m != nil {
-		return m.Fingerprint
+		return m.Version
+	}
+	return 0
+}
+
+func (m *RegisteredPolicy) GetSupersedes() string {
+	if m != nil {
+		return m.Supersedes
 	}
 	return ""
 }
 
-// EventDeactivateAgent is emitted when an agent is deactivated.
-type EventDeactivateAgent struct {
-	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Owner   string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+func (m *RegisteredPolicy) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
 }
 
-func (m *EventDeactivateAgent) Reset()         { *m = EventDeactivateAgent{} }
-func (m *EventDeactivateAgent) String() string { return proto.CompactTextString(m) }
-func (*EventDeactivateAgent) ProtoMessage()    {}
-func (*EventDeactivateAgent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{4}
+func (m *RegisteredPolicy) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
 }
-func (m *EventDeactivateAgent) XXX_Unmarshal(b []byte) error {
+
+// SubAgentSpec is what a parent agent attests to when it creates a
+// sub-agent: the sub-agent's policies, the budget carved out of the parent's
+// escrow and the sub-agent's own spend budgets.
+type SubAgentSpec struct {
+	AgentId   string     `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Policy    tee.Policy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	PolicySet PolicySet  `protobuf:"bytes,3,opt,name=policy_set,json=policySet,proto3" json:"policy_set"`
+	// budget is moved from the parent's escrow to the sub-agent's.
+	Budget github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+	// spend_budgets are the sub-agent's per-denom spend budgets. Each denom
+	// must be budgeted on the parent with at least the same limit.
+	SpendBudgets []SpendBudget `protobuf:"bytes,5,rep,name=spend_budgets,json=spendBudgets,proto3" json:"spend_budgets"`
+}
+
+func (m *SubAgentSpec) Reset()         { *m = SubAgentSpec{} }
+func (m *SubAgentSpec) String() string { return proto.CompactTextString(m) }
+func (*SubAgentSpec) ProtoMessage()    {}
+func (*SubAgentSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{3}
+}
+func (m *SubAgentSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventDeactivateAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubAgentSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventDeactivateAgent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubAgentSpec.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -392,50 +659,72 @@ func (m *EventDeactivateAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventDeactivateAgent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventDeactivateAgent.Merge(m, src)
+func (m *SubAgentSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubAgentSpec.Merge(m, src)
 }
-func (m *EventDeactivateAgent) XXX_Size() int {
+func (m *SubAgentSpec) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventDeactivateAgent) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventDeactivateAgent.DiscardUnknown(m)
+func (m *SubAgentSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubAgentSpec.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventDeactivateAgent proto.InternalMessageInfo
+var xxx_messageInfo_SubAgentSpec proto.InternalMessageInfo
 
-func (m *EventDeactivateAgent) GetAgentId() string {
+func (m *SubAgentSpec) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-func (m *EventDeactivateAgent) GetOwner() string {
+func (m *SubAgentSpec) GetPolicy() tee.Policy {
 	if m != nil {
-		return m.Owner
+		return m.Policy
 	}
-	return ""
+	return tee.Policy{}
 }
 
-// EventPolicyRevoked is emitted when a policy fingerprint is revoked.
-type EventPolicyRevoked struct {
-	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	Reason      string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+func (m *SubAgentSpec) GetPolicySet() PolicySet {
+	if m != nil {
+		return m.PolicySet
+	}
+	return PolicySet{}
 }
 
-func (m *EventPolicyRevoked) Reset()         { *m = EventPolicyRevoked{} }
-func (m *EventPolicyRevoked) String() string { return proto.CompactTextString(m) }
-func (*EventPolicyRevoked) ProtoMessage()    {}
-func (*EventPolicyRevoked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{5}
+func (m *SubAgentSpec) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
+	}
+	return nil
 }
-func (m *EventPolicyRevoked) XXX_Unmarshal(b []byte) error {
+
+func (m *SubAgentSpec) GetSpendBudgets() []SpendBudget {
+	if m != nil {
+		return m.SpendBudgets
+	}
+	return nil
+}
+
+// PolicySet is the set of policies an agent attests under beyond its primary
+// policy. Policy index 0 is the primary policy and index i is co_policies[i-1].
+type PolicySet struct {
+	CoPolicies []tee.Policy      `protobuf:"bytes,1,rep,name=co_policies,json=coPolicies,proto3" json:"co_policies"`
+	Quorum     AttestationQuorum `protobuf:"bytes,2,opt,name=quorum,proto3" json:"quorum"`
+}
+
+func (m *PolicySet) Reset()         { *m = PolicySet{} }
+func (m *PolicySet) String() string { return proto.CompactTextString(m) }
+func (*PolicySet) ProtoMessage()    {}
+func (*PolicySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{4}
+}
+func (m *PolicySet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPolicyRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PolicySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPolicyRevoked.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PolicySet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -445,49 +734,56 @@ func (m *EventPolicyRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *EventPolicyRevoked) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPolicyRevoked.Merge(m, src)
+func (m *PolicySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PolicySet.Merge(m, src)
 }
-func (m *EventPolicyRevoked) XXX_Size() int {
+func (m *PolicySet) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPolicyRevoked) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPolicyRevoked.DiscardUnknown(m)
+func (m *PolicySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_PolicySet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPolicyRevoked proto.InternalMessageInfo
+var xxx_messageInfo_PolicySet proto.InternalMessageInfo
 
-func (m *EventPolicyRevoked) GetFingerprint() string {
+func (m *PolicySet) GetCoPolicies() []tee.Policy {
 	if m != nil {
-		return m.Fingerprint
+		return m.CoPolicies
 	}
-	return ""
+	return nil
 }
 
-func (m *EventPolicyRevoked) GetReason() string {
+func (m *PolicySet) GetQuorum() AttestationQuorum {
 	if m != nil {
-		return m.Reason
+		return m.Quorum
 	}
-	return ""
+	return AttestationQuorum{}
 }
 
-// EventPolicyUnrevoked is emitted when a policy fingerprint is unrevoked.
-type EventPolicyUnrevoked struct {
-	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+// AttestationQuorum is the number of distinct policies that must attest an
+// operation once it crosses the value threshold. The primary policy always
+// attests, so a quorum of one or less is a single attestation.
+type AttestationQuorum struct {
+	Required uint32 `protobuf:"varint,1,opt,name=required,proto3" json:"required,omitempty"`
+	// transfer_threshold is, per denom, the transfer amount from which the
+	// quorum applies. Transfers in denoms not listed always need the quorum.
+	TransferThreshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=transfer_threshold,json=transferThreshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"transfer_threshold"`
+	// actions requires the quorum for attested actions too.
+	Actions bool `protobuf:"varint,3,opt,name=actions,proto3" json:"actions,omitempty"`
 }
 
-func (m *EventPolicyUnrevoked) Reset()         { *m = EventPolicyUnrevoked{} }
-func (m *EventPolicyUnrevoked) String() string { return proto.CompactTextString(m) }
-func (*EventPolicyUnrevoked) ProtoMessage()    {}
-func (*EventPolicyUnrevoked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{6}
+func (m *AttestationQuorum) Reset()         { *m = AttestationQuorum{} }
+func (m *AttestationQuorum) String() string { return proto.CompactTextString(m) }
+func (*AttestationQuorum) ProtoMessage()    {}
+func (*AttestationQuorum) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{5}
 }
-func (m *EventPolicyUnrevoked) XXX_Unmarshal(b []byte) error {
+func (m *AttestationQuorum) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventPolicyUnrevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AttestationQuorum) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventPolicyUnrevoked.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AttestationQuorum.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -497,44 +793,60 @@ func (m *EventPolicyUnrevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventPolicyUnrevoked) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventPolicyUnrevoked.Merge(m, src)
+func (m *AttestationQuorum) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationQuorum.Merge(m, src)
 }
-func (m *EventPolicyUnrevoked) XXX_Size() int {
+func (m *AttestationQuorum) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventPolicyUnrevoked) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventPolicyUnrevoked.DiscardUnknown(m)
+func (m *AttestationQuorum) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationQuorum.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventPolicyUnrevoked proto.InternalMessageInfo
+var xxx_messageInfo_AttestationQuorum proto.InternalMessageInfo
 
-func (m *EventPolicyUnrevoked) GetFingerprint() string {
+func (m *AttestationQuorum) GetRequired() uint32 {
 	if m != nil {
-		return m.Fingerprint
+		return m.Required
 	}
-	return ""
+	return 0
 }
 
-// EventUpdateAgentPolicy is emitted when a timelocked policy rotation is
-// scheduled.
-type EventUpdateAgentPolicy struct {
-	AgentId          string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	ActivationHeight int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+func (m *AttestationQuorum) GetTransferThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TransferThreshold
+	}
+	return nil
 }
 
-func (m *EventUpdateAgentPolicy) Reset()         { *m = EventUpdateAgentPolicy{} }
-func (m *EventUpdateAgentPolicy) String() string { return proto.CompactTextString(m) }
-func (*EventUpdateAgentPolicy) ProtoMessage()    {}
-func (*EventUpdateAgentPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{7}
+func (m *AttestationQuorum) GetActions() bool {
+	if m != nil {
+		return m.Actions
+	}
+	return false
 }
-func (m *EventUpdateAgentPolicy) XXX_Unmarshal(b []byte) error {
+
+// SecurityPolicy is an agent's emergency controls.
+type SecurityPolicy struct {
+	// guardian, if set, may freeze and unfreeze the agent.
+	Guardian string `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// withdrawal_delay_blocks is the timelock on escrow withdrawals. Zero pays
+	// withdrawals out immediately.
+	WithdrawalDelayBlocks uint64 `protobuf:"varint,2,opt,name=withdrawal_delay_blocks,json=withdrawalDelayBlocks,proto3" json:"withdrawal_delay_blocks,omitempty"`
+}
+
+func (m *SecurityPolicy) Reset()         { *m = SecurityPolicy{} }
+func (m *SecurityPolicy) String() string { return proto.CompactTextString(m) }
+func (*SecurityPolicy) ProtoMessage()    {}
+func (*SecurityPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{6}
+}
+func (m *SecurityPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventUpdateAgentPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SecurityPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventUpdateAgentPolicy.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SecurityPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -544,54 +856,56 @@ func (m *EventUpdateAgentPolicy) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *EventUpdateAgentPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventUpdateAgentPolicy.Merge(m, src)
+func (m *SecurityPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SecurityPolicy.Merge(m, src)
 }
-func (m *EventUpdateAgentPolicy) XXX_Size() int {
+func (m *SecurityPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventUpdateAgentPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventUpdateAgentPolicy.DiscardUnknown(m)
+func (m *SecurityPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_SecurityPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventUpdateAgentPolicy proto.InternalMessageInfo
+var xxx_messageInfo_SecurityPolicy proto.InternalMessageInfo
 
-func (m *EventUpdateAgentPolicy) GetAgentId() string {
+func (m *SecurityPolicy) GetGuardian() string {
 	if m != nil {
-		return m.AgentId
+		return m.Guardian
 	}
 	return ""
 }
 
-func (m *EventUpdateAgentPolicy) GetActivationHeight() int64 {
+func (m *SecurityPolicy) GetWithdrawalDelayBlocks() uint64 {
 	if m != nil {
-		return m.ActivationHeight
+		return m.WithdrawalDelayBlocks
 	}
 	return 0
 }
 
-// EventAttestedTransfer is emitted when an attested transfer pays out from an
-// agent's escrow.
-type EventAttestedTransfer struct {
-	AgentId   string     `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Seq       uint64     `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
-	Recipient string     `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
-	Submitter string     `protobuf:"bytes,5,opt,name=submitter,proto3" json:"submitter,omitempty"`
+// PendingWithdrawal is an escrow withdrawal waiting out the agent's
+// withdrawal delay. Its amount is already debited from the escrow ledger.
+type PendingWithdrawal struct {
+	Id      uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// owner is the account the withdrawal pays out to.
+	Owner  string                                   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// mature_height is the height at whose end block the withdrawal is paid.
+	MatureHeight int64 `protobuf:"varint,5,opt,name=mature_height,json=matureHeight,proto3" json:"mature_height,omitempty"`
 }
 
-func (m *EventAttestedTransfer) Reset()         { *m = EventAttestedTransfer{} }
-func (m *EventAttestedTransfer) String() string { return proto.CompactTextString(m) }
-func (*EventAttestedTransfer) ProtoMessage()    {}
-func (*EventAttestedTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{8}
+func (m *PendingWithdrawal) Reset()         { *m = PendingWithdrawal{} }
+func (m *PendingWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*PendingWithdrawal) ProtoMessage()    {}
+func (*PendingWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{7}
 }
-func (m *EventAttestedTransfer) XXX_Unmarshal(b []byte) error {
+func (m *PendingWithdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventAttestedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PendingWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventAttestedTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PendingWithdrawal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -601,72 +915,92 @@ func (m *EventAttestedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *EventAttestedTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventAttestedTransfer.Merge(m, src)
+func (m *PendingWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingWithdrawal.Merge(m, src)
 }
-func (m *EventAttestedTransfer) XXX_Size() int {
+func (m *PendingWithdrawal) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventAttestedTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventAttestedTransfer.DiscardUnknown(m)
+func (m *PendingWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingWithdrawal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventAttestedTransfer proto.InternalMessageInfo
+var xxx_messageInfo_PendingWithdrawal proto.InternalMessageInfo
 
-func (m *EventAttestedTransfer) GetAgentId() string {
+func (m *PendingWithdrawal) GetId() uint64 {
 	if m != nil {
-		return m.AgentId
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *EventAttestedTransfer) GetSeq() uint64 {
+func (m *PendingWithdrawal) GetAgentId() string {
 	if m != nil {
-		return m.Seq
+		return m.AgentId
 	}
-	return 0
+	return ""
 }
 
-func (m *EventAttestedTransfer) GetRecipient() string {
+func (m *PendingWithdrawal) GetOwner() string {
 	if m != nil {
-		return m.Recipient
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *EventAttestedTransfer) GetAmount() types.Coin {
+func (m *PendingWithdrawal) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
-	return types.Coin{}
+	return nil
 }
 
-func (m *EventAttestedTransfer) GetSubmitter() string {
+func (m *PendingWithdrawal) GetMatureHeight() int64 {
 	if m != nil {
-		return m.Submitter
+		return m.MatureHeight
 	}
-	return ""
-}
-
-// EventFundAgentEscrow is emitted when an agent's escrow is funded.
-type EventFundAgentEscrow struct {
-	AgentId string                                   `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Funder  string                                   `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
-	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	return 0
 }
 
-func (m *EventFundAgentEscrow) Reset()         { *m = EventFundAgentEscrow{} }
-func (m *EventFundAgentEscrow) String() string { return proto.CompactTextString(m) }
-func (*EventFundAgentEscrow) ProtoMessage()    {}
-func (*EventFundAgentEscrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{9}
+// PaymentStream is an owner-approved recurring payout from an agent's escrow
+// to a fixed recipient, paid by the end blocker without an attestation. Each
+// payout counts against the agent's spend budget and recipient policy.
+type PaymentStream struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	AgentId   string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount_per_period is paid once per elapsed period.
+	AmountPerPeriod types.Coin    `protobuf:"bytes,4,opt,name=amount_per_period,json=amountPerPeriod,proto3" json:"amount_per_period"`
+	Period          time.Duration `protobuf:"bytes,5,opt,name=period,proto3,stdduration" json:"period"`
+	// next_payment_time is when the next period falls due.
+	NextPaymentTime time.Time `protobuf:"bytes,6,opt,name=next_payment_time,json=nextPaymentTime,proto3,stdtime" json:"next_payment_time"`
+	// end_time is exclusive: no period falls due at or after it.
+	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// activation_height is the first height at which the stream pays: the
+	// creation height plus the agent's withdrawal delay, so a stolen owner key
+	// cannot use a stream to bypass the withdrawal timelock. Periods falling
+	// due before it are paid at activation.
+	ActivationHeight int64 `protobuf:"varint,8,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// paid is the total paid out so far.
+	Paid cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+	// missed_periods counts periods skipped because the payout failed (e.g.
+	// budget exhausted, escrow short, agent frozen). Missed periods are not
+	// paid later.
+	MissedPeriods uint64 `protobuf:"varint,10,opt,name=missed_periods,json=missedPeriods,proto3" json:"missed_periods,omitempty"`
+}
+
+func (m *PaymentStream) Reset()         { *m = PaymentStream{} }
+func (m *PaymentStream) String() string { return proto.CompactTextString(m) }
+func (*PaymentStream) ProtoMessage()    {}
+func (*PaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{8}
 }
-func (m *EventFundAgentEscrow) XXX_Unmarshal(b []byte) error {
+func (m *PaymentStream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventFundAgentEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventFundAgentEscrow.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PaymentStream.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -676,122 +1010,101 @@ func (m *EventFundAgentEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *EventFundAgentEscrow) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventFundAgentEscrow.Merge(m, src)
+func (m *PaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PaymentStream.Merge(m, src)
 }
-func (m *EventFundAgentEscrow) XXX_Size() int {
+func (m *PaymentStream) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventFundAgentEscrow) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventFundAgentEscrow.DiscardUnknown(m)
+func (m *PaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_PaymentStream.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EventFundAgentEscrow proto.InternalMessageInfo
+var xxx_messageInfo_PaymentStream proto.InternalMessageInfo
 
-func (m *EventFundAgentEscrow) GetAgentId() string {
+func (m *PaymentStream) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PaymentStream) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-func (m *EventFundAgentEscrow) GetFunder() string {
+func (m *PaymentStream) GetRecipient() string {
 	if m != nil {
-		return m.Funder
+		return m.Recipient
 	}
 	return ""
 }
 
-func (m *EventFundAgentEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *PaymentStream) GetAmountPerPeriod() types.Coin {
 	if m != nil {
-		return m.Amount
+		return m.AmountPerPeriod
 	}
-	return nil
+	return types.Coin{}
 }
 
-// EventWithdrawAgentEscrow is emitted when the owner withdraws from an
-// agent's escrow.
-type EventWithdrawAgentEscrow struct {
-	AgentId string                                   `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Owner   string                                   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+func (m *PaymentStream) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
 }
 
-func (m *EventWithdrawAgentEscrow) Reset()         { *m = EventWithdrawAgentEscrow{} }
-func (m *EventWithdrawAgentEscrow) String() string { return proto.CompactTextString(m) }
-func (*EventWithdrawAgentEscrow) ProtoMessage()    {}
-func (*EventWithdrawAgentEscrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{10}
-}
-func (m *EventWithdrawAgentEscrow) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *EventWithdrawAgentEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_EventWithdrawAgentEscrow.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *PaymentStream) GetNextPaymentTime() time.Time {
+	if m != nil {
+		return m.NextPaymentTime
 	}
+	return time.Time{}
 }
-func (m *EventWithdrawAgentEscrow) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventWithdrawAgentEscrow.Merge(m, src)
-}
-func (m *EventWithdrawAgentEscrow) XXX_Size() int {
-	return m.Size()
-}
-func (m *EventWithdrawAgentEscrow) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventWithdrawAgentEscrow.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventWithdrawAgentEscrow proto.InternalMessageInfo
 
-func (m *EventWithdrawAgentEscrow) GetAgentId() string {
+func (m *PaymentStream) GetEndTime() time.Time {
 	if m != nil {
-		return m.AgentId
+		return m.EndTime
 	}
-	return ""
+	return time.Time{}
 }
 
-func (m *EventWithdrawAgentEscrow) GetOwner() string {
+func (m *PaymentStream) GetActivationHeight() int64 {
 	if m != nil {
-		return m.Owner
+		return m.ActivationHeight
 	}
-	return ""
+	return 0
 }
 
-func (m *EventWithdrawAgentEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *PaymentStream) GetMissedPeriods() uint64 {
 	if m != nil {
-		return m.Amount
+		return m.MissedPeriods
 	}
-	return nil
+	return 0
 }
 
-// EventUpdateAgentSpendPolicy is emitted when the owner updates an agent's
-// spend policy.
-type EventUpdateAgentSpendPolicy struct {
-	AgentId             string                `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	SpendDenom          string                `protobuf:"bytes,2,opt,name=spend_denom,json=spendDenom,proto3" json:"spend_denom,omitempty"`
-	SpendLimitPerWindow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=spend_limit_per_window,json=spendLimitPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit_per_window"`
-	SpendWindowBlocks   uint64                `protobuf:"varint,4,opt,name=spend_window_blocks,json=spendWindowBlocks,proto3" json:"spend_window_blocks,omitempty"`
+// SpendSlot is the amount spent in one slot of a rolling window. Slots are
+// window_duration / 24 long.
+type SpendSlot struct {
+	// start is the aligned start of the slot.
+	Start time.Time             `protobuf:"bytes,1,opt,name=start,proto3,stdtime" json:"start"`
+	Spent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
 }
 
-func (m *EventUpdateAgentSpendPolicy) Reset()         { *m = EventUpdateAgentSpendPolicy{} }
-func (m *EventUpdateAgentSpendPolicy) String() string { return proto.CompactTextString(m) }
-func (*EventUpdateAgentSpendPolicy) ProtoMessage()    {}
-func (*EventUpdateAgentSpendPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{11}
+func (m *SpendSlot) Reset()         { *m = SpendSlot{} }
+func (m *SpendSlot) String() string { return proto.CompactTextString(m) }
+func (*SpendSlot) ProtoMessage()    {}
+func (*SpendSlot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{9}
 }
-func (m *EventUpdateAgentSpendPolicy) XXX_Unmarshal(b []byte) error {
+func (m *SpendSlot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *EventUpdateAgentSpendPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpendSlot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_EventUpdateAgentSpendPolicy.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpendSlot.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -801,62 +1114,62 @@ func (m *EventUpdateAgentSpendPolicy) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *EventUpdateAgentSpendPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EventUpdateAgentSpendPolicy.Merge(m, src)
+func (m *SpendSlot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendSlot.Merge(m, src)
 }
-func (m *EventUpdateAgentSpendPolicy) XXX_Size() int {
+func (m *SpendSlot) XXX_Size() int {
 	return m.Size()
 }
-func (m *EventUpdateAgentSpendPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_EventUpdateAgentSpendPolicy.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_EventUpdateAgentSpendPolicy proto.InternalMessageInfo
-
-func (m *EventUpdateAgentSpendPolicy) GetAgentId() string {
-	if m != nil {
-		return m.AgentId
-	}
-	return ""
+func (m *SpendSlot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendSlot.DiscardUnknown(m)
 }
 
-func (m *EventUpdateAgentSpendPolicy) GetSpendDenom() string {
-	if m != nil {
-		return m.SpendDenom
-	}
-	return ""
-}
+var xxx_messageInfo_SpendSlot proto.InternalMessageInfo
 
-func (m *EventUpdateAgentSpendPolicy) GetSpendWindowBlocks() uint64 {
+func (m *SpendSlot) GetStart() time.Time {
 	if m != nil {
-		return m.SpendWindowBlocks
+		return m.Start
 	}
-	return 0
-}
-
-// ActionLogEntry is an immutable record of one attested action, keyed by
-// (agent_id, seq).
-type ActionLogEntry struct {
-	AgentId     string    `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Seq         uint64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
-	Payload     []byte    `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
-	PayloadHash []byte    `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
-	Height      int64     `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
-	Time        time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+	return time.Time{}
 }
 
-func (m *ActionLogEntry) Reset()         { *m = ActionLogEntry{} }
-func (m *ActionLogEntry) String() string { return proto.CompactTextString(m) }
-func (*ActionLogEntry) ProtoMessage()    {}
-func (*ActionLogEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_82de718b81b99b21, []int{12}
+// SpendBudget is a per-denom spend cap over a block or block-time window,
+// plus the bookkeeping of the current window.
+type SpendBudget struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// limit_per_window is the max spendable per rate window.
+	LimitPerWindow cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=limit_per_window,json=limitPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"limit_per_window"`
+	// window_blocks is the rate window length in blocks. Must be > 0 iff
+	// window_mode is SPEND_WINDOW_MODE_BLOCKS.
+	WindowBlocks uint64 `protobuf:"varint,3,opt,name=window_blocks,json=windowBlocks,proto3" json:"window_blocks,omitempty"`
+	// window_start_height is the aligned bucket start of the current window.
+	WindowStartHeight uint64 `protobuf:"varint,4,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// window_spent is the amount spent so far in the current window.
+	WindowSpent cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=window_spent,json=windowSpent,proto3,customtype=cosmossdk.io/math.Int" json:"window_spent"`
+	WindowMode  SpendWindowMode       `protobuf:"varint,6,opt,name=window_mode,json=windowMode,proto3,enum=dymensionxyz.dymension.agent.SpendWindowMode" json:"window_mode,omitempty"`
+	// window_duration is the window length in block time. Must be set iff
+	// window_mode is time-based.
+	WindowDuration time.Duration `protobuf:"bytes,7,opt,name=window_duration,json=windowDuration,proto3,stdduration" json:"window_duration"`
+	// window_start_time is the aligned start of the current window in
+	// SPEND_WINDOW_MODE_TIME.
+	WindowStartTime time.Time `protobuf:"bytes,8,opt,name=window_start_time,json=windowStartTime,proto3,stdtime" json:"window_start_time"`
+	// recent_slots are the spends still inside the trailing window in
+	// SPEND_WINDOW_MODE_ROLLING, oldest first.
+	RecentSlots []SpendSlot `protobuf:"bytes,9,rep,name=recent_slots,json=recentSlots,proto3" json:"recent_slots"`
+}
+
+func (m *SpendBudget) Reset()         { *m = SpendBudget{} }
+func (m *SpendBudget) String() string { return proto.CompactTextString(m) }
+func (*SpendBudget) ProtoMessage()    {}
+func (*SpendBudget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{10}
 }
-func (m *ActionLogEntry) XXX_Unmarshal(b []byte) error {
+func (m *SpendBudget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ActionLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SpendBudget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ActionLogEntry.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SpendBudget.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -866,1140 +1179,11017 @@ func (m *ActionLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *ActionLogEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ActionLogEntry.Merge(m, src)
+func (m *SpendBudget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpendBudget.Merge(m, src)
 }
-func (m *ActionLogEntry) XXX_Size() int {
+func (m *SpendBudget) XXX_Size() int {
 	return m.Size()
 }
-func (m *ActionLogEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_ActionLogEntry.DiscardUnknown(m)
+func (m *SpendBudget) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpendBudget.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ActionLogEntry proto.InternalMessageInfo
+var xxx_messageInfo_SpendBudget proto.InternalMessageInfo
 
-func (m *ActionLogEntry) GetAgentId() string {
+func (m *SpendBudget) GetDenom() string {
 	if m != nil {
-		return m.AgentId
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *ActionLogEntry) GetSeq() uint64 {
+func (m *SpendBudget) GetWindowBlocks() uint64 {
 	if m != nil {
-		return m.Seq
+		return m.WindowBlocks
 	}
 	return 0
 }
 
-func (m *ActionLogEntry) GetPayload() []byte {
+func (m *SpendBudget) GetWindowStartHeight() uint64 {
 	if m != nil {
-		return m.Payload
+		return m.WindowStartHeight
 	}
-	return nil
+	return 0
 }
 
-func (m *ActionLogEntry) GetPayloadHash() []byte {
+func (m *SpendBudget) GetWindowMode() SpendWindowMode {
 	if m != nil {
-		return m.PayloadHash
+		return m.WindowMode
 	}
-	return nil
+	return SpendWindowMode_SPEND_WINDOW_MODE_BLOCKS
 }
 
-func (m *ActionLogEntry) GetHeight() int64 {
+func (m *SpendBudget) GetWindowDuration() time.Duration {
 	if m != nil {
-		return m.Height
+		return m.WindowDuration
 	}
 	return 0
 }
 
-func (m *ActionLogEntry) GetTime() time.Time {
+func (m *SpendBudget) GetWindowStartTime() time.Time {
 	if m != nil {
-		return m.Time
+		return m.WindowStartTime
 	}
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.agent.Params")
-	proto.RegisterType((*Agent)(nil), "dymensionxyz.dymension.agent.Agent")
-	proto.RegisterType((*AgentEscrow)(nil), "dymensionxyz.dymension.agent.AgentEscrow")
-	proto.RegisterType((*EventRegisterAgent)(nil), "dymensionxyz.dymension.agent.EventRegisterAgent")
-	proto.RegisterType((*EventDeactivateAgent)(nil), "dymensionxyz.dymension.agent.EventDeactivateAgent")
-	proto.RegisterType((*EventPolicyRevoked)(nil), "dymensionxyz.dymension.agent.EventPolicyRevoked")
-	proto.RegisterType((*EventPolicyUnrevoked)(nil), "dymensionxyz.dymension.agent.EventPolicyUnrevoked")
-	proto.RegisterType((*EventUpdateAgentPolicy)(nil), "dymensionxyz.dymension.agent.EventUpdateAgentPolicy")
-	proto.RegisterType((*EventAttestedTransfer)(nil), "dymensionxyz.dymension.agent.EventAttestedTransfer")
-	proto.RegisterType((*EventFundAgentEscrow)(nil), "dymensionxyz.dymension.agent.EventFundAgentEscrow")
-	proto.RegisterType((*EventWithdrawAgentEscrow)(nil), "dymensionxyz.dymension.agent.EventWithdrawAgentEscrow")
-	proto.RegisterType((*EventUpdateAgentSpendPolicy)(nil), "dymensionxyz.dymension.agent.EventUpdateAgentSpendPolicy")
-	proto.RegisterType((*ActionLogEntry)(nil), "dymensionxyz.dymension.agent.ActionLogEntry")
+func (m *SpendBudget) GetRecentSlots() []SpendSlot {
+	if m != nil {
+		return m.RecentSlots
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/agent/agent.proto", fileDescriptor_82de718b81b99b21)
+// AgentEscrow is one agent's escrow ledger entry: funds held in the agent
+// module account and spendable by attested transfers.
+type AgentEscrow struct {
+	AgentId string                                   `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
 }
 
-var fileDescriptor_82de718b81b99b21 = []byte{
-	// 1084 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0x8e, 0x13, 0x3f, 0x87, 0x28, 0x9d, 0xa6, 0x96, 0x13, 0x82, 0x1d, 0x56, 0x42,
-	0x58, 0xaa, 0xba, 0x4b, 0x93, 0x03, 0xe5, 0x84, 0xe2, 0x26, 0xa5, 0x91, 0x02, 0x8a, 0xb6, 0x89,
-	0x2a, 0xb8, 0x6c, 0xc7, 0xbb, 0xe3, 0xf5, 0x28, 0xde, 0x99, 0xed, 0xce, 0x38, 0x89, 0xf9, 0x12,
-	0xf4, 0x73, 0x70, 0x40, 0x1c, 0xb8, 0x70, 0xe6, 0xd2, 0x03, 0x87, 0x8a, 0x13, 0xe2, 0xd0, 0xa2,
-	0xe4, 0xc8, 0x37, 0xe0, 0x84, 0xe6, 0x8f, 0x1d, 0x27, 0x90, 0x90, 0x20, 0xe0, 0x92, 0xec, 0xfb,
-	0x3b, 0xbf, 0xf7, 0xe6, 0xf7, 0x9e, 0x07, 0x5a, 0xf1, 0x30, 0x25, 0x4c, 0x50, 0xce, 0x8e, 0x87,
-	0x5f, 0xfa, 0x63, 0xc1, 0xc7, 0x09, 0x61, 0xd2, 0xfc, 0xf5, 0xb2, 0x9c, 0x4b, 0x8e, 0x56, 0x26,
-	0x3d, 0xbd, 0xb1, 0xe0, 0x69, 0x9f, 0xe5, 0xc5, 0x84, 0x27, 0x5c, 0x3b, 0xfa, 0xea, 0xcb, 0xc4,
-	0x2c, 0x37, 0x13, 0xce, 0x93, 0x3e, 0xf1, 0xb5, 0xd4, 0x19, 0x74, 0x7d, 0x49, 0x53, 0x22, 0x24,
-	0x4e, 0x33, 0xeb, 0xd0, 0x88, 0xb8, 0x48, 0xb9, 0xf0, 0x3b, 0x58, 0x10, 0xff, 0xf0, 0x7e, 0x87,
-	0x48, 0x7c, 0xdf, 0x8f, 0x38, 0x65, 0xd6, 0xbe, 0x64, 0xec, 0xa1, 0xc9, 0x6c, 0x04, 0x6b, 0x7a,
-	0xff, 0x12, 0xe4, 0x11, 0x4f, 0x53, 0xce, 0x7c, 0x49, 0x88, 0x71, 0x74, 0x7f, 0x28, 0x40, 0x79,
-	0x17, 0xe7, 0x38, 0x15, 0xa8, 0x05, 0x0b, 0x29, 0x3e, 0x0e, 0x71, 0x24, 0x29, 0x67, 0x61, 0x67,
-	0x28, 0x89, 0xa8, 0x3b, 0xab, 0x4e, 0xab, 0x14, 0xcc, 0xa7, 0xf8, 0x78, 0x43, 0xab, 0xdb, 0x4a,
-	0x8b, 0xf6, 0xa1, 0xa6, 0x0b, 0x0b, 0x73, 0x92, 0x50, 0x21, 0x73, 0xac, 0x23, 0xba, 0x84, 0xd4,
-	0x0b, 0xab, 0x4e, 0xab, 0xba, 0xb6, 0xe4, 0x59, 0x30, 0x0a, 0xb9, 0x67, 0x91, 0x7b, 0x0f, 0x39,
-	0x65, 0xed, 0xd2, 0xcb, 0xd7, 0xcd, 0xa9, 0x60, 0x51, 0x87, 0x07, 0x13, 0xd1, 0x8f, 0x08, 0x41,
-	0x1f, 0xc3, 0x4a, 0xc6, 0xfb, 0x34, 0x1a, 0x86, 0x39, 0x97, 0x26, 0x67, 0x4c, 0xfa, 0x78, 0x18,
-	0x76, 0xfa, 0x3c, 0x3a, 0x10, 0xf5, 0xa2, 0x06, 0xb3, 0x64, 0x7c, 0x02, 0xeb, 0xb2, 0xa9, 0x3c,
-	0xda, 0xda, 0x01, 0xb5, 0x61, 0xae, 0x4b, 0x48, 0xdc, 0xc1, 0xd1, 0x81, 0x46, 0x53, 0xba, 0x1e,
-	0x9a, 0xea, 0x28, 0x48, 0x81, 0x58, 0x87, 0xda, 0x38, 0x87, 0xc4, 0x49, 0xa8, 0x5a, 0x62, 0x7a,
-	0x31, 0xad, 0x8f, 0xbf, 0x3d, 0xb2, 0xee, 0xe1, 0xe4, 0x53, 0x7c, 0xac, 0x1b, 0xe2, 0xfe, 0x5e,
-	0x82, 0xe9, 0x0d, 0x55, 0x12, 0x9a, 0x87, 0x02, 0x8d, 0x75, 0xdb, 0x2a, 0x41, 0x81, 0xc6, 0xe8,
-	0x21, 0x94, 0x0d, 0x5e, 0xdb, 0x9a, 0xf7, 0xbc, 0x4b, 0x98, 0x62, 0x6e, 0xc6, 0xdb, 0xd5, 0xce,
-	0x16, 0x98, 0x0d, 0x45, 0x35, 0x28, 0xab, 0x5b, 0x39, 0x24, 0xba, 0x05, 0xb3, 0x81, 0x95, 0xd0,
-	0x3b, 0x00, 0xf6, 0xb6, 0x04, 0x79, 0xae, 0xab, 0x2d, 0x05, 0x15, 0xa3, 0x79, 0x42, 0x9e, 0xa3,
-	0x45, 0x98, 0xe6, 0x47, 0x8c, 0xe4, 0x1a, 0x79, 0x25, 0x30, 0x02, 0x0a, 0x60, 0x3e, 0x23, 0x2c,
-	0xa6, 0x2c, 0x09, 0x2d, 0xb2, 0xf2, 0x4d, 0x91, 0x39, 0xc1, 0x5b, 0x36, 0x85, 0x51, 0xa2, 0x35,
-	0xb8, 0x73, 0x3e, 0x67, 0xd8, 0x23, 0x34, 0xe9, 0xc9, 0xfa, 0xcc, 0xaa, 0xd3, 0x2a, 0x06, 0xb7,
-	0xcf, 0x79, 0x3f, 0xd6, 0x26, 0xd4, 0x84, 0xaa, 0x50, 0xfa, 0x30, 0x26, 0x8c, 0xa7, 0xf5, 0x59,
-	0x8d, 0x11, 0xb4, 0x6a, 0x53, 0x69, 0xd0, 0x33, 0xa8, 0x19, 0x87, 0x3e, 0x4d, 0xa9, 0x0c, 0x33,
-	0x92, 0x87, 0x47, 0x94, 0xc5, 0xfc, 0xa8, 0x5e, 0x51, 0xbe, 0xed, 0xbb, 0xaa, 0x47, 0xbf, 0xbc,
-	0x6e, 0xde, 0x31, 0xd7, 0x2b, 0xe2, 0x03, 0x8f, 0x72, 0x3f, 0xc5, 0xb2, 0xe7, 0x6d, 0x33, 0xf9,
-	0xd3, 0x77, 0xf7, 0xc0, 0xde, 0xfb, 0x36, 0x93, 0xc1, 0x6d, 0x9d, 0x6a, 0x47, 0x65, 0xda, 0x25,
-	0xf9, 0x53, 0x9d, 0x07, 0x79, 0x60, 0xd4, 0x36, 0xef, 0x88, 0x67, 0xa0, 0x1b, 0x79, 0x4b, 0x9b,
-	0x8c, 0xa7, 0xe5, 0xd7, 0x47, 0xb0, 0x74, 0xce, 0x5f, 0x48, 0x9c, 0xcb, 0x51, 0xa9, 0x55, 0x1d,
-	0x55, 0x9b, 0x88, 0x7a, 0xa2, 0xcc, 0xb6, 0xda, 0xcf, 0x01, 0x9d, 0x0f, 0xcd, 0x08, 0x93, 0xf5,
-	0xb9, 0x9b, 0x17, 0xb2, 0x30, 0x79, 0x80, 0x4a, 0xe2, 0x7e, 0xe5, 0x40, 0x55, 0x93, 0x6f, 0x4b,
-	0x44, 0x39, 0x3f, 0x42, 0x4b, 0x30, 0x6b, 0xa6, 0x73, 0x4c, 0xc4, 0x19, 0x2d, 0x6f, 0xc7, 0x88,
-	0xc0, 0x4c, 0x07, 0xf7, 0x31, 0x8b, 0xd4, 0xa4, 0x16, 0xaf, 0x9e, 0x8d, 0x0f, 0x14, 0xaa, 0xaf,
-	0xdf, 0x34, 0x5b, 0x09, 0x95, 0xbd, 0x41, 0x47, 0xd1, 0xc0, 0xee, 0x18, 0xfb, 0xef, 0x9e, 0x88,
-	0x0f, 0x7c, 0x39, 0xcc, 0x88, 0xd0, 0x01, 0x22, 0x18, 0xe5, 0x76, 0x13, 0x40, 0x5b, 0x87, 0xe3,
-	0x01, 0x27, 0xb9, 0x19, 0x8d, 0x2b, 0x70, 0x8d, 0x99, 0x5a, 0x98, 0x64, 0xea, 0x2a, 0x54, 0xbb,
-	0x94, 0x25, 0x24, 0xcf, 0x72, 0xca, 0xa4, 0xe6, 0x7e, 0x25, 0x98, 0x54, 0xb9, 0x9f, 0xc0, 0xa2,
-	0x3e, 0x68, 0x93, 0xe8, 0x89, 0xc0, 0x92, 0xfc, 0xb3, 0xa3, 0xdc, 0xcf, 0x2c, 0x62, 0xc3, 0xd0,
-	0x80, 0x1c, 0xf2, 0x03, 0x12, 0x5f, 0x04, 0xe0, 0xfc, 0x09, 0x80, 0x9a, 0xcc, 0x9c, 0x60, 0xc1,
-	0x99, 0x4d, 0x67, 0x25, 0xf7, 0x81, 0x05, 0x66, 0xf2, 0xed, 0xb3, 0xfc, 0xba, 0x19, 0xdd, 0x67,
-	0x50, 0xd3, 0x91, 0xfb, 0x59, 0x3c, 0x2a, 0xc7, 0x0e, 0xd9, 0x15, 0x45, 0xdd, 0x85, 0x5b, 0xb6,
-	0x01, 0x6a, 0x19, 0x58, 0x42, 0x16, 0xf4, 0xec, 0x2d, 0x9c, 0x19, 0x0c, 0x15, 0xdd, 0xef, 0x1d,
-	0xb8, 0xa3, 0x8f, 0xd8, 0x90, 0x92, 0x08, 0x49, 0xe2, 0xbd, 0x1c, 0x33, 0xd1, 0x25, 0xf9, 0x55,
-	0x27, 0x2c, 0x40, 0x51, 0xed, 0x98, 0x82, 0x26, 0xb9, 0xfa, 0x44, 0x2b, 0x50, 0xc9, 0x49, 0x44,
-	0x33, 0x4a, 0xc6, 0x77, 0x73, 0xa6, 0x40, 0x1f, 0x42, 0x19, 0xa7, 0x7c, 0xc0, 0xe4, 0x75, 0x97,
-	0xb0, 0x75, 0x57, 0x69, 0xc5, 0xa0, 0x93, 0x52, 0x29, 0xc7, 0x8b, 0xeb, 0x4c, 0xe1, 0x7e, 0xe3,
-	0xd8, 0xc6, 0x3e, 0x1a, 0xb0, 0xf8, 0x9a, 0xa4, 0xaf, 0x41, 0xb9, 0x3b, 0x60, 0xf1, 0xf8, 0xca,
-	0xad, 0x84, 0xa2, 0x31, 0xc4, 0xe2, 0xbf, 0x3f, 0x0b, 0x36, 0xb5, 0xfb, 0xad, 0x03, 0x75, 0x0d,
-	0xf8, 0x29, 0x95, 0xbd, 0x38, 0xc7, 0x47, 0xd7, 0x04, 0xfd, 0xd7, 0x13, 0xf1, 0xbf, 0x40, 0xfe,
-	0xcd, 0x81, 0xb7, 0x2f, 0x52, 0x50, 0x6d, 0x9a, 0xf8, 0xef, 0x79, 0x78, 0x61, 0xa7, 0x17, 0x6e,
-	0xb0, 0xd3, 0x8b, 0xff, 0xed, 0x4e, 0x2f, 0x5d, 0xb2, 0xd3, 0xdd, 0x1f, 0x1d, 0x98, 0x37, 0x6f,
-	0x9b, 0x1d, 0x9e, 0x6c, 0x31, 0x99, 0x0f, 0x6f, 0x36, 0x06, 0x75, 0x98, 0xc9, 0xf0, 0xb0, 0xcf,
-	0x71, 0xac, 0x4b, 0x98, 0x0b, 0x46, 0x22, 0x7a, 0x17, 0xe6, 0xec, 0x67, 0xd8, 0xc3, 0xa2, 0xa7,
-	0x21, 0xcc, 0x05, 0x55, 0xab, 0x7b, 0x8c, 0x45, 0x4f, 0x51, 0xd3, 0x0e, 0xeb, 0xb4, 0x1e, 0x56,
-	0x2b, 0xa1, 0x07, 0x50, 0x52, 0x8f, 0x41, 0xfb, 0xcb, 0xbc, 0xec, 0x99, 0x97, 0xa2, 0x37, 0x7a,
-	0x29, 0x7a, 0x7b, 0xa3, 0x97, 0x62, 0x7b, 0x56, 0x35, 0xec, 0xc5, 0x9b, 0xa6, 0x13, 0xe8, 0x88,
-	0xf6, 0xce, 0xcb, 0x93, 0x86, 0xf3, 0xea, 0xa4, 0xe1, 0xfc, 0x7a, 0xd2, 0x70, 0x5e, 0x9c, 0x36,
-	0xa6, 0x5e, 0x9d, 0x36, 0xa6, 0x7e, 0x3e, 0x6d, 0x4c, 0x7d, 0xb1, 0x36, 0x41, 0x84, 0x4b, 0x5e,
-	0x87, 0x87, 0xeb, 0xfe, 0xb1, 0x7d, 0xdc, 0x6a, 0x62, 0x74, 0xca, 0xfa, 0xc4, 0xf5, 0x3f, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x67, 0xe9, 0x8a, 0xf0, 0x09, 0x0b, 0x00, 0x00,
+func (m *AgentEscrow) Reset()         { *m = AgentEscrow{} }
+func (m *AgentEscrow) String() string { return proto.CompactTextString(m) }
+func (*AgentEscrow) ProtoMessage()    {}
+func (*AgentEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{11}
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AgentEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AgentEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AgentEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *AgentEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentEscrow.Merge(m, src)
+}
+func (m *AgentEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *AgentEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentEscrow.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_AgentEscrow proto.InternalMessageInfo
+
+func (m *AgentEscrow) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FeedbackTagMaxBytes != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.FeedbackTagMaxBytes))
-		i--
-		dAtA[i] = 0x28
+func (m *AgentEscrow) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	{
-		size, err := m.FeedbackFee.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// RecipientPolicy restricts who an agent's attested transfers may pay. The
+// zero value places no restriction.
+type RecipientPolicy struct {
+	// allowlist, if non-empty, is the exhaustive set of bech32 accounts the
+	// agent may pay.
+	Allowlist []string `protobuf:"bytes,1,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+	// per_recipient_cap is the max a single recipient may receive per cap
+	// window, per denom. Denoms not listed are uncapped.
+	PerRecipientCap github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=per_recipient_cap,json=perRecipientCap,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"per_recipient_cap"`
+	// cap_window_blocks is the per-recipient cap window length in blocks. Must
+	// be > 0 iff per_recipient_cap is set.
+	CapWindowBlocks uint64 `protobuf:"varint,3,opt,name=cap_window_blocks,json=capWindowBlocks,proto3" json:"cap_window_blocks,omitempty"`
+	// dym_name_resolved_only requires every recipient to be the resolved
+	// address of at least one active Dym-Name on this chain.
+	DymNameResolvedOnly bool `protobuf:"varint,4,opt,name=dym_name_resolved_only,json=dymNameResolvedOnly,proto3" json:"dym_name_resolved_only,omitempty"`
+}
+
+func (m *RecipientPolicy) Reset()         { *m = RecipientPolicy{} }
+func (m *RecipientPolicy) String() string { return proto.CompactTextString(m) }
+func (*RecipientPolicy) ProtoMessage()    {}
+func (*RecipientPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{12}
+}
+func (m *RecipientPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintAgent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if m.PolicyRotationDelayBlocks != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.PolicyRotationDelayBlocks))
-		i--
-		dAtA[i] = 0x18
+}
+func (m *RecipientPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientPolicy.Merge(m, src)
+}
+func (m *RecipientPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipientPolicy proto.InternalMessageInfo
+
+func (m *RecipientPolicy) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
 	}
-	{
-		size, err := m.AgentRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintAgent(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *RecipientPolicy) GetPerRecipientCap() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PerRecipientCap
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.MaxActionBytes != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.MaxActionBytes))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *RecipientPolicy) GetCapWindowBlocks() uint64 {
+	if m != nil {
+		return m.CapWindowBlocks
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Agent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RecipientPolicy) GetDymNameResolvedOnly() bool {
+	if m != nil {
+		return m.DymNameResolvedOnly
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Agent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RecipientUsage is what one recipient received from an agent in the current
+// per-recipient cap window, keyed by (agent_id, recipient).
+type RecipientUsage struct {
+	AgentId   string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// window_start_height is the aligned bucket start of the current window.
+	WindowStartHeight uint64                                   `protobuf:"varint,3,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	Received          github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=received,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"received"`
 }
 
-func (m *Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.SpendWindowSpent.Size()
-		i -= size
-		if _, err := m.SpendWindowSpent.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintAgent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	if m.SpendWindowStartHeight != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.SpendWindowStartHeight))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.SpendWindowBlocks != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.SpendWindowBlocks))
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size := m.SpendLimitPerWindow.Size()
-		i -= size
-		if _, err := m.SpendLimitPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *RecipientUsage) Reset()         { *m = RecipientUsage{} }
+func (m *RecipientUsage) String() string { return proto.CompactTextString(m) }
+func (*RecipientUsage) ProtoMessage()    {}
+func (*RecipientUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{13}
+}
+func (m *RecipientUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintAgent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.SpendDenom) > 0 {
-		i -= len(m.SpendDenom)
-		copy(dAtA[i:], m.SpendDenom)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.SpendDenom)))
-		i--
-		dAtA[i] = 0x42
+}
+func (m *RecipientUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientUsage.Merge(m, src)
+}
+func (m *RecipientUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipientUsage proto.InternalMessageInfo
+
+func (m *RecipientUsage) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	if m.PendingPolicyHeight != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.PendingPolicyHeight))
-		i--
-		dAtA[i] = 0x38
+	return ""
+}
+
+func (m *RecipientUsage) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	if m.PendingPolicy != nil {
-		{
-			size, err := m.PendingPolicy.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintAgent(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *RecipientUsage) GetWindowStartHeight() uint64 {
+	if m != nil {
+		return m.WindowStartHeight
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x2a
+	return 0
+}
+
+func (m *RecipientUsage) GetReceived() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Received
 	}
-	if m.ActionSeq != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.ActionSeq))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.Active {
-		i--
-		if m.Active {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// EventRegisterAgent is emitted when an agent is registered.
+type EventRegisterAgent struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Owner   string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// fingerprint is the lowercase hex SHA-256 of the deterministic proto
+	// marshaling of the agent's policy; pass it to MsgRevokePolicy to revoke.
+	Fingerprint string `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+}
+
+func (m *EventRegisterAgent) Reset()         { *m = EventRegisterAgent{} }
+func (m *EventRegisterAgent) String() string { return proto.CompactTextString(m) }
+func (*EventRegisterAgent) ProtoMessage()    {}
+func (*EventRegisterAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{14}
+}
+func (m *EventRegisterAgent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRegisterAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRegisterAgent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintAgent(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventRegisterAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRegisterAgent.Merge(m, src)
+}
+func (m *EventRegisterAgent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRegisterAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRegisterAgent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRegisterAgent proto.InternalMessageInfo
+
+func (m *EventRegisterAgent) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AgentEscrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventRegisterAgent) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AgentEscrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventRegisterAgent) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
 }
 
-func (m *AgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+// EventDeactivateAgent is emitted when an agent is deactivated.
+type EventDeactivateAgent struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Owner   string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+}
+
+func (m *EventDeactivateAgent) Reset()         { *m = EventDeactivateAgent{} }
+func (m *EventDeactivateAgent) String() string { return proto.CompactTextString(m) }
+func (*EventDeactivateAgent) ProtoMessage()    {}
+func (*EventDeactivateAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{15}
+}
+func (m *EventDeactivateAgent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDeactivateAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDeactivateAgent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventDeactivateAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDeactivateAgent.Merge(m, src)
+}
+func (m *EventDeactivateAgent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDeactivateAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDeactivateAgent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDeactivateAgent proto.InternalMessageInfo
+
+func (m *EventDeactivateAgent) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventRegisterAgent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDeactivateAgent) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventRegisterAgent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventCreateSubAgent is emitted when a parent agent creates a sub-agent.
+// seq is the parent's action log entry recording the creation.
+type EventCreateSubAgent struct {
+	ParentId    string                                   `protobuf:"bytes,1,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	AgentId     string                                   `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Owner       string                                   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Fingerprint string                                   `protobuf:"bytes,4,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Budget      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=budget,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"budget"`
+	Seq         uint64                                   `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *EventRegisterAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventCreateSubAgent) Reset()         { *m = EventCreateSubAgent{} }
+func (m *EventCreateSubAgent) String() string { return proto.CompactTextString(m) }
+func (*EventCreateSubAgent) ProtoMessage()    {}
+func (*EventCreateSubAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{16}
+}
+func (m *EventCreateSubAgent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateSubAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateSubAgent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventCreateSubAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateSubAgent.Merge(m, src)
+}
+func (m *EventCreateSubAgent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateSubAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateSubAgent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateSubAgent proto.InternalMessageInfo
+
+func (m *EventCreateSubAgent) GetParentId() string {
+	if m != nil {
+		return m.ParentId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventDeactivateAgent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCreateSubAgent) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventDeactivateAgent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventCreateSubAgent) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-func (m *EventDeactivateAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
+func (m *EventCreateSubAgent) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
 	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventCreateSubAgent) GetBudget() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Budget
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventPolicyRevoked) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventCreateSubAgent) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventPolicyRevoked) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventReturnSubAgentEscrow is emitted when a deactivated sub-agent's escrow
+// is returned to its parent.
+type EventReturnSubAgentEscrow struct {
+	AgentId  string                                   `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	ParentId string                                   `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *EventPolicyRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventReturnSubAgentEscrow) Reset()         { *m = EventReturnSubAgentEscrow{} }
+func (m *EventReturnSubAgentEscrow) String() string { return proto.CompactTextString(m) }
+func (*EventReturnSubAgentEscrow) ProtoMessage()    {}
+func (*EventReturnSubAgentEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{17}
+}
+func (m *EventReturnSubAgentEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventReturnSubAgentEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventReturnSubAgentEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventReturnSubAgentEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventReturnSubAgentEscrow.Merge(m, src)
+}
+func (m *EventReturnSubAgentEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventReturnSubAgentEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventReturnSubAgentEscrow.DiscardUnknown(m)
 }
 
-func (m *EventPolicyUnrevoked) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventReturnSubAgentEscrow proto.InternalMessageInfo
+
+func (m *EventReturnSubAgentEscrow) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventPolicyUnrevoked) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventReturnSubAgentEscrow) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
 }
 
-func (m *EventPolicyUnrevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventReturnSubAgentEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventUpdateAgentPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// EventPolicyRegistered is emitted when a policy is added to the registry,
+// including as a successor.
+type EventPolicyRegistered struct {
+	Id      string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Admin   string      `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty"`
+	Version uint64      `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	TeeType tee.TEEType `protobuf:"varint,4,opt,name=tee_type,json=teeType,proto3,enum=dymensionxyz.dymension.common.TEEType" json:"tee_type,omitempty"`
+}
+
+func (m *EventPolicyRegistered) Reset()         { *m = EventPolicyRegistered{} }
+func (m *EventPolicyRegistered) String() string { return proto.CompactTextString(m) }
+func (*EventPolicyRegistered) ProtoMessage()    {}
+func (*EventPolicyRegistered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{18}
+}
+func (m *EventPolicyRegistered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPolicyRegistered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPolicyRegistered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *EventPolicyRegistered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPolicyRegistered.Merge(m, src)
+}
+func (m *EventPolicyRegistered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPolicyRegistered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPolicyRegistered.DiscardUnknown(m)
 }
 
-func (m *EventUpdateAgentPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_EventPolicyRegistered proto.InternalMessageInfo
+
+func (m *EventPolicyRegistered) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
 
-func (m *EventUpdateAgentPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActivationHeight != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.ActivationHeight))
-		i--
-		dAtA[i] = 0x10
+func (m *EventPolicyRegistered) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventPolicyRegistered) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventAttestedTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPolicyRegistered) GetTeeType() tee.TEEType {
+	if m != nil {
+		return m.TeeType
 	}
-	return dAtA[:n], nil
+	return tee.TEEType_TEE_TYPE_GCP_CONFIDENTIAL_SPACE
 }
 
-func (m *EventAttestedTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPolicySuperseded is emitted when a policy is superseded; referrers of
+// its lineage now resolve to superseded_by.
+type EventPolicySuperseded struct {
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	SupersededBy string `protobuf:"bytes,2,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	// authority is the admin or governance account that superseded it.
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+	Version   uint64 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *EventAttestedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+func (m *EventPolicySuperseded) Reset()         { *m = EventPolicySuperseded{} }
+func (m *EventPolicySuperseded) String() string { return proto.CompactTextString(m) }
+func (*EventPolicySuperseded) ProtoMessage()    {}
+func (*EventPolicySuperseded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{19}
+}
+func (m *EventPolicySuperseded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPolicySuperseded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPolicySuperseded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintAgent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Seq != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventPolicySuperseded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPolicySuperseded.Merge(m, src)
+}
+func (m *EventPolicySuperseded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPolicySuperseded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPolicySuperseded.DiscardUnknown(m)
 }
 
-func (m *EventFundAgentEscrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventPolicySuperseded proto.InternalMessageInfo
+
+func (m *EventPolicySuperseded) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventFundAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventPolicySuperseded) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
 }
 
-func (m *EventFundAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Funder) > 0 {
-		i -= len(m.Funder)
-		copy(dAtA[i:], m.Funder)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Funder)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventPolicySuperseded) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventWithdrawAgentEscrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPolicySuperseded) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventWithdrawAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPolicyRevoked is emitted when a policy fingerprint is revoked.
+type EventPolicyRevoked struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Reason      string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *EventWithdrawAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAgent(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *EventPolicyRevoked) Reset()         { *m = EventPolicyRevoked{} }
+func (m *EventPolicyRevoked) String() string { return proto.CompactTextString(m) }
+func (*EventPolicyRevoked) ProtoMessage()    {}
+func (*EventPolicyRevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{20}
+}
+func (m *EventPolicyRevoked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPolicyRevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPolicyRevoked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventPolicyRevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPolicyRevoked.Merge(m, src)
+}
+func (m *EventPolicyRevoked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPolicyRevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPolicyRevoked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPolicyRevoked proto.InternalMessageInfo
+
+func (m *EventPolicyRevoked) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventUpdateAgentSpendPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventPolicyRevoked) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUpdateAgentSpendPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventPolicyUnrevoked is emitted when a policy fingerprint is unrevoked.
+type EventPolicyUnrevoked struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 }
 
-func (m *EventUpdateAgentSpendPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpendWindowBlocks != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.SpendWindowBlocks))
-		i--
-		dAtA[i] = 0x20
-	}
-	{
-		size := m.SpendLimitPerWindow.Size()
-		i -= size
-		if _, err := m.SpendLimitPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *EventPolicyUnrevoked) Reset()         { *m = EventPolicyUnrevoked{} }
+func (m *EventPolicyUnrevoked) String() string { return proto.CompactTextString(m) }
+func (*EventPolicyUnrevoked) ProtoMessage()    {}
+func (*EventPolicyUnrevoked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{21}
+}
+func (m *EventPolicyUnrevoked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPolicyUnrevoked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPolicyUnrevoked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintAgent(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.SpendDenom) > 0 {
-		i -= len(m.SpendDenom)
-		copy(dAtA[i:], m.SpendDenom)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.SpendDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventPolicyUnrevoked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPolicyUnrevoked.Merge(m, src)
+}
+func (m *EventPolicyUnrevoked) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPolicyUnrevoked) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPolicyUnrevoked.DiscardUnknown(m)
 }
 
-func (m *ActionLogEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventPolicyUnrevoked proto.InternalMessageInfo
+
+func (m *EventPolicyUnrevoked) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ActionLogEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EventUpdateAgentPolicy is emitted when a timelocked policy rotation is
+// scheduled.
+type EventUpdateAgentPolicy struct {
+	AgentId          string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	ActivationHeight int64  `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
-func (m *ActionLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
-	if err6 != nil {
-		return 0, err6
-	}
-	i -= n6
-	i = encodeVarintAgent(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x32
-	if m.Height != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.PayloadHash) > 0 {
-		i -= len(m.PayloadHash)
-		copy(dAtA[i:], m.PayloadHash)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.PayloadHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Seq != 0 {
-		i = encodeVarintAgent(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventUpdateAgentPolicy) Reset()         { *m = EventUpdateAgentPolicy{} }
+func (m *EventUpdateAgentPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAgentPolicy) ProtoMessage()    {}
+func (*EventUpdateAgentPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{22}
+}
+func (m *EventUpdateAgentPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateAgentPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateAgentPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventUpdateAgentPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAgentPolicy.Merge(m, src)
+}
+func (m *EventUpdateAgentPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateAgentPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAgentPolicy.DiscardUnknown(m)
 }
 
-func encodeVarintAgent(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAgent(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventUpdateAgentPolicy proto.InternalMessageInfo
+
+func (m *EventUpdateAgentPolicy) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventUpdateAgentPolicy) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	var l int
-	_ = l
-	if m.MaxActionBytes != 0 {
-		n += 1 + sovAgent(uint64(m.MaxActionBytes))
-	}
-	l = m.AgentRegistrationFee.Size()
-	n += 1 + l + sovAgent(uint64(l))
-	if m.PolicyRotationDelayBlocks != 0 {
-		n += 1 + sovAgent(uint64(m.PolicyRotationDelayBlocks))
-	}
-	l = m.FeedbackFee.Size()
-	n += 1 + l + sovAgent(uint64(l))
-	if m.FeedbackTagMaxBytes != 0 {
-		n += 1 + sovAgent(uint64(m.FeedbackTagMaxBytes))
-	}
-	return n
+	return 0
 }
 
-func (m *Agent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = m.Policy.Size()
-	n += 1 + l + sovAgent(uint64(l))
-	if m.Active {
-		n += 2
-	}
-	if m.ActionSeq != 0 {
-		n += 1 + sovAgent(uint64(m.ActionSeq))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.PendingPolicy != nil {
-		l = m.PendingPolicy.Size()
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.PendingPolicyHeight != 0 {
-		n += 1 + sovAgent(uint64(m.PendingPolicyHeight))
-	}
-	l = len(m.SpendDenom)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = m.SpendLimitPerWindow.Size()
-	n += 1 + l + sovAgent(uint64(l))
-	if m.SpendWindowBlocks != 0 {
-		n += 1 + sovAgent(uint64(m.SpendWindowBlocks))
-	}
-	if m.SpendWindowStartHeight != 0 {
-		n += 1 + sovAgent(uint64(m.SpendWindowStartHeight))
-	}
-	l = m.SpendWindowSpent.Size()
-	n += 1 + l + sovAgent(uint64(l))
-	return n
+// EventUpdateAgentPolicySet is emitted when a timelocked policy set update is
+// scheduled. co_fingerprints are the fingerprints of the new co-policies.
+type EventUpdateAgentPolicySet struct {
+	AgentId          string            `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	ActivationHeight int64             `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	CoFingerprints   []string          `protobuf:"bytes,3,rep,name=co_fingerprints,json=coFingerprints,proto3" json:"co_fingerprints,omitempty"`
+	Quorum           AttestationQuorum `protobuf:"bytes,4,opt,name=quorum,proto3" json:"quorum"`
 }
 
-func (m *AgentEscrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+func (m *EventUpdateAgentPolicySet) Reset()         { *m = EventUpdateAgentPolicySet{} }
+func (m *EventUpdateAgentPolicySet) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAgentPolicySet) ProtoMessage()    {}
+func (*EventUpdateAgentPolicySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{23}
+}
+func (m *EventUpdateAgentPolicySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateAgentPolicySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateAgentPolicySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventUpdateAgentPolicySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAgentPolicySet.Merge(m, src)
+}
+func (m *EventUpdateAgentPolicySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateAgentPolicySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAgentPolicySet.DiscardUnknown(m)
 }
 
-func (m *EventRegisterAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+var xxx_messageInfo_EventUpdateAgentPolicySet proto.InternalMessageInfo
+
+func (m *EventUpdateAgentPolicySet) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return n
+	return ""
 }
 
-func (m *EventDeactivateAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func (m *EventUpdateAgentPolicySet) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	return n
+	return 0
 }
 
-func (m *EventPolicyRevoked) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func (m *EventUpdateAgentPolicySet) GetCoFingerprints() []string {
+	if m != nil {
+		return m.CoFingerprints
 	}
-	return n
+	return nil
 }
 
-func (m *EventPolicyUnrevoked) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+func (m *EventUpdateAgentPolicySet) GetQuorum() AttestationQuorum {
+	if m != nil {
+		return m.Quorum
 	}
-	return n
+	return AttestationQuorum{}
 }
 
-func (m *EventUpdateAgentPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.ActivationHeight != 0 {
-		n += 1 + sovAgent(uint64(m.ActivationHeight))
-	}
-	return n
+// EventAttestedTransfer is emitted when an attested transfer pays out from an
+// agent's escrow.
+type EventAttestedTransfer struct {
+	AgentId   string     `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Seq       uint64     `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Recipient string     `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Submitter string     `protobuf:"bytes,5,opt,name=submitter,proto3" json:"submitter,omitempty"`
 }
 
-func (m *EventAttestedTransfer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventAttestedTransfer) Reset()         { *m = EventAttestedTransfer{} }
+func (m *EventAttestedTransfer) String() string { return proto.CompactTextString(m) }
+func (*EventAttestedTransfer) ProtoMessage()    {}
+func (*EventAttestedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{24}
+}
+func (m *EventAttestedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAttestedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAttestedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+}
+func (m *EventAttestedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAttestedTransfer.Merge(m, src)
+}
+func (m *EventAttestedTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAttestedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAttestedTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAttestedTransfer proto.InternalMessageInfo
+
+func (m *EventAttestedTransfer) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	if m.Seq != 0 {
-		n += 1 + sovAgent(uint64(m.Seq))
+	return ""
+}
+
+func (m *EventAttestedTransfer) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	return 0
+}
+
+func (m *EventAttestedTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovAgent(uint64(l))
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	return ""
+}
+
+func (m *EventAttestedTransfer) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *EventFundAgentEscrow) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventAttestedTransfer) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	return ""
+}
+
+// EventFundAgentEscrow is emitted when an agent's escrow is funded.
+type EventFundAgentEscrow struct {
+	AgentId string                                   `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Funder  string                                   `protobuf:"bytes,2,opt,name=funder,proto3" json:"funder,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventFundAgentEscrow) Reset()         { *m = EventFundAgentEscrow{} }
+func (m *EventFundAgentEscrow) String() string { return proto.CompactTextString(m) }
+func (*EventFundAgentEscrow) ProtoMessage()    {}
+func (*EventFundAgentEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{25}
+}
+func (m *EventFundAgentEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFundAgentEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFundAgentEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Funder)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+}
+func (m *EventFundAgentEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFundAgentEscrow.Merge(m, src)
+}
+func (m *EventFundAgentEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFundAgentEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFundAgentEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFundAgentEscrow proto.InternalMessageInfo
+
+func (m *EventFundAgentEscrow) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	return ""
+}
+
+func (m *EventFundAgentEscrow) GetFunder() string {
+	if m != nil {
+		return m.Funder
+	}
+	return ""
+}
+
+func (m *EventFundAgentEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// EventWithdrawAgentEscrow is emitted when the owner withdraws from an
+// agent's escrow.
+type EventWithdrawAgentEscrow struct {
+	AgentId string                                   `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Owner   string                                   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Amount  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *EventWithdrawAgentEscrow) Reset()         { *m = EventWithdrawAgentEscrow{} }
+func (m *EventWithdrawAgentEscrow) String() string { return proto.CompactTextString(m) }
+func (*EventWithdrawAgentEscrow) ProtoMessage()    {}
+func (*EventWithdrawAgentEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{26}
+}
+func (m *EventWithdrawAgentEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventWithdrawAgentEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventWithdrawAgentEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventWithdrawAgentEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventWithdrawAgentEscrow.Merge(m, src)
+}
+func (m *EventWithdrawAgentEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventWithdrawAgentEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventWithdrawAgentEscrow.DiscardUnknown(m)
 }
 
-func (m *EventWithdrawAgentEscrow) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_EventWithdrawAgentEscrow proto.InternalMessageInfo
+
+func (m *EventWithdrawAgentEscrow) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	return ""
+}
+
+func (m *EventWithdrawAgentEscrow) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
+	return ""
+}
+
+func (m *EventWithdrawAgentEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovAgent(uint64(l))
+	return nil
+}
+
+// EventUpdateAgentSpendPolicy is emitted when the owner updates an agent's
+// spend policy.
+type EventUpdateAgentSpendPolicy struct {
+	AgentId             string                `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	SpendDenom          string                `protobuf:"bytes,2,opt,name=spend_denom,json=spendDenom,proto3" json:"spend_denom,omitempty"`
+	SpendLimitPerWindow cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=spend_limit_per_window,json=spendLimitPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit_per_window"`
+	SpendWindowBlocks   uint64                `protobuf:"varint,4,opt,name=spend_window_blocks,json=spendWindowBlocks,proto3" json:"spend_window_blocks,omitempty"`
+	SpendBudgets        []SpendBudget         `protobuf:"bytes,5,rep,name=spend_budgets,json=spendBudgets,proto3" json:"spend_budgets"`
+	SpendWindowMode     SpendWindowMode       `protobuf:"varint,6,opt,name=spend_window_mode,json=spendWindowMode,proto3,enum=dymensionxyz.dymension.agent.SpendWindowMode" json:"spend_window_mode,omitempty"`
+	SpendWindowDuration time.Duration         `protobuf:"bytes,7,opt,name=spend_window_duration,json=spendWindowDuration,proto3,stdduration" json:"spend_window_duration"`
+}
+
+func (m *EventUpdateAgentSpendPolicy) Reset()         { *m = EventUpdateAgentSpendPolicy{} }
+func (m *EventUpdateAgentSpendPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAgentSpendPolicy) ProtoMessage()    {}
+func (*EventUpdateAgentSpendPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{27}
+}
+func (m *EventUpdateAgentSpendPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateAgentSpendPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateAgentSpendPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateAgentSpendPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAgentSpendPolicy.Merge(m, src)
+}
+func (m *EventUpdateAgentSpendPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateAgentSpendPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAgentSpendPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateAgentSpendPolicy proto.InternalMessageInfo
+
+func (m *EventUpdateAgentSpendPolicy) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *EventUpdateAgentSpendPolicy) GetSpendDenom() string {
+	if m != nil {
+		return m.SpendDenom
+	}
+	return ""
+}
+
+func (m *EventUpdateAgentSpendPolicy) GetSpendWindowBlocks() uint64 {
+	if m != nil {
+		return m.SpendWindowBlocks
+	}
+	return 0
+}
+
+func (m *EventUpdateAgentSpendPolicy) GetSpendBudgets() []SpendBudget {
+	if m != nil {
+		return m.SpendBudgets
+	}
+	return nil
+}
+
+func (m *EventUpdateAgentSpendPolicy) GetSpendWindowMode() SpendWindowMode {
+	if m != nil {
+		return m.SpendWindowMode
+	}
+	return SpendWindowMode_SPEND_WINDOW_MODE_BLOCKS
+}
+
+func (m *EventUpdateAgentSpendPolicy) GetSpendWindowDuration() time.Duration {
+	if m != nil {
+		return m.SpendWindowDuration
+	}
+	return 0
+}
+
+// EventUpdateAgentSecurityPolicy is emitted when the owner updates an agent's
+// security policy. activation_height is the current height when the update
+// applies immediately.
+type EventUpdateAgentSecurityPolicy struct {
+	AgentId          string         `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Policy           SecurityPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+	ActivationHeight int64          `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *EventUpdateAgentSecurityPolicy) Reset()         { *m = EventUpdateAgentSecurityPolicy{} }
+func (m *EventUpdateAgentSecurityPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAgentSecurityPolicy) ProtoMessage()    {}
+func (*EventUpdateAgentSecurityPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{28}
+}
+func (m *EventUpdateAgentSecurityPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateAgentSecurityPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateAgentSecurityPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateAgentSecurityPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAgentSecurityPolicy.Merge(m, src)
+}
+func (m *EventUpdateAgentSecurityPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateAgentSecurityPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAgentSecurityPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateAgentSecurityPolicy proto.InternalMessageInfo
+
+func (m *EventUpdateAgentSecurityPolicy) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *EventUpdateAgentSecurityPolicy) GetPolicy() SecurityPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return SecurityPolicy{}
+}
+
+func (m *EventUpdateAgentSecurityPolicy) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// EventFreezeAgent is emitted when an agent is frozen. Freezing cancels the
+// agent's pending withdrawals.
+type EventFreezeAgent struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Signer  string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventFreezeAgent) Reset()         { *m = EventFreezeAgent{} }
+func (m *EventFreezeAgent) String() string { return proto.CompactTextString(m) }
+func (*EventFreezeAgent) ProtoMessage()    {}
+func (*EventFreezeAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{29}
+}
+func (m *EventFreezeAgent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFreezeAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFreezeAgent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFreezeAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFreezeAgent.Merge(m, src)
+}
+func (m *EventFreezeAgent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFreezeAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFreezeAgent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFreezeAgent proto.InternalMessageInfo
+
+func (m *EventFreezeAgent) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *EventFreezeAgent) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// EventUnfreezeAgent is emitted when an agent is unfrozen.
+type EventUnfreezeAgent struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Signer  string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+}
+
+func (m *EventUnfreezeAgent) Reset()         { *m = EventUnfreezeAgent{} }
+func (m *EventUnfreezeAgent) String() string { return proto.CompactTextString(m) }
+func (*EventUnfreezeAgent) ProtoMessage()    {}
+func (*EventUnfreezeAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{30}
+}
+func (m *EventUnfreezeAgent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnfreezeAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnfreezeAgent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnfreezeAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnfreezeAgent.Merge(m, src)
+}
+func (m *EventUnfreezeAgent) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnfreezeAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnfreezeAgent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnfreezeAgent proto.InternalMessageInfo
+
+func (m *EventUnfreezeAgent) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *EventUnfreezeAgent) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
+
+// EventQueueAgentWithdrawal is emitted when a withdrawal is queued behind the
+// agent's withdrawal delay.
+type EventQueueAgentWithdrawal struct {
+	Withdrawal PendingWithdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal"`
+}
+
+func (m *EventQueueAgentWithdrawal) Reset()         { *m = EventQueueAgentWithdrawal{} }
+func (m *EventQueueAgentWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*EventQueueAgentWithdrawal) ProtoMessage()    {}
+func (*EventQueueAgentWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{31}
+}
+func (m *EventQueueAgentWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQueueAgentWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQueueAgentWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventQueueAgentWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQueueAgentWithdrawal.Merge(m, src)
+}
+func (m *EventQueueAgentWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQueueAgentWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQueueAgentWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventQueueAgentWithdrawal proto.InternalMessageInfo
+
+func (m *EventQueueAgentWithdrawal) GetWithdrawal() PendingWithdrawal {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return PendingWithdrawal{}
+}
+
+// EventCancelAgentWithdrawal is emitted when a pending withdrawal is
+// cancelled and its amount returned to the escrow.
+type EventCancelAgentWithdrawal struct {
+	Withdrawal PendingWithdrawal `protobuf:"bytes,1,opt,name=withdrawal,proto3" json:"withdrawal"`
+	Reason     string            `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventCancelAgentWithdrawal) Reset()         { *m = EventCancelAgentWithdrawal{} }
+func (m *EventCancelAgentWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*EventCancelAgentWithdrawal) ProtoMessage()    {}
+func (*EventCancelAgentWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{32}
+}
+func (m *EventCancelAgentWithdrawal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelAgentWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelAgentWithdrawal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelAgentWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelAgentWithdrawal.Merge(m, src)
+}
+func (m *EventCancelAgentWithdrawal) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelAgentWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelAgentWithdrawal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelAgentWithdrawal proto.InternalMessageInfo
+
+func (m *EventCancelAgentWithdrawal) GetWithdrawal() PendingWithdrawal {
+	if m != nil {
+		return m.Withdrawal
+	}
+	return PendingWithdrawal{}
+}
+
+func (m *EventCancelAgentWithdrawal) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventCreatePaymentStream is emitted when the owner creates a payment
+// stream.
+type EventCreatePaymentStream struct {
+	Stream PaymentStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+}
+
+func (m *EventCreatePaymentStream) Reset()         { *m = EventCreatePaymentStream{} }
+func (m *EventCreatePaymentStream) String() string { return proto.CompactTextString(m) }
+func (*EventCreatePaymentStream) ProtoMessage()    {}
+func (*EventCreatePaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{33}
+}
+func (m *EventCreatePaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreatePaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreatePaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreatePaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreatePaymentStream.Merge(m, src)
+}
+func (m *EventCreatePaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreatePaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreatePaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreatePaymentStream proto.InternalMessageInfo
+
+func (m *EventCreatePaymentStream) GetStream() PaymentStream {
+	if m != nil {
+		return m.Stream
+	}
+	return PaymentStream{}
+}
+
+// EventPaymentStreamPayout is emitted when a payment stream pays out one or
+// more due periods.
+type EventPaymentStreamPayout struct {
+	AgentId   string     `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	StreamId  uint64     `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Recipient string     `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Periods   uint64     `protobuf:"varint,5,opt,name=periods,proto3" json:"periods,omitempty"`
+}
+
+func (m *EventPaymentStreamPayout) Reset()         { *m = EventPaymentStreamPayout{} }
+func (m *EventPaymentStreamPayout) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentStreamPayout) ProtoMessage()    {}
+func (*EventPaymentStreamPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{34}
+}
+func (m *EventPaymentStreamPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentStreamPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentStreamPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentStreamPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentStreamPayout.Merge(m, src)
+}
+func (m *EventPaymentStreamPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentStreamPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentStreamPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentStreamPayout proto.InternalMessageInfo
+
+func (m *EventPaymentStreamPayout) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *EventPaymentStreamPayout) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventPaymentStreamPayout) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventPaymentStreamPayout) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventPaymentStreamPayout) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+// EventPaymentStreamMissed is emitted when due periods of a payment stream
+// are skipped because the payout failed.
+type EventPaymentStreamMissed struct {
+	AgentId  string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	Periods  uint64 `protobuf:"varint,3,opt,name=periods,proto3" json:"periods,omitempty"`
+	Reason   string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventPaymentStreamMissed) Reset()         { *m = EventPaymentStreamMissed{} }
+func (m *EventPaymentStreamMissed) String() string { return proto.CompactTextString(m) }
+func (*EventPaymentStreamMissed) ProtoMessage()    {}
+func (*EventPaymentStreamMissed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{35}
+}
+func (m *EventPaymentStreamMissed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPaymentStreamMissed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPaymentStreamMissed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPaymentStreamMissed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPaymentStreamMissed.Merge(m, src)
+}
+func (m *EventPaymentStreamMissed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPaymentStreamMissed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPaymentStreamMissed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPaymentStreamMissed proto.InternalMessageInfo
+
+func (m *EventPaymentStreamMissed) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *EventPaymentStreamMissed) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *EventPaymentStreamMissed) GetPeriods() uint64 {
+	if m != nil {
+		return m.Periods
+	}
+	return 0
+}
+
+func (m *EventPaymentStreamMissed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventClosePaymentStream is emitted when a payment stream is cancelled or
+// has paid its last period.
+type EventClosePaymentStream struct {
+	Stream PaymentStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+	Reason string        `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventClosePaymentStream) Reset()         { *m = EventClosePaymentStream{} }
+func (m *EventClosePaymentStream) String() string { return proto.CompactTextString(m) }
+func (*EventClosePaymentStream) ProtoMessage()    {}
+func (*EventClosePaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{36}
+}
+func (m *EventClosePaymentStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventClosePaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventClosePaymentStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventClosePaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventClosePaymentStream.Merge(m, src)
+}
+func (m *EventClosePaymentStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventClosePaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventClosePaymentStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventClosePaymentStream proto.InternalMessageInfo
+
+func (m *EventClosePaymentStream) GetStream() PaymentStream {
+	if m != nil {
+		return m.Stream
+	}
+	return PaymentStream{}
+}
+
+func (m *EventClosePaymentStream) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventUpdateAgentRecipientPolicy is emitted when the owner updates an
+// agent's recipient policy.
+type EventUpdateAgentRecipientPolicy struct {
+	AgentId string          `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Policy  RecipientPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *EventUpdateAgentRecipientPolicy) Reset()         { *m = EventUpdateAgentRecipientPolicy{} }
+func (m *EventUpdateAgentRecipientPolicy) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateAgentRecipientPolicy) ProtoMessage()    {}
+func (*EventUpdateAgentRecipientPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{37}
+}
+func (m *EventUpdateAgentRecipientPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateAgentRecipientPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateAgentRecipientPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateAgentRecipientPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateAgentRecipientPolicy.Merge(m, src)
+}
+func (m *EventUpdateAgentRecipientPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateAgentRecipientPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateAgentRecipientPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateAgentRecipientPolicy proto.InternalMessageInfo
+
+func (m *EventUpdateAgentRecipientPolicy) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *EventUpdateAgentRecipientPolicy) GetPolicy() RecipientPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return RecipientPolicy{}
+}
+
+// ActionLogEntry is an immutable record of one attested action, keyed by
+// (agent_id, seq).
+type ActionLogEntry struct {
+	AgentId     string    `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Seq         uint64    `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Payload     []byte    `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	PayloadHash []byte    `protobuf:"bytes,4,opt,name=payload_hash,json=payloadHash,proto3" json:"payload_hash,omitempty"`
+	Height      int64     `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	Time        time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+	// submitter is the account that submitted the attested action or transfer.
+	Submitter string `protobuf:"bytes,7,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// recipient is the account paid, set only for attested transfers.
+	Recipient string `protobuf:"bytes,8,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *ActionLogEntry) Reset()         { *m = ActionLogEntry{} }
+func (m *ActionLogEntry) String() string { return proto.CompactTextString(m) }
+func (*ActionLogEntry) ProtoMessage()    {}
+func (*ActionLogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{38}
+}
+func (m *ActionLogEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionLogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionLogEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionLogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionLogEntry.Merge(m, src)
+}
+func (m *ActionLogEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionLogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionLogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionLogEntry proto.InternalMessageInfo
+
+func (m *ActionLogEntry) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *ActionLogEntry) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *ActionLogEntry) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *ActionLogEntry) GetPayloadHash() []byte {
+	if m != nil {
+		return m.PayloadHash
+	}
+	return nil
+}
+
+func (m *ActionLogEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ActionLogEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *ActionLogEntry) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *ActionLogEntry) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// ActionLogCommitment is an agent's Merkle mountain range accumulator over
+// its action log. Every appended entry is a leaf, so pruned entries stay
+// provable against root.
+type ActionLogCommitment struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// leaf_count is the number of accumulated entries; it equals the agent's
+	// action_seq.
+	LeafCount uint64 `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	// root is the bagged root over all leaves; empty when leaf_count is zero.
+	Root []byte `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	// pruned_before is the lowest seq still held in state; entries below it
+	// have been pruned.
+	PrunedBefore uint64 `protobuf:"varint,4,opt,name=pruned_before,json=prunedBefore,proto3" json:"pruned_before,omitempty"`
+}
+
+func (m *ActionLogCommitment) Reset()         { *m = ActionLogCommitment{} }
+func (m *ActionLogCommitment) String() string { return proto.CompactTextString(m) }
+func (*ActionLogCommitment) ProtoMessage()    {}
+func (*ActionLogCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{39}
+}
+func (m *ActionLogCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionLogCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionLogCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionLogCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionLogCommitment.Merge(m, src)
+}
+func (m *ActionLogCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionLogCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionLogCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionLogCommitment proto.InternalMessageInfo
+
+func (m *ActionLogCommitment) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *ActionLogCommitment) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *ActionLogCommitment) GetRoot() []byte {
+	if m != nil {
+		return m.Root
+	}
+	return nil
+}
+
+func (m *ActionLogCommitment) GetPrunedBefore() uint64 {
+	if m != nil {
+		return m.PrunedBefore
+	}
+	return 0
+}
+
+// ActionLogNode is one node of an agent's action log accumulator. Level zero
+// holds the leaf hashes.
+type ActionLogNode struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Level   uint32 `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`
+	Index   uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Hash    []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *ActionLogNode) Reset()         { *m = ActionLogNode{} }
+func (m *ActionLogNode) String() string { return proto.CompactTextString(m) }
+func (*ActionLogNode) ProtoMessage()    {}
+func (*ActionLogNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{40}
+}
+func (m *ActionLogNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionLogNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionLogNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionLogNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionLogNode.Merge(m, src)
+}
+func (m *ActionLogNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionLogNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionLogNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionLogNode proto.InternalMessageInfo
+
+func (m *ActionLogNode) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *ActionLogNode) GetLevel() uint32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *ActionLogNode) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ActionLogNode) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+// ActionLogProof proves that leaf_hash is the action log entry at seq of an
+// accumulator with leaf_count leaves. siblings climb from the leaf to the
+// peak at peak_index, and peaks bag into the root.
+type ActionLogProof struct {
+	Seq       uint64   `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	LeafCount uint64   `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	LeafHash  []byte   `protobuf:"bytes,3,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	Siblings  [][]byte `protobuf:"bytes,4,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	Peaks     [][]byte `protobuf:"bytes,5,rep,name=peaks,proto3" json:"peaks,omitempty"`
+	PeakIndex uint32   `protobuf:"varint,6,opt,name=peak_index,json=peakIndex,proto3" json:"peak_index,omitempty"`
+}
+
+func (m *ActionLogProof) Reset()         { *m = ActionLogProof{} }
+func (m *ActionLogProof) String() string { return proto.CompactTextString(m) }
+func (*ActionLogProof) ProtoMessage()    {}
+func (*ActionLogProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{41}
+}
+func (m *ActionLogProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActionLogProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActionLogProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActionLogProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActionLogProof.Merge(m, src)
+}
+func (m *ActionLogProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActionLogProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActionLogProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActionLogProof proto.InternalMessageInfo
+
+func (m *ActionLogProof) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *ActionLogProof) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *ActionLogProof) GetLeafHash() []byte {
+	if m != nil {
+		return m.LeafHash
+	}
+	return nil
+}
+
+func (m *ActionLogProof) GetSiblings() [][]byte {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *ActionLogProof) GetPeaks() [][]byte {
+	if m != nil {
+		return m.Peaks
+	}
+	return nil
+}
+
+func (m *ActionLogProof) GetPeakIndex() uint32 {
+	if m != nil {
+		return m.PeakIndex
+	}
+	return 0
+}
+
+// EventPruneActionLog is emitted when action log entries in
+// [from_seq, to_seq) of an agent are pruned from state.
+type EventPruneActionLog struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	FromSeq uint64 `protobuf:"varint,2,opt,name=from_seq,json=fromSeq,proto3" json:"from_seq,omitempty"`
+	ToSeq   uint64 `protobuf:"varint,3,opt,name=to_seq,json=toSeq,proto3" json:"to_seq,omitempty"`
+}
+
+func (m *EventPruneActionLog) Reset()         { *m = EventPruneActionLog{} }
+func (m *EventPruneActionLog) String() string { return proto.CompactTextString(m) }
+func (*EventPruneActionLog) ProtoMessage()    {}
+func (*EventPruneActionLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82de718b81b99b21, []int{42}
+}
+func (m *EventPruneActionLog) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPruneActionLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPruneActionLog.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPruneActionLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPruneActionLog.Merge(m, src)
+}
+func (m *EventPruneActionLog) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPruneActionLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPruneActionLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPruneActionLog proto.InternalMessageInfo
+
+func (m *EventPruneActionLog) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *EventPruneActionLog) GetFromSeq() uint64 {
+	if m != nil {
+		return m.FromSeq
+	}
+	return 0
+}
+
+func (m *EventPruneActionLog) GetToSeq() uint64 {
+	if m != nil {
+		return m.ToSeq
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.agent.SpendWindowMode", SpendWindowMode_name, SpendWindowMode_value)
+	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.agent.Params")
+	proto.RegisterType((*Agent)(nil), "dymensionxyz.dymension.agent.Agent")
+	proto.RegisterType((*RegisteredPolicy)(nil), "dymensionxyz.dymension.agent.RegisteredPolicy")
+	proto.RegisterType((*SubAgentSpec)(nil), "dymensionxyz.dymension.agent.SubAgentSpec")
+	proto.RegisterType((*PolicySet)(nil), "dymensionxyz.dymension.agent.PolicySet")
+	proto.RegisterType((*AttestationQuorum)(nil), "dymensionxyz.dymension.agent.AttestationQuorum")
+	proto.RegisterType((*SecurityPolicy)(nil), "dymensionxyz.dymension.agent.SecurityPolicy")
+	proto.RegisterType((*PendingWithdrawal)(nil), "dymensionxyz.dymension.agent.PendingWithdrawal")
+	proto.RegisterType((*PaymentStream)(nil), "dymensionxyz.dymension.agent.PaymentStream")
+	proto.RegisterType((*SpendSlot)(nil), "dymensionxyz.dymension.agent.SpendSlot")
+	proto.RegisterType((*SpendBudget)(nil), "dymensionxyz.dymension.agent.SpendBudget")
+	proto.RegisterType((*AgentEscrow)(nil), "dymensionxyz.dymension.agent.AgentEscrow")
+	proto.RegisterType((*RecipientPolicy)(nil), "dymensionxyz.dymension.agent.RecipientPolicy")
+	proto.RegisterType((*RecipientUsage)(nil), "dymensionxyz.dymension.agent.RecipientUsage")
+	proto.RegisterType((*EventRegisterAgent)(nil), "dymensionxyz.dymension.agent.EventRegisterAgent")
+	proto.RegisterType((*EventDeactivateAgent)(nil), "dymensionxyz.dymension.agent.EventDeactivateAgent")
+	proto.RegisterType((*EventCreateSubAgent)(nil), "dymensionxyz.dymension.agent.EventCreateSubAgent")
+	proto.RegisterType((*EventReturnSubAgentEscrow)(nil), "dymensionxyz.dymension.agent.EventReturnSubAgentEscrow")
+	proto.RegisterType((*EventPolicyRegistered)(nil), "dymensionxyz.dymension.agent.EventPolicyRegistered")
+	proto.RegisterType((*EventPolicySuperseded)(nil), "dymensionxyz.dymension.agent.EventPolicySuperseded")
+	proto.RegisterType((*EventPolicyRevoked)(nil), "dymensionxyz.dymension.agent.EventPolicyRevoked")
+	proto.RegisterType((*EventPolicyUnrevoked)(nil), "dymensionxyz.dymension.agent.EventPolicyUnrevoked")
+	proto.RegisterType((*EventUpdateAgentPolicy)(nil), "dymensionxyz.dymension.agent.EventUpdateAgentPolicy")
+	proto.RegisterType((*EventUpdateAgentPolicySet)(nil), "dymensionxyz.dymension.agent.EventUpdateAgentPolicySet")
+	proto.RegisterType((*EventAttestedTransfer)(nil), "dymensionxyz.dymension.agent.EventAttestedTransfer")
+	proto.RegisterType((*EventFundAgentEscrow)(nil), "dymensionxyz.dymension.agent.EventFundAgentEscrow")
+	proto.RegisterType((*EventWithdrawAgentEscrow)(nil), "dymensionxyz.dymension.agent.EventWithdrawAgentEscrow")
+	proto.RegisterType((*EventUpdateAgentSpendPolicy)(nil), "dymensionxyz.dymension.agent.EventUpdateAgentSpendPolicy")
+	proto.RegisterType((*EventUpdateAgentSecurityPolicy)(nil), "dymensionxyz.dymension.agent.EventUpdateAgentSecurityPolicy")
+	proto.RegisterType((*EventFreezeAgent)(nil), "dymensionxyz.dymension.agent.EventFreezeAgent")
+	proto.RegisterType((*EventUnfreezeAgent)(nil), "dymensionxyz.dymension.agent.EventUnfreezeAgent")
+	proto.RegisterType((*EventQueueAgentWithdrawal)(nil), "dymensionxyz.dymension.agent.EventQueueAgentWithdrawal")
+	proto.RegisterType((*EventCancelAgentWithdrawal)(nil), "dymensionxyz.dymension.agent.EventCancelAgentWithdrawal")
+	proto.RegisterType((*EventCreatePaymentStream)(nil), "dymensionxyz.dymension.agent.EventCreatePaymentStream")
+	proto.RegisterType((*EventPaymentStreamPayout)(nil), "dymensionxyz.dymension.agent.EventPaymentStreamPayout")
+	proto.RegisterType((*EventPaymentStreamMissed)(nil), "dymensionxyz.dymension.agent.EventPaymentStreamMissed")
+	proto.RegisterType((*EventClosePaymentStream)(nil), "dymensionxyz.dymension.agent.EventClosePaymentStream")
+	proto.RegisterType((*EventUpdateAgentRecipientPolicy)(nil), "dymensionxyz.dymension.agent.EventUpdateAgentRecipientPolicy")
+	proto.RegisterType((*ActionLogEntry)(nil), "dymensionxyz.dymension.agent.ActionLogEntry")
+	proto.RegisterType((*ActionLogCommitment)(nil), "dymensionxyz.dymension.agent.ActionLogCommitment")
+	proto.RegisterType((*ActionLogNode)(nil), "dymensionxyz.dymension.agent.ActionLogNode")
+	proto.RegisterType((*ActionLogProof)(nil), "dymensionxyz.dymension.agent.ActionLogProof")
+	proto.RegisterType((*EventPruneActionLog)(nil), "dymensionxyz.dymension.agent.EventPruneActionLog")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/agent/agent.proto", fileDescriptor_82de718b81b99b21)
+}
+
+var fileDescriptor_82de718b81b99b21 = []byte{
+	// 3068 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x3a, 0x4d, 0x6f, 0xe3, 0xd6,
+	0xb5, 0x43, 0x49, 0x96, 0xa5, 0x23, 0x59, 0xb6, 0xaf, 0x6d, 0x0d, 0xed, 0x99, 0x78, 0xe6, 0x31,
+	0x2f, 0x2f, 0x7e, 0xc9, 0x1b, 0xf9, 0x65, 0xa6, 0x48, 0x93, 0x16, 0x45, 0xe0, 0xaf, 0x24, 0x6e,
+	0x6c, 0x8f, 0x42, 0xdb, 0x18, 0xa4, 0x69, 0xc1, 0x50, 0xe2, 0x95, 0xcc, 0x0c, 0xc9, 0xab, 0xe1,
+	0xa5, 0x2c, 0x6b, 0x50, 0x74, 0x95, 0x45, 0xd3, 0x2e, 0x9a, 0x65, 0x51, 0xa0, 0xbb, 0x2e, 0x8a,
+	0x2e, 0xda, 0x2e, 0xb2, 0x68, 0x81, 0x6e, 0x8a, 0xa2, 0x40, 0x96, 0x41, 0x56, 0x45, 0x0b, 0x24,
+	0xed, 0xe4, 0x37, 0x14, 0xe9, 0xa2, 0x8b, 0xe2, 0x7e, 0x90, 0x22, 0x25, 0xdb, 0x92, 0x1c, 0x3b,
+	0x1b, 0x5b, 0xf7, 0xdc, 0x73, 0x0e, 0xcf, 0x3d, 0xdf, 0xe7, 0x92, 0xb0, 0x62, 0x75, 0x5d, 0xec,
+	0x51, 0x9b, 0x78, 0x27, 0xdd, 0xc7, 0xab, 0xd1, 0x62, 0xd5, 0x6c, 0x62, 0x2f, 0x10, 0x7f, 0x2b,
+	0x2d, 0x9f, 0x04, 0x04, 0xdd, 0x8c, 0x63, 0x56, 0xa2, 0x45, 0x85, 0xe3, 0x2c, 0xcd, 0x37, 0x49,
+	0x93, 0x70, 0xc4, 0x55, 0xf6, 0x4b, 0xd0, 0x2c, 0x2d, 0x37, 0x09, 0x69, 0x3a, 0x78, 0x95, 0xaf,
+	0x6a, 0xed, 0xc6, 0xaa, 0xd5, 0xf6, 0xcd, 0x80, 0x51, 0x89, 0xfd, 0x5b, 0xfd, 0xfb, 0x81, 0xed,
+	0x62, 0x1a, 0x98, 0x6e, 0x2b, 0x64, 0x50, 0x27, 0xd4, 0x25, 0x74, 0xb5, 0x66, 0x52, 0xbc, 0x7a,
+	0xfc, 0x42, 0x0d, 0x07, 0xe6, 0x0b, 0xab, 0x75, 0x62, 0x87, 0x0c, 0x16, 0xc5, 0xbe, 0x21, 0x9e,
+	0x2c, 0x16, 0x72, 0xeb, 0xd9, 0x33, 0x4e, 0x56, 0x27, 0xae, 0x4b, 0xbc, 0xd5, 0x00, 0x63, 0x81,
+	0xa8, 0xfd, 0x31, 0x0b, 0xd9, 0xaa, 0xe9, 0x9b, 0x2e, 0x45, 0x2b, 0x30, 0xe3, 0x9a, 0x27, 0x86,
+	0x59, 0x67, 0x32, 0x1a, 0xb5, 0x6e, 0x80, 0xa9, 0xaa, 0xdc, 0x56, 0x56, 0x32, 0x7a, 0xc9, 0x35,
+	0x4f, 0xd6, 0x38, 0x78, 0x9d, 0x41, 0xd1, 0x21, 0x94, 0xf9, 0xc1, 0x0d, 0x1f, 0x37, 0x6d, 0x1a,
+	0x88, 0x53, 0x19, 0x0d, 0x8c, 0xd5, 0xd4, 0x6d, 0x65, 0xa5, 0x70, 0x77, 0xb1, 0x22, 0x85, 0x61,
+	0x92, 0x57, 0xa4, 0xe4, 0x95, 0x0d, 0x62, 0x7b, 0xeb, 0x99, 0x8f, 0x3e, 0xbd, 0x75, 0x4d, 0x9f,
+	0xe7, 0xe4, 0x7a, 0x8c, 0xfa, 0x55, 0x8c, 0xd1, 0x2b, 0x70, 0xb3, 0x45, 0x1c, 0xbb, 0xde, 0x35,
+	0x7c, 0x12, 0x08, 0x9e, 0x16, 0x76, 0xcc, 0xae, 0x51, 0x73, 0x48, 0xfd, 0x21, 0x55, 0xd3, 0x5c,
+	0x98, 0x45, 0x81, 0xa3, 0x4b, 0x94, 0x4d, 0x86, 0xb1, 0xce, 0x11, 0xd0, 0x3a, 0x14, 0x1b, 0x18,
+	0x5b, 0x35, 0xb3, 0xfe, 0x90, 0x4b, 0x93, 0x19, 0x4d, 0x9a, 0x42, 0x48, 0xc4, 0x84, 0xb8, 0x07,
+	0xe5, 0x88, 0x47, 0x60, 0x36, 0x0d, 0xa6, 0x12, 0xa1, 0x8b, 0x09, 0xfe, 0xf8, 0xb9, 0x70, 0xf7,
+	0xc0, 0x6c, 0xee, 0x9a, 0x27, 0x42, 0x21, 0xdf, 0x05, 0x35, 0x22, 0xb2, 0x70, 0xdd, 0xec, 0x1a,
+	0x47, 0xa6, 0xd3, 0x30, 0x1c, 0xbb, 0x81, 0xd5, 0xac, 0x14, 0x42, 0x58, 0xbb, 0x12, 0x5a, 0xbb,
+	0xb2, 0x29, 0xbd, 0x61, 0x3d, 0xc7, 0x84, 0xf8, 0xe9, 0x67, 0xb7, 0x14, 0x7d, 0x21, 0x64, 0xb2,
+	0xc9, 0x78, 0xbc, 0x6e, 0x3a, 0x8d, 0x1d, 0xbb, 0xc1, 0xf5, 0x22, 0x8d, 0xe2, 0x90, 0xa6, 0xe1,
+	0xe3, 0x00, 0x7b, 0x7c, 0x85, 0xbd, 0xc0, 0xb7, 0x31, 0x55, 0x27, 0x85, 0x5e, 0x04, 0xce, 0x0e,
+	0x69, 0xea, 0x21, 0xc6, 0x96, 0x40, 0x40, 0xdf, 0x82, 0x1b, 0xa7, 0x32, 0x90, 0x7a, 0xcd, 0x71,
+	0x7a, 0x75, 0x90, 0x5e, 0xaa, 0xf5, 0x1e, 0x94, 0x63, 0xe4, 0x2d, 0xbf, 0xed, 0x61, 0xc3, 0xb1,
+	0x5d, 0x3b, 0x50, 0xf3, 0x42, 0x25, 0x11, 0x65, 0x95, 0xed, 0xed, 0xb0, 0x2d, 0x74, 0x07, 0xe6,
+	0x98, 0xea, 0x68, 0xbb, 0x66, 0x08, 0x5f, 0xb1, 0x70, 0x2b, 0x38, 0x52, 0x81, 0x53, 0x30, 0x47,
+	0xdb, 0x6f, 0xd7, 0xd6, 0xd8, 0xc6, 0x26, 0x83, 0xa3, 0xff, 0x86, 0x52, 0x02, 0x9d, 0xaa, 0x05,
+	0x8e, 0x59, 0x8c, 0x61, 0x52, 0xb4, 0x0f, 0x73, 0x66, 0x10, 0xb0, 0x20, 0xe1, 0xe2, 0x70, 0x77,
+	0x6d, 0x62, 0xb5, 0x38, 0xba, 0x8a, 0x67, 0x63, 0xf4, 0xbb, 0xe6, 0xc9, 0x5a, 0x53, 0xb8, 0x9d,
+	0xc9, 0x22, 0x24, 0x30, 0x68, 0xe0, 0x63, 0xd3, 0x65, 0x01, 0x55, 0xc7, 0x94, 0xca, 0x43, 0x4e,
+	0x49, 0xb7, 0x13, 0x38, 0xfb, 0x1c, 0xa5, 0x2a, 0x30, 0xf8, 0x51, 0xb5, 0x2f, 0xa6, 0x60, 0x82,
+	0x0b, 0x88, 0x4a, 0x90, 0xb2, 0x2d, 0x1e, 0x34, 0x79, 0x3d, 0x65, 0x5b, 0x68, 0x03, 0xb2, 0xc2,
+	0x5b, 0x65, 0x60, 0x3c, 0x53, 0x39, 0x23, 0x8f, 0x88, 0xb8, 0xac, 0x54, 0x39, 0xb2, 0x74, 0x4b,
+	0x49, 0x8a, 0xca, 0x90, 0x65, 0x0a, 0x3e, 0xc6, 0x3c, 0x00, 0x72, 0xba, 0x5c, 0xa1, 0xa7, 0x00,
+	0xa4, 0x59, 0x28, 0x7e, 0xc4, 0x7d, 0x3d, 0xa3, 0xe7, 0x05, 0x64, 0x1f, 0x3f, 0x42, 0xf3, 0x30,
+	0x41, 0x3a, 0x1e, 0xf6, 0xb9, 0xdf, 0xe6, 0x75, 0xb1, 0x40, 0x3a, 0x94, 0x5a, 0xd8, 0xb3, 0x6c,
+	0xaf, 0x69, 0x48, 0xc9, 0xb2, 0xe3, 0x4a, 0xa6, 0xe8, 0x53, 0x92, 0x85, 0x00, 0xa2, 0xbb, 0xb0,
+	0x90, 0xe4, 0x69, 0x1c, 0x61, 0xbb, 0x79, 0x14, 0x70, 0xc7, 0x4c, 0xeb, 0x73, 0x09, 0xec, 0xd7,
+	0xf9, 0x16, 0xba, 0x05, 0x05, 0xca, 0xe0, 0x86, 0x85, 0x3d, 0xe2, 0x72, 0x17, 0xcc, 0xeb, 0xc0,
+	0x41, 0x9b, 0x0c, 0x82, 0xde, 0x81, 0xb2, 0x40, 0xe0, 0x46, 0x30, 0x5a, 0xd8, 0x37, 0x3a, 0xb6,
+	0x67, 0x91, 0x0e, 0x77, 0xba, 0xfc, 0xfa, 0xf3, 0x4c, 0x47, 0x7f, 0xfd, 0xf4, 0xd6, 0x82, 0x08,
+	0x6e, 0x6a, 0x3d, 0xac, 0xd8, 0x64, 0xd5, 0x35, 0x83, 0xa3, 0xca, 0xb6, 0x17, 0x7c, 0xf2, 0xe1,
+	0x1d, 0x10, 0x1b, 0x6c, 0xa5, 0xcf, 0x71, 0x56, 0xdc, 0x5a, 0x55, 0xec, 0x3f, 0xe0, 0x7c, 0x50,
+	0x05, 0x04, 0x58, 0xf2, 0x0d, 0xa3, 0x41, 0x78, 0xe8, 0x2c, 0xdf, 0x12, 0x98, 0x32, 0x0c, 0x5e,
+	0x86, 0xc5, 0x04, 0x3e, 0x0d, 0x4c, 0x3f, 0x08, 0x8f, 0x2a, 0xbc, 0xb5, 0x1c, 0xa3, 0xda, 0x67,
+	0xdb, 0xf2, 0xb4, 0x6f, 0x01, 0x4a, 0x92, 0xb6, 0xb0, 0x17, 0xa8, 0xc5, 0xf1, 0x0f, 0x32, 0x13,
+	0x7f, 0x00, 0x63, 0x82, 0x0e, 0x60, 0x4a, 0xb0, 0xae, 0xb5, 0xad, 0x26, 0x0e, 0xa8, 0x3a, 0x75,
+	0x3b, 0xbd, 0x52, 0xb8, 0xfb, 0xbf, 0x95, 0xf3, 0x2a, 0x56, 0x85, 0xd1, 0x5a, 0xeb, 0x9c, 0x42,
+	0x7a, 0x5b, 0x91, 0xf6, 0x40, 0x14, 0xbd, 0x05, 0xb3, 0x09, 0x81, 0x5d, 0x62, 0x61, 0xb5, 0x74,
+	0x5b, 0x59, 0x29, 0xdd, 0xbd, 0x33, 0x02, 0x67, 0x21, 0xe0, 0x2e, 0xb1, 0xb0, 0x3e, 0x4d, 0x93,
+	0x00, 0xf4, 0x00, 0x16, 0x12, 0xac, 0xc3, 0xaa, 0xa8, 0x4e, 0x8f, 0x1e, 0xc5, 0x73, 0x31, 0xa6,
+	0xe1, 0x36, 0x7a, 0x1b, 0xae, 0x9f, 0x62, 0x1f, 0x56, 0x54, 0xd5, 0x19, 0xce, 0x7a, 0x69, 0x80,
+	0xf5, 0x41, 0x58, 0x71, 0x05, 0xef, 0x0f, 0x18, 0xef, 0xf9, 0x7e, 0x1b, 0x32, 0x24, 0xf4, 0x76,
+	0x68, 0x41, 0x1f, 0xd7, 0x79, 0xa6, 0x70, 0x48, 0x40, 0xd5, 0x59, 0xae, 0xeb, 0x67, 0x47, 0xd0,
+	0xc8, 0xbe, 0x43, 0x42, 0x4d, 0x0b, 0x1b, 0xea, 0x9c, 0x0f, 0x03, 0x53, 0xb4, 0x07, 0x39, 0x8a,
+	0xeb, 0x6d, 0xdf, 0x0e, 0xba, 0x2a, 0xe2, 0xa2, 0xfe, 0xdf, 0x10, 0x96, 0x12, 0x3b, 0x91, 0x2f,
+	0x22, 0x1e, 0xe8, 0x7b, 0x30, 0x13, 0x06, 0x64, 0xc4, 0x77, 0xee, 0x82, 0x7c, 0x15, 0x7d, 0x5a,
+	0xf2, 0x0a, 0x37, 0xd1, 0x8b, 0x70, 0xbd, 0x9f, 0x7d, 0x18, 0x06, 0xf3, 0x3c, 0xe2, 0x17, 0xfa,
+	0x28, 0x64, 0x14, 0x94, 0x21, 0xdb, 0xf0, 0xc9, 0x63, 0xec, 0xa9, 0x0b, 0x22, 0x91, 0x89, 0x15,
+	0xda, 0x01, 0x90, 0x79, 0x83, 0xe2, 0x40, 0x2d, 0xdf, 0x56, 0x86, 0xeb, 0x54, 0x08, 0xb8, 0x1f,
+	0x79, 0x6f, 0xbe, 0x15, 0x02, 0x98, 0xa5, 0xfa, 0xb2, 0x11, 0xe3, 0x7a, 0x7d, 0x7c, 0xae, 0x8a,
+	0x3e, 0x93, 0xc8, 0x5c, 0x8c, 0xf9, 0xcb, 0xb0, 0x38, 0xc8, 0x3c, 0x3c, 0xbc, 0xca, 0x0f, 0x5f,
+	0xee, 0x27, 0x92, 0xa7, 0xbf, 0x01, 0xf9, 0x96, 0xe9, 0x33, 0xdf, 0xb1, 0x2d, 0x75, 0x91, 0xe7,
+	0xbb, 0x9c, 0x00, 0x6c, 0x5b, 0xc8, 0x81, 0x42, 0x40, 0x02, 0xd3, 0x91, 0x99, 0x61, 0xe9, 0x76,
+	0xfa, 0xfc, 0xc6, 0xe5, 0xff, 0xd9, 0xa9, 0x7f, 0xf5, 0xd9, 0xad, 0x95, 0xa6, 0x1d, 0x1c, 0xb5,
+	0x6b, 0x2c, 0x4b, 0xcb, 0x06, 0x50, 0xfe, 0xbb, 0x43, 0xad, 0x87, 0xab, 0x41, 0xb7, 0x85, 0x29,
+	0x27, 0xa0, 0x3a, 0x70, 0xfe, 0x22, 0x67, 0x30, 0x51, 0x84, 0xf4, 0xb6, 0xa5, 0xde, 0x90, 0xa2,
+	0x70, 0xc0, 0xb6, 0x85, 0x9e, 0x83, 0xd9, 0xbe, 0x23, 0xda, 0x96, 0x7a, 0x93, 0x23, 0x4d, 0x27,
+	0x8e, 0xb6, 0x6d, 0x69, 0x3f, 0x4f, 0xc1, 0x8c, 0xe8, 0xe2, 0xb0, 0x8f, 0x2d, 0x01, 0xbe, 0x9a,
+	0x22, 0x58, 0x81, 0x09, 0xd3, 0x72, 0x6d, 0x8f, 0xd7, 0xc0, 0xfc, 0xba, 0xfa, 0xc9, 0x87, 0x77,
+	0xe6, 0xa5, 0x76, 0xd6, 0x2c, 0xcb, 0xc7, 0x94, 0xee, 0x07, 0xbe, 0xed, 0x35, 0x75, 0x81, 0x86,
+	0x54, 0x98, 0x3c, 0xc6, 0x3e, 0x63, 0x2b, 0x2b, 0x63, 0xb8, 0x44, 0xcb, 0x00, 0xb4, 0xdd, 0xc2,
+	0x3e, 0xc5, 0x96, 0x6c, 0xea, 0xf2, 0x7a, 0x0c, 0x82, 0x9e, 0x86, 0xa9, 0x68, 0x65, 0x19, 0x35,
+	0x51, 0x20, 0xf3, 0x7a, 0xb1, 0x07, 0x5c, 0xef, 0xa2, 0x67, 0xa0, 0x54, 0xf7, 0xb1, 0x19, 0x60,
+	0x2b, 0x59, 0xeb, 0xa6, 0x24, 0x54, 0xd8, 0x5c, 0xfb, 0x22, 0x05, 0xc5, 0xb0, 0x7b, 0xd9, 0x6f,
+	0xe1, 0x3a, 0x5a, 0x84, 0x9c, 0xe8, 0x86, 0x22, 0x0d, 0x4d, 0xf2, 0xf5, 0xf6, 0x25, 0xa9, 0x29,
+	0x19, 0x4a, 0xe9, 0x2f, 0x19, 0x4a, 0x75, 0xc8, 0x8a, 0xaa, 0xa2, 0x66, 0x2e, 0xdf, 0x21, 0x25,
+	0xeb, 0xc1, 0x02, 0x36, 0x71, 0x09, 0x05, 0x4c, 0xfb, 0xa5, 0x02, 0xf9, 0x5e, 0xd8, 0xee, 0x40,
+	0xa1, 0x4e, 0x84, 0x3b, 0xdb, 0x7c, 0xaa, 0x49, 0x8f, 0xab, 0x60, 0xa8, 0x93, 0xaa, 0x24, 0x47,
+	0xbb, 0x90, 0x7d, 0xd4, 0x26, 0x7e, 0xdb, 0x95, 0x96, 0x5a, 0x3d, 0x5f, 0xd4, 0xb5, 0x5e, 0xc7,
+	0xf9, 0x26, 0x27, 0x0b, 0x6d, 0x26, 0x98, 0x68, 0x7f, 0x56, 0x60, 0x76, 0x00, 0x07, 0x2d, 0x41,
+	0xce, 0xc7, 0x8f, 0xda, 0xb6, 0x8f, 0x85, 0xa7, 0x4c, 0xe9, 0xd1, 0x1a, 0x3d, 0x06, 0x14, 0xf8,
+	0xa6, 0x47, 0x1b, 0xd8, 0x37, 0x82, 0x23, 0x1f, 0xd3, 0x23, 0xe2, 0x58, 0x6a, 0xea, 0xf2, 0x6d,
+	0x34, 0x1b, 0x3e, 0xe6, 0x20, 0x7c, 0x0a, 0x0b, 0x2c, 0xd1, 0x63, 0x52, 0xd9, 0x8e, 0x86, 0x4b,
+	0xed, 0x07, 0x50, 0x4a, 0xd6, 0x0f, 0xf4, 0x35, 0xc8, 0x35, 0xdb, 0xa6, 0x6f, 0xd9, 0xa6, 0xa7,
+	0x2a, 0x43, 0xe2, 0x36, 0xc2, 0x64, 0xe5, 0xa5, 0x63, 0x07, 0x47, 0x96, 0x6f, 0x76, 0x4c, 0x27,
+	0x39, 0x01, 0xa6, 0x78, 0x28, 0x2f, 0xf4, 0xb6, 0x63, 0xd3, 0x9f, 0xf6, 0x2f, 0x05, 0x66, 0xab,
+	0x22, 0x41, 0x3d, 0x88, 0x10, 0x62, 0xd9, 0x28, 0xc3, 0xb3, 0x51, 0x3c, 0x02, 0x53, 0xc9, 0x08,
+	0xac, 0x84, 0x1d, 0xf3, 0xd0, 0x1c, 0xc3, 0xd1, 0x58, 0x78, 0x98, 0x2e, 0x69, 0x7b, 0x57, 0x13,
+	0x1e, 0x82, 0x35, 0x4b, 0x47, 0xae, 0x19, 0xb4, 0x7d, 0x1c, 0x26, 0x9a, 0x09, 0x9e, 0x68, 0x8a,
+	0x02, 0x28, 0xf3, 0xcc, 0x2f, 0x32, 0x30, 0x55, 0x8d, 0xcf, 0x27, 0xe3, 0x1c, 0xfb, 0x45, 0xc8,
+	0xfb, 0xb8, 0x6e, 0xb7, 0x6c, 0x56, 0x79, 0x86, 0x1d, 0xbd, 0x87, 0x8a, 0xde, 0x80, 0x59, 0x21,
+	0x23, 0x6f, 0xce, 0x5b, 0xd8, 0xb7, 0x89, 0x35, 0xea, 0xc8, 0x3d, 0x2d, 0x28, 0xab, 0xd8, 0xaf,
+	0x72, 0x3a, 0xf4, 0x4d, 0xc8, 0x4a, 0x0e, 0x13, 0xa3, 0xb7, 0x81, 0x92, 0x04, 0x55, 0x61, 0xd6,
+	0xc3, 0x27, 0x81, 0x11, 0x8e, 0x71, 0xbc, 0xe7, 0xcb, 0x8e, 0xd1, 0xf3, 0x4d, 0x33, 0x72, 0xa9,
+	0x40, 0xb6, 0x8f, 0x5e, 0x81, 0x1c, 0x4b, 0x49, 0x9c, 0xd1, 0xe4, 0x18, 0x8c, 0x26, 0xb1, 0x67,
+	0x71, 0x06, 0xcf, 0xc3, 0x2c, 0x1f, 0xd3, 0xc4, 0xa0, 0x2a, 0x4d, 0x97, 0xe3, 0xa6, 0x9b, 0xe9,
+	0x6d, 0xc8, 0xd6, 0xe0, 0x15, 0xc8, 0xb4, 0x4c, 0xdb, 0xba, 0xc8, 0x64, 0xc3, 0x09, 0x59, 0x39,
+	0x72, 0x6d, 0x4a, 0xb1, 0x25, 0xcd, 0x10, 0x4e, 0x31, 0x53, 0x02, 0x2a, 0x74, 0x4c, 0xb5, 0x1f,
+	0x29, 0x90, 0x8f, 0xba, 0x51, 0xf4, 0x0d, 0x98, 0xe0, 0x2d, 0xb2, 0xaa, 0x8c, 0x71, 0x40, 0x41,
+	0x82, 0xd6, 0x60, 0x42, 0x74, 0x2a, 0xa9, 0xf1, 0x45, 0x16, 0x94, 0xda, 0x3f, 0x32, 0x50, 0x88,
+	0x65, 0x71, 0x36, 0xaf, 0x8a, 0x59, 0x50, 0xd4, 0x45, 0xb1, 0x40, 0x87, 0x30, 0x33, 0x30, 0x00,
+	0x5e, 0xe0, 0x99, 0x25, 0x27, 0x39, 0xfb, 0x3d, 0x0d, 0x53, 0xc9, 0xa9, 0x4f, 0xdc, 0x2d, 0x15,
+	0x3b, 0xf1, 0x81, 0xaf, 0x02, 0x73, 0xa7, 0x8d, 0x7a, 0xa2, 0x9f, 0x98, 0xed, 0x0c, 0x4c, 0x79,
+	0x7b, 0x50, 0x4c, 0xcc, 0x77, 0x13, 0xe3, 0xcb, 0x59, 0xe8, 0xc4, 0x46, 0xbb, 0x3d, 0x28, 0xc4,
+	0xc7, 0xaf, 0xec, 0x45, 0xc6, 0x2f, 0xe8, 0x44, 0xbf, 0xd1, 0x0e, 0x4c, 0xf7, 0xcf, 0x5c, 0x93,
+	0xa3, 0x07, 0x5b, 0xa9, 0x93, 0x1c, 0xb7, 0xaa, 0x30, 0x3b, 0x38, 0x68, 0xe5, 0xc6, 0x09, 0xba,
+	0x4e, 0xdf, 0x8c, 0x55, 0x85, 0x62, 0x62, 0xba, 0xca, 0x5f, 0x64, 0xba, 0x2a, 0xf8, 0xbd, 0xc1,
+	0x4a, 0xfb, 0x89, 0x02, 0x05, 0xde, 0x7c, 0x6d, 0xd1, 0xba, 0x4f, 0x3a, 0xe7, 0xb5, 0x5f, 0x18,
+	0x26, 0x6b, 0xa6, 0x63, 0x7a, 0x75, 0x7c, 0x15, 0x85, 0x34, 0xe4, 0xad, 0xbd, 0x9f, 0x82, 0x69,
+	0x3d, 0x4c, 0xa1, 0xb2, 0x4c, 0xde, 0x84, 0xbc, 0xe9, 0x38, 0xa4, 0xe3, 0xd8, 0x34, 0xe0, 0xbd,
+	0x49, 0x5e, 0xef, 0x01, 0x50, 0x87, 0xf5, 0xe3, 0xbe, 0x11, 0xe5, 0x5d, 0xa3, 0x6e, 0xb6, 0xae,
+	0x42, 0xc4, 0xe9, 0x16, 0xf6, 0x23, 0xc9, 0x36, 0xcc, 0x16, 0x1b, 0x04, 0xea, 0x66, 0xcb, 0x38,
+	0x2d, 0x4e, 0xa6, 0xeb, 0x66, 0x2b, 0x71, 0x37, 0x72, 0x0f, 0xca, 0x56, 0xd7, 0x35, 0x3c, 0xd3,
+	0xc5, 0x86, 0x8f, 0x29, 0x71, 0x8e, 0xb1, 0x65, 0x10, 0xcf, 0xe9, 0xf2, 0x68, 0xc9, 0xe9, 0x73,
+	0x56, 0xd7, 0xdd, 0x33, 0x5d, 0xac, 0xcb, 0xbd, 0xfb, 0x9e, 0xd3, 0xd5, 0x9e, 0x28, 0x50, 0x8a,
+	0x9e, 0x78, 0x48, 0xcd, 0x26, 0x3e, 0xcf, 0x40, 0x37, 0xe3, 0x65, 0x4a, 0x94, 0xb0, 0x1e, 0xe0,
+	0xac, 0x58, 0x4d, 0x9f, 0x15, 0xab, 0x4d, 0xd6, 0x5e, 0xd5, 0xb1, 0x7d, 0x8c, 0xad, 0xab, 0xa8,
+	0xde, 0x11, 0x73, 0xad, 0x09, 0x68, 0xeb, 0x38, 0xba, 0xec, 0xc6, 0xbe, 0xb8, 0x28, 0x3c, 0xe7,
+	0x9c, 0xd1, 0xbd, 0x5d, 0x2a, 0x7e, 0x6f, 0x77, 0x1b, 0x0a, 0x0d, 0xdb, 0x6b, 0x62, 0xbf, 0xe5,
+	0xdb, 0x61, 0x99, 0xd6, 0xe3, 0x20, 0xed, 0x35, 0x98, 0xe7, 0x0f, 0xda, 0xc4, 0xb2, 0xbe, 0xe0,
+	0x8b, 0x3d, 0x4a, 0xfb, 0xb7, 0x02, 0x73, 0x9c, 0xd3, 0x06, 0x9f, 0x65, 0xc2, 0xf9, 0x25, 0x39,
+	0xc0, 0x2a, 0x7d, 0x03, 0xec, 0x39, 0xfd, 0xc5, 0x7c, 0xa2, 0xad, 0x3a, 0xe3, 0x40, 0x99, 0x81,
+	0x03, 0xc5, 0xa6, 0x8f, 0x89, 0xab, 0x9b, 0x3e, 0x66, 0x20, 0xcd, 0x6e, 0x4f, 0xb3, 0xdc, 0x4f,
+	0xd8, 0x4f, 0xed, 0x77, 0x0a, 0x2c, 0x4a, 0x8b, 0x05, 0x6d, 0xdf, 0x0b, 0x8f, 0x3f, 0x3c, 0x83,
+	0x24, 0xf4, 0x93, 0xea, 0xd3, 0x4f, 0xaf, 0x57, 0x4c, 0x5f, 0x59, 0xaf, 0xa8, 0xfd, 0x4c, 0x81,
+	0x85, 0xad, 0xe3, 0x28, 0xb1, 0xf4, 0x26, 0xf3, 0x81, 0x99, 0x7c, 0x3e, 0x1c, 0xa7, 0xa5, 0xe5,
+	0x07, 0x86, 0xe6, 0x74, 0x72, 0x68, 0x5e, 0x83, 0x5c, 0x80, 0xb1, 0xc1, 0x1e, 0xca, 0x4d, 0x55,
+	0xba, 0xfb, 0x3f, 0x43, 0xa6, 0xa7, 0x83, 0xad, 0xad, 0x83, 0x6e, 0x0b, 0xeb, 0x93, 0x01, 0xc6,
+	0xec, 0x87, 0xf6, 0x5e, 0x52, 0xb8, 0xfd, 0x68, 0x9c, 0x1e, 0x10, 0x6e, 0x60, 0x02, 0x4f, 0x9d,
+	0x32, 0x81, 0xb3, 0xa4, 0xd9, 0x0e, 0x8e, 0x08, 0xbf, 0xdc, 0x12, 0x9e, 0xd5, 0x03, 0x9c, 0x3d,
+	0xfe, 0x6b, 0x7b, 0x80, 0x62, 0x52, 0xe8, 0xf8, 0x98, 0x3c, 0xc4, 0x56, 0xbf, 0x37, 0x2a, 0x83,
+	0xde, 0x58, 0x86, 0xac, 0x8f, 0x4d, 0x4a, 0x42, 0x95, 0xc9, 0x95, 0xf6, 0x12, 0xcc, 0xc7, 0xf8,
+	0x1d, 0x7a, 0xfe, 0xa8, 0x1c, 0xb5, 0x77, 0xa0, 0xcc, 0x29, 0x0f, 0x5b, 0x56, 0x18, 0xac, 0xb2,
+	0x20, 0x9c, 0xe3, 0x64, 0xa7, 0xf6, 0x95, 0xa9, 0xd3, 0xfb, 0x4a, 0xed, 0x6f, 0xa1, 0x2b, 0x0f,
+	0x3c, 0x82, 0x0d, 0xc5, 0x97, 0xf4, 0x14, 0xf4, 0x2c, 0x4c, 0xd7, 0x89, 0x11, 0x3b, 0x19, 0xe5,
+	0x3e, 0x9e, 0xd7, 0x4b, 0x75, 0xf2, 0x6a, 0x0c, 0x1a, 0x9b, 0x9b, 0x33, 0x97, 0x31, 0x37, 0xff,
+	0x3e, 0x74, 0x28, 0x81, 0x88, 0xad, 0x03, 0x39, 0xac, 0x9e, 0x77, 0x32, 0x19, 0xef, 0xa9, 0x28,
+	0xde, 0x93, 0x75, 0x25, 0xdd, 0x5f, 0x57, 0xbe, 0x1e, 0x9b, 0xf1, 0x46, 0x9a, 0x6c, 0x24, 0x3a,
+	0x63, 0x4b, 0xdb, 0x35, 0xd7, 0x0e, 0x82, 0xe8, 0x15, 0x4c, 0x0f, 0xa0, 0xfd, 0x5a, 0x91, 0x6e,
+	0xf3, 0x6a, 0xdb, 0xb3, 0x46, 0xcc, 0x2f, 0xec, 0xfa, 0xb4, 0xed, 0x59, 0x51, 0xba, 0x96, 0xab,
+	0xaf, 0x26, 0xb5, 0xfc, 0x56, 0x01, 0x95, 0x0b, 0x1c, 0x8e, 0xd6, 0x23, 0x0a, 0x7d, 0x7a, 0x35,
+	0xfb, 0x4a, 0x44, 0xfe, 0x67, 0x1a, 0x6e, 0xf4, 0x7b, 0x3f, 0xef, 0x16, 0x87, 0x47, 0x59, 0xdf,
+	0xdb, 0xa9, 0xd4, 0x18, 0x6f, 0xa7, 0xd2, 0x57, 0xfb, 0x76, 0x2a, 0x73, 0xd6, 0xdb, 0xa9, 0x2b,
+	0xb9, 0x46, 0x3b, 0xfd, 0x3d, 0x50, 0xf6, 0x6a, 0xdf, 0x03, 0x4d, 0x7e, 0xb9, 0xf7, 0x40, 0xda,
+	0x87, 0x0a, 0x2c, 0x0f, 0xd8, 0x3d, 0x79, 0x31, 0x75, 0x8e, 0xe9, 0xbf, 0xdd, 0x77, 0x0d, 0x7b,
+	0x91, 0x37, 0x31, 0x92, 0xc3, 0xe9, 0x69, 0x34, 0x7d, 0x46, 0xb2, 0xde, 0x82, 0x19, 0x91, 0x11,
+	0x7c, 0x8c, 0x1f, 0x0f, 0xef, 0xdd, 0xca, 0x90, 0xa5, 0x76, 0xb3, 0x17, 0x59, 0x72, 0xa5, 0xbd,
+	0x26, 0xeb, 0xdb, 0xa1, 0xd7, 0xf8, 0x72, 0x8c, 0x7c, 0x59, 0x3b, 0xde, 0x6c, 0xe3, 0xb6, 0xe0,
+	0x12, 0xbb, 0x55, 0x3b, 0x04, 0xe8, 0x5d, 0xc2, 0xa9, 0xca, 0x28, 0xe9, 0x7c, 0xe0, 0x6a, 0x2e,
+	0xbc, 0x59, 0xed, 0x31, 0xd2, 0x7e, 0xac, 0xc0, 0x92, 0x68, 0x3d, 0xd9, 0xb0, 0xe4, 0x7c, 0x35,
+	0x4f, 0x3d, 0xb3, 0xb4, 0x63, 0x50, 0x63, 0x7d, 0x70, 0xf2, 0x7e, 0x6d, 0x1b, 0xb2, 0xe2, 0x63,
+	0x01, 0x29, 0xc6, 0xf3, 0x43, 0xc4, 0x88, 0x13, 0x87, 0x5e, 0x22, 0x18, 0x68, 0x7f, 0x0a, 0x53,
+	0x6b, 0x02, 0xa9, 0x6a, 0x76, 0x49, 0x3b, 0x18, 0xd2, 0x6f, 0x0a, 0x0e, 0x61, 0xbf, 0x99, 0xd1,
+	0x73, 0x02, 0xd0, 0x3f, 0x2d, 0x5d, 0x5e, 0x55, 0x53, 0x61, 0x32, 0xbc, 0x61, 0x12, 0x9f, 0xc3,
+	0x84, 0x4b, 0xed, 0xbd, 0x53, 0x4f, 0xb1, 0xcb, 0xef, 0x9f, 0x2e, 0x7c, 0x8a, 0xd8, 0xe3, 0xd2,
+	0x89, 0xc7, 0xc5, 0x6c, 0x96, 0x49, 0xd8, 0xec, 0xfb, 0x70, 0x5d, 0xd8, 0xcc, 0x21, 0xf4, 0xca,
+	0x4c, 0x76, 0xa6, 0xc7, 0xbc, 0xaf, 0xc0, 0xad, 0xfe, 0xd4, 0xd3, 0x3f, 0xed, 0x9f, 0xa3, 0x8b,
+	0x37, 0xfa, 0x72, 0xcf, 0x90, 0x14, 0xdb, 0xc7, 0x39, 0x99, 0x7c, 0xb4, 0x3f, 0xa4, 0xa0, 0xb4,
+	0x16, 0x7e, 0x98, 0xc3, 0xbe, 0x04, 0xea, 0x8e, 0xd7, 0x17, 0x31, 0xdd, 0x9b, 0x5d, 0x87, 0x98,
+	0x16, 0xd7, 0x7d, 0x51, 0x0f, 0x97, 0xe8, 0xbf, 0xa0, 0x28, 0x7f, 0x1a, 0x47, 0x26, 0x3d, 0xe2,
+	0x16, 0x28, 0xea, 0x05, 0x09, 0x7b, 0xdd, 0xa4, 0x47, 0x4c, 0x41, 0x89, 0xeb, 0x6a, 0xb9, 0x42,
+	0x2f, 0x41, 0x66, 0xec, 0xcb, 0x59, 0x4e, 0xc1, 0x6e, 0xa9, 0x7b, 0xfd, 0xd4, 0xe4, 0xb0, 0x5b,
+	0xea, 0x08, 0x35, 0x79, 0xbb, 0x9d, 0x1b, 0xf9, 0x76, 0x5b, 0xfb, 0xa1, 0x02, 0x73, 0x91, 0xfa,
+	0x36, 0x88, 0xeb, 0xda, 0x81, 0x3b, 0x24, 0x93, 0x3e, 0x05, 0xe0, 0x60, 0xb3, 0x61, 0xd4, 0x79,
+	0x64, 0x09, 0x55, 0xe6, 0x19, 0x64, 0x83, 0xc7, 0x0e, 0x82, 0x8c, 0x4f, 0x48, 0x20, 0xb5, 0xc9,
+	0x7f, 0xb3, 0x51, 0x87, 0x7f, 0x4f, 0x65, 0x19, 0x35, 0xdc, 0x20, 0x3e, 0x96, 0xf5, 0xbd, 0x28,
+	0x80, 0xeb, 0x1c, 0xa6, 0xbd, 0x0b, 0x53, 0x91, 0x24, 0x7b, 0xac, 0x74, 0x9e, 0xdf, 0x6f, 0x39,
+	0xf8, 0x18, 0x3b, 0xfc, 0xf1, 0x53, 0xba, 0x58, 0x30, 0xa8, 0xed, 0x59, 0xf8, 0x44, 0x46, 0x91,
+	0x58, 0x30, 0x81, 0x62, 0xf6, 0xe3, 0xbf, 0xb5, 0xdf, 0x28, 0x31, 0xaf, 0xa9, 0xfa, 0x84, 0x34,
+	0x42, 0xd7, 0x50, 0x7a, 0xae, 0x31, 0xe4, 0xa0, 0x37, 0x80, 0x2f, 0x84, 0x73, 0x88, 0xd3, 0xe6,
+	0x18, 0x80, 0x7b, 0xc6, 0x12, 0xe4, 0xa8, 0x5d, 0x73, 0x6c, 0xaf, 0x49, 0xf9, 0xc5, 0x4b, 0x51,
+	0x8f, 0xd6, 0x4c, 0xcc, 0x16, 0x36, 0x1f, 0x8a, 0xde, 0xa5, 0xa8, 0x8b, 0x05, 0x7b, 0x1a, 0xfb,
+	0x61, 0x88, 0x13, 0x64, 0xf9, 0xb9, 0xf2, 0x0c, 0xb2, 0xcd, 0x00, 0x5a, 0x4d, 0xde, 0x56, 0xf0,
+	0x6f, 0xcf, 0x22, 0xd1, 0xcf, 0xd3, 0xd1, 0x22, 0xe4, 0x1a, 0x3e, 0x71, 0x8d, 0x9e, 0xc3, 0x4f,
+	0xb2, 0x35, 0xfb, 0x68, 0x6a, 0x01, 0xb2, 0x01, 0xe1, 0x1b, 0x52, 0x53, 0x01, 0xd9, 0xc7, 0x8f,
+	0x9e, 0x7b, 0x17, 0xa6, 0xfb, 0xfa, 0x19, 0x74, 0x13, 0xd4, 0xfd, 0xea, 0xd6, 0xde, 0xa6, 0xf1,
+	0x60, 0x7b, 0x6f, 0xf3, 0xfe, 0x03, 0x63, 0xf7, 0xfe, 0xe6, 0x96, 0xb1, 0xbe, 0x73, 0x7f, 0xe3,
+	0x8d, 0xfd, 0x99, 0x6b, 0x68, 0x09, 0xca, 0x83, 0xbb, 0x07, 0xdb, 0xbb, 0x5b, 0x33, 0x0a, 0x7a,
+	0x0a, 0x16, 0x07, 0xf7, 0xf4, 0xfb, 0x3b, 0x3b, 0xdb, 0x7b, 0xaf, 0xcd, 0xa4, 0xd6, 0x77, 0x3e,
+	0x7a, 0xb2, 0xac, 0x7c, 0xfc, 0x64, 0x59, 0xf9, 0xfb, 0x93, 0x65, 0xe5, 0x83, 0xcf, 0x97, 0xaf,
+	0x7d, 0xfc, 0xf9, 0xf2, 0xb5, 0xbf, 0x7c, 0xbe, 0x7c, 0xed, 0x3b, 0x77, 0x63, 0x2d, 0xf0, 0x19,
+	0xdf, 0x77, 0x1e, 0xdf, 0x5b, 0x3d, 0x91, 0x9f, 0xaf, 0xf2, 0x96, 0xb8, 0x96, 0xe5, 0xa1, 0x75,
+	0xef, 0x3f, 0x03, 0x00, 0x6d, 0xbf, 0x43, 0x83, 0xeb, 0x2a, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PaymentStreamProcessLimit != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.PaymentStreamProcessLimit))
+		i--
+		dAtA[i] = 0x68
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.AttestationMaxAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AttestationMaxAge):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAgent(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x62
+	if m.MaxSubAgents != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxSubAgents))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxSubAgentDepth != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxSubAgentDepth))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ActionLogPruneLimit != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ActionLogPruneLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.ActionLogRetentionBlocks != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ActionLogRetentionBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ActionLogRetentionEntries != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ActionLogRetentionEntries))
+		i--
+		dAtA[i] = 0x38
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.FeedbackDecayHalfLife, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeedbackDecayHalfLife):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAgent(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x32
+	if m.FeedbackTagMaxBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FeedbackTagMaxBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.FeedbackFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PolicyRotationDelayBlocks != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.PolicyRotationDelayBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.AgentRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.MaxActionBytes != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MaxActionBytes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Agent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Agent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Agent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingPolicyId) > 0 {
+		i -= len(m.PendingPolicyId)
+		copy(dAtA[i:], m.PendingPolicyId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.PendingPolicyId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
+	}
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
+	}
+	if len(m.TotalSpent) > 0 {
+		for iNdEx := len(m.TotalSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd2
+		}
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.PendingPolicySetHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.PendingPolicySetHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.PendingPolicySet != nil {
+		{
+			size, err := m.PendingPolicySet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	{
+		size, err := m.PolicySet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.PendingSecurityHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.PendingSecurityHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.PendingSecurity != nil {
+		{
+			size, err := m.PendingSecurity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	{
+		size, err := m.Security.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.SpendRecentSlots) > 0 {
+		for iNdEx := len(m.SpendRecentSlots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendRecentSlots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpendWindowStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpendWindowStartTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintAgent(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SpendWindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindowDuration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintAgent(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x7a
+	if m.SpendWindowMode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.SpendWindowMode))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.SpendBudgets) > 0 {
+		for iNdEx := len(m.SpendBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size := m.SpendWindowSpent.Size()
+		i -= size
+		if _, err := m.SpendWindowSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.SpendWindowStartHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.SpendWindowStartHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.SpendWindowBlocks != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.SpendWindowBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.SpendLimitPerWindow.Size()
+		i -= size
+		if _, err := m.SpendLimitPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SpendDenom) > 0 {
+		i -= len(m.SpendDenom)
+		copy(dAtA[i:], m.SpendDenom)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.SpendDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PendingPolicyHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.PendingPolicyHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PendingPolicy != nil {
+		{
+			size, err := m.PendingPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAgent(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ActionSeq != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ActionSeq))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Supersedes) > 0 {
+		i -= len(m.Supersedes)
+		copy(dAtA[i:], m.Supersedes)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Supersedes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Version != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubAgentSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubAgentSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubAgentSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SpendBudgets) > 0 {
+		for iNdEx := len(m.SpendBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.PolicySet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PolicySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PolicySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PolicySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.CoPolicies) > 0 {
+		for iNdEx := len(m.CoPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationQuorum) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationQuorum) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationQuorum) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Actions {
+		i--
+		if m.Actions {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TransferThreshold) > 0 {
+		for iNdEx := len(m.TransferThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TransferThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Required != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Required))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SecurityPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SecurityPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SecurityPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawalDelayBlocks != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.WithdrawalDelayBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MatureHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MatureHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedPeriods != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.MissedPeriods))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.ActivationHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintAgent(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x3a
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextPaymentTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextPaymentTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintAgent(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x32
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintAgent(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.AmountPerPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendSlot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendSlot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendSlot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Spent.Size()
+		i -= size
+		if _, err := m.Spent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintAgent(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SpendBudget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpendBudget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpendBudget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecentSlots) > 0 {
+		for iNdEx := len(m.RecentSlots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentSlots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	n22, err22 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.WindowStartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStartTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintAgent(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x42
+	n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.WindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintAgent(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x3a
+	if m.WindowMode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.WindowMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.WindowSpent.Size()
+		i -= size
+		if _, err := m.WindowSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.LimitPerWindow.Size()
+		i -= size
+		if _, err := m.LimitPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AgentEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AgentEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipientPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DymNameResolvedOnly {
+		i--
+		if m.DymNameResolvedOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CapWindowBlocks != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.CapWindowBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PerRecipientCap) > 0 {
+		for iNdEx := len(m.PerRecipientCap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerRecipientCap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipientUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Received) > 0 {
+		for iNdEx := len(m.Received) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Received[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRegisterAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRegisterAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRegisterAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDeactivateAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDeactivateAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDeactivateAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreateSubAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateSubAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateSubAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Budget) > 0 {
+		for iNdEx := len(m.Budget) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Budget[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventReturnSubAgentEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventReturnSubAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventReturnSubAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPolicyRegistered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPolicyRegistered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPolicyRegistered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TeeType != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.TeeType))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Version != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPolicySuperseded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPolicySuperseded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPolicySuperseded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPolicyRevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPolicyRevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPolicyRevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPolicyUnrevoked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPolicyUnrevoked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPolicyUnrevoked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAgentPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAgentPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAgentPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAgentPolicySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAgentPolicySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAgentPolicySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quorum.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.CoFingerprints) > 0 {
+		for iNdEx := len(m.CoFingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoFingerprints[iNdEx])
+			copy(dAtA[i:], m.CoFingerprints[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.CoFingerprints[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAttestedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttestedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAttestedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Seq != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFundAgentEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFundAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFundAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventWithdrawAgentEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventWithdrawAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventWithdrawAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAgentSpendPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAgentSpendPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAgentSpendPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n26, err26 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SpendWindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindowDuration):])
+	if err26 != nil {
+		return 0, err26
+	}
+	i -= n26
+	i = encodeVarintAgent(dAtA, i, uint64(n26))
+	i--
+	dAtA[i] = 0x3a
+	if m.SpendWindowMode != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.SpendWindowMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SpendBudgets) > 0 {
+		for iNdEx := len(m.SpendBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAgent(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.SpendWindowBlocks != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.SpendWindowBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.SpendLimitPerWindow.Size()
+		i -= size
+		if _, err := m.SpendLimitPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.SpendDenom) > 0 {
+		i -= len(m.SpendDenom)
+		copy(dAtA[i:], m.SpendDenom)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.SpendDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAgentSecurityPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAgentSecurityPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAgentSecurityPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFreezeAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFreezeAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFreezeAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnfreezeAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnfreezeAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnfreezeAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQueueAgentWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQueueAgentWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQueueAgentWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCancelAgentWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelAgentWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelAgentWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCreatePaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreatePaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreatePaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentStreamPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentStreamPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentStreamPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Periods != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPaymentStreamMissed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPaymentStreamMissed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPaymentStreamMissed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Periods != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Periods))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventClosePaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventClosePaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventClosePaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateAgentRecipientPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateAgentRecipientPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateAgentRecipientPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAgent(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionLogEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionLogEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionLogEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n34, err34 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintAgent(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PayloadHash) > 0 {
+		i -= len(m.PayloadHash)
+		copy(dAtA[i:], m.PayloadHash)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.PayloadHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Seq != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionLogCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionLogCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionLogCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrunedBefore != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.PrunedBefore))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LeafCount != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionLogNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionLogNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionLogNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Index != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Level != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ActionLogProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ActionLogProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ActionLogProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PeakIndex != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.PeakIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Peaks) > 0 {
+		for iNdEx := len(m.Peaks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Peaks[iNdEx])
+			copy(dAtA[i:], m.Peaks[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Peaks[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintAgent(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LeafCount != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Seq != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPruneActionLog) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPruneActionLog) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPruneActionLog) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToSeq != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.ToSeq))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromSeq != 0 {
+		i = encodeVarintAgent(dAtA, i, uint64(m.FromSeq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintAgent(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAgent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAgent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxActionBytes != 0 {
+		n += 1 + sovAgent(uint64(m.MaxActionBytes))
+	}
+	l = m.AgentRegistrationFee.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.PolicyRotationDelayBlocks != 0 {
+		n += 1 + sovAgent(uint64(m.PolicyRotationDelayBlocks))
+	}
+	l = m.FeedbackFee.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.FeedbackTagMaxBytes != 0 {
+		n += 1 + sovAgent(uint64(m.FeedbackTagMaxBytes))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.FeedbackDecayHalfLife)
+	n += 1 + l + sovAgent(uint64(l))
+	if m.ActionLogRetentionEntries != 0 {
+		n += 1 + sovAgent(uint64(m.ActionLogRetentionEntries))
+	}
+	if m.ActionLogRetentionBlocks != 0 {
+		n += 1 + sovAgent(uint64(m.ActionLogRetentionBlocks))
+	}
+	if m.ActionLogPruneLimit != 0 {
+		n += 1 + sovAgent(uint64(m.ActionLogPruneLimit))
+	}
+	if m.MaxSubAgentDepth != 0 {
+		n += 1 + sovAgent(uint64(m.MaxSubAgentDepth))
+	}
+	if m.MaxSubAgents != 0 {
+		n += 1 + sovAgent(uint64(m.MaxSubAgents))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.AttestationMaxAge)
+	n += 1 + l + sovAgent(uint64(l))
+	if m.PaymentStreamProcessLimit != 0 {
+		n += 1 + sovAgent(uint64(m.PaymentStreamProcessLimit))
+	}
+	return n
+}
+
+func (m *Agent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	if m.ActionSeq != 0 {
+		n += 1 + sovAgent(uint64(m.ActionSeq))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.PendingPolicy != nil {
+		l = m.PendingPolicy.Size()
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.PendingPolicyHeight != 0 {
+		n += 1 + sovAgent(uint64(m.PendingPolicyHeight))
+	}
+	l = len(m.SpendDenom)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.SpendLimitPerWindow.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.SpendWindowBlocks != 0 {
+		n += 1 + sovAgent(uint64(m.SpendWindowBlocks))
+	}
+	if m.SpendWindowStartHeight != 0 {
+		n += 1 + sovAgent(uint64(m.SpendWindowStartHeight))
+	}
+	l = m.SpendWindowSpent.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if len(m.SpendBudgets) > 0 {
+		for _, e := range m.SpendBudgets {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.SpendWindowMode != 0 {
+		n += 1 + sovAgent(uint64(m.SpendWindowMode))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindowDuration)
+	n += 1 + l + sovAgent(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpendWindowStartTime)
+	n += 2 + l + sovAgent(uint64(l))
+	if len(m.SpendRecentSlots) > 0 {
+		for _, e := range m.SpendRecentSlots {
+			l = e.Size()
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
+	l = m.Security.Size()
+	n += 2 + l + sovAgent(uint64(l))
+	if m.PendingSecurity != nil {
+		l = m.PendingSecurity.Size()
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	if m.PendingSecurityHeight != 0 {
+		n += 2 + sovAgent(uint64(m.PendingSecurityHeight))
+	}
+	if m.Frozen {
+		n += 3
+	}
+	l = m.PolicySet.Size()
+	n += 2 + l + sovAgent(uint64(l))
+	if m.PendingPolicySet != nil {
+		l = m.PendingPolicySet.Size()
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	if m.PendingPolicySetHeight != 0 {
+		n += 2 + sovAgent(uint64(m.PendingPolicySetHeight))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	if len(m.TotalSpent) > 0 {
+		for _, e := range m.TotalSpent {
+			l = e.Size()
+			n += 2 + l + sovAgent(uint64(l))
+		}
+	}
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	l = len(m.PendingPolicyId)
+	if l > 0 {
+		n += 2 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAgent(uint64(m.Version))
+	}
+	l = len(m.Supersedes)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovAgent(uint64(m.CreatedHeight))
+	}
+	return n
+}
+
+func (m *SubAgentSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	l = m.PolicySet.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.SpendBudgets) > 0 {
+		for _, e := range m.SpendBudgets {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PolicySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CoPolicies) > 0 {
+		for _, e := range m.CoPolicies {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = m.Quorum.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	return n
+}
+
+func (m *AttestationQuorum) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Required != 0 {
+		n += 1 + sovAgent(uint64(m.Required))
+	}
+	if len(m.TransferThreshold) > 0 {
+		for _, e := range m.TransferThreshold {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.Actions {
+		n += 2
+	}
+	return n
+}
+
+func (m *SecurityPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.WithdrawalDelayBlocks != 0 {
+		n += 1 + sovAgent(uint64(m.WithdrawalDelayBlocks))
+	}
+	return n
+}
+
+func (m *PendingWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAgent(uint64(m.Id))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.MatureHeight != 0 {
+		n += 1 + sovAgent(uint64(m.MatureHeight))
+	}
+	return n
+}
+
+func (m *PaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAgent(uint64(m.Id))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.AmountPerPeriod.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAgent(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextPaymentTime)
+	n += 1 + l + sovAgent(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAgent(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovAgent(uint64(m.ActivationHeight))
+	}
+	l = m.Paid.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.MissedPeriods != 0 {
+		n += 1 + sovAgent(uint64(m.MissedPeriods))
+	}
+	return n
+}
+
+func (m *SpendSlot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovAgent(uint64(l))
+	l = m.Spent.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	return n
+}
+
+func (m *SpendBudget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.LimitPerWindow.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovAgent(uint64(m.WindowBlocks))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovAgent(uint64(m.WindowStartHeight))
+	}
+	l = m.WindowSpent.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.WindowMode != 0 {
+		n += 1 + sovAgent(uint64(m.WindowMode))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.WindowDuration)
+	n += 1 + l + sovAgent(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.WindowStartTime)
+	n += 1 + l + sovAgent(uint64(l))
+	if len(m.RecentSlots) > 0 {
+		for _, e := range m.RecentSlots {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AgentEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RecipientPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.PerRecipientCap) > 0 {
+		for _, e := range m.PerRecipientCap {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.CapWindowBlocks != 0 {
+		n += 1 + sovAgent(uint64(m.CapWindowBlocks))
+	}
+	if m.DymNameResolvedOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *RecipientUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovAgent(uint64(m.WindowStartHeight))
+	}
+	if len(m.Received) > 0 {
+		for _, e := range m.Received {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventRegisterAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeactivateAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreateSubAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Budget) > 0 {
+		for _, e := range m.Budget {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.Seq != 0 {
+		n += 1 + sovAgent(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *EventReturnSubAgentEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventPolicyRegistered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAgent(uint64(m.Version))
+	}
+	if m.TeeType != 0 {
+		n += 1 + sovAgent(uint64(m.TeeType))
+	}
+	return n
+}
+
+func (m *EventPolicySuperseded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovAgent(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *EventPolicyRevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPolicyUnrevoked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateAgentPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovAgent(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *EventUpdateAgentPolicySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovAgent(uint64(m.ActivationHeight))
+	}
+	if len(m.CoFingerprints) > 0 {
+		for _, s := range m.CoFingerprints {
+			l = len(s)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	l = m.Quorum.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	return n
+}
+
+func (m *EventAttestedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovAgent(uint64(m.Seq))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFundAgentEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventWithdrawAgentEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventUpdateAgentSpendPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.SpendDenom)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.SpendLimitPerWindow.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.SpendWindowBlocks != 0 {
+		n += 1 + sovAgent(uint64(m.SpendWindowBlocks))
+	}
+	if len(m.SpendBudgets) > 0 {
+		for _, e := range m.SpendBudgets {
+			l = e.Size()
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.SpendWindowMode != 0 {
+		n += 1 + sovAgent(uint64(m.SpendWindowMode))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindowDuration)
+	n += 1 + l + sovAgent(uint64(l))
+	return n
+}
+
+func (m *EventUpdateAgentSecurityPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovAgent(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *EventFreezeAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUnfreezeAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventQueueAgentWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	return n
+}
+
+func (m *EventCancelAgentWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Withdrawal.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCreatePaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	return n
+}
+
+func (m *EventPaymentStreamPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovAgent(uint64(m.StreamId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	if m.Periods != 0 {
+		n += 1 + sovAgent(uint64(m.Periods))
+	}
+	return n
+}
+
+func (m *EventPaymentStreamMissed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovAgent(uint64(m.StreamId))
+	}
+	if m.Periods != 0 {
+		n += 1 + sovAgent(uint64(m.Periods))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventClosePaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateAgentRecipientPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovAgent(uint64(l))
+	return n
+}
+
+func (m *ActionLogEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovAgent(uint64(m.Seq))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.PayloadHash)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAgent(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovAgent(uint64(l))
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ActionLogCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovAgent(uint64(m.LeafCount))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.PrunedBefore != 0 {
+		n += 1 + sovAgent(uint64(m.PrunedBefore))
+	}
+	return n
+}
+
+func (m *ActionLogNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.Level != 0 {
+		n += 1 + sovAgent(uint64(m.Level))
+	}
+	if m.Index != 0 {
+		n += 1 + sovAgent(uint64(m.Index))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	return n
+}
+
+func (m *ActionLogProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovAgent(uint64(m.Seq))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovAgent(uint64(m.LeafCount))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if len(m.Siblings) > 0 {
+		for _, b := range m.Siblings {
+			l = len(b)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if len(m.Peaks) > 0 {
+		for _, b := range m.Peaks {
+			l = len(b)
+			n += 1 + l + sovAgent(uint64(l))
+		}
+	}
+	if m.PeakIndex != 0 {
+		n += 1 + sovAgent(uint64(m.PeakIndex))
+	}
+	return n
+}
+
+func (m *EventPruneActionLog) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovAgent(uint64(l))
+	}
+	if m.FromSeq != 0 {
+		n += 1 + sovAgent(uint64(m.FromSeq))
+	}
+	if m.ToSeq != 0 {
+		n += 1 + sovAgent(uint64(m.ToSeq))
+	}
+	return n
+}
+
+func sovAgent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAgent(x uint64) (n int) {
+	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxActionBytes", wireType)
+			}
+			m.MaxActionBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxActionBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentRegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AgentRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyRotationDelayBlocks", wireType)
+			}
+			m.PolicyRotationDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyRotationDelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedbackFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeedbackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedbackTagMaxBytes", wireType)
+			}
+			m.FeedbackTagMaxBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeedbackTagMaxBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedbackDecayHalfLife", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.FeedbackDecayHalfLife, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLogRetentionEntries", wireType)
+			}
+			m.ActionLogRetentionEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionLogRetentionEntries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLogRetentionBlocks", wireType)
+			}
+			m.ActionLogRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionLogRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLogPruneLimit", wireType)
+			}
+			m.ActionLogPruneLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionLogPruneLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubAgentDepth", wireType)
+			}
+			m.MaxSubAgentDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubAgentDepth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSubAgents", wireType)
+			}
+			m.MaxSubAgents = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSubAgents |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationMaxAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.AttestationMaxAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreamProcessLimit", wireType)
+			}
+			m.PaymentStreamProcessLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaymentStreamProcessLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Agent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionSeq", wireType)
+			}
+			m.ActionSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActionSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingPolicy == nil {
+				m.PendingPolicy = &tee.Policy{}
+			}
+			if err := m.PendingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPolicyHeight", wireType)
+			}
+			m.PendingPolicyHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingPolicyHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimitPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowBlocks", wireType)
+			}
+			m.SpendWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowStartHeight", wireType)
+			}
+			m.SpendWindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendWindowStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendWindowSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendBudgets = append(m.SpendBudgets, SpendBudget{})
+			if err := m.SpendBudgets[len(m.SpendBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowMode", wireType)
+			}
+			m.SpendWindowMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendWindowMode |= SpendWindowMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SpendWindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpendWindowStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendRecentSlots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendRecentSlots = append(m.SpendRecentSlots, SpendSlot{})
+			if err := m.SpendRecentSlots[len(m.SpendRecentSlots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Security", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Security.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSecurity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingSecurity == nil {
+				m.PendingSecurity = &SecurityPolicy{}
+			}
+			if err := m.PendingSecurity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSecurityHeight", wireType)
+			}
+			m.PendingSecurityHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingSecurityHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicySet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolicySet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPolicySet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingPolicySet == nil {
+				m.PendingPolicySet = &PolicySet{}
+			}
+			if err := m.PendingPolicySet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPolicySetHeight", wireType)
+			}
+			m.PendingPolicySetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingPolicySetHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSpent = append(m.TotalSpent, types.Coin{})
+			if err := m.TotalSpent[len(m.TotalSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 27:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 28:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supersedes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supersedes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubAgentSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubAgentSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubAgentSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicySet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolicySet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendBudgets = append(m.SpendBudgets, SpendBudget{})
+			if err := m.SpendBudgets[len(m.SpendBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoPolicies = append(m.CoPolicies, tee.Policy{})
+			if err := m.CoPolicies[len(m.CoPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationQuorum) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationQuorum: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationQuorum: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			m.Required = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Required |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TransferThreshold = append(m.TransferThreshold, types.Coin{})
+			if err := m.TransferThreshold[len(m.TransferThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Actions = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SecurityPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SecurityPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SecurityPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalDelayBlocks", wireType)
+			}
+			m.WithdrawalDelayBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalDelayBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MatureHeight", wireType)
+			}
+			m.MatureHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MatureHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PaymentStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPaymentTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextPaymentTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedPeriods", wireType)
+			}
+			m.MissedPeriods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedPeriods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendSlot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendSlot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendSlot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpendBudget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpendBudget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpendBudget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LimitPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowMode", wireType)
+			}
+			m.WindowMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowMode |= SpendWindowMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.WindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.WindowStartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentSlots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentSlots = append(m.RecentSlots, SpendSlot{})
+			if err := m.RecentSlots[len(m.RecentSlots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AgentEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AgentEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipientPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipientPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipientPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerRecipientCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerRecipientCap = append(m.PerRecipientCap, types.Coin{})
+			if err := m.PerRecipientCap[len(m.PerRecipientCap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CapWindowBlocks", wireType)
+			}
+			m.CapWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CapWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DymNameResolvedOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DymNameResolvedOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RecipientUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecipientUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecipientUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Received = append(m.Received, types.Coin{})
+			if err := m.Received[len(m.Received)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRegisterAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRegisterAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRegisterAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDeactivateAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDeactivateAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDeactivateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreateSubAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateSubAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateSubAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Budget = append(m.Budget, types.Coin{})
+			if err := m.Budget[len(m.Budget)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventReturnSubAgentEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventReturnSubAgentEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventReturnSubAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPolicyRegistered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPolicyRegistered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPolicyRegistered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeeType", wireType)
+			}
+			m.TeeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeeType |= tee.TEEType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPolicySuperseded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPolicySuperseded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPolicySuperseded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPolicyRevoked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPolicyRevoked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPolicyRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPolicyUnrevoked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPolicyUnrevoked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPolicyUnrevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateAgentPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateAgentPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateAgentPolicySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateAgentPolicySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateAgentPolicySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoFingerprints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoFingerprints = append(m.CoFingerprints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quorum", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quorum.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAttestedTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttestedTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttestedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *EventUpdateAgentSpendPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.SpendDenom)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = m.SpendLimitPerWindow.Size()
-	n += 1 + l + sovAgent(uint64(l))
-	if m.SpendWindowBlocks != 0 {
-		n += 1 + sovAgent(uint64(m.SpendWindowBlocks))
-	}
-	return n
-}
 
-func (m *ActionLogEntry) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Seq != 0 {
-		n += 1 + sovAgent(uint64(m.Seq))
-	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	l = len(m.PayloadHash)
-	if l > 0 {
-		n += 1 + l + sovAgent(uint64(l))
-	}
-	if m.Height != 0 {
-		n += 1 + sovAgent(uint64(m.Height))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
-	n += 1 + l + sovAgent(uint64(l))
-	return n
-}
-
-func sovAgent(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAgent(x uint64) (n int) {
-	return sovAgent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EventFundAgentEscrow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2022,17 +12212,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFundAgentEscrow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFundAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxActionBytes", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
-			m.MaxActionBytes = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2042,14 +12232,59 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxActionBytes |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentRegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2076,15 +12311,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AgentRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyRotationDelayBlocks", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PolicyRotationDelayBlocks = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawAgentEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2094,16 +12380,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PolicyRotationDelayBlocks |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedbackFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2113,30 +12412,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.FeedbackFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedbackTagMaxBytes", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.FeedbackTagMaxBytes = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2146,11 +12444,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FeedbackTagMaxBytes |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -2172,7 +12485,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Agent) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2195,15 +12508,15 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Agent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateAgentSpendPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Agent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateAgentSpendPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2231,13 +12544,13 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2247,30 +12560,29 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SpendDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitPerWindow", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2280,17 +12592,31 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Active = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimitPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActionSeq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowBlocks", wireType)
 			}
-			m.ActionSeq = 0
+			m.SpendWindowBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2300,16 +12626,16 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActionSeq |= uint64(b&0x7F) << shift
+				m.SpendWindowBlocks |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendBudgets", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2319,27 +12645,48 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.SpendBudgets = append(m.SpendBudgets, SpendBudget{})
+			if err := m.SpendBudgets[len(m.SpendBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowMode", wireType)
+			}
+			m.SpendWindowMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendWindowMode |= SpendWindowMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPolicy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2366,35 +12713,63 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PendingPolicy == nil {
-				m.PendingPolicy = &tee.Policy{}
-			}
-			if err := m.PendingPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SpendWindowDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PendingPolicyHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.PendingPolicyHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PendingPolicyHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
 			}
-		case 8:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateAgentSecurityPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateAgentSecurityPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateAgentSecurityPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2422,13 +12797,13 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendDenom = string(dAtA[iNdEx:postIndex])
+			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2438,31 +12813,30 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpendLimitPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			m.SpendWindowBlocks = 0
+			m.ActivationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2472,16 +12846,66 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpendWindowBlocks |= uint64(b&0x7F) << shift
+				m.ActivationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowStartHeight", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.SpendWindowStartHeight = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFreezeAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFreezeAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFreezeAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2491,14 +12915,27 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpendWindowStartHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 12:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowSpent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2526,9 +12963,7 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpendWindowSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2551,7 +12986,7 @@ func (m *Agent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AgentEscrow) Unmarshal(dAtA []byte) error {
+func (m *EventUnfreezeAgent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2574,10 +13009,10 @@ func (m *AgentEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AgentEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnfreezeAgent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnfreezeAgent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2614,7 +13049,89 @@ func (m *AgentEscrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventQueueAgentWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventQueueAgentWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventQueueAgentWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2641,8 +13158,7 @@ func (m *AgentEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2667,7 +13183,7 @@ func (m *AgentEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventRegisterAgent) Unmarshal(dAtA []byte) error {
+func (m *EventCancelAgentWithdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2690,17 +13206,17 @@ func (m *EventRegisterAgent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventRegisterAgent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelAgentWithdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventRegisterAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelAgentWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2710,27 +13226,28 @@ func (m *EventRegisterAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2758,13 +13275,63 @@ func (m *EventRegisterAgent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAgent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCreatePaymentStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAgent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreatePaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreatePaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -2774,23 +13341,24 @@ func (m *EventRegisterAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2813,7 +13381,7 @@ func (m *EventRegisterAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDeactivateAgent) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentStreamPayout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2836,10 +13404,10 @@ func (m *EventDeactivateAgent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDeactivateAgent: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentStreamPayout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDeactivateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentStreamPayout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2875,8 +13443,27 @@ func (m *EventDeactivateAgent) Unmarshal(dAtA []byte) error {
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2904,8 +13491,60 @@ func (m *EventDeactivateAgent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -2927,7 +13566,7 @@ func (m *EventDeactivateAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPolicyRevoked) Unmarshal(dAtA []byte) error {
+func (m *EventPaymentStreamMissed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2950,15 +13589,15 @@ func (m *EventPolicyRevoked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPolicyRevoked: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPaymentStreamMissed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPolicyRevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPaymentStreamMissed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2986,9 +13625,47 @@ func (m *EventPolicyRevoked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Periods", wireType)
+			}
+			m.Periods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Periods |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
@@ -3041,7 +13718,7 @@ func (m *EventPolicyRevoked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventPolicyUnrevoked) Unmarshal(dAtA []byte) error {
+func (m *EventClosePaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3064,15 +13741,48 @@ func (m *EventPolicyUnrevoked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventPolicyUnrevoked: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventClosePaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventPolicyUnrevoked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventClosePaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3100,7 +13810,7 @@ func (m *EventPolicyUnrevoked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3123,7 +13833,7 @@ func (m *EventPolicyUnrevoked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateAgentRecipientPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3146,10 +13856,10 @@ func (m *EventUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateAgentPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateAgentRecipientPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateAgentPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateAgentRecipientPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3185,10 +13895,10 @@ func (m *EventUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			m.ActivationHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3198,11 +13908,25 @@ func (m *EventUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ActivationHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -3224,7 +13948,7 @@ func (m *EventUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttestedTransfer) Unmarshal(dAtA []byte) error {
+func (m *ActionLogEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3247,10 +13971,10 @@ func (m *EventAttestedTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttestedTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionLogEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttestedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3306,9 +14030,9 @@ func (m *EventAttestedTransfer) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3318,27 +14042,82 @@ func (m *EventAttestedTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PayloadHash = append(m.PayloadHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.PayloadHash == nil {
+				m.PayloadHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3365,11 +14144,11 @@ func (m *EventAttestedTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
@@ -3401,6 +14180,38 @@ func (m *EventAttestedTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAgent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAgent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -3422,7 +14233,7 @@ func (m *EventAttestedTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventFundAgentEscrow) Unmarshal(dAtA []byte) error {
+func (m *ActionLogCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3445,10 +14256,10 @@ func (m *EventFundAgentEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventFundAgentEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionLogCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventFundAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionLogCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3484,10 +14295,29 @@ func (m *EventFundAgentEscrow) Unmarshal(dAtA []byte) error {
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3497,29 +14327,31 @@ func (m *EventFundAgentEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funder = string(dAtA[iNdEx:postIndex])
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrunedBefore", wireType)
 			}
-			var msglen int
+			m.PrunedBefore = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3529,26 +14361,11 @@ func (m *EventFundAgentEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PrunedBefore |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -3570,7 +14387,7 @@ func (m *EventFundAgentEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
+func (m *ActionLogNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3593,10 +14410,10 @@ func (m *EventWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawAgentEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionLogNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionLogNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3632,10 +14449,10 @@ func (m *EventWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
 			}
-			var stringLen uint64
+			m.Level = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3645,29 +14462,35 @@ func (m *EventWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Level |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3677,24 +14500,24 @@ func (m *EventWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3718,7 +14541,7 @@ func (m *EventWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
+func (m *ActionLogProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3741,17 +14564,55 @@ func (m *EventUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateAgentSpendPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActionLogProof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateAgentSpendPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActionLogProof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAgent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3761,29 +14622,31 @@ func (m *EventUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
+			m.LeafHash = append(m.LeafHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.LeafHash == nil {
+				m.LeafHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3793,29 +14656,29 @@ func (m *EventUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendDenom = string(dAtA[iNdEx:postIndex])
+			m.Siblings = append(m.Siblings, make([]byte, postIndex-iNdEx))
+			copy(m.Siblings[len(m.Siblings)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Peaks", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3825,31 +14688,29 @@ func (m *EventUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthAgent
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAgent
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpendLimitPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Peaks = append(m.Peaks, make([]byte, postIndex-iNdEx))
+			copy(m.Peaks[len(m.Peaks)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowBlocks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PeakIndex", wireType)
 			}
-			m.SpendWindowBlocks = 0
+			m.PeakIndex = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3859,7 +14720,7 @@ func (m *EventUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpendWindowBlocks |= uint64(b&0x7F) << shift
+				m.PeakIndex |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3885,7 +14746,7 @@ func (m *EventUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ActionLogEntry) Unmarshal(dAtA []byte) error {
+func (m *EventPruneActionLog) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3908,10 +14769,10 @@ func (m *ActionLogEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ActionLogEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventPruneActionLog: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ActionLogEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventPruneActionLog: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3948,9 +14809,9 @@ func (m *ActionLogEntry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSeq", wireType)
 			}
-			m.Seq = 0
+			m.FromSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -3960,103 +14821,16 @@ func (m *ActionLogEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				m.FromSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayloadHash", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PayloadHash = append(m.PayloadHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.PayloadHash == nil {
-				m.PayloadHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
-			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAgent
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ToSeq", wireType)
 			}
-			var msglen int
+			m.ToSeq = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAgent
@@ -4066,25 +14840,11 @@ func (m *ActionLogEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ToSeq |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAgent
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAgent
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAgent(dAtA[iNdEx:])
@@ -2,13 +2,29 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
 )
 
+func atHeight(h uint64) types.SpendClock {
+	return types.SpendClock{Height: h}
+}
+
+func atTime(t time.Time) types.SpendClock {
+	return types.SpendClock{Time: t}
+}
+
+// validBudgetState validates b's window bookkeeping through an agent that
+// carries it as a secondary budget.
+func validBudgetState(b types.SpendBudget) error {
+	return types.Agent{SpendBudgets: []types.SpendBudget{b}}.ValidateSpendState()
+}
+
 // TestAgentSpendWindow mirrors the eIBC on-demand LP bucket tests: the window
 // is an absolute-aligned tumbling bucket that fully resets on rollover.
 func TestAgentSpendWindow(t *testing.T) {
@@ -19,29 +35,181 @@ func TestAgentSpendWindow(t *testing.T) {
 	}
 
 	// nil window-spent decodes as zero
-	require.True(t, a.SpendAllows(15, math.NewInt(100)))
-	require.False(t, a.SpendAllows(15, math.NewInt(101)))
+	require.True(t, a.SpendAllows(atHeight(15), math.NewInt(100)))
+	require.False(t, a.SpendAllows(atHeight(15), math.NewInt(101)))
 
-	a.RecordSpend(15, math.NewInt(60))
+	a.RecordSpend(atHeight(15), math.NewInt(60))
 	require.Equal(t, uint64(10), a.SpendWindowStartHeight)
-	require.True(t, a.SpendAllows(19, math.NewInt(40)))
-	require.False(t, a.SpendAllows(19, math.NewInt(41)))
+	require.True(t, a.SpendAllows(atHeight(19), math.NewInt(40)))
+	require.False(t, a.SpendAllows(atHeight(19), math.NewInt(41)))
 
 	// rollover: capacity resets even though the prior window was exhausted
-	a.RecordSpend(19, math.NewInt(40))
-	require.False(t, a.SpendAllows(19, math.NewInt(1)))
-	require.True(t, a.SpendAllows(20, math.NewInt(100)))
+	a.RecordSpend(atHeight(19), math.NewInt(40))
+	require.False(t, a.SpendAllows(atHeight(19), math.NewInt(1)))
+	require.True(t, a.SpendAllows(atHeight(20), math.NewInt(100)))
 
-	a.RecordSpend(20, math.NewInt(100))
+	a.RecordSpend(atHeight(20), math.NewInt(100))
 	require.Equal(t, uint64(20), a.SpendWindowStartHeight)
 	require.Equal(t, math.NewInt(100), a.SpendWindowSpent)
-	require.False(t, a.SpendAllows(29, math.NewInt(1)))
-	require.True(t, a.SpendAllows(30, math.NewInt(100)))
+	require.False(t, a.SpendAllows(atHeight(29), math.NewInt(1)))
+	require.True(t, a.SpendAllows(atHeight(30), math.NewInt(100)))
 }
 
 func TestAgentSpendDisabled(t *testing.T) {
 	a := types.Agent{}
 	require.False(t, a.SpendEnabled())
-	require.Equal(t, math.ZeroInt(), a.RemainingWindowBudget(100))
-	require.False(t, a.SpendAllows(100, math.NewInt(1)))
+	require.Equal(t, math.ZeroInt(), a.RemainingWindowBudget(atHeight(100)))
+	require.False(t, a.SpendAllows(atHeight(100), math.NewInt(1)))
+}
+
+// TestAgentMultiDenomBudgets checks that each denom is metered against its own
+// budget and window, independently of the primary spend denom.
+func TestAgentMultiDenomBudgets(t *testing.T) {
+	a := types.Agent{
+		SpendDenom:          "adym",
+		SpendLimitPerWindow: math.NewInt(100),
+		SpendWindowBlocks:   10,
+		SpendBudgets: []types.SpendBudget{
+			{Denom: "uusdc", LimitPerWindow: math.NewInt(50), WindowBlocks: 5},
+		},
+	}
+	require.NoError(t, a.ValidateSpendState())
+
+	_, ok := a.SpendBudgetFor("ibc/unknown")
+	require.False(t, ok)
+
+	a.RecordDenomSpend("uusdc", atHeight(6), math.NewInt(50))
+	usdc, ok := a.SpendBudgetFor("uusdc")
+	require.True(t, ok)
+	require.False(t, usdc.Allows(atHeight(9), math.NewInt(1)))
+	require.True(t, usdc.Allows(atHeight(10), math.NewInt(50)))
+
+	// the primary budget is untouched by other denoms
+	dym, ok := a.SpendBudgetFor("adym")
+	require.True(t, ok)
+	require.True(t, dym.Allows(atHeight(9), math.NewInt(100)))
+
+	a.RecordDenomSpend("adym", atHeight(9), math.NewInt(30))
+	require.Equal(t, math.NewInt(30), a.SpendWindowSpent)
+	require.Len(t, a.AllSpendBudgets(), 2)
+
+	a.ResetSpendWindows()
+	usdc, _ = a.SpendBudgetFor("uusdc")
+	require.True(t, usdc.Allows(atHeight(9), math.NewInt(50)))
+	require.True(t, a.SpendAllows(atHeight(9), math.NewInt(100)))
+}
+
+// TestSpendBudgetTimeWindow checks that a time window resets on aligned
+// boundaries of block time regardless of how many blocks passed.
+func TestSpendBudgetTimeWindow(t *testing.T) {
+	b := types.SpendBudget{
+		Denom:          "uusdc",
+		LimitPerWindow: math.NewInt(100),
+		WindowMode:     types.SpendWindowMode_SPEND_WINDOW_MODE_TIME,
+		WindowDuration: 24 * time.Hour,
+	}
+	morning := time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)
+
+	b.RecordSpend(types.SpendClock{Height: 7, Time: morning}, math.NewInt(70))
+	require.Equal(t, time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), b.WindowStartTime)
+	require.Zero(t, b.WindowStartHeight)
+	require.NoError(t, validBudgetState(b))
+
+	// the block height is irrelevant: only block time moves the window
+	late := morning.Add(14*time.Hour + 59*time.Minute)
+	require.Equal(t, math.NewInt(30), b.Remaining(types.SpendClock{Height: 1_000_000, Time: late}))
+	require.False(t, b.Allows(atTime(late), math.NewInt(31)))
+
+	// UTC midnight starts a fresh window
+	next := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	require.True(t, b.Allows(atTime(next), math.NewInt(100)))
+	b.RecordSpend(atTime(next), math.NewInt(10))
+	require.Equal(t, next, b.WindowStartTime)
+	require.Equal(t, math.NewInt(10), b.WindowSpent)
+}
+
+// TestSpendBudgetRollingWindow checks that a rolling window caps the sum over
+// the trailing duration and that spends age out slot by slot, never early.
+func TestSpendBudgetRollingWindow(t *testing.T) {
+	b := types.SpendBudget{
+		Denom:          "uusdc",
+		LimitPerWindow: math.NewInt(100),
+		WindowMode:     types.SpendWindowMode_SPEND_WINDOW_MODE_ROLLING,
+		WindowDuration: 24 * time.Hour, // one-hour slots
+	}
+	t0 := time.Date(2024, 5, 1, 10, 30, 0, 0, time.UTC)
+
+	b.RecordSpend(atTime(t0), math.NewInt(60))
+	b.RecordSpend(atTime(t0.Add(10*time.Minute)), math.NewInt(10)) // same slot
+	b.RecordSpend(atTime(t0.Add(6*time.Hour)), math.NewInt(30))
+	require.Len(t, b.RecentSlots, 2)
+	require.NoError(t, validBudgetState(b))
+
+	// no fixed reset: a day boundary does not free any budget
+	midnight := time.Date(2024, 5, 2, 0, 0, 0, 0, time.UTC)
+	require.True(t, b.Remaining(atTime(midnight)).IsZero())
+
+	// the first spends count for at least 24h after they happened
+	require.True(t, b.Remaining(atTime(t0.Add(10*time.Minute+24*time.Hour))).IsZero())
+	// and are dropped once their whole slot has aged out
+	require.Equal(t, math.NewInt(70), b.Remaining(atTime(time.Date(2024, 5, 2, 11, 0, 0, 0, time.UTC))))
+
+	b.RecordSpend(atTime(time.Date(2024, 5, 2, 11, 0, 0, 0, time.UTC)), math.NewInt(70))
+	require.Len(t, b.RecentSlots, 2, "expired slot pruned on record")
+	require.True(t, b.Remaining(atTime(time.Date(2024, 5, 2, 11, 0, 0, 0, time.UTC))).IsZero())
+	require.NoError(t, validBudgetState(b))
+}
+
+func TestValidateSpendPolicyWindowModes(t *testing.T) {
+	timeBudget := func(mode types.SpendWindowMode, d time.Duration) types.SpendBudget {
+		return types.SpendBudget{Denom: "uusdc", LimitPerWindow: math.NewInt(1), WindowMode: mode, WindowDuration: d}
+	}
+	require.NoError(t, types.ValidateSpendPolicy(timeBudget(types.SpendWindowMode_SPEND_WINDOW_MODE_TIME, 24*time.Hour)))
+	require.NoError(t, types.ValidateSpendPolicy(timeBudget(types.SpendWindowMode_SPEND_WINDOW_MODE_ROLLING, 6*time.Hour)))
+	require.Error(t, types.ValidateSpendPolicy(timeBudget(types.SpendWindowMode_SPEND_WINDOW_MODE_TIME, 0)))
+	require.Error(t, types.ValidateSpendPolicy(timeBudget(types.SpendWindowMode_SPEND_WINDOW_MODE_ROLLING, time.Second)))
+	require.Error(t, types.ValidateSpendPolicy(timeBudget(types.SpendWindowMode(9), time.Hour)))
+
+	withBlocks := timeBudget(types.SpendWindowMode_SPEND_WINDOW_MODE_TIME, time.Hour)
+	withBlocks.WindowBlocks = 10
+	require.Error(t, types.ValidateSpendPolicy(withBlocks), "block length on a time window")
+
+	withDuration := types.SpendBudget{Denom: "uusdc", LimitPerWindow: math.NewInt(1), WindowBlocks: 10, WindowDuration: time.Hour}
+	require.Error(t, types.ValidateSpendPolicy(withDuration), "duration on a block window")
+
+	require.Error(t, types.ValidateSpendPolicy(types.SpendBudget{WindowMode: types.SpendWindowMode_SPEND_WINDOW_MODE_TIME}), "mode without denom")
+}
+
+func TestValidateSpendBudgets(t *testing.T) {
+	budget := func(denom string) types.SpendBudget {
+		return types.SpendBudget{Denom: denom, LimitPerWindow: math.NewInt(1), WindowBlocks: 1}
+	}
+	require.NoError(t, types.ValidateSpendBudgets("adym", []types.SpendBudget{budget("uusdc"), budget("uatom")}))
+	require.Error(t, types.ValidateSpendBudgets("adym", []types.SpendBudget{budget("adym")}))
+	require.Error(t, types.ValidateSpendBudgets("adym", []types.SpendBudget{budget("uusdc"), budget("uusdc")}))
+	require.Error(t, types.ValidateSpendBudgets("adym", []types.SpendBudget{budget("")}))
+	require.Error(t, types.ValidateSpendBudgets("adym", []types.SpendBudget{{Denom: "uusdc", LimitPerWindow: math.NewInt(1)}}))
+}
+
+func TestRecipientPolicy(t *testing.T) {
+	const addr = sampleOwner
+	require.True(t, types.RecipientPolicy{}.IsEmpty())
+	require.NoError(t, types.RecipientPolicy{Allowlist: []string{addr}}.Validate())
+	require.Error(t, types.RecipientPolicy{Allowlist: []string{addr, addr}}.Validate())
+	require.Error(t, types.RecipientPolicy{Allowlist: []string{"not-an-address"}}.Validate())
+
+	// cap and window go together
+	capped := types.RecipientPolicy{PerRecipientCap: sdk.NewCoins(sdk.NewInt64Coin("adym", 10))}
+	require.Error(t, capped.Validate())
+	capped.CapWindowBlocks = 10
+	require.NoError(t, capped.Validate())
+	require.Error(t, types.RecipientPolicy{CapWindowBlocks: 10}.Validate())
+
+	var u types.RecipientUsage
+	require.True(t, u.CapAllows(capped, 15, sdk.NewInt64Coin("adym", 10)))
+	require.True(t, u.CapAllows(capped, 15, sdk.NewInt64Coin("uusdc", 1000)), "uncapped denom")
+	u.RecordReceived(capped, 15, sdk.NewInt64Coin("adym", 7))
+	require.False(t, u.CapAllows(capped, 19, sdk.NewInt64Coin("adym", 4)))
+	require.True(t, u.CapAllows(capped, 20, sdk.NewInt64Coin("adym", 10)))
+	require.True(t, u.CurrentReceived(capped, 20).IsZero())
 }
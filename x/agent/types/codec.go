@@ -11,11 +11,22 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRegisterAgent{}, "agent/RegisterAgent", nil)
 	cdc.RegisterConcrete(&MsgDeactivateAgent{}, "agent/DeactivateAgent", nil)
 	cdc.RegisterConcrete(&MsgUpdateAgentPolicy{}, "agent/UpdateAgentPolicy", nil)
+	cdc.RegisterConcrete(&MsgUpdateAgentPolicySet{}, "agent/UpdateAgentPolicySet", nil)
 	cdc.RegisterConcrete(&MsgSubmitAttestedAction{}, "agent/SubmitAttestedAction", nil)
 	cdc.RegisterConcrete(&MsgFundAgentEscrow{}, "agent/FundAgentEscrow", nil)
 	cdc.RegisterConcrete(&MsgWithdrawAgentEscrow{}, "agent/WithdrawAgentEscrow", nil)
+	cdc.RegisterConcrete(&MsgCancelAgentWithdrawal{}, "agent/CancelAgentWithdrawal", nil)
+	cdc.RegisterConcrete(&MsgUpdateAgentSecurityPolicy{}, "agent/UpdateAgentSecurityPolicy", nil)
+	cdc.RegisterConcrete(&MsgFreezeAgent{}, "agent/FreezeAgent", nil)
+	cdc.RegisterConcrete(&MsgUnfreezeAgent{}, "agent/UnfreezeAgent", nil)
+	cdc.RegisterConcrete(&MsgCreatePaymentStream{}, "agent/CreatePaymentStream", nil)
+	cdc.RegisterConcrete(&MsgCancelPaymentStream{}, "agent/CancelPaymentStream", nil)
 	cdc.RegisterConcrete(&MsgUpdateAgentSpendPolicy{}, "agent/UpdateAgentSpendPolicy", nil)
+	cdc.RegisterConcrete(&MsgUpdateAgentRecipientPolicy{}, "agent/UpdateAgentRecipientPolicy", nil)
 	cdc.RegisterConcrete(&MsgSubmitAttestedTransfer{}, "agent/SubmitAttestedTransfer", nil)
+	cdc.RegisterConcrete(&MsgCreateSubAgent{}, "agent/CreateSubAgent", nil)
+	cdc.RegisterConcrete(&MsgRegisterPolicy{}, "agent/RegisterPolicy", nil)
+	cdc.RegisterConcrete(&MsgSupersedePolicy{}, "agent/SupersedePolicy", nil)
 	cdc.RegisterConcrete(&MsgRevokePolicy{}, "agent/RevokePolicy", nil)
 	cdc.RegisterConcrete(&MsgUnrevokePolicy{}, "agent/UnrevokePolicy", nil)
 	cdc.RegisterConcrete(&MsgSubmitFeedback{}, "agent/SubmitFeedback", nil)
@@ -27,11 +38,22 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgRegisterAgent{},
 		&MsgDeactivateAgent{},
 		&MsgUpdateAgentPolicy{},
+		&MsgUpdateAgentPolicySet{},
 		&MsgSubmitAttestedAction{},
 		&MsgFundAgentEscrow{},
 		&MsgWithdrawAgentEscrow{},
+		&MsgCancelAgentWithdrawal{},
+		&MsgUpdateAgentSecurityPolicy{},
+		&MsgFreezeAgent{},
+		&MsgUnfreezeAgent{},
+		&MsgCreatePaymentStream{},
+		&MsgCancelPaymentStream{},
 		&MsgUpdateAgentSpendPolicy{},
+		&MsgUpdateAgentRecipientPolicy{},
 		&MsgSubmitAttestedTransfer{},
+		&MsgCreateSubAgent{},
+		&MsgRegisterPolicy{},
+		&MsgSupersedePolicy{},
 		&MsgRevokePolicy{},
 		&MsgUnrevokePolicy{},
 		&MsgSubmitFeedback{},
@@ -18,4 +18,12 @@ var (
 	ErrSpendingDisabled       = errorsmod.Register(ModuleName, 14, "agent spending is disabled")
 	ErrSpendBudgetExceeded    = errorsmod.Register(ModuleName, 15, "spend budget exceeded for the current window")
 	ErrInsufficientEscrow     = errorsmod.Register(ModuleName, 16, "insufficient escrow balance")
+	ErrRecipientNotAllowed    = errorsmod.Register(ModuleName, 17, "recipient not allowed by the agent's recipient policy")
+	ErrRecipientCapExceeded   = errorsmod.Register(ModuleName, 18, "per-recipient cap exceeded for the current window")
+	ErrAgentFrozen            = errorsmod.Register(ModuleName, 19, "agent is frozen")
+	ErrWithdrawalNotFound     = errorsmod.Register(ModuleName, 20, "pending withdrawal not found")
+	ErrPaymentStreamNotFound  = errorsmod.Register(ModuleName, 21, "payment stream not found")
+	ErrInvalidActionLogProof  = errorsmod.Register(ModuleName, 22, "invalid action log proof")
+	ErrQuorumNotMet           = errorsmod.Register(ModuleName, 23, "attestation quorum not met")
+	ErrSubAgentLimit          = errorsmod.Register(ModuleName, 24, "sub-agent limit exceeded")
 )
@@ -3,7 +3,10 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dymnstypes "github.com/dymensionxyz/dymension/v3/x/dymns/types"
 )
 
 type BankKeeper interface {
@@ -12,3 +15,17 @@ type BankKeeper interface {
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 }
+
+type DymNSKeeper interface {
+	ReverseResolveDymNameAddress(ctx sdk.Context, inputAddress, workingChainId string) (dymnstypes.ReverseResolvedDymNameAddresses, error)
+}
+
+// StakingKeeper and LockupKeeper supply a feedback client's stake weight.
+type StakingKeeper interface {
+	BondDenom(ctx context.Context) (string, error)
+	GetDelegatorBonded(ctx context.Context, delegator sdk.AccAddress) (math.Int, error)
+}
+
+type LockupKeeper interface {
+	GetAccountLockedCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
@@ -43,6 +45,16 @@ type Feedback struct {
 	EvidenceSeq uint64    `protobuf:"varint,6,opt,name=evidence_seq,json=evidenceSeq,proto3" json:"evidence_seq,omitempty"`
 	Height      int64     `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
 	Time        time.Time `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+	// weight is the client's bonded plus locked stake in the bond denom when
+	// the feedback was submitted; resubmitting refreshes it. It weights the
+	// feedback in the weighted reputation score, capped at the client's stake
+	// at query time, so fresh accounts without stake cannot move it and stake
+	// moved away stops counting.
+	Weight cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=weight,proto3,customtype=cosmossdk.io/math.Int" json:"weight"`
+	// verified is set when the keeper checked that evidence_seq is an
+	// interaction with the client: an action it submitted or a transfer that
+	// paid it.
+	Verified bool `protobuf:"varint,10,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
 func (m *Feedback) Reset()         { *m = Feedback{} }
@@ -134,6 +146,13 @@ func (m *Feedback) GetTime() time.Time {
 	return time.Time{}
 }
 
+func (m *Feedback) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 // Reputation is the per-agent incremental aggregate. average = score_sum/count.
 type Reputation struct {
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
@@ -141,6 +160,10 @@ type Reputation struct {
 	Count uint64 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 	// score_sum is the sum of current scores.
 	ScoreSum uint64 `protobuf:"varint,3,opt,name=score_sum,json=scoreSum,proto3" json:"score_sum,omitempty"`
+	// verified_count and verified_score_sum cover only verified feedback, and
+	// are included in count and score_sum.
+	VerifiedCount    uint64 `protobuf:"varint,4,opt,name=verified_count,json=verifiedCount,proto3" json:"verified_count,omitempty"`
+	VerifiedScoreSum uint64 `protobuf:"varint,5,opt,name=verified_score_sum,json=verifiedScoreSum,proto3" json:"verified_score_sum,omitempty"`
 }
 
 func (m *Reputation) Reset()         { *m = Reputation{} }
@@ -197,11 +220,27 @@ func (m *Reputation) GetScoreSum() uint64 {
 	return 0
 }
 
+func (m *Reputation) GetVerifiedCount() uint64 {
+	if m != nil {
+		return m.VerifiedCount
+	}
+	return 0
+}
+
+func (m *Reputation) GetVerifiedScoreSum() uint64 {
+	if m != nil {
+		return m.VerifiedScoreSum
+	}
+	return 0
+}
+
 // EventSubmitFeedback is emitted when feedback is submitted or overwritten.
 type EventSubmitFeedback struct {
-	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Client  string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
-	Score   uint32 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	AgentId  string                `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Client   string                `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
+	Score    uint32                `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	Weight   cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=weight,proto3,customtype=cosmossdk.io/math.Int" json:"weight"`
+	Verified bool                  `protobuf:"varint,5,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
 func (m *EventSubmitFeedback) Reset()         { *m = EventSubmitFeedback{} }
@@ -258,6 +297,13 @@ func (m *EventSubmitFeedback) GetScore() uint32 {
 	return 0
 }
 
+func (m *EventSubmitFeedback) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
 // EventRevokeFeedback is emitted when feedback is revoked.
 type EventRevokeFeedback struct {
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
@@ -323,32 +369,40 @@ func init() {
 }
 
 var fileDescriptor_5b1abf39fc5e4db2 = []byte{
-	// 399 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xbf, 0x8e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x63, 0x2e, 0xed, 0xe5, 0x7c, 0xb0, 0x98, 0x0a, 0x99, 0x82, 0xd2, 0x90, 0x29, 0x12,
-	0x52, 0x2c, 0x72, 0x0b, 0xf3, 0x49, 0x20, 0x90, 0x98, 0x5c, 0xa6, 0x1b, 0xa8, 0xf2, 0xe7, 0x77,
-	0xae, 0xd5, 0x26, 0x4e, 0x1b, 0x27, 0x6a, 0x79, 0x8a, 0x3e, 0x56, 0xc7, 0x8e, 0x4c, 0x80, 0xda,
-	0x17, 0xe0, 0x11, 0x50, 0x9d, 0xb4, 0x62, 0x81, 0x85, 0xdb, 0xfc, 0xf9, 0xfa, 0xeb, 0x9f, 0xf5,
-	0xb1, 0x8c, 0x5f, 0x67, 0xeb, 0x1c, 0x8a, 0x4a, 0xaa, 0x62, 0xb5, 0xfe, 0xca, 0xce, 0xc0, 0x62,
-	0x01, 0x85, 0x66, 0xf7, 0x00, 0x59, 0x12, 0xa7, 0xb3, 0xb0, 0x5c, 0x2a, 0xad, 0xc8, 0xcb, 0x3f,
-	0xcb, 0xe1, 0x19, 0x42, 0x53, 0x1e, 0x0e, 0x84, 0x12, 0xca, 0x14, 0xd9, 0x71, 0xd5, 0x9e, 0x19,
-	0x8e, 0x84, 0x52, 0x62, 0x0e, 0xcc, 0x50, 0x52, 0xdf, 0x33, 0x2d, 0x73, 0xa8, 0x74, 0x9c, 0x97,
-	0x6d, 0xc1, 0xff, 0x85, 0xb0, 0xf3, 0xbe, 0xbb, 0x87, 0x3c, 0xc7, 0x8e, 0x19, 0x36, 0x91, 0x19,
-	0x45, 0x1e, 0x0a, 0xae, 0xf8, 0xa5, 0xe1, 0x8f, 0x19, 0x79, 0x86, 0xfb, 0xe9, 0x5c, 0x42, 0xa1,
-	0xe9, 0x23, 0xb3, 0xd1, 0x11, 0x19, 0xe0, 0x5e, 0x95, 0xaa, 0x25, 0xd0, 0x0b, 0x0f, 0x05, 0x4f,
-	0x78, 0x0b, 0x84, 0x60, 0x5b, 0xc7, 0xe2, 0x0d, 0xb5, 0x4d, 0xd7, 0xac, 0xbb, 0x2c, 0xa2, 0xbd,
-	0x73, 0x16, 0x91, 0x57, 0xf8, 0x31, 0x34, 0x32, 0x83, 0x22, 0x85, 0x49, 0x05, 0x0b, 0xda, 0xf7,
-	0x50, 0x60, 0xf3, 0xeb, 0x53, 0x36, 0x86, 0xc5, 0xf1, 0xe2, 0x29, 0x48, 0x31, 0xd5, 0xf4, 0xd2,
-	0x43, 0xc1, 0x05, 0xef, 0x88, 0xbc, 0xc5, 0xf6, 0xd1, 0x85, 0x3a, 0x1e, 0x0a, 0xae, 0xa3, 0x61,
-	0xd8, 0x8a, 0x86, 0x27, 0xd1, 0xf0, 0xf3, 0x49, 0xf4, 0xd6, 0xd9, 0x7e, 0x1f, 0x59, 0x9b, 0x1f,
-	0x23, 0xc4, 0xcd, 0x09, 0xff, 0x0e, 0x63, 0x0e, 0x65, 0xad, 0x63, 0x2d, 0x55, 0xf1, 0x2f, 0xe7,
-	0x01, 0xee, 0xa5, 0xaa, 0xee, 0x94, 0x6d, 0xde, 0x02, 0x79, 0x81, 0xaf, 0x8c, 0xe4, 0xa4, 0xaa,
-	0x73, 0x63, 0x6d, 0x73, 0xc7, 0x04, 0xe3, 0x3a, 0xf7, 0xbf, 0xe0, 0xa7, 0xef, 0x1a, 0x28, 0xf4,
-	0xb8, 0x4e, 0x72, 0xa9, 0x1f, 0xfc, 0x61, 0xfd, 0x0f, 0xdd, 0x7c, 0x0e, 0x8d, 0x9a, 0xc1, 0x7f,
-	0xcc, 0xbf, 0xfd, 0xb4, 0xdd, 0xbb, 0x68, 0xb7, 0x77, 0xd1, 0xcf, 0xbd, 0x8b, 0x36, 0x07, 0xd7,
-	0xda, 0x1d, 0x5c, 0xeb, 0xdb, 0xc1, 0xb5, 0xee, 0x22, 0x21, 0xf5, 0xb4, 0x4e, 0xc2, 0x54, 0xe5,
-	0xec, 0x2f, 0xff, 0xb3, 0xb9, 0x61, 0xab, 0xee, 0x93, 0xea, 0x75, 0x09, 0x55, 0xd2, 0x37, 0xef,
-	0x7e, 0xf3, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x77, 0x57, 0xc4, 0xc9, 0xd1, 0x02, 0x00, 0x00,
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0xcd, 0xb6, 0x4e, 0xea, 0x6c, 0xbf, 0x7e, 0x42, 0x4b, 0x40, 0x6e, 0x40, 0x8e, 0x89, 0x84,
+	0x64, 0xa9, 0x60, 0x8b, 0xf4, 0xc2, 0x39, 0x15, 0x88, 0x48, 0x9c, 0x36, 0x9c, 0xb8, 0x44, 0x8e,
+	0x3d, 0x71, 0x56, 0xa9, 0xbd, 0x69, 0xbc, 0x36, 0x0d, 0xbf, 0xa2, 0xff, 0x84, 0x0b, 0x77, 0xae,
+	0x3d, 0x16, 0x4e, 0x88, 0x43, 0x41, 0xc9, 0x1f, 0x41, 0xde, 0x5d, 0xbb, 0x08, 0x09, 0x2e, 0xf4,
+	0xb6, 0x6f, 0xde, 0x9b, 0x99, 0x7d, 0x4f, 0xbb, 0xf8, 0x28, 0x5a, 0x27, 0x90, 0x66, 0x8c, 0xa7,
+	0xe7, 0xeb, 0xf7, 0x7e, 0x0d, 0xfc, 0x20, 0x86, 0x54, 0xf8, 0x33, 0x80, 0x68, 0x1a, 0x84, 0x0b,
+	0x6f, 0xb9, 0xe2, 0x82, 0x93, 0x87, 0xbf, 0x8a, 0xbd, 0x1a, 0x78, 0x52, 0xdc, 0xed, 0xc4, 0x3c,
+	0xe6, 0x52, 0xe8, 0x97, 0x27, 0xd5, 0xd3, 0x3d, 0x0c, 0x79, 0x96, 0xf0, 0x6c, 0xa2, 0x08, 0x05,
+	0x34, 0xd5, 0x8b, 0x39, 0x8f, 0x4f, 0xc1, 0x97, 0x68, 0x9a, 0xcf, 0x7c, 0xc1, 0x12, 0xc8, 0x44,
+	0x90, 0x2c, 0x95, 0xa0, 0xff, 0x79, 0x07, 0x9b, 0x2f, 0xf5, 0x15, 0xc8, 0x21, 0x36, 0xe5, 0x9e,
+	0x09, 0x8b, 0x2c, 0xe4, 0x20, 0xb7, 0x4d, 0xf7, 0x24, 0x1e, 0x45, 0xe4, 0x3e, 0x6e, 0x85, 0xa7,
+	0x0c, 0x52, 0x61, 0xed, 0x48, 0x42, 0x23, 0xd2, 0xc1, 0xcd, 0x2c, 0xe4, 0x2b, 0xb0, 0x76, 0x1d,
+	0xe4, 0x1e, 0x50, 0x05, 0x08, 0xc1, 0x86, 0x08, 0xe2, 0x67, 0x96, 0x21, 0xb5, 0xf2, 0xac, 0x6b,
+	0x03, 0xab, 0x59, 0xd7, 0x06, 0xe4, 0x11, 0xfe, 0x0f, 0x0a, 0x16, 0x41, 0x1a, 0xc2, 0x24, 0x83,
+	0x33, 0xab, 0xe5, 0x20, 0xd7, 0xa0, 0xfb, 0x55, 0x6d, 0x0c, 0x67, 0xe5, 0xe2, 0x39, 0xb0, 0x78,
+	0x2e, 0xac, 0x3d, 0x07, 0xb9, 0xbb, 0x54, 0x23, 0xf2, 0x1c, 0x1b, 0xa5, 0x17, 0xcb, 0x74, 0x90,
+	0xbb, 0x3f, 0xe8, 0x7a, 0xca, 0xa8, 0x57, 0x19, 0xf5, 0xde, 0x54, 0x46, 0x87, 0xe6, 0xe5, 0x75,
+	0xaf, 0x71, 0xf1, 0xbd, 0x87, 0xa8, 0xec, 0x20, 0x27, 0xb8, 0xf5, 0x4e, 0x4d, 0x6c, 0x97, 0x57,
+	0x19, 0x1e, 0x95, 0xfc, 0xb7, 0xeb, 0xde, 0x3d, 0x95, 0x5c, 0x16, 0x2d, 0x3c, 0xc6, 0xfd, 0x24,
+	0x10, 0x73, 0x6f, 0x94, 0x8a, 0x2f, 0x1f, 0x9f, 0x62, 0x1d, 0xe9, 0x28, 0x15, 0x54, 0xb7, 0x92,
+	0x2e, 0x36, 0x0b, 0x58, 0xb1, 0x19, 0x83, 0xc8, 0xc2, 0x0e, 0x72, 0x4d, 0x5a, 0xe3, 0xfe, 0x07,
+	0x84, 0x31, 0x85, 0x65, 0x2e, 0x02, 0xc1, 0x78, 0xfa, 0xb7, 0x54, 0x3b, 0xb8, 0x19, 0xf2, 0x5c,
+	0x87, 0x6a, 0x50, 0x05, 0xc8, 0x03, 0xdc, 0x96, 0x31, 0x4e, 0xb2, 0x3c, 0x91, 0xb9, 0x1a, 0xd4,
+	0x94, 0x85, 0x71, 0x9e, 0x90, 0xc7, 0xf8, 0xff, 0x6a, 0xd1, 0x44, 0xf5, 0x1a, 0x52, 0x71, 0x50,
+	0x55, 0x4f, 0xe4, 0x8c, 0x27, 0x98, 0xd4, 0xb2, 0x9b, 0x61, 0x4d, 0x29, 0xbd, 0x53, 0x31, 0x63,
+	0x3d, 0xb4, 0xff, 0x09, 0xe1, 0xbb, 0x2f, 0x0a, 0x48, 0xc5, 0x38, 0x9f, 0x26, 0x4c, 0xdc, 0xfe,
+	0x83, 0xb8, 0xc9, 0xdc, 0xb8, 0x9d, 0xcc, 0x9b, 0xbf, 0x65, 0xfe, 0x4a, 0x1b, 0xa0, 0x50, 0xf0,
+	0x05, 0xfc, 0x83, 0x81, 0xe1, 0xeb, 0xcb, 0x8d, 0x8d, 0xae, 0x36, 0x36, 0xfa, 0xb1, 0xb1, 0xd1,
+	0xc5, 0xd6, 0x6e, 0x5c, 0x6d, 0xed, 0xc6, 0xd7, 0xad, 0xdd, 0x78, 0x3b, 0x88, 0x99, 0x98, 0xe7,
+	0x53, 0x2f, 0xe4, 0x89, 0xff, 0x87, 0x3f, 0x5d, 0x1c, 0xfb, 0xe7, 0xfa, 0x63, 0x8b, 0xf5, 0x12,
+	0xb2, 0x69, 0x4b, 0x3e, 0xc8, 0xe3, 0x9f, 0x03, 0x00, 0x8a, 0x4e, 0xd4, 0xfe, 0x05, 0x04, 0x00,
+	0x00,
 }
 
 func (m *Feedback) Marshal() (dAtA []byte, err error) {
@@ -371,6 +425,26 @@ func (m *Feedback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeedback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
 	if err1 != nil {
 		return 0, err1
@@ -445,6 +519,16 @@ func (m *Reputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VerifiedScoreSum != 0 {
+		i = encodeVarintFeedback(dAtA, i, uint64(m.VerifiedScoreSum))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.VerifiedCount != 0 {
+		i = encodeVarintFeedback(dAtA, i, uint64(m.VerifiedCount))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.ScoreSum != 0 {
 		i = encodeVarintFeedback(dAtA, i, uint64(m.ScoreSum))
 		i--
@@ -485,6 +569,26 @@ func (m *EventSubmitFeedback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeedback(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.Score != 0 {
 		i = encodeVarintFeedback(dAtA, i, uint64(m.Score))
 		i--
@@ -588,6 +692,11 @@ func (m *Feedback) Size() (n int) {
 	}
 	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
 	n += 1 + l + sovFeedback(uint64(l))
+	l = m.Weight.Size()
+	n += 1 + l + sovFeedback(uint64(l))
+	if m.Verified {
+		n += 2
+	}
 	return n
 }
 
@@ -607,6 +716,12 @@ func (m *Reputation) Size() (n int) {
 	if m.ScoreSum != 0 {
 		n += 1 + sovFeedback(uint64(m.ScoreSum))
 	}
+	if m.VerifiedCount != 0 {
+		n += 1 + sovFeedback(uint64(m.VerifiedCount))
+	}
+	if m.VerifiedScoreSum != 0 {
+		n += 1 + sovFeedback(uint64(m.VerifiedScoreSum))
+	}
 	return n
 }
 
@@ -627,6 +742,11 @@ func (m *EventSubmitFeedback) Size() (n int) {
 	if m.Score != 0 {
 		n += 1 + sovFeedback(uint64(m.Score))
 	}
+	l = m.Weight.Size()
+	n += 1 + l + sovFeedback(uint64(l))
+	if m.Verified {
+		n += 2
+	}
 	return n
 }
 
@@ -900,6 +1020,60 @@ func (m *Feedback) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeedback(dAtA[iNdEx:])
@@ -1020,6 +1194,44 @@ func (m *Reputation) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedCount", wireType)
+			}
+			m.VerifiedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedScoreSum", wireType)
+			}
+			m.VerifiedScoreSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedScoreSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeedback(dAtA[iNdEx:])
@@ -1153,6 +1365,60 @@ func (m *EventSubmitFeedback) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedback
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedback
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedback
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeedback(dAtA[iNdEx:])
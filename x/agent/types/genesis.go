@@ -1,6 +1,12 @@
 package types
 
-import "fmt"
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
 
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -20,8 +26,30 @@ func (g GenesisState) Validate() error {
 		if err := a.ValidateSpendState(); err != nil {
 			return fmt.Errorf("agent %s: %w", a.Id, err)
 		}
+		if err := a.ValidateSecurityState(); err != nil {
+			return fmt.Errorf("agent %s: %w", a.Id, err)
+		}
+		if err := a.ValidatePolicySetState(); err != nil {
+			return fmt.Errorf("agent %s: %w", a.Id, err)
+		}
+		if err := a.TotalSpent.Validate(); err != nil {
+			return fmt.Errorf("agent %s: total spent: %w", a.Id, err)
+		}
 		agentIDs[a.Id] = struct{}{}
 	}
+	if err := validateSubAgentTree(g.Agents); err != nil {
+		return err
+	}
+	if err := validateRegisteredPolicies(g.RegisteredPolicies); err != nil {
+		return err
+	}
+	if err := g.validatePolicyRefs(); err != nil {
+		return err
+	}
+
+	if err := g.validateActionLog(); err != nil {
+		return err
+	}
 
 	escrowSeen := make(map[string]struct{}, len(g.Escrows))
 	for _, e := range g.Escrows {
@@ -40,11 +68,76 @@ func (g GenesisState) Validate() error {
 			return fmt.Errorf("empty escrow balance for agent: %s", e.AgentId)
 		}
 	}
+	policySeen := make(map[string]struct{}, len(g.RecipientPolicies))
+	for _, p := range g.RecipientPolicies {
+		if _, ok := agentIDs[p.AgentId]; !ok {
+			return fmt.Errorf("recipient policy for unknown agent: %s", p.AgentId)
+		}
+		if _, dup := policySeen[p.AgentId]; dup {
+			return fmt.Errorf("duplicate recipient policy: %s", p.AgentId)
+		}
+		policySeen[p.AgentId] = struct{}{}
+		if p.Policy.IsEmpty() {
+			return fmt.Errorf("empty recipient policy for agent: %s", p.AgentId)
+		}
+		if err := p.Policy.Validate(); err != nil {
+			return fmt.Errorf("recipient policy for agent %s: %w", p.AgentId, err)
+		}
+	}
+	usageSeen := make(map[string]struct{}, len(g.RecipientUsages))
+	for _, u := range g.RecipientUsages {
+		if _, ok := policySeen[u.AgentId]; !ok {
+			return fmt.Errorf("recipient usage without recipient policy: %s", u.AgentId)
+		}
+		key := u.AgentId + "/" + u.Recipient
+		if _, dup := usageSeen[key]; dup {
+			return fmt.Errorf("duplicate recipient usage: agent %s recipient %s", u.AgentId, u.Recipient)
+		}
+		usageSeen[key] = struct{}{}
+		if err := u.Received.Validate(); err != nil {
+			return fmt.Errorf("recipient usage for agent %s recipient %s: %w", u.AgentId, u.Recipient, err)
+		}
+	}
+	withdrawalSeen := make(map[uint64]struct{}, len(g.PendingWithdrawals))
+	for _, w := range g.PendingWithdrawals {
+		if _, ok := agentIDs[w.AgentId]; !ok {
+			return fmt.Errorf("pending withdrawal for unknown agent: %s", w.AgentId)
+		}
+		if _, dup := withdrawalSeen[w.Id]; dup {
+			return fmt.Errorf("duplicate pending withdrawal: %d", w.Id)
+		}
+		withdrawalSeen[w.Id] = struct{}{}
+		if w.Id >= g.NextWithdrawalId {
+			return fmt.Errorf("pending withdrawal id %d not below next withdrawal id %d", w.Id, g.NextWithdrawalId)
+		}
+		if err := w.Validate(); err != nil {
+			return fmt.Errorf("pending withdrawal %d: %w", w.Id, err)
+		}
+	}
+	streamSeen := make(map[uint64]struct{}, len(g.PaymentStreams))
+	for _, st := range g.PaymentStreams {
+		if _, ok := agentIDs[st.AgentId]; !ok {
+			return fmt.Errorf("payment stream for unknown agent: %s", st.AgentId)
+		}
+		if _, dup := streamSeen[st.Id]; dup {
+			return fmt.Errorf("duplicate payment stream: %d", st.Id)
+		}
+		streamSeen[st.Id] = struct{}{}
+		if st.Id >= g.NextStreamId {
+			return fmt.Errorf("payment stream id %d not below next stream id %d", st.Id, g.NextStreamId)
+		}
+		if err := st.Validate(); err != nil {
+			return fmt.Errorf("payment stream %d: %w", st.Id, err)
+		}
+	}
 	for _, fp := range g.RevokedPolicies {
 		if err := ValidateFingerprint(fp); err != nil {
 			return err
 		}
 	}
+	if err := tee.ValidateConsumedAttestations(g.ConsumedAttestations); err != nil {
+		return err
+	}
 	feedbackSeen := make(map[string]struct{}, len(g.Feedbacks))
 	for _, f := range g.Feedbacks {
 		if f.Score > MaxFeedbackScore {
@@ -58,3 +151,88 @@ func (g GenesisState) Validate() error {
 	}
 	return nil
 }
+
+// validateActionLog checks that action log commitments match their agent's
+// action seq and bag from the imported peaks, and that every entry has a
+// commitment, lies in its unpruned range and matches its leaf.
+func (g GenesisState) validateActionLog() error {
+	agentSeqs := make(map[string]uint64, len(g.Agents))
+	for _, a := range g.Agents {
+		agentSeqs[a.Id] = a.ActionSeq
+	}
+
+	commitments := make(map[string]ActionLogCommitment, len(g.ActionLogCommitments))
+	for _, c := range g.ActionLogCommitments {
+		seq, ok := agentSeqs[c.AgentId]
+		if !ok {
+			return fmt.Errorf("action log commitment for unknown agent: %s", c.AgentId)
+		}
+		if _, dup := commitments[c.AgentId]; dup {
+			return fmt.Errorf("duplicate action log commitment: %s", c.AgentId)
+		}
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("action log commitment for agent %s: %w", c.AgentId, err)
+		}
+		if c.LeafCount != seq {
+			return fmt.Errorf("action log commitment for agent %s: leaf count %d, action seq %d", c.AgentId, c.LeafCount, seq)
+		}
+		commitments[c.AgentId] = c
+	}
+
+	type nodeKey struct {
+		agentID string
+		level   uint32
+		index   uint64
+	}
+	nodes := make(map[nodeKey][]byte, len(g.ActionLogNodes))
+	for _, n := range g.ActionLogNodes {
+		c, ok := commitments[n.AgentId]
+		if !ok {
+			return fmt.Errorf("action log node for agent without commitment: %s", n.AgentId)
+		}
+		key := nodeKey{n.AgentId, n.Level, n.Index}
+		if _, dup := nodes[key]; dup {
+			return fmt.Errorf("duplicate action log node: agent %s level %d index %d", n.AgentId, n.Level, n.Index)
+		}
+		if n.Level >= 64 || n.Index >= c.LeafCount>>n.Level {
+			return fmt.Errorf("action log node out of range: agent %s level %d index %d", n.AgentId, n.Level, n.Index)
+		}
+		if len(n.Hash) != sha256.Size {
+			return fmt.Errorf("action log node hash must be %d bytes: agent %s level %d index %d", sha256.Size, n.AgentId, n.Level, n.Index)
+		}
+		nodes[key] = n.Hash
+	}
+	for _, c := range g.ActionLogCommitments {
+		var peaks [][]byte
+		for _, p := range ActionLogPeaks(c.LeafCount) {
+			hash, ok := nodes[nodeKey{c.AgentId, p.Level, p.Index}]
+			if !ok {
+				return fmt.Errorf("missing action log peak: agent %s level %d index %d", c.AgentId, p.Level, p.Index)
+			}
+			peaks = append(peaks, hash)
+		}
+		if !bytes.Equal(BagActionLogPeaks(peaks), c.Root) {
+			return fmt.Errorf("action log root does not match peaks: agent %s", c.AgentId)
+		}
+	}
+
+	entrySeen := make(map[nodeKey]struct{}, len(g.ActionLog))
+	for _, e := range g.ActionLog {
+		c, ok := commitments[e.AgentId]
+		if !ok {
+			return fmt.Errorf("action log entry for agent without commitment: %s", e.AgentId)
+		}
+		if e.Seq < c.PrunedBefore || c.LeafCount <= e.Seq {
+			return fmt.Errorf("action log entry outside retained range: agent %s seq %d", e.AgentId, e.Seq)
+		}
+		key := nodeKey{agentID: e.AgentId, index: e.Seq}
+		if _, dup := entrySeen[key]; dup {
+			return fmt.Errorf("duplicate action log entry: agent %s seq %d", e.AgentId, e.Seq)
+		}
+		entrySeen[key] = struct{}{}
+		if !bytes.Equal(nodes[key], ActionLogLeafHash(e)) {
+			return fmt.Errorf("action log entry does not match its leaf: agent %s seq %d", e.AgentId, e.Seq)
+		}
+	}
+	return nil
+}
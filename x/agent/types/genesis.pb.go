@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	tee "github.com/dymensionxyz/dymension/v3/x/common/tee"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -31,8 +32,23 @@ type GenesisState struct {
 	RevokedPolicies []string `protobuf:"bytes,4,rep,name=revoked_policies,json=revokedPolicies,proto3" json:"revoked_policies,omitempty"`
 	// feedbacks holds all current feedback records; reputation aggregates are
 	// rebuilt from them on init.
-	Feedbacks []Feedback    `protobuf:"bytes,5,rep,name=feedbacks,proto3" json:"feedbacks"`
-	Escrows   []AgentEscrow `protobuf:"bytes,6,rep,name=escrows,proto3" json:"escrows"`
+	Feedbacks          []Feedback               `protobuf:"bytes,5,rep,name=feedbacks,proto3" json:"feedbacks"`
+	Escrows            []AgentEscrow            `protobuf:"bytes,6,rep,name=escrows,proto3" json:"escrows"`
+	RecipientPolicies  []GenesisRecipientPolicy `protobuf:"bytes,7,rep,name=recipient_policies,json=recipientPolicies,proto3" json:"recipient_policies"`
+	RecipientUsages    []RecipientUsage         `protobuf:"bytes,8,rep,name=recipient_usages,json=recipientUsages,proto3" json:"recipient_usages"`
+	PendingWithdrawals []PendingWithdrawal      `protobuf:"bytes,9,rep,name=pending_withdrawals,json=pendingWithdrawals,proto3" json:"pending_withdrawals"`
+	// next_withdrawal_id is the id the next queued withdrawal gets.
+	NextWithdrawalId uint64          `protobuf:"varint,10,opt,name=next_withdrawal_id,json=nextWithdrawalId,proto3" json:"next_withdrawal_id,omitempty"`
+	PaymentStreams   []PaymentStream `protobuf:"bytes,11,rep,name=payment_streams,json=paymentStreams,proto3" json:"payment_streams"`
+	// next_stream_id is the id the next payment stream gets.
+	NextStreamId uint64 `protobuf:"varint,12,opt,name=next_stream_id,json=nextStreamId,proto3" json:"next_stream_id,omitempty"`
+	// action_log_commitments holds each agent's action log accumulator;
+	// action_log_nodes holds the accumulator nodes, including those of pruned
+	// entries.
+	ActionLogCommitments []ActionLogCommitment     `protobuf:"bytes,13,rep,name=action_log_commitments,json=actionLogCommitments,proto3" json:"action_log_commitments"`
+	ActionLogNodes       []ActionLogNode           `protobuf:"bytes,14,rep,name=action_log_nodes,json=actionLogNodes,proto3" json:"action_log_nodes"`
+	RegisteredPolicies   []RegisteredPolicy        `protobuf:"bytes,15,rep,name=registered_policies,json=registeredPolicies,proto3" json:"registered_policies"`
+	ConsumedAttestations []tee.ConsumedAttestation `protobuf:"bytes,16,rep,name=consumed_attestations,json=consumedAttestations,proto3" json:"consumed_attestations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -110,8 +126,132 @@ func (m *GenesisState) GetEscrows() []AgentEscrow {
 	return nil
 }
 
+func (m *GenesisState) GetRecipientPolicies() []GenesisRecipientPolicy {
+	if m != nil {
+		return m.RecipientPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecipientUsages() []RecipientUsage {
+	if m != nil {
+		return m.RecipientUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingWithdrawals() []PendingWithdrawal {
+	if m != nil {
+		return m.PendingWithdrawals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextWithdrawalId() uint64 {
+	if m != nil {
+		return m.NextWithdrawalId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPaymentStreams() []PaymentStream {
+	if m != nil {
+		return m.PaymentStreams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextStreamId() uint64 {
+	if m != nil {
+		return m.NextStreamId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetActionLogCommitments() []ActionLogCommitment {
+	if m != nil {
+		return m.ActionLogCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetActionLogNodes() []ActionLogNode {
+	if m != nil {
+		return m.ActionLogNodes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegisteredPolicies() []RegisteredPolicy {
+	if m != nil {
+		return m.RegisteredPolicies
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumedAttestations() []tee.ConsumedAttestation {
+	if m != nil {
+		return m.ConsumedAttestations
+	}
+	return nil
+}
+
+// GenesisRecipientPolicy is one agent's recipient policy.
+type GenesisRecipientPolicy struct {
+	AgentId string          `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Policy  RecipientPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
+}
+
+func (m *GenesisRecipientPolicy) Reset()         { *m = GenesisRecipientPolicy{} }
+func (m *GenesisRecipientPolicy) String() string { return proto.CompactTextString(m) }
+func (*GenesisRecipientPolicy) ProtoMessage()    {}
+func (*GenesisRecipientPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b7293a9987e2d772, []int{1}
+}
+func (m *GenesisRecipientPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisRecipientPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisRecipientPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisRecipientPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisRecipientPolicy.Merge(m, src)
+}
+func (m *GenesisRecipientPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisRecipientPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisRecipientPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisRecipientPolicy proto.InternalMessageInfo
+
+func (m *GenesisRecipientPolicy) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *GenesisRecipientPolicy) GetPolicy() RecipientPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return RecipientPolicy{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.agent.GenesisState")
+	proto.RegisterType((*GenesisRecipientPolicy)(nil), "dymensionxyz.dymension.agent.GenesisRecipientPolicy")
 }
 
 func init() {
@@ -119,29 +259,50 @@ func init() {
 }
 
 var fileDescriptor_b7293a9987e2d772 = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4e, 0xc2, 0x30,
-	0x18, 0xc7, 0x37, 0x87, 0x33, 0x14, 0x13, 0x4d, 0xe3, 0x61, 0x21, 0x66, 0x12, 0x35, 0x66, 0xa8,
-	0xd9, 0x12, 0x78, 0x02, 0x48, 0xd0, 0x68, 0x38, 0x20, 0xde, 0xbc, 0x90, 0x32, 0x6a, 0x5d, 0x80,
-	0x7d, 0xcb, 0x5a, 0x91, 0xf9, 0x14, 0x3e, 0x87, 0x4f, 0xc2, 0x91, 0xa3, 0x27, 0x63, 0xe0, 0x45,
-	0x0c, 0x5d, 0xb7, 0x78, 0x71, 0x7a, 0x69, 0xd6, 0x6f, 0xbf, 0xff, 0xef, 0x6b, 0xda, 0x0f, 0x9d,
-	0x8f, 0x92, 0x29, 0x0d, 0x79, 0x00, 0xe1, 0x3c, 0x79, 0xf5, 0xf2, 0x8d, 0x47, 0x18, 0x0d, 0x85,
-	0xc7, 0x68, 0x48, 0x79, 0xc0, 0xdd, 0x28, 0x06, 0x01, 0xf8, 0xf0, 0x27, 0xeb, 0xe6, 0x1b, 0x57,
-	0xb2, 0xd5, 0x03, 0x06, 0x0c, 0x24, 0xe8, 0x6d, 0xbe, 0xd2, 0x4c, 0xd5, 0x29, 0xf4, 0xcb, 0x55,
-	0x91, 0x17, 0x85, 0xe4, 0x23, 0xa5, 0xa3, 0x21, 0xf1, 0xc7, 0x29, 0x7c, 0xfc, 0x6e, 0xa0, 0xdd,
-	0xeb, 0xf4, 0x70, 0xf7, 0x82, 0x08, 0x8a, 0xdb, 0xc8, 0x8c, 0x48, 0x4c, 0xa6, 0xdc, 0xd2, 0x6b,
-	0xba, 0x53, 0x69, 0x9c, 0xba, 0x45, 0x87, 0x75, 0x7b, 0x92, 0x6d, 0x97, 0x16, 0x9f, 0x47, 0x5a,
-	0x5f, 0x25, 0x71, 0x0b, 0x99, 0xf2, 0x2f, 0xb7, 0xb6, 0x6a, 0x86, 0x53, 0x69, 0x9c, 0x14, 0x3b,
-	0x5a, 0x9b, 0x35, 0x53, 0xa4, 0x41, 0x7c, 0x87, 0x10, 0xf1, 0x45, 0x00, 0xe1, 0x60, 0x02, 0xcc,
-	0x32, 0xa4, 0xe6, 0xf2, 0x0f, 0x8d, 0xe4, 0xbb, 0xc0, 0x3a, 0xa1, 0x88, 0x13, 0xe5, 0x2b, 0x93,
-	0xac, 0x8a, 0xeb, 0x68, 0x3f, 0xa6, 0x33, 0x18, 0xd3, 0xd1, 0x20, 0x82, 0x49, 0xe0, 0x07, 0x94,
-	0x5b, 0xa5, 0x9a, 0xe1, 0x94, 0xfb, 0x7b, 0xaa, 0xde, 0x53, 0x65, 0x7c, 0x8b, 0xca, 0xd9, 0x3d,
-	0x71, 0x6b, 0x5b, 0x36, 0x3f, 0x2b, 0x6e, 0x7e, 0xa5, 0xf0, 0xac, 0x6d, 0x1e, 0xc7, 0x37, 0x68,
-	0x87, 0x72, 0x3f, 0x86, 0x17, 0x6e, 0x99, 0xd2, 0x54, 0xff, 0xc7, 0x6d, 0x74, 0x64, 0x42, 0xc9,
-	0xb2, 0x7c, 0xbb, 0xbb, 0x58, 0xd9, 0xfa, 0x72, 0x65, 0xeb, 0x5f, 0x2b, 0x5b, 0x7f, 0x5b, 0xdb,
-	0xda, 0x72, 0x6d, 0x6b, 0x1f, 0x6b, 0x5b, 0x7b, 0x68, 0xb0, 0x40, 0x3c, 0x3d, 0x0f, 0x5d, 0x1f,
-	0xa6, 0xde, 0x2f, 0xcf, 0x3f, 0x6b, 0x7a, 0x73, 0x35, 0x03, 0x22, 0x89, 0x28, 0x1f, 0x9a, 0x72,
-	0x02, 0x9a, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x94, 0xb0, 0xa1, 0x37, 0xba, 0x02, 0x00, 0x00,
+	// 688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x4e, 0x13, 0x41,
+	0x14, 0xee, 0x02, 0x16, 0x3a, 0x60, 0x5b, 0x07, 0x24, 0x2b, 0x31, 0xb5, 0x41, 0xa2, 0x45, 0x70,
+	0x37, 0x16, 0x5f, 0x00, 0x08, 0x9a, 0x2a, 0x31, 0x58, 0x62, 0x4c, 0x30, 0x66, 0x33, 0xec, 0x0e,
+	0xcb, 0x04, 0x76, 0x67, 0x33, 0x33, 0xfc, 0xd4, 0x2b, 0x1f, 0xc1, 0x27, 0xf1, 0x39, 0xb8, 0xe4,
+	0xd2, 0x2b, 0x63, 0xe0, 0x45, 0xcc, 0x9c, 0x9d, 0xdd, 0xb6, 0x62, 0x7f, 0x6e, 0x9a, 0xee, 0x99,
+	0xef, 0xe7, 0x9c, 0x33, 0x73, 0x66, 0xd0, 0x8b, 0xa0, 0x13, 0xd1, 0x58, 0x32, 0x1e, 0x5f, 0x76,
+	0xbe, 0xb9, 0xf9, 0x87, 0x4b, 0x42, 0x1a, 0x2b, 0x37, 0xa4, 0x31, 0x95, 0x4c, 0x3a, 0x89, 0xe0,
+	0x8a, 0xe3, 0xc7, 0xbd, 0x58, 0x27, 0xff, 0x70, 0x00, 0xbb, 0xb4, 0x10, 0xf2, 0x90, 0x03, 0xd0,
+	0xd5, 0xff, 0x52, 0xce, 0x52, 0x63, 0xa8, 0x3e, 0xfc, 0x1a, 0xe4, 0xda, 0x50, 0xe4, 0x11, 0xa5,
+	0xc1, 0x21, 0xf1, 0x4f, 0x0c, 0xf8, 0xf9, 0x00, 0xb0, 0xcf, 0xa3, 0x88, 0xc7, 0xae, 0xa2, 0x34,
+	0x05, 0x2e, 0xff, 0x44, 0x68, 0xee, 0x6d, 0x5a, 0xc5, 0xbe, 0x22, 0x8a, 0xe2, 0x2d, 0x54, 0x4c,
+	0x88, 0x20, 0x91, 0xb4, 0xad, 0xba, 0xd5, 0x98, 0x6d, 0xae, 0x38, 0xc3, 0xaa, 0x72, 0xf6, 0x00,
+	0xbb, 0x35, 0x75, 0xf5, 0xfb, 0x49, 0xa1, 0x6d, 0x98, 0x78, 0x13, 0x15, 0x61, 0x55, 0xda, 0x13,
+	0xf5, 0xc9, 0xc6, 0x6c, 0xf3, 0xe9, 0x70, 0x8d, 0x4d, 0xfd, 0x9b, 0x49, 0xa4, 0x44, 0xfc, 0x11,
+	0x21, 0xe2, 0x2b, 0xc6, 0x63, 0xef, 0x94, 0x87, 0xf6, 0x24, 0xc8, 0xac, 0x8f, 0x90, 0x01, 0xfc,
+	0x2e, 0x0f, 0x77, 0x62, 0x25, 0x3a, 0x46, 0xaf, 0x44, 0xb2, 0x28, 0x5e, 0x45, 0x55, 0x41, 0xcf,
+	0xf9, 0x09, 0x0d, 0xbc, 0x84, 0x9f, 0x32, 0x9f, 0x51, 0x69, 0x4f, 0xd5, 0x27, 0x1b, 0xa5, 0x76,
+	0xc5, 0xc4, 0xf7, 0x4c, 0x18, 0xbf, 0x43, 0xa5, 0xac, 0xa1, 0xd2, 0xbe, 0x07, 0xe6, 0xcf, 0x86,
+	0x9b, 0xbf, 0x31, 0xf0, 0xcc, 0x36, 0xa7, 0xe3, 0x16, 0x9a, 0xa6, 0xd2, 0x17, 0xfc, 0x42, 0xda,
+	0x45, 0x50, 0x5a, 0x1d, 0xa3, 0x1b, 0x3b, 0xc0, 0x30, 0x62, 0x19, 0x1f, 0x33, 0x84, 0x05, 0xf5,
+	0x59, 0xc2, 0x68, 0xac, 0xba, 0x35, 0x4c, 0x83, 0xea, 0xeb, 0xe1, 0xaa, 0x66, 0x8f, 0xdb, 0x19,
+	0x1d, 0x4a, 0xcd, 0x9a, 0xf4, 0x40, 0xf4, 0x85, 0x75, 0x07, 0xbe, 0xa2, 0x6a, 0x1e, 0xf4, 0xce,
+	0x24, 0x09, 0xa9, 0xb4, 0x67, 0xc6, 0xd9, 0x85, 0xdc, 0xe1, 0x93, 0x26, 0x19, 0x83, 0x8a, 0xe8,
+	0x8b, 0x4a, 0x7c, 0x84, 0xe6, 0x13, 0x1a, 0x07, 0x2c, 0x0e, 0xbd, 0x0b, 0xa6, 0x8e, 0x03, 0x41,
+	0x2e, 0xc8, 0xa9, 0xb4, 0x4b, 0xe0, 0xe0, 0x8e, 0x38, 0x72, 0x29, 0xf1, 0x73, 0xce, 0x33, 0x26,
+	0x38, 0xf9, 0x77, 0x41, 0xe2, 0x75, 0x84, 0x63, 0x7a, 0xa9, 0x7a, 0x4c, 0x3c, 0x16, 0xd8, 0xa8,
+	0x6e, 0x35, 0xa6, 0xda, 0x55, 0xbd, 0xd2, 0x05, 0xb7, 0x02, 0x7c, 0x80, 0x2a, 0x09, 0xd1, 0x6e,
+	0xca, 0x93, 0x4a, 0x50, 0x3d, 0x04, 0xb3, 0x90, 0xd1, 0xda, 0xa8, 0x21, 0x00, 0xd2, 0x3e, 0x70,
+	0x4c, 0x36, 0xe5, 0xa4, 0x37, 0x28, 0xf1, 0x0a, 0x2a, 0x43, 0x26, 0xa9, 0xb0, 0xce, 0x62, 0x0e,
+	0xb2, 0x98, 0xd3, 0xd1, 0x14, 0xd4, 0x0a, 0x70, 0x84, 0x16, 0xbb, 0xc7, 0xde, 0xd3, 0xd3, 0xca,
+	0x54, 0x04, 0x93, 0x74, 0x1f, 0x12, 0x79, 0x35, 0xe6, 0x08, 0x6c, 0xe7, 0x4c, 0x93, 0xce, 0x02,
+	0xb9, 0xbb, 0x24, 0xf1, 0x17, 0x54, 0xed, 0xb1, 0x8b, 0x79, 0x40, 0xa5, 0x5d, 0x1e, 0xa7, 0xe2,
+	0xdc, 0xe8, 0x03, 0x0f, 0xb2, 0x4d, 0x2e, 0x93, 0xde, 0xa0, 0xc4, 0x14, 0xcd, 0x0b, 0x1a, 0x32,
+	0xa9, 0xa8, 0xe8, 0x1d, 0xb9, 0x0a, 0xe8, 0x3b, 0xa3, 0x4e, 0x51, 0x46, 0xec, 0x3b, 0xa8, 0x58,
+	0xf4, 0xc7, 0xf5, 0x49, 0x8d, 0xd0, 0x43, 0x9f, 0xc7, 0xf2, 0x2c, 0xa2, 0x81, 0x47, 0x94, 0xa2,
+	0x52, 0x11, 0x9d, 0x86, 0xb4, 0xab, 0x60, 0xd4, 0x1c, 0x64, 0x94, 0x5e, 0x85, 0xce, 0xb6, 0xe1,
+	0x6e, 0x76, 0xa9, 0x59, 0xcb, 0xfc, 0xbb, 0x4b, 0x72, 0xf9, 0xbb, 0x85, 0x16, 0xff, 0x3f, 0x4c,
+	0xf8, 0x11, 0x9a, 0x81, 0xec, 0xf5, 0xe6, 0xea, 0xcb, 0xb3, 0xd4, 0x9e, 0x86, 0xef, 0x56, 0x80,
+	0xdf, 0xa3, 0x22, 0x34, 0xa0, 0x63, 0x4f, 0xc0, 0xad, 0xfa, 0x72, 0xcc, 0x21, 0xea, 0xab, 0xde,
+	0x48, 0x6c, 0xed, 0x5e, 0xdd, 0xd4, 0xac, 0xeb, 0x9b, 0x9a, 0xf5, 0xe7, 0xa6, 0x66, 0xfd, 0xb8,
+	0xad, 0x15, 0xae, 0x6f, 0x6b, 0x85, 0x5f, 0xb7, 0xb5, 0xc2, 0x41, 0x33, 0x64, 0xea, 0xf8, 0xec,
+	0x50, 0xd7, 0xe6, 0x0e, 0x78, 0x01, 0xce, 0x37, 0xdc, 0x4b, 0xf3, 0x66, 0xa8, 0x4e, 0x42, 0xe5,
+	0x61, 0x11, 0x1e, 0x82, 0x8d, 0xbf, 0x03, 0x00, 0x42, 0x22, 0x0a, 0x76, 0xea, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,6 +325,130 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumedAttestations) > 0 {
+		for iNdEx := len(m.ConsumedAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumedAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.RegisteredPolicies) > 0 {
+		for iNdEx := len(m.RegisteredPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegisteredPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ActionLogNodes) > 0 {
+		for iNdEx := len(m.ActionLogNodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionLogNodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ActionLogCommitments) > 0 {
+		for iNdEx := len(m.ActionLogCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActionLogCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.NextStreamId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextStreamId))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.PaymentStreams) > 0 {
+		for iNdEx := len(m.PaymentStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PaymentStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.NextWithdrawalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.NextWithdrawalId))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for iNdEx := len(m.PendingWithdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingWithdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RecipientUsages) > 0 {
+		for iNdEx := len(m.RecipientUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RecipientPolicies) > 0 {
+		for iNdEx := len(m.RecipientPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if len(m.Escrows) > 0 {
 		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -242,6 +527,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisRecipientPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisRecipientPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisRecipientPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -291,6 +616,75 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RecipientPolicies) > 0 {
+		for _, e := range m.RecipientPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecipientUsages) > 0 {
+		for _, e := range m.RecipientUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingWithdrawals) > 0 {
+		for _, e := range m.PendingWithdrawals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextWithdrawalId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextWithdrawalId))
+	}
+	if len(m.PaymentStreams) > 0 {
+		for _, e := range m.PaymentStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextStreamId != 0 {
+		n += 1 + sovGenesis(uint64(m.NextStreamId))
+	}
+	if len(m.ActionLogCommitments) > 0 {
+		for _, e := range m.ActionLogCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ActionLogNodes) > 0 {
+		for _, e := range m.ActionLogNodes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredPolicies) > 0 {
+		for _, e := range m.RegisteredPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumedAttestations) > 0 {
+		for _, e := range m.ConsumedAttestations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GenesisRecipientPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -530,6 +924,431 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientPolicies = append(m.RecipientPolicies, GenesisRecipientPolicy{})
+			if err := m.RecipientPolicies[len(m.RecipientPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientUsages = append(m.RecipientUsages, RecipientUsage{})
+			if err := m.RecipientUsages[len(m.RecipientUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingWithdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingWithdrawals = append(m.PendingWithdrawals, PendingWithdrawal{})
+			if err := m.PendingWithdrawals[len(m.PendingWithdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextWithdrawalId", wireType)
+			}
+			m.NextWithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextWithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentStreams = append(m.PaymentStreams, PaymentStream{})
+			if err := m.PaymentStreams[len(m.PaymentStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStreamId", wireType)
+			}
+			m.NextStreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextStreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLogCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionLogCommitments = append(m.ActionLogCommitments, ActionLogCommitment{})
+			if err := m.ActionLogCommitments[len(m.ActionLogCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActionLogNodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActionLogNodes = append(m.ActionLogNodes, ActionLogNode{})
+			if err := m.ActionLogNodes[len(m.ActionLogNodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredPolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredPolicies = append(m.RegisteredPolicies, RegisteredPolicy{})
+			if err := m.RegisteredPolicies[len(m.RegisteredPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumedAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumedAttestations = append(m.ConsumedAttestations, tee.ConsumedAttestation{})
+			if err := m.ConsumedAttestations[len(m.ConsumedAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisRecipientPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisRecipientPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisRecipientPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
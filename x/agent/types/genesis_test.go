@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 )
 
 func spendingAgent() types.Agent {
@@ -30,6 +31,15 @@ func TestGenesisValidate(t *testing.T) {
 			Escrows: []types.AgentEscrow{
 				{AgentId: "a1", Balance: sdk.NewCoins(sdk.NewInt64Coin("adym", 5))},
 			},
+			RecipientPolicies: []types.GenesisRecipientPolicy{
+				{AgentId: "a1", Policy: types.RecipientPolicy{
+					PerRecipientCap: sdk.NewCoins(sdk.NewInt64Coin("adym", 50)),
+					CapWindowBlocks: 10,
+				}},
+			},
+			RecipientUsages: []types.RecipientUsage{
+				{AgentId: "a1", Recipient: sampleOwner, WindowStartHeight: 20, Received: sdk.NewCoins(sdk.NewInt64Coin("adym", 5))},
+			},
 		}
 	}
 
@@ -59,6 +69,17 @@ func TestGenesisValidate(t *testing.T) {
 		{"invalid escrow balance", func(g *types.GenesisState) {
 			g.Escrows[0].Balance = sdk.Coins{sdk.Coin{Denom: "adym", Amount: math.NewInt(-1)}}
 		}},
+		{"spend budget duplicates spend denom", func(g *types.GenesisState) {
+			g.Agents[0].SpendBudgets = []types.SpendBudget{{Denom: "adym", LimitPerWindow: math.NewInt(1), WindowBlocks: 1}}
+		}},
+		{"spend budget window start not bucket-aligned", func(g *types.GenesisState) {
+			g.Agents[0].SpendBudgets = []types.SpendBudget{{Denom: "uusdc", LimitPerWindow: math.NewInt(1), WindowBlocks: 10, WindowStartHeight: 5}}
+		}},
+		{"recipient policy for unknown agent", func(g *types.GenesisState) { g.RecipientPolicies[0].AgentId = "ghost" }},
+		{"empty recipient policy", func(g *types.GenesisState) { g.RecipientPolicies[0].Policy = types.RecipientPolicy{} }},
+		{"invalid recipient policy", func(g *types.GenesisState) { g.RecipientPolicies[0].Policy.CapWindowBlocks = 0 }},
+		{"recipient usage without policy", func(g *types.GenesisState) { g.RecipientUsages[0].AgentId = "ghost" }},
+		{"duplicate recipient usage", func(g *types.GenesisState) { g.RecipientUsages = append(g.RecipientUsages, g.RecipientUsages[0]) }},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -82,3 +103,78 @@ func TestGenesisValidate_RuntimeStatesAccepted(t *testing.T) {
 	}
 	require.NoError(t, g.Validate())
 }
+
+func TestGenesisValidate_SubAgentTree(t *testing.T) {
+	agent := func(id, parent string, active bool) types.Agent {
+		return types.Agent{Id: id, Owner: sampleOwner, ParentId: parent, Active: active}
+	}
+	valid := types.GenesisState{
+		Params: types.DefaultParams(),
+		Agents: []types.Agent{agent("p", "", true), agent("c", "p", true), agent("g", "c", false)},
+	}
+	require.NoError(t, valid.Validate())
+
+	for name, agents := range map[string][]types.Agent{
+		"unknown parent":            {agent("c", "p", true)},
+		"parent cycle":              {agent("a", "b", true), agent("b", "a", true)},
+		"own parent":                {agent("a", "a", true)},
+		"active below inactive":     {agent("p", "", false), agent("c", "p", true)},
+		"owner differs from parent": {agent("p", "", true), {Id: "c", Owner: "other", ParentId: "p", Active: true}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := valid
+			g.Agents = agents
+			require.Error(t, g.Validate())
+		})
+	}
+}
+
+func TestGenesisValidate_RegisteredPolicies(t *testing.T) {
+	cert := validCertPEM(t)
+	policy := func(q string) tee.Policy {
+		return tee.Policy{GcpRootCertPem: cert, PolicyValues: "{}", PolicyQuery: q, PolicyStructure: "package x"}
+	}
+	fp := func(p tee.Policy) string {
+		id, err := types.RegisteredPolicyID(sampleOwner, p)
+		require.NoError(t, err)
+		return id
+	}
+	v1, v2 := policy("v1"), policy("v2")
+	valid := func() types.GenesisState {
+		return types.GenesisState{
+			Params: types.DefaultParams(),
+			Agents: []types.Agent{{Id: "a1", Owner: sampleOwner, Policy: v2, PolicyId: fp(v2), Active: true}},
+			RegisteredPolicies: []types.RegisteredPolicy{
+				{Id: fp(v1), Policy: v1, Admin: sampleOwner, Version: 1, SupersededBy: fp(v2)},
+				{Id: fp(v2), Policy: v2, Admin: sampleOwner, Version: 2, Supersedes: fp(v1)},
+			},
+		}
+	}
+	require.NoError(t, valid().Validate())
+
+	for name, mutate := range map[string]func(*types.GenesisState){
+		"id of another policy": func(g *types.GenesisState) { g.RegisteredPolicies[0].Id = fp(policy("other")) },
+		"id is the bare fingerprint": func(g *types.GenesisState) {
+			g.RegisteredPolicies[0].Id, _ = types.PolicyFingerprint(v1)
+		},
+		"duplicate":   func(g *types.GenesisState) { g.RegisteredPolicies[1] = g.RegisteredPolicies[0] },
+		"version gap": func(g *types.GenesisState) { g.RegisteredPolicies[1].Version = 3 },
+		"admin changes": func(g *types.GenesisState) {
+			g.RegisteredPolicies[1].Admin = "dym1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqhx6c8w"
+		},
+		"one-sided link": func(g *types.GenesisState) { g.RegisteredPolicies[0].SupersededBy = "" },
+		"version 1 with predecessor": func(g *types.GenesisState) {
+			g.RegisteredPolicies[0].Supersedes = fp(v2)
+		},
+		"agent bound to unknown policy": func(g *types.GenesisState) { g.Agents[0].PolicyId = fp(policy("other")) },
+		"pending id without pending policy": func(g *types.GenesisState) {
+			g.Agents[0].PendingPolicyId = fp(v1)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			g := valid()
+			mutate(&g)
+			require.Error(t, g.Validate())
+		})
+	}
+}
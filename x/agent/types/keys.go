@@ -19,4 +19,34 @@ var (
 	KeyFeedback        = []byte{0x04}
 	KeyReputation      = []byte{0x05}
 	KeyAgentEscrow     = []byte{0x06}
+	KeyRecipientPolicy = []byte{0x07}
+	KeyRecipientUsage  = []byte{0x08}
+	// KeyPendingWithdrawals holds queued withdrawals by (agent, id);
+	// KeyWithdrawalQueue indexes them by (mature height, agent, id).
+	KeyPendingWithdrawals = []byte{0x09}
+	KeyWithdrawalQueue    = []byte{0x0a}
+	KeyNextWithdrawalID   = []byte{0x0b}
+	// KeyPaymentStreams holds payment streams by (agent, id); KeyStreamQueue
+	// indexes them by (next payment time, agent, id).
+	KeyPaymentStreams = []byte{0x0c}
+	KeyStreamQueue    = []byte{0x0d}
+	KeyNextStreamID   = []byte{0x0e}
+	// KeyActionLogCommitments holds each agent's action log accumulator and
+	// KeyActionLogNodes its nodes by (agent, level, index);
+	// KeyActionLogQueue indexes retained entries by (height, agent, seq) for
+	// block-based pruning.
+	KeyActionLogCommitments = []byte{0x0f}
+	KeyActionLogNodes       = []byte{0x10}
+	KeyActionLogQueue       = []byte{0x11}
+	// KeySubAgents indexes sub-agents by (parent, child).
+	KeySubAgents = []byte{0x12}
+	// KeyRegisteredPolicies holds the shared policy registry by fingerprint.
+	KeyRegisteredPolicies = []byte{0x13}
+	// KeyConsumedAttestations holds consumed attestation token digests and
+	// KeyConsumedAttestationsByExpiry indexes them by expiry for pruning.
+	KeyConsumedAttestations         = []byte{0x14}
+	KeyConsumedAttestationsByExpiry = []byte{0x15}
+	// KeyStreamQueueCursor is the last stream queue entry processed, when the
+	// end blocker stopped at payment_stream_process_limit.
+	KeyStreamQueueCursor = []byte{0x16}
 )
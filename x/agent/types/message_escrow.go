@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -53,13 +55,14 @@ func validateEscrowAmount(amount sdk.Coins) error {
 	return nil
 }
 
-func NewMsgUpdateAgentSpendPolicy(owner, agentID, spendDenom string, spendLimitPerWindow math.Int, spendWindowBlocks uint64) *MsgUpdateAgentSpendPolicy {
+func NewMsgUpdateAgentSpendPolicy(owner, agentID, spendDenom string, spendLimitPerWindow math.Int, spendWindowBlocks uint64, spendBudgets ...SpendBudget) *MsgUpdateAgentSpendPolicy {
 	return &MsgUpdateAgentSpendPolicy{
 		Owner:               owner,
 		AgentId:             agentID,
 		SpendDenom:          spendDenom,
 		SpendLimitPerWindow: spendLimitPerWindow,
 		SpendWindowBlocks:   spendWindowBlocks,
+		SpendBudgets:        spendBudgets,
 	}
 }
 
@@ -70,32 +73,101 @@ func (m *MsgUpdateAgentSpendPolicy) ValidateBasic() error {
 	if m.AgentId == "" {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
 	}
-	return ValidateSpendPolicy(m.SpendDenom, m.SpendLimitPerWindow, m.SpendWindowBlocks)
+	if err := ValidateSpendPolicy(SpendBudget{
+		Denom:          m.SpendDenom,
+		LimitPerWindow: m.SpendLimitPerWindow,
+		WindowBlocks:   m.SpendWindowBlocks,
+		WindowMode:     m.SpendWindowMode,
+		WindowDuration: m.SpendWindowDuration,
+	}); err != nil {
+		return err
+	}
+	if err := ValidateSpendBudgets(m.SpendDenom, m.SpendBudgets); err != nil {
+		return err
+	}
+	for _, b := range m.SpendBudgets {
+		// window bookkeeping belongs to the keeper
+		if b.hasWindowState() {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "spend budget %s: window state must be unset", b.Denom)
+		}
+	}
+	return nil
 }
 
+const (
+	// RollingWindowSlots is the number of slots a rolling spend window is
+	// aged in: spends expire at a granularity of window_duration / 24.
+	RollingWindowSlots = 24
+	// MinSpendWindowDuration is the shortest time-based spend window, keeping
+	// rolling slots at least a few seconds long.
+	MinSpendWindowDuration = time.Minute
+)
+
 // ValidateSpendPolicy checks the spend-policy invariant shared by
 // MsgUpdateAgentSpendPolicy and genesis-imported agents: the budget fields are
-// set iff the denom is, so SpendBucket can never divide by zero on an enabled
-// agent.
-func ValidateSpendPolicy(spendDenom string, spendLimitPerWindow math.Int, spendWindowBlocks uint64) error {
-	if spendDenom == "" {
+// set iff the denom is, and the window length matching the window mode is set
+// while the other is not, so SpendBucket can never divide by zero on an
+// enabled agent.
+func ValidateSpendPolicy(b SpendBudget) error {
+	if b.Denom == "" {
 		// disabling spending: the budget fields must be unset
-		if !spendLimitPerWindow.IsNil() && !spendLimitPerWindow.IsZero() {
+		if !b.LimitPerWindow.IsNil() && !b.LimitPerWindow.IsZero() {
 			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend limit set without spend denom")
 		}
-		if spendWindowBlocks != 0 {
+		if b.WindowBlocks != 0 {
 			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window blocks set without spend denom")
 		}
+		if b.WindowMode != SpendWindowMode_SPEND_WINDOW_MODE_BLOCKS || b.WindowDuration != 0 {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window mode set without spend denom")
+		}
 		return nil
 	}
-	if sdk.ValidateDenom(spendDenom) != nil {
+	if sdk.ValidateDenom(b.Denom) != nil {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend denom")
 	}
-	if spendLimitPerWindow.IsNil() || !spendLimitPerWindow.IsPositive() {
+	if b.LimitPerWindow.IsNil() || !b.LimitPerWindow.IsPositive() {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend limit per window must be positive")
 	}
-	if spendWindowBlocks == 0 {
-		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window blocks must be positive")
+	switch b.WindowMode {
+	case SpendWindowMode_SPEND_WINDOW_MODE_BLOCKS:
+		if b.WindowBlocks == 0 {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window blocks must be positive")
+		}
+		if b.WindowDuration != 0 {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window duration set on block window")
+		}
+	case SpendWindowMode_SPEND_WINDOW_MODE_TIME, SpendWindowMode_SPEND_WINDOW_MODE_ROLLING:
+		if b.WindowDuration < MinSpendWindowDuration {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "spend window duration must be at least %s", MinSpendWindowDuration)
+		}
+		if b.WindowBlocks != 0 {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend window blocks set on time window")
+		}
+	default:
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "unknown spend window mode: %s", b.WindowMode)
+	}
+	return nil
+}
+
+// ValidateSpendBudgets checks the per-denom budget table: every entry is an
+// enabled budget for a distinct denom other than spendDenom, which is governed
+// by the agent's primary spend fields.
+func ValidateSpendBudgets(spendDenom string, budgets []SpendBudget) error {
+	seen := make(map[string]struct{}, len(budgets))
+	for _, b := range budgets {
+		if b.Denom == "" {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty spend budget denom")
+		}
+		if err := ValidateSpendPolicy(b); err != nil {
+			return errorsmod.Wrapf(err, "spend budget %s", b.Denom)
+		}
+		if b.Denom == spendDenom {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "spend budget duplicates spend denom: %s", b.Denom)
+		}
+		if _, dup := seen[b.Denom]; dup {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "duplicate spend budget: %s", b.Denom)
+		}
+		seen[b.Denom] = struct{}{}
 	}
 	return nil
 }
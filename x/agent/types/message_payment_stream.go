@@ -0,0 +1,55 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewMsgCreatePaymentStream(owner, agentID, recipient string, amountPerPeriod sdk.Coin, period time.Duration, startTime, endTime time.Time) *MsgCreatePaymentStream {
+	return &MsgCreatePaymentStream{
+		Owner:           owner,
+		AgentId:         agentID,
+		Recipient:       recipient,
+		AmountPerPeriod: amountPerPeriod,
+		Period:          period,
+		StartTime:       startTime,
+		EndTime:         endTime,
+	}
+}
+
+func (m *MsgCreatePaymentStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner address")
+	}
+	if m.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "recipient address")
+	}
+	if err := validatePaymentStreamTerms(m.AmountPerPeriod, m.Period, m.StartTime, m.EndTime); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+	}
+	return nil
+}
+
+func NewMsgCancelPaymentStream(signer, agentID string, streamID uint64) *MsgCancelPaymentStream {
+	return &MsgCancelPaymentStream{
+		Signer:   signer,
+		AgentId:  agentID,
+		StreamId: streamID,
+	}
+}
+
+func (m *MsgCancelPaymentStream) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "signer address")
+	}
+	if m.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	return nil
+}
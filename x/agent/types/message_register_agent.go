@@ -23,14 +23,51 @@ func (m *MsgRegisterAgent) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner address")
 	}
-	return validatePolicy(m.Policy)
+	if err := validatePolicyOrID(m.Policy, m.PolicyId); err != nil {
+		return err
+	}
+	return validatePolicySetFor(m.Policy, m.PolicySet)
+}
+
+// validatePolicyOrID checks exactly one of an embedded policy and a registered
+// policy id is given, and that it is well-formed.
+func validatePolicyOrID(p tee.Policy, id string) error {
+	if id == "" {
+		return validatePolicy(p)
+	}
+	if p != (tee.Policy{}) {
+		return errorsmod.Wrap(ErrInvalidPolicy, "both policy and policy id set")
+	}
+	if err := ValidateFingerprint(id); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPolicy, "policy id: %s", err)
+	}
+	return nil
 }
 
-// validatePolicy checks the embedded TEE policy is well-formed: the root cert
-// PEM parses and the rego query/structure are present.
+// validatePolicySetFor checks the policy set and that it does not list the
+// primary policy as a co-policy.
+func validatePolicySetFor(primary tee.Policy, set PolicySet) error {
+	if err := set.ValidateBasic(); err != nil {
+		return err
+	}
+	listed, err := set.Includes(primary)
+	if err != nil {
+		return err
+	}
+	if listed {
+		return errorsmod.Wrap(ErrInvalidPolicy, "primary policy listed as co-policy")
+	}
+	return nil
+}
+
+// validatePolicy checks the embedded TEE policy is well-formed: the backend is
+// known, the root cert PEM parses and the rego query/structure are present.
 func validatePolicy(p tee.Policy) error {
+	if err := p.Type.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidPolicy, err.Error())
+	}
 	if _, err := p.PemCert(); err != nil {
-		return errorsmod.Wrap(ErrInvalidPolicy, "gcp root cert pem")
+		return errorsmod.Wrap(ErrInvalidPolicy, "root cert pem")
 	}
 	if p.PolicyQuery == "" {
 		return errorsmod.Wrap(ErrInvalidPolicy, "empty policy query")
@@ -36,6 +36,13 @@ func validCertPEM(t *testing.T) string {
 func TestMsgRegisterAgent_ValidateBasic(t *testing.T) {
 	cert := validCertPEM(t)
 	good := tee.Policy{GcpRootCertPem: cert, PolicyValues: "{}", PolicyQuery: "data.x.allow", PolicyStructure: "package x"}
+	byID := func(p tee.Policy, id string) *types.MsgRegisterAgent {
+		m := types.NewMsgRegisterAgent(sampleOwner, "a1", p)
+		m.PolicyId = id
+		return m
+	}
+	fp, err := types.PolicyFingerprint(good)
+	require.NoError(t, err)
 
 	for _, tc := range []struct {
 		name    string
@@ -48,6 +55,11 @@ func TestMsgRegisterAgent_ValidateBasic(t *testing.T) {
 		{"bad cert", types.NewMsgRegisterAgent(sampleOwner, "a1", tee.Policy{GcpRootCertPem: "nope", PolicyQuery: "q", PolicyStructure: "s"}), true},
 		{"empty query", types.NewMsgRegisterAgent(sampleOwner, "a1", tee.Policy{GcpRootCertPem: cert, PolicyStructure: "s"}), true},
 		{"empty structure", types.NewMsgRegisterAgent(sampleOwner, "a1", tee.Policy{GcpRootCertPem: cert, PolicyQuery: "q"}), true},
+		{"unknown tee type", types.NewMsgRegisterAgent(sampleOwner, "a1", tee.Policy{GcpRootCertPem: cert, PolicyQuery: "q", PolicyStructure: "s", Type: 9}), true},
+		{"nitro policy", types.NewMsgRegisterAgent(sampleOwner, "a1", tee.Policy{GcpRootCertPem: cert, PolicyQuery: "q", PolicyStructure: "s", Type: tee.TEEType_TEE_TYPE_AWS_NITRO}), false},
+		{"registered policy id", byID(tee.Policy{}, fp), false},
+		{"malformed policy id", byID(tee.Policy{}, "abc"), true},
+		{"both policy and id", byID(good, fp), true},
 	} {
 		t.Run(tc.name, func(t *testing.T) {
 			err := tc.msg.ValidateBasic()
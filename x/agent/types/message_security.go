@@ -0,0 +1,81 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewMsgCancelAgentWithdrawal(owner, agentID string, withdrawalID uint64) *MsgCancelAgentWithdrawal {
+	return &MsgCancelAgentWithdrawal{
+		Owner:        owner,
+		AgentId:      agentID,
+		WithdrawalId: withdrawalID,
+	}
+}
+
+func (m *MsgCancelAgentWithdrawal) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner address")
+	}
+	if m.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	return nil
+}
+
+func NewMsgUpdateAgentSecurityPolicy(owner, agentID string, policy SecurityPolicy) *MsgUpdateAgentSecurityPolicy {
+	return &MsgUpdateAgentSecurityPolicy{
+		Owner:   owner,
+		AgentId: agentID,
+		Policy:  policy,
+	}
+}
+
+func (m *MsgUpdateAgentSecurityPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner address")
+	}
+	if m.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	if m.Policy.Guardian == m.Owner {
+		// a guardian must hold a key independent of the one it guards against
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "guardian must differ from owner")
+	}
+	return m.Policy.Validate()
+}
+
+func NewMsgFreezeAgent(signer, agentID string) *MsgFreezeAgent {
+	return &MsgFreezeAgent{
+		Signer:  signer,
+		AgentId: agentID,
+	}
+}
+
+func (m *MsgFreezeAgent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "signer address")
+	}
+	if m.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	return nil
+}
+
+func NewMsgUnfreezeAgent(signer, agentID string) *MsgUnfreezeAgent {
+	return &MsgUnfreezeAgent{
+		Signer:  signer,
+		AgentId: agentID,
+	}
+}
+
+func (m *MsgUnfreezeAgent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "signer address")
+	}
+	if m.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	return nil
+}
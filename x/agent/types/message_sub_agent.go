@@ -0,0 +1,35 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewMsgCreateSubAgent(submitter, parentID string, spec SubAgentSpec, token string) *MsgCreateSubAgent {
+	return &MsgCreateSubAgent{
+		Submitter: submitter,
+		ParentId:  parentID,
+		Spec:      spec,
+		Token:     token,
+	}
+}
+
+func (m *MsgCreateSubAgent) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Submitter); err != nil {
+		return gerrc.ErrInvalidArgument.Wrap("submitter")
+	}
+	if m.ParentId == "" {
+		return gerrc.ErrInvalidArgument.Wrap("parent id is required")
+	}
+	if err := m.Spec.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "spec")
+	}
+	if m.Spec.AgentId == m.ParentId {
+		return gerrc.ErrInvalidArgument.Wrap("agent cannot be its own parent")
+	}
+	if m.Token == "" {
+		return gerrc.ErrInvalidArgument.Wrap("token is required")
+	}
+	return validateCoAttestations(m.CoAttestations)
+}
@@ -23,5 +23,23 @@ func (m *MsgUpdateAgentPolicy) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner address")
 	}
-	return validatePolicy(m.NewPolicy)
+	return validatePolicyOrID(m.NewPolicy, m.NewPolicyId)
+}
+
+func NewMsgUpdateAgentPolicySet(owner, agentID string, set PolicySet) *MsgUpdateAgentPolicySet {
+	return &MsgUpdateAgentPolicySet{
+		Owner:     owner,
+		AgentId:   agentID,
+		PolicySet: set,
+	}
+}
+
+func (m *MsgUpdateAgentPolicySet) ValidateBasic() error {
+	if m.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner address")
+	}
+	return m.PolicySet.ValidateBasic()
 }
@@ -0,0 +1,25 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func NewMsgUpdateAgentRecipientPolicy(owner, agentID string, policy RecipientPolicy) *MsgUpdateAgentRecipientPolicy {
+	return &MsgUpdateAgentRecipientPolicy{
+		Owner:   owner,
+		AgentId: agentID,
+		Policy:  policy,
+	}
+}
+
+func (m *MsgUpdateAgentRecipientPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner address")
+	}
+	if m.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	return m.Policy.Validate()
+}
@@ -8,15 +8,16 @@ import (
 	"cosmossdk.io/math"
 )
 
-// Nonce domain tags. Actions and transfers share one action_seq counter, so
-// without domain separation a token minted for a pending transfer could be
-// replayed by any observer as a plain action at the same seq — advancing the
-// counter and killing the enclave-authorized payment. The tag makes the two
-// nonce spaces disjoint: a token only verifies for the message type it was
-// minted for.
+// Nonce domain tags. Actions, transfers and sub-agent creations share one
+// action_seq counter, so without domain separation a token minted for a
+// pending transfer could be replayed by any observer as a plain action at the
+// same seq — advancing the counter and killing the enclave-authorized payment.
+// The tag makes the nonce spaces disjoint: a token only verifies for the
+// message type it was minted for.
 const (
 	nonceDomainAction   byte = 0x01
 	nonceDomainTransfer byte = 0x02
+	nonceDomainSubAgent byte = 0x03
 )
 
 // ActionNonce derives the per-action nonce that binds an attestation token to
@@ -38,6 +39,12 @@ func TransferNonce(agentID string, payload []byte, actionSeq uint64) string {
 	return attestNonce(nonceDomainTransfer, agentID, payload, actionSeq)
 }
 
+// SubAgentNonce is ActionNonce in the sub-agent creation domain, over the
+// parent's id and seq and the SubAgentSpecBytes payload.
+func SubAgentNonce(parentID string, payload []byte, actionSeq uint64) string {
+	return attestNonce(nonceDomainSubAgent, parentID, payload, actionSeq)
+}
+
 // attestNonce hashes agentID ‖ sha256(payload) ‖ seq ‖ domain. The suffix after
 // agentID is fixed-width (32+8+1), so the encoding is unambiguous even though
 // agentID is variable-length.
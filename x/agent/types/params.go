@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
@@ -15,6 +16,31 @@ const DefaultPolicyRotationDelayBlocks = 100_800
 // DefaultFeedbackTagMaxBytes caps the byte length of a feedback dimension tag.
 const DefaultFeedbackTagMaxBytes = 32
 
+// DefaultFeedbackDecayHalfLife halves a feedback's weight every 30 days.
+const DefaultFeedbackDecayHalfLife = 30 * 24 * time.Hour
+
+// DefaultActionLogRetentionEntries keeps the latest 10k entries per agent;
+// older ones stay provable against the agent's action log commitment.
+const DefaultActionLogRetentionEntries = 10_000
+
+// DefaultActionLogPruneLimit bounds the pruning work done per block.
+const DefaultActionLogPruneLimit = 100
+
+// DefaultPaymentStreamProcessLimit bounds the payment streams processed per
+// block.
+const DefaultPaymentStreamProcessLimit = 200
+
+// DefaultMaxSubAgentDepth and DefaultMaxSubAgents bound an agent tree, and so
+// the work of a cascading deactivation and of the per-transfer ancestor walk.
+const (
+	DefaultMaxSubAgentDepth = 3
+	DefaultMaxSubAgents     = 16
+)
+
+// DefaultAttestationMaxAge rejects attestation tokens issued more than 15
+// minutes before the block time.
+const DefaultAttestationMaxAge = 15 * time.Minute
+
 func DefaultParams() Params {
 	return Params{
 		AgentRegistrationFee:      commontypes.DYMCoin,
@@ -22,6 +48,13 @@ func DefaultParams() Params {
 		PolicyRotationDelayBlocks: DefaultPolicyRotationDelayBlocks,
 		FeedbackFee:               commontypes.DYMCoin,
 		FeedbackTagMaxBytes:       DefaultFeedbackTagMaxBytes,
+		FeedbackDecayHalfLife:     DefaultFeedbackDecayHalfLife,
+		ActionLogRetentionEntries: DefaultActionLogRetentionEntries,
+		ActionLogPruneLimit:       DefaultActionLogPruneLimit,
+		MaxSubAgentDepth:          DefaultMaxSubAgentDepth,
+		MaxSubAgents:              DefaultMaxSubAgents,
+		AttestationMaxAge:         DefaultAttestationMaxAge,
+		PaymentStreamProcessLimit: DefaultPaymentStreamProcessLimit,
 	}
 }
 
@@ -41,5 +74,17 @@ func (p Params) Validate() error {
 	if p.FeedbackTagMaxBytes == 0 {
 		return fmt.Errorf("feedback tag max bytes must be positive")
 	}
+	if p.FeedbackDecayHalfLife < 0 {
+		return fmt.Errorf("feedback decay half life must not be negative")
+	}
+	if p.ActionLogPruneLimit == 0 {
+		return fmt.Errorf("action log prune limit must be positive")
+	}
+	if p.AttestationMaxAge <= 0 {
+		return fmt.Errorf("attestation max age must be positive")
+	}
+	if p.PaymentStreamProcessLimit == 0 {
+		return fmt.Errorf("payment stream process limit must be positive")
+	}
 	return nil
 }
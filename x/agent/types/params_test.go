@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -14,4 +15,26 @@ func TestParams_Validate(t *testing.T) {
 	p := types.DefaultParams()
 	p.PolicyRotationDelayBlocks = 0
 	require.Error(t, p.Validate())
+
+	p = types.DefaultParams()
+	p.FeedbackDecayHalfLife = 0
+	require.NoError(t, p.Validate())
+	p.FeedbackDecayHalfLife = -time.Second
+	require.Error(t, p.Validate())
+
+	// zero retention keeps everything, but pruning must make progress
+	p = types.DefaultParams()
+	p.ActionLogRetentionEntries = 0
+	p.ActionLogRetentionBlocks = 0
+	require.NoError(t, p.Validate())
+	p.ActionLogPruneLimit = 0
+	require.Error(t, p.Validate())
+
+	p = types.DefaultParams()
+	p.AttestationMaxAge = 0
+	require.Error(t, p.Validate())
+
+	p = types.DefaultParams()
+	p.PaymentStreamProcessLimit = 0
+	require.Error(t, p.Validate())
 }
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MinPaymentStreamPeriod bounds how often a stream can fall due, and so
+	// the end blocker's work per stream.
+	MinPaymentStreamPeriod = time.Minute
+	// MaxPaymentStreamsPerAgent bounds the streams an agent may have open.
+	MaxPaymentStreamsPerAgent = 16
+)
+
+// DuePeriods returns how many periods of the stream have fallen due at now
+// and are not yet paid or missed. Periods at or after end_time never fall
+// due.
+func (s PaymentStream) DuePeriods(now time.Time) uint64 {
+	if now.Before(s.NextPaymentTime) || s.Done() {
+		return 0
+	}
+	due := uint64(now.Sub(s.NextPaymentTime)/s.Period) + 1
+	// ceil((end - next) / period)
+	left := uint64((s.EndTime.Sub(s.NextPaymentTime) + s.Period - 1) / s.Period)
+	return min(due, left)
+}
+
+// PeriodsAmount is what paying the given number of periods costs.
+func (s PaymentStream) PeriodsAmount(periods uint64) sdk.Coin {
+	return sdk.NewCoin(s.AmountPerPeriod.Denom, s.AmountPerPeriod.Amount.Mul(math.NewIntFromUint64(periods)))
+}
+
+// Advance moves next_payment_time past the given number of periods.
+func (s *PaymentStream) Advance(periods uint64) {
+	s.NextPaymentTime = s.NextPaymentTime.Add(time.Duration(periods) * s.Period) //nolint:gosec // periods is bounded by DuePeriods
+}
+
+// Done reports whether the stream has no period left to fall due.
+func (s PaymentStream) Done() bool {
+	return !s.NextPaymentTime.Before(s.EndTime)
+}
+
+func (s PaymentStream) Validate() error {
+	if s.AgentId == "" {
+		return fmt.Errorf("empty agent id")
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Recipient); err != nil {
+		return fmt.Errorf("recipient address: %w", err)
+	}
+	if err := validatePaymentStreamTerms(s.AmountPerPeriod, s.Period, s.NextPaymentTime, s.EndTime); err != nil {
+		return err
+	}
+	if s.Paid.IsNil() || s.Paid.IsNegative() {
+		return fmt.Errorf("paid must be non-negative")
+	}
+	return nil
+}
+
+func validatePaymentStreamTerms(amount sdk.Coin, period time.Duration, start, end time.Time) error {
+	if err := amount.Validate(); err != nil {
+		return fmt.Errorf("amount per period: %w", err)
+	}
+	if !amount.IsPositive() {
+		return fmt.Errorf("amount per period must be positive")
+	}
+	if period < MinPaymentStreamPeriod {
+		return fmt.Errorf("period must be at least %s: got %s", MinPaymentStreamPeriod, period)
+	}
+	if end.IsZero() {
+		return fmt.Errorf("end time must be set")
+	}
+	if !start.IsZero() && !start.Before(end) {
+		return fmt.Errorf("start time %s not before end time %s", start, end)
+	}
+	return nil
+}
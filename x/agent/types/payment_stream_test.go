@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+)
+
+func TestPaymentStreamDuePeriods(t *testing.T) {
+	t0 := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := types.PaymentStream{
+		AmountPerPeriod: sdk.NewCoin("adym", math.NewInt(5)),
+		Period:          time.Hour,
+		NextPaymentTime: t0,
+		// not a whole number of periods: the period starting at t0+2h is the last
+		EndTime: t0.Add(150 * time.Minute),
+	}
+
+	require.Zero(t, s.DuePeriods(t0.Add(-time.Second)))
+	require.Equal(t, uint64(1), s.DuePeriods(t0))
+	require.Equal(t, uint64(1), s.DuePeriods(t0.Add(59*time.Minute)))
+	require.Equal(t, uint64(2), s.DuePeriods(t0.Add(time.Hour)))
+	// capped at the periods left before end_time
+	require.Equal(t, uint64(3), s.DuePeriods(t0.Add(100*time.Hour)))
+	require.Equal(t, sdk.NewCoin("adym", math.NewInt(15)), s.PeriodsAmount(3))
+
+	s.Advance(2)
+	require.False(t, s.Done())
+	require.Equal(t, uint64(1), s.DuePeriods(t0.Add(100*time.Hour)))
+	s.Advance(1)
+	require.True(t, s.Done())
+	require.Zero(t, s.DuePeriods(t0.Add(100*time.Hour)))
+}
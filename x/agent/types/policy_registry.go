@@ -0,0 +1,147 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+func NewMsgRegisterPolicy(admin string, policy tee.Policy) *MsgRegisterPolicy {
+	return &MsgRegisterPolicy{
+		Admin:  admin,
+		Policy: policy,
+	}
+}
+
+func (m *MsgRegisterPolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "admin address")
+	}
+	return validatePolicy(m.Policy)
+}
+
+func NewMsgSupersedePolicy(authority, id string, policy tee.Policy) *MsgSupersedePolicy {
+	return &MsgSupersedePolicy{
+		Authority: authority,
+		Id:        id,
+		NewPolicy: policy,
+	}
+}
+
+func (m *MsgSupersedePolicy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "authority address")
+	}
+	if err := ValidateFingerprint(m.Id); err != nil {
+		return err
+	}
+	return validatePolicy(m.NewPolicy)
+}
+
+// RegisteredPolicyID is the id of the lineage admin registers policy under:
+// the hash of the admin and the policy fingerprint, in the fingerprint format.
+func RegisteredPolicyID(admin string, policy tee.Policy) (string, error) {
+	fp, err := PolicyFingerprint(policy)
+	if err != nil {
+		return "", errorsmod.Wrap(err, "policy fingerprint")
+	}
+	h := sha256.Sum256([]byte(admin + "/" + fp))
+	return hex.EncodeToString(h[:]), nil
+}
+
+// IsHead reports whether the policy is the latest version of its lineage.
+func (p RegisteredPolicy) IsHead() bool {
+	return p.SupersededBy == ""
+}
+
+// Validate checks the policy is well-formed and addressed by its admin and
+// fingerprint.
+func (p RegisteredPolicy) Validate() error {
+	if err := validatePolicy(p.Policy); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(p.Admin); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "admin address")
+	}
+	id, err := RegisteredPolicyID(p.Admin, p.Policy)
+	if err != nil {
+		return err
+	}
+	if p.Id != id {
+		return gerrc.ErrInvalidArgument.Wrapf("id %s is not the registered policy id %s", p.Id, id)
+	}
+	if p.Version == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("zero version")
+	}
+	if (p.Version == 1) != (p.Supersedes == "") {
+		return gerrc.ErrInvalidArgument.Wrap("only version 1 has no predecessor")
+	}
+	return nil
+}
+
+// validateRegisteredPolicies checks each policy and that supersession links
+// form linear lineages: successor and predecessor point at each other, the
+// successor's version is one more and the admin carries over.
+func validateRegisteredPolicies(policies []RegisteredPolicy) error {
+	byID := make(map[string]RegisteredPolicy, len(policies))
+	for _, p := range policies {
+		if err := p.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "registered policy %s", p.Id)
+		}
+		if _, dup := byID[p.Id]; dup {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate registered policy: %s", p.Id)
+		}
+		byID[p.Id] = p
+	}
+	for _, p := range policies {
+		if p.Supersedes == "" {
+			continue
+		}
+		prev, ok := byID[p.Supersedes]
+		if !ok || prev.SupersededBy != p.Id {
+			return gerrc.ErrInvalidArgument.Wrapf("registered policy %s: predecessor %s does not link to it", p.Id, p.Supersedes)
+		}
+		if prev.Version+1 != p.Version || prev.Admin != p.Admin {
+			return gerrc.ErrInvalidArgument.Wrapf("registered policy %s: version or admin does not follow %s", p.Id, p.Supersedes)
+		}
+	}
+	for _, p := range policies {
+		if p.SupersededBy == "" {
+			continue
+		}
+		if next, ok := byID[p.SupersededBy]; !ok || next.Supersedes != p.Id {
+			return gerrc.ErrInvalidArgument.Wrapf("registered policy %s: successor %s does not link back", p.Id, p.SupersededBy)
+		}
+	}
+	return nil
+}
+
+// validatePolicyRefs checks every registered policy an agent binds to exists,
+// and that a pending policy id comes with the pending policy it resolved to.
+func (g GenesisState) validatePolicyRefs() error {
+	registered := make(map[string]struct{}, len(g.RegisteredPolicies))
+	for _, p := range g.RegisteredPolicies {
+		registered[p.Id] = struct{}{}
+	}
+	for _, a := range g.Agents {
+		if _, ok := registered[a.PolicyId]; a.PolicyId != "" && !ok {
+			return fmt.Errorf("agent %s: unknown registered policy: %s", a.Id, a.PolicyId)
+		}
+		if a.PendingPolicyId == "" {
+			continue
+		}
+		if a.PendingPolicy == nil {
+			return fmt.Errorf("agent %s: pending policy id without pending policy", a.Id)
+		}
+		if _, ok := registered[a.PendingPolicyId]; !ok {
+			return fmt.Errorf("agent %s: unknown registered policy: %s", a.Id, a.PendingPolicyId)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+// MaxCoPolicies bounds the attestations verified per operation.
+const MaxCoPolicies = 7
+
+// ValidateBasic checks that the co-policies are well-formed and pairwise
+// distinct, and that the quorum is reachable.
+func (s PolicySet) ValidateBasic() error {
+	if len(s.CoPolicies) > MaxCoPolicies {
+		return errorsmod.Wrapf(ErrInvalidPolicy, "%d co-policies exceed max %d", len(s.CoPolicies), MaxCoPolicies)
+	}
+	fps, err := s.CoFingerprints()
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]struct{}, len(fps))
+	for i, p := range s.CoPolicies {
+		if err := validatePolicy(p); err != nil {
+			return errorsmod.Wrapf(err, "co-policy %d", i+1)
+		}
+		if _, dup := seen[fps[i]]; dup {
+			return errorsmod.Wrapf(ErrInvalidPolicy, "duplicate co-policy %d", i+1)
+		}
+		seen[fps[i]] = struct{}{}
+	}
+	if n := uint32(len(s.CoPolicies)) + 1; s.Quorum.Required > n { //nolint:gosec // bounded by MaxCoPolicies
+		return errorsmod.Wrapf(ErrInvalidPolicy, "quorum %d exceeds %d policies", s.Quorum.Required, n)
+	}
+	if err := s.Quorum.TransferThreshold.Validate(); err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "transfer threshold: %s", err)
+	}
+	return nil
+}
+
+// CoFingerprints returns the co-policy fingerprints in policy index order.
+func (s PolicySet) CoFingerprints() ([]string, error) {
+	fps := make([]string, 0, len(s.CoPolicies))
+	for _, p := range s.CoPolicies {
+		fp, err := PolicyFingerprint(p)
+		if err != nil {
+			return nil, err
+		}
+		fps = append(fps, fp)
+	}
+	return fps, nil
+}
+
+// Includes reports whether p is one of the co-policies, compared by
+// fingerprint.
+func (s PolicySet) Includes(p tee.Policy) (bool, error) {
+	fp, err := PolicyFingerprint(p)
+	if err != nil {
+		return false, err
+	}
+	fps, err := s.CoFingerprints()
+	if err != nil {
+		return false, err
+	}
+	for _, co := range fps {
+		if co == fp {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// RequiredAttestations returns how many distinct policies must attest an
+// operation: a transfer of transfer, or an action when transfer is nil.
+func (q AttestationQuorum) RequiredAttestations(transfer *sdk.Coin) uint32 {
+	if q.Required <= 1 {
+		return 1
+	}
+	if transfer == nil {
+		if q.Actions {
+			return q.Required
+		}
+		return 1
+	}
+	if ok, threshold := q.TransferThreshold.Find(transfer.Denom); ok && transfer.Amount.LT(threshold.Amount) {
+		return 1
+	}
+	return q.Required
+}
+
+// CoPolicy returns the co-policy at policy index, which starts at 1; index 0
+// is the primary policy.
+func (a Agent) CoPolicy(index uint32) (tee.Policy, bool) {
+	if index == 0 || int(index) > len(a.PolicySet.CoPolicies) {
+		return tee.Policy{}, false
+	}
+	return a.PolicySet.CoPolicies[index-1], true
+}
+
+// PolicySets returns the agent's policy set and, if scheduled, its pending
+// replacement.
+func (a Agent) PolicySets() []PolicySet {
+	if a.PendingPolicySet != nil {
+		return []PolicySet{a.PolicySet, *a.PendingPolicySet}
+	}
+	return []PolicySet{a.PolicySet}
+}
+
+// PrimaryPolicies returns the agent's primary policy and, if scheduled, its
+// pending rotation.
+func (a Agent) PrimaryPolicies() []tee.Policy {
+	if a.PendingPolicy != nil {
+		return []tee.Policy{a.Policy, *a.PendingPolicy}
+	}
+	return []tee.Policy{a.Policy}
+}
+
+// EffectivePolicySet returns the policy set in force at height without
+// mutating the stored agent.
+func (a Agent) EffectivePolicySet(height int64) PolicySet {
+	a.PromotePendingPolicy(height)
+	return a.PolicySet
+}
+
+// ValidatePolicySetState checks the agent's policy set and any pending update
+// are valid and never list the primary policy as a co-policy.
+func (a Agent) ValidatePolicySetState() error {
+	if err := validatePolicySetFor(a.Policy, a.PolicySet); err != nil {
+		return errorsmod.Wrap(err, "policy set")
+	}
+	if a.PendingPolicySet != nil {
+		if err := a.PendingPolicySet.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "pending policy set")
+		}
+	} else if a.PendingPolicySetHeight != 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "pending policy set height set without pending policy set")
+	}
+	return nil
+}
+
+// validateCoAttestations checks the co-attestations name distinct co-policy
+// indices and carry tokens.
+func validateCoAttestations(atts []CoAttestation) error {
+	if len(atts) > MaxCoPolicies {
+		return gerrc.ErrInvalidArgument.Wrapf("%d co-attestations exceed max %d", len(atts), MaxCoPolicies)
+	}
+	seen := make(map[uint32]struct{}, len(atts))
+	for _, att := range atts {
+		if att.PolicyIndex == 0 {
+			return gerrc.ErrInvalidArgument.Wrap("co-attestation policy index must be positive")
+		}
+		if _, dup := seen[att.PolicyIndex]; dup {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate co-attestation for policy index %d", att.PolicyIndex)
+		}
+		seen[att.PolicyIndex] = struct{}{}
+		if att.Token == "" {
+			return gerrc.ErrInvalidArgument.Wrapf("empty co-attestation token for policy index %d", att.PolicyIndex)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+func TestPolicySet_ValidateBasic(t *testing.T) {
+	cert := validCertPEM(t)
+	policy := func(q string) tee.Policy {
+		return tee.Policy{GcpRootCertPem: cert, PolicyValues: "{}", PolicyQuery: q, PolicyStructure: "package x"}
+	}
+	primary := policy("primary")
+
+	for _, tc := range []struct {
+		name    string
+		set     types.PolicySet
+		wantErr bool
+	}{
+		{"empty", types.PolicySet{}, false},
+		{"two of three", types.PolicySet{
+			CoPolicies: []tee.Policy{policy("a"), policy("b")},
+			Quorum:     types.AttestationQuorum{Required: 2, TransferThreshold: sdk.NewCoins(sdk.NewInt64Coin("adym", 100))},
+		}, false},
+		{"quorum exceeds policies", types.PolicySet{
+			CoPolicies: []tee.Policy{policy("a")},
+			Quorum:     types.AttestationQuorum{Required: 3},
+		}, true},
+		{"duplicate co-policy", types.PolicySet{CoPolicies: []tee.Policy{policy("a"), policy("a")}}, true},
+		{"primary as co-policy", types.PolicySet{CoPolicies: []tee.Policy{primary}}, true},
+		{"malformed co-policy", types.PolicySet{CoPolicies: []tee.Policy{{GcpRootCertPem: cert}}}, true},
+		{"invalid threshold", types.PolicySet{
+			Quorum: types.AttestationQuorum{TransferThreshold: sdk.Coins{{Denom: "adym", Amount: math.NewInt(-1)}}},
+		}, true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := types.NewMsgRegisterAgent(sampleOwner, "a1", primary)
+			msg.PolicySet = tc.set
+			err := msg.ValidateBasic()
+			if tc.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAttestationQuorum_RequiredAttestations(t *testing.T) {
+	q := types.AttestationQuorum{Required: 2, TransferThreshold: sdk.NewCoins(sdk.NewInt64Coin("adym", 100))}
+
+	require.Equal(t, uint32(1), q.RequiredAttestations(nil))
+	below := sdk.NewInt64Coin("adym", 99)
+	require.Equal(t, uint32(1), q.RequiredAttestations(&below))
+	at := sdk.NewInt64Coin("adym", 100)
+	require.Equal(t, uint32(2), q.RequiredAttestations(&at))
+	// denoms without a threshold always need the quorum
+	unlisted := sdk.NewInt64Coin("uusdc", 1)
+	require.Equal(t, uint32(2), q.RequiredAttestations(&unlisted))
+
+	q.Actions = true
+	require.Equal(t, uint32(2), q.RequiredAttestations(nil))
+
+	// a quorum of one is a single attestation regardless
+	require.Equal(t, uint32(1), types.AttestationQuorum{Required: 1, Actions: true}.RequiredAttestations(nil))
+}
+
+func TestMsgSubmitAttestedAction_CoAttestations(t *testing.T) {
+	msg := &types.MsgSubmitAttestedAction{Submitter: sampleOwner, AgentId: "a1", Token: "t"}
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 1, Token: "t1"}, {PolicyIndex: 2, Token: "t2"}}
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 1, Token: "t1"}, {PolicyIndex: 1, Token: "t2"}}
+	require.Error(t, msg.ValidateBasic())
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 0, Token: "t1"}}
+	require.Error(t, msg.ValidateBasic())
+	msg.CoAttestations = []types.CoAttestation{{PolicyIndex: 1}}
+	require.Error(t, msg.ValidateBasic())
+}
@@ -44,6 +44,61 @@ func TestAgentProtoFieldCompatibility(t *testing.T) {
 				"bytes,12,opt,name=spend_window_spent,json=spendWindowSpent,proto3,customtype=cosmossdk.io/math.Int",
 				reflect.TypeOf(math.Int{}),
 			},
+			"SpendBudgets": {
+				"bytes,13,rep,name=spend_budgets,json=spendBudgets,proto3",
+				reflect.TypeOf([]types.SpendBudget(nil)),
+			},
+			"SpendWindowMode": {
+				"varint,14,opt,name=spend_window_mode,json=spendWindowMode,proto3,enum=dymensionxyz.dymension.agent.SpendWindowMode",
+				reflect.TypeOf(types.SpendWindowMode(0)),
+			},
+			"SpendWindowDuration": {
+				"bytes,15,opt,name=spend_window_duration,json=spendWindowDuration,proto3,stdduration",
+				reflect.TypeOf(time.Duration(0)),
+			},
+			"SpendWindowStartTime": {
+				"bytes,16,opt,name=spend_window_start_time,json=spendWindowStartTime,proto3,stdtime",
+				reflect.TypeOf(time.Time{}),
+			},
+			"SpendRecentSlots": {
+				"bytes,17,rep,name=spend_recent_slots,json=spendRecentSlots,proto3",
+				reflect.TypeOf([]types.SpendSlot(nil)),
+			},
+			"Security": {
+				"bytes,18,opt,name=security,proto3",
+				reflect.TypeOf(types.SecurityPolicy{}),
+			},
+			"PendingSecurity": {
+				"bytes,19,opt,name=pending_security,json=pendingSecurity,proto3",
+				reflect.TypeOf((*types.SecurityPolicy)(nil)),
+			},
+			"PendingSecurityHeight": {
+				"varint,20,opt,name=pending_security_height,json=pendingSecurityHeight,proto3",
+				reflect.TypeOf(int64(0)),
+			},
+			"Frozen": {"varint,21,opt,name=frozen,proto3", reflect.TypeOf(false)},
+			"PolicySet": {
+				"bytes,22,opt,name=policy_set,json=policySet,proto3",
+				reflect.TypeOf(types.PolicySet{}),
+			},
+			"PendingPolicySet": {
+				"bytes,23,opt,name=pending_policy_set,json=pendingPolicySet,proto3",
+				reflect.TypeOf(&types.PolicySet{}),
+			},
+			"PendingPolicySetHeight": {
+				"varint,24,opt,name=pending_policy_set_height,json=pendingPolicySetHeight,proto3",
+				reflect.TypeOf(int64(0)),
+			},
+			"ParentId": {"bytes,25,opt,name=parent_id,json=parentId,proto3", reflect.TypeOf("")},
+			"TotalSpent": {
+				"bytes,26,rep,name=total_spent,json=totalSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins",
+				reflect.TypeOf(sdk.Coins{}),
+			},
+			"PolicyId": {"bytes,27,opt,name=policy_id,json=policyId,proto3", reflect.TypeOf("")},
+			"PendingPolicyId": {
+				"bytes,28,opt,name=pending_policy_id,json=pendingPolicyId,proto3",
+				reflect.TypeOf(""),
+			},
 		}},
 		{types.Params{}, map[string]fieldContract{
 			"MaxActionBytes":            {"varint,1,opt,name=max_action_bytes,json=maxActionBytes,proto3", reflect.TypeOf(uint64(0))},
@@ -51,6 +106,20 @@ func TestAgentProtoFieldCompatibility(t *testing.T) {
 			"PolicyRotationDelayBlocks": {"varint,3,opt,name=policy_rotation_delay_blocks,json=policyRotationDelayBlocks,proto3", reflect.TypeOf(uint64(0))},
 			"FeedbackFee":               {"bytes,4,opt,name=feedback_fee,json=feedbackFee,proto3", reflect.TypeOf(types.DefaultParams().FeedbackFee)},
 			"FeedbackTagMaxBytes":       {"varint,5,opt,name=feedback_tag_max_bytes,json=feedbackTagMaxBytes,proto3", reflect.TypeOf(uint64(0))},
+			"FeedbackDecayHalfLife": {
+				"bytes,6,opt,name=feedback_decay_half_life,json=feedbackDecayHalfLife,proto3,stdduration",
+				reflect.TypeOf(time.Duration(0)),
+			},
+			"ActionLogRetentionEntries": {"varint,7,opt,name=action_log_retention_entries,json=actionLogRetentionEntries,proto3", reflect.TypeOf(uint64(0))},
+			"ActionLogRetentionBlocks":  {"varint,8,opt,name=action_log_retention_blocks,json=actionLogRetentionBlocks,proto3", reflect.TypeOf(uint64(0))},
+			"ActionLogPruneLimit":       {"varint,9,opt,name=action_log_prune_limit,json=actionLogPruneLimit,proto3", reflect.TypeOf(uint64(0))},
+			"MaxSubAgentDepth":          {"varint,10,opt,name=max_sub_agent_depth,json=maxSubAgentDepth,proto3", reflect.TypeOf(uint64(0))},
+			"MaxSubAgents":              {"varint,11,opt,name=max_sub_agents,json=maxSubAgents,proto3", reflect.TypeOf(uint64(0))},
+			"AttestationMaxAge": {
+				"bytes,12,opt,name=attestation_max_age,json=attestationMaxAge,proto3,stdduration",
+				reflect.TypeOf(time.Duration(0)),
+			},
+			"PaymentStreamProcessLimit": {"varint,13,opt,name=payment_stream_process_limit,json=paymentStreamProcessLimit,proto3", reflect.TypeOf(uint64(0))},
 		}},
 		{types.ActionLogEntry{}, map[string]fieldContract{
 			"AgentId":     {"bytes,1,opt,name=agent_id,json=agentId,proto3", reflect.TypeOf("")},
@@ -59,6 +128,8 @@ func TestAgentProtoFieldCompatibility(t *testing.T) {
 			"PayloadHash": {"bytes,4,opt,name=payload_hash,json=payloadHash,proto3", reflect.TypeOf([]byte(nil))},
 			"Height":      {"varint,5,opt,name=height,proto3", reflect.TypeOf(int64(0))},
 			"Time":        {"bytes,6,opt,name=time,proto3,stdtime", reflect.TypeOf(time.Time{})},
+			"Submitter":   {"bytes,7,opt,name=submitter,proto3", reflect.TypeOf("")},
+			"Recipient":   {"bytes,8,opt,name=recipient,proto3", reflect.TypeOf("")},
 		}},
 		{types.EventRegisterAgent{}, map[string]fieldContract{
 			"AgentId":     {"bytes,1,opt,name=agent_id,json=agentId,proto3", reflect.TypeOf("")},
@@ -103,11 +174,21 @@ func TestAgentProtoRoundTripCompatibility(t *testing.T) {
 		{&types.Agent{
 			Id: "agent-1", Policy: policy, Active: true, ActionSeq: 7,
 			Owner: "dym1owner", PendingPolicy: &policy, PendingPolicyHeight: 42,
+			PolicySet: types.PolicySet{
+				CoPolicies: []tee.Policy{policy},
+				Quorum:     types.AttestationQuorum{Required: 2, TransferThreshold: sdk.NewCoins(sdk.NewInt64Coin("adym", 5)), Actions: true},
+			},
+			PendingPolicySet: &types.PolicySet{}, PendingPolicySetHeight: 43,
+			ParentId: "agent-0", TotalSpent: sdk.NewCoins(sdk.NewInt64Coin("adym", 9)),
+			PolicyId: "policy-1", PendingPolicyId: "policy-2",
 		}, &types.Agent{}},
 		{&types.Params{
 			MaxActionBytes: 1024, AgentRegistrationFee: sdk.NewInt64Coin("adym", 12),
 			PolicyRotationDelayBlocks: 99, FeedbackFee: sdk.NewInt64Coin("adym", 34),
-			FeedbackTagMaxBytes: 56,
+			FeedbackTagMaxBytes: 56, FeedbackDecayHalfLife: time.Hour,
+			ActionLogRetentionEntries: 78, ActionLogRetentionBlocks: 90, ActionLogPruneLimit: 12,
+			MaxSubAgentDepth: 3, MaxSubAgents: 4, AttestationMaxAge: time.Minute,
+			PaymentStreamProcessLimit: 5,
 		}, &types.Params{}},
 		{&types.ActionLogEntry{
 			AgentId: "agent-1", Seq: 7, Payload: []byte("payload"),
@@ -165,6 +165,9 @@ type QueryAgentResponse struct {
 	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 	// revoked reports whether the agent's policy fingerprint is revoked.
 	Revoked bool `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// co_fingerprints are the fingerprints of the agent's effective co-policies,
+	// in policy index order.
+	CoFingerprints []string `protobuf:"bytes,4,rep,name=co_fingerprints,json=coFingerprints,proto3" json:"co_fingerprints,omitempty"`
 }
 
 func (m *QueryAgentResponse) Reset()         { *m = QueryAgentResponse{} }
@@ -221,6 +224,13 @@ func (m *QueryAgentResponse) GetRevoked() bool {
 	return false
 }
 
+func (m *QueryAgentResponse) GetCoFingerprints() []string {
+	if m != nil {
+		return m.CoFingerprints
+	}
+	return nil
+}
+
 type QueryAgentsRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -517,22 +527,22 @@ func (m *QueryAgentActionResponse) GetAction() ActionLogEntry {
 	return ActionLogEntry{}
 }
 
-type QueryEscrowBalanceRequest struct {
+type QueryActionLogCommitmentRequest struct {
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 }
 
-func (m *QueryEscrowBalanceRequest) Reset()         { *m = QueryEscrowBalanceRequest{} }
-func (m *QueryEscrowBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryEscrowBalanceRequest) ProtoMessage()    {}
-func (*QueryEscrowBalanceRequest) Descriptor() ([]byte, []int) {
+func (m *QueryActionLogCommitmentRequest) Reset()         { *m = QueryActionLogCommitmentRequest{} }
+func (m *QueryActionLogCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActionLogCommitmentRequest) ProtoMessage()    {}
+func (*QueryActionLogCommitmentRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{10}
 }
-func (m *QueryEscrowBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryActionLogCommitmentRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEscrowBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryActionLogCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEscrowBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryActionLogCommitmentRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -542,44 +552,41 @@ func (m *QueryEscrowBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryEscrowBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEscrowBalanceRequest.Merge(m, src)
+func (m *QueryActionLogCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionLogCommitmentRequest.Merge(m, src)
 }
-func (m *QueryEscrowBalanceRequest) XXX_Size() int {
+func (m *QueryActionLogCommitmentRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEscrowBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEscrowBalanceRequest.DiscardUnknown(m)
+func (m *QueryActionLogCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionLogCommitmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEscrowBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryActionLogCommitmentRequest proto.InternalMessageInfo
 
-func (m *QueryEscrowBalanceRequest) GetAgentId() string {
+func (m *QueryActionLogCommitmentRequest) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-type QueryEscrowBalanceResponse struct {
-	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
-	// remaining_window_budget is the spend budget left in the rate window at the
-	// current height. Zero when spending is disabled.
-	RemainingWindowBudget cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remaining_window_budget,json=remainingWindowBudget,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_window_budget"`
+type QueryActionLogCommitmentResponse struct {
+	Commitment ActionLogCommitment `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment"`
 }
 
-func (m *QueryEscrowBalanceResponse) Reset()         { *m = QueryEscrowBalanceResponse{} }
-func (m *QueryEscrowBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryEscrowBalanceResponse) ProtoMessage()    {}
-func (*QueryEscrowBalanceResponse) Descriptor() ([]byte, []int) {
+func (m *QueryActionLogCommitmentResponse) Reset()         { *m = QueryActionLogCommitmentResponse{} }
+func (m *QueryActionLogCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActionLogCommitmentResponse) ProtoMessage()    {}
+func (*QueryActionLogCommitmentResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{11}
 }
-func (m *QueryEscrowBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryActionLogCommitmentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryEscrowBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryActionLogCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryEscrowBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryActionLogCommitmentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -589,41 +596,42 @@ func (m *QueryEscrowBalanceResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryEscrowBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryEscrowBalanceResponse.Merge(m, src)
+func (m *QueryActionLogCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionLogCommitmentResponse.Merge(m, src)
 }
-func (m *QueryEscrowBalanceResponse) XXX_Size() int {
+func (m *QueryActionLogCommitmentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryEscrowBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryEscrowBalanceResponse.DiscardUnknown(m)
+func (m *QueryActionLogCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionLogCommitmentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryEscrowBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryActionLogCommitmentResponse proto.InternalMessageInfo
 
-func (m *QueryEscrowBalanceResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+func (m *QueryActionLogCommitmentResponse) GetCommitment() ActionLogCommitment {
 	if m != nil {
-		return m.Balance
+		return m.Commitment
 	}
-	return nil
+	return ActionLogCommitment{}
 }
 
-type QueryAgentReputationRequest struct {
+type QueryActionLogProofRequest struct {
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Seq     uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *QueryAgentReputationRequest) Reset()         { *m = QueryAgentReputationRequest{} }
-func (m *QueryAgentReputationRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAgentReputationRequest) ProtoMessage()    {}
-func (*QueryAgentReputationRequest) Descriptor() ([]byte, []int) {
+func (m *QueryActionLogProofRequest) Reset()         { *m = QueryActionLogProofRequest{} }
+func (m *QueryActionLogProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryActionLogProofRequest) ProtoMessage()    {}
+func (*QueryActionLogProofRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{12}
 }
-func (m *QueryAgentReputationRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryActionLogProofRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAgentReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryActionLogProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAgentReputationRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryActionLogProofRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -633,43 +641,53 @@ func (m *QueryAgentReputationRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAgentReputationRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAgentReputationRequest.Merge(m, src)
+func (m *QueryActionLogProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionLogProofRequest.Merge(m, src)
 }
-func (m *QueryAgentReputationRequest) XXX_Size() int {
+func (m *QueryActionLogProofRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAgentReputationRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAgentReputationRequest.DiscardUnknown(m)
+func (m *QueryActionLogProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionLogProofRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAgentReputationRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryActionLogProofRequest proto.InternalMessageInfo
 
-func (m *QueryAgentReputationRequest) GetAgentId() string {
+func (m *QueryActionLogProofRequest) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-type QueryAgentReputationResponse struct {
-	Reputation Reputation `protobuf:"bytes,1,opt,name=reputation,proto3" json:"reputation"`
-	// average_score is score_sum / count via integer division; 0 when count is 0.
-	AverageScore uint32 `protobuf:"varint,2,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+func (m *QueryActionLogProofRequest) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
 }
 
-func (m *QueryAgentReputationResponse) Reset()         { *m = QueryAgentReputationResponse{} }
-func (m *QueryAgentReputationResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAgentReputationResponse) ProtoMessage()    {}
-func (*QueryAgentReputationResponse) Descriptor() ([]byte, []int) {
+type QueryActionLogProofResponse struct {
+	Proof ActionLogProof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof"`
+	// root is the accumulator root the proof verifies against.
+	Root []byte `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// action is the entry itself while it is retained in state; nil once
+	// pruned.
+	Action *ActionLogEntry `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (m *QueryActionLogProofResponse) Reset()         { *m = QueryActionLogProofResponse{} }
+func (m *QueryActionLogProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryActionLogProofResponse) ProtoMessage()    {}
+func (*QueryActionLogProofResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{13}
 }
-func (m *QueryAgentReputationResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryActionLogProofResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAgentReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryActionLogProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAgentReputationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryActionLogProofResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -679,49 +697,55 @@ func (m *QueryAgentReputationResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAgentReputationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAgentReputationResponse.Merge(m, src)
+func (m *QueryActionLogProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryActionLogProofResponse.Merge(m, src)
 }
-func (m *QueryAgentReputationResponse) XXX_Size() int {
+func (m *QueryActionLogProofResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAgentReputationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAgentReputationResponse.DiscardUnknown(m)
+func (m *QueryActionLogProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryActionLogProofResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAgentReputationResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryActionLogProofResponse proto.InternalMessageInfo
 
-func (m *QueryAgentReputationResponse) GetReputation() Reputation {
+func (m *QueryActionLogProofResponse) GetProof() ActionLogProof {
 	if m != nil {
-		return m.Reputation
+		return m.Proof
 	}
-	return Reputation{}
+	return ActionLogProof{}
 }
 
-func (m *QueryAgentReputationResponse) GetAverageScore() uint32 {
+func (m *QueryActionLogProofResponse) GetRoot() []byte {
 	if m != nil {
-		return m.AverageScore
+		return m.Root
 	}
-	return 0
+	return nil
 }
 
-type QueryAgentFeedbackRequest struct {
+func (m *QueryActionLogProofResponse) GetAction() *ActionLogEntry {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+type QueryEscrowBalanceRequest struct {
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Client  string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
 }
 
-func (m *QueryAgentFeedbackRequest) Reset()         { *m = QueryAgentFeedbackRequest{} }
-func (m *QueryAgentFeedbackRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAgentFeedbackRequest) ProtoMessage()    {}
-func (*QueryAgentFeedbackRequest) Descriptor() ([]byte, []int) {
+func (m *QueryEscrowBalanceRequest) Reset()         { *m = QueryEscrowBalanceRequest{} }
+func (m *QueryEscrowBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowBalanceRequest) ProtoMessage()    {}
+func (*QueryEscrowBalanceRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{14}
 }
-func (m *QueryAgentFeedbackRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryEscrowBalanceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAgentFeedbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEscrowBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAgentFeedbackRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEscrowBalanceRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -731,48 +755,47 @@ func (m *QueryAgentFeedbackRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryAgentFeedbackRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAgentFeedbackRequest.Merge(m, src)
+func (m *QueryEscrowBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowBalanceRequest.Merge(m, src)
 }
-func (m *QueryAgentFeedbackRequest) XXX_Size() int {
+func (m *QueryEscrowBalanceRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAgentFeedbackRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAgentFeedbackRequest.DiscardUnknown(m)
+func (m *QueryEscrowBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowBalanceRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAgentFeedbackRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryEscrowBalanceRequest proto.InternalMessageInfo
 
-func (m *QueryAgentFeedbackRequest) GetAgentId() string {
+func (m *QueryEscrowBalanceRequest) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-func (m *QueryAgentFeedbackRequest) GetClient() string {
-	if m != nil {
-		return m.Client
-	}
-	return ""
-}
-
-type QueryAgentFeedbackResponse struct {
-	Feedback Feedback `protobuf:"bytes,1,opt,name=feedback,proto3" json:"feedback"`
+type QueryEscrowBalanceResponse struct {
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+	// remaining_window_budget is the spend budget left in the rate window at the
+	// current height. Zero when spending is disabled.
+	RemainingWindowBudget cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=remaining_window_budget,json=remainingWindowBudget,proto3,customtype=cosmossdk.io/math.Int" json:"remaining_window_budget"`
+	// remaining_budgets is the spend budget left at the current height for every
+	// budgeted denom, spend_denom included. Exhausted budgets report zero.
+	RemainingBudgets []types.Coin `protobuf:"bytes,3,rep,name=remaining_budgets,json=remainingBudgets,proto3" json:"remaining_budgets"`
 }
 
-func (m *QueryAgentFeedbackResponse) Reset()         { *m = QueryAgentFeedbackResponse{} }
-func (m *QueryAgentFeedbackResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAgentFeedbackResponse) ProtoMessage()    {}
-func (*QueryAgentFeedbackResponse) Descriptor() ([]byte, []int) {
+func (m *QueryEscrowBalanceResponse) Reset()         { *m = QueryEscrowBalanceResponse{} }
+func (m *QueryEscrowBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEscrowBalanceResponse) ProtoMessage()    {}
+func (*QueryEscrowBalanceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{15}
 }
-func (m *QueryAgentFeedbackResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryEscrowBalanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAgentFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryEscrowBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAgentFeedbackResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryEscrowBalanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -782,42 +805,49 @@ func (m *QueryAgentFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAgentFeedbackResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAgentFeedbackResponse.Merge(m, src)
+func (m *QueryEscrowBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEscrowBalanceResponse.Merge(m, src)
 }
-func (m *QueryAgentFeedbackResponse) XXX_Size() int {
+func (m *QueryEscrowBalanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAgentFeedbackResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAgentFeedbackResponse.DiscardUnknown(m)
+func (m *QueryEscrowBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEscrowBalanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAgentFeedbackResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryEscrowBalanceResponse proto.InternalMessageInfo
 
-func (m *QueryAgentFeedbackResponse) GetFeedback() Feedback {
+func (m *QueryEscrowBalanceResponse) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Feedback
+		return m.Balance
 	}
-	return Feedback{}
+	return nil
 }
 
-type QueryAgentFeedbacksRequest struct {
+func (m *QueryEscrowBalanceResponse) GetRemainingBudgets() []types.Coin {
+	if m != nil {
+		return m.RemainingBudgets
+	}
+	return nil
+}
+
+type QuerySubAgentsRequest struct {
 	AgentId    string             `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAgentFeedbacksRequest) Reset()         { *m = QueryAgentFeedbacksRequest{} }
-func (m *QueryAgentFeedbacksRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryAgentFeedbacksRequest) ProtoMessage()    {}
-func (*QueryAgentFeedbacksRequest) Descriptor() ([]byte, []int) {
+func (m *QuerySubAgentsRequest) Reset()         { *m = QuerySubAgentsRequest{} }
+func (m *QuerySubAgentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubAgentsRequest) ProtoMessage()    {}
+func (*QuerySubAgentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{16}
 }
-func (m *QueryAgentFeedbacksRequest) XXX_Unmarshal(b []byte) error {
+func (m *QuerySubAgentsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAgentFeedbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySubAgentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAgentFeedbacksRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySubAgentsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -827,49 +857,49 @@ func (m *QueryAgentFeedbacksRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryAgentFeedbacksRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAgentFeedbacksRequest.Merge(m, src)
+func (m *QuerySubAgentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubAgentsRequest.Merge(m, src)
 }
-func (m *QueryAgentFeedbacksRequest) XXX_Size() int {
+func (m *QuerySubAgentsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAgentFeedbacksRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAgentFeedbacksRequest.DiscardUnknown(m)
+func (m *QuerySubAgentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubAgentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAgentFeedbacksRequest proto.InternalMessageInfo
+var xxx_messageInfo_QuerySubAgentsRequest proto.InternalMessageInfo
 
-func (m *QueryAgentFeedbacksRequest) GetAgentId() string {
+func (m *QuerySubAgentsRequest) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-func (m *QueryAgentFeedbacksRequest) GetPagination() *query.PageRequest {
+func (m *QuerySubAgentsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryAgentFeedbacksResponse struct {
-	Feedbacks  []Feedback          `protobuf:"bytes,1,rep,name=feedbacks,proto3" json:"feedbacks"`
+type QuerySubAgentsResponse struct {
+	Agents     []Agent             `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents"`
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAgentFeedbacksResponse) Reset()         { *m = QueryAgentFeedbacksResponse{} }
-func (m *QueryAgentFeedbacksResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryAgentFeedbacksResponse) ProtoMessage()    {}
-func (*QueryAgentFeedbacksResponse) Descriptor() ([]byte, []int) {
+func (m *QuerySubAgentsResponse) Reset()         { *m = QuerySubAgentsResponse{} }
+func (m *QuerySubAgentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubAgentsResponse) ProtoMessage()    {}
+func (*QuerySubAgentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{17}
 }
-func (m *QueryAgentFeedbacksResponse) XXX_Unmarshal(b []byte) error {
+func (m *QuerySubAgentsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryAgentFeedbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySubAgentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryAgentFeedbacksResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySubAgentsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -879,47 +909,48 @@ func (m *QueryAgentFeedbacksResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryAgentFeedbacksResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryAgentFeedbacksResponse.Merge(m, src)
+func (m *QuerySubAgentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubAgentsResponse.Merge(m, src)
 }
-func (m *QueryAgentFeedbacksResponse) XXX_Size() int {
+func (m *QuerySubAgentsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryAgentFeedbacksResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryAgentFeedbacksResponse.DiscardUnknown(m)
+func (m *QuerySubAgentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubAgentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryAgentFeedbacksResponse proto.InternalMessageInfo
+var xxx_messageInfo_QuerySubAgentsResponse proto.InternalMessageInfo
 
-func (m *QueryAgentFeedbacksResponse) GetFeedbacks() []Feedback {
+func (m *QuerySubAgentsResponse) GetAgents() []Agent {
 	if m != nil {
-		return m.Feedbacks
+		return m.Agents
 	}
 	return nil
 }
 
-func (m *QueryAgentFeedbacksResponse) GetPagination() *query.PageResponse {
+func (m *QuerySubAgentsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-type QueryRevokedPoliciesRequest struct {
+type QueryAggregateSpendRequest struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 }
 
-func (m *QueryRevokedPoliciesRequest) Reset()         { *m = QueryRevokedPoliciesRequest{} }
-func (m *QueryRevokedPoliciesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRevokedPoliciesRequest) ProtoMessage()    {}
-func (*QueryRevokedPoliciesRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAggregateSpendRequest) Reset()         { *m = QueryAggregateSpendRequest{} }
+func (m *QueryAggregateSpendRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateSpendRequest) ProtoMessage()    {}
+func (*QueryAggregateSpendRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{18}
 }
-func (m *QueryRevokedPoliciesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryAggregateSpendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRevokedPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAggregateSpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRevokedPoliciesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAggregateSpendRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -929,34 +960,48 @@ func (m *QueryRevokedPoliciesRequest) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryRevokedPoliciesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRevokedPoliciesRequest.Merge(m, src)
+func (m *QueryAggregateSpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateSpendRequest.Merge(m, src)
 }
-func (m *QueryRevokedPoliciesRequest) XXX_Size() int {
+func (m *QueryAggregateSpendRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRevokedPoliciesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRevokedPoliciesRequest.DiscardUnknown(m)
+func (m *QueryAggregateSpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateSpendRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRevokedPoliciesRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryAggregateSpendRequest proto.InternalMessageInfo
 
-type QueryRevokedPoliciesResponse struct {
-	Fingerprints []string `protobuf:"bytes,1,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`
+func (m *QueryAggregateSpendRequest) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
 }
 
-func (m *QueryRevokedPoliciesResponse) Reset()         { *m = QueryRevokedPoliciesResponse{} }
-func (m *QueryRevokedPoliciesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRevokedPoliciesResponse) ProtoMessage()    {}
-func (*QueryRevokedPoliciesResponse) Descriptor() ([]byte, []int) {
+type QueryAggregateSpendResponse struct {
+	// spent is the agent's own lifetime spend.
+	Spent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=spent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spent"`
+	// total_spent is the lifetime spend of the agent and all its descendants.
+	TotalSpent github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_spent,json=totalSpent,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_spent"`
+	// total_escrow is the escrow balance of the agent and all its descendants.
+	TotalEscrow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_escrow,json=totalEscrow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrow"`
+	// descendants is the number of sub-agents below the agent.
+	Descendants uint64 `protobuf:"varint,4,opt,name=descendants,proto3" json:"descendants,omitempty"`
+}
+
+func (m *QueryAggregateSpendResponse) Reset()         { *m = QueryAggregateSpendResponse{} }
+func (m *QueryAggregateSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAggregateSpendResponse) ProtoMessage()    {}
+func (*QueryAggregateSpendResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{19}
 }
-func (m *QueryRevokedPoliciesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryAggregateSpendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRevokedPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryAggregateSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRevokedPoliciesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryAggregateSpendResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -966,41 +1011,63 @@ func (m *QueryRevokedPoliciesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *QueryRevokedPoliciesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRevokedPoliciesResponse.Merge(m, src)
+func (m *QueryAggregateSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAggregateSpendResponse.Merge(m, src)
 }
-func (m *QueryRevokedPoliciesResponse) XXX_Size() int {
+func (m *QueryAggregateSpendResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRevokedPoliciesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRevokedPoliciesResponse.DiscardUnknown(m)
+func (m *QueryAggregateSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAggregateSpendResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRevokedPoliciesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryAggregateSpendResponse proto.InternalMessageInfo
 
-func (m *QueryRevokedPoliciesResponse) GetFingerprints() []string {
+func (m *QueryAggregateSpendResponse) GetSpent() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.Fingerprints
+		return m.Spent
 	}
 	return nil
 }
 
-type QueryPolicyRevokedRequest struct {
-	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+func (m *QueryAggregateSpendResponse) GetTotalSpent() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalSpent
+	}
+	return nil
 }
 
-func (m *QueryPolicyRevokedRequest) Reset()         { *m = QueryPolicyRevokedRequest{} }
-func (m *QueryPolicyRevokedRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPolicyRevokedRequest) ProtoMessage()    {}
-func (*QueryPolicyRevokedRequest) Descriptor() ([]byte, []int) {
+func (m *QueryAggregateSpendResponse) GetTotalEscrow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrow
+	}
+	return nil
+}
+
+func (m *QueryAggregateSpendResponse) GetDescendants() uint64 {
+	if m != nil {
+		return m.Descendants
+	}
+	return 0
+}
+
+type QueryRecipientPolicyRequest struct {
+	AgentId    string             `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecipientPolicyRequest) Reset()         { *m = QueryRecipientPolicyRequest{} }
+func (m *QueryRecipientPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecipientPolicyRequest) ProtoMessage()    {}
+func (*QueryRecipientPolicyRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{20}
 }
-func (m *QueryPolicyRevokedRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecipientPolicyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPolicyRevokedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecipientPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPolicyRevokedRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecipientPolicyRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1010,41 +1077,53 @@ func (m *QueryPolicyRevokedRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryPolicyRevokedRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPolicyRevokedRequest.Merge(m, src)
+func (m *QueryRecipientPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecipientPolicyRequest.Merge(m, src)
 }
-func (m *QueryPolicyRevokedRequest) XXX_Size() int {
+func (m *QueryRecipientPolicyRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPolicyRevokedRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPolicyRevokedRequest.DiscardUnknown(m)
+func (m *QueryRecipientPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecipientPolicyRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPolicyRevokedRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecipientPolicyRequest proto.InternalMessageInfo
 
-func (m *QueryPolicyRevokedRequest) GetFingerprint() string {
+func (m *QueryRecipientPolicyRequest) GetAgentId() string {
 	if m != nil {
-		return m.Fingerprint
+		return m.AgentId
 	}
 	return ""
 }
 
-type QueryPolicyRevokedResponse struct {
-	Revoked bool `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
+func (m *QueryRecipientPolicyRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryPolicyRevokedResponse) Reset()         { *m = QueryPolicyRevokedResponse{} }
-func (m *QueryPolicyRevokedResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPolicyRevokedResponse) ProtoMessage()    {}
-func (*QueryPolicyRevokedResponse) Descriptor() ([]byte, []int) {
+type QueryRecipientPolicyResponse struct {
+	// policy is the zero value when the agent has no recipient policy.
+	Policy RecipientPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// usages is what each recipient received in the current cap window; entries
+	// from an elapsed window report nothing received.
+	Usages     []RecipientUsage    `protobuf:"bytes,2,rep,name=usages,proto3" json:"usages"`
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecipientPolicyResponse) Reset()         { *m = QueryRecipientPolicyResponse{} }
+func (m *QueryRecipientPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecipientPolicyResponse) ProtoMessage()    {}
+func (*QueryRecipientPolicyResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_edb3e5892be7a3b9, []int{21}
 }
-func (m *QueryPolicyRevokedResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecipientPolicyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPolicyRevokedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecipientPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPolicyRevokedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecipientPolicyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1054,1738 +1133,6872 @@ func (m *QueryPolicyRevokedResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryPolicyRevokedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPolicyRevokedResponse.Merge(m, src)
+func (m *QueryRecipientPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecipientPolicyResponse.Merge(m, src)
 }
-func (m *QueryPolicyRevokedResponse) XXX_Size() int {
+func (m *QueryRecipientPolicyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPolicyRevokedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPolicyRevokedResponse.DiscardUnknown(m)
+func (m *QueryRecipientPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecipientPolicyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPolicyRevokedResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecipientPolicyResponse proto.InternalMessageInfo
 
-func (m *QueryPolicyRevokedResponse) GetRevoked() bool {
+func (m *QueryRecipientPolicyResponse) GetPolicy() RecipientPolicy {
 	if m != nil {
-		return m.Revoked
+		return m.Policy
 	}
-	return false
+	return RecipientPolicy{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.agent.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.agent.QueryParamsResponse")
-	proto.RegisterType((*QueryAgentRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentRequest")
-	proto.RegisterType((*QueryAgentResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentResponse")
-	proto.RegisterType((*QueryAgentsRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentsRequest")
-	proto.RegisterType((*QueryAgentsResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentsResponse")
-	proto.RegisterType((*QueryAgentActionsRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentActionsRequest")
-	proto.RegisterType((*QueryAgentActionsResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentActionsResponse")
-	proto.RegisterType((*QueryAgentActionRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentActionRequest")
-	proto.RegisterType((*QueryAgentActionResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentActionResponse")
-	proto.RegisterType((*QueryEscrowBalanceRequest)(nil), "dymensionxyz.dymension.agent.QueryEscrowBalanceRequest")
-	proto.RegisterType((*QueryEscrowBalanceResponse)(nil), "dymensionxyz.dymension.agent.QueryEscrowBalanceResponse")
-	proto.RegisterType((*QueryAgentReputationRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentReputationRequest")
-	proto.RegisterType((*QueryAgentReputationResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentReputationResponse")
-	proto.RegisterType((*QueryAgentFeedbackRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentFeedbackRequest")
-	proto.RegisterType((*QueryAgentFeedbackResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentFeedbackResponse")
-	proto.RegisterType((*QueryAgentFeedbacksRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentFeedbacksRequest")
-	proto.RegisterType((*QueryAgentFeedbacksResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentFeedbacksResponse")
-	proto.RegisterType((*QueryRevokedPoliciesRequest)(nil), "dymensionxyz.dymension.agent.QueryRevokedPoliciesRequest")
-	proto.RegisterType((*QueryRevokedPoliciesResponse)(nil), "dymensionxyz.dymension.agent.QueryRevokedPoliciesResponse")
-	proto.RegisterType((*QueryPolicyRevokedRequest)(nil), "dymensionxyz.dymension.agent.QueryPolicyRevokedRequest")
-	proto.RegisterType((*QueryPolicyRevokedResponse)(nil), "dymensionxyz.dymension.agent.QueryPolicyRevokedResponse")
+func (m *QueryRecipientPolicyResponse) GetUsages() []RecipientUsage {
+	if m != nil {
+		return m.Usages
+	}
+	return nil
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/agent/query.proto", fileDescriptor_edb3e5892be7a3b9)
+func (m *QueryRecipientPolicyResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-var fileDescriptor_edb3e5892be7a3b9 = []byte{
-	// 1227 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x41, 0x4f, 0xdc, 0x46,
-	0x14, 0x66, 0x20, 0x2c, 0x30, 0x40, 0x93, 0x4e, 0x93, 0x06, 0x36, 0x74, 0x41, 0x4e, 0x94, 0xa2,
-	0x26, 0x78, 0x80, 0xa8, 0x0b, 0xa4, 0x49, 0x10, 0x6e, 0xa0, 0x25, 0x42, 0x11, 0x75, 0x0f, 0x55,
-	0xab, 0x4a, 0xc8, 0xeb, 0x1d, 0x1c, 0x0b, 0xd6, 0xb3, 0xd8, 0x06, 0xb2, 0x45, 0xab, 0x4a, 0xfd,
-	0x05, 0xad, 0x72, 0xec, 0xa9, 0xea, 0xad, 0xa7, 0xaa, 0xea, 0x0f, 0xa8, 0xda, 0x0b, 0x87, 0xaa,
-	0x8a, 0xda, 0x4b, 0xd5, 0x43, 0x5a, 0x41, 0x0f, 0xfd, 0x19, 0x95, 0x67, 0x9e, 0x8d, 0xbd, 0x38,
-	0x8b, 0xbd, 0x8a, 0x72, 0x81, 0xf5, 0x78, 0xbe, 0x37, 0xdf, 0xfb, 0xde, 0xcc, 0x9b, 0x6f, 0x17,
-	0x4f, 0x56, 0x1b, 0x35, 0xe6, 0x78, 0x36, 0x77, 0x1e, 0x37, 0x3e, 0xa3, 0xd1, 0x03, 0x35, 0x2c,
-	0xe6, 0xf8, 0x74, 0x67, 0x97, 0xb9, 0x0d, 0xb5, 0xee, 0x72, 0x9f, 0x93, 0xb1, 0xf8, 0x4c, 0x35,
-	0x7a, 0x50, 0xc5, 0xcc, 0xe2, 0x45, 0x8b, 0x5b, 0x5c, 0x4c, 0xa4, 0xc1, 0x27, 0x89, 0x29, 0x8e,
-	0x59, 0x9c, 0x5b, 0xdb, 0x8c, 0x1a, 0x75, 0x9b, 0x1a, 0x8e, 0xc3, 0x7d, 0xc3, 0xb7, 0xb9, 0xe3,
-	0xc1, 0xdb, 0xb7, 0x4c, 0xee, 0xd5, 0xb8, 0x47, 0x2b, 0x86, 0xc7, 0xe4, 0x52, 0x74, 0x6f, 0xa6,
-	0xc2, 0x7c, 0x63, 0x86, 0xd6, 0x0d, 0xcb, 0x76, 0xc4, 0x64, 0x98, 0x5b, 0x8a, 0xcf, 0x0d, 0x67,
-	0x99, 0xdc, 0x0e, 0xdf, 0x8f, 0xca, 0xf7, 0x1b, 0x92, 0x82, 0x7c, 0x80, 0x57, 0xed, 0x53, 0x14,
-	0x7f, 0x61, 0xe6, 0x8d, 0xb6, 0x33, 0x37, 0x19, 0xab, 0x56, 0x0c, 0x73, 0x4b, 0x4e, 0x56, 0x2e,
-	0x62, 0xf2, 0x41, 0xc0, 0x79, 0xdd, 0x70, 0x8d, 0x9a, 0xa7, 0xb3, 0x9d, 0x5d, 0xe6, 0xf9, 0xca,
-	0xc7, 0xf8, 0xb5, 0xc4, 0xa8, 0x57, 0xe7, 0x8e, 0xc7, 0x88, 0x86, 0x0b, 0x75, 0x31, 0x32, 0x82,
-	0x26, 0xd0, 0xe4, 0xe0, 0xec, 0x35, 0xb5, 0x9d, 0x9a, 0xaa, 0x44, 0x6b, 0xe7, 0x0e, 0x9f, 0x8d,
-	0x77, 0xe9, 0x80, 0x54, 0x54, 0xfc, 0xaa, 0x08, 0xbd, 0x14, 0x4c, 0x81, 0xf5, 0xc8, 0x28, 0xee,
-	0x17, 0x90, 0x0d, 0xbb, 0x2a, 0x42, 0x0f, 0xe8, 0x7d, 0xe2, 0x79, 0xb5, 0xaa, 0x7c, 0x85, 0x80,
-	0x21, 0x00, 0x80, 0xca, 0x22, 0xee, 0x15, 0x33, 0x80, 0xc9, 0xd5, 0xf6, 0x4c, 0x04, 0x16, 0x88,
-	0x48, 0x1c, 0x99, 0xc0, 0x83, 0x9b, 0xb6, 0x63, 0x31, 0xb7, 0xee, 0xda, 0x8e, 0x3f, 0xd2, 0x2d,
-	0x56, 0x8d, 0x0f, 0x91, 0x11, 0xdc, 0xe7, 0xb2, 0x3d, 0xbe, 0xc5, 0xaa, 0x23, 0x3d, 0x13, 0x68,
-	0xb2, 0x5f, 0x0f, 0x1f, 0x95, 0x4f, 0xe3, 0x94, 0x42, 0xd1, 0xc8, 0x0a, 0xc6, 0x27, 0x05, 0x07,
-	0x5e, 0xd7, 0x55, 0x28, 0x62, 0x50, 0x71, 0x55, 0x6e, 0x44, 0xa8, 0xbb, 0xba, 0x6e, 0x58, 0x0c,
-	0xb0, 0x7a, 0x0c, 0xa9, 0x7c, 0x83, 0x40, 0xfd, 0x30, 0x3c, 0xa4, 0xbc, 0x84, 0x0b, 0x82, 0x7a,
-	0xa0, 0x7e, 0x4f, 0xbe, 0x9c, 0x01, 0x48, 0xde, 0x4b, 0x50, 0xec, 0x16, 0x14, 0xdf, 0x3c, 0x93,
-	0xa2, 0x5c, 0x3f, 0xc1, 0xb1, 0x89, 0x47, 0x4e, 0x28, 0x2e, 0x99, 0xe2, 0x3c, 0x9c, 0x5d, 0xcc,
-	0x16, 0x89, 0xba, 0x3b, 0x96, 0xe8, 0x07, 0x84, 0x47, 0x53, 0xd6, 0x07, 0xa1, 0xd6, 0x70, 0x9f,
-	0x21, 0x87, 0x40, 0xa9, 0x9b, 0x67, 0x28, 0x25, 0x26, 0xaf, 0x71, 0x6b, 0xd9, 0xf1, 0xdd, 0x06,
-	0x48, 0x16, 0x86, 0x78, 0x71, 0x9a, 0xad, 0xe0, 0xcb, 0xad, 0x9c, 0x33, 0x48, 0x76, 0x01, 0xf7,
-	0x78, 0x6c, 0x47, 0xac, 0x7b, 0x4e, 0x0f, 0x3e, 0x2a, 0x9b, 0xa7, 0xb5, 0x8f, 0x52, 0x7f, 0x80,
-	0x0b, 0x92, 0x37, 0xec, 0xbf, 0x4e, 0x32, 0x87, 0x08, 0x4a, 0x19, 0x34, 0x5e, 0xf6, 0x4c, 0x97,
-	0xef, 0x6b, 0xc6, 0xb6, 0xe1, 0x98, 0x2c, 0xc3, 0x89, 0xfd, 0x0f, 0xe1, 0x62, 0x1a, 0x10, 0x28,
-	0x32, 0xdc, 0x57, 0x91, 0x43, 0x50, 0x9d, 0xd1, 0x84, 0x98, 0xa1, 0x8c, 0xef, 0x72, 0xdb, 0xd1,
-	0xa6, 0x03, 0x42, 0xdf, 0xfd, 0x3d, 0x3e, 0x69, 0xd9, 0xfe, 0xa3, 0xdd, 0x8a, 0x6a, 0xf2, 0x1a,
-	0x74, 0x45, 0xf8, 0x37, 0xe5, 0x55, 0xb7, 0xa8, 0xdf, 0xa8, 0x33, 0x4f, 0x00, 0x3c, 0x3d, 0x8c,
-	0x4d, 0x4c, 0x7c, 0xd9, 0x65, 0x35, 0xc3, 0x76, 0x6c, 0xc7, 0xda, 0xd8, 0xb7, 0x9d, 0x2a, 0xdf,
-	0xdf, 0xa8, 0xec, 0x56, 0x2d, 0x06, 0x67, 0x5d, 0xbb, 0x11, 0xc4, 0xfe, 0xeb, 0xd9, 0xf8, 0x25,
-	0x19, 0xc9, 0xab, 0x6e, 0xa9, 0x36, 0xa7, 0x35, 0xc3, 0x7f, 0xa4, 0xae, 0x3a, 0xfe, 0xef, 0x3f,
-	0x4e, 0x61, 0xa0, 0xb5, 0xea, 0xf8, 0xfa, 0xa5, 0x28, 0xd6, 0x47, 0x22, 0x94, 0x26, 0x22, 0x29,
-	0xf3, 0xf8, 0x4a, 0xbc, 0x37, 0xd5, 0x77, 0xe5, 0xd5, 0x90, 0x41, 0xa4, 0x27, 0x08, 0x8f, 0xa5,
-	0x43, 0x41, 0xa6, 0x87, 0x18, 0xbb, 0xd1, 0x28, 0x54, 0x73, 0xb2, 0x7d, 0x35, 0x4f, 0xa2, 0x40,
-	0x25, 0x63, 0x11, 0xc8, 0x55, 0x3c, 0x6c, 0xec, 0x31, 0xd7, 0xb0, 0xd8, 0x86, 0x67, 0x72, 0x97,
-	0x09, 0x15, 0x86, 0xf5, 0x21, 0x18, 0xfc, 0x30, 0x18, 0x53, 0x1e, 0xc6, 0x8f, 0xd5, 0x0a, 0xdc,
-	0x14, 0x19, 0x36, 0xe9, 0xeb, 0xb8, 0x60, 0x6e, 0xdb, 0x2c, 0xea, 0xa3, 0xf0, 0xa4, 0x6c, 0xc2,
-	0x4e, 0x68, 0x89, 0x07, 0x29, 0xbe, 0x8f, 0xfb, 0xc3, 0xdb, 0x28, 0x6a, 0x97, 0x6d, 0x13, 0x0c,
-	0x23, 0x40, 0x7a, 0x11, 0x5a, 0xf9, 0x3c, 0x6d, 0x9d, 0x97, 0xdc, 0x90, 0xae, 0xa4, 0x32, 0x88,
-	0xce, 0xe5, 0x40, 0x48, 0x36, 0x6c, 0x4a, 0xf9, 0x72, 0x3d, 0x81, 0xbf, 0xb8, 0x86, 0xf4, 0x06,
-	0x70, 0xd6, 0xe5, 0xb5, 0xb6, 0xce, 0xb7, 0x6d, 0xd3, 0x66, 0x91, 0x09, 0xd0, 0x60, 0x87, 0x9e,
-	0x7a, 0x0d, 0x39, 0x29, 0x78, 0x28, 0x76, 0x5d, 0xca, 0xb4, 0x06, 0xf4, 0xc4, 0x98, 0x72, 0x17,
-	0x36, 0x94, 0x00, 0x87, 0x91, 0xc2, 0xba, 0xb4, 0x5c, 0xc1, 0xe8, 0xd4, 0x15, 0xac, 0x94, 0xa1,
-	0xae, 0x2d, 0x70, 0x20, 0x10, 0xbb, 0xa0, 0x51, 0xe2, 0x82, 0x9e, 0xfd, 0xfe, 0x02, 0xee, 0x15,
-	0x40, 0xf2, 0x35, 0xc2, 0x05, 0xe9, 0x43, 0xc8, 0x74, 0x7b, 0xc1, 0x4f, 0xdb, 0xa0, 0xe2, 0x4c,
-	0x0e, 0x84, 0xe4, 0xa4, 0xdc, 0xfc, 0xe2, 0x8f, 0x7f, 0x9f, 0x74, 0x5f, 0x27, 0xd7, 0x68, 0x5b,
-	0x17, 0x26, 0xcd, 0x10, 0xf9, 0x16, 0xe1, 0x5e, 0xb1, 0x63, 0x08, 0xcd, 0xb0, 0x54, 0xdc, 0x32,
-	0x15, 0xa7, 0xb3, 0x03, 0x80, 0xda, 0x9c, 0xa0, 0x36, 0x43, 0x28, 0x3d, 0xdb, 0x4a, 0x7a, 0xf4,
-	0x20, 0x3c, 0x32, 0x4d, 0xa1, 0xa1, 0xf4, 0x22, 0x24, 0xf3, 0xaa, 0xb9, 0x34, 0x4c, 0x1a, 0x9d,
-	0xac, 0x1a, 0x82, 0xa7, 0xf9, 0x09, 0xe1, 0xa1, 0xb8, 0x0d, 0x20, 0xe5, 0xac, 0x2b, 0x26, 0x7d,
-	0x4b, 0x71, 0x2e, 0x37, 0x0e, 0xf8, 0x2e, 0x0a, 0xbe, 0x0b, 0x64, 0x2e, 0xa7, 0xb0, 0x34, 0xb4,
-	0x18, 0xbf, 0x20, 0x3c, 0x18, 0x8b, 0x4c, 0xde, 0xce, 0xc7, 0x24, 0x4c, 0xa0, 0x9c, 0x17, 0x06,
-	0xfc, 0x97, 0x05, 0xff, 0x45, 0x72, 0xb7, 0x43, 0xfe, 0xf4, 0xc0, 0x63, 0x3b, 0x4d, 0xf2, 0x33,
-	0xc2, 0xc3, 0x89, 0x2b, 0x9f, 0x64, 0x51, 0x34, 0xcd, 0x5d, 0x14, 0xe7, 0xf3, 0x03, 0x21, 0x97,
-	0x7b, 0x22, 0x97, 0x79, 0x52, 0xce, 0x9b, 0x0b, 0x13, 0xe1, 0x82, 0xdd, 0x74, 0xbe, 0xa5, 0xe1,
-	0x91, 0x85, 0x0c, 0x6c, 0xd2, 0x7b, 0x68, 0xf1, 0x76, 0x27, 0x50, 0x48, 0xa5, 0x2c, 0x52, 0x99,
-	0x26, 0x6a, 0xfb, 0x54, 0xa0, 0xe7, 0x4d, 0xd5, 0x43, 0xba, 0x87, 0x08, 0x0f, 0x27, 0x1a, 0x66,
-	0xa6, 0x3a, 0xa4, 0x75, 0xe8, 0x4c, 0x75, 0x48, 0xed, 0xcd, 0xca, 0x7d, 0x41, 0xfe, 0x1e, 0xb9,
-	0x93, 0x8f, 0x3c, 0x3d, 0x88, 0xb5, 0xff, 0x26, 0xf9, 0x15, 0xe1, 0xf3, 0x2d, 0x06, 0x29, 0x53,
-	0x35, 0xd2, 0xfd, 0x58, 0xa6, 0x6a, 0x3c, 0xc7, 0x8f, 0x29, 0x9a, 0x48, 0xe8, 0x0e, 0xb9, 0x9d,
-	0x77, 0x63, 0xc5, 0x3c, 0xd8, 0x6f, 0x08, 0x0f, 0x27, 0x0c, 0x02, 0xc9, 0xdc, 0x73, 0x5a, 0xcc,
-	0x58, 0xa6, 0xca, 0xa4, 0xba, 0x2e, 0xe5, 0x81, 0x48, 0xe4, 0x3e, 0xd1, 0xf2, 0x26, 0x12, 0x39,
-	0x10, 0x7a, 0x20, 0xed, 0x5d, 0x33, 0xd8, 0x6a, 0xaf, 0x24, 0x1d, 0x0f, 0xc9, 0x4d, 0x2c, 0x3a,
-	0x2b, 0x0b, 0x1d, 0x20, 0x21, 0xa7, 0x25, 0x91, 0xd3, 0x3b, 0x64, 0xa1, 0xe3, 0x9c, 0xb4, 0xb5,
-	0xc3, 0xa3, 0x12, 0x7a, 0x7a, 0x54, 0x42, 0xff, 0x1c, 0x95, 0xd0, 0x97, 0xc7, 0xa5, 0xae, 0xa7,
-	0xc7, 0xa5, 0xae, 0x3f, 0x8f, 0x4b, 0x5d, 0x9f, 0xcc, 0xc6, 0xbe, 0x7c, 0x3c, 0x27, 0xfc, 0xde,
-	0x2d, 0xfa, 0x18, 0xd6, 0x10, 0x5f, 0x46, 0x2a, 0x05, 0xf1, 0xeb, 0xca, 0xad, 0xff, 0x03, 0x00,
-	0x00, 0xff, 0xff, 0xb7, 0xbc, 0x60, 0x17, 0x99, 0x12, 0x00, 0x00,
+type QueryPendingWithdrawalsRequest struct {
+	AgentId    string             `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Agent queries a single agent by id.
-	Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error)
-	// Agents queries all registered agents, paginated.
-	Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error)
-	// AgentActions queries an agent's attested action log, paginated.
-	AgentActions(ctx context.Context, in *QueryAgentActionsRequest, opts ...grpc.CallOption) (*QueryAgentActionsResponse, error)
-	// AgentAction queries a single action log entry by (agent_id, seq).
-	AgentAction(ctx context.Context, in *QueryAgentActionRequest, opts ...grpc.CallOption) (*QueryAgentActionResponse, error)
-	// EscrowBalance queries an agent's escrow balance and the remaining spend
-	// budget in the current rate window.
-	EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error)
-	// RevokedPolicies queries all revoked policy fingerprints.
-	RevokedPolicies(ctx context.Context, in *QueryRevokedPoliciesRequest, opts ...grpc.CallOption) (*QueryRevokedPoliciesResponse, error)
-	// PolicyRevoked queries whether a policy fingerprint is revoked.
-	PolicyRevoked(ctx context.Context, in *QueryPolicyRevokedRequest, opts ...grpc.CallOption) (*QueryPolicyRevokedResponse, error)
-	// AgentReputation queries an agent's aggregate reputation. Zero-valued when
-	// no feedback exists.
-	AgentReputation(ctx context.Context, in *QueryAgentReputationRequest, opts ...grpc.CallOption) (*QueryAgentReputationResponse, error)
-	// AgentFeedback queries a single feedback record by (agent_id, client).
-	AgentFeedback(ctx context.Context, in *QueryAgentFeedbackRequest, opts ...grpc.CallOption) (*QueryAgentFeedbackResponse, error)
-	// AgentFeedbacks queries all feedback records for an agent, paginated.
-	AgentFeedbacks(ctx context.Context, in *QueryAgentFeedbacksRequest, opts ...grpc.CallOption) (*QueryAgentFeedbacksResponse, error)
+func (m *QueryPendingWithdrawalsRequest) Reset()         { *m = QueryPendingWithdrawalsRequest{} }
+func (m *QueryPendingWithdrawalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWithdrawalsRequest) ProtoMessage()    {}
+func (*QueryPendingWithdrawalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{22}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPendingWithdrawalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPendingWithdrawalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWithdrawalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWithdrawalsRequest.Merge(m, src)
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWithdrawalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWithdrawalsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingWithdrawalsRequest proto.InternalMessageInfo
+
+func (m *QueryPendingWithdrawalsRequest) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error) {
-	out := new(QueryAgentResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/Agent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingWithdrawalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error) {
-	out := new(QueryAgentsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/Agents", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPendingWithdrawalsResponse struct {
+	Withdrawals []PendingWithdrawal `protobuf:"bytes,1,rep,name=withdrawals,proto3" json:"withdrawals"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) AgentActions(ctx context.Context, in *QueryAgentActionsRequest, opts ...grpc.CallOption) (*QueryAgentActionsResponse, error) {
-	out := new(QueryAgentActionsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentActions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingWithdrawalsResponse) Reset()         { *m = QueryPendingWithdrawalsResponse{} }
+func (m *QueryPendingWithdrawalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingWithdrawalsResponse) ProtoMessage()    {}
+func (*QueryPendingWithdrawalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{23}
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingWithdrawalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingWithdrawalsResponse.Merge(m, src)
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingWithdrawalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingWithdrawalsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) AgentAction(ctx context.Context, in *QueryAgentActionRequest, opts ...grpc.CallOption) (*QueryAgentActionResponse, error) {
-	out := new(QueryAgentActionResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingWithdrawalsResponse proto.InternalMessageInfo
+
+func (m *QueryPendingWithdrawalsResponse) GetWithdrawals() []PendingWithdrawal {
+	if m != nil {
+		return m.Withdrawals
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error) {
-	out := new(QueryEscrowBalanceResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/EscrowBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingWithdrawalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RevokedPolicies(ctx context.Context, in *QueryRevokedPoliciesRequest, opts ...grpc.CallOption) (*QueryRevokedPoliciesResponse, error) {
-	out := new(QueryRevokedPoliciesResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/RevokedPolicies", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPaymentStreamsRequest struct {
+	AgentId    string             `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) PolicyRevoked(ctx context.Context, in *QueryPolicyRevokedRequest, opts ...grpc.CallOption) (*QueryPolicyRevokedResponse, error) {
-	out := new(QueryPolicyRevokedResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/PolicyRevoked", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPaymentStreamsRequest) Reset()         { *m = QueryPaymentStreamsRequest{} }
+func (m *QueryPaymentStreamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{24}
+}
+func (m *QueryPaymentStreamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPaymentStreamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsRequest.Merge(m, src)
+}
+func (m *QueryPaymentStreamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AgentReputation(ctx context.Context, in *QueryAgentReputationRequest, opts ...grpc.CallOption) (*QueryAgentReputationResponse, error) {
-	out := new(QueryAgentReputationResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentReputation", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPaymentStreamsRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsRequest) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AgentFeedback(ctx context.Context, in *QueryAgentFeedbackRequest, opts ...grpc.CallOption) (*QueryAgentFeedbackResponse, error) {
-	out := new(QueryAgentFeedbackResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentFeedback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPaymentStreamsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) AgentFeedbacks(ctx context.Context, in *QueryAgentFeedbacksRequest, opts ...grpc.CallOption) (*QueryAgentFeedbacksResponse, error) {
-	out := new(QueryAgentFeedbacksResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentFeedbacks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPaymentStreamsResponse struct {
+	Streams    []PaymentStream     `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Agent queries a single agent by id.
-	Agent(context.Context, *QueryAgentRequest) (*QueryAgentResponse, error)
-	// Agents queries all registered agents, paginated.
-	Agents(context.Context, *QueryAgentsRequest) (*QueryAgentsResponse, error)
-	// AgentActions queries an agent's attested action log, paginated.
-	AgentActions(context.Context, *QueryAgentActionsRequest) (*QueryAgentActionsResponse, error)
-	// AgentAction queries a single action log entry by (agent_id, seq).
-	AgentAction(context.Context, *QueryAgentActionRequest) (*QueryAgentActionResponse, error)
-	// EscrowBalance queries an agent's escrow balance and the remaining spend
-	// budget in the current rate window.
-	EscrowBalance(context.Context, *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error)
-	// RevokedPolicies queries all revoked policy fingerprints.
-	RevokedPolicies(context.Context, *QueryRevokedPoliciesRequest) (*QueryRevokedPoliciesResponse, error)
-	// PolicyRevoked queries whether a policy fingerprint is revoked.
-	PolicyRevoked(context.Context, *QueryPolicyRevokedRequest) (*QueryPolicyRevokedResponse, error)
-	// AgentReputation queries an agent's aggregate reputation. Zero-valued when
-	// no feedback exists.
-	AgentReputation(context.Context, *QueryAgentReputationRequest) (*QueryAgentReputationResponse, error)
-	// AgentFeedback queries a single feedback record by (agent_id, client).
-	AgentFeedback(context.Context, *QueryAgentFeedbackRequest) (*QueryAgentFeedbackResponse, error)
-	// AgentFeedbacks queries all feedback records for an agent, paginated.
-	AgentFeedbacks(context.Context, *QueryAgentFeedbacksRequest) (*QueryAgentFeedbacksResponse, error)
+func (m *QueryPaymentStreamsResponse) Reset()         { *m = QueryPaymentStreamsResponse{} }
+func (m *QueryPaymentStreamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamsResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{25}
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPaymentStreamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPaymentStreamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Agent(ctx context.Context, req *QueryAgentRequest) (*QueryAgentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Agent not implemented")
+func (m *QueryPaymentStreamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Agents(ctx context.Context, req *QueryAgentsRequest) (*QueryAgentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Agents not implemented")
+func (m *QueryPaymentStreamsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) AgentActions(ctx context.Context, req *QueryAgentActionsRequest) (*QueryAgentActionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AgentActions not implemented")
+func (m *QueryPaymentStreamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) AgentAction(ctx context.Context, req *QueryAgentActionRequest) (*QueryAgentActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AgentAction not implemented")
+
+var xxx_messageInfo_QueryPaymentStreamsResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamsResponse) GetStreams() []PaymentStream {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) EscrowBalance(ctx context.Context, req *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EscrowBalance not implemented")
+
+func (m *QueryPaymentStreamsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) RevokedPolicies(ctx context.Context, req *QueryRevokedPoliciesRequest) (*QueryRevokedPoliciesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokedPolicies not implemented")
+
+type QueryPaymentStreamRequest struct {
+	AgentId  string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
-func (*UnimplementedQueryServer) PolicyRevoked(ctx context.Context, req *QueryPolicyRevokedRequest) (*QueryPolicyRevokedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PolicyRevoked not implemented")
+
+func (m *QueryPaymentStreamRequest) Reset()         { *m = QueryPaymentStreamRequest{} }
+func (m *QueryPaymentStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamRequest) ProtoMessage()    {}
+func (*QueryPaymentStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{26}
 }
-func (*UnimplementedQueryServer) AgentReputation(ctx context.Context, req *QueryAgentReputationRequest) (*QueryAgentReputationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AgentReputation not implemented")
+func (m *QueryPaymentStreamRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) AgentFeedback(ctx context.Context, req *QueryAgentFeedbackRequest) (*QueryAgentFeedbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AgentFeedback not implemented")
+func (m *QueryPaymentStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AgentFeedbacks(ctx context.Context, req *QueryAgentFeedbacksRequest) (*QueryAgentFeedbacksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AgentFeedbacks not implemented")
+func (m *QueryPaymentStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryPaymentStreamRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryPaymentStreamRequest proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamRequest) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Agent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Agent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/Agent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Agent(ctx, req.(*QueryAgentRequest))
+func (m *QueryPaymentStreamRequest) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Agents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Agents(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/Agents",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Agents(ctx, req.(*QueryAgentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryPaymentStreamResponse struct {
+	Stream PaymentStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
 }
 
-func _Query_AgentActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentActionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AgentActions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentActions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AgentActions(ctx, req.(*QueryAgentActionsRequest))
+func (m *QueryPaymentStreamResponse) Reset()         { *m = QueryPaymentStreamResponse{} }
+func (m *QueryPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPaymentStreamResponse) ProtoMessage()    {}
+func (*QueryPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{27}
+}
+func (m *QueryPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPaymentStreamResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPaymentStreamResponse.Merge(m, src)
+}
+func (m *QueryPaymentStreamResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPaymentStreamResponse.DiscardUnknown(m)
 }
 
-func _Query_AgentAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentActionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AgentAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AgentAction(ctx, req.(*QueryAgentActionRequest))
+var xxx_messageInfo_QueryPaymentStreamResponse proto.InternalMessageInfo
+
+func (m *QueryPaymentStreamResponse) GetStream() PaymentStream {
+	if m != nil {
+		return m.Stream
 	}
-	return interceptor(ctx, in, info, handler)
+	return PaymentStream{}
 }
 
-func _Query_EscrowBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEscrowBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EscrowBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/EscrowBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EscrowBalance(ctx, req.(*QueryEscrowBalanceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAgentReputationRequest struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 }
 
-func _Query_RevokedPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRevokedPoliciesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RevokedPolicies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/RevokedPolicies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RevokedPolicies(ctx, req.(*QueryRevokedPoliciesRequest))
+func (m *QueryAgentReputationRequest) Reset()         { *m = QueryAgentReputationRequest{} }
+func (m *QueryAgentReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAgentReputationRequest) ProtoMessage()    {}
+func (*QueryAgentReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{28}
+}
+func (m *QueryAgentReputationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAgentReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAgentReputationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAgentReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAgentReputationRequest.Merge(m, src)
+}
+func (m *QueryAgentReputationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAgentReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAgentReputationRequest.DiscardUnknown(m)
 }
 
-func _Query_PolicyRevoked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPolicyRevokedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PolicyRevoked(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/PolicyRevoked",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PolicyRevoked(ctx, req.(*QueryPolicyRevokedRequest))
+var xxx_messageInfo_QueryAgentReputationRequest proto.InternalMessageInfo
+
+func (m *QueryAgentReputationRequest) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_AgentReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentReputationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AgentReputation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentReputation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AgentReputation(ctx, req.(*QueryAgentReputationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryAgentReputationResponse struct {
+	Reputation Reputation `protobuf:"bytes,1,opt,name=reputation,proto3" json:"reputation"`
+	// average_score is score_sum / count via integer division; 0 when count is 0.
+	AverageScore uint32 `protobuf:"varint,2,opt,name=average_score,json=averageScore,proto3" json:"average_score,omitempty"`
+	// verified_average_score is the average over verified feedback only; 0
+	// when there is none.
+	VerifiedAverageScore uint32 `protobuf:"varint,5,opt,name=verified_average_score,json=verifiedAverageScore,proto3" json:"verified_average_score,omitempty"`
+	// weighted_score is the average score with each feedback weighted by its
+	// stake weight, capped at the client's current stake and decayed by
+	// feedback_decay_half_life since submission. Same
+	// scale as score; 0 when no feedback carries weight.
+	WeightedScore cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weighted_score,json=weightedScore,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weighted_score"`
+	// total_weight is the sum of the decayed weights behind weighted_score, a
+	// measure of how much stake backs it.
+	TotalWeight cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=total_weight,json=totalWeight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"total_weight"`
 }
 
-func _Query_AgentFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentFeedbackRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AgentFeedback(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentFeedback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AgentFeedback(ctx, req.(*QueryAgentFeedbackRequest))
+func (m *QueryAgentReputationResponse) Reset()         { *m = QueryAgentReputationResponse{} }
+func (m *QueryAgentReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAgentReputationResponse) ProtoMessage()    {}
+func (*QueryAgentReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{29}
+}
+func (m *QueryAgentReputationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAgentReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAgentReputationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryAgentReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAgentReputationResponse.Merge(m, src)
+}
+func (m *QueryAgentReputationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAgentReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAgentReputationResponse.DiscardUnknown(m)
 }
 
-func _Query_AgentFeedbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAgentFeedbacksRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AgentFeedbacks(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentFeedbacks",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AgentFeedbacks(ctx, req.(*QueryAgentFeedbacksRequest))
+var xxx_messageInfo_QueryAgentReputationResponse proto.InternalMessageInfo
+
+func (m *QueryAgentReputationResponse) GetReputation() Reputation {
+	if m != nil {
+		return m.Reputation
 	}
-	return interceptor(ctx, in, info, handler)
+	return Reputation{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.agent.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Agent",
-			Handler:    _Query_Agent_Handler,
-		},
-		{
-			MethodName: "Agents",
-			Handler:    _Query_Agents_Handler,
-		},
-		{
-			MethodName: "AgentActions",
-			Handler:    _Query_AgentActions_Handler,
-		},
-		{
-			MethodName: "AgentAction",
-			Handler:    _Query_AgentAction_Handler,
-		},
-		{
-			MethodName: "EscrowBalance",
-			Handler:    _Query_EscrowBalance_Handler,
-		},
-		{
-			MethodName: "RevokedPolicies",
-			Handler:    _Query_RevokedPolicies_Handler,
-		},
-		{
-			MethodName: "PolicyRevoked",
-			Handler:    _Query_PolicyRevoked_Handler,
-		},
-		{
-			MethodName: "AgentReputation",
-			Handler:    _Query_AgentReputation_Handler,
-		},
-		{
-			MethodName: "AgentFeedback",
-			Handler:    _Query_AgentFeedback_Handler,
-		},
-		{
-			MethodName: "AgentFeedbacks",
-			Handler:    _Query_AgentFeedbacks_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/agent/query.proto",
+func (m *QueryAgentReputationResponse) GetAverageScore() uint32 {
+	if m != nil {
+		return m.AverageScore
+	}
+	return 0
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAgentReputationResponse) GetVerifiedAverageScore() uint32 {
+	if m != nil {
+		return m.VerifiedAverageScore
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAgentFeedbackRequest struct {
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Client  string `protobuf:"bytes,2,opt,name=client,proto3" json:"client,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAgentFeedbackRequest) Reset()         { *m = QueryAgentFeedbackRequest{} }
+func (m *QueryAgentFeedbackRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAgentFeedbackRequest) ProtoMessage()    {}
+func (*QueryAgentFeedbackRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{30}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAgentFeedbackRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAgentFeedbackRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAgentFeedbackRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAgentFeedbackRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAgentFeedbackRequest.Merge(m, src)
+}
+func (m *QueryAgentFeedbackRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAgentFeedbackRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAgentFeedbackRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+var xxx_messageInfo_QueryAgentFeedbackRequest proto.InternalMessageInfo
+
+func (m *QueryAgentFeedbackRequest) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAgentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAgentFeedbackRequest) GetClient() string {
+	if m != nil {
+		return m.Client
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAgentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAgentFeedbackResponse struct {
+	Feedback Feedback `protobuf:"bytes,1,opt,name=feedback,proto3" json:"feedback"`
 }
 
-func (m *QueryAgentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAgentFeedbackResponse) Reset()         { *m = QueryAgentFeedbackResponse{} }
+func (m *QueryAgentFeedbackResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAgentFeedbackResponse) ProtoMessage()    {}
+func (*QueryAgentFeedbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{31}
+}
+func (m *QueryAgentFeedbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAgentFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAgentFeedbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAgentFeedbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAgentFeedbackResponse.Merge(m, src)
+}
+func (m *QueryAgentFeedbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAgentFeedbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAgentFeedbackResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAgentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAgentFeedbackResponse proto.InternalMessageInfo
+
+func (m *QueryAgentFeedbackResponse) GetFeedback() Feedback {
+	if m != nil {
+		return m.Feedback
 	}
-	return dAtA[:n], nil
+	return Feedback{}
 }
 
-func (m *QueryAgentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAgentFeedbacksRequest struct {
+	AgentId    string             `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Revoked {
-		i--
-		if m.Revoked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Agent.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAgentFeedbacksRequest) Reset()         { *m = QueryAgentFeedbacksRequest{} }
+func (m *QueryAgentFeedbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAgentFeedbacksRequest) ProtoMessage()    {}
+func (*QueryAgentFeedbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{32}
+}
+func (m *QueryAgentFeedbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAgentFeedbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAgentFeedbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAgentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAgentFeedbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAgentFeedbacksRequest.Merge(m, src)
 }
-
-func (m *QueryAgentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAgentFeedbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAgentFeedbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAgentFeedbacksRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAgentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryAgentFeedbacksRequest proto.InternalMessageInfo
+
+func (m *QueryAgentFeedbacksRequest) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryAgentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAgentFeedbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAgentFeedbacksResponse struct {
+	Feedbacks  []Feedback          `protobuf:"bytes,1,rep,name=feedbacks,proto3" json:"feedbacks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAgentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryAgentFeedbacksResponse) Reset()         { *m = QueryAgentFeedbacksResponse{} }
+func (m *QueryAgentFeedbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAgentFeedbacksResponse) ProtoMessage()    {}
+func (*QueryAgentFeedbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{33}
+}
+func (m *QueryAgentFeedbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAgentFeedbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAgentFeedbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Agents) > 0 {
-		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Agents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryAgentFeedbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAgentFeedbacksResponse.Merge(m, src)
+}
+func (m *QueryAgentFeedbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAgentFeedbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAgentFeedbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAgentFeedbacksResponse proto.InternalMessageInfo
+
+func (m *QueryAgentFeedbacksResponse) GetFeedbacks() []Feedback {
+	if m != nil {
+		return m.Feedbacks
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryAgentActionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAgentFeedbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentActionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRevokedPoliciesRequest struct {
 }
 
-func (m *QueryAgentActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRevokedPoliciesRequest) Reset()         { *m = QueryRevokedPoliciesRequest{} }
+func (m *QueryRevokedPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRevokedPoliciesRequest) ProtoMessage()    {}
+func (*QueryRevokedPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{34}
 }
-
-func (m *QueryAgentActionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRevokedPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevokedPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevokedPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryRevokedPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevokedPoliciesRequest.Merge(m, src)
+}
+func (m *QueryRevokedPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevokedPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevokedPoliciesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAgentActionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryRevokedPoliciesRequest proto.InternalMessageInfo
+
+type QueryRevokedPoliciesResponse struct {
+	Fingerprints []string `protobuf:"bytes,1,rep,name=fingerprints,proto3" json:"fingerprints,omitempty"`
 }
 
-func (m *QueryAgentActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Actions) > 0 {
-		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRevokedPoliciesResponse) Reset()         { *m = QueryRevokedPoliciesResponse{} }
+func (m *QueryRevokedPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRevokedPoliciesResponse) ProtoMessage()    {}
+func (*QueryRevokedPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{35}
+}
+func (m *QueryRevokedPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRevokedPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRevokedPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRevokedPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRevokedPoliciesResponse.Merge(m, src)
+}
+func (m *QueryRevokedPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRevokedPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRevokedPoliciesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAgentActionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRevokedPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryRevokedPoliciesResponse) GetFingerprints() []string {
+	if m != nil {
+		return m.Fingerprints
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentActionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPolicyRevokedRequest struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 }
 
-func (m *QueryAgentActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Seq != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPolicyRevokedRequest) Reset()         { *m = QueryPolicyRevokedRequest{} }
+func (m *QueryPolicyRevokedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicyRevokedRequest) ProtoMessage()    {}
+func (*QueryPolicyRevokedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{36}
+}
+func (m *QueryPolicyRevokedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPolicyRevokedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicyRevokedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPolicyRevokedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicyRevokedRequest.Merge(m, src)
+}
+func (m *QueryPolicyRevokedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPolicyRevokedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicyRevokedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAgentActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPolicyRevokedRequest proto.InternalMessageInfo
+
+func (m *QueryPolicyRevokedRequest) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAgentActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPolicyRevokedResponse struct {
+	Revoked bool `protobuf:"varint,1,opt,name=revoked,proto3" json:"revoked,omitempty"`
 }
 
-func (m *QueryAgentActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPolicyRevokedResponse) Reset()         { *m = QueryPolicyRevokedResponse{} }
+func (m *QueryPolicyRevokedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicyRevokedResponse) ProtoMessage()    {}
+func (*QueryPolicyRevokedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{37}
+}
+func (m *QueryPolicyRevokedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPolicyRevokedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicyRevokedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPolicyRevokedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicyRevokedResponse.Merge(m, src)
+}
+func (m *QueryPolicyRevokedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPolicyRevokedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicyRevokedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEscrowBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPolicyRevokedResponse proto.InternalMessageInfo
+
+func (m *QueryPolicyRevokedResponse) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryEscrowBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRegisteredPolicyRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *QueryEscrowBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRegisteredPolicyRequest) Reset()         { *m = QueryRegisteredPolicyRequest{} }
+func (m *QueryRegisteredPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredPolicyRequest) ProtoMessage()    {}
+func (*QueryRegisteredPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{38}
 }
-
-func (m *QueryEscrowBalanceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryEscrowBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRegisteredPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryEscrowBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RemainingWindowBudget.Size()
-		i -= size
-		if _, err := m.RemainingWindowBudget.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryRegisteredPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredPolicyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAgentReputationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRegisteredPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredPolicyRequest.Merge(m, src)
 }
-
-func (m *QueryAgentReputationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRegisteredPolicyRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryAgentReputationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryRegisteredPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredPolicyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAgentReputationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRegisteredPolicyRequest proto.InternalMessageInfo
+
+func (m *QueryRegisteredPolicyRequest) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAgentReputationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRegisteredPolicyResponse struct {
+	Policy RegisteredPolicy `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy"`
+	// head is the latest version of the policy's lineage, the policy referrers
+	// of id resolve to.
+	Head RegisteredPolicy `protobuf:"bytes,2,opt,name=head,proto3" json:"head"`
 }
 
-func (m *QueryAgentReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AverageScore != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.AverageScore))
-		i--
-		dAtA[i] = 0x10
-	}
-	{
-		size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRegisteredPolicyResponse) Reset()         { *m = QueryRegisteredPolicyResponse{} }
+func (m *QueryRegisteredPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredPolicyResponse) ProtoMessage()    {}
+func (*QueryRegisteredPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{39}
+}
+func (m *QueryRegisteredPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryAgentFeedbackRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRegisteredPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredPolicyResponse.Merge(m, src)
 }
-
-func (m *QueryAgentFeedbackRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRegisteredPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAgentFeedbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryRegisteredPolicyResponse proto.InternalMessageInfo
+
+func (m *QueryRegisteredPolicyResponse) GetPolicy() RegisteredPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return len(dAtA) - i, nil
+	return RegisteredPolicy{}
 }
 
-func (m *QueryAgentFeedbackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRegisteredPolicyResponse) GetHead() RegisteredPolicy {
+	if m != nil {
+		return m.Head
 	}
-	return dAtA[:n], nil
+	return RegisteredPolicy{}
 }
 
-func (m *QueryAgentFeedbackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRegisteredPoliciesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAgentFeedbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Feedback.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRegisteredPoliciesRequest) Reset()         { *m = QueryRegisteredPoliciesRequest{} }
+func (m *QueryRegisteredPoliciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredPoliciesRequest) ProtoMessage()    {}
+func (*QueryRegisteredPoliciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{40}
+}
+func (m *QueryRegisteredPoliciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredPoliciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredPoliciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRegisteredPoliciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredPoliciesRequest.Merge(m, src)
+}
+func (m *QueryRegisteredPoliciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredPoliciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredPoliciesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAgentFeedbacksRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRegisteredPoliciesRequest proto.InternalMessageInfo
+
+func (m *QueryRegisteredPoliciesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentFeedbacksRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRegisteredPoliciesResponse struct {
+	Policies   []RegisteredPolicy  `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAgentFeedbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRegisteredPoliciesResponse) Reset()         { *m = QueryRegisteredPoliciesResponse{} }
+func (m *QueryRegisteredPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredPoliciesResponse) ProtoMessage()    {}
+func (*QueryRegisteredPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_edb3e5892be7a3b9, []int{41}
+}
+func (m *QueryRegisteredPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRegisteredPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredPoliciesResponse.Merge(m, src)
+}
+func (m *QueryRegisteredPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredPoliciesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAgentFeedbacksResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRegisteredPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryRegisteredPoliciesResponse) GetPolicies() []RegisteredPolicy {
+	if m != nil {
+		return m.Policies
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAgentFeedbacksResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRegisteredPoliciesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAgentFeedbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Feedbacks) > 0 {
-		for iNdEx := len(m.Feedbacks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Feedbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.agent.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.agent.QueryParamsResponse")
+	proto.RegisterType((*QueryAgentRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentRequest")
+	proto.RegisterType((*QueryAgentResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentResponse")
+	proto.RegisterType((*QueryAgentsRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentsRequest")
+	proto.RegisterType((*QueryAgentsResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentsResponse")
+	proto.RegisterType((*QueryAgentActionsRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentActionsRequest")
+	proto.RegisterType((*QueryAgentActionsResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentActionsResponse")
+	proto.RegisterType((*QueryAgentActionRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentActionRequest")
+	proto.RegisterType((*QueryAgentActionResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentActionResponse")
+	proto.RegisterType((*QueryActionLogCommitmentRequest)(nil), "dymensionxyz.dymension.agent.QueryActionLogCommitmentRequest")
+	proto.RegisterType((*QueryActionLogCommitmentResponse)(nil), "dymensionxyz.dymension.agent.QueryActionLogCommitmentResponse")
+	proto.RegisterType((*QueryActionLogProofRequest)(nil), "dymensionxyz.dymension.agent.QueryActionLogProofRequest")
+	proto.RegisterType((*QueryActionLogProofResponse)(nil), "dymensionxyz.dymension.agent.QueryActionLogProofResponse")
+	proto.RegisterType((*QueryEscrowBalanceRequest)(nil), "dymensionxyz.dymension.agent.QueryEscrowBalanceRequest")
+	proto.RegisterType((*QueryEscrowBalanceResponse)(nil), "dymensionxyz.dymension.agent.QueryEscrowBalanceResponse")
+	proto.RegisterType((*QuerySubAgentsRequest)(nil), "dymensionxyz.dymension.agent.QuerySubAgentsRequest")
+	proto.RegisterType((*QuerySubAgentsResponse)(nil), "dymensionxyz.dymension.agent.QuerySubAgentsResponse")
+	proto.RegisterType((*QueryAggregateSpendRequest)(nil), "dymensionxyz.dymension.agent.QueryAggregateSpendRequest")
+	proto.RegisterType((*QueryAggregateSpendResponse)(nil), "dymensionxyz.dymension.agent.QueryAggregateSpendResponse")
+	proto.RegisterType((*QueryRecipientPolicyRequest)(nil), "dymensionxyz.dymension.agent.QueryRecipientPolicyRequest")
+	proto.RegisterType((*QueryRecipientPolicyResponse)(nil), "dymensionxyz.dymension.agent.QueryRecipientPolicyResponse")
+	proto.RegisterType((*QueryPendingWithdrawalsRequest)(nil), "dymensionxyz.dymension.agent.QueryPendingWithdrawalsRequest")
+	proto.RegisterType((*QueryPendingWithdrawalsResponse)(nil), "dymensionxyz.dymension.agent.QueryPendingWithdrawalsResponse")
+	proto.RegisterType((*QueryPaymentStreamsRequest)(nil), "dymensionxyz.dymension.agent.QueryPaymentStreamsRequest")
+	proto.RegisterType((*QueryPaymentStreamsResponse)(nil), "dymensionxyz.dymension.agent.QueryPaymentStreamsResponse")
+	proto.RegisterType((*QueryPaymentStreamRequest)(nil), "dymensionxyz.dymension.agent.QueryPaymentStreamRequest")
+	proto.RegisterType((*QueryPaymentStreamResponse)(nil), "dymensionxyz.dymension.agent.QueryPaymentStreamResponse")
+	proto.RegisterType((*QueryAgentReputationRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentReputationRequest")
+	proto.RegisterType((*QueryAgentReputationResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentReputationResponse")
+	proto.RegisterType((*QueryAgentFeedbackRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentFeedbackRequest")
+	proto.RegisterType((*QueryAgentFeedbackResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentFeedbackResponse")
+	proto.RegisterType((*QueryAgentFeedbacksRequest)(nil), "dymensionxyz.dymension.agent.QueryAgentFeedbacksRequest")
+	proto.RegisterType((*QueryAgentFeedbacksResponse)(nil), "dymensionxyz.dymension.agent.QueryAgentFeedbacksResponse")
+	proto.RegisterType((*QueryRevokedPoliciesRequest)(nil), "dymensionxyz.dymension.agent.QueryRevokedPoliciesRequest")
+	proto.RegisterType((*QueryRevokedPoliciesResponse)(nil), "dymensionxyz.dymension.agent.QueryRevokedPoliciesResponse")
+	proto.RegisterType((*QueryPolicyRevokedRequest)(nil), "dymensionxyz.dymension.agent.QueryPolicyRevokedRequest")
+	proto.RegisterType((*QueryPolicyRevokedResponse)(nil), "dymensionxyz.dymension.agent.QueryPolicyRevokedResponse")
+	proto.RegisterType((*QueryRegisteredPolicyRequest)(nil), "dymensionxyz.dymension.agent.QueryRegisteredPolicyRequest")
+	proto.RegisterType((*QueryRegisteredPolicyResponse)(nil), "dymensionxyz.dymension.agent.QueryRegisteredPolicyResponse")
+	proto.RegisterType((*QueryRegisteredPoliciesRequest)(nil), "dymensionxyz.dymension.agent.QueryRegisteredPoliciesRequest")
+	proto.RegisterType((*QueryRegisteredPoliciesResponse)(nil), "dymensionxyz.dymension.agent.QueryRegisteredPoliciesResponse")
 }
 
-func (m *QueryRevokedPoliciesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/agent/query.proto", fileDescriptor_edb3e5892be7a3b9)
 }
 
-func (m *QueryRevokedPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_edb3e5892be7a3b9 = []byte{
+	// 2142 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4d, 0x6f, 0xdc, 0xc6,
+	0x19, 0x36, 0x77, 0xf5, 0xf9, 0xea, 0xc3, 0xce, 0xf8, 0x23, 0x6b, 0xca, 0x91, 0x04, 0x26, 0x70,
+	0x84, 0xda, 0xbb, 0xb4, 0xec, 0x54, 0x96, 0x1c, 0xcb, 0xae, 0xd6, 0x92, 0x6c, 0xc5, 0x8a, 0xa1,
+	0xae, 0x5a, 0xb8, 0x2d, 0x0a, 0x08, 0x5c, 0x72, 0x44, 0x11, 0xd6, 0x92, 0x6b, 0x92, 0x2b, 0x45,
+	0x11, 0x84, 0x18, 0xed, 0x1f, 0x28, 0xd0, 0x63, 0x4f, 0x45, 0x7b, 0xea, 0xb1, 0x2d, 0x5a, 0x20,
+	0xe8, 0xa1, 0x69, 0x2e, 0x3e, 0x14, 0x41, 0x90, 0xa2, 0x68, 0xd1, 0x83, 0x5b, 0x58, 0xfe, 0x13,
+	0xbd, 0x15, 0x9c, 0x79, 0xc9, 0x25, 0x57, 0xd4, 0x2e, 0x49, 0x2d, 0x84, 0x5e, 0x92, 0xe5, 0x70,
+	0xde, 0x67, 0x9e, 0xe7, 0x9d, 0x0f, 0xbe, 0xf3, 0xc8, 0x30, 0xa5, 0xed, 0xd5, 0xa8, 0xe9, 0x18,
+	0x96, 0xf9, 0xc9, 0xde, 0xa7, 0x72, 0xf0, 0x20, 0x2b, 0x3a, 0x35, 0x5d, 0xf9, 0x79, 0x83, 0xda,
+	0x7b, 0xa5, 0xba, 0x6d, 0xb9, 0x16, 0xb9, 0x12, 0xee, 0x59, 0x0a, 0x1e, 0x4a, 0xac, 0xa7, 0x78,
+	0x41, 0xb7, 0x74, 0x8b, 0x75, 0x94, 0xbd, 0x5f, 0x3c, 0x46, 0xbc, 0xa2, 0x5b, 0x96, 0xbe, 0x4d,
+	0x65, 0xa5, 0x6e, 0xc8, 0x8a, 0x69, 0x5a, 0xae, 0xe2, 0x1a, 0x96, 0xe9, 0xe0, 0xdb, 0x6f, 0xa9,
+	0x96, 0x53, 0xb3, 0x1c, 0xb9, 0xaa, 0x38, 0x94, 0x0f, 0x25, 0xef, 0x4c, 0x57, 0xa9, 0xab, 0x4c,
+	0xcb, 0x75, 0x45, 0x37, 0x4c, 0xd6, 0x19, 0xfb, 0x8e, 0x87, 0xfb, 0xfa, 0xbd, 0x54, 0xcb, 0xf0,
+	0xdf, 0x5f, 0xe6, 0xef, 0x37, 0x38, 0x05, 0xfe, 0x80, 0xaf, 0xda, 0x4b, 0x64, 0xff, 0xc5, 0x9e,
+	0xd7, 0xda, 0xf6, 0xdc, 0xa4, 0x54, 0xab, 0x2a, 0xea, 0x33, 0xde, 0x59, 0xba, 0x00, 0xe4, 0xbb,
+	0x1e, 0xe7, 0x35, 0xc5, 0x56, 0x6a, 0x4e, 0x85, 0x3e, 0x6f, 0x50, 0xc7, 0x95, 0x7e, 0x08, 0xe7,
+	0x23, 0xad, 0x4e, 0xdd, 0x32, 0x1d, 0x4a, 0xca, 0xd0, 0x57, 0x67, 0x2d, 0x05, 0x61, 0x52, 0x98,
+	0x1a, 0xba, 0xf9, 0x5e, 0xa9, 0x5d, 0x36, 0x4b, 0x3c, 0xba, 0xdc, 0xf3, 0xf2, 0xd5, 0xc4, 0x99,
+	0x0a, 0x46, 0x4a, 0x25, 0x78, 0x8b, 0x41, 0x2f, 0x78, 0x5d, 0x70, 0x3c, 0x72, 0x19, 0x06, 0x58,
+	0xc8, 0x86, 0xa1, 0x31, 0xe8, 0xc1, 0x4a, 0x3f, 0x7b, 0x5e, 0xd1, 0xa4, 0xcf, 0x05, 0x20, 0xe1,
+	0x00, 0xa4, 0x72, 0x1f, 0x7a, 0x59, 0x0f, 0x64, 0xf2, 0x6e, 0x7b, 0x26, 0x2c, 0x16, 0x89, 0xf0,
+	0x38, 0x32, 0x09, 0x43, 0x9b, 0x86, 0xa9, 0x53, 0xbb, 0x6e, 0x1b, 0xa6, 0x5b, 0xc8, 0xb1, 0x51,
+	0xc3, 0x4d, 0xa4, 0x00, 0xfd, 0x36, 0xdd, 0xb1, 0x9e, 0x51, 0xad, 0x90, 0x9f, 0x14, 0xa6, 0x06,
+	0x2a, 0xfe, 0x23, 0x79, 0x1f, 0xce, 0xaa, 0xd6, 0x46, 0xa8, 0xaf, 0x53, 0xe8, 0x99, 0xcc, 0x4f,
+	0x0d, 0x56, 0x46, 0x55, 0x6b, 0x39, 0xd4, 0x2a, 0xfd, 0x38, 0xcc, 0xdd, 0xcf, 0x2e, 0x59, 0x06,
+	0x68, 0xae, 0x0c, 0x14, 0x70, 0xb5, 0x84, 0xb3, 0xed, 0x2d, 0x8d, 0x12, 0x5f, 0xb1, 0xb8, 0x40,
+	0x4a, 0x6b, 0x8a, 0x4e, 0x31, 0xb6, 0x12, 0x8a, 0x94, 0x7e, 0x29, 0xc0, 0xf9, 0x08, 0x3c, 0xe6,
+	0x66, 0x01, 0xfa, 0x98, 0x46, 0x6f, 0x9a, 0xf2, 0xe9, 0x92, 0x83, 0x81, 0xe4, 0x61, 0x84, 0x62,
+	0x8e, 0x51, 0x7c, 0xbf, 0x23, 0x45, 0x3e, 0x7e, 0x84, 0xe3, 0x01, 0x14, 0x9a, 0x14, 0x17, 0x54,
+	0xaf, 0xcd, 0xe9, 0x3c, 0xeb, 0x64, 0x39, 0x66, 0xfc, 0x2c, 0x29, 0xfa, 0xad, 0x00, 0x97, 0x63,
+	0xc6, 0xc7, 0x44, 0xad, 0x42, 0xbf, 0xc2, 0x9b, 0x30, 0x53, 0xd7, 0x3b, 0x64, 0x8a, 0x75, 0x5e,
+	0xb5, 0xf4, 0x25, 0xd3, 0xb5, 0xf7, 0x30, 0x65, 0x3e, 0x44, 0xf7, 0x72, 0xb6, 0x0c, 0x6f, 0xb7,
+	0x72, 0x4e, 0x90, 0xb2, 0x73, 0x90, 0x77, 0xe8, 0x73, 0x36, 0x6e, 0x4f, 0xc5, 0xfb, 0x29, 0x6d,
+	0x1e, 0xcd, 0x7d, 0x20, 0xfd, 0x23, 0xe8, 0xe3, 0xbc, 0x71, 0xfd, 0x65, 0x51, 0x8e, 0x08, 0xd2,
+	0x5d, 0x98, 0xe0, 0xe3, 0xf8, 0x9d, 0x1e, 0x58, 0xb5, 0x9a, 0xe1, 0xd6, 0x92, 0x6d, 0xf0, 0x7d,
+	0x98, 0x3c, 0x3e, 0x1a, 0xd9, 0x3e, 0x05, 0x50, 0x83, 0x56, 0x64, 0x3c, 0x9d, 0x90, 0x71, 0x13,
+	0x0e, 0x69, 0x87, 0xa0, 0xa4, 0x15, 0x10, 0xa3, 0x83, 0xaf, 0xd9, 0x96, 0xb5, 0x99, 0x29, 0xdb,
+	0x5f, 0x08, 0x30, 0x16, 0x8b, 0x85, 0x1a, 0x1e, 0x41, 0x6f, 0xdd, 0x6b, 0x48, 0x99, 0x70, 0x06,
+	0xe2, 0x1f, 0x5d, 0x0c, 0x80, 0x10, 0xe8, 0xb1, 0x2d, 0x8b, 0x9f, 0x59, 0xc3, 0x15, 0xf6, 0x9b,
+	0x2c, 0x06, 0xf3, 0x99, 0x4f, 0x3f, 0x9f, 0xc1, 0x4c, 0xce, 0xe0, 0x6e, 0x59, 0x72, 0x54, 0xdb,
+	0xda, 0x2d, 0x2b, 0xdb, 0x8a, 0xa9, 0xd2, 0x04, 0x73, 0xf8, 0x87, 0x1c, 0x88, 0x71, 0x81, 0x28,
+	0x9d, 0x42, 0x7f, 0x95, 0x37, 0xe1, 0x3e, 0xbb, 0x1c, 0xd9, 0x16, 0xfe, 0x86, 0x78, 0x60, 0x19,
+	0x66, 0xf9, 0x86, 0xa7, 0xf4, 0x37, 0xff, 0x9e, 0x98, 0xd2, 0x0d, 0x77, 0xab, 0x51, 0x2d, 0xa9,
+	0x56, 0x0d, 0x3f, 0x84, 0xf8, 0xbf, 0xa2, 0xa3, 0x3d, 0x93, 0xdd, 0xbd, 0x3a, 0x75, 0x58, 0x80,
+	0x53, 0xf1, 0xb1, 0x89, 0x0a, 0x6f, 0xdb, 0xb4, 0xa6, 0x18, 0xa6, 0x61, 0xea, 0x1b, 0xbb, 0x86,
+	0xa9, 0x59, 0xbb, 0x1b, 0xd5, 0x86, 0xa6, 0x53, 0x3c, 0xde, 0xcb, 0xd7, 0x3c, 0xec, 0x7f, 0xbd,
+	0x9a, 0xb8, 0xc8, 0x91, 0x1c, 0xed, 0x59, 0xc9, 0xb0, 0xe4, 0x9a, 0xe2, 0x6e, 0x95, 0x56, 0x4c,
+	0xf7, 0x9b, 0xdf, 0x17, 0x01, 0x69, 0xad, 0x98, 0x6e, 0xe5, 0x62, 0x80, 0xf5, 0x94, 0x41, 0x95,
+	0x19, 0x12, 0x59, 0x85, 0xb7, 0x9a, 0x83, 0x70, 0x74, 0xa7, 0x90, 0xef, 0xa4, 0x8a, 0xcf, 0xdf,
+	0xb9, 0x20, 0x92, 0x83, 0x39, 0xd2, 0xa7, 0x70, 0x91, 0xe5, 0x6d, 0xbd, 0x51, 0x8d, 0x7e, 0x23,
+	0x4e, 0xe1, 0x6c, 0xfc, 0xb5, 0x00, 0x97, 0x5a, 0x07, 0xff, 0x3f, 0xfc, 0x82, 0xdc, 0xf6, 0xb7,
+	0xa8, 0xae, 0xdb, 0x54, 0x57, 0x5c, 0xba, 0x5e, 0xa7, 0xa6, 0x96, 0x60, 0x51, 0xbe, 0xc8, 0xc3,
+	0x58, 0x6c, 0x24, 0x8a, 0x54, 0xa0, 0xd7, 0xa9, 0xf3, 0xf3, 0xa4, 0xeb, 0x6b, 0x92, 0x23, 0x93,
+	0x6d, 0x18, 0x72, 0x2d, 0x57, 0xd9, 0xde, 0xe0, 0x03, 0xe5, 0xba, 0x3f, 0x10, 0x30, 0xfc, 0x75,
+	0x36, 0x9a, 0x09, 0xc3, 0x7c, 0x34, 0xca, 0x76, 0x61, 0x21, 0xdf, 0xfd, 0xe1, 0xb8, 0x1c, 0xbe,
+	0xcb, 0xbd, 0x12, 0x4a, 0xa3, 0x8e, 0x4a, 0x4d, 0x4d, 0xe1, 0x25, 0x90, 0x77, 0x16, 0x86, 0x9b,
+	0xa4, 0x17, 0xfe, 0x99, 0x58, 0xa1, 0xaa, 0x51, 0x37, 0xa8, 0xe9, 0xae, 0x59, 0xdb, 0x86, 0xba,
+	0x77, 0x8a, 0xab, 0xfc, 0x45, 0x0e, 0xae, 0xc4, 0x53, 0xc0, 0x65, 0xf0, 0x18, 0xfa, 0xea, 0xac,
+	0x05, 0x0f, 0xe6, 0x62, 0xfb, 0xb5, 0xde, 0x02, 0x13, 0x54, 0xb7, 0xec, 0xc9, 0xfb, 0xac, 0x36,
+	0x1c, 0x45, 0xa7, 0x0e, 0xce, 0xf5, 0xf5, 0x84, 0x60, 0xdf, 0xf7, 0x82, 0x7c, 0x2c, 0x8e, 0xd0,
+	0xb2, 0x83, 0xf2, 0xd9, 0x77, 0xd0, 0x4f, 0x05, 0x18, 0xe7, 0xe5, 0x3c, 0x35, 0x35, 0x76, 0x9e,
+	0xb9, 0x5b, 0x9a, 0xad, 0xec, 0x2a, 0xdb, 0xa7, 0x79, 0xdc, 0x7c, 0x29, 0xc0, 0xc4, 0xb1, 0x2c,
+	0x82, 0xef, 0xfc, 0xd0, 0x6e, 0xb3, 0x19, 0x37, 0xa6, 0xdc, 0xe1, 0x96, 0xd1, 0x0a, 0x87, 0x69,
+	0x0c, 0x23, 0x75, 0xef, 0x34, 0xfa, 0x0c, 0x4f, 0xa3, 0x35, 0xc5, 0xa3, 0xe1, 0xae, 0xbb, 0x36,
+	0x55, 0x6a, 0xa7, 0x99, 0xc6, 0xdf, 0xf9, 0x5b, 0xaa, 0x95, 0x41, 0xb0, 0x9c, 0xfb, 0x1d, 0xde,
+	0x84, 0xe9, 0xbb, 0xd6, 0xe9, 0x92, 0x16, 0x82, 0xf1, 0x4b, 0x5a, 0x44, 0xe8, 0x5e, 0xda, 0xd6,
+	0xb1, 0xb0, 0x88, 0x8c, 0x96, 0x20, 0x6b, 0x63, 0x30, 0xc8, 0xb9, 0x78, 0xef, 0x78, 0xb1, 0x35,
+	0xc0, 0x1b, 0x56, 0x34, 0x49, 0x8f, 0x9b, 0x8b, 0x20, 0x11, 0x2b, 0xd0, 0xc7, 0x7b, 0xe2, 0xbe,
+	0xce, 0x90, 0x07, 0x04, 0x90, 0x66, 0x83, 0x0f, 0x09, 0x2b, 0x4a, 0xeb, 0x0d, 0xee, 0x00, 0x24,
+	0xf8, 0x06, 0xfd, 0xd7, 0x3f, 0x7d, 0x8e, 0x84, 0x22, 0xcb, 0x27, 0x00, 0x76, 0xd0, 0x8a, 0x4c,
+	0xa7, 0x3a, 0x1d, 0x1a, 0x7e, 0x7f, 0xbf, 0xa0, 0x6d, 0x22, 0x90, 0x77, 0x61, 0x44, 0xd9, 0xa1,
+	0xb6, 0xa2, 0xd3, 0x0d, 0x47, 0xb5, 0x6c, 0xca, 0x92, 0x36, 0x52, 0x19, 0xc6, 0xc6, 0x75, 0xaf,
+	0x8d, 0x7c, 0x00, 0x97, 0x76, 0xa8, 0x6d, 0x6c, 0x1a, 0x54, 0xdb, 0x88, 0xf6, 0xee, 0x65, 0xbd,
+	0x2f, 0xf8, 0x6f, 0x17, 0xc2, 0x51, 0x3f, 0x80, 0xd1, 0x5d, 0x6a, 0xe8, 0x5b, 0x2e, 0xd5, 0xb0,
+	0x77, 0x9e, 0x55, 0x55, 0xd3, 0x58, 0x55, 0x8d, 0x1d, 0xad, 0xaa, 0x56, 0xa9, 0xae, 0xa8, 0x7b,
+	0x8b, 0x54, 0x0d, 0xd5, 0x56, 0x8b, 0x54, 0xad, 0x8c, 0xf8, 0x40, 0x1c, 0xf9, 0x7b, 0xfe, 0x87,
+	0x8b, 0x37, 0x17, 0x7a, 0xb2, 0xe2, 0xf2, 0xcf, 0xd3, 0x53, 0x86, 0x22, 0x3d, 0x09, 0x5f, 0xfd,
+	0x96, 0xd1, 0xf6, 0x48, 0xb0, 0xe6, 0x2e, 0x41, 0x9f, 0xba, 0x6d, 0xd0, 0xc0, 0x14, 0xc0, 0x27,
+	0x69, 0x13, 0xc4, 0x38, 0xbc, 0xa0, 0xbc, 0x1f, 0xf0, 0xad, 0x95, 0xe0, 0x4a, 0xdf, 0x76, 0x1a,
+	0x7d, 0x04, 0x9c, 0xc4, 0x20, 0x5a, 0xfa, 0x2c, 0x6e, 0x9c, 0x53, 0xbe, 0x34, 0x8f, 0xc5, 0x32,
+	0x08, 0xee, 0x8e, 0x83, 0x3e, 0x59, 0xff, 0x90, 0x49, 0xa7, 0xb5, 0x19, 0xde, 0xbd, 0x13, 0xe6,
+	0x9d, 0xa0, 0xd2, 0x60, 0x1e, 0x0d, 0xfb, 0x3a, 0x1b, 0x34, 0x70, 0xb4, 0xca, 0x70, 0x25, 0xfe,
+	0x35, 0x6a, 0x92, 0x60, 0x38, 0xe2, 0xe7, 0x08, 0xcc, 0xcf, 0x89, 0xb4, 0x49, 0xf3, 0xfe, 0x21,
+	0x86, 0x05, 0x04, 0x43, 0xf2, 0xe7, 0xa5, 0xc5, 0x4f, 0x12, 0x8e, 0xf8, 0x49, 0xd2, 0x0c, 0x88,
+	0x71, 0xe1, 0x48, 0x20, 0xe4, 0x36, 0x09, 0x11, 0xb7, 0x49, 0x2a, 0x05, 0xd4, 0x75, 0xc3, 0x71,
+	0xa9, 0x4d, 0xb5, 0x68, 0x11, 0x35, 0x0a, 0xb9, 0x60, 0x2d, 0xe4, 0x0c, 0x4d, 0xfa, 0xa3, 0x00,
+	0xef, 0x1c, 0x13, 0x10, 0xf8, 0x1e, 0xd1, 0x92, 0xa7, 0xd4, 0xe9, 0xc0, 0x89, 0xe2, 0xb4, 0xd4,
+	0x3c, 0x8f, 0xa0, 0x67, 0x8b, 0x2a, 0x5a, 0x21, 0x77, 0x02, 0x2c, 0x86, 0x20, 0x6d, 0x61, 0x9d,
+	0xd2, 0xd2, 0xa9, 0x39, 0x8d, 0x5d, 0xb3, 0xce, 0xfe, 0xe4, 0x17, 0x23, 0x71, 0x43, 0x61, 0x96,
+	0xd6, 0x60, 0xa0, 0x8e, 0x6d, 0xb8, 0xca, 0xb3, 0x69, 0x0b, 0x50, 0xba, 0xb6, 0xd8, 0x6f, 0xbe,
+	0x99, 0x84, 0x5e, 0x46, 0x9f, 0xfc, 0x42, 0x80, 0x3e, 0xee, 0xb3, 0x92, 0x1b, 0xed, 0xd9, 0x1d,
+	0xb5, 0x79, 0xc5, 0xe9, 0x14, 0x11, 0x9c, 0x85, 0x74, 0xfd, 0x27, 0x7f, 0x7b, 0xf3, 0xf3, 0xdc,
+	0x55, 0xf2, 0x9e, 0xdc, 0xd6, 0x65, 0xe6, 0x66, 0x2f, 0xf9, 0x95, 0x00, 0xbd, 0xec, 0x10, 0x21,
+	0x72, 0x82, 0xa1, 0xc2, 0x96, 0xb0, 0x78, 0x23, 0x79, 0x00, 0x52, 0xbb, 0xcd, 0xa8, 0x4d, 0x13,
+	0x59, 0xee, 0x6c, 0x95, 0x3b, 0xf2, 0xbe, 0x7f, 0x8a, 0x1e, 0xb0, 0x1c, 0x32, 0xa8, 0x64, 0x39,
+	0x8c, 0x5c, 0xd4, 0xc5, 0xe9, 0x14, 0x11, 0xe9, 0x72, 0x88, 0x17, 0xe9, 0x3f, 0x0b, 0x30, 0x1c,
+	0x76, 0x2f, 0xc9, 0x4c, 0xd2, 0x11, 0xa3, 0x76, 0xab, 0x78, 0x3b, 0x75, 0x1c, 0xf2, 0xbd, 0xcf,
+	0xf8, 0xce, 0x91, 0xdb, 0x29, 0x13, 0x2b, 0xfb, 0xce, 0xe8, 0x97, 0x02, 0x0c, 0x85, 0x90, 0xc9,
+	0xb7, 0xd3, 0x31, 0xf1, 0x05, 0xcc, 0xa4, 0x0d, 0x43, 0xfe, 0x4b, 0x8c, 0xff, 0x7d, 0x32, 0x9f,
+	0x91, 0xbf, 0xbc, 0xef, 0xd0, 0xe7, 0x07, 0xe4, 0x50, 0x80, 0xf3, 0x31, 0xae, 0x22, 0x99, 0x4f,
+	0x42, 0xeb, 0x58, 0x6b, 0x54, 0xbc, 0x97, 0x35, 0x1c, 0xd5, 0x7d, 0xcc, 0xd4, 0x3d, 0x24, 0x4b,
+	0xd9, 0xd4, 0x15, 0xb7, 0x2d, 0xbd, 0xd8, 0x74, 0x44, 0xc9, 0xdf, 0x05, 0x18, 0x8d, 0x9a, 0x8f,
+	0x64, 0x36, 0x0d, 0xc3, 0xb0, 0x81, 0x2a, 0xce, 0x65, 0x88, 0x44, 0x59, 0x4f, 0x98, 0xac, 0x47,
+	0x64, 0xf9, 0x04, 0xb2, 0x98, 0x5d, 0x8a, 0xb3, 0xf7, 0x17, 0x01, 0x46, 0x22, 0xee, 0x24, 0x49,
+	0xb2, 0x1f, 0xe2, 0x8c, 0x50, 0x71, 0x36, 0x7d, 0x20, 0x8a, 0xba, 0xc7, 0x44, 0xcd, 0x92, 0x99,
+	0xb4, 0xa2, 0xb8, 0xa3, 0x43, 0x3e, 0x17, 0x60, 0x30, 0x70, 0xeb, 0xc8, 0xad, 0x04, 0x3c, 0x5a,
+	0x8d, 0x45, 0xf1, 0x83, 0x74, 0x41, 0x48, 0xbc, 0xcc, 0x88, 0xdf, 0x25, 0x77, 0xd2, 0x12, 0x77,
+	0x1a, 0xd5, 0x22, 0x6f, 0x25, 0x5f, 0x79, 0x2b, 0x2b, 0x62, 0xc5, 0x25, 0x5b, 0x59, 0x71, 0xbe,
+	0x9f, 0x38, 0x97, 0x21, 0x12, 0xb5, 0x3c, 0x64, 0x5a, 0x16, 0xc8, 0xfd, 0xd4, 0x2b, 0xcb, 0xc7,
+	0x2b, 0x3a, 0x8c, 0xfd, 0x37, 0x02, 0x9c, 0x6d, 0xb1, 0x83, 0x48, 0x12, 0x5e, 0xf1, 0x66, 0x98,
+	0x78, 0x27, 0x4b, 0x28, 0x6a, 0x7a, 0xc4, 0x34, 0x95, 0xc9, 0x77, 0xd2, 0x6a, 0xb2, 0x7d, 0xc0,
+	0x22, 0x56, 0x73, 0xaf, 0x04, 0x20, 0x47, 0x1d, 0x1a, 0x72, 0x37, 0x49, 0xa9, 0x70, 0x9c, 0xbd,
+	0x24, 0xce, 0x67, 0x8c, 0x46, 0x75, 0x8f, 0x99, 0xba, 0x25, 0xf2, 0x20, 0xad, 0xba, 0x3a, 0xc7,
+	0x2c, 0x86, 0xad, 0x20, 0x6f, 0x19, 0x46, 0xbd, 0x93, 0x44, 0xcb, 0x30, 0xd6, 0xf0, 0x11, 0xe7,
+	0x32, 0x44, 0x9e, 0x74, 0x19, 0xd6, 0x39, 0x5e, 0xd1, 0x37, 0x69, 0xfe, 0x21, 0xc0, 0x48, 0x64,
+	0x8c, 0x44, 0x27, 0x5b, 0x9c, 0x13, 0x23, 0xce, 0xa6, 0x0f, 0x44, 0x35, 0xeb, 0x4c, 0xcd, 0xc7,
+	0xe4, 0xf1, 0x09, 0xd5, 0xc8, 0xfb, 0x81, 0xdf, 0x73, 0xe0, 0x95, 0x3e, 0x67, 0x5b, 0x2e, 0x6c,
+	0x09, 0x37, 0x58, 0xdc, 0x1d, 0x50, 0xbc, 0x93, 0x25, 0x14, 0xf5, 0xcd, 0x30, 0x7d, 0x37, 0x48,
+	0xa9, 0xbd, 0x3e, 0xbc, 0xb3, 0x15, 0x83, 0x92, 0xff, 0xa5, 0x37, 0x39, 0xe1, 0x0b, 0x5f, 0xb2,
+	0xc9, 0x89, 0xb9, 0x61, 0x8a, 0xb3, 0xe9, 0x03, 0x91, 0xfc, 0x22, 0x23, 0x7f, 0x8f, 0xdc, 0x4d,
+	0x47, 0x5e, 0xde, 0x0f, 0x5d, 0x5f, 0xd9, 0x6c, 0x9c, 0x6b, 0xbd, 0xe2, 0x90, 0x64, 0x39, 0x8d,
+	0xbd, 0xb8, 0x8a, 0x1f, 0x66, 0x8a, 0x45, 0x4d, 0xb7, 0x98, 0xa6, 0x22, 0xb9, 0xd6, 0xe1, 0x22,
+	0x12, 0x68, 0xf1, 0x16, 0xd4, 0x17, 0x02, 0x90, 0xa3, 0x37, 0xbe, 0x44, 0x87, 0xdb, 0xb1, 0x77,
+	0x52, 0x71, 0x3e, 0x63, 0x34, 0x0a, 0x29, 0x31, 0x21, 0x53, 0xe4, 0x6a, 0x32, 0x21, 0xe4, 0xaf,
+	0x02, 0x9c, 0x6d, 0x71, 0x13, 0xc9, 0x5c, 0xf2, 0xcb, 0x52, 0x8b, 0x79, 0x29, 0xde, 0xc9, 0x12,
+	0x7a, 0xd2, 0xaa, 0x20, 0x64, 0x58, 0x7e, 0x25, 0xc0, 0x48, 0xc4, 0x67, 0x22, 0x89, 0xef, 0x29,
+	0x2d, 0x9e, 0x9e, 0x38, 0x9b, 0x3e, 0x10, 0x85, 0x7c, 0xc4, 0x84, 0x2c, 0x92, 0x72, 0x5a, 0x21,
+	0x81, 0x91, 0x25, 0xef, 0x73, 0x97, 0xf0, 0xc0, 0xdb, 0xf1, 0xa3, 0x91, 0x51, 0x1c, 0x92, 0x9a,
+	0x98, 0x93, 0xae, 0xcc, 0x89, 0x73, 0xe9, 0xa4, 0x05, 0xa6, 0xe9, 0x43, 0x32, 0x97, 0x59, 0x53,
+	0x79, 0xf5, 0xe5, 0xeb, 0x71, 0xe1, 0xeb, 0xd7, 0xe3, 0xc2, 0x7f, 0x5e, 0x8f, 0x0b, 0x3f, 0x3b,
+	0x1c, 0x3f, 0xf3, 0xf5, 0xe1, 0xf8, 0x99, 0x7f, 0x1e, 0x8e, 0x9f, 0xf9, 0xd1, 0xcd, 0xd0, 0x5f,
+	0x0c, 0x8f, 0x81, 0xdf, 0xb9, 0x25, 0x7f, 0x82, 0x63, 0xb0, 0xbf, 0x20, 0x56, 0xfb, 0xd8, 0xbf,
+	0x38, 0xbb, 0xf5, 0xbf, 0x01, 0x00, 0xd5, 0x08, 0xec, 0x7f, 0xad, 0x27, 0x00, 0x00,
 }
 
-func (m *QueryRevokedPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Agent queries a single agent by id.
+	Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error)
+	// Agents queries all registered agents, paginated.
+	Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error)
+	// AgentActions queries an agent's attested action log, paginated.
+	AgentActions(ctx context.Context, in *QueryAgentActionsRequest, opts ...grpc.CallOption) (*QueryAgentActionsResponse, error)
+	// AgentAction queries a single action log entry by (agent_id, seq).
+	AgentAction(ctx context.Context, in *QueryAgentActionRequest, opts ...grpc.CallOption) (*QueryAgentActionResponse, error)
+	// ActionLogCommitment queries an agent's action log accumulator.
+	ActionLogCommitment(ctx context.Context, in *QueryActionLogCommitmentRequest, opts ...grpc.CallOption) (*QueryActionLogCommitmentResponse, error)
+	// ActionLogProof queries an inclusion proof of an agent's action log entry
+	// against the current accumulator root. Pruned entries remain provable.
+	ActionLogProof(ctx context.Context, in *QueryActionLogProofRequest, opts ...grpc.CallOption) (*QueryActionLogProofResponse, error)
+	// EscrowBalance queries an agent's escrow balance and the remaining spend
+	// budget in the current rate window of every budgeted denom.
+	EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error)
+	// SubAgents queries an agent's direct sub-agents, paginated.
+	SubAgents(ctx context.Context, in *QuerySubAgentsRequest, opts ...grpc.CallOption) (*QuerySubAgentsResponse, error)
+	// AggregateSpend queries the lifetime spend and escrow of an agent and all
+	// of its descendants.
+	AggregateSpend(ctx context.Context, in *QueryAggregateSpendRequest, opts ...grpc.CallOption) (*QueryAggregateSpendResponse, error)
+	// RecipientPolicy queries an agent's recipient policy and the per-recipient
+	// usage in the current cap window, paginated over recipients.
+	RecipientPolicy(ctx context.Context, in *QueryRecipientPolicyRequest, opts ...grpc.CallOption) (*QueryRecipientPolicyResponse, error)
+	// PendingWithdrawals queries an agent's queued escrow withdrawals,
+	// paginated.
+	PendingWithdrawals(ctx context.Context, in *QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*QueryPendingWithdrawalsResponse, error)
+	// PaymentStreams queries an agent's payment streams, paginated.
+	PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error)
+	// PaymentStream queries a single payment stream by (agent_id, stream_id).
+	PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error)
+	// RevokedPolicies queries all revoked policy fingerprints.
+	RevokedPolicies(ctx context.Context, in *QueryRevokedPoliciesRequest, opts ...grpc.CallOption) (*QueryRevokedPoliciesResponse, error)
+	// PolicyRevoked queries whether a policy fingerprint is revoked.
+	PolicyRevoked(ctx context.Context, in *QueryPolicyRevokedRequest, opts ...grpc.CallOption) (*QueryPolicyRevokedResponse, error)
+	// RegisteredPolicy queries a registered policy and the head of its lineage.
+	RegisteredPolicy(ctx context.Context, in *QueryRegisteredPolicyRequest, opts ...grpc.CallOption) (*QueryRegisteredPolicyResponse, error)
+	// RegisteredPolicies queries all registered policies, by id.
+	RegisteredPolicies(ctx context.Context, in *QueryRegisteredPoliciesRequest, opts ...grpc.CallOption) (*QueryRegisteredPoliciesResponse, error)
+	// AgentReputation queries an agent's aggregate reputation. Zero-valued when
+	// no feedback exists.
+	AgentReputation(ctx context.Context, in *QueryAgentReputationRequest, opts ...grpc.CallOption) (*QueryAgentReputationResponse, error)
+	// AgentFeedback queries a single feedback record by (agent_id, client).
+	AgentFeedback(ctx context.Context, in *QueryAgentFeedbackRequest, opts ...grpc.CallOption) (*QueryAgentFeedbackResponse, error)
+	// AgentFeedbacks queries all feedback records for an agent, paginated.
+	AgentFeedbacks(ctx context.Context, in *QueryAgentFeedbacksRequest, opts ...grpc.CallOption) (*QueryAgentFeedbacksResponse, error)
 }
 
-func (m *QueryRevokedPoliciesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryRevokedPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Agent(ctx context.Context, in *QueryAgentRequest, opts ...grpc.CallOption) (*QueryAgentResponse, error) {
+	out := new(QueryAgentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/Agent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryRevokedPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fingerprints) > 0 {
-		for iNdEx := len(m.Fingerprints) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Fingerprints[iNdEx])
-			copy(dAtA[i:], m.Fingerprints[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprints[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Agents(ctx context.Context, in *QueryAgentsRequest, opts ...grpc.CallOption) (*QueryAgentsResponse, error) {
+	out := new(QueryAgentsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/Agents", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPolicyRevokedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AgentActions(ctx context.Context, in *QueryAgentActionsRequest, opts ...grpc.CallOption) (*QueryAgentActionsResponse, error) {
+	out := new(QueryAgentActionsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentActions", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPolicyRevokedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AgentAction(ctx context.Context, in *QueryAgentActionRequest, opts ...grpc.CallOption) (*QueryAgentActionResponse, error) {
+	out := new(QueryAgentActionResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPolicyRevokedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ActionLogCommitment(ctx context.Context, in *QueryActionLogCommitmentRequest, opts ...grpc.CallOption) (*QueryActionLogCommitmentResponse, error) {
+	out := new(QueryActionLogCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/ActionLogCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPolicyRevokedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ActionLogProof(ctx context.Context, in *QueryActionLogProofRequest, opts ...grpc.CallOption) (*QueryActionLogProofResponse, error) {
+	out := new(QueryActionLogProofResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/ActionLogProof", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPolicyRevokedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EscrowBalance(ctx context.Context, in *QueryEscrowBalanceRequest, opts ...grpc.CallOption) (*QueryEscrowBalanceResponse, error) {
+	out := new(QueryEscrowBalanceResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/EscrowBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryPolicyRevokedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Revoked {
-		i--
-		if m.Revoked {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) SubAgents(ctx context.Context, in *QuerySubAgentsRequest, opts ...grpc.CallOption) (*QuerySubAgentsResponse, error) {
+	out := new(QuerySubAgentsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/SubAgents", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) AggregateSpend(ctx context.Context, in *QueryAggregateSpendRequest, opts ...grpc.CallOption) (*QueryAggregateSpendResponse, error) {
+	out := new(QueryAggregateSpendResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AggregateSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) RecipientPolicy(ctx context.Context, in *QueryRecipientPolicyRequest, opts ...grpc.CallOption) (*QueryRecipientPolicyResponse, error) {
+	out := new(QueryRecipientPolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/RecipientPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) PendingWithdrawals(ctx context.Context, in *QueryPendingWithdrawalsRequest, opts ...grpc.CallOption) (*QueryPendingWithdrawalsResponse, error) {
+	out := new(QueryPendingWithdrawalsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/PendingWithdrawals", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryAgentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAgentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Agent.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Revoked {
-		n += 2
+func (c *queryClient) PaymentStreams(ctx context.Context, in *QueryPaymentStreamsRequest, opts ...grpc.CallOption) (*QueryPaymentStreamsResponse, error) {
+	out := new(QueryPaymentStreamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/PaymentStreams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAgentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PaymentStream(ctx context.Context, in *QueryPaymentStreamRequest, opts ...grpc.CallOption) (*QueryPaymentStreamResponse, error) {
+	out := new(QueryPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/PaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAgentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Agents) > 0 {
-		for _, e := range m.Agents {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RevokedPolicies(ctx context.Context, in *QueryRevokedPoliciesRequest, opts ...grpc.CallOption) (*QueryRevokedPoliciesResponse, error) {
+	out := new(QueryRevokedPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/RevokedPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAgentActionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) PolicyRevoked(ctx context.Context, in *QueryPolicyRevokedRequest, opts ...grpc.CallOption) (*QueryPolicyRevokedResponse, error) {
+	out := new(QueryPolicyRevokedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/PolicyRevoked", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAgentActionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Actions) > 0 {
-		for _, e := range m.Actions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) RegisteredPolicy(ctx context.Context, in *QueryRegisteredPolicyRequest, opts ...grpc.CallOption) (*QueryRegisteredPolicyResponse, error) {
+	out := new(QueryRegisteredPolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/RegisteredPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAgentActionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Seq != 0 {
-		n += 1 + sovQuery(uint64(m.Seq))
+func (c *queryClient) RegisteredPolicies(ctx context.Context, in *QueryRegisteredPoliciesRequest, opts ...grpc.CallOption) (*QueryRegisteredPoliciesResponse, error) {
+	out := new(QueryRegisteredPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/RegisteredPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryAgentActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) AgentReputation(ctx context.Context, in *QueryAgentReputationRequest, opts ...grpc.CallOption) (*QueryAgentReputationResponse, error) {
+	out := new(QueryAgentReputationResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentReputation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Action.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryEscrowBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) AgentFeedback(ctx context.Context, in *QueryAgentFeedbackRequest, opts ...grpc.CallOption) (*QueryAgentFeedbackResponse, error) {
+	out := new(QueryAgentFeedbackResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentFeedback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryEscrowBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Balance) > 0 {
-		for _, e := range m.Balance {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) AgentFeedbacks(ctx context.Context, in *QueryAgentFeedbacksRequest, opts ...grpc.CallOption) (*QueryAgentFeedbacksResponse, error) {
+	out := new(QueryAgentFeedbacksResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Query/AgentFeedbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.RemainingWindowBudget.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryAgentReputationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Agent queries a single agent by id.
+	Agent(context.Context, *QueryAgentRequest) (*QueryAgentResponse, error)
+	// Agents queries all registered agents, paginated.
+	Agents(context.Context, *QueryAgentsRequest) (*QueryAgentsResponse, error)
+	// AgentActions queries an agent's attested action log, paginated.
+	AgentActions(context.Context, *QueryAgentActionsRequest) (*QueryAgentActionsResponse, error)
+	// AgentAction queries a single action log entry by (agent_id, seq).
+	AgentAction(context.Context, *QueryAgentActionRequest) (*QueryAgentActionResponse, error)
+	// ActionLogCommitment queries an agent's action log accumulator.
+	ActionLogCommitment(context.Context, *QueryActionLogCommitmentRequest) (*QueryActionLogCommitmentResponse, error)
+	// ActionLogProof queries an inclusion proof of an agent's action log entry
+	// against the current accumulator root. Pruned entries remain provable.
+	ActionLogProof(context.Context, *QueryActionLogProofRequest) (*QueryActionLogProofResponse, error)
+	// EscrowBalance queries an agent's escrow balance and the remaining spend
+	// budget in the current rate window of every budgeted denom.
+	EscrowBalance(context.Context, *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error)
+	// SubAgents queries an agent's direct sub-agents, paginated.
+	SubAgents(context.Context, *QuerySubAgentsRequest) (*QuerySubAgentsResponse, error)
+	// AggregateSpend queries the lifetime spend and escrow of an agent and all
+	// of its descendants.
+	AggregateSpend(context.Context, *QueryAggregateSpendRequest) (*QueryAggregateSpendResponse, error)
+	// RecipientPolicy queries an agent's recipient policy and the per-recipient
+	// usage in the current cap window, paginated over recipients.
+	RecipientPolicy(context.Context, *QueryRecipientPolicyRequest) (*QueryRecipientPolicyResponse, error)
+	// PendingWithdrawals queries an agent's queued escrow withdrawals,
+	// paginated.
+	PendingWithdrawals(context.Context, *QueryPendingWithdrawalsRequest) (*QueryPendingWithdrawalsResponse, error)
+	// PaymentStreams queries an agent's payment streams, paginated.
+	PaymentStreams(context.Context, *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error)
+	// PaymentStream queries a single payment stream by (agent_id, stream_id).
+	PaymentStream(context.Context, *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error)
+	// RevokedPolicies queries all revoked policy fingerprints.
+	RevokedPolicies(context.Context, *QueryRevokedPoliciesRequest) (*QueryRevokedPoliciesResponse, error)
+	// PolicyRevoked queries whether a policy fingerprint is revoked.
+	PolicyRevoked(context.Context, *QueryPolicyRevokedRequest) (*QueryPolicyRevokedResponse, error)
+	// RegisteredPolicy queries a registered policy and the head of its lineage.
+	RegisteredPolicy(context.Context, *QueryRegisteredPolicyRequest) (*QueryRegisteredPolicyResponse, error)
+	// RegisteredPolicies queries all registered policies, by id.
+	RegisteredPolicies(context.Context, *QueryRegisteredPoliciesRequest) (*QueryRegisteredPoliciesResponse, error)
+	// AgentReputation queries an agent's aggregate reputation. Zero-valued when
+	// no feedback exists.
+	AgentReputation(context.Context, *QueryAgentReputationRequest) (*QueryAgentReputationResponse, error)
+	// AgentFeedback queries a single feedback record by (agent_id, client).
+	AgentFeedback(context.Context, *QueryAgentFeedbackRequest) (*QueryAgentFeedbackResponse, error)
+	// AgentFeedbacks queries all feedback records for an agent, paginated.
+	AgentFeedbacks(context.Context, *QueryAgentFeedbacksRequest) (*QueryAgentFeedbacksResponse, error)
 }
 
-func (m *QueryAgentReputationResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Reputation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.AverageScore != 0 {
-		n += 1 + sovQuery(uint64(m.AverageScore))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryAgentFeedbackRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryAgentFeedbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Feedback.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (*UnimplementedQueryServer) Agent(ctx context.Context, req *QueryAgentRequest) (*QueryAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Agent not implemented")
+}
+func (*UnimplementedQueryServer) Agents(ctx context.Context, req *QueryAgentsRequest) (*QueryAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Agents not implemented")
+}
+func (*UnimplementedQueryServer) AgentActions(ctx context.Context, req *QueryAgentActionsRequest) (*QueryAgentActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentActions not implemented")
+}
+func (*UnimplementedQueryServer) AgentAction(ctx context.Context, req *QueryAgentActionRequest) (*QueryAgentActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentAction not implemented")
+}
+func (*UnimplementedQueryServer) ActionLogCommitment(ctx context.Context, req *QueryActionLogCommitmentRequest) (*QueryActionLogCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionLogCommitment not implemented")
+}
+func (*UnimplementedQueryServer) ActionLogProof(ctx context.Context, req *QueryActionLogProofRequest) (*QueryActionLogProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActionLogProof not implemented")
+}
+func (*UnimplementedQueryServer) EscrowBalance(ctx context.Context, req *QueryEscrowBalanceRequest) (*QueryEscrowBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscrowBalance not implemented")
+}
+func (*UnimplementedQueryServer) SubAgents(ctx context.Context, req *QuerySubAgentsRequest) (*QuerySubAgentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubAgents not implemented")
+}
+func (*UnimplementedQueryServer) AggregateSpend(ctx context.Context, req *QueryAggregateSpendRequest) (*QueryAggregateSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AggregateSpend not implemented")
+}
+func (*UnimplementedQueryServer) RecipientPolicy(ctx context.Context, req *QueryRecipientPolicyRequest) (*QueryRecipientPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecipientPolicy not implemented")
+}
+func (*UnimplementedQueryServer) PendingWithdrawals(ctx context.Context, req *QueryPendingWithdrawalsRequest) (*QueryPendingWithdrawalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingWithdrawals not implemented")
+}
+func (*UnimplementedQueryServer) PaymentStreams(ctx context.Context, req *QueryPaymentStreamsRequest) (*QueryPaymentStreamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStreams not implemented")
+}
+func (*UnimplementedQueryServer) PaymentStream(ctx context.Context, req *QueryPaymentStreamRequest) (*QueryPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PaymentStream not implemented")
+}
+func (*UnimplementedQueryServer) RevokedPolicies(ctx context.Context, req *QueryRevokedPoliciesRequest) (*QueryRevokedPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokedPolicies not implemented")
+}
+func (*UnimplementedQueryServer) PolicyRevoked(ctx context.Context, req *QueryPolicyRevokedRequest) (*QueryPolicyRevokedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PolicyRevoked not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredPolicy(ctx context.Context, req *QueryRegisteredPolicyRequest) (*QueryRegisteredPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredPolicy not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredPolicies(ctx context.Context, req *QueryRegisteredPoliciesRequest) (*QueryRegisteredPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredPolicies not implemented")
+}
+func (*UnimplementedQueryServer) AgentReputation(ctx context.Context, req *QueryAgentReputationRequest) (*QueryAgentReputationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentReputation not implemented")
+}
+func (*UnimplementedQueryServer) AgentFeedback(ctx context.Context, req *QueryAgentFeedbackRequest) (*QueryAgentFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentFeedback not implemented")
+}
+func (*UnimplementedQueryServer) AgentFeedbacks(ctx context.Context, req *QueryAgentFeedbacksRequest) (*QueryAgentFeedbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AgentFeedbacks not implemented")
 }
 
-func (m *QueryAgentFeedbacksRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/Params",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAgentFeedbacksResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Agent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Feedbacks) > 0 {
-		for _, e := range m.Feedbacks {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Agent(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/Agent",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Agent(ctx, req.(*QueryAgentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRevokedPoliciesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Agents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).Agents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/Agents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Agents(ctx, req.(*QueryAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRevokedPoliciesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_AgentActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Fingerprints) > 0 {
-		for _, s := range m.Fingerprints {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).AgentActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AgentActions(ctx, req.(*QueryAgentActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AgentAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AgentAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AgentAction(ctx, req.(*QueryAgentActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionLogCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActionLogCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionLogCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/ActionLogCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionLogCommitment(ctx, req.(*QueryActionLogCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ActionLogProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryActionLogProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ActionLogProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/ActionLogProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ActionLogProof(ctx, req.(*QueryActionLogProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EscrowBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEscrowBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EscrowBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/EscrowBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EscrowBalance(ctx, req.(*QueryEscrowBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SubAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubAgentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SubAgents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/SubAgents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SubAgents(ctx, req.(*QuerySubAgentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AggregateSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAggregateSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AggregateSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/AggregateSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AggregateSpend(ctx, req.(*QueryAggregateSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecipientPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecipientPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecipientPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/RecipientPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecipientPolicy(ctx, req.(*QueryRecipientPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingWithdrawals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingWithdrawalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingWithdrawals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/PendingWithdrawals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingWithdrawals(ctx, req.(*QueryPendingWithdrawalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStreams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStreams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/PaymentStreams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStreams(ctx, req.(*QueryPaymentStreamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPaymentStreamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/PaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PaymentStream(ctx, req.(*QueryPaymentStreamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevokedPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRevokedPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevokedPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/RevokedPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevokedPolicies(ctx, req.(*QueryRevokedPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PolicyRevoked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicyRevokedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PolicyRevoked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/PolicyRevoked",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PolicyRevoked(ctx, req.(*QueryPolicyRevokedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/RegisteredPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredPolicy(ctx, req.(*QueryRegisteredPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/RegisteredPolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredPolicies(ctx, req.(*QueryRegisteredPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AgentReputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AgentReputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentReputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AgentReputation(ctx, req.(*QueryAgentReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AgentFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentFeedbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AgentFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentFeedback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AgentFeedback(ctx, req.(*QueryAgentFeedbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AgentFeedbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAgentFeedbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AgentFeedbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Query/AgentFeedbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AgentFeedbacks(ctx, req.(*QueryAgentFeedbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.agent.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Agent",
+			Handler:    _Query_Agent_Handler,
+		},
+		{
+			MethodName: "Agents",
+			Handler:    _Query_Agents_Handler,
+		},
+		{
+			MethodName: "AgentActions",
+			Handler:    _Query_AgentActions_Handler,
+		},
+		{
+			MethodName: "AgentAction",
+			Handler:    _Query_AgentAction_Handler,
+		},
+		{
+			MethodName: "ActionLogCommitment",
+			Handler:    _Query_ActionLogCommitment_Handler,
+		},
+		{
+			MethodName: "ActionLogProof",
+			Handler:    _Query_ActionLogProof_Handler,
+		},
+		{
+			MethodName: "EscrowBalance",
+			Handler:    _Query_EscrowBalance_Handler,
+		},
+		{
+			MethodName: "SubAgents",
+			Handler:    _Query_SubAgents_Handler,
+		},
+		{
+			MethodName: "AggregateSpend",
+			Handler:    _Query_AggregateSpend_Handler,
+		},
+		{
+			MethodName: "RecipientPolicy",
+			Handler:    _Query_RecipientPolicy_Handler,
+		},
+		{
+			MethodName: "PendingWithdrawals",
+			Handler:    _Query_PendingWithdrawals_Handler,
+		},
+		{
+			MethodName: "PaymentStreams",
+			Handler:    _Query_PaymentStreams_Handler,
+		},
+		{
+			MethodName: "PaymentStream",
+			Handler:    _Query_PaymentStream_Handler,
+		},
+		{
+			MethodName: "RevokedPolicies",
+			Handler:    _Query_RevokedPolicies_Handler,
+		},
+		{
+			MethodName: "PolicyRevoked",
+			Handler:    _Query_PolicyRevoked_Handler,
+		},
+		{
+			MethodName: "RegisteredPolicy",
+			Handler:    _Query_RegisteredPolicy_Handler,
+		},
+		{
+			MethodName: "RegisteredPolicies",
+			Handler:    _Query_RegisteredPolicies_Handler,
+		},
+		{
+			MethodName: "AgentReputation",
+			Handler:    _Query_AgentReputation_Handler,
+		},
+		{
+			MethodName: "AgentFeedback",
+			Handler:    _Query_AgentFeedback_Handler,
+		},
+		{
+			MethodName: "AgentFeedbacks",
+			Handler:    _Query_AgentFeedbacks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/agent/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoFingerprints) > 0 {
+		for iNdEx := len(m.CoFingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CoFingerprints[iNdEx])
+			copy(dAtA[i:], m.CoFingerprints[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.CoFingerprints[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Agent.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agents) > 0 {
+		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Agents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Actions) > 0 {
+		for iNdEx := len(m.Actions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Actions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentActionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentActionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentActionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionLogCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionLogCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionLogCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionLogCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionLogCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionLogCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionLogProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionLogProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionLogProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryActionLogProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryActionLogProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryActionLogProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != nil {
+		{
+			size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEscrowBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEscrowBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEscrowBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemainingBudgets) > 0 {
+		for iNdEx := len(m.RemainingBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemainingBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.RemainingWindowBudget.Size()
+		i -= size
+		if _, err := m.RemainingWindowBudget.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubAgentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubAgentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubAgentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubAgentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubAgentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubAgentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Agents) > 0 {
+		for iNdEx := len(m.Agents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Agents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateSpendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateSpendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateSpendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAggregateSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAggregateSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAggregateSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Descendants != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Descendants))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TotalEscrow) > 0 {
+		for iNdEx := len(m.TotalEscrow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TotalSpent) > 0 {
+		for iNdEx := len(m.TotalSpent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalSpent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Spent) > 0 {
+		for iNdEx := len(m.Spent) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Spent[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipientPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipientPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipientPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipientPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipientPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipientPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingWithdrawalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingWithdrawalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingWithdrawalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingWithdrawalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingWithdrawalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingWithdrawalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentReputationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentReputationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentReputationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentReputationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentReputationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentReputationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VerifiedAverageScore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VerifiedAverageScore))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.WeightedScore.Size()
+		i -= size
+		if _, err := m.WeightedScore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.AverageScore != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AverageScore))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentFeedbackRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentFeedbackRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentFeedbackRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentFeedbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentFeedbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentFeedbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Feedback.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentFeedbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentFeedbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentFeedbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAgentFeedbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAgentFeedbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAgentFeedbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Feedbacks) > 0 {
+		for iNdEx := len(m.Feedbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Feedbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevokedPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevokedPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevokedPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRevokedPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRevokedPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRevokedPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprints) > 0 {
+		for iNdEx := len(m.Fingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Fingerprints[iNdEx])
+			copy(dAtA[i:], m.Fingerprints[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprints[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicyRevokedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyRevokedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyRevokedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicyRevokedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicyRevokedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicyRevokedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredPolicyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredPolicyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredPolicyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredPoliciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredPoliciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredPoliciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAgentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Agent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	if len(m.CoFingerprints) > 0 {
+		for _, s := range m.CoFingerprints {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAgentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Agents) > 0 {
+		for _, e := range m.Agents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Actions) > 0 {
+		for _, e := range m.Actions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentActionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovQuery(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *QueryAgentActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Action.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActionLogCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryActionLogCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commitment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryActionLogProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovQuery(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *QueryActionLogProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Action != nil {
+		l = m.Action.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEscrowBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.RemainingWindowBudget.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RemainingBudgets) > 0 {
+		for _, e := range m.RemainingBudgets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySubAgentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubAgentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Agents) > 0 {
+		for _, e := range m.Agents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateSpendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAggregateSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Spent) > 0 {
+		for _, e := range m.Spent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalSpent) > 0 {
+		for _, e := range m.TotalSpent {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalEscrow) > 0 {
+		for _, e := range m.TotalEscrow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Descendants != 0 {
+		n += 1 + sovQuery(uint64(m.Descendants))
+	}
+	return n
+}
+
+func (m *QueryRecipientPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecipientPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingWithdrawalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingWithdrawalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovQuery(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *QueryPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAgentReputationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentReputationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reputation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.AverageScore != 0 {
+		n += 1 + sovQuery(uint64(m.AverageScore))
+	}
+	l = m.WeightedScore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.VerifiedAverageScore != 0 {
+		n += 1 + sovQuery(uint64(m.VerifiedAverageScore))
+	}
+	return n
+}
+
+func (m *QueryAgentFeedbackRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentFeedbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Feedback.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAgentFeedbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAgentFeedbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Feedbacks) > 0 {
+		for _, e := range m.Feedbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRevokedPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRevokedPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fingerprints) > 0 {
+		for _, s := range m.Fingerprints {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPolicyRevokedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicyRevokedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Revoked {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryRegisteredPolicyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Policy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Head.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRegisteredPoliciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Agent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoFingerprints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoFingerprints = append(m.CoFingerprints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agents = append(m.Agents, Agent{})
+			if err := m.Agents[len(m.Agents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentActionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentActionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentActionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentActionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Actions = append(m.Actions, ActionLogEntry{})
+			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentActionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentActionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAgentActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAgentActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAgentActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionLogCommitmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionLogCommitmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionLogCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionLogCommitmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionLogCommitmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionLogCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionLogProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionLogProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionLogProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryActionLogProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryActionLogProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryActionLogProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = append(m.Root[:0], dAtA[iNdEx:postIndex]...)
+			if m.Root == nil {
+				m.Root = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Action == nil {
+				m.Action = &ActionLogEntry{}
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowBalanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEscrowBalanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEscrowBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingWindowBudget", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingWindowBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemainingBudgets = append(m.RemainingBudgets, types.Coin{})
+			if err := m.RemainingBudgets[len(m.RemainingBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubAgentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubAgentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubAgentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySubAgentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySubAgentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySubAgentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Agents = append(m.Agents, Agent{})
+			if err := m.Agents[len(m.Agents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateSpendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateSpendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateSpendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAggregateSpendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAggregateSpendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAggregateSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spent = append(m.Spent, types.Coin{})
+			if err := m.Spent[len(m.Spent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSpent", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalSpent = append(m.TotalSpent, types.Coin{})
+			if err := m.TotalSpent[len(m.TotalSpent)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEscrow = append(m.TotalEscrow, types.Coin{})
+			if err := m.TotalEscrow[len(m.TotalEscrow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Descendants", wireType)
+			}
+			m.Descendants = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Descendants |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecipientPolicyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecipientPolicyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecipientPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecipientPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecipientPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecipientPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, RecipientUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *QueryPolicyRevokedRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryPolicyRevokedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Revoked {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingWithdrawalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2808,12 +8021,80 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingWithdrawalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingWithdrawalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2835,7 +8116,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingWithdrawalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2858,15 +8139,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingWithdrawalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingWithdrawalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2893,7 +8174,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Withdrawals = append(m.Withdrawals, PendingWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2918,7 +8236,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2937,21 +8255,53 @@ func (m *QueryAgentRequest) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPaymentStreamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2961,23 +8311,27 @@ func (m *QueryAgentRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3000,7 +8354,7 @@ func (m *QueryAgentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3023,15 +8377,15 @@ func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3058,15 +8412,16 @@ func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Agent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Streams = append(m.Streams, PaymentStream{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3076,44 +8431,28 @@ func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Revoked = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3135,7 +8474,7 @@ func (m *QueryAgentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3158,17 +8497,17 @@ func (m *QueryAgentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3178,28 +8517,43 @@ func (m *QueryAgentsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3221,7 +8575,7 @@ func (m *QueryAgentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3244,49 +8598,15 @@ func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Agents", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Agents = append(m.Agents, Agent{})
-			if err := m.Agents[len(m.Agents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3313,10 +8633,7 @@ func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3341,7 +8658,7 @@ func (m *QueryAgentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentActionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAgentReputationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3364,10 +8681,10 @@ func (m *QueryAgentActionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentActionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAgentReputationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAgentReputationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3402,42 +8719,6 @@ func (m *QueryAgentActionsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3459,7 +8740,7 @@ func (m *QueryAgentActionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentActionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAgentReputationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3482,15 +8763,15 @@ func (m *QueryAgentActionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentActionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAgentReputationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAgentReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3517,16 +8798,68 @@ func (m *QueryAgentActionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Actions = append(m.Actions, ActionLogEntry{})
-			if err := m.Actions[len(m.Actions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageScore", wireType)
+			}
+			m.AverageScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AverageScore |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedScore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WeightedScore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3536,28 +8869,45 @@ func (m *QueryAgentActionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedAverageScore", wireType)
+			}
+			m.VerifiedAverageScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VerifiedAverageScore |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3579,7 +8929,7 @@ func (m *QueryAgentActionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentActionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAgentFeedbackRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3602,10 +8952,10 @@ func (m *QueryAgentActionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentActionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAgentFeedbackRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentActionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAgentFeedbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3641,10 +8991,10 @@ func (m *QueryAgentActionRequest) Unmarshal(dAtA []byte) error {
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
 			}
-			m.Seq = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3654,11 +9004,24 @@ func (m *QueryAgentActionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Client = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3680,7 +9043,7 @@ func (m *QueryAgentActionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentActionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAgentFeedbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3703,15 +9066,15 @@ func (m *QueryAgentActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAgentFeedbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAgentFeedbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feedback", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3738,7 +9101,7 @@ func (m *QueryAgentActionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Feedback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3763,7 +9126,7 @@ func (m *QueryAgentActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAgentFeedbacksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3786,10 +9149,10 @@ func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowBalanceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAgentFeedbacksRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAgentFeedbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3824,6 +9187,42 @@ func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3845,7 +9244,7 @@ func (m *QueryEscrowBalanceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAgentFeedbacksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3868,15 +9267,15 @@ func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEscrowBalanceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAgentFeedbacksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEscrowBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAgentFeedbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Feedbacks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3903,16 +9302,16 @@ func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = append(m.Balance, types.Coin{})
-			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Feedbacks = append(m.Feedbacks, Feedback{})
+			if err := m.Feedbacks[len(m.Feedbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemainingWindowBudget", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3922,23 +9321,25 @@ func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemainingWindowBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3963,7 +9364,7 @@ func (m *QueryEscrowBalanceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentReputationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRevokedPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3986,44 +9387,12 @@ func (m *QueryAgentReputationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentReputationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevokedPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentReputationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevokedPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4045,7 +9414,7 @@ func (m *QueryAgentReputationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentReputationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRevokedPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4068,17 +9437,17 @@ func (m *QueryAgentReputationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentReputationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRevokedPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentReputationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRevokedPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprints", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4088,44 +9457,24 @@ func (m *QueryAgentReputationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fingerprints = append(m.Fingerprints, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AverageScore", wireType)
-			}
-			m.AverageScore = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AverageScore |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4147,7 +9496,7 @@ func (m *QueryAgentReputationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentFeedbackRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyRevokedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4169,48 +9518,16 @@ func (m *QueryAgentFeedbackRequest) Unmarshal(dAtA []byte) error {
 		}
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentFeedbackRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentFeedbackRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicyRevokedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicyRevokedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Client", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4238,7 +9555,7 @@ func (m *QueryAgentFeedbackRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Client = string(dAtA[iNdEx:postIndex])
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4261,7 +9578,7 @@ func (m *QueryAgentFeedbackRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentFeedbackResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPolicyRevokedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4284,17 +9601,17 @@ func (m *QueryAgentFeedbackResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentFeedbackResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPolicyRevokedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentFeedbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPolicyRevokedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feedback", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4304,25 +9621,12 @@ func (m *QueryAgentFeedbackResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Feedback.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Revoked = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4344,7 +9648,7 @@ func (m *QueryAgentFeedbackResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentFeedbacksRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRegisteredPolicyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4367,15 +9671,15 @@ func (m *QueryAgentFeedbacksRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentFeedbacksRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegisteredPolicyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentFeedbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegisteredPolicyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4403,43 +9707,7 @@ func (m *QueryAgentFeedbacksRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4462,7 +9730,7 @@ func (m *QueryAgentFeedbacksRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAgentFeedbacksResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRegisteredPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4485,15 +9753,15 @@ func (m *QueryAgentFeedbacksResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAgentFeedbacksResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegisteredPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAgentFeedbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegisteredPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Feedbacks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4520,14 +9788,13 @@ func (m *QueryAgentFeedbacksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Feedbacks = append(m.Feedbacks, Feedback{})
-			if err := m.Feedbacks[len(m.Feedbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4554,10 +9821,7 @@ func (m *QueryAgentFeedbacksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4582,57 +9846,7 @@ func (m *QueryAgentFeedbacksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRevokedPoliciesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevokedPoliciesRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevokedPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRevokedPoliciesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRegisteredPoliciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4655,17 +9869,17 @@ func (m *QueryRevokedPoliciesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRevokedPoliciesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegisteredPoliciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRevokedPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegisteredPoliciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4675,23 +9889,27 @@ func (m *QueryRevokedPoliciesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprints = append(m.Fingerprints, string(dAtA[iNdEx:postIndex]))
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4714,7 +9932,7 @@ func (m *QueryRevokedPoliciesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPolicyRevokedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRegisteredPoliciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4737,17 +9955,17 @@ func (m *QueryPolicyRevokedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyRevokedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRegisteredPoliciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyRevokedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRegisteredPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4757,79 +9975,31 @@ func (m *QueryPolicyRevokedRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Policies = append(m.Policies, RegisteredPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPolicyRevokedResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPolicyRevokedResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPolicyRevokedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4839,12 +10009,28 @@ func (m *QueryPolicyRevokedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Revoked = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,85 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// IsEmpty reports whether the policy places no restriction on recipients.
+func (p RecipientPolicy) IsEmpty() bool {
+	return len(p.Allowlist) == 0 && p.PerRecipientCap.IsZero() && p.CapWindowBlocks == 0 && !p.DymNameResolvedOnly
+}
+
+// Validate checks the allowlist holds distinct bech32 accounts and that the
+// cap fields are set together, so CapBucket can never divide by zero.
+func (p RecipientPolicy) Validate() error {
+	seen := make(map[string]struct{}, len(p.Allowlist))
+	for _, r := range p.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(r); err != nil {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "allowlist address: %s", r)
+		}
+		if _, dup := seen[r]; dup {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "duplicate allowlist address: %s", r)
+		}
+		seen[r] = struct{}{}
+	}
+	if err := p.PerRecipientCap.Validate(); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "per recipient cap")
+	}
+	if p.PerRecipientCap.IsZero() != (p.CapWindowBlocks == 0) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "per recipient cap and cap window blocks must be set together")
+	}
+	return nil
+}
+
+// Allows reports whether recipient is on the allowlist; an empty allowlist
+// allows everyone.
+func (p RecipientPolicy) Allows(recipient string) bool {
+	if len(p.Allowlist) == 0 {
+		return true
+	}
+	for _, r := range p.Allowlist {
+		if r == recipient {
+			return true
+		}
+	}
+	return false
+}
+
+// CapBucket returns the start height of the absolute-aligned cap window that
+// nowHeight falls into. Only meaningful when CapWindowBlocks > 0.
+func (p RecipientPolicy) CapBucket(nowHeight uint64) uint64 {
+	return nowHeight - (nowHeight % p.CapWindowBlocks)
+}
+
+// CurrentReceived returns what the recipient received in the cap window
+// containing nowHeight: nothing once the stored window has elapsed.
+func (u RecipientUsage) CurrentReceived(p RecipientPolicy, nowHeight uint64) sdk.Coins {
+	if p.CapWindowBlocks == 0 || p.CapBucket(nowHeight) != u.WindowStartHeight {
+		return sdk.NewCoins()
+	}
+	return u.Received
+}
+
+// CapAllows reports whether paying amount to the recipient at nowHeight stays
+// within the per-recipient cap of amount's denom.
+func (u RecipientUsage) CapAllows(p RecipientPolicy, nowHeight uint64, amount sdk.Coin) bool {
+	limit := p.PerRecipientCap.AmountOf(amount.Denom)
+	if limit.IsZero() {
+		return true // uncapped denom
+	}
+	received := u.CurrentReceived(p, nowHeight).AmountOf(amount.Denom)
+	return received.Add(amount.Amount).LTE(limit)
+}
+
+// RecordReceived accounts amount paid at nowHeight, rolling the window over
+// when nowHeight falls into a new bucket.
+func (u *RecipientUsage) RecordReceived(p RecipientPolicy, nowHeight uint64, amount sdk.Coin) {
+	bucket := p.CapBucket(nowHeight)
+	if bucket != u.WindowStartHeight {
+		u.WindowStartHeight = bucket
+		u.Received = sdk.NewCoins()
+	}
+	u.Received = u.Received.Add(amount)
+}
@@ -0,0 +1,31 @@
+package types
+
+// Add counts fb into the aggregate.
+func (r *Reputation) Add(fb Feedback) {
+	r.Count++
+	r.ScoreSum += uint64(fb.Score)
+	if fb.Verified {
+		r.VerifiedCount++
+		r.VerifiedScoreSum += uint64(fb.Score)
+	}
+}
+
+// Remove takes fb, previously added, out of the aggregate.
+func (r *Reputation) Remove(fb Feedback) {
+	r.Count--
+	r.ScoreSum -= uint64(fb.Score)
+	if fb.Verified {
+		r.VerifiedCount--
+		r.VerifiedScoreSum -= uint64(fb.Score)
+	}
+}
+
+// VerifiedAverageScore is the average over verified feedback, 0 when there is
+// none.
+func (r Reputation) VerifiedAverageScore() uint32 {
+	if r.VerifiedCount == 0 {
+		return 0
+	}
+	// fits uint32: every score is <= MaxFeedbackScore, so the mean is too
+	return uint32(r.VerifiedScoreSum / r.VerifiedCount) //nolint:gosec
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// MaxWithdrawalDelayBlocks bounds the withdrawal timelock (about a year of
+// 6s blocks) so mature heights cannot overflow and an owner cannot lock its
+// escrow forever by mistake.
+const MaxWithdrawalDelayBlocks = 5_256_000
+
+func (p SecurityPolicy) Validate() error {
+	if p.Guardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.Guardian); err != nil {
+			return errorsmod.Wrap(gerrc.ErrInvalidArgument, "guardian address")
+		}
+	}
+	if p.WithdrawalDelayBlocks > MaxWithdrawalDelayBlocks {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "withdrawal delay blocks exceeds max: got %d, max %d", p.WithdrawalDelayBlocks, MaxWithdrawalDelayBlocks)
+	}
+	return nil
+}
+
+// Tightens reports whether moving from p to next only adds protection: a
+// guardian is set where there was none (or kept), and the delay does not
+// shrink. Such updates are safe to apply immediately; anything else could be
+// an attacker holding the owner key disarming the controls.
+func (p SecurityPolicy) Tightens(next SecurityPolicy) bool {
+	guardianKept := p.Guardian == "" || p.Guardian == next.Guardian
+	return guardianKept && next.WithdrawalDelayBlocks >= p.WithdrawalDelayBlocks
+}
+
+// PromotePendingSecurity applies a matured pending security policy in place.
+// Callers persist the agent to make the promotion durable.
+func (a *Agent) PromotePendingSecurity(height int64) {
+	if a.PendingSecurity != nil && height >= a.PendingSecurityHeight {
+		a.Security = *a.PendingSecurity
+		a.PendingSecurity = nil
+		a.PendingSecurityHeight = 0
+	}
+}
+
+// EffectiveSecurity returns the security policy in force at height without
+// mutating the stored agent.
+func (a Agent) EffectiveSecurity(height int64) SecurityPolicy {
+	a.PromotePendingSecurity(height)
+	return a.Security
+}
+
+// ValidateSecurityState checks the agent's security policy and any pending
+// update are valid.
+func (a Agent) ValidateSecurityState() error {
+	if err := a.Security.Validate(); err != nil {
+		return errorsmod.Wrap(err, "security policy")
+	}
+	if a.PendingSecurity != nil {
+		if err := a.PendingSecurity.Validate(); err != nil {
+			return errorsmod.Wrap(err, "pending security policy")
+		}
+	} else if a.PendingSecurityHeight != 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "pending security height set without pending security policy")
+	}
+	return nil
+}
+
+func (w PendingWithdrawal) Validate() error {
+	if w.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	if _, err := sdk.AccAddressFromBech32(w.Owner); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "owner address")
+	}
+	if err := w.Amount.Validate(); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
+	}
+	if w.Amount.IsZero() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty amount")
+	}
+	if w.MatureHeight <= 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "mature height must be positive")
+	}
+	return nil
+}
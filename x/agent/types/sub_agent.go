@@ -0,0 +1,94 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// IsSubAgent reports whether the agent was created by a parent agent.
+func (a Agent) IsSubAgent() bool {
+	return a.ParentId != ""
+}
+
+// ValidateBasic checks the spec is a valid registration with a valid budget
+// and spend budgets. A sub-agent has no primary spend denom: all its budgets
+// live in spend_budgets.
+func (s SubAgentSpec) ValidateBasic() error {
+	if s.AgentId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty agent id")
+	}
+	if err := validatePolicy(s.Policy); err != nil {
+		return err
+	}
+	if err := validatePolicySetFor(s.Policy, s.PolicySet); err != nil {
+		return err
+	}
+	if err := s.Budget.Validate(); err != nil {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "budget: %s", err)
+	}
+	if err := ValidateSpendBudgets("", s.SpendBudgets); err != nil {
+		return err
+	}
+	for _, b := range s.SpendBudgets {
+		if b.hasWindowState() {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "spend budget %s: window state must be unset", b.Denom)
+		}
+	}
+	return nil
+}
+
+// SubAgentSpecBytes is the payload a sub-agent creation nonce commits to: the
+// proto marshaling of the spec, which has no map fields and so is
+// deterministic.
+func SubAgentSpecBytes(s SubAgentSpec) ([]byte, error) {
+	return s.Marshal()
+}
+
+// RequiredAttestationsFor returns the attestations required to move amount
+// out of the escrow: the strictest of the per-denom requirements, or that of
+// an action when amount is empty.
+func (q AttestationQuorum) RequiredAttestationsFor(amount sdk.Coins) uint32 {
+	if amount.Empty() {
+		return q.RequiredAttestations(nil)
+	}
+	var required uint32
+	for _, c := range amount {
+		required = max(required, q.RequiredAttestations(&c))
+	}
+	return required
+}
+
+// validateSubAgentTree checks that every parent is a known agent sharing the
+// child's owner, that the parent links form no cycle, and that no active
+// agent sits below an inactive one, since deactivation cascades.
+func validateSubAgentTree(agents []Agent) error {
+	byID := make(map[string]Agent, len(agents))
+	for _, a := range agents {
+		byID[a.Id] = a
+	}
+	for _, a := range agents {
+		if !a.IsSubAgent() {
+			continue
+		}
+		parent, ok := byID[a.ParentId]
+		if !ok {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "agent %s: unknown parent %s", a.Id, a.ParentId)
+		}
+		if parent.Owner != a.Owner {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "agent %s: owner differs from parent %s", a.Id, a.ParentId)
+		}
+		if a.Active && !parent.Active {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "agent %s: active below inactive parent %s", a.Id, a.ParentId)
+		}
+		// a chain longer than the agent count has revisited an agent
+		cur := a
+		for i := 0; cur.IsSubAgent(); i++ {
+			if i == len(agents) {
+				return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "agent %s: parent cycle", a.Id)
+			}
+			cur = byID[cur.ParentId]
+		}
+	}
+	return nil
+}
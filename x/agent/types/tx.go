@@ -15,7 +15,7 @@ func (m *MsgSubmitAttestedAction) ValidateBasic() error {
 	if m.Token == "" {
 		return gerrc.ErrInvalidArgument.Wrap("token is required")
 	}
-	return nil
+	return validateCoAttestations(m.CoAttestations)
 }
 
 func (m *MsgSubmitAttestedTransfer) ValidateBasic() error {
@@ -31,8 +31,11 @@ func (m *MsgSubmitAttestedTransfer) ValidateBasic() error {
 	if m.Amount.IsNil() || !m.Amount.IsPositive() {
 		return gerrc.ErrInvalidArgument.Wrap("amount must be positive")
 	}
+	if m.Denom != "" && sdk.ValidateDenom(m.Denom) != nil {
+		return gerrc.ErrInvalidArgument.Wrap("denom")
+	}
 	if m.Token == "" {
 		return gerrc.ErrInvalidArgument.Wrap("token is required")
 	}
-	return nil
+	return validateCoAttestations(m.CoAttestations)
 }
@@ -14,19 +14,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	tee "github.com/dymensionxyz/dymension/v3/x/common/tee"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +43,11 @@ type MsgRegisterAgent struct {
 	Owner   string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	AgentId string     `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	Policy  tee.Policy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
+	// policy_set optionally registers co-policies and an attestation quorum.
+	PolicySet PolicySet `protobuf:"bytes,4,opt,name=policy_set,json=policySet,proto3" json:"policy_set"`
+	// policy_id, instead of policy, binds the agent to a registered policy
+	// lineage.
+	PolicyId string `protobuf:"bytes,5,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
 }
 
 func (m *MsgRegisterAgent) Reset()         { *m = MsgRegisterAgent{} }
@@ -94,6 +104,20 @@ func (m *MsgRegisterAgent) GetPolicy() tee.Policy {
 	return tee.Policy{}
 }
 
+func (m *MsgRegisterAgent) GetPolicySet() PolicySet {
+	if m != nil {
+		return m.PolicySet
+	}
+	return PolicySet{}
+}
+
+func (m *MsgRegisterAgent) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
 type MsgRegisterAgentResponse struct {
 }
 
@@ -222,6 +246,9 @@ type MsgUpdateAgentPolicy struct {
 	Owner     string     `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	AgentId   string     `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	NewPolicy tee.Policy `protobuf:"bytes,3,opt,name=new_policy,json=newPolicy,proto3" json:"new_policy"`
+	// new_policy_id, instead of new_policy, rotates to a registered policy
+	// lineage.
+	NewPolicyId string `protobuf:"bytes,4,opt,name=new_policy_id,json=newPolicyId,proto3" json:"new_policy_id,omitempty"`
 }
 
 func (m *MsgUpdateAgentPolicy) Reset()         { *m = MsgUpdateAgentPolicy{} }
@@ -278,6 +305,13 @@ func (m *MsgUpdateAgentPolicy) GetNewPolicy() tee.Policy {
 	return tee.Policy{}
 }
 
+func (m *MsgUpdateAgentPolicy) GetNewPolicyId() string {
+	if m != nil {
+		return m.NewPolicyId
+	}
+	return ""
+}
+
 type MsgUpdateAgentPolicyResponse struct {
 	ActivationHeight int64 `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
@@ -322,6 +356,164 @@ func (m *MsgUpdateAgentPolicyResponse) GetActivationHeight() int64 {
 	return 0
 }
 
+type MsgUpdateAgentPolicySet struct {
+	Owner     string    `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	AgentId   string    `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	PolicySet PolicySet `protobuf:"bytes,3,opt,name=policy_set,json=policySet,proto3" json:"policy_set"`
+}
+
+func (m *MsgUpdateAgentPolicySet) Reset()         { *m = MsgUpdateAgentPolicySet{} }
+func (m *MsgUpdateAgentPolicySet) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgentPolicySet) ProtoMessage()    {}
+func (*MsgUpdateAgentPolicySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{6}
+}
+func (m *MsgUpdateAgentPolicySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAgentPolicySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAgentPolicySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAgentPolicySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgentPolicySet.Merge(m, src)
+}
+func (m *MsgUpdateAgentPolicySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAgentPolicySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgentPolicySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAgentPolicySet proto.InternalMessageInfo
+
+func (m *MsgUpdateAgentPolicySet) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUpdateAgentPolicySet) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
+}
+
+func (m *MsgUpdateAgentPolicySet) GetPolicySet() PolicySet {
+	if m != nil {
+		return m.PolicySet
+	}
+	return PolicySet{}
+}
+
+type MsgUpdateAgentPolicySetResponse struct {
+	ActivationHeight int64 `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+}
+
+func (m *MsgUpdateAgentPolicySetResponse) Reset()         { *m = MsgUpdateAgentPolicySetResponse{} }
+func (m *MsgUpdateAgentPolicySetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgentPolicySetResponse) ProtoMessage()    {}
+func (*MsgUpdateAgentPolicySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{7}
+}
+func (m *MsgUpdateAgentPolicySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAgentPolicySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAgentPolicySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAgentPolicySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgentPolicySetResponse.Merge(m, src)
+}
+func (m *MsgUpdateAgentPolicySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAgentPolicySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgentPolicySetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAgentPolicySetResponse proto.InternalMessageInfo
+
+func (m *MsgUpdateAgentPolicySetResponse) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// CoAttestation is an attestation token from the agent's co-policy at
+// policy_index, over the same nonce as the primary token.
+type CoAttestation struct {
+	PolicyIndex uint32 `protobuf:"varint,1,opt,name=policy_index,json=policyIndex,proto3" json:"policy_index,omitempty"`
+	Token       string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (m *CoAttestation) Reset()         { *m = CoAttestation{} }
+func (m *CoAttestation) String() string { return proto.CompactTextString(m) }
+func (*CoAttestation) ProtoMessage()    {}
+func (*CoAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{8}
+}
+func (m *CoAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CoAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CoAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CoAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CoAttestation.Merge(m, src)
+}
+func (m *CoAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CoAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CoAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CoAttestation proto.InternalMessageInfo
+
+func (m *CoAttestation) GetPolicyIndex() uint32 {
+	if m != nil {
+		return m.PolicyIndex
+	}
+	return 0
+}
+
+func (m *CoAttestation) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
 // MsgSubmitAttestedAction submits an attested action on behalf of an agent.
 // The submitter signs and pays for the tx but is not validated against the
 // agent: the enclave token authorizes the content, so any account may submit a
@@ -331,13 +523,16 @@ type MsgSubmitAttestedAction struct {
 	AgentId   string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	Payload   []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
 	Token     string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	// co_attestations count toward the agent's attestation quorum. Each must
+	// verify.
+	CoAttestations []CoAttestation `protobuf:"bytes,5,rep,name=co_attestations,json=coAttestations,proto3" json:"co_attestations"`
 }
 
 func (m *MsgSubmitAttestedAction) Reset()         { *m = MsgSubmitAttestedAction{} }
 func (m *MsgSubmitAttestedAction) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitAttestedAction) ProtoMessage()    {}
 func (*MsgSubmitAttestedAction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{6}
+	return fileDescriptor_cc4323968b6c653f, []int{9}
 }
 func (m *MsgSubmitAttestedAction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -394,6 +589,13 @@ func (m *MsgSubmitAttestedAction) GetToken() string {
 	return ""
 }
 
+func (m *MsgSubmitAttestedAction) GetCoAttestations() []CoAttestation {
+	if m != nil {
+		return m.CoAttestations
+	}
+	return nil
+}
+
 type MsgSubmitAttestedActionResponse struct {
 	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 }
@@ -402,7 +604,7 @@ func (m *MsgSubmitAttestedActionResponse) Reset()         { *m = MsgSubmitAttest
 func (m *MsgSubmitAttestedActionResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitAttestedActionResponse) ProtoMessage()    {}
 func (*MsgSubmitAttestedActionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{7}
+	return fileDescriptor_cc4323968b6c653f, []int{10}
 }
 func (m *MsgSubmitAttestedActionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -448,7 +650,7 @@ func (m *MsgFundAgentEscrow) Reset()         { *m = MsgFundAgentEscrow{} }
 func (m *MsgFundAgentEscrow) String() string { return proto.CompactTextString(m) }
 func (*MsgFundAgentEscrow) ProtoMessage()    {}
 func (*MsgFundAgentEscrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{8}
+	return fileDescriptor_cc4323968b6c653f, []int{11}
 }
 func (m *MsgFundAgentEscrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +707,7 @@ func (m *MsgFundAgentEscrowResponse) Reset()         { *m = MsgFundAgentEscrowRe
 func (m *MsgFundAgentEscrowResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundAgentEscrowResponse) ProtoMessage()    {}
 func (*MsgFundAgentEscrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{9}
+	return fileDescriptor_cc4323968b6c653f, []int{12}
 }
 func (m *MsgFundAgentEscrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +746,7 @@ func (m *MsgWithdrawAgentEscrow) Reset()         { *m = MsgWithdrawAgentEscrow{}
 func (m *MsgWithdrawAgentEscrow) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawAgentEscrow) ProtoMessage()    {}
 func (*MsgWithdrawAgentEscrow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{10}
+	return fileDescriptor_cc4323968b6c653f, []int{13}
 }
 func (m *MsgWithdrawAgentEscrow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -595,13 +797,15 @@ func (m *MsgWithdrawAgentEscrow) GetAmount() github_com_cosmos_cosmos_sdk_types.
 }
 
 type MsgWithdrawAgentEscrowResponse struct {
+	// pending is the queued withdrawal; unset when paid out immediately.
+	Pending *PendingWithdrawal `protobuf:"bytes,1,opt,name=pending,proto3" json:"pending,omitempty"`
 }
 
 func (m *MsgWithdrawAgentEscrowResponse) Reset()         { *m = MsgWithdrawAgentEscrowResponse{} }
 func (m *MsgWithdrawAgentEscrowResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawAgentEscrowResponse) ProtoMessage()    {}
 func (*MsgWithdrawAgentEscrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{11}
+	return fileDescriptor_cc4323968b6c653f, []int{14}
 }
 func (m *MsgWithdrawAgentEscrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,27 +834,31 @@ func (m *MsgWithdrawAgentEscrowResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawAgentEscrowResponse proto.InternalMessageInfo
 
-type MsgUpdateAgentSpendPolicy struct {
-	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	// spend_denom is the single spendable denom; empty disables spending.
-	SpendDenom          string                `protobuf:"bytes,3,opt,name=spend_denom,json=spendDenom,proto3" json:"spend_denom,omitempty"`
-	SpendLimitPerWindow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=spend_limit_per_window,json=spendLimitPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit_per_window"`
-	SpendWindowBlocks   uint64                `protobuf:"varint,5,opt,name=spend_window_blocks,json=spendWindowBlocks,proto3" json:"spend_window_blocks,omitempty"`
+func (m *MsgWithdrawAgentEscrowResponse) GetPending() *PendingWithdrawal {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
 }
 
-func (m *MsgUpdateAgentSpendPolicy) Reset()         { *m = MsgUpdateAgentSpendPolicy{} }
-func (m *MsgUpdateAgentSpendPolicy) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateAgentSpendPolicy) ProtoMessage()    {}
-func (*MsgUpdateAgentSpendPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{12}
+type MsgCancelAgentWithdrawal struct {
+	Owner        string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	AgentId      string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	WithdrawalId uint64 `protobuf:"varint,3,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
 }
-func (m *MsgUpdateAgentSpendPolicy) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCancelAgentWithdrawal) Reset()         { *m = MsgCancelAgentWithdrawal{} }
+func (m *MsgCancelAgentWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAgentWithdrawal) ProtoMessage()    {}
+func (*MsgCancelAgentWithdrawal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{15}
+}
+func (m *MsgCancelAgentWithdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateAgentSpendPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAgentWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateAgentSpendPolicy.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAgentWithdrawal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -660,61 +868,54 @@ func (m *MsgUpdateAgentSpendPolicy) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateAgentSpendPolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateAgentSpendPolicy.Merge(m, src)
+func (m *MsgCancelAgentWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAgentWithdrawal.Merge(m, src)
 }
-func (m *MsgUpdateAgentSpendPolicy) XXX_Size() int {
+func (m *MsgCancelAgentWithdrawal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateAgentSpendPolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateAgentSpendPolicy.DiscardUnknown(m)
+func (m *MsgCancelAgentWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAgentWithdrawal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateAgentSpendPolicy proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAgentWithdrawal proto.InternalMessageInfo
 
-func (m *MsgUpdateAgentSpendPolicy) GetOwner() string {
+func (m *MsgCancelAgentWithdrawal) GetOwner() string {
 	if m != nil {
 		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgUpdateAgentSpendPolicy) GetAgentId() string {
+func (m *MsgCancelAgentWithdrawal) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-func (m *MsgUpdateAgentSpendPolicy) GetSpendDenom() string {
-	if m != nil {
-		return m.SpendDenom
-	}
-	return ""
-}
-
-func (m *MsgUpdateAgentSpendPolicy) GetSpendWindowBlocks() uint64 {
+func (m *MsgCancelAgentWithdrawal) GetWithdrawalId() uint64 {
 	if m != nil {
-		return m.SpendWindowBlocks
+		return m.WithdrawalId
 	}
 	return 0
 }
 
-type MsgUpdateAgentSpendPolicyResponse struct {
+type MsgCancelAgentWithdrawalResponse struct {
 }
 
-func (m *MsgUpdateAgentSpendPolicyResponse) Reset()         { *m = MsgUpdateAgentSpendPolicyResponse{} }
-func (m *MsgUpdateAgentSpendPolicyResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateAgentSpendPolicyResponse) ProtoMessage()    {}
-func (*MsgUpdateAgentSpendPolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{13}
+func (m *MsgCancelAgentWithdrawalResponse) Reset()         { *m = MsgCancelAgentWithdrawalResponse{} }
+func (m *MsgCancelAgentWithdrawalResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelAgentWithdrawalResponse) ProtoMessage()    {}
+func (*MsgCancelAgentWithdrawalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{16}
 }
-func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelAgentWithdrawalResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelAgentWithdrawalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateAgentSpendPolicyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelAgentWithdrawalResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -724,44 +925,36 @@ func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateAgentSpendPolicyResponse.Merge(m, src)
+func (m *MsgCancelAgentWithdrawalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelAgentWithdrawalResponse.Merge(m, src)
 }
-func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Size() int {
+func (m *MsgCancelAgentWithdrawalResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateAgentSpendPolicyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateAgentSpendPolicyResponse.DiscardUnknown(m)
+func (m *MsgCancelAgentWithdrawalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelAgentWithdrawalResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateAgentSpendPolicyResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelAgentWithdrawalResponse proto.InternalMessageInfo
 
-// MsgSubmitAttestedTransfer submits an attested transfer on behalf of an
-// agent. Like MsgSubmitAttestedAction, the submitter signs and pays for the tx
-// but is not validated against the agent: the enclave token is bound to the
-// exact (recipient, denom, amount, memo) via the nonce, so the enclave — not
-// the submitter — authorizes the payment.
-type MsgSubmitAttestedTransfer struct {
-	Submitter string                `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
-	AgentId   string                `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	Recipient string                `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	Amount    cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
-	Memo      []byte                `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
-	Token     string                `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+type MsgUpdateAgentSecurityPolicy struct {
+	Owner   string         `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	AgentId string         `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Policy  SecurityPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
 }
 
-func (m *MsgSubmitAttestedTransfer) Reset()         { *m = MsgSubmitAttestedTransfer{} }
-func (m *MsgSubmitAttestedTransfer) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitAttestedTransfer) ProtoMessage()    {}
-func (*MsgSubmitAttestedTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{14}
+func (m *MsgUpdateAgentSecurityPolicy) Reset()         { *m = MsgUpdateAgentSecurityPolicy{} }
+func (m *MsgUpdateAgentSecurityPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgentSecurityPolicy) ProtoMessage()    {}
+func (*MsgUpdateAgentSecurityPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{17}
 }
-func (m *MsgSubmitAttestedTransfer) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateAgentSecurityPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitAttestedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateAgentSecurityPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitAttestedTransfer.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateAgentSecurityPolicy.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -771,69 +964,57 @@ func (m *MsgSubmitAttestedTransfer) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitAttestedTransfer) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitAttestedTransfer.Merge(m, src)
+func (m *MsgUpdateAgentSecurityPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgentSecurityPolicy.Merge(m, src)
 }
-func (m *MsgSubmitAttestedTransfer) XXX_Size() int {
+func (m *MsgUpdateAgentSecurityPolicy) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitAttestedTransfer) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitAttestedTransfer.DiscardUnknown(m)
+func (m *MsgUpdateAgentSecurityPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgentSecurityPolicy.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitAttestedTransfer proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateAgentSecurityPolicy proto.InternalMessageInfo
 
-func (m *MsgSubmitAttestedTransfer) GetSubmitter() string {
+func (m *MsgUpdateAgentSecurityPolicy) GetOwner() string {
 	if m != nil {
-		return m.Submitter
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgSubmitAttestedTransfer) GetAgentId() string {
+func (m *MsgUpdateAgentSecurityPolicy) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-func (m *MsgSubmitAttestedTransfer) GetRecipient() string {
-	if m != nil {
-		return m.Recipient
-	}
-	return ""
-}
-
-func (m *MsgSubmitAttestedTransfer) GetMemo() []byte {
-	if m != nil {
-		return m.Memo
-	}
-	return nil
-}
-
-func (m *MsgSubmitAttestedTransfer) GetToken() string {
+func (m *MsgUpdateAgentSecurityPolicy) GetPolicy() SecurityPolicy {
 	if m != nil {
-		return m.Token
+		return m.Policy
 	}
-	return ""
+	return SecurityPolicy{}
 }
 
-type MsgSubmitAttestedTransferResponse struct {
-	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+type MsgUpdateAgentSecurityPolicyResponse struct {
+	// activation_height is when the policy takes effect; the current height
+	// when applied immediately.
+	ActivationHeight int64 `protobuf:"varint,1,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
-func (m *MsgSubmitAttestedTransferResponse) Reset()         { *m = MsgSubmitAttestedTransferResponse{} }
-func (m *MsgSubmitAttestedTransferResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitAttestedTransferResponse) ProtoMessage()    {}
-func (*MsgSubmitAttestedTransferResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{15}
+func (m *MsgUpdateAgentSecurityPolicyResponse) Reset()         { *m = MsgUpdateAgentSecurityPolicyResponse{} }
+func (m *MsgUpdateAgentSecurityPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgentSecurityPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateAgentSecurityPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{18}
 }
-func (m *MsgSubmitAttestedTransferResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateAgentSecurityPolicyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitAttestedTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateAgentSecurityPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitAttestedTransferResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateAgentSecurityPolicyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -843,49 +1024,43 @@ func (m *MsgSubmitAttestedTransferResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitAttestedTransferResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitAttestedTransferResponse.Merge(m, src)
+func (m *MsgUpdateAgentSecurityPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgentSecurityPolicyResponse.Merge(m, src)
 }
-func (m *MsgSubmitAttestedTransferResponse) XXX_Size() int {
+func (m *MsgUpdateAgentSecurityPolicyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitAttestedTransferResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitAttestedTransferResponse.DiscardUnknown(m)
+func (m *MsgUpdateAgentSecurityPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgentSecurityPolicyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitAttestedTransferResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateAgentSecurityPolicyResponse proto.InternalMessageInfo
 
-func (m *MsgSubmitAttestedTransferResponse) GetSeq() uint64 {
+func (m *MsgUpdateAgentSecurityPolicyResponse) GetActivationHeight() int64 {
 	if m != nil {
-		return m.Seq
+		return m.ActivationHeight
 	}
 	return 0
 }
 
-// MsgRevokePolicy revokes a TEE policy by fingerprint: agents pinning it can
-// no longer submit attested actions, and new agents cannot register with it.
-type MsgRevokePolicy struct {
-	// authority is the x/gov module account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// fingerprint is the lowercase hex SHA-256 of the deterministic proto
-	// marshaling of the policy.
-	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	// reason documents why the policy was revoked (e.g. a CVE identifier).
-	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+type MsgFreezeAgent struct {
+	// signer is the agent's guardian or the x/gov module account.
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 }
 
-func (m *MsgRevokePolicy) Reset()         { *m = MsgRevokePolicy{} }
-func (m *MsgRevokePolicy) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokePolicy) ProtoMessage()    {}
-func (*MsgRevokePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{16}
+func (m *MsgFreezeAgent) Reset()         { *m = MsgFreezeAgent{} }
+func (m *MsgFreezeAgent) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeAgent) ProtoMessage()    {}
+func (*MsgFreezeAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{19}
 }
-func (m *MsgRevokePolicy) XXX_Unmarshal(b []byte) error {
+func (m *MsgFreezeAgent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFreezeAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokePolicy.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFreezeAgent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -895,54 +1070,47 @@ func (m *MsgRevokePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokePolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokePolicy.Merge(m, src)
+func (m *MsgFreezeAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeAgent.Merge(m, src)
 }
-func (m *MsgRevokePolicy) XXX_Size() int {
+func (m *MsgFreezeAgent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokePolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokePolicy.DiscardUnknown(m)
+func (m *MsgFreezeAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeAgent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokePolicy proto.InternalMessageInfo
-
-func (m *MsgRevokePolicy) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
-}
+var xxx_messageInfo_MsgFreezeAgent proto.InternalMessageInfo
 
-func (m *MsgRevokePolicy) GetFingerprint() string {
+func (m *MsgFreezeAgent) GetSigner() string {
 	if m != nil {
-		return m.Fingerprint
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgRevokePolicy) GetReason() string {
+func (m *MsgFreezeAgent) GetAgentId() string {
 	if m != nil {
-		return m.Reason
+		return m.AgentId
 	}
 	return ""
 }
 
-type MsgRevokePolicyResponse struct {
+type MsgFreezeAgentResponse struct {
 }
 
-func (m *MsgRevokePolicyResponse) Reset()         { *m = MsgRevokePolicyResponse{} }
-func (m *MsgRevokePolicyResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokePolicyResponse) ProtoMessage()    {}
-func (*MsgRevokePolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{17}
+func (m *MsgFreezeAgentResponse) Reset()         { *m = MsgFreezeAgentResponse{} }
+func (m *MsgFreezeAgentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeAgentResponse) ProtoMessage()    {}
+func (*MsgFreezeAgentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{20}
 }
-func (m *MsgRevokePolicyResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFreezeAgentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFreezeAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokePolicyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFreezeAgentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -952,40 +1120,36 @@ func (m *MsgRevokePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokePolicyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokePolicyResponse.Merge(m, src)
+func (m *MsgFreezeAgentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeAgentResponse.Merge(m, src)
 }
-func (m *MsgRevokePolicyResponse) XXX_Size() int {
+func (m *MsgFreezeAgentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokePolicyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokePolicyResponse.DiscardUnknown(m)
+func (m *MsgFreezeAgentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeAgentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokePolicyResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgFreezeAgentResponse proto.InternalMessageInfo
 
-// MsgUnrevokePolicy removes a policy fingerprint from the revocation
-// denylist, re-enabling agents pinning it. No agent state is touched.
-type MsgUnrevokePolicy struct {
-	// authority is the x/gov module account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// fingerprint is the lowercase hex SHA-256 of the deterministic proto
-	// marshaling of the policy.
-	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+type MsgUnfreezeAgent struct {
+	// signer is the agent's guardian or the x/gov module account.
+	Signer  string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 }
 
-func (m *MsgUnrevokePolicy) Reset()         { *m = MsgUnrevokePolicy{} }
-func (m *MsgUnrevokePolicy) String() string { return proto.CompactTextString(m) }
-func (*MsgUnrevokePolicy) ProtoMessage()    {}
-func (*MsgUnrevokePolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{18}
+func (m *MsgUnfreezeAgent) Reset()         { *m = MsgUnfreezeAgent{} }
+func (m *MsgUnfreezeAgent) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeAgent) ProtoMessage()    {}
+func (*MsgUnfreezeAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{21}
 }
-func (m *MsgUnrevokePolicy) XXX_Unmarshal(b []byte) error {
+func (m *MsgUnfreezeAgent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUnrevokePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUnfreezeAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUnrevokePolicy.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUnfreezeAgent.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -995,47 +1159,47 @@ func (m *MsgUnrevokePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgUnrevokePolicy) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUnrevokePolicy.Merge(m, src)
+func (m *MsgUnfreezeAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeAgent.Merge(m, src)
 }
-func (m *MsgUnrevokePolicy) XXX_Size() int {
+func (m *MsgUnfreezeAgent) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUnrevokePolicy) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUnrevokePolicy.DiscardUnknown(m)
+func (m *MsgUnfreezeAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeAgent.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUnrevokePolicy proto.InternalMessageInfo
+var xxx_messageInfo_MsgUnfreezeAgent proto.InternalMessageInfo
 
-func (m *MsgUnrevokePolicy) GetAuthority() string {
+func (m *MsgUnfreezeAgent) GetSigner() string {
 	if m != nil {
-		return m.Authority
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgUnrevokePolicy) GetFingerprint() string {
+func (m *MsgUnfreezeAgent) GetAgentId() string {
 	if m != nil {
-		return m.Fingerprint
+		return m.AgentId
 	}
 	return ""
 }
 
-type MsgUnrevokePolicyResponse struct {
+type MsgUnfreezeAgentResponse struct {
 }
 
-func (m *MsgUnrevokePolicyResponse) Reset()         { *m = MsgUnrevokePolicyResponse{} }
-func (m *MsgUnrevokePolicyResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUnrevokePolicyResponse) ProtoMessage()    {}
-func (*MsgUnrevokePolicyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{19}
+func (m *MsgUnfreezeAgentResponse) Reset()         { *m = MsgUnfreezeAgentResponse{} }
+func (m *MsgUnfreezeAgentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeAgentResponse) ProtoMessage()    {}
+func (*MsgUnfreezeAgentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{22}
 }
-func (m *MsgUnrevokePolicyResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgUnfreezeAgentResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUnrevokePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUnfreezeAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUnrevokePolicyResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUnfreezeAgentResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1045,43 +1209,43 @@ func (m *MsgUnrevokePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgUnrevokePolicyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUnrevokePolicyResponse.Merge(m, src)
+func (m *MsgUnfreezeAgentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeAgentResponse.Merge(m, src)
 }
-func (m *MsgUnrevokePolicyResponse) XXX_Size() int {
+func (m *MsgUnfreezeAgentResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUnrevokePolicyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUnrevokePolicyResponse.DiscardUnknown(m)
+func (m *MsgUnfreezeAgentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeAgentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUnrevokePolicyResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUnfreezeAgentResponse proto.InternalMessageInfo
 
-type MsgSubmitFeedback struct {
-	Client  string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
-	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	// score is fixed-point with 2 decimals: 0..10000 == 0.00%..100.00%.
-	Score uint32 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
-	// tag1 is the primary dimension name, e.g. "liveness". Required.
-	Tag1 string `protobuf:"bytes,4,opt,name=tag1,proto3" json:"tag1,omitempty"`
-	// tag2 is an optional secondary dimension name.
-	Tag2 string `protobuf:"bytes,5,opt,name=tag2,proto3" json:"tag2,omitempty"`
-	// evidence_seq must reference an existing action-log entry of the agent.
-	EvidenceSeq uint64 `protobuf:"varint,6,opt,name=evidence_seq,json=evidenceSeq,proto3" json:"evidence_seq,omitempty"`
+type MsgCreatePaymentStream struct {
+	Owner           string        `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	AgentId         string        `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Recipient       string        `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	AmountPerPeriod types.Coin    `protobuf:"bytes,4,opt,name=amount_per_period,json=amountPerPeriod,proto3" json:"amount_per_period"`
+	Period          time.Duration `protobuf:"bytes,5,opt,name=period,proto3,stdduration" json:"period"`
+	// start_time is when the first period falls due; the zero value means the
+	// current block time. It may not be in the past.
+	StartTime time.Time `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// end_time is exclusive: no period falls due at or after it.
+	EndTime time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 }
 
-func (m *MsgSubmitFeedback) Reset()         { *m = MsgSubmitFeedback{} }
-func (m *MsgSubmitFeedback) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitFeedback) ProtoMessage()    {}
-func (*MsgSubmitFeedback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{20}
+func (m *MsgCreatePaymentStream) Reset()         { *m = MsgCreatePaymentStream{} }
+func (m *MsgCreatePaymentStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentStream) ProtoMessage()    {}
+func (*MsgCreatePaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{23}
 }
-func (m *MsgSubmitFeedback) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePaymentStream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitFeedback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitFeedback.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePaymentStream.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1091,75 +1255,85 @@ func (m *MsgSubmitFeedback) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitFeedback) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitFeedback.Merge(m, src)
+func (m *MsgCreatePaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentStream.Merge(m, src)
 }
-func (m *MsgSubmitFeedback) XXX_Size() int {
+func (m *MsgCreatePaymentStream) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitFeedback) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitFeedback.DiscardUnknown(m)
+func (m *MsgCreatePaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentStream.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitFeedback proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePaymentStream proto.InternalMessageInfo
 
-func (m *MsgSubmitFeedback) GetClient() string {
+func (m *MsgCreatePaymentStream) GetOwner() string {
 	if m != nil {
-		return m.Client
+		return m.Owner
 	}
 	return ""
 }
 
-func (m *MsgSubmitFeedback) GetAgentId() string {
+func (m *MsgCreatePaymentStream) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-func (m *MsgSubmitFeedback) GetScore() uint32 {
+func (m *MsgCreatePaymentStream) GetRecipient() string {
 	if m != nil {
-		return m.Score
+		return m.Recipient
 	}
-	return 0
+	return ""
 }
 
-func (m *MsgSubmitFeedback) GetTag1() string {
+func (m *MsgCreatePaymentStream) GetAmountPerPeriod() types.Coin {
 	if m != nil {
-		return m.Tag1
+		return m.AmountPerPeriod
 	}
-	return ""
+	return types.Coin{}
 }
 
-func (m *MsgSubmitFeedback) GetTag2() string {
+func (m *MsgCreatePaymentStream) GetPeriod() time.Duration {
 	if m != nil {
-		return m.Tag2
+		return m.Period
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgSubmitFeedback) GetEvidenceSeq() uint64 {
+func (m *MsgCreatePaymentStream) GetStartTime() time.Time {
 	if m != nil {
-		return m.EvidenceSeq
+		return m.StartTime
 	}
-	return 0
+	return time.Time{}
 }
 
-type MsgSubmitFeedbackResponse struct {
+func (m *MsgCreatePaymentStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
 }
 
-func (m *MsgSubmitFeedbackResponse) Reset()         { *m = MsgSubmitFeedbackResponse{} }
-func (m *MsgSubmitFeedbackResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitFeedbackResponse) ProtoMessage()    {}
-func (*MsgSubmitFeedbackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{21}
+type MsgCreatePaymentStreamResponse struct {
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// activation_height is the first height at which the stream pays.
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
-func (m *MsgSubmitFeedbackResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCreatePaymentStreamResponse) Reset()         { *m = MsgCreatePaymentStreamResponse{} }
+func (m *MsgCreatePaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePaymentStreamResponse) ProtoMessage()    {}
+func (*MsgCreatePaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{24}
+}
+func (m *MsgCreatePaymentStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitFeedbackResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePaymentStreamResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1169,35 +1343,52 @@ func (m *MsgSubmitFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitFeedbackResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitFeedbackResponse.Merge(m, src)
+func (m *MsgCreatePaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePaymentStreamResponse.Merge(m, src)
 }
-func (m *MsgSubmitFeedbackResponse) XXX_Size() int {
+func (m *MsgCreatePaymentStreamResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitFeedbackResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitFeedbackResponse.DiscardUnknown(m)
+func (m *MsgCreatePaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePaymentStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitFeedbackResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePaymentStreamResponse proto.InternalMessageInfo
 
-type MsgRevokeFeedback struct {
-	Client  string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
-	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+func (m *MsgCreatePaymentStreamResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
 }
 
-func (m *MsgRevokeFeedback) Reset()         { *m = MsgRevokeFeedback{} }
-func (m *MsgRevokeFeedback) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokeFeedback) ProtoMessage()    {}
-func (*MsgRevokeFeedback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{22}
+func (m *MsgCreatePaymentStreamResponse) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
 }
-func (m *MsgRevokeFeedback) XXX_Unmarshal(b []byte) error {
+
+type MsgCancelPaymentStream struct {
+	// signer is the agent owner, the agent's guardian or the x/gov module
+	// account.
+	Signer   string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	AgentId  string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	StreamId uint64 `protobuf:"varint,3,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+}
+
+func (m *MsgCancelPaymentStream) Reset()         { *m = MsgCancelPaymentStream{} }
+func (m *MsgCancelPaymentStream) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentStream) ProtoMessage()    {}
+func (*MsgCancelPaymentStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{25}
+}
+func (m *MsgCancelPaymentStream) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokeFeedback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelPaymentStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokeFeedback.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelPaymentStream.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1207,47 +1398,54 @@ func (m *MsgRevokeFeedback) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokeFeedback) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokeFeedback.Merge(m, src)
+func (m *MsgCancelPaymentStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentStream.Merge(m, src)
 }
-func (m *MsgRevokeFeedback) XXX_Size() int {
+func (m *MsgCancelPaymentStream) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokeFeedback) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokeFeedback.DiscardUnknown(m)
+func (m *MsgCancelPaymentStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentStream.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokeFeedback proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelPaymentStream proto.InternalMessageInfo
 
-func (m *MsgRevokeFeedback) GetClient() string {
+func (m *MsgCancelPaymentStream) GetSigner() string {
 	if m != nil {
-		return m.Client
+		return m.Signer
 	}
 	return ""
 }
 
-func (m *MsgRevokeFeedback) GetAgentId() string {
+func (m *MsgCancelPaymentStream) GetAgentId() string {
 	if m != nil {
 		return m.AgentId
 	}
 	return ""
 }
 
-type MsgRevokeFeedbackResponse struct {
+func (m *MsgCancelPaymentStream) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
 }
 
-func (m *MsgRevokeFeedbackResponse) Reset()         { *m = MsgRevokeFeedbackResponse{} }
-func (m *MsgRevokeFeedbackResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgRevokeFeedbackResponse) ProtoMessage()    {}
-func (*MsgRevokeFeedbackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cc4323968b6c653f, []int{23}
+type MsgCancelPaymentStreamResponse struct {
 }
-func (m *MsgRevokeFeedbackResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCancelPaymentStreamResponse) Reset()         { *m = MsgCancelPaymentStreamResponse{} }
+func (m *MsgCancelPaymentStreamResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelPaymentStreamResponse) ProtoMessage()    {}
+func (*MsgCancelPaymentStreamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{26}
+}
+func (m *MsgCancelPaymentStreamResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRevokeFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelPaymentStreamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRevokeFeedbackResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelPaymentStreamResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1257,1938 +1455,7260 @@ func (m *MsgRevokeFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgRevokeFeedbackResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRevokeFeedbackResponse.Merge(m, src)
+func (m *MsgCancelPaymentStreamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelPaymentStreamResponse.Merge(m, src)
 }
-func (m *MsgRevokeFeedbackResponse) XXX_Size() int {
+func (m *MsgCancelPaymentStreamResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRevokeFeedbackResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRevokeFeedbackResponse.DiscardUnknown(m)
+func (m *MsgCancelPaymentStreamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelPaymentStreamResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRevokeFeedbackResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelPaymentStreamResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgRegisterAgent)(nil), "dymensionxyz.dymension.agent.MsgRegisterAgent")
-	proto.RegisterType((*MsgRegisterAgentResponse)(nil), "dymensionxyz.dymension.agent.MsgRegisterAgentResponse")
-	proto.RegisterType((*MsgDeactivateAgent)(nil), "dymensionxyz.dymension.agent.MsgDeactivateAgent")
-	proto.RegisterType((*MsgDeactivateAgentResponse)(nil), "dymensionxyz.dymension.agent.MsgDeactivateAgentResponse")
-	proto.RegisterType((*MsgUpdateAgentPolicy)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentPolicy")
-	proto.RegisterType((*MsgUpdateAgentPolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentPolicyResponse")
-	proto.RegisterType((*MsgSubmitAttestedAction)(nil), "dymensionxyz.dymension.agent.MsgSubmitAttestedAction")
-	proto.RegisterType((*MsgSubmitAttestedActionResponse)(nil), "dymensionxyz.dymension.agent.MsgSubmitAttestedActionResponse")
-	proto.RegisterType((*MsgFundAgentEscrow)(nil), "dymensionxyz.dymension.agent.MsgFundAgentEscrow")
-	proto.RegisterType((*MsgFundAgentEscrowResponse)(nil), "dymensionxyz.dymension.agent.MsgFundAgentEscrowResponse")
-	proto.RegisterType((*MsgWithdrawAgentEscrow)(nil), "dymensionxyz.dymension.agent.MsgWithdrawAgentEscrow")
-	proto.RegisterType((*MsgWithdrawAgentEscrowResponse)(nil), "dymensionxyz.dymension.agent.MsgWithdrawAgentEscrowResponse")
-	proto.RegisterType((*MsgUpdateAgentSpendPolicy)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentSpendPolicy")
-	proto.RegisterType((*MsgUpdateAgentSpendPolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentSpendPolicyResponse")
-	proto.RegisterType((*MsgSubmitAttestedTransfer)(nil), "dymensionxyz.dymension.agent.MsgSubmitAttestedTransfer")
-	proto.RegisterType((*MsgSubmitAttestedTransferResponse)(nil), "dymensionxyz.dymension.agent.MsgSubmitAttestedTransferResponse")
-	proto.RegisterType((*MsgRevokePolicy)(nil), "dymensionxyz.dymension.agent.MsgRevokePolicy")
-	proto.RegisterType((*MsgRevokePolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgRevokePolicyResponse")
-	proto.RegisterType((*MsgUnrevokePolicy)(nil), "dymensionxyz.dymension.agent.MsgUnrevokePolicy")
-	proto.RegisterType((*MsgUnrevokePolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgUnrevokePolicyResponse")
-	proto.RegisterType((*MsgSubmitFeedback)(nil), "dymensionxyz.dymension.agent.MsgSubmitFeedback")
-	proto.RegisterType((*MsgSubmitFeedbackResponse)(nil), "dymensionxyz.dymension.agent.MsgSubmitFeedbackResponse")
-	proto.RegisterType((*MsgRevokeFeedback)(nil), "dymensionxyz.dymension.agent.MsgRevokeFeedback")
-	proto.RegisterType((*MsgRevokeFeedbackResponse)(nil), "dymensionxyz.dymension.agent.MsgRevokeFeedbackResponse")
+type MsgUpdateAgentSpendPolicy struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// spend_denom is the single spendable denom; empty disables spending.
+	SpendDenom          string                `protobuf:"bytes,3,opt,name=spend_denom,json=spendDenom,proto3" json:"spend_denom,omitempty"`
+	SpendLimitPerWindow cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=spend_limit_per_window,json=spendLimitPerWindow,proto3,customtype=cosmossdk.io/math.Int" json:"spend_limit_per_window"`
+	SpendWindowBlocks   uint64                `protobuf:"varint,5,opt,name=spend_window_blocks,json=spendWindowBlocks,proto3" json:"spend_window_blocks,omitempty"`
+	// spend_budgets replaces the agent's per-denom budgets for denoms other than
+	// spend_denom. Window bookkeeping fields must be unset.
+	SpendBudgets []SpendBudget `protobuf:"bytes,6,rep,name=spend_budgets,json=spendBudgets,proto3" json:"spend_budgets"`
+	// spend_window_mode selects how the spend_denom window is measured;
+	// spend_window_duration is its length for the time-based modes.
+	SpendWindowMode     SpendWindowMode `protobuf:"varint,7,opt,name=spend_window_mode,json=spendWindowMode,proto3,enum=dymensionxyz.dymension.agent.SpendWindowMode" json:"spend_window_mode,omitempty"`
+	SpendWindowDuration time.Duration   `protobuf:"bytes,8,opt,name=spend_window_duration,json=spendWindowDuration,proto3,stdduration" json:"spend_window_duration"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/agent/tx.proto", fileDescriptor_cc4323968b6c653f)
+func (m *MsgUpdateAgentSpendPolicy) Reset()         { *m = MsgUpdateAgentSpendPolicy{} }
+func (m *MsgUpdateAgentSpendPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgentSpendPolicy) ProtoMessage()    {}
+func (*MsgUpdateAgentSpendPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{27}
 }
-
-var fileDescriptor_cc4323968b6c653f = []byte{
-	// 1246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xd6, 0x89, 0x8b, 0x9f, 0xd3, 0x3f, 0xd9, 0x9a, 0xd4, 0x59, 0x2a, 0x27, 0x35, 0xaa,
-	0x88, 0x5a, 0x65, 0xb7, 0x71, 0x49, 0x82, 0x22, 0x10, 0x8a, 0x5b, 0x2a, 0x02, 0xb5, 0x14, 0x6d,
-	0x40, 0x95, 0xb8, 0x98, 0xf5, 0xee, 0x64, 0x3d, 0x72, 0x76, 0xc6, 0xdd, 0x19, 0xdb, 0x71, 0xa5,
-	0x4a, 0x08, 0x4e, 0x48, 0x1c, 0x10, 0x7c, 0x06, 0x2e, 0x08, 0xa1, 0x1e, 0x7a, 0x80, 0x6f, 0xd0,
-	0x13, 0xaa, 0x0a, 0x07, 0xc4, 0xa1, 0xa0, 0xe4, 0xd0, 0xaf, 0x81, 0x76, 0x76, 0xbc, 0xf1, 0xff,
-	0x3f, 0x51, 0xda, 0x93, 0x77, 0x66, 0xde, 0xef, 0xcd, 0xef, 0xfd, 0xe6, 0xed, 0x7b, 0xe3, 0x85,
-	0x6b, 0x4e, 0xd3, 0x43, 0x84, 0x61, 0x4a, 0x0e, 0x9a, 0x0f, 0x8d, 0x68, 0x60, 0x58, 0x2e, 0x22,
-	0xdc, 0xe0, 0x07, 0x7a, 0xd5, 0xa7, 0x9c, 0xaa, 0x57, 0xda, 0xcd, 0xf4, 0x68, 0xa0, 0x0b, 0x33,
-	0x6d, 0xc1, 0xa6, 0xcc, 0xa3, 0xac, 0x28, 0x6c, 0x8d, 0x70, 0x10, 0x02, 0xb5, 0xcb, 0xe1, 0xc8,
-	0xf0, 0x98, 0x6b, 0xd4, 0x57, 0x83, 0x1f, 0xb9, 0x90, 0x91, 0x0b, 0x25, 0x8b, 0x21, 0xa3, 0xbe,
-	0x5a, 0x42, 0xdc, 0x5a, 0x35, 0x6c, 0x8a, 0x89, 0x5c, 0x4f, 0xb9, 0xd4, 0xa5, 0xa1, 0xc3, 0xe0,
-	0x49, 0xce, 0xbe, 0x33, 0x80, 0xae, 0x4d, 0x3d, 0x8f, 0x12, 0x83, 0x23, 0x14, 0x1a, 0x66, 0x7f,
-	0x55, 0xe0, 0x62, 0x81, 0xb9, 0x26, 0x72, 0x31, 0xe3, 0xc8, 0xdf, 0x0a, 0x78, 0xaa, 0x3a, 0xcc,
-	0xd0, 0x06, 0x41, 0x7e, 0x5a, 0x59, 0x52, 0x96, 0x13, 0xf9, 0xf4, 0xf3, 0x27, 0x2b, 0x29, 0xc9,
-	0x76, 0xcb, 0x71, 0x7c, 0xc4, 0xd8, 0x2e, 0xf7, 0x31, 0x71, 0xcd, 0xd0, 0x4c, 0x5d, 0x80, 0x37,
-	0x44, 0x80, 0x45, 0xec, 0xa4, 0xcf, 0x04, 0x10, 0xf3, 0xac, 0x18, 0x6f, 0x3b, 0xea, 0x6d, 0x88,
-	0x57, 0xe9, 0x3e, 0xb6, 0x9b, 0xe9, 0xd8, 0x92, 0xb2, 0x9c, 0xcc, 0x5d, 0xd3, 0x07, 0x28, 0x14,
-	0x32, 0xd3, 0x77, 0x84, 0x71, 0x7e, 0xfa, 0xe9, 0x8b, 0xc5, 0x29, 0x53, 0x42, 0x37, 0xe1, 0xeb,
-	0x97, 0x8f, 0xaf, 0x87, 0x7b, 0x65, 0x35, 0x48, 0x77, 0xf3, 0x35, 0x11, 0xab, 0x52, 0xc2, 0x50,
-	0xb6, 0x02, 0x6a, 0x81, 0xb9, 0x77, 0x90, 0x65, 0x73, 0x5c, 0xb7, 0x38, 0x3a, 0xed, 0x68, 0x3a,
-	0x88, 0x5c, 0x01, 0xad, 0x77, 0xb3, 0x88, 0xca, 0xef, 0x0a, 0xa4, 0x0a, 0xcc, 0xfd, 0xbc, 0xea,
-	0xb4, 0x96, 0xc2, 0xc8, 0x4e, 0x53, 0xdb, 0x4f, 0x00, 0x08, 0x6a, 0x14, 0x4f, 0xae, 0x6f, 0x82,
-	0xa0, 0xc6, 0x4e, 0xaf, 0xc4, 0x9f, 0xc2, 0x95, 0x7e, 0xd4, 0x5b, 0xb1, 0xa9, 0x37, 0x60, 0x4e,
-	0x06, 0x8d, 0x29, 0x29, 0x96, 0x11, 0x76, 0xcb, 0x5c, 0x84, 0x13, 0x33, 0x2f, 0x1e, 0x2f, 0x7c,
-	0x2c, 0xe6, 0xb3, 0xbf, 0x28, 0x70, 0xb9, 0xc0, 0xdc, 0xdd, 0x5a, 0xc9, 0xc3, 0x7c, 0x8b, 0x73,
-	0xc4, 0x38, 0x72, 0xb6, 0xec, 0xc0, 0x42, 0x5d, 0x87, 0x04, 0x13, 0xf3, 0x7c, 0x0c, 0x3d, 0x8e,
-	0x4d, 0x87, 0x69, 0x92, 0x86, 0xb3, 0x55, 0xab, 0xb9, 0x4f, 0x2d, 0x47, 0x08, 0x32, 0x6b, 0xb6,
-	0x86, 0x6a, 0x0a, 0x66, 0x38, 0xad, 0x20, 0x92, 0x9e, 0x16, 0x88, 0x70, 0xb0, 0x79, 0x3e, 0x88,
-	0xfb, 0xd8, 0x75, 0xf6, 0x16, 0x2c, 0x0e, 0x60, 0x1b, 0x85, 0x7f, 0x11, 0x62, 0x0c, 0x3d, 0x10,
-	0x7c, 0xa7, 0xcd, 0xe0, 0x31, 0xfb, 0xa7, 0x22, 0x12, 0xef, 0x6e, 0x8d, 0x38, 0x42, 0xaf, 0x8f,
-	0x98, 0xed, 0xd3, 0x86, 0x7a, 0x13, 0xe2, 0x7b, 0x35, 0xe2, 0x8c, 0x11, 0x9b, 0xb4, 0x1b, 0x16,
-	0x98, 0x0d, 0x71, 0xcb, 0xa3, 0x35, 0xc2, 0xd3, 0xb1, 0xa5, 0xd8, 0x72, 0x32, 0xb7, 0xa0, 0x4b,
-	0x4f, 0x41, 0x61, 0xd0, 0x65, 0x61, 0xd0, 0x6f, 0x53, 0x4c, 0xf2, 0x37, 0x83, 0xc3, 0xfd, 0xf9,
-	0xdf, 0xc5, 0x65, 0x17, 0xf3, 0x72, 0xad, 0x14, 0x1c, 0xbd, 0x2c, 0x36, 0xf2, 0x67, 0x85, 0x39,
-	0x15, 0x83, 0x37, 0xab, 0x88, 0x09, 0x00, 0x33, 0xa5, 0xeb, 0xcd, 0x64, 0xa0, 0x86, 0x24, 0x23,
-	0x13, 0xbc, 0x2b, 0xa8, 0x28, 0xc1, 0xff, 0x52, 0x60, 0xbe, 0xc0, 0xdc, 0xfb, 0x98, 0x97, 0x1d,
-	0xdf, 0x6a, 0xb4, 0xc7, 0x7d, 0x8a, 0x29, 0xfe, 0x5a, 0xa2, 0x6e, 0xcf, 0xfd, 0x25, 0xc8, 0xf4,
-	0x8f, 0x2a, 0x0a, 0xfc, 0xa7, 0x33, 0xb0, 0xd0, 0xf9, 0x7a, 0xec, 0x56, 0x11, 0x71, 0x4e, 0xff,
-	0xf5, 0x5e, 0x84, 0x24, 0x0b, 0x3c, 0x17, 0x1d, 0x44, 0xa8, 0x27, 0xd2, 0x39, 0x61, 0x82, 0x98,
-	0xba, 0x13, 0xcc, 0xa8, 0x5f, 0xc2, 0x7c, 0x68, 0xb0, 0x8f, 0x3d, 0xcc, 0x8b, 0x55, 0xe4, 0x17,
-	0x1b, 0x98, 0x38, 0xb4, 0x11, 0xa6, 0x78, 0xfe, 0x46, 0xa0, 0xc8, 0x3f, 0x2f, 0x16, 0xdf, 0x0c,
-	0x09, 0x30, 0xa7, 0xa2, 0x63, 0x6a, 0x78, 0x16, 0x2f, 0xeb, 0xdb, 0x84, 0x3f, 0x7f, 0xb2, 0x02,
-	0x92, 0xd9, 0x36, 0xe1, 0xe6, 0x25, 0xe1, 0xea, 0x5e, 0xe0, 0x69, 0x07, 0xf9, 0xf7, 0x85, 0x1f,
-	0x55, 0x87, 0x70, 0x5a, 0xfa, 0x2d, 0x96, 0xf6, 0xa9, 0x5d, 0x61, 0xe9, 0x19, 0x91, 0xfa, 0x73,
-	0x62, 0x29, 0xb4, 0xcc, 0x8b, 0x85, 0x0e, 0x25, 0xdf, 0x86, 0xab, 0x03, 0x65, 0xea, 0x16, 0xb3,
-	0xf3, 0x7d, 0xfb, 0xcc, 0xb7, 0x08, 0xdb, 0x43, 0xfe, 0xab, 0xa8, 0x0f, 0xeb, 0x90, 0xf0, 0x91,
-	0x8d, 0xab, 0x18, 0x89, 0x9c, 0x1a, 0xe1, 0x32, 0x32, 0x0d, 0xfa, 0x98, 0x4c, 0xc4, 0x13, 0x68,
-	0x2b, 0xa1, 0xaa, 0x0a, 0xd3, 0x1e, 0xf2, 0xa8, 0xd0, 0x6f, 0xd6, 0x14, 0xcf, 0xc7, 0x65, 0x29,
-	0x3e, 0xac, 0x2c, 0xad, 0x09, 0x31, 0xfb, 0xcb, 0x34, 0xa4, 0x30, 0xfd, 0xa8, 0xc0, 0x05, 0xd1,
-	0x2d, 0xeb, 0xb4, 0x82, 0x64, 0x86, 0xae, 0x43, 0xc2, 0xaa, 0xf1, 0x32, 0xf5, 0x31, 0x6f, 0x8e,
-	0x16, 0x35, 0x32, 0x55, 0x97, 0x20, 0xb9, 0x87, 0x89, 0x8b, 0xfc, 0xaa, 0x8f, 0x09, 0x97, 0xba,
-	0xb6, 0x4f, 0xa9, 0xf3, 0x10, 0xf7, 0x91, 0xc5, 0x28, 0x91, 0xb9, 0x2a, 0x47, 0x32, 0x98, 0xc8,
-	0x53, 0x76, 0x41, 0x74, 0x84, 0x76, 0x52, 0x51, 0x3e, 0x3c, 0x82, 0xb9, 0x20, 0x69, 0x88, 0xff,
-	0x5a, 0x18, 0xf7, 0x30, 0x7b, 0x2b, 0x7c, 0xb5, 0x3b, 0xb6, 0x8f, 0xb8, 0xfd, 0xa1, 0x08, 0x72,
-	0xe1, 0x21, 0xdc, 0x45, 0xc8, 0x29, 0x59, 0x76, 0x25, 0x28, 0xf2, 0xf6, 0xbe, 0xc8, 0xa6, 0x91,
-	0x45, 0x3e, 0xb4, 0x1b, 0x96, 0x9d, 0x29, 0x98, 0x61, 0x36, 0xf5, 0x91, 0x10, 0xf0, 0x9c, 0x19,
-	0x0e, 0x82, 0xb4, 0xe1, 0x96, 0xbb, 0x2a, 0x1b, 0x97, 0x78, 0x96, 0x73, 0x39, 0x91, 0x4a, 0xe1,
-	0x5c, 0x4e, 0xbd, 0x0a, 0xb3, 0xa8, 0x8e, 0x1d, 0x44, 0x6c, 0x54, 0x0c, 0x12, 0x21, 0x2e, 0x12,
-	0x21, 0xd9, 0x9a, 0xdb, 0x45, 0x0f, 0x64, 0x81, 0x0f, 0x89, 0xc8, 0x68, 0x3b, 0xe3, 0x89, 0xa2,
-	0xf5, 0x44, 0xb0, 0xe1, 0x21, 0xbd, 0x92, 0x60, 0xfb, 0x71, 0xe9, 0xdc, 0xae, 0xc5, 0x25, 0xf7,
-	0xdb, 0x2c, 0xc4, 0x0a, 0xcc, 0x55, 0x1b, 0x70, 0xae, 0xeb, 0xa2, 0xaa, 0x0f, 0xbb, 0x6f, 0xeb,
-	0xdd, 0x17, 0x45, 0x6d, 0x7d, 0x32, 0xfb, 0xe8, 0xcd, 0x7a, 0x04, 0x17, 0xba, 0x6f, 0x95, 0x37,
-	0x47, 0xba, 0xea, 0x42, 0x68, 0xef, 0x4d, 0x8a, 0x88, 0xb6, 0xff, 0x46, 0x81, 0xb9, 0xde, 0x9b,
-	0x64, 0x6e, 0xa4, 0xbf, 0x1e, 0x8c, 0xb6, 0x39, 0x39, 0x26, 0x62, 0xf1, 0x9d, 0x02, 0xa9, 0xbe,
-	0xd7, 0xb8, 0xb5, 0x91, 0x4e, 0xfb, 0xc1, 0xb4, 0x0f, 0x4e, 0x04, 0x6b, 0x3f, 0x93, 0x9e, 0x0b,
-	0xd7, 0x48, 0x8f, 0x5d, 0x88, 0x31, 0xce, 0x64, 0xc0, 0xfd, 0x47, 0xfd, 0x56, 0x81, 0x4b, 0xfd,
-	0x2e, 0x3f, 0xef, 0x8e, 0xf4, 0xd8, 0x07, 0xa5, 0xbd, 0x7f, 0x12, 0x54, 0xc4, 0xe5, 0x07, 0x05,
-	0xe6, 0x07, 0xdc, 0x47, 0x36, 0x26, 0x39, 0xf0, 0x36, 0xa0, 0xf6, 0xe1, 0x09, 0x81, 0x1d, 0xa4,
-	0x06, 0xf4, 0xf5, 0x8d, 0x09, 0x4f, 0xbe, 0x05, 0x1c, 0x83, 0xd4, 0x88, 0x16, 0xc9, 0x61, 0xb6,
-	0xa3, 0x19, 0xae, 0x8c, 0x51, 0x10, 0x8e, 0xcd, 0xb5, 0xb5, 0x89, 0xcc, 0xa3, 0x5d, 0x1f, 0xc2,
-	0xf9, 0xae, 0x96, 0x66, 0x8c, 0x56, 0xb7, 0x03, 0xa0, 0x6d, 0x4c, 0x08, 0x68, 0xdf, 0xbb, 0xab,
-	0x63, 0x19, 0x63, 0x8a, 0xd8, 0x02, 0x68, 0x1b, 0x13, 0x02, 0xda, 0xf7, 0xee, 0x6a, 0x20, 0xc6,
-	0x98, 0x02, 0x4e, 0xb0, 0x77, 0xff, 0x9e, 0xa1, 0xcd, 0x7c, 0xf5, 0xf2, 0xf1, 0x75, 0x25, 0x7f,
-	0xef, 0xe9, 0x61, 0x46, 0x79, 0x76, 0x98, 0x51, 0xfe, 0x3b, 0xcc, 0x28, 0xdf, 0x1f, 0x65, 0xa6,
-	0x9e, 0x1d, 0x65, 0xa6, 0xfe, 0x3e, 0xca, 0x4c, 0x7d, 0x91, 0x6b, 0xfb, 0x9f, 0x30, 0xe0, 0x6b,
-	0x49, 0xfd, 0x96, 0x71, 0xd0, 0xfa, 0xc2, 0x13, 0xfc, 0x6f, 0x28, 0xc5, 0xc5, 0x47, 0x93, 0x5b,
-	0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0x39, 0x62, 0x78, 0x36, 0x0e, 0x12, 0x00, 0x00,
+func (m *MsgUpdateAgentSpendPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAgentSpendPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAgentSpendPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAgentSpendPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgentSpendPolicy.Merge(m, src)
+}
+func (m *MsgUpdateAgentSpendPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAgentSpendPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgentSpendPolicy.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgUpdateAgentSpendPolicy proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// RegisterAgent registers a new agent with an immutable attestation policy.
-	RegisterAgent(ctx context.Context, in *MsgRegisterAgent, opts ...grpc.CallOption) (*MsgRegisterAgentResponse, error)
-	// DeactivateAgent deactivates an existing agent. Owner only.
-	DeactivateAgent(ctx context.Context, in *MsgDeactivateAgent, opts ...grpc.CallOption) (*MsgDeactivateAgentResponse, error)
-	// UpdateAgentPolicy schedules a timelocked rotation of the agent's
-	// attestation policy. Owner only. The new policy activates after
-	// policy_rotation_delay_blocks.
-	UpdateAgentPolicy(ctx context.Context, in *MsgUpdateAgentPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentPolicyResponse, error)
-	// SubmitAttestedAction verifies a TEE attestation token against the agent's
-	// policy, bound by a per-action nonce, and appends an entry to the agent's
-	// action log.
-	SubmitAttestedAction(ctx context.Context, in *MsgSubmitAttestedAction, opts ...grpc.CallOption) (*MsgSubmitAttestedActionResponse, error)
-	// FundAgentEscrow deposits funds into an agent's escrow. Anyone may fund.
-	FundAgentEscrow(ctx context.Context, in *MsgFundAgentEscrow, opts ...grpc.CallOption) (*MsgFundAgentEscrowResponse, error)
-	// WithdrawAgentEscrow withdraws funds from an agent's escrow. Owner only.
-	WithdrawAgentEscrow(ctx context.Context, in *MsgWithdrawAgentEscrow, opts ...grpc.CallOption) (*MsgWithdrawAgentEscrowResponse, error)
-	// UpdateAgentSpendPolicy sets the agent's spend policy (denom, per-window
-	// limit, window length). Owner only, effective immediately.
-	UpdateAgentSpendPolicy(ctx context.Context, in *MsgUpdateAgentSpendPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentSpendPolicyResponse, error)
-	// SubmitAttestedTransfer verifies a TEE attestation token bound to a
-	// specific (recipient, denom, amount, memo), checks the per-window spend
-	// budget, pays out from the agent's escrow, and appends an entry to the
-	// agent's action log.
-	SubmitAttestedTransfer(ctx context.Context, in *MsgSubmitAttestedTransfer, opts ...grpc.CallOption) (*MsgSubmitAttestedTransferResponse, error)
-	// RevokePolicy adds a policy fingerprint to the revocation denylist.
-	// Governance only.
-	RevokePolicy(ctx context.Context, in *MsgRevokePolicy, opts ...grpc.CallOption) (*MsgRevokePolicyResponse, error)
-	// UnrevokePolicy removes a policy fingerprint from the revocation denylist.
-	// Governance only.
-	UnrevokePolicy(ctx context.Context, in *MsgUnrevokePolicy, opts ...grpc.CallOption) (*MsgUnrevokePolicyResponse, error)
-	// SubmitFeedback submits or overwrites the client's feedback score for an
-	// agent, grounded in an existing action-log entry.
-	SubmitFeedback(ctx context.Context, in *MsgSubmitFeedback, opts ...grpc.CallOption) (*MsgSubmitFeedbackResponse, error)
-	// RevokeFeedback removes the client's feedback for an agent.
-	RevokeFeedback(ctx context.Context, in *MsgRevokeFeedback, opts ...grpc.CallOption) (*MsgRevokeFeedbackResponse, error)
+func (m *MsgUpdateAgentSpendPolicy) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateAgentSpendPolicy) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateAgentSpendPolicy) GetSpendDenom() string {
+	if m != nil {
+		return m.SpendDenom
+	}
+	return ""
 }
 
-func (c *msgClient) RegisterAgent(ctx context.Context, in *MsgRegisterAgent, opts ...grpc.CallOption) (*MsgRegisterAgentResponse, error) {
-	out := new(MsgRegisterAgentResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/RegisterAgent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgentSpendPolicy) GetSpendWindowBlocks() uint64 {
+	if m != nil {
+		return m.SpendWindowBlocks
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) DeactivateAgent(ctx context.Context, in *MsgDeactivateAgent, opts ...grpc.CallOption) (*MsgDeactivateAgentResponse, error) {
-	out := new(MsgDeactivateAgentResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/DeactivateAgent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgentSpendPolicy) GetSpendBudgets() []SpendBudget {
+	if m != nil {
+		return m.SpendBudgets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateAgentPolicy(ctx context.Context, in *MsgUpdateAgentPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentPolicyResponse, error) {
-	out := new(MsgUpdateAgentPolicyResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UpdateAgentPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgentSpendPolicy) GetSpendWindowMode() SpendWindowMode {
+	if m != nil {
+		return m.SpendWindowMode
 	}
-	return out, nil
+	return SpendWindowMode_SPEND_WINDOW_MODE_BLOCKS
 }
 
-func (c *msgClient) SubmitAttestedAction(ctx context.Context, in *MsgSubmitAttestedAction, opts ...grpc.CallOption) (*MsgSubmitAttestedActionResponse, error) {
-	out := new(MsgSubmitAttestedActionResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/SubmitAttestedAction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgentSpendPolicy) GetSpendWindowDuration() time.Duration {
+	if m != nil {
+		return m.SpendWindowDuration
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) FundAgentEscrow(ctx context.Context, in *MsgFundAgentEscrow, opts ...grpc.CallOption) (*MsgFundAgentEscrowResponse, error) {
-	out := new(MsgFundAgentEscrowResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/FundAgentEscrow", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgUpdateAgentSpendPolicyResponse struct {
 }
 
-func (c *msgClient) WithdrawAgentEscrow(ctx context.Context, in *MsgWithdrawAgentEscrow, opts ...grpc.CallOption) (*MsgWithdrawAgentEscrowResponse, error) {
-	out := new(MsgWithdrawAgentEscrowResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/WithdrawAgentEscrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgentSpendPolicyResponse) Reset()         { *m = MsgUpdateAgentSpendPolicyResponse{} }
+func (m *MsgUpdateAgentSpendPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgentSpendPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateAgentSpendPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{28}
+}
+func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAgentSpendPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgentSpendPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateAgentSpendPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAgentSpendPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgentSpendPolicyResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateAgentSpendPolicy(ctx context.Context, in *MsgUpdateAgentSpendPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentSpendPolicyResponse, error) {
-	out := new(MsgUpdateAgentSpendPolicyResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UpdateAgentSpendPolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateAgentSpendPolicyResponse proto.InternalMessageInfo
+
+type MsgUpdateAgentRecipientPolicy struct {
+	Owner   string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// policy replaces the agent's recipient policy; the zero value removes it.
+	Policy RecipientPolicy `protobuf:"bytes,3,opt,name=policy,proto3" json:"policy"`
 }
 
-func (c *msgClient) SubmitAttestedTransfer(ctx context.Context, in *MsgSubmitAttestedTransfer, opts ...grpc.CallOption) (*MsgSubmitAttestedTransferResponse, error) {
-	out := new(MsgSubmitAttestedTransferResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/SubmitAttestedTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgentRecipientPolicy) Reset()         { *m = MsgUpdateAgentRecipientPolicy{} }
+func (m *MsgUpdateAgentRecipientPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgentRecipientPolicy) ProtoMessage()    {}
+func (*MsgUpdateAgentRecipientPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{29}
+}
+func (m *MsgUpdateAgentRecipientPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAgentRecipientPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAgentRecipientPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateAgentRecipientPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgentRecipientPolicy.Merge(m, src)
+}
+func (m *MsgUpdateAgentRecipientPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAgentRecipientPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgentRecipientPolicy.DiscardUnknown(m)
 }
 
-func (c *msgClient) RevokePolicy(ctx context.Context, in *MsgRevokePolicy, opts ...grpc.CallOption) (*MsgRevokePolicyResponse, error) {
-	out := new(MsgRevokePolicyResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/RevokePolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateAgentRecipientPolicy proto.InternalMessageInfo
+
+func (m *MsgUpdateAgentRecipientPolicy) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UnrevokePolicy(ctx context.Context, in *MsgUnrevokePolicy, opts ...grpc.CallOption) (*MsgUnrevokePolicyResponse, error) {
-	out := new(MsgUnrevokePolicyResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UnrevokePolicy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgentRecipientPolicy) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitFeedback(ctx context.Context, in *MsgSubmitFeedback, opts ...grpc.CallOption) (*MsgSubmitFeedbackResponse, error) {
-	out := new(MsgSubmitFeedbackResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/SubmitFeedback", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateAgentRecipientPolicy) GetPolicy() RecipientPolicy {
+	if m != nil {
+		return m.Policy
 	}
-	return out, nil
+	return RecipientPolicy{}
 }
 
-func (c *msgClient) RevokeFeedback(ctx context.Context, in *MsgRevokeFeedback, opts ...grpc.CallOption) (*MsgRevokeFeedbackResponse, error) {
-	out := new(MsgRevokeFeedbackResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/RevokeFeedback", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgUpdateAgentRecipientPolicyResponse struct {
+}
+
+func (m *MsgUpdateAgentRecipientPolicyResponse) Reset()         { *m = MsgUpdateAgentRecipientPolicyResponse{} }
+func (m *MsgUpdateAgentRecipientPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAgentRecipientPolicyResponse) ProtoMessage()    {}
+func (*MsgUpdateAgentRecipientPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{30}
+}
+func (m *MsgUpdateAgentRecipientPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAgentRecipientPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAgentRecipientPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateAgentRecipientPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAgentRecipientPolicyResponse.Merge(m, src)
+}
+func (m *MsgUpdateAgentRecipientPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAgentRecipientPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAgentRecipientPolicyResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// RegisterAgent registers a new agent with an immutable attestation policy.
-	RegisterAgent(context.Context, *MsgRegisterAgent) (*MsgRegisterAgentResponse, error)
-	// DeactivateAgent deactivates an existing agent. Owner only.
-	DeactivateAgent(context.Context, *MsgDeactivateAgent) (*MsgDeactivateAgentResponse, error)
-	// UpdateAgentPolicy schedules a timelocked rotation of the agent's
-	// attestation policy. Owner only. The new policy activates after
-	// policy_rotation_delay_blocks.
-	UpdateAgentPolicy(context.Context, *MsgUpdateAgentPolicy) (*MsgUpdateAgentPolicyResponse, error)
-	// SubmitAttestedAction verifies a TEE attestation token against the agent's
-	// policy, bound by a per-action nonce, and appends an entry to the agent's
-	// action log.
-	SubmitAttestedAction(context.Context, *MsgSubmitAttestedAction) (*MsgSubmitAttestedActionResponse, error)
-	// FundAgentEscrow deposits funds into an agent's escrow. Anyone may fund.
-	FundAgentEscrow(context.Context, *MsgFundAgentEscrow) (*MsgFundAgentEscrowResponse, error)
-	// WithdrawAgentEscrow withdraws funds from an agent's escrow. Owner only.
-	WithdrawAgentEscrow(context.Context, *MsgWithdrawAgentEscrow) (*MsgWithdrawAgentEscrowResponse, error)
-	// UpdateAgentSpendPolicy sets the agent's spend policy (denom, per-window
-	// limit, window length). Owner only, effective immediately.
-	UpdateAgentSpendPolicy(context.Context, *MsgUpdateAgentSpendPolicy) (*MsgUpdateAgentSpendPolicyResponse, error)
-	// SubmitAttestedTransfer verifies a TEE attestation token bound to a
-	// specific (recipient, denom, amount, memo), checks the per-window spend
-	// budget, pays out from the agent's escrow, and appends an entry to the
-	// agent's action log.
-	SubmitAttestedTransfer(context.Context, *MsgSubmitAttestedTransfer) (*MsgSubmitAttestedTransferResponse, error)
-	// RevokePolicy adds a policy fingerprint to the revocation denylist.
-	// Governance only.
-	RevokePolicy(context.Context, *MsgRevokePolicy) (*MsgRevokePolicyResponse, error)
-	// UnrevokePolicy removes a policy fingerprint from the revocation denylist.
-	// Governance only.
-	UnrevokePolicy(context.Context, *MsgUnrevokePolicy) (*MsgUnrevokePolicyResponse, error)
-	// SubmitFeedback submits or overwrites the client's feedback score for an
-	// agent, grounded in an existing action-log entry.
-	SubmitFeedback(context.Context, *MsgSubmitFeedback) (*MsgSubmitFeedbackResponse, error)
-	// RevokeFeedback removes the client's feedback for an agent.
-	RevokeFeedback(context.Context, *MsgRevokeFeedback) (*MsgRevokeFeedbackResponse, error)
-}
+var xxx_messageInfo_MsgUpdateAgentRecipientPolicyResponse proto.InternalMessageInfo
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSubmitAttestedTransfer submits an attested transfer on behalf of an
+// agent. Like MsgSubmitAttestedAction, the submitter signs and pays for the tx
+// but is not validated against the agent: the enclave token is bound to the
+// exact (recipient, denom, amount, memo) via the nonce, so the enclave — not
+// the submitter — authorizes the payment.
+type MsgSubmitAttestedTransfer struct {
+	Submitter string                `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	AgentId   string                `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	Recipient string                `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Memo      []byte                `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Token     string                `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`
+	// denom is the denom to pay out; empty means the agent's spend_denom.
+	Denom string `protobuf:"bytes,7,opt,name=denom,proto3" json:"denom,omitempty"`
+	// co_attestations count toward the agent's attestation quorum. Each must
+	// verify.
+	CoAttestations []CoAttestation `protobuf:"bytes,8,rep,name=co_attestations,json=coAttestations,proto3" json:"co_attestations"`
 }
 
-func (*UnimplementedMsgServer) RegisterAgent(ctx context.Context, req *MsgRegisterAgent) (*MsgRegisterAgentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterAgent not implemented")
-}
-func (*UnimplementedMsgServer) DeactivateAgent(ctx context.Context, req *MsgDeactivateAgent) (*MsgDeactivateAgentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAgent not implemented")
-}
-func (*UnimplementedMsgServer) UpdateAgentPolicy(ctx context.Context, req *MsgUpdateAgentPolicy) (*MsgUpdateAgentPolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentPolicy not implemented")
-}
-func (*UnimplementedMsgServer) SubmitAttestedAction(ctx context.Context, req *MsgSubmitAttestedAction) (*MsgSubmitAttestedActionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestedAction not implemented")
-}
-func (*UnimplementedMsgServer) FundAgentEscrow(ctx context.Context, req *MsgFundAgentEscrow) (*MsgFundAgentEscrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FundAgentEscrow not implemented")
-}
-func (*UnimplementedMsgServer) WithdrawAgentEscrow(ctx context.Context, req *MsgWithdrawAgentEscrow) (*MsgWithdrawAgentEscrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAgentEscrow not implemented")
-}
-func (*UnimplementedMsgServer) UpdateAgentSpendPolicy(ctx context.Context, req *MsgUpdateAgentSpendPolicy) (*MsgUpdateAgentSpendPolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentSpendPolicy not implemented")
+func (m *MsgSubmitAttestedTransfer) Reset()         { *m = MsgSubmitAttestedTransfer{} }
+func (m *MsgSubmitAttestedTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestedTransfer) ProtoMessage()    {}
+func (*MsgSubmitAttestedTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{31}
 }
-func (*UnimplementedMsgServer) SubmitAttestedTransfer(ctx context.Context, req *MsgSubmitAttestedTransfer) (*MsgSubmitAttestedTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestedTransfer not implemented")
+func (m *MsgSubmitAttestedTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RevokePolicy(ctx context.Context, req *MsgRevokePolicy) (*MsgRevokePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokePolicy not implemented")
+func (m *MsgSubmitAttestedTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestedTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UnrevokePolicy(ctx context.Context, req *MsgUnrevokePolicy) (*MsgUnrevokePolicyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UnrevokePolicy not implemented")
+func (m *MsgSubmitAttestedTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestedTransfer.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitFeedback(ctx context.Context, req *MsgSubmitFeedback) (*MsgSubmitFeedbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeedback not implemented")
+func (m *MsgSubmitAttestedTransfer) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RevokeFeedback(ctx context.Context, req *MsgRevokeFeedback) (*MsgRevokeFeedbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeedback not implemented")
+func (m *MsgSubmitAttestedTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestedTransfer.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgSubmitAttestedTransfer proto.InternalMessageInfo
 
-func _Msg_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterAgent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterAgent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/RegisterAgent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterAgent(ctx, req.(*MsgRegisterAgent))
+func (m *MsgSubmitAttestedTransfer) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_DeactivateAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeactivateAgent)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeactivateAgent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/DeactivateAgent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeactivateAgent(ctx, req.(*MsgDeactivateAgent))
+func (m *MsgSubmitAttestedTransfer) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateAgentPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateAgentPolicy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateAgentPolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/UpdateAgentPolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateAgentPolicy(ctx, req.(*MsgUpdateAgentPolicy))
+func (m *MsgSubmitAttestedTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitAttestedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitAttestedAction)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitAttestedAction(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/SubmitAttestedAction",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitAttestedAction(ctx, req.(*MsgSubmitAttestedAction))
+func (m *MsgSubmitAttestedTransfer) GetMemo() []byte {
+	if m != nil {
+		return m.Memo
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_FundAgentEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFundAgentEscrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FundAgentEscrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/FundAgentEscrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FundAgentEscrow(ctx, req.(*MsgFundAgentEscrow))
+func (m *MsgSubmitAttestedTransfer) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WithdrawAgentEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawAgentEscrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawAgentEscrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/WithdrawAgentEscrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawAgentEscrow(ctx, req.(*MsgWithdrawAgentEscrow))
+func (m *MsgSubmitAttestedTransfer) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateAgentSpendPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateAgentSpendPolicy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateAgentSpendPolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/UpdateAgentSpendPolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateAgentSpendPolicy(ctx, req.(*MsgUpdateAgentSpendPolicy))
+func (m *MsgSubmitAttestedTransfer) GetCoAttestations() []CoAttestation {
+	if m != nil {
+		return m.CoAttestations
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_SubmitAttestedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitAttestedTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitAttestedTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/SubmitAttestedTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitAttestedTransfer(ctx, req.(*MsgSubmitAttestedTransfer))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSubmitAttestedTransferResponse struct {
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func _Msg_RevokePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokePolicy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokePolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/RevokePolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokePolicy(ctx, req.(*MsgRevokePolicy))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSubmitAttestedTransferResponse) Reset()         { *m = MsgSubmitAttestedTransferResponse{} }
+func (m *MsgSubmitAttestedTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitAttestedTransferResponse) ProtoMessage()    {}
+func (*MsgSubmitAttestedTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{32}
 }
-
-func _Msg_UnrevokePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUnrevokePolicy)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UnrevokePolicy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/UnrevokePolicy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UnrevokePolicy(ctx, req.(*MsgUnrevokePolicy))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSubmitAttestedTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_SubmitFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitFeedback)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitFeedback(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/SubmitFeedback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitFeedback(ctx, req.(*MsgSubmitFeedback))
+func (m *MsgSubmitAttestedTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitAttestedTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Msg_RevokeFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeFeedback)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RevokeFeedback(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.agent.Msg/RevokeFeedback",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeFeedback(ctx, req.(*MsgRevokeFeedback))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgSubmitAttestedTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitAttestedTransferResponse.Merge(m, src)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.agent.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterAgent",
-			Handler:    _Msg_RegisterAgent_Handler,
-		},
-		{
-			MethodName: "DeactivateAgent",
-			Handler:    _Msg_DeactivateAgent_Handler,
-		},
-		{
-			MethodName: "UpdateAgentPolicy",
-			Handler:    _Msg_UpdateAgentPolicy_Handler,
-		},
-		{
-			MethodName: "SubmitAttestedAction",
-			Handler:    _Msg_SubmitAttestedAction_Handler,
-		},
-		{
-			MethodName: "FundAgentEscrow",
-			Handler:    _Msg_FundAgentEscrow_Handler,
-		},
-		{
-			MethodName: "WithdrawAgentEscrow",
-			Handler:    _Msg_WithdrawAgentEscrow_Handler,
-		},
-		{
-			MethodName: "UpdateAgentSpendPolicy",
-			Handler:    _Msg_UpdateAgentSpendPolicy_Handler,
-		},
-		{
-			MethodName: "SubmitAttestedTransfer",
-			Handler:    _Msg_SubmitAttestedTransfer_Handler,
-		},
-		{
-			MethodName: "RevokePolicy",
-			Handler:    _Msg_RevokePolicy_Handler,
-		},
-		{
-			MethodName: "UnrevokePolicy",
-			Handler:    _Msg_UnrevokePolicy_Handler,
-		},
-		{
-			MethodName: "SubmitFeedback",
-			Handler:    _Msg_SubmitFeedback_Handler,
-		},
-		{
-			MethodName: "RevokeFeedback",
-			Handler:    _Msg_RevokeFeedback_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/agent/tx.proto",
+func (m *MsgSubmitAttestedTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitAttestedTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitAttestedTransferResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterAgent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitAttestedTransferResponse proto.InternalMessageInfo
+
+func (m *MsgSubmitAttestedTransferResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgRegisterAgent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateSubAgent creates a sub-agent on behalf of its parent. As with
+// attested actions, any account may submit: the parent's token authorizes
+// the exact spec. The submitter pays the agent registration fee.
+type MsgCreateSubAgent struct {
+	Submitter string       `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	ParentId  string       `protobuf:"bytes,2,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	Spec      SubAgentSpec `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec"`
+	Token     string       `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
+	// co_attestations count toward the parent's attestation quorum. Each must
+	// verify.
+	CoAttestations []CoAttestation `protobuf:"bytes,5,rep,name=co_attestations,json=coAttestations,proto3" json:"co_attestations"`
 }
 
-func (m *MsgRegisterAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgCreateSubAgent) Reset()         { *m = MsgCreateSubAgent{} }
+func (m *MsgCreateSubAgent) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubAgent) ProtoMessage()    {}
+func (*MsgCreateSubAgent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{33}
+}
+func (m *MsgCreateSubAgent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSubAgent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSubAgent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgRegisterAgentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCreateSubAgent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubAgent.Merge(m, src)
 }
-
-func (m *MsgRegisterAgentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateSubAgent) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgRegisterAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateSubAgent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubAgent.DiscardUnknown(m)
 }
 
-func (m *MsgDeactivateAgent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateSubAgent proto.InternalMessageInfo
+
+func (m *MsgCreateSubAgent) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeactivateAgent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateSubAgent) GetParentId() string {
+	if m != nil {
+		return m.ParentId
+	}
+	return ""
 }
 
-func (m *MsgDeactivateAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgCreateSubAgent) GetSpec() SubAgentSpec {
+	if m != nil {
+		return m.Spec
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+	return SubAgentSpec{}
+}
+
+func (m *MsgCreateSubAgent) GetToken() string {
+	if m != nil {
+		return m.Token
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeactivateAgentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateSubAgent) GetCoAttestations() []CoAttestation {
+	if m != nil {
+		return m.CoAttestations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDeactivateAgentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateSubAgentResponse returns the parent's action log seq recording the
+// creation.
+type MsgCreateSubAgentResponse struct {
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
 }
 
-func (m *MsgDeactivateAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateSubAgentResponse) Reset()         { *m = MsgCreateSubAgentResponse{} }
+func (m *MsgCreateSubAgentResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateSubAgentResponse) ProtoMessage()    {}
+func (*MsgCreateSubAgentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{34}
+}
+func (m *MsgCreateSubAgentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateSubAgentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateSubAgentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateSubAgentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateSubAgentResponse.Merge(m, src)
+}
+func (m *MsgCreateSubAgentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateSubAgentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateSubAgentResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateAgentPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCreateSubAgentResponse proto.InternalMessageInfo
+
+func (m *MsgCreateSubAgentResponse) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateAgentPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRegisterPolicy adds a policy to the shared registry.
+type MsgRegisterPolicy struct {
+	Admin  string     `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty"`
+	Policy tee.Policy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy"`
 }
 
-func (m *MsgUpdateAgentPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NewPolicy.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRegisterPolicy) Reset()         { *m = MsgRegisterPolicy{} }
+func (m *MsgRegisterPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPolicy) ProtoMessage()    {}
+func (*MsgRegisterPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{35}
+}
+func (m *MsgRegisterPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRegisterPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPolicy.Merge(m, src)
+}
+func (m *MsgRegisterPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterPolicy proto.InternalMessageInfo
+
+func (m *MsgRegisterPolicy) GetAdmin() string {
+	if m != nil {
+		return m.Admin
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateAgentPolicyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRegisterPolicy) GetPolicy() tee.Policy {
+	if m != nil {
+		return m.Policy
 	}
-	return dAtA[:n], nil
+	return tee.Policy{}
 }
 
-func (m *MsgUpdateAgentPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRegisterPolicyResponse struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *MsgUpdateAgentPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ActivationHeight != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgRegisterPolicyResponse) Reset()         { *m = MsgRegisterPolicyResponse{} }
+func (m *MsgRegisterPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterPolicyResponse) ProtoMessage()    {}
+func (*MsgRegisterPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{36}
+}
+func (m *MsgRegisterPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterPolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRegisterPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterPolicyResponse.Merge(m, src)
+}
+func (m *MsgRegisterPolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterPolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitAttestedAction) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRegisterPolicyResponse proto.InternalMessageInfo
+
+func (m *MsgRegisterPolicyResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitAttestedAction) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSupersedePolicy registers new_policy as the successor of the lineage
+// head id. The successor keeps the lineage admin.
+type MsgSupersedePolicy struct {
+	// authority is the lineage admin or the governance account.
+	Authority string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        string     `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	NewPolicy tee.Policy `protobuf:"bytes,3,opt,name=new_policy,json=newPolicy,proto3" json:"new_policy"`
 }
 
-func (m *MsgSubmitAttestedAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Payload) > 0 {
-		i -= len(m.Payload)
-		copy(dAtA[i:], m.Payload)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgSupersedePolicy) Reset()         { *m = MsgSupersedePolicy{} }
+func (m *MsgSupersedePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgSupersedePolicy) ProtoMessage()    {}
+func (*MsgSupersedePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{37}
+}
+func (m *MsgSupersedePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSupersedePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSupersedePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSupersedePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSupersedePolicy.Merge(m, src)
+}
+func (m *MsgSupersedePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSupersedePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSupersedePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSupersedePolicy proto.InternalMessageInfo
+
+func (m *MsgSupersedePolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSupersedePolicy) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubmitAttestedActionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSupersedePolicy) GetNewPolicy() tee.Policy {
+	if m != nil {
+		return m.NewPolicy
 	}
-	return dAtA[:n], nil
+	return tee.Policy{}
 }
 
-func (m *MsgSubmitAttestedActionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSupersedePolicyResponse struct {
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *MsgSubmitAttestedActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Seq != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSupersedePolicyResponse) Reset()         { *m = MsgSupersedePolicyResponse{} }
+func (m *MsgSupersedePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSupersedePolicyResponse) ProtoMessage()    {}
+func (*MsgSupersedePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{38}
+}
+func (m *MsgSupersedePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSupersedePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSupersedePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSupersedePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSupersedePolicyResponse.Merge(m, src)
+}
+func (m *MsgSupersedePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSupersedePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSupersedePolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFundAgentEscrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSupersedePolicyResponse proto.InternalMessageInfo
+
+func (m *MsgSupersedePolicyResponse) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFundAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSupersedePolicyResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-func (m *MsgFundAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
+// MsgRevokePolicy revokes a TEE policy by fingerprint: agents pinning it can
+// no longer submit attested actions, and new agents cannot register with it.
+type MsgRevokePolicy struct {
+	// authority is the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// fingerprint is the lowercase hex SHA-256 of the deterministic proto
+	// marshaling of the policy.
+	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// reason documents why the policy was revoked (e.g. a CVE identifier).
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgRevokePolicy) Reset()         { *m = MsgRevokePolicy{} }
+func (m *MsgRevokePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePolicy) ProtoMessage()    {}
+func (*MsgRevokePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{39}
+}
+func (m *MsgRevokePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Funder) > 0 {
-		i -= len(m.Funder)
-		copy(dAtA[i:], m.Funder)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRevokePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePolicy.Merge(m, src)
+}
+func (m *MsgRevokePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokePolicy proto.InternalMessageInfo
+
+func (m *MsgRevokePolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgFundAgentEscrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokePolicy) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFundAgentEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokePolicy) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *MsgFundAgentEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRevokePolicyResponse struct {
 }
 
-func (m *MsgWithdrawAgentEscrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokePolicyResponse) Reset()         { *m = MsgRevokePolicyResponse{} }
+func (m *MsgRevokePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokePolicyResponse) ProtoMessage()    {}
+func (*MsgRevokePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{40}
+}
+func (m *MsgRevokePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgRevokePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokePolicyResponse.Merge(m, src)
+}
+func (m *MsgRevokePolicyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokePolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokePolicyResponse proto.InternalMessageInfo
+
+// MsgUnrevokePolicy removes a policy fingerprint from the revocation
+// denylist, re-enabling agents pinning it. No agent state is touched.
+type MsgUnrevokePolicy struct {
+	// authority is the x/gov module account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// fingerprint is the lowercase hex SHA-256 of the deterministic proto
+	// marshaling of the policy.
+	Fingerprint string `protobuf:"bytes,2,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 }
 
-func (m *MsgWithdrawAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgUnrevokePolicy) Reset()         { *m = MsgUnrevokePolicy{} }
+func (m *MsgUnrevokePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgUnrevokePolicy) ProtoMessage()    {}
+func (*MsgUnrevokePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{41}
+}
+func (m *MsgUnrevokePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnrevokePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnrevokePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUnrevokePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnrevokePolicy.Merge(m, src)
+}
+func (m *MsgUnrevokePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnrevokePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnrevokePolicy.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawAgentEscrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnrevokePolicy proto.InternalMessageInfo
+
+func (m *MsgUnrevokePolicy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawAgentEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnrevokePolicy) GetFingerprint() string {
+	if m != nil {
+		return m.Fingerprint
+	}
+	return ""
 }
 
-func (m *MsgWithdrawAgentEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgUnrevokePolicyResponse struct {
 }
 
-func (m *MsgUpdateAgentSpendPolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUnrevokePolicyResponse) Reset()         { *m = MsgUnrevokePolicyResponse{} }
+func (m *MsgUnrevokePolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnrevokePolicyResponse) ProtoMessage()    {}
+func (*MsgUnrevokePolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{42}
+}
+func (m *MsgUnrevokePolicyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnrevokePolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnrevokePolicyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateAgentSpendPolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUnrevokePolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnrevokePolicyResponse.Merge(m, src)
 }
-
-func (m *MsgUpdateAgentSpendPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SpendWindowBlocks != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SpendWindowBlocks))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.SpendLimitPerWindow.Size()
-		i -= size
-		if _, err := m.SpendLimitPerWindow.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.SpendDenom) > 0 {
-		i -= len(m.SpendDenom)
-		copy(dAtA[i:], m.SpendDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SpendDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgUnrevokePolicyResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUpdateAgentSpendPolicyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUnrevokePolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnrevokePolicyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateAgentSpendPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgUnrevokePolicyResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateAgentSpendPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSubmitFeedback struct {
+	Client  string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// score is fixed-point with 2 decimals: 0..10000 == 0.00%..100.00%.
+	Score uint32 `protobuf:"varint,3,opt,name=score,proto3" json:"score,omitempty"`
+	// tag1 is the primary dimension name, e.g. "liveness". Required.
+	Tag1 string `protobuf:"bytes,4,opt,name=tag1,proto3" json:"tag1,omitempty"`
+	// tag2 is an optional secondary dimension name.
+	Tag2 string `protobuf:"bytes,5,opt,name=tag2,proto3" json:"tag2,omitempty"`
+	// evidence_seq must reference an existing action-log entry of the agent.
+	EvidenceSeq uint64 `protobuf:"varint,6,opt,name=evidence_seq,json=evidenceSeq,proto3" json:"evidence_seq,omitempty"`
+	// verified requires evidence_seq to be an interaction with the client: an
+	// action the client submitted or an attested transfer that paid it. Such
+	// feedback is counted separately in the agent's reputation.
+	Verified bool `protobuf:"varint,7,opt,name=verified,proto3" json:"verified,omitempty"`
 }
 
-func (m *MsgSubmitAttestedTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitFeedback) Reset()         { *m = MsgSubmitFeedback{} }
+func (m *MsgSubmitFeedback) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFeedback) ProtoMessage()    {}
+func (*MsgSubmitFeedback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{43}
 }
-
-func (m *MsgSubmitAttestedTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitFeedback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgSubmitAttestedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Memo) > 0 {
-		i -= len(m.Memo)
-		copy(dAtA[i:], m.Memo)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSubmitFeedback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFeedback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Submitter) > 0 {
-		i -= len(m.Submitter)
-		copy(dAtA[i:], m.Submitter)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSubmitFeedback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFeedback.Merge(m, src)
+}
+func (m *MsgSubmitFeedback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitFeedback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFeedback.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitAttestedTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSubmitFeedback proto.InternalMessageInfo
+
+func (m *MsgSubmitFeedback) GetClient() string {
+	if m != nil {
+		return m.Client
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitAttestedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitFeedback) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
+	}
+	return ""
 }
 
-func (m *MsgSubmitAttestedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Seq != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Seq))
-		i--
-		dAtA[i] = 0x8
+func (m *MsgSubmitFeedback) GetScore() uint32 {
+	if m != nil {
+		return m.Score
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgRevokePolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFeedback) GetTag1() string {
+	if m != nil {
+		return m.Tag1
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRevokePolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitFeedback) GetTag2() string {
+	if m != nil {
+		return m.Tag2
+	}
+	return ""
 }
 
-func (m *MsgRevokePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSubmitFeedback) GetEvidenceSeq() uint64 {
+	if m != nil {
+		return m.EvidenceSeq
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgRevokePolicyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFeedback) GetVerified() bool {
+	if m != nil {
+		return m.Verified
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgRevokePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSubmitFeedbackResponse struct {
 }
 
-func (m *MsgRevokePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSubmitFeedbackResponse) Reset()         { *m = MsgSubmitFeedbackResponse{} }
+func (m *MsgSubmitFeedbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitFeedbackResponse) ProtoMessage()    {}
+func (*MsgSubmitFeedbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{44}
 }
-
-func (m *MsgUnrevokePolicy) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitFeedbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitFeedbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUnrevokePolicy) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSubmitFeedbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitFeedbackResponse.Merge(m, src)
 }
-
-func (m *MsgUnrevokePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSubmitFeedbackResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgUnrevokePolicyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSubmitFeedbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitFeedbackResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUnrevokePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgSubmitFeedbackResponse proto.InternalMessageInfo
 
-func (m *MsgUnrevokePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgRevokeFeedback struct {
+	Client  string `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
+	AgentId string `protobuf:"bytes,2,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 }
 
-func (m *MsgSubmitFeedback) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeFeedback) Reset()         { *m = MsgRevokeFeedback{} }
+func (m *MsgRevokeFeedback) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeFeedback) ProtoMessage()    {}
+func (*MsgRevokeFeedback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{45}
+}
+func (m *MsgRevokeFeedback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeFeedback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeFeedback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgSubmitFeedback) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeFeedback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeFeedback.Merge(m, src)
+}
+func (m *MsgRevokeFeedback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeFeedback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeFeedback.DiscardUnknown(m)
 }
 
-func (m *MsgSubmitFeedback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EvidenceSeq != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.EvidenceSeq))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Tag2) > 0 {
-		i -= len(m.Tag2)
-		copy(dAtA[i:], m.Tag2)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag2)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Tag1) > 0 {
-		i -= len(m.Tag1)
-		copy(dAtA[i:], m.Tag1)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag1)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Score != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Score))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgRevokeFeedback proto.InternalMessageInfo
+
+func (m *MsgRevokeFeedback) GetClient() string {
+	if m != nil {
+		return m.Client
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgSubmitFeedbackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeFeedback) GetAgentId() string {
+	if m != nil {
+		return m.AgentId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgSubmitFeedbackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRevokeFeedbackResponse struct {
 }
 
-func (m *MsgSubmitFeedbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeFeedbackResponse) Reset()         { *m = MsgRevokeFeedbackResponse{} }
+func (m *MsgRevokeFeedbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeFeedbackResponse) ProtoMessage()    {}
+func (*MsgRevokeFeedbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cc4323968b6c653f, []int{46}
 }
-
-func (m *MsgRevokeFeedback) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeFeedbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeFeedbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeFeedbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgRevokeFeedback) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeFeedbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeFeedbackResponse.Merge(m, src)
 }
-
-func (m *MsgRevokeFeedback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AgentId) > 0 {
-		i -= len(m.AgentId)
-		copy(dAtA[i:], m.AgentId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Client) > 0 {
-		i -= len(m.Client)
-		copy(dAtA[i:], m.Client)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgRevokeFeedbackResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgRevokeFeedbackResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeFeedbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeFeedbackResponse.DiscardUnknown(m)
 }
 
-func (m *MsgRevokeFeedbackResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeFeedbackResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgRegisterAgent)(nil), "dymensionxyz.dymension.agent.MsgRegisterAgent")
+	proto.RegisterType((*MsgRegisterAgentResponse)(nil), "dymensionxyz.dymension.agent.MsgRegisterAgentResponse")
+	proto.RegisterType((*MsgDeactivateAgent)(nil), "dymensionxyz.dymension.agent.MsgDeactivateAgent")
+	proto.RegisterType((*MsgDeactivateAgentResponse)(nil), "dymensionxyz.dymension.agent.MsgDeactivateAgentResponse")
+	proto.RegisterType((*MsgUpdateAgentPolicy)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentPolicy")
+	proto.RegisterType((*MsgUpdateAgentPolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentPolicyResponse")
+	proto.RegisterType((*MsgUpdateAgentPolicySet)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentPolicySet")
+	proto.RegisterType((*MsgUpdateAgentPolicySetResponse)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentPolicySetResponse")
+	proto.RegisterType((*CoAttestation)(nil), "dymensionxyz.dymension.agent.CoAttestation")
+	proto.RegisterType((*MsgSubmitAttestedAction)(nil), "dymensionxyz.dymension.agent.MsgSubmitAttestedAction")
+	proto.RegisterType((*MsgSubmitAttestedActionResponse)(nil), "dymensionxyz.dymension.agent.MsgSubmitAttestedActionResponse")
+	proto.RegisterType((*MsgFundAgentEscrow)(nil), "dymensionxyz.dymension.agent.MsgFundAgentEscrow")
+	proto.RegisterType((*MsgFundAgentEscrowResponse)(nil), "dymensionxyz.dymension.agent.MsgFundAgentEscrowResponse")
+	proto.RegisterType((*MsgWithdrawAgentEscrow)(nil), "dymensionxyz.dymension.agent.MsgWithdrawAgentEscrow")
+	proto.RegisterType((*MsgWithdrawAgentEscrowResponse)(nil), "dymensionxyz.dymension.agent.MsgWithdrawAgentEscrowResponse")
+	proto.RegisterType((*MsgCancelAgentWithdrawal)(nil), "dymensionxyz.dymension.agent.MsgCancelAgentWithdrawal")
+	proto.RegisterType((*MsgCancelAgentWithdrawalResponse)(nil), "dymensionxyz.dymension.agent.MsgCancelAgentWithdrawalResponse")
+	proto.RegisterType((*MsgUpdateAgentSecurityPolicy)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentSecurityPolicy")
+	proto.RegisterType((*MsgUpdateAgentSecurityPolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentSecurityPolicyResponse")
+	proto.RegisterType((*MsgFreezeAgent)(nil), "dymensionxyz.dymension.agent.MsgFreezeAgent")
+	proto.RegisterType((*MsgFreezeAgentResponse)(nil), "dymensionxyz.dymension.agent.MsgFreezeAgentResponse")
+	proto.RegisterType((*MsgUnfreezeAgent)(nil), "dymensionxyz.dymension.agent.MsgUnfreezeAgent")
+	proto.RegisterType((*MsgUnfreezeAgentResponse)(nil), "dymensionxyz.dymension.agent.MsgUnfreezeAgentResponse")
+	proto.RegisterType((*MsgCreatePaymentStream)(nil), "dymensionxyz.dymension.agent.MsgCreatePaymentStream")
+	proto.RegisterType((*MsgCreatePaymentStreamResponse)(nil), "dymensionxyz.dymension.agent.MsgCreatePaymentStreamResponse")
+	proto.RegisterType((*MsgCancelPaymentStream)(nil), "dymensionxyz.dymension.agent.MsgCancelPaymentStream")
+	proto.RegisterType((*MsgCancelPaymentStreamResponse)(nil), "dymensionxyz.dymension.agent.MsgCancelPaymentStreamResponse")
+	proto.RegisterType((*MsgUpdateAgentSpendPolicy)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentSpendPolicy")
+	proto.RegisterType((*MsgUpdateAgentSpendPolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentSpendPolicyResponse")
+	proto.RegisterType((*MsgUpdateAgentRecipientPolicy)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentRecipientPolicy")
+	proto.RegisterType((*MsgUpdateAgentRecipientPolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgUpdateAgentRecipientPolicyResponse")
+	proto.RegisterType((*MsgSubmitAttestedTransfer)(nil), "dymensionxyz.dymension.agent.MsgSubmitAttestedTransfer")
+	proto.RegisterType((*MsgSubmitAttestedTransferResponse)(nil), "dymensionxyz.dymension.agent.MsgSubmitAttestedTransferResponse")
+	proto.RegisterType((*MsgCreateSubAgent)(nil), "dymensionxyz.dymension.agent.MsgCreateSubAgent")
+	proto.RegisterType((*MsgCreateSubAgentResponse)(nil), "dymensionxyz.dymension.agent.MsgCreateSubAgentResponse")
+	proto.RegisterType((*MsgRegisterPolicy)(nil), "dymensionxyz.dymension.agent.MsgRegisterPolicy")
+	proto.RegisterType((*MsgRegisterPolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgRegisterPolicyResponse")
+	proto.RegisterType((*MsgSupersedePolicy)(nil), "dymensionxyz.dymension.agent.MsgSupersedePolicy")
+	proto.RegisterType((*MsgSupersedePolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgSupersedePolicyResponse")
+	proto.RegisterType((*MsgRevokePolicy)(nil), "dymensionxyz.dymension.agent.MsgRevokePolicy")
+	proto.RegisterType((*MsgRevokePolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgRevokePolicyResponse")
+	proto.RegisterType((*MsgUnrevokePolicy)(nil), "dymensionxyz.dymension.agent.MsgUnrevokePolicy")
+	proto.RegisterType((*MsgUnrevokePolicyResponse)(nil), "dymensionxyz.dymension.agent.MsgUnrevokePolicyResponse")
+	proto.RegisterType((*MsgSubmitFeedback)(nil), "dymensionxyz.dymension.agent.MsgSubmitFeedback")
+	proto.RegisterType((*MsgSubmitFeedbackResponse)(nil), "dymensionxyz.dymension.agent.MsgSubmitFeedbackResponse")
+	proto.RegisterType((*MsgRevokeFeedback)(nil), "dymensionxyz.dymension.agent.MsgRevokeFeedback")
+	proto.RegisterType((*MsgRevokeFeedbackResponse)(nil), "dymensionxyz.dymension.agent.MsgRevokeFeedbackResponse")
 }
 
-func (m *MsgRevokeFeedbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/agent/tx.proto", fileDescriptor_cc4323968b6c653f)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var fileDescriptor_cc4323968b6c653f = []byte{
+	// 2214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0x4a, 0x14, 0x45, 0x3d, 0x4a, 0x72, 0xb4, 0x96, 0x65, 0x72, 0xed, 0x52, 0x32, 0x53,
+	0xc3, 0xaa, 0x1d, 0x71, 0x65, 0xd9, 0x96, 0x0c, 0x27, 0xa9, 0x61, 0xc9, 0x35, 0xa2, 0xda, 0x6a,
+	0x85, 0xa5, 0x0d, 0xa3, 0xb9, 0xb0, 0xcb, 0xdd, 0xd1, 0x6a, 0x23, 0x71, 0x97, 0xde, 0x19, 0x8a,
+	0x96, 0x81, 0x00, 0x45, 0x7b, 0x69, 0x81, 0x02, 0x09, 0x9a, 0xa2, 0x1f, 0x28, 0xd0, 0x3f, 0xa0,
+	0x97, 0xfa, 0x10, 0xa0, 0x3d, 0x15, 0x28, 0x7a, 0xc9, 0x31, 0x48, 0x7b, 0x28, 0x0a, 0x34, 0x29,
+	0xec, 0x43, 0x80, 0xfe, 0x15, 0xc5, 0x7c, 0xec, 0x92, 0xbb, 0x5c, 0x7e, 0x2c, 0x4d, 0x1b, 0x3d,
+	0xd8, 0xda, 0x99, 0x79, 0xbf, 0x37, 0xbf, 0xf7, 0xe6, 0xcd, 0x9b, 0x99, 0x27, 0xc1, 0x05, 0xf3,
+	0xb8, 0x86, 0x1c, 0x6c, 0xbb, 0xce, 0x93, 0xe3, 0xa7, 0x6a, 0xd0, 0x50, 0x75, 0x0b, 0x39, 0x44,
+	0x25, 0x4f, 0x4a, 0x75, 0xcf, 0x25, 0xae, 0x7c, 0xae, 0x5d, 0xac, 0x14, 0x34, 0x4a, 0x4c, 0x4c,
+	0xc9, 0x1b, 0x2e, 0xae, 0xb9, 0xb8, 0xc2, 0x64, 0x55, 0xde, 0xe0, 0x40, 0xe5, 0x0c, 0x6f, 0xa9,
+	0x35, 0x6c, 0xa9, 0x47, 0x57, 0xe8, 0x0f, 0x31, 0x50, 0x10, 0x03, 0x55, 0x1d, 0x23, 0xf5, 0xe8,
+	0x4a, 0x15, 0x11, 0xfd, 0x8a, 0x6a, 0xb8, 0xb6, 0x23, 0xc6, 0xe7, 0x2d, 0xd7, 0x72, 0xb9, 0x42,
+	0xfa, 0xe5, 0xa3, 0x2c, 0xd7, 0xb5, 0x0e, 0x91, 0xca, 0x5a, 0xd5, 0xc6, 0x9e, 0x6a, 0x36, 0x3c,
+	0x9d, 0x50, 0x26, 0x7c, 0x7c, 0x31, 0x3a, 0x4e, 0xec, 0x1a, 0xc2, 0x44, 0xaf, 0xd5, 0x85, 0xc0,
+	0xc5, 0x2e, 0xf6, 0x1a, 0x6e, 0xad, 0xe6, 0x3a, 0x2a, 0x41, 0x48, 0x08, 0x2e, 0xf7, 0x74, 0x0c,
+	0xfb, 0x9f, 0x4b, 0x16, 0x7f, 0x3b, 0x06, 0x6f, 0xec, 0x60, 0x4b, 0x43, 0x96, 0x8d, 0x09, 0xf2,
+	0x6e, 0xd3, 0x21, 0xb9, 0x04, 0x13, 0x6e, 0xd3, 0x41, 0x5e, 0x4e, 0x5a, 0x92, 0x96, 0xa7, 0x36,
+	0x73, 0x5f, 0x7c, 0xba, 0x32, 0x2f, 0x1c, 0x73, 0xdb, 0x34, 0x3d, 0x84, 0x71, 0x99, 0x78, 0xb6,
+	0x63, 0x69, 0x5c, 0x4c, 0xce, 0x43, 0x86, 0xe9, 0xac, 0xd8, 0x66, 0x6e, 0x8c, 0x42, 0xb4, 0x49,
+	0xd6, 0xde, 0x36, 0xe5, 0x2d, 0x48, 0xd7, 0xdd, 0x43, 0xdb, 0x38, 0xce, 0x8d, 0x2f, 0x49, 0xcb,
+	0xd9, 0xb5, 0x0b, 0xa5, 0x2e, 0x8b, 0xc1, 0x6d, 0x28, 0xed, 0x32, 0xe1, 0xcd, 0xd4, 0x67, 0x5f,
+	0x2e, 0x9e, 0xd0, 0x04, 0x54, 0xbe, 0x0f, 0xc0, 0xbf, 0x2a, 0x18, 0x91, 0x5c, 0x8a, 0x29, 0xba,
+	0x58, 0xea, 0xb5, 0xaa, 0x42, 0x4f, 0x19, 0x11, 0xa1, 0x6a, 0xaa, 0xee, 0x77, 0xc8, 0x67, 0x41,
+	0x34, 0x28, 0xdd, 0x09, 0x46, 0x37, 0xc3, 0x3b, 0xb6, 0xcd, 0x9b, 0xf0, 0xe3, 0xaf, 0x9f, 0x5d,
+	0xe2, 0x66, 0x15, 0x15, 0xc8, 0x45, 0x5d, 0xa3, 0x21, 0x5c, 0x77, 0x1d, 0x8c, 0x8a, 0x07, 0x20,
+	0xef, 0x60, 0xeb, 0x0e, 0xd2, 0x0d, 0x62, 0x1f, 0xe9, 0x04, 0x8d, 0xda, 0x71, 0x21, 0x22, 0xe7,
+	0x40, 0xe9, 0x9c, 0x2c, 0xa0, 0xf2, 0x6f, 0x09, 0xe6, 0x77, 0xb0, 0xf5, 0xb0, 0x6e, 0xfa, 0x43,
+	0xdc, 0xf8, 0x51, 0x2e, 0xe3, 0x77, 0x01, 0x1c, 0xd4, 0xac, 0x0c, 0xbf, 0x94, 0x53, 0x0e, 0x6a,
+	0x0a, 0x5a, 0x45, 0x98, 0x69, 0xe9, 0xa2, 0x73, 0xa5, 0xd8, 0x5c, 0xd9, 0x40, 0x22, 0x62, 0xfd,
+	0x3d, 0x38, 0x17, 0x67, 0x9e, 0x6f, 0xbf, 0x7c, 0x19, 0xe6, 0x84, 0x63, 0x6c, 0xd7, 0xa9, 0xec,
+	0x23, 0xdb, 0xda, 0x27, 0xcc, 0xe4, 0x71, 0xed, 0x8d, 0xd6, 0xc0, 0x7b, 0xac, 0xbf, 0xf8, 0x17,
+	0x09, 0xce, 0xc4, 0x69, 0xa3, 0x81, 0x31, 0x42, 0x7f, 0x85, 0x23, 0x76, 0xfc, 0xe5, 0x22, 0x36,
+	0xe4, 0x8d, 0xef, 0xc1, 0x62, 0x17, 0xfe, 0xc3, 0x39, 0xe4, 0x3d, 0x98, 0xd9, 0x72, 0x6f, 0x13,
+	0x42, 0x13, 0x0d, 0xed, 0x96, 0xcf, 0xc3, 0xb4, 0xbf, 0x34, 0x8e, 0x89, 0x9e, 0x30, 0xe0, 0x8c,
+	0x96, 0x15, 0x3b, 0x84, 0x76, 0xc9, 0xf3, 0x30, 0x41, 0xdc, 0x03, 0xe4, 0x08, 0xab, 0x79, 0xa3,
+	0xf8, 0xd3, 0x31, 0xe6, 0xda, 0x72, 0xa3, 0x5a, 0xb3, 0x09, 0xd7, 0x88, 0xcc, 0xdb, 0x06, 0x53,
+	0xba, 0x0e, 0x53, 0x98, 0xf5, 0x93, 0x01, 0xdc, 0xdb, 0x12, 0xed, 0xe5, 0xe2, 0x1c, 0x4c, 0xd6,
+	0xf5, 0xe3, 0x43, 0x57, 0x37, 0x99, 0x7f, 0xa7, 0x35, 0xbf, 0xd9, 0xa2, 0x97, 0x6a, 0xa3, 0x27,
+	0xbf, 0x0f, 0x27, 0x0d, 0xb7, 0xa2, 0xb7, 0x2c, 0xc5, 0xb9, 0x89, 0xa5, 0xf1, 0xe5, 0xec, 0xda,
+	0xe5, 0xde, 0xeb, 0x12, 0xf2, 0x8e, 0x58, 0x9b, 0x59, 0xa3, 0xbd, 0x13, 0xdf, 0x9c, 0xa5, 0x0b,
+	0xd4, 0xa2, 0x5d, 0xbc, 0x0a, 0x8b, 0x5d, 0x3c, 0x11, 0x2c, 0xd2, 0x1b, 0x30, 0x8e, 0xd1, 0x63,
+	0xe6, 0x8b, 0x94, 0x46, 0x3f, 0x8b, 0x7f, 0x97, 0x58, 0x4e, 0xb9, 0xdb, 0x70, 0x4c, 0xb6, 0xb0,
+	0xdf, 0xc1, 0x86, 0xe7, 0x36, 0xe5, 0x55, 0x48, 0xef, 0x35, 0x1c, 0x73, 0x00, 0xbf, 0x09, 0xb9,
+	0x5e, 0x4e, 0x33, 0x20, 0xad, 0xd7, 0xdc, 0x86, 0x43, 0x63, 0x92, 0xda, 0x9e, 0x2f, 0x09, 0x4d,
+	0xf4, 0x24, 0x2b, 0x89, 0x93, 0xac, 0xb4, 0xe5, 0xda, 0xce, 0xe6, 0x2a, 0xb5, 0xf4, 0x0f, 0x5f,
+	0x2d, 0x2e, 0x5b, 0x36, 0xd9, 0x6f, 0x54, 0xe9, 0xae, 0x16, 0xa7, 0xa3, 0xf8, 0xb1, 0x82, 0xcd,
+	0x03, 0x95, 0x1c, 0xd7, 0x11, 0x66, 0x00, 0xac, 0x09, 0xd5, 0x37, 0xb3, 0xd4, 0x1b, 0x82, 0x8c,
+	0xc8, 0x5d, 0x11, 0xa3, 0x82, 0xdc, 0xf5, 0x0f, 0x09, 0x16, 0x76, 0xb0, 0xf5, 0xc8, 0x26, 0xfb,
+	0xa6, 0xa7, 0x37, 0xdb, 0xed, 0x1e, 0xe1, 0x6e, 0x7c, 0x2d, 0x56, 0xb7, 0x6f, 0xd2, 0xc7, 0x50,
+	0x88, 0xb7, 0x2a, 0x58, 0xfe, 0xef, 0xc3, 0x64, 0x1d, 0x39, 0xa6, 0xed, 0x58, 0xcc, 0xbe, 0xec,
+	0x9a, 0xda, 0x27, 0x3b, 0x70, 0x61, 0x5f, 0xa5, 0x7e, 0xc8, 0x22, 0x51, 0xd2, 0x7c, 0x2d, 0xc5,
+	0x5f, 0x49, 0xec, 0xb4, 0xda, 0xd2, 0x1d, 0x03, 0x1d, 0xb2, 0x19, 0x5b, 0xb2, 0xa3, 0xf4, 0xe5,
+	0x9b, 0x30, 0xd3, 0x0c, 0x14, 0x57, 0x6c, 0xbe, 0xf9, 0x52, 0xda, 0x74, 0xab, 0x33, 0x92, 0xbe,
+	0x8b, 0xb0, 0xd4, 0x8d, 0x57, 0x10, 0x06, 0x7f, 0x95, 0xa2, 0x39, 0xbe, 0x8c, 0x8c, 0x86, 0x67,
+	0x93, 0xe3, 0x57, 0x71, 0x94, 0x85, 0x6f, 0x24, 0x6f, 0xf5, 0x76, 0x7c, 0x98, 0x48, 0xf8, 0x62,
+	0x12, 0xb2, 0xb3, 0x0c, 0xdf, 0xec, 0x65, 0xc2, 0x70, 0xd9, 0xf9, 0x03, 0x98, 0xa5, 0xbb, 0xc7,
+	0x43, 0xe8, 0xa9, 0xb8, 0x62, 0xac, 0x42, 0x1a, 0xdb, 0xd6, 0x20, 0xae, 0x10, 0x72, 0xbd, 0x2e,
+	0x19, 0x7c, 0xa7, 0x72, 0xb9, 0x62, 0x0e, 0x16, 0xc2, 0x73, 0x05, 0xcb, 0x73, 0xc8, 0xee, 0x88,
+	0x0f, 0x9d, 0xbd, 0xd7, 0xc2, 0x83, 0x5f, 0xbb, 0x42, 0xb3, 0x05, 0x4c, 0xfe, 0x34, 0xce, 0x48,
+	0x6e, 0x79, 0x48, 0x27, 0x68, 0x57, 0xa7, 0x6b, 0x45, 0xca, 0xc4, 0x43, 0x7a, 0x6d, 0x94, 0x21,
+	0xb2, 0x0e, 0x53, 0x1e, 0x32, 0xec, 0xba, 0x8d, 0x1c, 0x7e, 0x78, 0xf7, 0x3c, 0xad, 0x02, 0x51,
+	0xf9, 0x1e, 0xcc, 0xf1, 0x64, 0x50, 0xa9, 0x23, 0x8f, 0xfe, 0xb3, 0x5d, 0x53, 0x5c, 0x57, 0x7b,
+	0xa4, 0x1c, 0x1e, 0x52, 0x27, 0x39, 0x72, 0x17, 0x79, 0xbb, 0x0c, 0x27, 0xbf, 0x0d, 0x69, 0xa1,
+	0x61, 0x42, 0x68, 0xe0, 0xcf, 0x83, 0x92, 0xff, 0x3c, 0x28, 0xdd, 0x11, 0xcf, 0x87, 0xcd, 0x0c,
+	0xd5, 0xf0, 0x9b, 0xaf, 0x16, 0x25, 0x4d, 0x40, 0xe4, 0x2d, 0x00, 0x4c, 0x74, 0x8f, 0x54, 0x88,
+	0x5d, 0x43, 0xb9, 0x34, 0x53, 0xa0, 0x74, 0x28, 0x78, 0xe0, 0xbf, 0x2f, 0xb8, 0x86, 0x8f, 0xa9,
+	0x86, 0x29, 0x86, 0xa3, 0x23, 0xf2, 0x2d, 0xc8, 0x20, 0xc7, 0xe4, 0x2a, 0x26, 0x13, 0xa8, 0x98,
+	0x44, 0x8e, 0x49, 0xfb, 0x43, 0xdb, 0xe3, 0x03, 0x28, 0xc4, 0x2f, 0x5c, 0xb0, 0x31, 0xce, 0xc2,
+	0x14, 0x66, 0x3d, 0x74, 0x45, 0xf8, 0xb9, 0x98, 0xe1, 0x1d, 0xdb, 0x66, 0xfc, 0xae, 0x19, 0xeb,
+	0xb2, 0x6b, 0x3e, 0xe2, 0xa7, 0x0a, 0xcf, 0x39, 0xe1, 0x28, 0x19, 0x65, 0xd8, 0x86, 0x19, 0x8f,
+	0x87, 0x19, 0x87, 0x63, 0x7a, 0x09, 0x0a, 0xf1, 0x84, 0x82, 0xc8, 0xfe, 0x63, 0x0a, 0xf2, 0x91,
+	0xfc, 0x41, 0x53, 0xfb, 0xe8, 0xf3, 0xdf, 0x22, 0x64, 0x31, 0xd5, 0x5c, 0x31, 0x91, 0xe3, 0xd6,
+	0x78, 0x78, 0x6b, 0xc0, 0xba, 0xee, 0xd0, 0x1e, 0xf9, 0x87, 0xb0, 0xc0, 0x05, 0x0e, 0xed, 0x9a,
+	0xcd, 0x43, 0xb9, 0x69, 0x3b, 0xa6, 0xdb, 0xe4, 0xf7, 0xa9, 0xcd, 0xcb, 0x74, 0xa1, 0xff, 0xf5,
+	0xe5, 0xe2, 0x69, 0x4e, 0x00, 0x9b, 0x07, 0x25, 0xdb, 0x55, 0x6b, 0x3a, 0xd9, 0x2f, 0x6d, 0x3b,
+	0xe4, 0x8b, 0x4f, 0x57, 0x40, 0x30, 0xdb, 0x76, 0x88, 0x76, 0x8a, 0xa9, 0xba, 0x4f, 0x35, 0xed,
+	0x22, 0xef, 0x11, 0xd3, 0x23, 0x97, 0x80, 0x77, 0x0b, 0xbd, 0x95, 0xea, 0xa1, 0x6b, 0x1c, 0x60,
+	0x16, 0xe7, 0x29, 0x6d, 0x8e, 0x0d, 0x71, 0xc9, 0x4d, 0x36, 0x20, 0x3f, 0x80, 0x19, 0x2e, 0x5f,
+	0x6d, 0x98, 0x16, 0x22, 0x38, 0x97, 0x66, 0xc7, 0xf8, 0xb7, 0xfa, 0x64, 0x6e, 0x0a, 0xd9, 0x64,
+	0x08, 0xb1, 0xc7, 0xa6, 0x71, 0xab, 0x0b, 0xcb, 0x3f, 0x80, 0xb9, 0x10, 0x8b, 0x9a, 0x6b, 0xf2,
+	0x38, 0x9f, 0x5d, 0x5b, 0x19, 0x40, 0x33, 0x67, 0xb8, 0xe3, 0x9a, 0x48, 0x3b, 0x89, 0xc3, 0x1d,
+	0xf2, 0x23, 0x38, 0x1d, 0x52, 0xed, 0x3f, 0xf4, 0x73, 0x99, 0xc1, 0xb7, 0xf2, 0xa9, 0x36, 0xa5,
+	0xfe, 0x70, 0x68, 0x47, 0xbd, 0x09, 0xe7, 0xbb, 0x06, 0x4c, 0x10, 0x56, 0x7f, 0x93, 0xe0, 0x1b,
+	0x61, 0x29, 0xcd, 0x4f, 0x57, 0xa3, 0x0f, 0xad, 0x7b, 0x91, 0xa3, 0xb5, 0x8f, 0x1b, 0x23, 0x4c,
+	0x7a, 0x9c, 0xad, 0x17, 0xe1, 0x42, 0x4f, 0x23, 0x02, 0x73, 0x7f, 0x3d, 0x0e, 0xf9, 0x8e, 0x9b,
+	0xf7, 0x03, 0x4f, 0x77, 0xf0, 0x1e, 0xf2, 0x5e, 0xc5, 0x2b, 0x64, 0xd8, 0xa3, 0x62, 0x2b, 0xb8,
+	0x92, 0x0e, 0xb1, 0xa9, 0x04, 0x54, 0x96, 0x21, 0x55, 0x43, 0x35, 0x97, 0x6d, 0x9c, 0x69, 0x8d,
+	0x7d, 0xb7, 0x1e, 0x3f, 0xe9, 0xf6, 0xc7, 0xcf, 0x3c, 0x4c, 0xf0, 0xed, 0x3e, 0xc9, 0x7b, 0x59,
+	0x23, 0xee, 0x49, 0x94, 0x79, 0x55, 0x4f, 0xa2, 0xeb, 0x70, 0xbe, 0xeb, 0xc2, 0xf4, 0x78, 0x14,
+	0x3d, 0x1b, 0x83, 0xb9, 0xe0, 0xdc, 0x28, 0x37, 0xaa, 0xfc, 0xf2, 0x31, 0xec, 0x42, 0xd2, 0xd2,
+	0x8f, 0xee, 0x85, 0x56, 0x32, 0xc3, 0x3b, 0xb6, 0x4d, 0xf9, 0x0e, 0xa4, 0x70, 0x1d, 0x19, 0x22,
+	0x76, 0x2f, 0xf5, 0x49, 0x01, 0x82, 0x4a, 0xb9, 0x8e, 0x0c, 0xe1, 0x01, 0x86, 0xfe, 0x3f, 0x78,
+	0x7c, 0xae, 0x40, 0xbe, 0xc3, 0x63, 0x3d, 0x3c, 0xfc, 0x4b, 0x09, 0xe6, 0xda, 0xca, 0x5c, 0xad,
+	0xac, 0xa0, 0x9b, 0x35, 0xdb, 0xe9, 0x9f, 0x15, 0x98, 0x58, 0x5b, 0x9d, 0x6f, 0x6c, 0xe8, 0x3a,
+	0x9f, 0xd8, 0xf2, 0x4c, 0x61, 0xf1, 0x32, 0xe4, 0x3b, 0x58, 0x05, 0x56, 0xcc, 0xc2, 0x98, 0xb8,
+	0x23, 0x4c, 0x69, 0x63, 0xb6, 0x59, 0xfc, 0x33, 0x7f, 0x3a, 0x97, 0x1b, 0x75, 0xe4, 0x61, 0x64,
+	0x22, 0x61, 0xc4, 0x3a, 0x4c, 0xe9, 0x0d, 0xb2, 0xef, 0xd2, 0x5b, 0x78, 0xff, 0x30, 0x09, 0x44,
+	0x85, 0xfa, 0x31, 0x5f, 0xfd, 0x28, 0xab, 0x5f, 0x62, 0xb5, 0x82, 0xb9, 0x8a, 0x77, 0x41, 0xe9,
+	0x64, 0xde, 0xcd, 0x50, 0x5a, 0xf4, 0x38, 0x42, 0x1e, 0x9d, 0x87, 0xd1, 0x4b, 0x69, 0x7e, 0xb3,
+	0xf8, 0x89, 0x04, 0x27, 0x99, 0xc3, 0x8e, 0xdc, 0x83, 0x97, 0xb5, 0x7f, 0x09, 0xb2, 0x7b, 0xb6,
+	0x63, 0x21, 0xaf, 0xee, 0xd9, 0x0e, 0x11, 0x8e, 0x68, 0xef, 0x92, 0x17, 0x20, 0xed, 0x21, 0x1d,
+	0xbb, 0x8e, 0xb8, 0x3f, 0x88, 0x56, 0x87, 0x75, 0x79, 0x38, 0x13, 0x21, 0x15, 0xa4, 0xea, 0x0f,
+	0x59, 0xd8, 0x3d, 0x74, 0xbc, 0xd7, 0xc2, 0xb8, 0x83, 0xd9, 0x59, 0xc8, 0x77, 0x4c, 0x1f, 0x70,
+	0xfb, 0x2f, 0xdf, 0x13, 0x3c, 0x5b, 0xdd, 0x45, 0xc8, 0xac, 0xea, 0xc6, 0x01, 0xbd, 0x3b, 0x1a,
+	0x87, 0x2c, 0xd1, 0xf7, 0xbd, 0x3b, 0x72, 0xb9, 0x5e, 0x07, 0xc7, 0x3c, 0x4c, 0x60, 0xc3, 0xf5,
+	0x10, 0x73, 0xe0, 0x8c, 0xc6, 0x1b, 0x34, 0xa3, 0x13, 0xdd, 0xba, 0x22, 0x92, 0x07, 0xfb, 0x16,
+	0x7d, 0x6b, 0xa2, 0x54, 0xcd, 0xbe, 0x69, 0x91, 0x0e, 0x1d, 0xd9, 0x26, 0x72, 0x0c, 0x54, 0xa1,
+	0xfb, 0x39, 0xcd, 0x82, 0x21, 0xeb, 0xf7, 0x95, 0xd1, 0x63, 0x59, 0x81, 0xcc, 0x11, 0xf2, 0xec,
+	0x3d, 0x1b, 0x99, 0x2c, 0xeb, 0x67, 0xb4, 0xa0, 0x2d, 0xee, 0xa6, 0x9c, 0xa4, 0xf0, 0x44, 0xd8,
+	0xd6, 0xc0, 0x13, 0x35, 0x91, 0x1c, 0xa8, 0x93, 0x5e, 0x89, 0x23, 0xe2, 0xb8, 0x84, 0xa7, 0xf3,
+	0xb9, 0xac, 0x7d, 0x92, 0x83, 0xf1, 0x1d, 0x6c, 0xc9, 0x4d, 0x98, 0x89, 0xfc, 0xbe, 0xa2, 0x77,
+	0x12, 0x8d, 0x16, 0xf1, 0x95, 0xf5, 0x64, 0xf2, 0xc1, 0x6e, 0xfc, 0x10, 0x4e, 0x46, 0x2b, 0xfe,
+	0xab, 0x7d, 0x55, 0x45, 0x10, 0xca, 0x8d, 0xa4, 0x88, 0x60, 0xfa, 0x9f, 0x48, 0x30, 0xd7, 0x59,
+	0xe5, 0x5f, 0xeb, 0xab, 0xaf, 0x03, 0xa3, 0xdc, 0x4c, 0x8e, 0x09, 0x58, 0xfc, 0x5c, 0x82, 0xf9,
+	0xd8, 0xf2, 0xf9, 0xf5, 0xe4, 0x4a, 0xcb, 0x88, 0x28, 0xef, 0x0e, 0x05, 0x0b, 0xd1, 0x89, 0x2d,
+	0x39, 0xf7, 0xa7, 0x13, 0x07, 0x53, 0xde, 0x1d, 0x0a, 0xd6, 0x1e, 0x22, 0x1d, 0x05, 0xdc, 0xbe,
+	0x1a, 0x23, 0x08, 0xe5, 0x46, 0x52, 0x44, 0x30, 0xfd, 0xcf, 0x24, 0x38, 0x15, 0x57, 0x4c, 0xbd,
+	0xd6, 0x57, 0x63, 0x0c, 0x4a, 0x79, 0x67, 0x18, 0x54, 0xc0, 0xe5, 0x23, 0x09, 0x4e, 0xc7, 0x97,
+	0x23, 0xfb, 0xef, 0xbf, 0x58, 0x9c, 0xf2, 0xed, 0xe1, 0x70, 0x01, 0xa3, 0xdf, 0x49, 0x90, 0xef,
+	0x5e, 0x63, 0x4c, 0xb4, 0x29, 0xc2, 0x58, 0x65, 0x73, 0x78, 0x6c, 0xc0, 0xee, 0x31, 0x64, 0xdb,
+	0x0b, 0x7d, 0x6f, 0xf5, 0x0f, 0x82, 0x96, 0xb4, 0x72, 0x2d, 0x89, 0x74, 0x30, 0x65, 0x13, 0x66,
+	0xc2, 0x55, 0xbd, 0xfe, 0x99, 0x34, 0x24, 0xaf, 0xac, 0x27, 0x93, 0x0f, 0xc5, 0x69, 0x5c, 0x11,
+	0xaf, 0xbf, 0x19, 0x31, 0x28, 0xe5, 0x9d, 0x61, 0x50, 0x61, 0x2e, 0x31, 0xa5, 0xa2, 0x6b, 0x03,
+	0x46, 0x5b, 0x62, 0x2e, 0xdd, 0xab, 0x40, 0xf2, 0x2f, 0x24, 0x58, 0xe8, 0x52, 0x02, 0xda, 0x48,
+	0x14, 0x62, 0x2d, 0xa0, 0x72, 0x6b, 0x48, 0x60, 0x40, 0xea, 0xf7, 0x12, 0x28, 0x3d, 0x0a, 0x08,
+	0x6f, 0x27, 0xd1, 0x1f, 0x01, 0x2b, 0x5b, 0x2f, 0x01, 0x0e, 0x79, 0xad, 0xcb, 0x93, 0x7f, 0x23,
+	0x61, 0x3a, 0xf7, 0x81, 0xca, 0xad, 0x21, 0x81, 0x01, 0xa9, 0xa7, 0x30, 0x1b, 0x79, 0xb5, 0xaa,
+	0x03, 0x86, 0xa9, 0x0f, 0x50, 0x36, 0x12, 0x02, 0xda, 0xe7, 0x8e, 0xbc, 0xe7, 0xd4, 0x81, 0xaf,
+	0x3c, 0x62, 0x61, 0x36, 0x12, 0x02, 0xda, 0x4f, 0xc0, 0xe8, 0x3b, 0x6c, 0x75, 0x00, 0x5f, 0x86,
+	0x10, 0xca, 0x8d, 0xa4, 0x88, 0x60, 0x7a, 0x02, 0xd3, 0xa1, 0x37, 0xd0, 0xca, 0x00, 0x76, 0xb4,
+	0xc4, 0x95, 0xeb, 0x89, 0xc4, 0xdb, 0x1d, 0x1e, 0x79, 0xc9, 0xa8, 0x03, 0x64, 0xc6, 0x76, 0x80,
+	0xb2, 0x91, 0x10, 0xd0, 0x3e, 0x77, 0xe4, 0xa1, 0xa2, 0x0e, 0x18, 0xbb, 0x3e, 0x40, 0xd9, 0x48,
+	0x08, 0x08, 0x07, 0x5a, 0xe8, 0x6d, 0xa0, 0x0e, 0xe8, 0xc0, 0x04, 0x73, 0xc7, 0x3f, 0x07, 0x94,
+	0x89, 0x1f, 0x7d, 0xfd, 0xec, 0x92, 0xb4, 0x79, 0xff, 0xb3, 0xe7, 0x05, 0xe9, 0xf3, 0xe7, 0x05,
+	0xe9, 0x3f, 0xcf, 0x0b, 0xd2, 0xc7, 0x2f, 0x0a, 0x27, 0x3e, 0x7f, 0x51, 0x38, 0xf1, 0xcf, 0x17,
+	0x85, 0x13, 0xef, 0xaf, 0xb5, 0xfd, 0x0e, 0xb7, 0xcb, 0x1f, 0x44, 0x1d, 0x5d, 0x55, 0x9f, 0xf8,
+	0x7f, 0x2e, 0x46, 0x7f, 0xa7, 0x5b, 0x4d, 0xb3, 0x92, 0xec, 0xd5, 0xff, 0x0d, 0x00, 0xc9, 0x7e,
+	0xc7, 0xa2, 0x5b, 0x26, 0x00, 0x00,
 }
-func (m *MsgRegisterAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Policy.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// RegisterAgent registers a new agent with an immutable attestation policy.
+	RegisterAgent(ctx context.Context, in *MsgRegisterAgent, opts ...grpc.CallOption) (*MsgRegisterAgentResponse, error)
+	// DeactivateAgent deactivates an existing agent. Owner only.
+	DeactivateAgent(ctx context.Context, in *MsgDeactivateAgent, opts ...grpc.CallOption) (*MsgDeactivateAgentResponse, error)
+	// UpdateAgentPolicy schedules a timelocked rotation of the agent's
+	// attestation policy. Owner only. The new policy activates after
+	// policy_rotation_delay_blocks.
+	UpdateAgentPolicy(ctx context.Context, in *MsgUpdateAgentPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentPolicyResponse, error)
+	// UpdateAgentPolicySet schedules a timelocked replacement of the agent's
+	// co-policies and attestation quorum. Owner only. The new set activates
+	// after policy_rotation_delay_blocks.
+	UpdateAgentPolicySet(ctx context.Context, in *MsgUpdateAgentPolicySet, opts ...grpc.CallOption) (*MsgUpdateAgentPolicySetResponse, error)
+	// SubmitAttestedAction verifies a TEE attestation token against the agent's
+	// policy, bound by a per-action nonce, and appends an entry to the agent's
+	// action log.
+	SubmitAttestedAction(ctx context.Context, in *MsgSubmitAttestedAction, opts ...grpc.CallOption) (*MsgSubmitAttestedActionResponse, error)
+	// FundAgentEscrow deposits funds into an agent's escrow. Anyone may fund.
+	FundAgentEscrow(ctx context.Context, in *MsgFundAgentEscrow, opts ...grpc.CallOption) (*MsgFundAgentEscrowResponse, error)
+	// WithdrawAgentEscrow withdraws funds from an agent's escrow. Owner only.
+	// With a withdrawal delay set, the withdrawal is queued and paid out in the
+	// end block of its mature height.
+	WithdrawAgentEscrow(ctx context.Context, in *MsgWithdrawAgentEscrow, opts ...grpc.CallOption) (*MsgWithdrawAgentEscrowResponse, error)
+	// CancelAgentWithdrawal cancels a pending withdrawal and returns its amount
+	// to the escrow. Owner only.
+	CancelAgentWithdrawal(ctx context.Context, in *MsgCancelAgentWithdrawal, opts ...grpc.CallOption) (*MsgCancelAgentWithdrawalResponse, error)
+	// UpdateAgentSecurityPolicy sets the agent's guardian and withdrawal delay.
+	// Owner only. Updates that add a guardian or lengthen the delay apply
+	// immediately; any other update waits out the current withdrawal delay.
+	UpdateAgentSecurityPolicy(ctx context.Context, in *MsgUpdateAgentSecurityPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentSecurityPolicyResponse, error)
+	// FreezeAgent blocks the agent's attested actions, attested transfers and
+	// withdrawals, and cancels its pending withdrawals. Guardian or governance
+	// only.
+	FreezeAgent(ctx context.Context, in *MsgFreezeAgent, opts ...grpc.CallOption) (*MsgFreezeAgentResponse, error)
+	// UnfreezeAgent lifts a freeze. Guardian or governance only.
+	UnfreezeAgent(ctx context.Context, in *MsgUnfreezeAgent, opts ...grpc.CallOption) (*MsgUnfreezeAgentResponse, error)
+	// CreatePaymentStream creates a recurring payout from the agent's escrow,
+	// paid by the end blocker and bounded by the spend budget.
+	CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error)
+	// CancelPaymentStream cancels a payment stream. The owner, the agent's
+	// guardian or governance may cancel.
+	CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error)
+	// UpdateAgentSpendPolicy sets the agent's spend policy (denom, per-window
+	// limit, window length, plus per-denom budgets for other denoms). Owner
+	// only, effective immediately.
+	UpdateAgentSpendPolicy(ctx context.Context, in *MsgUpdateAgentSpendPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentSpendPolicyResponse, error)
+	// UpdateAgentRecipientPolicy sets the agent's recipient policy (allowlist,
+	// per-recipient cap, Dym-Name-resolved-only mode). Owner only, effective
+	// immediately.
+	UpdateAgentRecipientPolicy(ctx context.Context, in *MsgUpdateAgentRecipientPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentRecipientPolicyResponse, error)
+	// SubmitAttestedTransfer verifies a TEE attestation token bound to a
+	// specific (recipient, denom, amount, memo), checks the per-window spend
+	// budget and the recipient policy, pays out from the agent's escrow, and
+	// appends an entry to the agent's action log.
+	SubmitAttestedTransfer(ctx context.Context, in *MsgSubmitAttestedTransfer, opts ...grpc.CallOption) (*MsgSubmitAttestedTransferResponse, error)
+	// CreateSubAgent creates a sub-agent of an active agent, authorized by the
+	// parent's attestation over the sub-agent spec. The budget is carved out of
+	// the parent's escrow and the creation is logged in the parent's action
+	// log.
+	CreateSubAgent(ctx context.Context, in *MsgCreateSubAgent, opts ...grpc.CallOption) (*MsgCreateSubAgentResponse, error)
+	// RegisterPolicy adds a policy to the shared registry as version 1 of a new
+	// lineage administered by the signer.
+	RegisterPolicy(ctx context.Context, in *MsgRegisterPolicy, opts ...grpc.CallOption) (*MsgRegisterPolicyResponse, error)
+	// SupersedePolicy registers the next version of a policy lineage. Signed by
+	// the lineage admin or governance.
+	SupersedePolicy(ctx context.Context, in *MsgSupersedePolicy, opts ...grpc.CallOption) (*MsgSupersedePolicyResponse, error)
+	// RevokePolicy adds a policy fingerprint to the revocation denylist.
+	// Governance only.
+	RevokePolicy(ctx context.Context, in *MsgRevokePolicy, opts ...grpc.CallOption) (*MsgRevokePolicyResponse, error)
+	// UnrevokePolicy removes a policy fingerprint from the revocation denylist.
+	// Governance only.
+	UnrevokePolicy(ctx context.Context, in *MsgUnrevokePolicy, opts ...grpc.CallOption) (*MsgUnrevokePolicyResponse, error)
+	// SubmitFeedback submits or overwrites the client's feedback score for an
+	// agent, grounded in an existing action-log entry.
+	SubmitFeedback(ctx context.Context, in *MsgSubmitFeedback, opts ...grpc.CallOption) (*MsgSubmitFeedbackResponse, error)
+	// RevokeFeedback removes the client's feedback for an agent.
+	RevokeFeedback(ctx context.Context, in *MsgRevokeFeedback, opts ...grpc.CallOption) (*MsgRevokeFeedbackResponse, error)
 }
 
-func (m *MsgRegisterAgentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgDeactivateAgent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgDeactivateAgentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RegisterAgent(ctx context.Context, in *MsgRegisterAgent, opts ...grpc.CallOption) (*MsgRegisterAgentResponse, error) {
+	out := new(MsgRegisterAgentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/RegisterAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateAgentPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) DeactivateAgent(ctx context.Context, in *MsgDeactivateAgent, opts ...grpc.CallOption) (*MsgDeactivateAgentResponse, error) {
+	out := new(MsgDeactivateAgentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/DeactivateAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.NewPolicy.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateAgentPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateAgentPolicy(ctx context.Context, in *MsgUpdateAgentPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentPolicyResponse, error) {
+	out := new(MsgUpdateAgentPolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UpdateAgentPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.ActivationHeight != 0 {
-		n += 1 + sovTx(uint64(m.ActivationHeight))
+	return out, nil
+}
+
+func (c *msgClient) UpdateAgentPolicySet(ctx context.Context, in *MsgUpdateAgentPolicySet, opts ...grpc.CallOption) (*MsgUpdateAgentPolicySetResponse, error) {
+	out := new(MsgUpdateAgentPolicySetResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UpdateAgentPolicySet", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitAttestedAction) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SubmitAttestedAction(ctx context.Context, in *MsgSubmitAttestedAction, opts ...grpc.CallOption) (*MsgSubmitAttestedActionResponse, error) {
+	out := new(MsgSubmitAttestedActionResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/SubmitAttestedAction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) FundAgentEscrow(ctx context.Context, in *MsgFundAgentEscrow, opts ...grpc.CallOption) (*MsgFundAgentEscrowResponse, error) {
+	out := new(MsgFundAgentEscrowResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/FundAgentEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawAgentEscrow(ctx context.Context, in *MsgWithdrawAgentEscrow, opts ...grpc.CallOption) (*MsgWithdrawAgentEscrowResponse, error) {
+	out := new(MsgWithdrawAgentEscrowResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/WithdrawAgentEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Payload)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelAgentWithdrawal(ctx context.Context, in *MsgCancelAgentWithdrawal, opts ...grpc.CallOption) (*MsgCancelAgentWithdrawalResponse, error) {
+	out := new(MsgCancelAgentWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/CancelAgentWithdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateAgentSecurityPolicy(ctx context.Context, in *MsgUpdateAgentSecurityPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentSecurityPolicyResponse, error) {
+	out := new(MsgUpdateAgentSecurityPolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UpdateAgentSecurityPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitAttestedActionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) FreezeAgent(ctx context.Context, in *MsgFreezeAgent, opts ...grpc.CallOption) (*MsgFreezeAgentResponse, error) {
+	out := new(MsgFreezeAgentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/FreezeAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovTx(uint64(m.Seq))
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeAgent(ctx context.Context, in *MsgUnfreezeAgent, opts ...grpc.CallOption) (*MsgUnfreezeAgentResponse, error) {
+	out := new(MsgUnfreezeAgentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UnfreezeAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgFundAgentEscrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Funder)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
-}
-
-func (m *MsgFundAgentEscrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgWithdrawAgentEscrow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (c *msgClient) CreatePaymentStream(ctx context.Context, in *MsgCreatePaymentStream, opts ...grpc.CallOption) (*MsgCreatePaymentStreamResponse, error) {
+	out := new(MsgCreatePaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/CreatePaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawAgentEscrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelPaymentStream(ctx context.Context, in *MsgCancelPaymentStream, opts ...grpc.CallOption) (*MsgCancelPaymentStreamResponse, error) {
+	out := new(MsgCancelPaymentStreamResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/CancelPaymentStream", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateAgentSpendPolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.SpendDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.SpendLimitPerWindow.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.SpendWindowBlocks != 0 {
-		n += 1 + sovTx(uint64(m.SpendWindowBlocks))
+func (c *msgClient) UpdateAgentSpendPolicy(ctx context.Context, in *MsgUpdateAgentSpendPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentSpendPolicyResponse, error) {
+	out := new(MsgUpdateAgentSpendPolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UpdateAgentSpendPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateAgentSpendPolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateAgentRecipientPolicy(ctx context.Context, in *MsgUpdateAgentRecipientPolicy, opts ...grpc.CallOption) (*MsgUpdateAgentRecipientPolicyResponse, error) {
+	out := new(MsgUpdateAgentRecipientPolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UpdateAgentRecipientPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitAttestedTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Submitter)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Memo)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) SubmitAttestedTransfer(ctx context.Context, in *MsgSubmitAttestedTransfer, opts ...grpc.CallOption) (*MsgSubmitAttestedTransferResponse, error) {
+	out := new(MsgSubmitAttestedTransferResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/SubmitAttestedTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitAttestedTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Seq != 0 {
-		n += 1 + sovTx(uint64(m.Seq))
+func (c *msgClient) CreateSubAgent(ctx context.Context, in *MsgCreateSubAgent, opts ...grpc.CallOption) (*MsgCreateSubAgentResponse, error) {
+	out := new(MsgCreateSubAgentResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/CreateSubAgent", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRevokePolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) RegisterPolicy(ctx context.Context, in *MsgRegisterPolicy, opts ...grpc.CallOption) (*MsgRegisterPolicyResponse, error) {
+	out := new(MsgRegisterPolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/RegisterPolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRevokePolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) SupersedePolicy(ctx context.Context, in *MsgSupersedePolicy, opts ...grpc.CallOption) (*MsgSupersedePolicyResponse, error) {
+	out := new(MsgSupersedePolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/SupersedePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUnrevokePolicy) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) RevokePolicy(ctx context.Context, in *MsgRevokePolicy, opts ...grpc.CallOption) (*MsgRevokePolicyResponse, error) {
+	out := new(MsgRevokePolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/RevokePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUnrevokePolicyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UnrevokePolicy(ctx context.Context, in *MsgUnrevokePolicy, opts ...grpc.CallOption) (*MsgUnrevokePolicyResponse, error) {
+	out := new(MsgUnrevokePolicyResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/UnrevokePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitFeedback) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.Score != 0 {
-		n += 1 + sovTx(uint64(m.Score))
-	}
-	l = len(m.Tag1)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tag2)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.EvidenceSeq != 0 {
-		n += 1 + sovTx(uint64(m.EvidenceSeq))
+func (c *msgClient) SubmitFeedback(ctx context.Context, in *MsgSubmitFeedback, opts ...grpc.CallOption) (*MsgSubmitFeedbackResponse, error) {
+	out := new(MsgSubmitFeedbackResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/SubmitFeedback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgSubmitFeedbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RevokeFeedback(ctx context.Context, in *MsgRevokeFeedback, opts ...grpc.CallOption) (*MsgRevokeFeedbackResponse, error) {
+	out := new(MsgRevokeFeedbackResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.agent.Msg/RevokeFeedback", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// RegisterAgent registers a new agent with an immutable attestation policy.
+	RegisterAgent(context.Context, *MsgRegisterAgent) (*MsgRegisterAgentResponse, error)
+	// DeactivateAgent deactivates an existing agent. Owner only.
+	DeactivateAgent(context.Context, *MsgDeactivateAgent) (*MsgDeactivateAgentResponse, error)
+	// UpdateAgentPolicy schedules a timelocked rotation of the agent's
+	// attestation policy. Owner only. The new policy activates after
+	// policy_rotation_delay_blocks.
+	UpdateAgentPolicy(context.Context, *MsgUpdateAgentPolicy) (*MsgUpdateAgentPolicyResponse, error)
+	// UpdateAgentPolicySet schedules a timelocked replacement of the agent's
+	// co-policies and attestation quorum. Owner only. The new set activates
+	// after policy_rotation_delay_blocks.
+	UpdateAgentPolicySet(context.Context, *MsgUpdateAgentPolicySet) (*MsgUpdateAgentPolicySetResponse, error)
+	// SubmitAttestedAction verifies a TEE attestation token against the agent's
+	// policy, bound by a per-action nonce, and appends an entry to the agent's
+	// action log.
+	SubmitAttestedAction(context.Context, *MsgSubmitAttestedAction) (*MsgSubmitAttestedActionResponse, error)
+	// FundAgentEscrow deposits funds into an agent's escrow. Anyone may fund.
+	FundAgentEscrow(context.Context, *MsgFundAgentEscrow) (*MsgFundAgentEscrowResponse, error)
+	// WithdrawAgentEscrow withdraws funds from an agent's escrow. Owner only.
+	// With a withdrawal delay set, the withdrawal is queued and paid out in the
+	// end block of its mature height.
+	WithdrawAgentEscrow(context.Context, *MsgWithdrawAgentEscrow) (*MsgWithdrawAgentEscrowResponse, error)
+	// CancelAgentWithdrawal cancels a pending withdrawal and returns its amount
+	// to the escrow. Owner only.
+	CancelAgentWithdrawal(context.Context, *MsgCancelAgentWithdrawal) (*MsgCancelAgentWithdrawalResponse, error)
+	// UpdateAgentSecurityPolicy sets the agent's guardian and withdrawal delay.
+	// Owner only. Updates that add a guardian or lengthen the delay apply
+	// immediately; any other update waits out the current withdrawal delay.
+	UpdateAgentSecurityPolicy(context.Context, *MsgUpdateAgentSecurityPolicy) (*MsgUpdateAgentSecurityPolicyResponse, error)
+	// FreezeAgent blocks the agent's attested actions, attested transfers and
+	// withdrawals, and cancels its pending withdrawals. Guardian or governance
+	// only.
+	FreezeAgent(context.Context, *MsgFreezeAgent) (*MsgFreezeAgentResponse, error)
+	// UnfreezeAgent lifts a freeze. Guardian or governance only.
+	UnfreezeAgent(context.Context, *MsgUnfreezeAgent) (*MsgUnfreezeAgentResponse, error)
+	// CreatePaymentStream creates a recurring payout from the agent's escrow,
+	// paid by the end blocker and bounded by the spend budget.
+	CreatePaymentStream(context.Context, *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error)
+	// CancelPaymentStream cancels a payment stream. The owner, the agent's
+	// guardian or governance may cancel.
+	CancelPaymentStream(context.Context, *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error)
+	// UpdateAgentSpendPolicy sets the agent's spend policy (denom, per-window
+	// limit, window length, plus per-denom budgets for other denoms). Owner
+	// only, effective immediately.
+	UpdateAgentSpendPolicy(context.Context, *MsgUpdateAgentSpendPolicy) (*MsgUpdateAgentSpendPolicyResponse, error)
+	// UpdateAgentRecipientPolicy sets the agent's recipient policy (allowlist,
+	// per-recipient cap, Dym-Name-resolved-only mode). Owner only, effective
+	// immediately.
+	UpdateAgentRecipientPolicy(context.Context, *MsgUpdateAgentRecipientPolicy) (*MsgUpdateAgentRecipientPolicyResponse, error)
+	// SubmitAttestedTransfer verifies a TEE attestation token bound to a
+	// specific (recipient, denom, amount, memo), checks the per-window spend
+	// budget and the recipient policy, pays out from the agent's escrow, and
+	// appends an entry to the agent's action log.
+	SubmitAttestedTransfer(context.Context, *MsgSubmitAttestedTransfer) (*MsgSubmitAttestedTransferResponse, error)
+	// CreateSubAgent creates a sub-agent of an active agent, authorized by the
+	// parent's attestation over the sub-agent spec. The budget is carved out of
+	// the parent's escrow and the creation is logged in the parent's action
+	// log.
+	CreateSubAgent(context.Context, *MsgCreateSubAgent) (*MsgCreateSubAgentResponse, error)
+	// RegisterPolicy adds a policy to the shared registry as version 1 of a new
+	// lineage administered by the signer.
+	RegisterPolicy(context.Context, *MsgRegisterPolicy) (*MsgRegisterPolicyResponse, error)
+	// SupersedePolicy registers the next version of a policy lineage. Signed by
+	// the lineage admin or governance.
+	SupersedePolicy(context.Context, *MsgSupersedePolicy) (*MsgSupersedePolicyResponse, error)
+	// RevokePolicy adds a policy fingerprint to the revocation denylist.
+	// Governance only.
+	RevokePolicy(context.Context, *MsgRevokePolicy) (*MsgRevokePolicyResponse, error)
+	// UnrevokePolicy removes a policy fingerprint from the revocation denylist.
+	// Governance only.
+	UnrevokePolicy(context.Context, *MsgUnrevokePolicy) (*MsgUnrevokePolicyResponse, error)
+	// SubmitFeedback submits or overwrites the client's feedback score for an
+	// agent, grounded in an existing action-log entry.
+	SubmitFeedback(context.Context, *MsgSubmitFeedback) (*MsgSubmitFeedbackResponse, error)
+	// RevokeFeedback removes the client's feedback for an agent.
+	RevokeFeedback(context.Context, *MsgRevokeFeedback) (*MsgRevokeFeedbackResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) RegisterAgent(ctx context.Context, req *MsgRegisterAgent) (*MsgRegisterAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAgent not implemented")
+}
+func (*UnimplementedMsgServer) DeactivateAgent(ctx context.Context, req *MsgDeactivateAgent) (*MsgDeactivateAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateAgent not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAgentPolicy(ctx context.Context, req *MsgUpdateAgentPolicy) (*MsgUpdateAgentPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentPolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAgentPolicySet(ctx context.Context, req *MsgUpdateAgentPolicySet) (*MsgUpdateAgentPolicySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentPolicySet not implemented")
+}
+func (*UnimplementedMsgServer) SubmitAttestedAction(ctx context.Context, req *MsgSubmitAttestedAction) (*MsgSubmitAttestedActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestedAction not implemented")
+}
+func (*UnimplementedMsgServer) FundAgentEscrow(ctx context.Context, req *MsgFundAgentEscrow) (*MsgFundAgentEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundAgentEscrow not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawAgentEscrow(ctx context.Context, req *MsgWithdrawAgentEscrow) (*MsgWithdrawAgentEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawAgentEscrow not implemented")
+}
+func (*UnimplementedMsgServer) CancelAgentWithdrawal(ctx context.Context, req *MsgCancelAgentWithdrawal) (*MsgCancelAgentWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAgentWithdrawal not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAgentSecurityPolicy(ctx context.Context, req *MsgUpdateAgentSecurityPolicy) (*MsgUpdateAgentSecurityPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentSecurityPolicy not implemented")
+}
+func (*UnimplementedMsgServer) FreezeAgent(ctx context.Context, req *MsgFreezeAgent) (*MsgFreezeAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeAgent not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeAgent(ctx context.Context, req *MsgUnfreezeAgent) (*MsgUnfreezeAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeAgent not implemented")
+}
+func (*UnimplementedMsgServer) CreatePaymentStream(ctx context.Context, req *MsgCreatePaymentStream) (*MsgCreatePaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePaymentStream not implemented")
+}
+func (*UnimplementedMsgServer) CancelPaymentStream(ctx context.Context, req *MsgCancelPaymentStream) (*MsgCancelPaymentStreamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelPaymentStream not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAgentSpendPolicy(ctx context.Context, req *MsgUpdateAgentSpendPolicy) (*MsgUpdateAgentSpendPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentSpendPolicy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAgentRecipientPolicy(ctx context.Context, req *MsgUpdateAgentRecipientPolicy) (*MsgUpdateAgentRecipientPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAgentRecipientPolicy not implemented")
+}
+func (*UnimplementedMsgServer) SubmitAttestedTransfer(ctx context.Context, req *MsgSubmitAttestedTransfer) (*MsgSubmitAttestedTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitAttestedTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CreateSubAgent(ctx context.Context, req *MsgCreateSubAgent) (*MsgCreateSubAgentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubAgent not implemented")
+}
+func (*UnimplementedMsgServer) RegisterPolicy(ctx context.Context, req *MsgRegisterPolicy) (*MsgRegisterPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterPolicy not implemented")
+}
+func (*UnimplementedMsgServer) SupersedePolicy(ctx context.Context, req *MsgSupersedePolicy) (*MsgSupersedePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupersedePolicy not implemented")
+}
+func (*UnimplementedMsgServer) RevokePolicy(ctx context.Context, req *MsgRevokePolicy) (*MsgRevokePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePolicy not implemented")
+}
+func (*UnimplementedMsgServer) UnrevokePolicy(ctx context.Context, req *MsgUnrevokePolicy) (*MsgUnrevokePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnrevokePolicy not implemented")
+}
+func (*UnimplementedMsgServer) SubmitFeedback(ctx context.Context, req *MsgSubmitFeedback) (*MsgSubmitFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFeedback not implemented")
+}
+func (*UnimplementedMsgServer) RevokeFeedback(ctx context.Context, req *MsgRevokeFeedback) (*MsgRevokeFeedbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeFeedback not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_RegisterAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/RegisterAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAgent(ctx, req.(*MsgRegisterAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeactivateAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeactivateAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeactivateAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/DeactivateAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeactivateAgent(ctx, req.(*MsgDeactivateAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAgentPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAgentPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAgentPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/UpdateAgentPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAgentPolicy(ctx, req.(*MsgUpdateAgentPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAgentPolicySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAgentPolicySet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAgentPolicySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/UpdateAgentPolicySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAgentPolicySet(ctx, req.(*MsgUpdateAgentPolicySet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitAttestedAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAttestedAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAttestedAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/SubmitAttestedAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAttestedAction(ctx, req.(*MsgSubmitAttestedAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundAgentEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundAgentEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundAgentEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/FundAgentEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundAgentEscrow(ctx, req.(*MsgFundAgentEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawAgentEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawAgentEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawAgentEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/WithdrawAgentEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawAgentEscrow(ctx, req.(*MsgWithdrawAgentEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAgentWithdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAgentWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAgentWithdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/CancelAgentWithdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAgentWithdrawal(ctx, req.(*MsgCancelAgentWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAgentSecurityPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAgentSecurityPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAgentSecurityPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/UpdateAgentSecurityPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAgentSecurityPolicy(ctx, req.(*MsgUpdateAgentSecurityPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/FreezeAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeAgent(ctx, req.(*MsgFreezeAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/UnfreezeAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeAgent(ctx, req.(*MsgUnfreezeAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/CreatePaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePaymentStream(ctx, req.(*MsgCreatePaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelPaymentStream_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelPaymentStream)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelPaymentStream(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/CancelPaymentStream",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelPaymentStream(ctx, req.(*MsgCancelPaymentStream))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAgentSpendPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAgentSpendPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAgentSpendPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/UpdateAgentSpendPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAgentSpendPolicy(ctx, req.(*MsgUpdateAgentSpendPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAgentRecipientPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAgentRecipientPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAgentRecipientPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/UpdateAgentRecipientPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAgentRecipientPolicy(ctx, req.(*MsgUpdateAgentRecipientPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitAttestedTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitAttestedTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitAttestedTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/SubmitAttestedTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitAttestedTransfer(ctx, req.(*MsgSubmitAttestedTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateSubAgent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateSubAgent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateSubAgent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/CreateSubAgent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateSubAgent(ctx, req.(*MsgCreateSubAgent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/RegisterPolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterPolicy(ctx, req.(*MsgRegisterPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SupersedePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupersedePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SupersedePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/SupersedePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupersedePolicy(ctx, req.(*MsgSupersedePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/RevokePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokePolicy(ctx, req.(*MsgRevokePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnrevokePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnrevokePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnrevokePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/UnrevokePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnrevokePolicy(ctx, req.(*MsgUnrevokePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/SubmitFeedback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFeedback(ctx, req.(*MsgSubmitFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeFeedback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeFeedback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.agent.Msg/RevokeFeedback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeFeedback(ctx, req.(*MsgRevokeFeedback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.agent.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterAgent",
+			Handler:    _Msg_RegisterAgent_Handler,
+		},
+		{
+			MethodName: "DeactivateAgent",
+			Handler:    _Msg_DeactivateAgent_Handler,
+		},
+		{
+			MethodName: "UpdateAgentPolicy",
+			Handler:    _Msg_UpdateAgentPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateAgentPolicySet",
+			Handler:    _Msg_UpdateAgentPolicySet_Handler,
+		},
+		{
+			MethodName: "SubmitAttestedAction",
+			Handler:    _Msg_SubmitAttestedAction_Handler,
+		},
+		{
+			MethodName: "FundAgentEscrow",
+			Handler:    _Msg_FundAgentEscrow_Handler,
+		},
+		{
+			MethodName: "WithdrawAgentEscrow",
+			Handler:    _Msg_WithdrawAgentEscrow_Handler,
+		},
+		{
+			MethodName: "CancelAgentWithdrawal",
+			Handler:    _Msg_CancelAgentWithdrawal_Handler,
+		},
+		{
+			MethodName: "UpdateAgentSecurityPolicy",
+			Handler:    _Msg_UpdateAgentSecurityPolicy_Handler,
+		},
+		{
+			MethodName: "FreezeAgent",
+			Handler:    _Msg_FreezeAgent_Handler,
+		},
+		{
+			MethodName: "UnfreezeAgent",
+			Handler:    _Msg_UnfreezeAgent_Handler,
+		},
+		{
+			MethodName: "CreatePaymentStream",
+			Handler:    _Msg_CreatePaymentStream_Handler,
+		},
+		{
+			MethodName: "CancelPaymentStream",
+			Handler:    _Msg_CancelPaymentStream_Handler,
+		},
+		{
+			MethodName: "UpdateAgentSpendPolicy",
+			Handler:    _Msg_UpdateAgentSpendPolicy_Handler,
+		},
+		{
+			MethodName: "UpdateAgentRecipientPolicy",
+			Handler:    _Msg_UpdateAgentRecipientPolicy_Handler,
+		},
+		{
+			MethodName: "SubmitAttestedTransfer",
+			Handler:    _Msg_SubmitAttestedTransfer_Handler,
+		},
+		{
+			MethodName: "CreateSubAgent",
+			Handler:    _Msg_CreateSubAgent_Handler,
+		},
+		{
+			MethodName: "RegisterPolicy",
+			Handler:    _Msg_RegisterPolicy_Handler,
+		},
+		{
+			MethodName: "SupersedePolicy",
+			Handler:    _Msg_SupersedePolicy_Handler,
+		},
+		{
+			MethodName: "RevokePolicy",
+			Handler:    _Msg_RevokePolicy_Handler,
+		},
+		{
+			MethodName: "UnrevokePolicy",
+			Handler:    _Msg_UnrevokePolicy_Handler,
+		},
+		{
+			MethodName: "SubmitFeedback",
+			Handler:    _Msg_SubmitFeedback_Handler,
+		},
+		{
+			MethodName: "RevokeFeedback",
+			Handler:    _Msg_RevokeFeedback_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/agent/tx.proto",
+}
+
+func (m *MsgRegisterAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.PolicySet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeactivateAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeactivateAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeactivateAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewPolicyId) > 0 {
+		i -= len(m.NewPolicyId)
+		copy(dAtA[i:], m.NewPolicyId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPolicyId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.NewPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentPolicySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentPolicySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentPolicySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PolicySet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentPolicySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentPolicySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentPolicySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CoAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CoAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CoAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PolicyIndex != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PolicyIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestedAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestedAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestedAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoAttestations) > 0 {
+		for iNdEx := len(m.CoAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestedActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestedActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestedActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundAgentEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Funder) > 0 {
+		i -= len(m.Funder)
+		copy(dAtA[i:], m.Funder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Funder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundAgentEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundAgentEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundAgentEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAgentEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAgentEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAgentEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawAgentEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawAgentEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawAgentEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pending != nil {
+		{
+			size, err := m.Pending.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAgentWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAgentWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAgentWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WithdrawalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.WithdrawalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelAgentWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelAgentWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelAgentWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentSecurityPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentSecurityPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentSecurityPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentSecurityPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentSecurityPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentSecurityPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x3a
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x32
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.AmountPerPeriod.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPaymentStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelPaymentStreamResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelPaymentStreamResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelPaymentStreamResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentSpendPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentSpendPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentSpendPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SpendWindowDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindowDuration):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x42
+	if m.SpendWindowMode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpendWindowMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.SpendBudgets) > 0 {
+		for iNdEx := len(m.SpendBudgets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendBudgets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.SpendWindowBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SpendWindowBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SpendLimitPerWindow.Size()
+		i -= size
+		if _, err := m.SpendLimitPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.SpendDenom) > 0 {
+		i -= len(m.SpendDenom)
+		copy(dAtA[i:], m.SpendDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SpendDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentSpendPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentSpendPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentSpendPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentRecipientPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentRecipientPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentRecipientPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAgentRecipientPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAgentRecipientPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAgentRecipientPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestedTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestedTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestedTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoAttestations) > 0 {
+		for iNdEx := len(m.CoAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Memo) > 0 {
+		i -= len(m.Memo)
+		copy(dAtA[i:], m.Memo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Memo)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitAttestedTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitAttestedTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitAttestedTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubAgent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubAgent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubAgent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CoAttestations) > 0 {
+		for iNdEx := len(m.CoAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CoAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ParentId) > 0 {
+		i -= len(m.ParentId)
+		copy(dAtA[i:], m.ParentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ParentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateSubAgentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateSubAgentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateSubAgentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Policy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterPolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterPolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterPolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupersedePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupersedePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupersedePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewPolicy.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupersedePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupersedePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupersedePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnrevokePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnrevokePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnrevokePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnrevokePolicyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnrevokePolicyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnrevokePolicyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFeedback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFeedback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFeedback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Verified {
+		i--
+		if m.Verified {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EvidenceSeq != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EvidenceSeq))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Tag2) > 0 {
+		i -= len(m.Tag2)
+		copy(dAtA[i:], m.Tag2)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag2)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Tag1) > 0 {
+		i -= len(m.Tag1)
+		copy(dAtA[i:], m.Tag1)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tag1)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Score != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitFeedbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFeedbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFeedbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeFeedback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeFeedback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeFeedback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AgentId) > 0 {
+		i -= len(m.AgentId)
+		copy(dAtA[i:], m.AgentId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AgentId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Client) > 0 {
+		i -= len(m.Client)
+		copy(dAtA[i:], m.Client)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Client)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeFeedbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeFeedbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeFeedbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRegisterAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PolicySet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeactivateAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeactivateAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAgentPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.NewPolicyId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAgentPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgUpdateAgentPolicySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.PolicySet.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAgentPolicySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *CoAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PolicyIndex != 0 {
+		n += 1 + sovTx(uint64(m.PolicyIndex))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitAttestedAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoAttestations) > 0 {
+		for _, e := range m.CoAttestations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitAttestedActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovTx(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *MsgFundAgentEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Funder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundAgentEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawAgentEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawAgentEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pending != nil {
+		l = m.Pending.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelAgentWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WithdrawalId != 0 {
+		n += 1 + sovTx(uint64(m.WithdrawalId))
+	}
+	return n
+}
+
+func (m *MsgCancelAgentWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAgentSecurityPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAgentSecurityPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgFreezeAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreatePaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AmountPerPeriod.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MsgCancelPaymentStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgCancelPaymentStreamResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAgentSpendPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SpendDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.SpendLimitPerWindow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SpendWindowBlocks != 0 {
+		n += 1 + sovTx(uint64(m.SpendWindowBlocks))
+	}
+	if len(m.SpendBudgets) > 0 {
+		for _, e := range m.SpendBudgets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SpendWindowMode != 0 {
+		n += 1 + sovTx(uint64(m.SpendWindowMode))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SpendWindowDuration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAgentSpendPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateAgentRecipientPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateAgentRecipientPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitAttestedTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Memo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoAttestations) > 0 {
+		for _, e := range m.CoAttestations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitAttestedTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovTx(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *MsgCreateSubAgent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ParentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Spec.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CoAttestations) > 0 {
+		for _, e := range m.CoAttestations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateSubAgentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovTx(uint64(m.Seq))
+	}
+	return n
+}
+
+func (m *MsgRegisterPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Policy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterPolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSupersedePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewPolicy.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSupersedePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgRevokePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnrevokePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnrevokePolicyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFeedback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovTx(uint64(m.Score))
+	}
+	l = len(m.Tag1)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tag2)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EvidenceSeq != 0 {
+		n += 1 + sovTx(uint64(m.EvidenceSeq))
+	}
+	if m.Verified {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitFeedbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
 
 func (m *MsgRevokeFeedback) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	l = len(m.Client)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Client)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AgentId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeFeedbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgRegisterAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicySet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolicySet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeactivateAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeactivateAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeactivateAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAgentPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAgentPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAgentPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAgentPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAgentPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAgentPolicySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAgentPolicySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAgentPolicySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicySet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PolicySet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAgentPolicySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAgentPolicySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAgentPolicySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CoAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CoAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CoAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyIndex", wireType)
+			}
+			m.PolicyIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PolicyIndex |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAttestedAction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAttestedAction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAttestedAction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoAttestations = append(m.CoAttestations, CoAttestation{})
+			if err := m.CoAttestations[len(m.CoAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitAttestedActionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitAttestedActionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitAttestedActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundAgentEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundAgentEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundAgentEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundAgentEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundAgentEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAgentEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawAgentEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawAgentEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawAgentEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pending == nil {
+				m.Pending = &PendingWithdrawal{}
+			}
+			if err := m.Pending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAgentWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAgentWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAgentWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawalId", wireType)
+			}
+			m.WithdrawalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WithdrawalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelAgentWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelAgentWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelAgentWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAgentSecurityPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAgentSecurityPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAgentSecurityPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.AgentId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgUpdateAgentSecurityPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAgentSecurityPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAgentSecurityPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeAgent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeAgent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeAgentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeAgentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRevokeFeedbackResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgRegisterAgent) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeAgent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3211,15 +8731,15 @@ func (m *MsgRegisterAgent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAgent: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeAgent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAgent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeAgent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3247,7 +8767,7 @@ func (m *MsgRegisterAgent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3281,39 +8801,6 @@ func (m *MsgRegisterAgent) Unmarshal(dAtA []byte) error {
 			}
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3335,7 +8822,7 @@ func (m *MsgRegisterAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRegisterAgentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnfreezeAgentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3358,10 +8845,10 @@ func (m *MsgRegisterAgentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterAgentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnfreezeAgentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnfreezeAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3385,7 +8872,7 @@ func (m *MsgRegisterAgentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateAgent) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3404,21 +8891,183 @@ func (m *MsgDeactivateAgent) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateAgent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateAgent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePaymentStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AgentId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountPerPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountPerPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3428,29 +9077,30 @@ func (m *MsgDeactivateAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3460,23 +9110,24 @@ func (m *MsgDeactivateAgent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3499,7 +9150,7 @@ func (m *MsgDeactivateAgent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeactivateAgentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3522,12 +9173,50 @@ func (m *MsgDeactivateAgentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeactivateAgentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeactivateAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3549,7 +9238,7 @@ func (m *MsgDeactivateAgentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPaymentStream) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3572,15 +9261,15 @@ func (m *MsgUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAgentPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPaymentStream: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAgentPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPaymentStream: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3608,7 +9297,7 @@ func (m *MsgUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3643,10 +9332,10 @@ func (m *MsgUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPolicy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
 			}
-			var msglen int
+			m.StreamId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3656,25 +9345,11 @@ func (m *MsgUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.StreamId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3696,7 +9371,7 @@ func (m *MsgUpdateAgentPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAgentPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelPaymentStreamResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3719,31 +9394,12 @@ func (m *MsgUpdateAgentPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAgentPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelPaymentStreamResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAgentPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelPaymentStreamResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
-			}
-			m.ActivationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ActivationHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3765,7 +9421,7 @@ func (m *MsgUpdateAgentPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitAttestedAction) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3788,15 +9444,15 @@ func (m *MsgSubmitAttestedAction) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitAttestedAction: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAgentSpendPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitAttestedAction: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAgentSpendPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3824,7 +9480,7 @@ func (m *MsgSubmitAttestedAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3860,9 +9516,9 @@ func (m *MsgSubmitAttestedAction) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendDenom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3872,29 +9528,27 @@ func (m *MsgSubmitAttestedAction) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
-			if m.Payload == nil {
-				m.Payload = []byte{}
-			}
+			m.SpendDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitPerWindow", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3922,7 +9576,114 @@ func (m *MsgSubmitAttestedAction) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			if err := m.SpendLimitPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowBlocks", wireType)
+			}
+			m.SpendWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendBudgets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendBudgets = append(m.SpendBudgets, SpendBudget{})
+			if err := m.SpendBudgets[len(m.SpendBudgets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowMode", wireType)
+			}
+			m.SpendWindowMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SpendWindowMode |= SpendWindowMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SpendWindowDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3945,7 +9706,7 @@ func (m *MsgSubmitAttestedAction) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitAttestedActionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAgentSpendPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3968,31 +9729,12 @@ func (m *MsgSubmitAttestedActionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitAttestedActionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAgentSpendPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitAttestedActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAgentSpendPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
-			}
-			m.Seq = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4014,7 +9756,7 @@ func (m *MsgSubmitAttestedActionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundAgentEscrow) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAgentRecipientPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4037,15 +9779,15 @@ func (m *MsgFundAgentEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundAgentEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAgentRecipientPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAgentRecipientPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Funder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4073,7 +9815,7 @@ func (m *MsgFundAgentEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Funder = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4109,7 +9851,7 @@ func (m *MsgFundAgentEscrow) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4136,8 +9878,7 @@ func (m *MsgFundAgentEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4162,7 +9903,7 @@ func (m *MsgFundAgentEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFundAgentEscrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAgentRecipientPolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4185,10 +9926,10 @@ func (m *MsgFundAgentEscrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFundAgentEscrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAgentRecipientPolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFundAgentEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAgentRecipientPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4212,7 +9953,7 @@ func (m *MsgFundAgentEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4235,15 +9976,15 @@ func (m *MsgWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawAgentEscrow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitAttestedTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawAgentEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitAttestedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4271,7 +10012,7 @@ func (m *MsgWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -4306,9 +10047,173 @@ func (m *MsgWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 			m.AgentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Memo = append(m.Memo[:0], dAtA[iNdEx:postIndex]...)
+			if m.Memo == nil {
+				m.Memo = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Token = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoAttestations", wireType)
+			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
@@ -4334,8 +10239,8 @@ func (m *MsgWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CoAttestations = append(m.CoAttestations, CoAttestation{})
+			if err := m.CoAttestations[len(m.CoAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4360,7 +10265,7 @@ func (m *MsgWithdrawAgentEscrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawAgentEscrowResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitAttestedTransferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4383,12 +10288,31 @@ func (m *MsgWithdrawAgentEscrowResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawAgentEscrowResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitAttestedTransferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawAgentEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitAttestedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4410,7 +10334,7 @@ func (m *MsgWithdrawAgentEscrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSubAgent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4433,15 +10357,15 @@ func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAgentSpendPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSubAgent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAgentSpendPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSubAgent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4469,11 +10393,11 @@ func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4501,13 +10425,13 @@ func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
+			m.ParentId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4517,27 +10441,28 @@ func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SpendDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimitPerWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4565,15 +10490,13 @@ func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpendLimitPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendWindowBlocks", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoAttestations", wireType)
 			}
-			m.SpendWindowBlocks = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4583,11 +10506,26 @@ func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SpendWindowBlocks |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CoAttestations = append(m.CoAttestations, CoAttestation{})
+			if err := m.CoAttestations[len(m.CoAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4609,7 +10547,7 @@ func (m *MsgUpdateAgentSpendPolicy) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAgentSpendPolicyResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateSubAgentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4632,12 +10570,31 @@ func (m *MsgUpdateAgentSpendPolicyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAgentSpendPolicyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateSubAgentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAgentSpendPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateSubAgentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4659,7 +10616,7 @@ func (m *MsgUpdateAgentSpendPolicyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4682,15 +10639,15 @@ func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitAttestedTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitAttestedTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4718,13 +10675,13 @@ func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Submitter = string(dAtA[iNdEx:postIndex])
+			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AgentId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4734,27 +10691,78 @@ func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AgentId = string(dAtA[iNdEx:postIndex])
+			if err := m.Policy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterPolicyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterPolicyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterPolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4782,11 +10790,61 @@ func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupersedePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupersedePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupersedePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4814,15 +10872,13 @@ func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4832,31 +10888,29 @@ func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memo = append(m.Memo[:0], dAtA[iNdEx:postIndex]...)
-			if m.Memo == nil {
-				m.Memo = []byte{}
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPolicy", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4866,23 +10920,24 @@ func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			if err := m.NewPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4905,7 +10960,7 @@ func (m *MsgSubmitAttestedTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitAttestedTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSupersedePolicyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4928,17 +10983,49 @@ func (m *MsgSubmitAttestedTransferResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitAttestedTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSupersedePolicyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitAttestedTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSupersedePolicyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			m.Seq = 0
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4948,7 +11035,7 @@ func (m *MsgSubmitAttestedTransferResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Seq |= uint64(b&0x7F) << shift
+				m.Version |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5529,6 +11616,26 @@ func (m *MsgSubmitFeedback) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verified", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Verified = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,137 @@
+package tee
+
+import (
+	"crypto/x509"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Backend authenticates one kind of TEE attestation. It checks the token is
+// signed under the policy's root certificate and bound to the nonce, and
+// returns the attested claims, which the verifier then evaluates against the
+// policy's rego module. Claims must be JSON-like (maps, slices, strings,
+// numbers, bools) so rego can address them. IssuedAt reads the issue time
+// from the claims, or returns the zero time if the format carries none.
+// Digest identifies a token Claims accepted by the content the TEE signed, so
+// every re-encoding of the token (alternate base64 or CBOR forms, unsigned
+// headers, a malleated signature) shares the digest.
+type Backend interface {
+	Claims(ctx sdk.Context, policy Policy, nonce, token string) (map[string]any, error)
+	IssuedAt(claims map[string]any) (time.Time, error)
+	Digest(token string) ([]byte, error)
+}
+
+// Registry maps each TEE type to the backend verifying it.
+type Registry map[TEEType]Backend
+
+// DefaultRegistry returns the backends the chain supports.
+func DefaultRegistry() Registry {
+	return Registry{
+		TEEType_TEE_TYPE_GCP_CONFIDENTIAL_SPACE: gcpBackend{},
+		TEEType_TEE_TYPE_AWS_NITRO:              nitroBackend{},
+		TEEType_TEE_TYPE_INTEL_DCAP:             dcapBackend{},
+	}
+}
+
+// Register adds a backend for t, refusing to replace an existing one.
+func (r Registry) Register(t TEEType, b Backend) error {
+	if _, ok := r[t]; ok {
+		return gerrc.ErrAlreadyExists.Wrapf("backend for %s", t)
+	}
+	r[t] = b
+	return nil
+}
+
+// Backend returns the backend for t.
+func (r Registry) Backend(t TEEType) (Backend, error) {
+	b, ok := r[t]
+	if !ok {
+		return nil, gerrc.ErrInvalidArgument.Wrapf("unsupported tee type: %s", t)
+	}
+	return b, nil
+}
+
+// Validate checks t names a known backend.
+func (t TEEType) Validate() error {
+	if _, ok := TEEType_name[int32(t)]; !ok {
+		return gerrc.ErrInvalidArgument.Wrapf("unknown tee type: %d", t)
+	}
+	return nil
+}
+
+// gcpBackend verifies GCP Confidential Space JWTs. The nonce is checked by the
+// rego module against the eat_nonce claim.
+type gcpBackend struct{}
+
+func (gcpBackend) Claims(ctx sdk.Context, policy Policy, _, token string) (map[string]any, error) {
+	// make sure the token really came from GCP
+	jwtToken, err := validateAttestationAuthenticity(ctx, policy, token)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "validate PKI token")
+	}
+	claims, err := jwtClaims(jwtToken)
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+// Digest is over the JWT signing input, the header and claims segments the
+// signature covers.
+func (gcpBackend) Digest(token string) ([]byte, error) {
+	i := strings.LastIndexByte(token, '.')
+	if i < 0 {
+		return nil, gerrc.ErrInvalidArgument.Wrap("token is not a jwt")
+	}
+	return SignedDigest([]byte(token[:i])), nil
+}
+
+func (gcpBackend) IssuedAt(claims map[string]any) (time.Time, error) {
+	iat, err := jwt.MapClaims(claims).GetIssuedAt()
+	if err != nil {
+		return time.Time{}, errorsmod.Wrap(err, "iat")
+	}
+	if iat == nil {
+		return time.Time{}, gerrc.ErrInvalidArgument.Wrap("iat empty")
+	}
+	return iat.Time, nil
+}
+
+// verifyCertificatePath verifies leaf up to the policy's root certificate
+// through intermediates. bundledRoot is the root the attestation carries, if
+// any; it must be the stored root. Validity is checked at now (block time),
+// never at wall-clock time, so verification is deterministic.
+func verifyCertificatePath(policy Policy, leaf *x509.Certificate, intermediates []*x509.Certificate, bundledRoot *x509.Certificate, now time.Time) error {
+	storedRoot, err := policy.PemCert()
+	if err != nil {
+		return errorsmod.Wrap(err, "decode and parse root certificate")
+	}
+	if bundledRoot != nil {
+		if err := compareCertificates(*storedRoot, *bundledRoot); err != nil {
+			return errorsmod.Wrap(err, "compare bundled root")
+		}
+	}
+
+	interPool := x509.NewCertPool()
+	for _, c := range intermediates {
+		interPool.AddCert(c)
+	}
+	rootPool := x509.NewCertPool()
+	rootPool.AddCert(storedRoot)
+
+	_, err = leaf.Verify(x509.VerifyOptions{
+		Intermediates: interPool,
+		Roots:         rootPool,
+		CurrentTime:   now,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "verify certificate chain")
+	}
+	return nil
+}
@@ -0,0 +1,300 @@
+package tee_test
+
+import (
+	"context"
+	"crypto/elliptic"
+	_ "embed"
+	"encoding/base64"
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+// The Nitro and DCAP fixtures are recorded by testdata/gen_fixtures.go in the
+// vendors' wire formats under a test PKI valid from 2025 to 2035.
+var (
+	//go:embed testdata/nonce.txt
+	fixtureNonce string
+
+	//go:embed testdata/nitro/root.pem
+	nitroRoot string
+	//go:embed testdata/nitro/attestation.b64
+	nitroAttestation string
+	//go:embed testdata/nitro/policy_values.json
+	nitroPolicyValues string
+	//go:embed testdata/nitro/query.rego
+	nitroPolicyQuery string
+	//go:embed testdata/nitro/policy.rego
+	nitroPolicyStructure string
+
+	//go:embed testdata/dcap/root.pem
+	dcapRoot string
+	//go:embed testdata/dcap/sgx_quote.b64
+	sgxQuote string
+	//go:embed testdata/dcap/sgx_policy_values.json
+	sgxPolicyValues string
+	//go:embed testdata/dcap/sgx_query.rego
+	sgxPolicyQuery string
+	//go:embed testdata/dcap/sgx_policy.rego
+	sgxPolicyStructure string
+	//go:embed testdata/dcap/tdx_quote.b64
+	tdxQuote string
+	//go:embed testdata/dcap/tdx_policy_values.json
+	tdxPolicyValues string
+	//go:embed testdata/dcap/tdx_query.rego
+	tdxPolicyQuery string
+	//go:embed testdata/dcap/tdx_policy.rego
+	tdxPolicyStructure string
+)
+
+func fixtureCtx() sdk.Context {
+	return sdk.Context{}.WithContext(context.Background()).
+		WithBlockTime(time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC))
+}
+
+func nitroPolicy() tee.Policy {
+	return tee.Policy{
+		Type:            tee.TEEType_TEE_TYPE_AWS_NITRO,
+		GcpRootCertPem:  nitroRoot,
+		PolicyValues:    nitroPolicyValues,
+		PolicyQuery:     nitroPolicyQuery,
+		PolicyStructure: nitroPolicyStructure,
+	}
+}
+
+func sgxPolicy() tee.Policy {
+	return tee.Policy{
+		Type:            tee.TEEType_TEE_TYPE_INTEL_DCAP,
+		GcpRootCertPem:  dcapRoot,
+		PolicyValues:    sgxPolicyValues,
+		PolicyQuery:     sgxPolicyQuery,
+		PolicyStructure: sgxPolicyStructure,
+	}
+}
+
+func tdxPolicy() tee.Policy {
+	return tee.Policy{
+		Type:            tee.TEEType_TEE_TYPE_INTEL_DCAP,
+		GcpRootCertPem:  dcapRoot,
+		PolicyValues:    tdxPolicyValues,
+		PolicyQuery:     tdxPolicyQuery,
+		PolicyStructure: tdxPolicyStructure,
+	}
+}
+
+// tamper flips one bit of the decoded token at offset (negative counts from
+// the end) and re-encodes it.
+func tamper(t *testing.T, token string, offset int) string {
+	t.Helper()
+	raw, err := base64.StdEncoding.DecodeString(token)
+	require.NoError(t, err)
+	if offset < 0 {
+		offset += len(raw)
+	}
+	raw[offset] ^= 0x01
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+func TestVerifyNitro(t *testing.T) {
+	ctx := fixtureCtx()
+	v := tee.NewVerifier()
+
+	att, err := v.Verify(ctx, nitroPolicy(), fixtureNonce, nitroAttestation)
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC), att.IssuedAt)
+
+	_, err = v.Verify(ctx, nitroPolicy(), "other-nonce", nitroAttestation)
+	require.ErrorContains(t, err, "attestation nonce mismatch")
+
+	// the signature is the last field of the COSE_Sign1
+	_, err = v.Verify(ctx, nitroPolicy(), fixtureNonce, tamper(t, nitroAttestation, -1))
+	require.ErrorContains(t, err, "cose signature")
+
+	// a chain to another root
+	p := nitroPolicy()
+	p.GcpRootCertPem = dcapRoot
+	_, err = v.Verify(ctx, p, fixtureNonce, nitroAttestation)
+	require.ErrorContains(t, err, "compare bundled root")
+
+	// certificate validity is judged at block time
+	_, err = v.Verify(ctx.WithBlockTime(time.Date(2036, 1, 1, 0, 0, 0, 0, time.UTC)), nitroPolicy(), fixtureNonce, nitroAttestation)
+	require.ErrorContains(t, err, "verify certificate chain")
+
+	// an authentic document whose measurements the policy does not allow
+	p = nitroPolicy()
+	p.PolicyValues = `{"allowed_pcr0": [], "allowed_pcr1": [], "allowed_pcr2": [], "allowed_digest": ["SHA384"]}`
+	_, err = v.Verify(ctx, p, fixtureNonce, nitroAttestation)
+	require.ErrorContains(t, err, "not authorised")
+
+	// a Nitro document is not a GCP token
+	p = nitroPolicy()
+	p.Type = tee.TEEType_TEE_TYPE_GCP_CONFIDENTIAL_SPACE
+	_, err = v.Verify(ctx, p, fixtureNonce, nitroAttestation)
+	require.Error(t, err)
+}
+
+func TestVerifyDCAP(t *testing.T) {
+	ctx := fixtureCtx()
+	v := tee.NewVerifier()
+
+	att, err := v.Verify(ctx, sgxPolicy(), fixtureNonce, sgxQuote)
+	require.NoError(t, err)
+	// quotes carry no issue time
+	require.True(t, att.IssuedAt.IsZero())
+	_, err = v.Verify(ctx, tdxPolicy(), fixtureNonce, tdxQuote)
+	require.NoError(t, err)
+
+	for name, tc := range map[string]struct {
+		policy tee.Policy
+		quote  string
+	}{"sgx": {sgxPolicy(), sgxQuote}, "tdx": {tdxPolicy(), tdxQuote}} {
+		t.Run(name, func(t *testing.T) {
+			_, err := v.Verify(ctx, tc.policy, "other-nonce", tc.quote)
+			require.ErrorContains(t, err, "attestation nonce mismatch")
+
+			// byte 64 is inside the report body, so the quote signature breaks
+			_, err = v.Verify(ctx, tc.policy, fixtureNonce, tamper(t, tc.quote, 64))
+			require.ErrorContains(t, err, "quote signature")
+
+			p := tc.policy
+			p.GcpRootCertPem = nitroRoot
+			_, err = v.Verify(ctx, p, fixtureNonce, tc.quote)
+			require.ErrorContains(t, err, "verify pck chain")
+
+			_, err = v.Verify(ctx, tc.policy, fixtureNonce, tc.quote[:len(tc.quote)/2])
+			require.Error(t, err)
+		})
+	}
+
+	// an authentic SGX quote does not satisfy a TDX policy
+	_, err = v.Verify(ctx, tdxPolicy(), fixtureNonce, sgxQuote)
+	require.ErrorContains(t, err, "not authorised")
+}
+
+// negateS replaces the s half of a raw r ‖ s ECDSA signature by n - s, which
+// verifies just as well.
+func negateS(sig []byte, curve elliptic.Curve) {
+	half := sig[len(sig)/2:]
+	s := new(big.Int).SetBytes(half)
+	new(big.Int).Sub(curve.Params().N, s).FillBytes(half)
+}
+
+// reencodeNitro rewrites a Nitro token leaving what its signature covers
+// intact: it adds the CBOR tag, sets an unprotected header and negates s.
+func reencodeNitro(t *testing.T, token string) string {
+	t.Helper()
+	raw, err := base64.StdEncoding.DecodeString(token)
+	require.NoError(t, err)
+	var parts []cbor.RawMessage
+	require.NoError(t, cbor.Unmarshal(raw, &parts))
+	var sig []byte
+	require.NoError(t, cbor.Unmarshal(parts[3], &sig))
+	negateS(sig, elliptic.P384())
+	parts[3], err = cbor.Marshal(sig)
+	require.NoError(t, err)
+	parts[1], err = cbor.Marshal(map[int][]byte{4: []byte("kid")})
+	require.NoError(t, err)
+	raw, err = cbor.Marshal(cbor.Tag{Number: 18, Content: parts})
+	require.NoError(t, err)
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+// malleateSGX negates s in the quote signature of an SGX v3 quote, which
+// follows the header, the report body and the signature data length.
+func malleateSGX(t *testing.T, token string) string {
+	t.Helper()
+	raw, err := base64.StdEncoding.DecodeString(token)
+	require.NoError(t, err)
+	const off = 48 + 384 + 4
+	negateS(raw[off:off+64], elliptic.P256())
+	return base64.StdEncoding.EncodeToString(raw)
+}
+
+// A token re-encoded without breaking its signature is the same attestation
+// and cannot be consumed twice.
+func TestReplayReencodedToken(t *testing.T) {
+	ctx, g := replayGuard(t)
+	v := tee.NewVerifier()
+
+	for name, tc := range map[string]struct {
+		policy           tee.Policy
+		token, reencoded string
+	}{
+		"nitro": {nitroPolicy(), nitroAttestation, reencodeNitro(t, nitroAttestation)},
+		"sgx":   {sgxPolicy(), sgxQuote, malleateSGX(t, sgxQuote)},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NotEqual(t, tc.token, tc.reencoded)
+			att, err := v.Verify(ctx, tc.policy, fixtureNonce, tc.token)
+			require.NoError(t, err)
+			again, err := v.Verify(ctx, tc.policy, fixtureNonce, tc.reencoded)
+			require.NoError(t, err)
+			require.Equal(t, att.Digest, again.Digest)
+
+			require.NoError(t, g.Consume(ctx, att, 24*time.Hour))
+			err = g.Consume(ctx, again, 24*time.Hour)
+			require.ErrorIs(t, err, gerrc.ErrAlreadyExists)
+		})
+	}
+
+	// a JWT is identified by its signing input, not the signature encoding
+	gcp, err := tee.DefaultRegistry().Backend(tee.TEEType_TEE_TYPE_GCP_CONFIDENTIAL_SPACE)
+	require.NoError(t, err)
+	d1, err := gcp.Digest("aGVhZGVy.Y2xhaW1z.c2ln")
+	require.NoError(t, err)
+	d2, err := gcp.Digest("aGVhZGVy.Y2xhaW1z.c2lo")
+	require.NoError(t, err)
+	d3, err := gcp.Digest("aGVhZGVy.b3RoZXI.c2ln")
+	require.NoError(t, err)
+	require.Equal(t, d1, d2)
+	require.NotEqual(t, d1, d3)
+}
+
+type stubBackend struct{ claims map[string]any }
+
+func (b stubBackend) Claims(sdk.Context, tee.Policy, string, string) (map[string]any, error) {
+	return b.claims, nil
+}
+
+func (b stubBackend) IssuedAt(map[string]any) (time.Time, error) {
+	return time.Unix(1, 0), nil
+}
+
+func (b stubBackend) Digest(token string) ([]byte, error) {
+	return tee.SignedDigest([]byte(token)), nil
+}
+
+func TestRegistry(t *testing.T) {
+	ctx := fixtureCtx()
+
+	r := tee.Registry{}
+	require.NoError(t, r.Register(tee.TEEType_TEE_TYPE_AWS_NITRO, stubBackend{claims: goodClaims("n")}))
+	require.Error(t, r.Register(tee.TEEType_TEE_TYPE_AWS_NITRO, stubBackend{}))
+
+	policy := tee.Policy{
+		Type:            tee.TEEType_TEE_TYPE_AWS_NITRO,
+		PolicyValues:    securePolicyValues,
+		PolicyQuery:     securePolicyQuery,
+		PolicyStructure: securePolicyStructure,
+	}
+	v := tee.NewRegistryVerifier(r)
+	att, err := v.Verify(ctx, policy, "n", "token")
+	require.NoError(t, err)
+	require.Equal(t, time.Unix(1, 0), att.IssuedAt)
+	_, err = v.Verify(ctx, policy, "m", "token")
+	require.ErrorContains(t, err, "not authorised")
+
+	policy.Type = tee.TEEType_TEE_TYPE_INTEL_DCAP
+	_, err = v.Verify(ctx, policy, "n", "token")
+	require.ErrorContains(t, err, "unsupported tee type")
+
+	require.NoError(t, tee.TEEType_TEE_TYPE_INTEL_DCAP.Validate())
+	require.Error(t, tee.TEEType(7).Validate())
+}
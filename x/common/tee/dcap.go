@@ -0,0 +1,327 @@
+package tee
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/pem"
+	"math/big"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+/*
+Quote layouts are from the Intel SGX ECDSA Quote Library Reference (quote v3)
+and the Intel TDX DCAP Quoting Library API (quote v4).
+https://download.01.org/intel-sgx/latest/dcap-latest/linux/docs/
+*/
+
+const (
+	dcapHeaderLen       = 48
+	dcapSGXBodyLen      = 384
+	dcapTDXBodyLen      = 584
+	dcapQEReportLen     = 384
+	dcapECDSASigLen     = 64
+	dcapAttestKeyLen    = 64
+	dcapAttestKeyECDSA  = 2
+	dcapTEETypeSGX      = 0x00
+	dcapTEETypeTDX      = 0x81
+	dcapCertPCKChain    = 5
+	dcapCertQEReport    = 6
+	dcapReportDataLen   = 64
+	dcapSGXDebugFlag    = 0x02
+	dcapTDXDebugFlag    = 0x01
+	dcapQEReportDataOff = 320
+)
+
+// dcapQuote is a parsed SGX or TDX ECDSA quote. Body is the enclave report
+// body (SGX) or TD report body (TDX) the quote signature covers.
+type dcapQuote struct {
+	Version   uint16
+	TEEType   uint32
+	QESVN     uint16
+	PCESVN    uint16
+	Signed    []byte // header ‖ body
+	Body      []byte
+	Signature []byte
+	AttestKey []byte
+	QEReport  []byte
+	QESig     []byte
+	QEAuth    []byte
+	PCKChain  []*x509.Certificate
+}
+
+// dcapBackend verifies Intel SGX (v3) and TDX (v4) ECDSA quotes against the
+// PCK certificate chain embedded in the quote (certification data type 5).
+// The quote's report data must be sha256(nonce) followed by 32 zero bytes.
+//
+// TCB status is not evaluated: Intel's TCB info and QE identity collateral are
+// not on chain, so policies pin the measurements they trust in rego instead.
+type dcapBackend struct{}
+
+// IssuedAt is always zero: quotes carry no time.
+func (dcapBackend) IssuedAt(map[string]any) (time.Time, error) {
+	return time.Time{}, nil
+}
+
+func (dcapBackend) Claims(ctx sdk.Context, policy Policy, nonce, token string) (map[string]any, error) {
+	raw, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("token is not base64")
+	}
+	q, err := parseDCAPQuote(raw)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "parse quote")
+	}
+
+	// IMPORTANT: the quote is untrusted until the PCK chain, the QE report
+	// and the quote signature are verified.
+	pck := q.PCKChain
+	var bundledRoot *x509.Certificate
+	if len(pck) > 1 {
+		bundledRoot = pck[len(pck)-1]
+		pck = pck[:len(pck)-1]
+	}
+	if err := verifyCertificatePath(policy, pck[0], pck[1:], bundledRoot, ctx.BlockTime()); err != nil {
+		return nil, errorsmod.Wrap(err, "verify pck chain")
+	}
+
+	// the PCK key signs the quoting enclave's report...
+	pckKey, ok := pck[0].PublicKey.(*ecdsa.PublicKey)
+	if !ok || pckKey.Curve != elliptic.P256() {
+		return nil, gerrc.ErrInvalidArgument.Wrap("pck key is not ecdsa p-256")
+	}
+	if !verifyP256(pckKey, q.QEReport, q.QESig) {
+		return nil, gerrc.ErrUnauthenticated.Wrap("qe report signature")
+	}
+
+	// ...which binds the attestation key...
+	binding := sha256.Sum256(append(append([]byte{}, q.AttestKey...), q.QEAuth...))
+	if !isBoundReportData(q.QEReport[dcapQEReportDataOff:], binding[:]) {
+		return nil, gerrc.ErrUnauthenticated.Wrap("qe report does not bind the attestation key")
+	}
+
+	// ...which signs the quote.
+	attestKey, err := p256Key(q.AttestKey)
+	if err != nil {
+		return nil, err
+	}
+	if !verifyP256(attestKey, q.Signed, q.Signature) {
+		return nil, gerrc.ErrUnauthenticated.Wrap("quote signature")
+	}
+
+	expect := sha256.Sum256([]byte(nonce))
+	if !isBoundReportData(q.reportData(), expect[:]) {
+		return nil, gerrc.ErrUnauthenticated.Wrap("attestation nonce mismatch")
+	}
+	return q.claims(), nil
+}
+
+// Digest is over the quote header and report body the quote signature covers.
+func (dcapBackend) Digest(token string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("token is not base64")
+	}
+	q, err := parseDCAPQuote(raw)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "parse quote")
+	}
+	return SignedDigest(q.Signed), nil
+}
+
+// parseDCAPQuote splits a quote into its signed part and signature data.
+func parseDCAPQuote(raw []byte) (dcapQuote, error) {
+	var q dcapQuote
+	r := dcapReader{b: raw}
+
+	header := r.next(dcapHeaderLen)
+	if r.err != nil {
+		return q, r.err
+	}
+	q.Version = binary.LittleEndian.Uint16(header[0:2])
+	attestKeyType := binary.LittleEndian.Uint16(header[2:4])
+	q.TEEType = binary.LittleEndian.Uint32(header[4:8])
+	q.QESVN = binary.LittleEndian.Uint16(header[8:10])
+	q.PCESVN = binary.LittleEndian.Uint16(header[10:12])
+
+	if attestKeyType != dcapAttestKeyECDSA {
+		return q, gerrc.ErrInvalidArgument.Wrapf("got attestation key type %d want ecdsa p-256", attestKeyType)
+	}
+	bodyLen := dcapSGXBodyLen
+	switch {
+	case q.Version == 3 && q.TEEType == dcapTEETypeSGX, q.Version == 4 && q.TEEType == dcapTEETypeSGX:
+	case q.Version == 4 && q.TEEType == dcapTEETypeTDX:
+		bodyLen = dcapTDXBodyLen
+	default:
+		return q, gerrc.ErrInvalidArgument.Wrapf("unsupported quote version %d tee type %#x", q.Version, q.TEEType)
+	}
+	q.Body = r.next(bodyLen)
+	sig := dcapReader{b: r.next(int(r.u32()))}
+	if r.err != nil {
+		return q, r.err
+	}
+	q.Signed = raw[:dcapHeaderLen+bodyLen]
+	q.Signature = sig.next(dcapECDSASigLen)
+	q.AttestKey = sig.next(dcapAttestKeyLen)
+
+	// v4 wraps the QE report section in certification data of type 6
+	qe := sig
+	if q.Version == 4 {
+		if t := sig.u16(); t != dcapCertQEReport && sig.err == nil {
+			return q, gerrc.ErrInvalidArgument.Wrapf("got certification data type %d want %d", t, dcapCertQEReport)
+		}
+		qe = dcapReader{b: sig.next(int(sig.u32()))}
+		if sig.err != nil {
+			return q, sig.err
+		}
+	}
+	q.QEReport = qe.next(dcapQEReportLen)
+	q.QESig = qe.next(dcapECDSASigLen)
+	q.QEAuth = qe.next(int(qe.u16()))
+	if t := qe.u16(); t != dcapCertPCKChain && qe.err == nil {
+		return q, gerrc.ErrInvalidArgument.Wrapf("got certification data type %d want %d", t, dcapCertPCKChain)
+	}
+	chain := qe.next(int(qe.u32()))
+	if err := firstErr(sig.err, qe.err); err != nil {
+		return q, err
+	}
+
+	for rest := chain; ; {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			break
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return q, errorsmod.Wrapf(err, "parse pck certificate %d", len(q.PCKChain))
+		}
+		q.PCKChain = append(q.PCKChain, cert)
+	}
+	if len(q.PCKChain) == 0 {
+		return q, gerrc.ErrInvalidArgument.Wrap("pck chain empty")
+	}
+	return q, nil
+}
+
+func (q dcapQuote) isTDX() bool {
+	return q.TEEType == dcapTEETypeTDX
+}
+
+func (q dcapQuote) reportData() []byte {
+	return q.Body[len(q.Body)-dcapReportDataLen:]
+}
+
+// claims exposes the report body to rego. Measurements and attribute fields
+// are lowercase hex; debug is decoded from the attributes.
+func (q dcapQuote) claims() map[string]any {
+	h := func(from, to int) string { return hex.EncodeToString(q.Body[from:to]) }
+	claims := map[string]any{
+		"version":     q.Version,
+		"qe_svn":      q.QESVN,
+		"pce_svn":     q.PCESVN,
+		"report_data": hex.EncodeToString(q.reportData()),
+	}
+	if q.isTDX() {
+		claims["tee_type"] = "TDX"
+		claims["tee_tcb_svn"] = h(0, 16)
+		claims["mr_seam"] = h(16, 64)
+		claims["mr_signer_seam"] = h(64, 112)
+		claims["td_attributes"] = h(120, 128)
+		claims["xfam"] = h(128, 136)
+		claims["mr_td"] = h(136, 184)
+		claims["mr_config_id"] = h(184, 232)
+		claims["mr_owner"] = h(232, 280)
+		claims["mr_owner_config"] = h(280, 328)
+		claims["rtmrs"] = []any{h(328, 376), h(376, 424), h(424, 472), h(472, 520)}
+		claims["debug"] = q.Body[120]&dcapTDXDebugFlag != 0
+		return claims
+	}
+	claims["tee_type"] = "SGX"
+	claims["cpu_svn"] = h(0, 16)
+	claims["attributes"] = h(48, 64)
+	claims["mr_enclave"] = h(64, 96)
+	claims["mr_signer"] = h(128, 160)
+	claims["isv_prod_id"] = binary.LittleEndian.Uint16(q.Body[256:258])
+	claims["isv_svn"] = binary.LittleEndian.Uint16(q.Body[258:260])
+	claims["debug"] = q.Body[48]&dcapSGXDebugFlag != 0
+	return claims
+}
+
+// isBoundReportData reports whether reportData is digest followed by zeros.
+func isBoundReportData(reportData, digest []byte) bool {
+	return bytes.Equal(reportData[:len(digest)], digest) &&
+		bytes.Equal(reportData[len(digest):], make([]byte, len(reportData)-len(digest)))
+}
+
+// p256Key decodes a raw x ‖ y P-256 point, rejecting points off the curve.
+func p256Key(raw []byte) (*ecdsa.PublicKey, error) {
+	if _, err := ecdh.P256().NewPublicKey(append([]byte{0x04}, raw...)); err != nil {
+		return nil, gerrc.ErrInvalidArgument.Wrapf("attestation key: %s", err)
+	}
+	return &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(raw[:32]),
+		Y:     new(big.Int).SetBytes(raw[32:]),
+	}, nil
+}
+
+// verifyP256 checks a raw r ‖ s ECDSA signature over sha256(msg).
+func verifyP256(pub *ecdsa.PublicKey, msg, sig []byte) bool {
+	digest := sha256.Sum256(msg)
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:])
+	return ecdsa.Verify(pub, digest[:], r, s)
+}
+
+// dcapReader reads little-endian quote fields, latching the first short read.
+type dcapReader struct {
+	b   []byte
+	err error
+}
+
+func (r *dcapReader) next(n int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n < 0 || n > len(r.b) {
+		r.err = gerrc.ErrInvalidArgument.Wrapf("quote truncated: want %d bytes have %d", n, len(r.b))
+		return nil
+	}
+	out := r.b[:n]
+	r.b = r.b[n:]
+	return out
+}
+
+func (r *dcapReader) u16() uint16 {
+	if b := r.next(2); b != nil {
+		return binary.LittleEndian.Uint16(b)
+	}
+	return 0
+}
+
+func (r *dcapReader) u32() uint32 {
+	if b := r.next(4); b != nil {
+		return binary.LittleEndian.Uint32(b)
+	}
+	return 0
+}
+
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package tee
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"math/big"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/fxamacker/cbor/v2"
+)
+
+/*
+Document format and verification steps are from
+https://github.com/aws/aws-nitro-enclaves-nsm-api/blob/main/docs/attestation_process.md
+*/
+
+const (
+	// coseAlgES384 is the COSE algorithm id Nitro signs with.
+	coseAlgES384 = -35
+	// coseHeaderAlg is the COSE protected header label for the algorithm.
+	coseHeaderAlg = 1
+	// coseSign1Tag is the optional CBOR tag on a COSE_Sign1.
+	coseSign1Tag = 18
+)
+
+// coseSign1 is an untagged COSE_Sign1 (RFC 9052 section 4.2).
+type coseSign1 struct {
+	_           struct{} `cbor:",toarray"`
+	Protected   []byte
+	Unprotected cbor.RawMessage
+	Payload     []byte
+	Signature   []byte
+}
+
+// nitroDocument is the attestation document signed inside the COSE_Sign1.
+type nitroDocument struct {
+	ModuleID    string          `cbor:"module_id"`
+	Digest      string          `cbor:"digest"`
+	Timestamp   uint64          `cbor:"timestamp"`
+	PCRs        map[uint][]byte `cbor:"pcrs"`
+	Certificate []byte          `cbor:"certificate"`
+	CABundle    [][]byte        `cbor:"cabundle"`
+	PublicKey   []byte          `cbor:"public_key"`
+	UserData    []byte          `cbor:"user_data"`
+	Nonce       []byte          `cbor:"nonce"`
+}
+
+// nitroBackend verifies AWS Nitro Enclave attestation documents. The token is
+// the base64 (standard encoding) COSE_Sign1 returned by the NSM, requested
+// with the nonce as its nonce field.
+type nitroBackend struct{}
+
+func (nitroBackend) Claims(ctx sdk.Context, policy Policy, nonce, token string) (map[string]any, error) {
+	raw, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("token is not base64")
+	}
+	msg, err := parseCoseSign1(raw)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "parse cose sign1")
+	}
+
+	// IMPORTANT: the document is untrusted until the certificate chain and the
+	// signature are verified.
+	var doc nitroDocument
+	if err := cbor.Unmarshal(msg.Payload, &doc); err != nil {
+		return nil, gerrc.ErrInvalidArgument.Wrapf("decode attestation document: %s", err)
+	}
+	if err := validateNitroDocument(doc); err != nil {
+		return nil, err
+	}
+
+	leaf, err := x509.ParseCertificate(doc.Certificate)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "parse leaf certificate")
+	}
+	// cabundle runs from the root down to the leaf's issuer
+	bundle := make([]*x509.Certificate, len(doc.CABundle))
+	for i, der := range doc.CABundle {
+		if bundle[i], err = x509.ParseCertificate(der); err != nil {
+			return nil, errorsmod.Wrapf(err, "parse cabundle certificate %d", i)
+		}
+	}
+	if err := verifyCertificatePath(policy, leaf, bundle[1:], bundle[0], ctx.BlockTime()); err != nil {
+		return nil, errorsmod.Wrap(err, "verify cabundle chain")
+	}
+
+	if err := verifyCoseES384(msg, leaf); err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(doc.Nonce, []byte(nonce)) {
+		return nil, gerrc.ErrUnauthenticated.Wrap("attestation nonce mismatch")
+	}
+	return doc.claims(), nil
+}
+
+// IssuedAt is the document timestamp, in milliseconds since the epoch.
+func (nitroBackend) IssuedAt(claims map[string]any) (time.Time, error) {
+	ms, ok := claims["timestamp"].(uint64)
+	if !ok {
+		return time.Time{}, gerrc.ErrInvalidArgument.Wrap("timestamp claim")
+	}
+	return time.UnixMilli(int64(ms)).UTC(), nil //nolint:gosec // validated non-zero, realistic epoch millis
+}
+
+// Digest is over the COSE Sig_structure, which holds the protected header and
+// the payload but neither the unprotected header nor the CBOR tag.
+func (nitroBackend) Digest(token string) ([]byte, error) {
+	raw, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, gerrc.ErrInvalidArgument.Wrap("token is not base64")
+	}
+	msg, err := parseCoseSign1(raw)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "parse cose sign1")
+	}
+	signed, err := msg.sigStructure()
+	if err != nil {
+		return nil, err
+	}
+	return SignedDigest(signed), nil
+}
+
+func parseCoseSign1(raw []byte) (coseSign1, error) {
+	var msg coseSign1
+	var tagged cbor.RawTag
+	if err := cbor.Unmarshal(raw, &tagged); err == nil {
+		if tagged.Number != coseSign1Tag {
+			return msg, gerrc.ErrInvalidArgument.Wrapf("unexpected cbor tag %d", tagged.Number)
+		}
+		raw = tagged.Content
+	}
+	if err := cbor.Unmarshal(raw, &msg); err != nil {
+		return msg, gerrc.ErrInvalidArgument.Wrapf("decode: %s", err)
+	}
+
+	var protected map[int]any
+	if err := cbor.Unmarshal(msg.Protected, &protected); err != nil {
+		return msg, gerrc.ErrInvalidArgument.Wrapf("decode protected header: %s", err)
+	}
+	if alg, ok := protected[coseHeaderAlg].(int64); !ok || alg != coseAlgES384 {
+		return msg, gerrc.ErrInvalidArgument.Wrapf("got alg %v want es384", protected[coseHeaderAlg])
+	}
+	return msg, nil
+}
+
+func validateNitroDocument(doc nitroDocument) error {
+	switch {
+	case doc.ModuleID == "":
+		return gerrc.ErrInvalidArgument.Wrap("module id empty")
+	case doc.Digest != "SHA384":
+		return gerrc.ErrInvalidArgument.Wrapf("got digest %s want SHA384", doc.Digest)
+	case doc.Timestamp == 0:
+		return gerrc.ErrInvalidArgument.Wrap("timestamp empty")
+	case len(doc.PCRs) == 0:
+		return gerrc.ErrInvalidArgument.Wrap("pcrs empty")
+	case len(doc.Certificate) == 0:
+		return gerrc.ErrInvalidArgument.Wrap("certificate empty")
+	case len(doc.CABundle) == 0:
+		return gerrc.ErrInvalidArgument.Wrap("cabundle empty")
+	}
+	return nil
+}
+
+// verifyCoseES384 checks the COSE_Sign1 signature over its Sig_structure with
+// the leaf certificate's P-384 key.
+func verifyCoseES384(msg coseSign1, leaf *x509.Certificate) error {
+	pub, ok := leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok || pub.Curve.Params().BitSize != 384 {
+		return gerrc.ErrInvalidArgument.Wrap("leaf certificate key is not ecdsa p-384")
+	}
+	if len(msg.Signature) != 96 {
+		return gerrc.ErrInvalidArgument.Wrapf("got signature length %d want 96", len(msg.Signature))
+	}
+
+	sigStructure, err := msg.sigStructure()
+	if err != nil {
+		return err
+	}
+	digest := sha512.Sum384(sigStructure)
+	r := new(big.Int).SetBytes(msg.Signature[:48])
+	s := new(big.Int).SetBytes(msg.Signature[48:])
+	if !ecdsa.Verify(pub, digest[:], r, s) {
+		return gerrc.ErrUnauthenticated.Wrap("cose signature")
+	}
+	return nil
+}
+
+// sigStructure is the encoded Sig_structure the signature is over (RFC 9052
+// section 4.4), without external data.
+func (msg coseSign1) sigStructure() ([]byte, error) {
+	b, err := cbor.Marshal([]any{"Signature1", msg.Protected, []byte{}, msg.Payload})
+	return b, errorsmod.Wrap(err, "encode sig structure")
+}
+
+// claims exposes the document to rego. Byte fields are lowercase hex, PCRs are
+// keyed by their decimal index and the nonce is the string it was minted from.
+func (doc nitroDocument) claims() map[string]any {
+	pcrs := make(map[string]any, len(doc.PCRs))
+	for i, v := range doc.PCRs {
+		pcrs[strconv.FormatUint(uint64(i), 10)] = hex.EncodeToString(v)
+	}
+	return map[string]any{
+		"module_id":  doc.ModuleID,
+		"digest":     doc.Digest,
+		"timestamp":  doc.Timestamp,
+		"pcrs":       pcrs,
+		"public_key": hex.EncodeToString(doc.PublicKey),
+		"user_data":  hex.EncodeToString(doc.UserData),
+		"nonce":      string(doc.Nonce),
+	}
+}
@@ -1,7 +1,9 @@
 package tee
 
 import (
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/pem"
 
 	errorsmod "cosmossdk.io/errors"
@@ -27,3 +29,30 @@ func (p Policy) PolicyValuesStore() (opastorage.Store, error) {
 	}
 	return inmem.NewFromObject(json), nil
 }
+
+// Fingerprint is the lowercase hex SHA-256 of the deterministic proto
+// marshaling of the policy. Policy has only string and enum fields, so
+// Marshal is stable across validators. Fingerprints content-address policies
+// in the x/agent registry and denylist.
+func (p Policy) Fingerprint() (string, error) {
+	b, err := p.Marshal()
+	if err != nil {
+		return "", err
+	}
+	h := sha256.Sum256(b)
+	return hex.EncodeToString(h[:]), nil
+}
+
+// ValidateFingerprint checks the fingerprint is 64-char lowercase hex, the
+// canonical form produced by Fingerprint.
+func ValidateFingerprint(fp string) error {
+	if len(fp) != 64 {
+		return gerrc.ErrInvalidArgument.Wrapf("fingerprint must be 64 hex chars, got %d", len(fp))
+	}
+	for _, c := range fp {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return gerrc.ErrInvalidArgument.Wrap("fingerprint must be lowercase hex")
+		}
+	}
+	return nil
+}
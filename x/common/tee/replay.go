@@ -0,0 +1,151 @@
+package tee
+
+import (
+	"crypto/sha256"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// ReplayGuard is a module's store of consumed attestations. An attestation is
+// accepted at most once and only while younger than the module's max age, so
+// a leaked token cannot be re-submitted, in any encoding. Each digest is kept
+// until the attestation it belongs to would fail the age check anyway, then
+// pruned.
+type ReplayGuard struct {
+	// consumed maps token digest to expiry (unix nanos)
+	consumed collections.Map[[]byte, int64]
+	// byExpiry indexes consumed by expiry for pruning
+	byExpiry collections.KeySet[collections.Pair[int64, []byte]]
+}
+
+// NewReplayGuard adds the guard's collections to sb under the two prefixes.
+func NewReplayGuard(sb *collections.SchemaBuilder, consumedPrefix, byExpiryPrefix collections.Prefix) ReplayGuard {
+	return ReplayGuard{
+		consumed: collections.NewMap(
+			sb,
+			consumedPrefix,
+			"consumed_attestations",
+			collections.BytesKey,
+			collections.Int64Value,
+		),
+		byExpiry: collections.NewKeySet(
+			sb,
+			byExpiryPrefix,
+			"consumed_attestations_by_expiry",
+			collections.PairKeyCodec(collections.Int64Key, collections.BytesKey),
+		),
+	}
+}
+
+// SignedDigest is the digest an attestation is remembered by: the hash of the
+// bytes its TEE signed.
+func SignedDigest(signed []byte) []byte {
+	d := sha256.Sum256(signed)
+	return d[:]
+}
+
+// Consume accepts a verified attestation once: it must have been issued no
+// more than maxAge before the block time and its digest not consumed before.
+// Formats carrying no issue time (zero att.IssuedAt) are exempt from the age
+// check and remembered for maxAge from now.
+func (g ReplayGuard) Consume(ctx sdk.Context, att Attestation, maxAge time.Duration) error {
+	if len(att.Digest) != sha256.Size {
+		return gerrc.ErrInvalidArgument.Wrapf("attestation digest length: %d", len(att.Digest))
+	}
+	now := ctx.BlockTime()
+	issued := att.IssuedAt
+	if issued.IsZero() {
+		issued = now
+	}
+	if age := now.Sub(issued); age > maxAge {
+		return gerrc.ErrFailedPrecondition.Wrapf("attestation too old: issued %s ago, max %s", age, maxAge)
+	}
+
+	digest := att.Digest
+	seen, err := g.consumed.Has(ctx, digest)
+	if err != nil {
+		return errorsmod.Wrap(err, "has consumed attestation")
+	}
+	if seen {
+		return gerrc.ErrAlreadyExists.Wrapf("attestation already consumed: %X", digest)
+	}
+	return g.set(ctx, digest, issued.Add(maxAge))
+}
+
+func (g ReplayGuard) set(ctx sdk.Context, digest []byte, expiry time.Time) error {
+	if err := g.consumed.Set(ctx, digest, expiry.UnixNano()); err != nil {
+		return errorsmod.Wrap(err, "set consumed attestation")
+	}
+	return g.byExpiry.Set(ctx, collections.Join(expiry.UnixNano(), digest))
+}
+
+// IsConsumed reports whether the attestation with the digest has been consumed
+// and not yet pruned.
+func (g ReplayGuard) IsConsumed(ctx sdk.Context, digest []byte) (bool, error) {
+	return g.consumed.Has(ctx, digest)
+}
+
+// Prune forgets up to limit digests that expired strictly before the block
+// time, oldest first, and returns how many it removed. A token at exactly its
+// expiry still passes the age check, so its digest must outlive that instant.
+func (g ReplayGuard) Prune(ctx sdk.Context, limit uint64) (uint64, error) {
+	now := ctx.BlockTime().UnixNano()
+	rng := new(collections.Range[collections.Pair[int64, []byte]]).
+		EndExclusive(collections.Join(now, []byte{}))
+	var expired []collections.Pair[int64, []byte]
+	err := g.byExpiry.Walk(ctx, rng, func(key collections.Pair[int64, []byte]) (bool, error) {
+		expired = append(expired, key)
+		return uint64(len(expired)) >= limit, nil
+	})
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "walk consumed attestations")
+	}
+	for _, key := range expired {
+		if err := g.byExpiry.Remove(ctx, key); err != nil {
+			return 0, err
+		}
+		if err := g.consumed.Remove(ctx, key.K2()); err != nil {
+			return 0, err
+		}
+	}
+	return uint64(len(expired)), nil
+}
+
+// Export returns every consumed attestation, soonest expiry first.
+func (g ReplayGuard) Export(ctx sdk.Context) ([]ConsumedAttestation, error) {
+	var out []ConsumedAttestation
+	err := g.byExpiry.Walk(ctx, nil, func(key collections.Pair[int64, []byte]) (bool, error) {
+		out = append(out, ConsumedAttestation{Digest: key.K2(), Expiry: time.Unix(0, key.K1()).UTC()})
+		return false, nil
+	})
+	return out, err
+}
+
+// Import restores exported consumed attestations.
+func (g ReplayGuard) Import(ctx sdk.Context, consumed []ConsumedAttestation) error {
+	for _, c := range consumed {
+		if err := g.set(ctx, c.Digest, c.Expiry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateConsumedAttestations checks digests are well-formed and distinct.
+func ValidateConsumedAttestations(consumed []ConsumedAttestation) error {
+	seen := make(map[string]struct{}, len(consumed))
+	for _, c := range consumed {
+		if len(c.Digest) != sha256.Size {
+			return gerrc.ErrInvalidArgument.Wrapf("consumed attestation digest length: %d", len(c.Digest))
+		}
+		if _, dup := seen[string(c.Digest)]; dup {
+			return gerrc.ErrInvalidArgument.Wrapf("duplicate consumed attestation: %X", c.Digest)
+		}
+		seen[string(c.Digest)] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package tee_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
+)
+
+func replayGuard(t *testing.T) (sdk.Context, tee.ReplayGuard) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey("tee")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_tee"))
+	sb := collections.NewSchemaBuilder(runtime.NewKVStoreService(key))
+	g := tee.NewReplayGuard(sb, collections.NewPrefix(0), collections.NewPrefix(1))
+	_, err := sb.Build()
+	require.NoError(t, err)
+	return ctx.WithBlockTime(time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)), g
+}
+
+// att is an attestation of the signed bytes token, issued at issuedAt.
+func att(token string, issuedAt time.Time) tee.Attestation {
+	return tee.Attestation{IssuedAt: issuedAt, Digest: tee.SignedDigest([]byte(token))}
+}
+
+func TestReplayGuard(t *testing.T) {
+	ctx, g := replayGuard(t)
+	const maxAge = 10 * time.Minute
+	now := ctx.BlockTime()
+
+	require.NoError(t, g.Consume(ctx, att("a", now.Add(-time.Minute)), maxAge))
+	err := g.Consume(ctx, att("a", now.Add(-time.Minute)), maxAge)
+	require.ErrorIs(t, err, gerrc.ErrAlreadyExists)
+
+	err = g.Consume(ctx, att("stale", now.Add(-maxAge - time.Second)), maxAge)
+	require.ErrorIs(t, err, gerrc.ErrFailedPrecondition)
+	consumed, err := g.IsConsumed(ctx, tee.SignedDigest([]byte("stale")))
+	require.NoError(t, err)
+	require.False(t, consumed)
+
+	err = g.Consume(ctx, tee.Attestation{IssuedAt: now}, maxAge)
+	require.ErrorIs(t, err, gerrc.ErrInvalidArgument)
+
+	// no issue time: remembered for max age from now
+	require.NoError(t, g.Consume(ctx, att("quote", time.Time{}), maxAge))
+
+	// "a" is kept while it could still pass the age check, then pruned
+	ctx = ctx.WithBlockTime(now.Add(maxAge - time.Minute))
+	n, err := g.Prune(ctx, 10)
+	require.NoError(t, err)
+	require.Zero(t, n)
+	ctx = ctx.WithBlockTime(now.Add(maxAge - time.Minute + time.Nanosecond))
+	n, err = g.Prune(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), n)
+	consumed, err = g.IsConsumed(ctx, tee.SignedDigest([]byte("a")))
+	require.NoError(t, err)
+	require.False(t, consumed)
+	err = g.Consume(ctx, att("a", now.Add(-time.Minute)), maxAge)
+	require.ErrorIs(t, err, gerrc.ErrFailedPrecondition)
+
+	exported, err := g.Export(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []tee.ConsumedAttestation{{Digest: tee.SignedDigest([]byte("quote")), Expiry: now.Add(maxAge)}}, exported)
+	require.NoError(t, tee.ValidateConsumedAttestations(exported))
+	require.Error(t, tee.ValidateConsumedAttestations(append(exported, exported...)))
+
+	ctx2, g2 := replayGuard(t)
+	require.NoError(t, g2.Import(ctx2, exported))
+	consumed, err = g2.IsConsumed(ctx2, tee.SignedDigest([]byte("quote")))
+	require.NoError(t, err)
+	require.True(t, consumed)
+}
+
+func TestReplayGuard_PruneLimit(t *testing.T) {
+	ctx, g := replayGuard(t)
+	for _, token := range []string{"a", "b", "c"} {
+		require.NoError(t, g.Consume(ctx, att(token, time.Time{}), time.Minute))
+	}
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute + time.Nanosecond))
+	n, err := g.Prune(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), n)
+	n, err = g.Prune(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), n)
+}
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,10 +27,47 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TEEType selects the attestation backend a policy is verified with.
+type TEEType int32
+
+const (
+	// TEE_TYPE_GCP_CONFIDENTIAL_SPACE is a GCP Confidential Space JWT signed
+	// under the Confidential Space PKI.
+	TEEType_TEE_TYPE_GCP_CONFIDENTIAL_SPACE TEEType = 0
+	// TEE_TYPE_AWS_NITRO is a base64 AWS Nitro Enclave attestation document: a
+	// COSE_Sign1 signed by the Nitro PKI.
+	TEEType_TEE_TYPE_AWS_NITRO TEEType = 1
+	// TEE_TYPE_INTEL_DCAP is a base64 Intel SGX (v3) or TDX (v4) ECDSA quote,
+	// verified against the PCK certificate chain it carries.
+	TEEType_TEE_TYPE_INTEL_DCAP TEEType = 2
+)
+
+var TEEType_name = map[int32]string{
+	0: "TEE_TYPE_GCP_CONFIDENTIAL_SPACE",
+	1: "TEE_TYPE_AWS_NITRO",
+	2: "TEE_TYPE_INTEL_DCAP",
+}
+
+var TEEType_value = map[string]int32{
+	"TEE_TYPE_GCP_CONFIDENTIAL_SPACE": 0,
+	"TEE_TYPE_AWS_NITRO":              1,
+	"TEE_TYPE_INTEL_DCAP":             2,
+}
+
+func (x TEEType) String() string {
+	return proto.EnumName(TEEType_name, int32(x))
+}
+
+func (TEEType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_033f219d19eb792c, []int{0}
+}
+
 // Policy is a neutral TEE attestation verification policy, consumed by the
 // shared TEE verifier. Consumers (rollapp, agent) adapt their own config to it.
 type Policy struct {
-	// gcp_root_cert_pem is the GCP root certificate in PEM format
+	// gcp_root_cert_pem is the root certificate of the backend's PKI in PEM
+	// format: the GCP Confidential Space root, the AWS Nitro root or the Intel
+	// SGX root CA. The name predates the other backends.
 	GcpRootCertPem string `protobuf:"bytes,1,opt,name=gcp_root_cert_pem,json=gcpRootCertPem,proto3" json:"gcp_root_cert_pem,omitempty" yaml:"gcp_root_cert_pem"`
 	// policy_values is the OPA data store values (JSON)
 	PolicyValues string `protobuf:"bytes,2,opt,name=policy_values,json=policyValues,proto3" json:"policy_values,omitempty" yaml:"policy_json_values"`
@@ -35,6 +76,9 @@ type Policy struct {
 	// policy_structure is the OPA rego policy module. The %s is formatted with
 	// the raw nonce by the verifier.
 	PolicyStructure string `protobuf:"bytes,4,opt,name=policy_structure,json=policyStructure,proto3" json:"policy_structure,omitempty" yaml:"policy_rego_structure"`
+	// type is the attestation backend. The zero value is GCP Confidential Space,
+	// so policies predating the field keep their meaning and fingerprint.
+	Type TEEType `protobuf:"varint,5,opt,name=type,proto3,enum=dymensionxyz.dymension.common.TEEType" json:"type,omitempty" yaml:"type"`
 }
 
 func (m *Policy) Reset()         { *m = Policy{} }
@@ -98,8 +142,74 @@ func (m *Policy) GetPolicyStructure() string {
 	return ""
 }
 
+func (m *Policy) GetType() TEEType {
+	if m != nil {
+		return m.Type
+	}
+	return TEEType_TEE_TYPE_GCP_CONFIDENTIAL_SPACE
+}
+
+// ConsumedAttestation is the digest of an attestation token a module has
+// accepted, kept until expiry, after which the token is too old to pass the
+// age check.
+type ConsumedAttestation struct {
+	// digest is the sha256 of the bytes the TEE signed, whatever the token
+	// encoding.
+	Digest []byte    `protobuf:"bytes,1,opt,name=digest,proto3" json:"digest,omitempty"`
+	Expiry time.Time `protobuf:"bytes,2,opt,name=expiry,proto3,stdtime" json:"expiry"`
+}
+
+func (m *ConsumedAttestation) Reset()         { *m = ConsumedAttestation{} }
+func (m *ConsumedAttestation) String() string { return proto.CompactTextString(m) }
+func (*ConsumedAttestation) ProtoMessage()    {}
+func (*ConsumedAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_033f219d19eb792c, []int{1}
+}
+func (m *ConsumedAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumedAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumedAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumedAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumedAttestation.Merge(m, src)
+}
+func (m *ConsumedAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumedAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumedAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumedAttestation proto.InternalMessageInfo
+
+func (m *ConsumedAttestation) GetDigest() []byte {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
+}
+
+func (m *ConsumedAttestation) GetExpiry() time.Time {
+	if m != nil {
+		return m.Expiry
+	}
+	return time.Time{}
+}
+
 func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.common.TEEType", TEEType_name, TEEType_value)
 	proto.RegisterType((*Policy)(nil), "dymensionxyz.dymension.common.Policy")
+	proto.RegisterType((*ConsumedAttestation)(nil), "dymensionxyz.dymension.common.ConsumedAttestation")
 }
 
 func init() {
@@ -107,27 +217,39 @@ func init() {
 }
 
 var fileDescriptor_033f219d19eb792c = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4f, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x93, 0xf3, 0x73, 0x73,
-	0xf3, 0xf3, 0xf4, 0x4b, 0x52, 0x53, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x64, 0x91, 0x15,
-	0xea, 0xc1, 0x39, 0x7a, 0x10, 0x85, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x95, 0xfa, 0x20,
-	0x16, 0x44, 0x93, 0xd2, 0x62, 0x26, 0x2e, 0xb6, 0x80, 0xfc, 0x9c, 0xcc, 0xe4, 0x4a, 0x21, 0x77,
-	0x2e, 0xc1, 0xf4, 0xe4, 0x82, 0xf8, 0xa2, 0xfc, 0xfc, 0x92, 0xf8, 0xe4, 0xd4, 0xa2, 0x92, 0xf8,
-	0x82, 0xd4, 0x5c, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x4e, 0x27, 0x99, 0x4f, 0xf7, 0xe4, 0x25, 0x2a,
-	0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0x94, 0x28, 0x05, 0xf1, 0xa5, 0x27, 0x17, 0x04, 0xe5, 0xe7,
-	0x97, 0x38, 0xa7, 0x16, 0x95, 0x04, 0xa4, 0xe6, 0x0a, 0x39, 0x71, 0xf1, 0x16, 0x80, 0x8d, 0x8c,
-	0x2f, 0x4b, 0xcc, 0x29, 0x4d, 0x2d, 0x96, 0x60, 0x02, 0x1b, 0x22, 0xfb, 0xe9, 0x9e, 0xbc, 0x24,
-	0xc4, 0x10, 0xa8, 0x74, 0x56, 0x71, 0x7e, 0x1e, 0x54, 0x8d, 0x52, 0x10, 0x0f, 0x44, 0x30, 0x0c,
-	0xcc, 0x15, 0xb2, 0xe7, 0x82, 0xf2, 0xe3, 0x0b, 0x4b, 0x53, 0x8b, 0x2a, 0x25, 0x98, 0xd1, 0xdd,
-	0x01, 0x95, 0x2d, 0x4a, 0x4d, 0xcf, 0x87, 0x28, 0x51, 0x0a, 0xe2, 0x86, 0x88, 0x05, 0x82, 0x78,
-	0x42, 0xde, 0x5c, 0x02, 0x50, 0x25, 0xc5, 0x25, 0x45, 0xa5, 0xc9, 0x25, 0xa5, 0x45, 0xa9, 0x12,
-	0x2c, 0x60, 0x43, 0x14, 0x3e, 0xdd, 0x93, 0x97, 0xc1, 0x34, 0x04, 0xae, 0x4c, 0x29, 0x88, 0x1f,
-	0x22, 0x1e, 0x0c, 0x13, 0x71, 0xf2, 0x3e, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07,
-	0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86,
-	0x28, 0xc3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0x50, 0x20, 0xeb, 0xe3, 0x88, 0xa8, 0x32, 0x63,
-	0xfd, 0x0a, 0xa4, 0xd8, 0x4a, 0x62, 0x03, 0x87, 0xbc, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf1,
-	0xba, 0x7e, 0xca, 0xd9, 0x01, 0x00, 0x00,
+	// 506 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x61, 0x6f, 0x93, 0x40,
+	0x1c, 0xc6, 0x4b, 0x9d, 0x55, 0xaf, 0x73, 0xab, 0x37, 0x33, 0xb1, 0xd9, 0xa0, 0xc1, 0x44, 0x17,
+	0x5f, 0x40, 0xdc, 0xde, 0x19, 0x13, 0x03, 0x0c, 0x97, 0xa6, 0x4b, 0x87, 0x94, 0x68, 0xf4, 0xcd,
+	0xa5, 0xa5, 0x27, 0xa2, 0x85, 0x43, 0x38, 0x96, 0xe2, 0xa7, 0xd8, 0xc7, 0xda, 0xcb, 0xbd, 0xf4,
+	0x15, 0x9a, 0xf6, 0x0b, 0x98, 0x7e, 0x02, 0xc3, 0x1d, 0x25, 0x8b, 0x8b, 0xbe, 0xeb, 0xf3, 0xdc,
+	0xef, 0xff, 0xf4, 0xf8, 0x3f, 0x07, 0x9e, 0x4d, 0xf3, 0x10, 0x47, 0x69, 0x40, 0xa2, 0x79, 0xfe,
+	0x5d, 0xab, 0x85, 0xe6, 0x91, 0x30, 0x24, 0x91, 0x46, 0x31, 0x56, 0xe3, 0x84, 0x50, 0x02, 0xf7,
+	0xaf, 0x83, 0x6a, 0x2d, 0x54, 0x0e, 0x76, 0x1f, 0xfa, 0xc4, 0x27, 0x8c, 0xd4, 0xca, 0x5f, 0x7c,
+	0xa8, 0x2b, 0xfb, 0x84, 0xf8, 0x33, 0xac, 0x31, 0x35, 0xc9, 0x3e, 0x69, 0x34, 0x08, 0x71, 0x4a,
+	0xc7, 0x61, 0xcc, 0x01, 0xe5, 0x77, 0x13, 0xb4, 0x6c, 0x32, 0x0b, 0xbc, 0x1c, 0x9e, 0x80, 0x07,
+	0xbe, 0x17, 0xa3, 0x84, 0x10, 0x8a, 0x3c, 0x9c, 0x50, 0x14, 0xe3, 0x50, 0x14, 0x7a, 0xc2, 0xc1,
+	0x3d, 0x63, 0x6f, 0x55, 0xc8, 0x62, 0x3e, 0x0e, 0x67, 0x2f, 0x95, 0x1b, 0x88, 0xe2, 0x6c, 0xf9,
+	0x5e, 0xec, 0x10, 0x42, 0x4d, 0x9c, 0x50, 0x1b, 0x87, 0xd0, 0x00, 0xf7, 0x63, 0x16, 0x89, 0xce,
+	0xc7, 0xb3, 0x0c, 0xa7, 0x62, 0x93, 0x85, 0xec, 0xaf, 0x0a, 0xf9, 0x31, 0x0f, 0xa9, 0x8e, 0xbf,
+	0xa4, 0x24, 0xaa, 0x18, 0xc5, 0xd9, 0xe4, 0xe6, 0x3b, 0x26, 0xe1, 0x6b, 0x50, 0x69, 0xf4, 0x2d,
+	0xc3, 0x49, 0x2e, 0xde, 0xfa, 0xfb, 0x1e, 0xd5, 0x69, 0x82, 0x7d, 0xc2, 0x11, 0xc5, 0x69, 0x73,
+	0xef, 0x6d, 0xa9, 0xe0, 0x00, 0x74, 0x2a, 0x24, 0xa5, 0x49, 0xe6, 0xd1, 0x2c, 0xc1, 0xe2, 0x06,
+	0x0b, 0xe9, 0xad, 0x0a, 0x79, 0xef, 0x66, 0x48, 0x8d, 0x29, 0xce, 0x36, 0xf7, 0x47, 0x6b, 0x07,
+	0x0e, 0xc0, 0x06, 0xcd, 0x63, 0x2c, 0xde, 0xee, 0x09, 0x07, 0x5b, 0x87, 0x4f, 0xd5, 0xff, 0x56,
+	0xa1, 0xba, 0x96, 0xe5, 0xe6, 0x31, 0x36, 0xb6, 0x57, 0x85, 0xdc, 0xe6, 0x7f, 0x54, 0x4e, 0x2b,
+	0x0e, 0x0b, 0x51, 0xbe, 0x82, 0x1d, 0x93, 0x44, 0x69, 0x16, 0xe2, 0xa9, 0x4e, 0x69, 0xd9, 0x06,
+	0x0d, 0x48, 0x04, 0x77, 0x41, 0x6b, 0x1a, 0xf8, 0x38, 0xa5, 0x6c, 0xe7, 0x9b, 0x4e, 0xa5, 0xe0,
+	0x2b, 0xd0, 0xc2, 0xf3, 0x38, 0x48, 0x72, 0xb6, 0xc6, 0xf6, 0x61, 0x57, 0xe5, 0x9d, 0xaa, 0xeb,
+	0x4e, 0x55, 0x77, 0xdd, 0xa9, 0x71, 0xf7, 0xb2, 0x90, 0x1b, 0x17, 0x3f, 0x65, 0xc1, 0xa9, 0x66,
+	0x9e, 0x23, 0x70, 0xa7, 0xba, 0x0e, 0x7c, 0x02, 0x64, 0xd7, 0xb2, 0x90, 0xfb, 0xc1, 0xb6, 0xd0,
+	0x89, 0x69, 0x23, 0xf3, 0x6c, 0xf8, 0xa6, 0x7f, 0x6c, 0x0d, 0xdd, 0xbe, 0x7e, 0x8a, 0x46, 0xb6,
+	0x6e, 0x5a, 0x9d, 0x06, 0xdc, 0x05, 0xb0, 0x86, 0xf4, 0xf7, 0x23, 0x34, 0xec, 0xbb, 0xce, 0x59,
+	0x47, 0x80, 0x8f, 0xc0, 0x4e, 0xed, 0xf7, 0x87, 0xae, 0x75, 0x8a, 0x8e, 0x4d, 0xdd, 0xee, 0x34,
+	0x8d, 0xc1, 0xe5, 0x42, 0x12, 0xae, 0x16, 0x92, 0xf0, 0x6b, 0x21, 0x09, 0x17, 0x4b, 0xa9, 0x71,
+	0xb5, 0x94, 0x1a, 0x3f, 0x96, 0x52, 0xe3, 0xe3, 0x0b, 0x3f, 0xa0, 0x9f, 0xb3, 0x49, 0xb9, 0x15,
+	0xed, 0x1f, 0x8f, 0xfc, 0xfc, 0x48, 0x9b, 0x5f, 0x7b, 0xe9, 0x93, 0x16, 0xfb, 0xa6, 0xa3, 0x3f,
+	0x03, 0x00, 0xaa, 0x44, 0x62, 0x51, 0x15, 0x03, 0x00, 0x00,
 }
 
 func (m *Policy) Marshal() (dAtA []byte, err error) {
@@ -150,6 +272,11 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Type != 0 {
+		i = encodeVarintTee(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.PolicyStructure) > 0 {
 		i -= len(m.PolicyStructure)
 		copy(dAtA[i:], m.PolicyStructure)
@@ -181,6 +308,44 @@ func (m *Policy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ConsumedAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumedAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumedAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Expiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTee(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Digest) > 0 {
+		i -= len(m.Digest)
+		copy(dAtA[i:], m.Digest)
+		i = encodeVarintTee(dAtA, i, uint64(len(m.Digest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTee(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTee(v)
 	base := offset
@@ -214,6 +379,24 @@ func (m *Policy) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTee(uint64(l))
 	}
+	if m.Type != 0 {
+		n += 1 + sovTee(uint64(m.Type))
+	}
+	return n
+}
+
+func (m *ConsumedAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Digest)
+	if l > 0 {
+		n += 1 + l + sovTee(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Expiry)
+	n += 1 + l + sovTee(uint64(l))
 	return n
 }
 
@@ -380,6 +563,142 @@ func (m *Policy) Unmarshal(dAtA []byte) error {
 			}
 			m.PolicyStructure = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TEEType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumedAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumedAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumedAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTee
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = append(m.Digest[:0], dAtA[iNdEx:postIndex]...)
+			if m.Digest == nil {
+				m.Digest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Expiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTee(dAtA[iNdEx:])
@@ -0,0 +1,31 @@
+package sgx_enclave
+
+import rego.v1
+
+default allow := false
+default tee_type_verified := false
+default mr_enclave_verified := false
+default mr_signer_verified := false
+default isv_svn_verified := false
+default debug_verified := false
+default nonce_verified := false
+
+allow if {
+	tee_type_verified
+	mr_enclave_verified
+	mr_signer_verified
+	isv_svn_verified
+	debug_verified
+	nonce_verified
+}
+
+tee_type_verified if input.tee_type == "SGX"
+mr_enclave_verified if input.mr_enclave in data.allowed_mr_enclave
+mr_signer_verified if input.mr_signer in data.allowed_mr_signer
+isv_svn_verified if input.isv_svn >= data.min_isv_svn
+debug_verified if input.debug == false
+
+# report data is sha256(nonce) followed by 32 zero bytes
+nonce_verified if {
+	input.report_data == concat("", [crypto.sha256("%s"), "0000000000000000000000000000000000000000000000000000000000000000"])
+}
@@ -0,0 +1,7 @@
+allow = data.sgx_enclave.allow;
+tee_type_verified = data.sgx_enclave.tee_type_verified;
+mr_enclave_verified = data.sgx_enclave.mr_enclave_verified;
+mr_signer_verified = data.sgx_enclave.mr_signer_verified;
+isv_svn_verified = data.sgx_enclave.isv_svn_verified;
+debug_verified = data.sgx_enclave.debug_verified;
+nonce_verified = data.sgx_enclave.nonce_verified
\ No newline at end of file
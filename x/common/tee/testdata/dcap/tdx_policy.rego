@@ -0,0 +1,28 @@
+package tdx_guest
+
+import rego.v1
+
+default allow := false
+default tee_type_verified := false
+default mr_td_verified := false
+default mr_seam_verified := false
+default debug_verified := false
+default nonce_verified := false
+
+allow if {
+	tee_type_verified
+	mr_td_verified
+	mr_seam_verified
+	debug_verified
+	nonce_verified
+}
+
+tee_type_verified if input.tee_type == "TDX"
+mr_td_verified if input.mr_td in data.allowed_mr_td
+mr_seam_verified if input.mr_seam in data.allowed_mr_seam
+debug_verified if input.debug == false
+
+# report data is sha256(nonce) followed by 32 zero bytes
+nonce_verified if {
+	input.report_data == concat("", [crypto.sha256("%s"), "0000000000000000000000000000000000000000000000000000000000000000"])
+}
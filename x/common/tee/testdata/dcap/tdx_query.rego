@@ -0,0 +1,6 @@
+allow = data.tdx_guest.allow;
+tee_type_verified = data.tdx_guest.tee_type_verified;
+mr_td_verified = data.tdx_guest.mr_td_verified;
+mr_seam_verified = data.tdx_guest.mr_seam_verified;
+debug_verified = data.tdx_guest.debug_verified;
+nonce_verified = data.tdx_guest.nonce_verified
\ No newline at end of file
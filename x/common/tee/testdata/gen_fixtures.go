@@ -0,0 +1,223 @@
+//go:build ignore
+
+// gen_fixtures records the AWS Nitro and Intel DCAP attestation fixtures the
+// tee tests verify. Real attestations chain to vendor roots whose keys we do
+// not hold, so the fixtures are produced in the vendors' exact wire formats
+// under a test PKI with the same shape (root, intermediate, leaf).
+//
+//	go run x/common/tee/testdata/gen_fixtures.go
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+)
+
+// Nonce is the nonce both fixtures are bound to; the tests read it back.
+const Nonce = "5d3e1b1ee2c2d4aa6a4f1c2c7d6f0b1e9a0f3b8d2a1c4e5f60718293a4b5c6d7"
+
+var (
+	dir       = filepath.Join("x", "common", "tee", "testdata")
+	notBefore = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	notAfter  = time.Date(2035, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func main() {
+	must(os.WriteFile(filepath.Join(dir, "nonce.txt"), []byte(Nonce), 0o644))
+	nitro()
+	dcap()
+}
+
+func nitro() {
+	root, rootKey := ca(elliptic.P384(), "aws.nitro-enclaves", nil, nil)
+	inter, interKey := ca(elliptic.P384(), "zonal.us-east-1.aws.nitro-enclaves", root, rootKey)
+	leaf, leafKey := cert(elliptic.P384(), "i-0123456789abcdef0.us-east-1.aws.nitro-enclaves", inter, interKey)
+
+	pcr := func(s string) []byte { h := sha512.Sum384([]byte(s)); return h[:] }
+	doc := struct {
+		ModuleID    string          `cbor:"module_id"`
+		Digest      string          `cbor:"digest"`
+		Timestamp   uint64          `cbor:"timestamp"`
+		PCRs        map[uint][]byte `cbor:"pcrs"`
+		Certificate []byte          `cbor:"certificate"`
+		CABundle    [][]byte        `cbor:"cabundle"`
+		PublicKey   []byte          `cbor:"public_key"`
+		UserData    []byte          `cbor:"user_data"`
+		Nonce       []byte          `cbor:"nonce"`
+	}{
+		ModuleID:    "i-0123456789abcdef0-enc0123456789abcdef",
+		Digest:      "SHA384",
+		Timestamp:   uint64(time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC).UnixMilli()),
+		PCRs:        map[uint][]byte{0: pcr("enclave image"), 1: pcr("kernel"), 2: pcr("application"), 3: make([]byte, 48)},
+		Certificate: leaf.Raw,
+		CABundle:    [][]byte{root.Raw, inter.Raw},
+		Nonce:       []byte(Nonce),
+	}
+	payload, err := cbor.Marshal(doc)
+	must(err)
+	protected, err := cbor.Marshal(map[int]int{1: -35})
+	must(err)
+	sigStructure, err := cbor.Marshal([]any{"Signature1", protected, []byte{}, payload})
+	must(err)
+	digest := sha512.Sum384(sigStructure)
+	r, s, err := ecdsa.Sign(rand.Reader, leafKey, digest[:])
+	must(err)
+	sig := append(r.FillBytes(make([]byte, 48)), s.FillBytes(make([]byte, 48))...)
+
+	sign1, err := cbor.Marshal([]any{protected, map[int]any{}, payload, sig})
+	must(err)
+	write("nitro", "root.pem", pemCert(root))
+	write("nitro", "attestation.b64", []byte(base64.StdEncoding.EncodeToString(sign1)))
+}
+
+func dcap() {
+	root, rootKey := ca(elliptic.P256(), "Intel SGX Root CA", nil, nil)
+	inter, interKey := ca(elliptic.P256(), "Intel SGX PCK Platform CA", root, rootKey)
+	pck, pckKey := cert(elliptic.P256(), "Intel SGX PCK Certificate", inter, interKey)
+	chain := append(append(pemCert(pck), pemCert(inter)...), pemCert(root)...)
+
+	reportData := make([]byte, 64)
+	nonceHash := sha256.Sum256([]byte(Nonce))
+	copy(reportData, nonceHash[:])
+
+	// SGX v3: enclave report body
+	sgx := make([]byte, 384)
+	sgx[48] = 0x05 // INIT | MODE64BIT, not DEBUG
+	copy(sgx[64:96], hash256("enclave"))
+	copy(sgx[128:160], hash256("signer"))
+	binary.LittleEndian.PutUint16(sgx[256:258], 1)
+	binary.LittleEndian.PutUint16(sgx[258:260], 2)
+	copy(sgx[320:], reportData)
+	write("dcap", "sgx_quote.b64", quote(3, 0x00, sgx, pckKey, chain))
+
+	// TDX v4: TD report body
+	tdx := make([]byte, 584)
+	copy(tdx[16:64], hash384("seam"))
+	copy(tdx[136:184], hash384("td"))
+	for i := range 4 {
+		copy(tdx[328+48*i:376+48*i], hash384("rtmr"+string(rune('0'+i))))
+	}
+	copy(tdx[520:], reportData)
+	write("dcap", "tdx_quote.b64", quote(4, 0x81, tdx, pckKey, chain))
+
+	write("dcap", "root.pem", pemCert(root))
+}
+
+// quote assembles and signs an ECDSA quote around body.
+func quote(version uint16, teeType uint32, body []byte, pckKey *ecdsa.PrivateKey, chain []byte) []byte {
+	header := make([]byte, 48)
+	binary.LittleEndian.PutUint16(header[0:2], version)
+	binary.LittleEndian.PutUint16(header[2:4], 2)
+	binary.LittleEndian.PutUint32(header[4:8], teeType)
+	binary.LittleEndian.PutUint16(header[8:10], 8)
+	binary.LittleEndian.PutUint16(header[10:12], 13)
+	copy(header[12:28], []byte{0x93, 0x9a, 0x72, 0x33, 0xf7, 0x9c, 0x4c, 0xa9, 0x94, 0x0a, 0x0d, 0xb3, 0x95, 0x7f, 0x06, 0x07})
+
+	attestKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	must(err)
+	attestPub := append(attestKey.X.FillBytes(make([]byte, 32)), attestKey.Y.FillBytes(make([]byte, 32))...)
+	qeAuth := []byte("qe authentication data")
+
+	qeReport := make([]byte, 384)
+	copy(qeReport[64:96], hash256("quoting enclave"))
+	binding := sha256.Sum256(append(append([]byte{}, attestPub...), qeAuth...))
+	copy(qeReport[320:352], binding[:])
+
+	var qe []byte
+	qe = append(qe, qeReport...)
+	qe = append(qe, signP256(pckKey, qeReport)...)
+	qe = binary.LittleEndian.AppendUint16(qe, uint16(len(qeAuth)))
+	qe = append(qe, qeAuth...)
+	qe = binary.LittleEndian.AppendUint16(qe, 5)
+	qe = binary.LittleEndian.AppendUint32(qe, uint32(len(chain)))
+	qe = append(qe, chain...)
+
+	signed := append(append([]byte{}, header...), body...)
+	var sigData []byte
+	sigData = append(sigData, signP256(attestKey, signed)...)
+	sigData = append(sigData, attestPub...)
+	if version == 4 {
+		sigData = binary.LittleEndian.AppendUint16(sigData, 6)
+		sigData = binary.LittleEndian.AppendUint32(sigData, uint32(len(qe)))
+	}
+	sigData = append(sigData, qe...)
+
+	out := binary.LittleEndian.AppendUint32(signed, uint32(len(sigData)))
+	out = append(out, sigData...)
+	return []byte(base64.StdEncoding.EncodeToString(out))
+}
+
+func signP256(key *ecdsa.PrivateKey, msg []byte) []byte {
+	digest := sha256.Sum256(msg)
+	r, s, err := ecdsa.Sign(rand.Reader, key, digest[:])
+	must(err)
+	return append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+}
+
+func ca(curve elliptic.Curve, cn string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	return issue(curve, cn, true, parent, parentKey)
+}
+
+func cert(curve elliptic.Curve, cn string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	return issue(curve, cn, false, parent, parentKey)
+}
+
+func issue(curve elliptic.Curve, cn string, isCA bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(curve, rand.Reader)
+	must(err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	must(err)
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+	}
+	if isCA {
+		tmpl.KeyUsage |= x509.KeyUsageCertSign
+	}
+	if parent == nil {
+		parent, parentKey = tmpl, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, parent, &key.PublicKey, parentKey)
+	must(err)
+	c, err := x509.ParseCertificate(der)
+	must(err)
+	return c, key
+}
+
+func pemCert(c *x509.Certificate) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.Raw})
+}
+
+func hash256(s string) []byte { h := sha256.Sum256([]byte(s)); return h[:] }
+
+func hash384(s string) []byte { h := sha512.Sum384([]byte(s)); return h[:] }
+
+func write(sub, name string, b []byte) {
+	must(os.MkdirAll(filepath.Join(dir, sub), 0o755))
+	must(os.WriteFile(filepath.Join(dir, sub, name), b, 0o644))
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,27 @@
+package nitro_enclave
+
+import rego.v1
+
+default allow := false
+default pcr0_verified := false
+default pcr1_verified := false
+default pcr2_verified := false
+default digest_verified := false
+default nonce_verified := false
+
+allow if {
+	pcr0_verified
+	pcr1_verified
+	pcr2_verified
+	digest_verified
+	nonce_verified
+}
+
+pcr0_verified if input.pcrs["0"] in data.allowed_pcr0
+pcr1_verified if input.pcrs["1"] in data.allowed_pcr1
+pcr2_verified if input.pcrs["2"] in data.allowed_pcr2
+digest_verified if input.digest in data.allowed_digest
+
+nonce_verified if {
+	input.nonce == "%s"
+}
@@ -0,0 +1,6 @@
+allow = data.nitro_enclave.allow;
+pcr0_verified = data.nitro_enclave.pcr0_verified;
+pcr1_verified = data.nitro_enclave.pcr1_verified;
+pcr2_verified = data.nitro_enclave.pcr2_verified;
+digest_verified = data.nitro_enclave.digest_verified;
+nonce_verified = data.nitro_enclave.nonce_verified
\ No newline at end of file
@@ -18,41 +18,78 @@ import (
 Validation logic is from https://github.com/GoogleCloudPlatform/confidential-space/blob/b6ade09bb9d3c7f39bb6af482ba71c7156184fd0/codelabs/health_data_analysis_codelab/src/uwear/workload.go#L1-L380 (https://codelabs.developers.google.com/confidential-space-pki?hl=en#0)
 */
 
-// Verifier verifies a GCP confidential space TEE attestation token against a
-// policy. The seam is an interface so consumers can unit-test their logic with
-// a fake verifier, without needing a live GCP-signed token.
+// Verifier verifies a TEE attestation token against a policy. The seam is an
+// interface so consumers can unit-test their logic with a fake verifier,
+// without needing a live hardware-signed token.
 type Verifier interface {
-	Verify(ctx sdk.Context, policy Policy, nonce, token string) error
+	Verify(ctx sdk.Context, policy Policy, nonce, token string) (Attestation, error)
 }
 
-type verifier struct{}
+// Attestation is what a verified token attests to beyond the policy decision.
+type Attestation struct {
+	// IssuedAt is when the TEE produced the token. It is zero for formats
+	// carrying no time (DCAP quotes), whose freshness rests on the nonce.
+	IssuedAt time.Time
+	// Digest identifies the attestation whatever its encoding, see
+	// Backend.Digest.
+	Digest []byte
+}
+
+type verifier struct {
+	backends Registry
+}
 
-// NewVerifier returns the real verifier doing GCP PKI cert-chain verification
-// and OPA/rego policy evaluation.
+// NewVerifier returns the real verifier over the default backends: PKI
+// verification by the policy's backend, then OPA/rego policy evaluation of
+// the attested claims.
 func NewVerifier() Verifier {
-	return verifier{}
+	return NewRegistryVerifier(DefaultRegistry())
+}
+
+// NewRegistryVerifier returns a verifier dispatching on the policy type to
+// the given backends.
+func NewRegistryVerifier(backends Registry) Verifier {
+	return verifier{backends: backends}
 }
 
-func (verifier) Verify(ctx sdk.Context, policy Policy, nonce, token string) error {
-	// make sure the token really came from GCP
-	jwtToken, err := validateAttestationAuthenticity(ctx, policy, token)
+func (v verifier) Verify(ctx sdk.Context, policy Policy, nonce, token string) (Attestation, error) {
+	backend, err := v.backends.Backend(policy.Type)
+	if err != nil {
+		return Attestation{}, err
+	}
+
+	// make sure the token really came from the TEE vendor's PKI
+	claims, err := backend.Claims(ctx, policy, nonce, token)
 	if err != nil {
-		return errorsmod.Wrap(err, "validate PKI token")
+		return Attestation{}, errorsmod.Wrapf(err, "authenticate %s attestation", policy.Type)
 	}
 
 	// make the sure token actually certifies the non-tampered with computation
-	err = validateAttestationIntegrity(ctx, policy, jwtToken, nonce)
+	err = validateAttestationIntegrity(ctx, policy, claims, nonce)
 	if err != nil {
-		return errorsmod.Wrap(err, "claims validation")
+		return Attestation{}, errorsmod.Wrap(err, "claims validation")
 	}
-	return nil
+
+	issuedAt, err := backend.IssuedAt(claims)
+	if err != nil {
+		return Attestation{}, errorsmod.Wrap(err, "issue time")
+	}
+	digest, err := backend.Digest(token)
+	if err != nil {
+		return Attestation{}, errorsmod.Wrap(err, "digest")
+	}
+	return Attestation{IssuedAt: issuedAt, Digest: digest}, nil
 }
 
-func validateAttestationIntegrity(ctx sdk.Context, policy Policy, token jwt.Token, nonce string) error {
+func jwtClaims(token jwt.Token) (map[string]any, error) {
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return gerrc.ErrInvalidArgument.Wrap("get claims from jwt")
+		return nil, gerrc.ErrInvalidArgument.Wrap("get claims from jwt")
 	}
+	return claims, nil
+}
+
+func validateAttestationIntegrity(ctx sdk.Context, policy Policy, claims map[string]any, nonce string) error {
 	authorized, err := EvaluateOPAPolicy(ctx, policy, claims, nonce)
 	if err != nil {
 		return errorsmod.Wrap(err, "evaluate opa policy")
@@ -66,7 +103,7 @@ func validateAttestationIntegrity(ctx sdk.Context, policy Policy, token jwt.Toke
 // EvaluateOPAPolicy returns a boolean indicating if the OPA policy is satisfied
 // by the given claims, or an error if one occurred. The verifier formats the
 // raw nonce into the policy structure (the %s in Policy.PolicyStructure).
-func EvaluateOPAPolicy(ctx sdk.Context, policy Policy, claims jwt.MapClaims, nonce string) (bool, error) {
+func EvaluateOPAPolicy(ctx sdk.Context, policy Policy, claims map[string]any, nonce string) (bool, error) {
 	module := fmt.Sprintf(policy.PolicyStructure, nonce)
 
 	store, err := policy.PolicyValuesStore()
@@ -269,7 +306,10 @@ func validateAttestationAuthenticity(ctx sdk.Context, policy Policy, attestation
 		return certificates.LeafCert.PublicKey, nil
 	}
 
-	verifiedJWT, err := jwt.Parse(attestationToken, keyFunc, jwt.WithTimeFunc(func() time.Time { return ctx.BlockTime() }))
+	verifiedJWT, err := jwt.Parse(attestationToken, keyFunc,
+		jwt.WithTimeFunc(func() time.Time { return ctx.BlockTime() }),
+		jwt.WithStrictDecoding(),
+	)
 	if err != nil {
 		return jwt.Token{}, errorsmod.Wrap(err, "jwt lib parse validate verify")
 	}
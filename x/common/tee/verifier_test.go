@@ -125,6 +125,6 @@ func TestVerify(t *testing.T) {
 
 	ctx := sdk.Context{}.WithContext(context.Background()).
 		WithBlockTime(time.Date(2025, 9, 18, 9, 47, 0, 0, time.UTC))
-	err := tee.NewVerifier().Verify(ctx, policy, res.Result.Nonce, res.Result.Token)
+	_, err := tee.NewVerifier().Verify(ctx, policy, res.Result.Nonce, res.Result.Token)
 	require.NoError(t, err)
 }
@@ -107,6 +107,20 @@ func (r RollappPacket) GetAck() (channeltypes.Acknowledgement, error) {
 	return ack, nil
 }
 
+// TransferTarget returns who the packet pays when it finalizes: the receiver
+// of a received transfer, or the sender refunded by an ack or timeout. Once a
+// demand order for the packet is fulfilled, this is the fulfiller.
+func (r RollappPacket) TransferTarget() (string, error) {
+	data, err := r.GetTransferPacketData()
+	if err != nil {
+		return "", err
+	}
+	if r.Type == RollappPacket_ON_RECV {
+		return data.Receiver, nil
+	}
+	return data.Sender, nil
+}
+
 // restores the packet back to how it looked when hub first received it, to make sure the right ack
 // is written back
 func (r RollappPacket) RestoreOriginalTransferTarget() RollappPacket {
@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetPacketsByStatus())
 	cmd.AddCommand(CmdGetPacketsByType())
 	cmd.AddCommand(CmdGetPendingPacketsByAddress())
+	cmd.AddCommand(CmdQueryFinalizationQueue())
 
 	return cmd
 }
@@ -254,3 +255,34 @@ func CmdGetPendingPacketsByAddress() *cobra.Command {
 
 	return cmd
 }
+
+func CmdQueryFinalizationQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalization-queue [rollapp-id]",
+		Short: "Get the depth of the automatic finalization queue, per rollapp",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFinalizationQueueRequest{}
+			if len(args) == 1 {
+				req.RollappId = args[0]
+			}
+
+			res, err := queryClient.FinalizationQueue(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -13,6 +13,11 @@ import (
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
+const (
+	FlagMaxProofHeight = "max-proof-height"
+	FlagMaxCount       = "max-count"
+)
+
 // GetTxCmd returns the transaction commands for this module.
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +29,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdFinalizePacket())
+	cmd.AddCommand(CmdFinalizePackets())
 
 	return cmd
 }
@@ -73,6 +79,49 @@ func CmdFinalizePacket() *cobra.Command {
 	return cmd
 }
 
+func CmdFinalizePackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-packets [rollapp-id] --from <sender>",
+		Short: "Finalize the pending packets of a rollapp up to its finalized height",
+		Long: `Finalize the pending packets of a rollapp, lowest proof height first, up to its
+latest finalized height or --max-proof-height if lower. At most --max-count
+packets are finalized, fewer if the tx runs low on gas; resend the tx while
+the response has more.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			maxProofHeight, err := cmd.Flags().GetUint64(FlagMaxProofHeight)
+			if err != nil {
+				return err
+			}
+
+			maxCount, err := cmd.Flags().GetUint32(FlagMaxCount)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFinalizePackets{
+				Sender:         clientCtx.GetFromAddress().String(),
+				RollappId:      args[0],
+				MaxProofHeight: maxProofHeight,
+				MaxCount:       maxCount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagMaxProofHeight, 0, "Highest proof height to finalize, defaults to the latest finalized height")
+	cmd.Flags().Uint32(FlagMaxCount, 0, fmt.Sprintf("Most packets to finalize, defaults to %d", types.MaxFinalizePacketsCount))
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parsePacketType(packetType string) (commontypes.RollappPacket_Type, error) {
 	switch packetType {
 	case commontypes.RollappPacket_ON_RECV.String():
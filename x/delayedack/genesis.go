@@ -1,6 +1,8 @@
 package delayedack
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -23,12 +25,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 		k.SetRollappPacket(ctx, packet)
 	}
+	for _, entry := range genState.FinalizationQueue {
+		if err := k.SetFinalizationQueueEntry(ctx, entry); err != nil {
+			panic(fmt.Errorf("set finalization queue entry: %w", err))
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	queue, err := k.FinalizationQueue(ctx)
+	if err != nil {
+		panic(fmt.Errorf("finalization queue: %w", err))
+	}
 	return &types.GenesisState{
-		Params:         k.GetParams(ctx),
-		RollappPackets: k.GetAllRollappPackets(ctx),
+		Params:            k.GetParams(ctx),
+		RollappPackets:    k.GetAllRollappPackets(ctx),
+		FinalizationQueue: queue,
 	}
 }
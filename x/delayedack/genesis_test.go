@@ -102,3 +102,28 @@ func TestExportGenesis(t *testing.T) {
 	require.Equal(t, params, got.Params)
 	require.Equal(t, rollappPackets, got.RollappPackets)
 }
+
+func TestGenesisFinalizationQueue(t *testing.T) {
+	k, ctx := keepertest.DelayedackKeeper(t)
+	queue := []types.FinalizationQueueEntry{
+		{Seq: 2, RollappId: "rollapp_1-1", PacketKey: []byte("key2")},
+		{Seq: 5, RollappId: "rollapp_2-1", PacketKey: []byte("key5")},
+		{Seq: 7, RollappId: "rollapp_1-1", PacketKey: []byte("key7")},
+	}
+	delayedack.InitGenesis(ctx, *k, types.GenesisState{Params: types.DefaultParams(), FinalizationQueue: queue})
+
+	depth, err := k.FinalizationQueueDepth(ctx, "rollapp_1-1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), depth)
+	depth, err = k.FinalizationQueueDepth(ctx, "rollapp_2-1")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), depth)
+
+	got := delayedack.ExportGenesis(ctx, *k)
+	require.Equal(t, queue, got.FinalizationQueue)
+
+	// the round trip gives the same state
+	k2, ctx2 := keepertest.DelayedackKeeper(t)
+	delayedack.InitGenesis(ctx2, *k2, *got)
+	require.Equal(t, got, delayedack.ExportGenesis(ctx2, *k2))
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// AfterStateFinalized queues the pending packets of the finalized state for
+// EndBlock, if automatic finalization is on.
+func (k Keeper) AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *rollapptypes.StateInfo) error {
+	if k.GetParams(ctx).AutoFinalizeMaxPackets == 0 {
+		return nil
+	}
+	packets := k.ListRollappPackets(ctx, types.PendingByRollappIDByHeightRange(rollappID, stateInfo.StartHeight, stateInfo.GetLatestHeight()))
+	for _, packet := range packets {
+		if err := k.enqueueFinalization(ctx, rollappID, packet.RollappPacketKey()); err != nil {
+			return fmt.Errorf("enqueue packet finalization: %w", err)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) enqueueFinalization(ctx sdk.Context, rollappID string, packetKey []byte) error {
+	seq, err := k.finalizationQueueSeq.Next(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.finalizationQueue.Set(ctx, collections.Join3(seq, rollappID, packetKey)); err != nil {
+		return err
+	}
+	depth, err := k.FinalizationQueueDepth(ctx, rollappID)
+	if err != nil {
+		return err
+	}
+	return k.finalizationQueueDepth.Set(ctx, rollappID, depth+1)
+}
+
+func (k Keeper) dequeueFinalization(ctx sdk.Context, key collections.Triple[uint64, string, []byte]) error {
+	if err := k.finalizationQueue.Remove(ctx, key); err != nil {
+		return err
+	}
+	depth, err := k.FinalizationQueueDepth(ctx, key.K2())
+	if err != nil {
+		return err
+	}
+	if depth <= 1 {
+		return k.finalizationQueueDepth.Remove(ctx, key.K2())
+	}
+	return k.finalizationQueueDepth.Set(ctx, key.K2(), depth-1)
+}
+
+// FinalizationQueue returns the queued entries, in order.
+func (k Keeper) FinalizationQueue(ctx sdk.Context) ([]types.FinalizationQueueEntry, error) {
+	iter, err := k.finalizationQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]types.FinalizationQueueEntry, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, types.FinalizationQueueEntry{Seq: key.K1(), RollappId: key.K2(), PacketKey: key.K3()})
+	}
+	return entries, nil
+}
+
+// SetFinalizationQueueEntry restores a queued entry, e.g. from genesis. Entries
+// queued later come after it.
+func (k Keeper) SetFinalizationQueueEntry(ctx sdk.Context, e types.FinalizationQueueEntry) error {
+	if err := k.finalizationQueue.Set(ctx, collections.Join3(e.Seq, e.RollappId, e.PacketKey)); err != nil {
+		return err
+	}
+	next, err := k.finalizationQueueSeq.Peek(ctx)
+	if err != nil {
+		return err
+	}
+	if next <= e.Seq {
+		if err := k.finalizationQueueSeq.Set(ctx, e.Seq+1); err != nil {
+			return err
+		}
+	}
+	depth, err := k.FinalizationQueueDepth(ctx, e.RollappId)
+	if err != nil {
+		return err
+	}
+	return k.finalizationQueueDepth.Set(ctx, e.RollappId, depth+1)
+}
+
+// FinalizationQueueDepth returns how many packets of the rollapp are queued.
+func (k Keeper) FinalizationQueueDepth(ctx sdk.Context, rollappID string) (uint64, error) {
+	depth, err := k.finalizationQueueDepth.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return depth, err
+}
+
+// FinalizationQueueDepths returns the depth of each rollapp's queue, skipping
+// empty ones.
+func (k Keeper) FinalizationQueueDepths(ctx sdk.Context) ([]types.FinalizationQueueDepth, error) {
+	iter, err := k.finalizationQueueDepth.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	kvs, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+	depths := make([]types.FinalizationQueueDepth, 0, len(kvs))
+	for _, kv := range kvs {
+		depths = append(depths, types.FinalizationQueueDepth{RollappId: kv.Key, Depth: kv.Value})
+	}
+	return depths, nil
+}
+
+// ProcessFinalizationQueue finalizes queued packets in the order queued, up to
+// the per-block packet and gas budgets in params. Entries whose packet is no
+// longer pending, finalized by a message or reverted by a fork, are dropped.
+// A packet which fails to finalize is dropped from the queue and stays pending
+// for a finalize message.
+func (k Keeper) ProcessFinalizationQueue(ctx sdk.Context, ibc porttypes.IBCModule) error {
+	params := k.GetParams(ctx)
+	if params.AutoFinalizeMaxPackets == 0 {
+		return nil
+	}
+
+	// read the budget's worth of entries first, the queue changes as we go
+	iter, err := k.finalizationQueue.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	var keys []collections.Triple[uint64, string, []byte]
+	for ; iter.Valid() && len(keys) < int(params.AutoFinalizeMaxPackets); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close() // nolint: errcheck
+			return err
+		}
+		keys = append(keys, key)
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	// meter the work done here on its own, end block gas is not limited
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	sender := authtypes.NewModuleAddress(types.ModuleName).String()
+	for _, key := range keys {
+		if params.AutoFinalizeMaxGas != 0 && params.AutoFinalizeMaxGas <= ctx.GasMeter().GasConsumed() {
+			break
+		}
+		if err := k.dequeueFinalization(ctx, key); err != nil {
+			return fmt.Errorf("dequeue packet finalization: %w", err)
+		}
+		rollappID := key.K2()
+		packet, err := k.GetRollappPacket(ctx, string(key.K3()))
+		if err != nil {
+			continue
+		}
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.finalizeRollappPacket(ctx, ibc, rollappID, *packet)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("auto finalize rollapp packet", "rollappID", rollappID, "sequence", packet.Packet.Sequence, "err", err)
+			continue
+		}
+		err = uevent.EmitTypedEvent(ctx, &types.EventFinalizePacket{
+			Sender:            sender,
+			RollappId:         rollappID,
+			PacketProofHeight: packet.ProofHeight,
+			PacketType:        packet.Type,
+			PacketSrcChannel:  packet.Packet.SourceChannel,
+			PacketSequence:    packet.Packet.Sequence,
+		})
+		if err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *DelayedAckTestSuite) TestFinalizationQueue() {
+	rollapp := "rollapp_1234-1"
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         commontypes.Status_FINALIZED,
+		Sequencer:      proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+
+	// the last packet is not in the finalized state
+	var packets []commontypes.RollappPacket
+	for i, h := range []uint64{3, 5, 8, 12} {
+		p := commontypes.RollappPacket{
+			RollappId:   rollapp,
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: h,
+			Packet:      apptesting.GenerateTestPacket(s.T(), uint64(i+1)),
+		}
+		s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, p)
+		packets = append(packets, p)
+	}
+	status := func(p commontypes.RollappPacket) commontypes.Status {
+		for _, st := range []commontypes.Status{commontypes.Status_PENDING, commontypes.Status_FINALIZED} {
+			key := commontypes.RollappPacketKey(st, p.RollappId, p.ProofHeight, p.Type, p.Packet.SourceChannel, p.Packet.Sequence)
+			if _, err := s.App.DelayedAckKeeper.GetRollappPacket(s.Ctx, string(key)); err == nil {
+				return st
+			}
+		}
+		s.FailNow("packet not found")
+		return 0
+	}
+
+	k := s.App.DelayedAckKeeper
+	ibc := s.App.DelayedAckMiddleware.NextIBCMiddleware()
+	depth := func() uint64 {
+		res, err := keeper.NewQuerier(k).FinalizationQueue(s.Ctx, &types.QueryFinalizationQueueRequest{})
+		s.Require().NoError(err)
+		return res.Total
+	}
+
+	// off by default: nothing is queued
+	s.Require().NoError(k.AfterStateFinalized(s.Ctx, rollapp, &stateInfo))
+	s.Require().Zero(depth())
+
+	params := k.GetParams(s.Ctx)
+	params.AutoFinalizeMaxPackets = 2
+	k.SetParams(s.Ctx, params)
+	s.Require().NoError(k.AfterStateFinalized(s.Ctx, rollapp, &stateInfo))
+	s.Require().Equal(uint64(3), depth())
+	res, err := keeper.NewQuerier(k).FinalizationQueue(s.Ctx, &types.QueryFinalizationQueueRequest{RollappId: rollapp})
+	s.Require().NoError(err)
+	s.Require().Equal([]types.FinalizationQueueDepth{{RollappId: rollapp, Depth: 3}}, res.Depths)
+
+	// two packets a block
+	s.Require().NoError(k.ProcessFinalizationQueue(s.Ctx, ibc))
+	s.Require().Equal(uint64(1), depth())
+	s.Require().Equal(commontypes.Status_FINALIZED, status(packets[0]))
+	s.Require().Equal(commontypes.Status_FINALIZED, status(packets[1]))
+	s.Require().Equal(commontypes.Status_PENDING, status(packets[2]))
+
+	// a packet finalized by a message leaves a stale entry, which is dropped
+	s.FinalizePacket(s.Ctx, packets[2])
+	s.Require().NoError(k.ProcessFinalizationQueue(s.Ctx, ibc))
+	s.Require().Zero(depth())
+	s.Require().Equal(commontypes.Status_PENDING, status(packets[3]))
+}
+
+func (s *DelayedAckTestSuite) TestFinalizationQueueGasBudget() {
+	rollapp := "rollapp_1234-1"
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         commontypes.Status_FINALIZED,
+		Sequencer:      proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+	for seq := uint64(1); seq <= 3; seq++ {
+		s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, commontypes.RollappPacket{
+			RollappId:   rollapp,
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: seq,
+			Packet:      apptesting.GenerateTestPacket(s.T(), seq),
+		})
+	}
+
+	k := s.App.DelayedAckKeeper
+	params := k.GetParams(s.Ctx)
+	params.AutoFinalizeMaxPackets = 10
+	// any packet exhausts it
+	params.AutoFinalizeMaxGas = 1
+	k.SetParams(s.Ctx, params)
+	s.Require().NoError(k.AfterStateFinalized(s.Ctx, rollapp, &stateInfo))
+
+	ibc := s.App.DelayedAckMiddleware.NextIBCMiddleware()
+	for want := uint64(2); ; want-- {
+		s.Require().NoError(k.ProcessFinalizationQueue(s.Ctx, ibc))
+		depth, err := k.FinalizationQueueDepth(s.Ctx, rollapp)
+		s.Require().NoError(err)
+		s.Require().Equal(want, depth)
+		if want == 0 {
+			break
+		}
+	}
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
@@ -10,6 +11,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
 func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule, rollappPacketKey string) (*commontypes.RollappPacket, error) {
@@ -30,6 +32,57 @@ func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule,
 	return packet, nil
 }
 
+// FinalizeRollappPackets finalizes the pending packets of the rollapp with
+// proof heights up to maxProofHeight, capped at its latest finalized height,
+// lowest first. It stops after maxCount packets or once the gas left is less
+// than the most a packet of the batch used. Each packet is finalized in its own
+// cached context: one which fails is left pending, its error in its result,
+// and the batch moves on.
+func (k Keeper) FinalizeRollappPackets(
+	ctx sdk.Context,
+	ibc porttypes.IBCModule,
+	rollappID string,
+	maxProofHeight uint64,
+	maxCount int,
+) (results []types.FinalizePacketResult, height uint64, hasMore bool, err error) {
+	height, err = k.rollappKeeper.GetLatestFinalizedHeight(ctx, rollappID)
+	if err != nil {
+		return nil, 0, false, fmt.Errorf("get latest finalized height: rollapp '%s': %w", rollappID, err)
+	}
+	if maxProofHeight != 0 && maxProofHeight < height {
+		height = maxProofHeight
+	}
+
+	filter := types.PendingByRollappIDByMaxHeight(rollappID, height)
+	filter.Limit = maxCount + 1 // one more tells if any are left
+	packets := k.ListRollappPackets(ctx, filter)
+
+	var perPacket storetypes.Gas
+	for i, packet := range packets {
+		if i == maxCount || ctx.GasMeter().GasRemaining() < perPacket {
+			return results, height, true, nil
+		}
+		before := ctx.GasMeter().GasConsumed()
+		packetErr := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.finalizeRollappPacket(ctx, ibc, rollappID, packet)
+		})
+		perPacket = max(perPacket, ctx.GasMeter().GasConsumed()-before)
+
+		res := types.FinalizePacketResult{
+			PacketKey:         commontypes.EncodePacketKey(packet.RollappPacketKey()),
+			PacketProofHeight: packet.ProofHeight,
+			PacketType:        packet.Type,
+			PacketSrcChannel:  packet.Packet.SourceChannel,
+			PacketSequence:    packet.Packet.Sequence,
+		}
+		if packetErr != nil {
+			res.Error = packetErr.Error()
+		}
+		results = append(results, res)
+	}
+	return results, height, false, nil
+}
+
 // used with osmo helper
 type wrappedFunc func(ctx sdk.Context) error
 
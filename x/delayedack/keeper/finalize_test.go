@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
@@ -194,3 +196,118 @@ func (s *DelayedAckTestSuite) TestFinalizePacketByPacketKey() {
 		})
 	}
 }
+
+func (s *DelayedAckTestSuite) TestFinalizePackets() {
+	rollapp := "rollapp_1234-1"
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         commontypes.Status_FINALIZED,
+		Sequencer:      proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+
+	// the last packet is above the finalized height
+	var packets []commontypes.RollappPacket
+	for i, h := range []uint64{3, 5, 8, 12} {
+		p := commontypes.RollappPacket{
+			RollappId:   rollapp,
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: h,
+			Packet:      apptesting.GenerateTestPacket(s.T(), uint64(i+1)),
+		}
+		s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, p)
+		packets = append(packets, p)
+	}
+
+	finalize := func(maxProofHeight uint64, maxCount uint32) *types.MsgFinalizePacketsResponse {
+		handler := s.App.MsgServiceRouter().Handler(new(types.MsgFinalizePackets))
+		resp, err := handler(s.Ctx, &types.MsgFinalizePackets{
+			Sender:         apptesting.CreateRandomAccounts(1)[0].String(),
+			RollappId:      rollapp,
+			MaxProofHeight: maxProofHeight,
+			MaxCount:       maxCount,
+		})
+		s.Require().NoError(err)
+		var res types.MsgFinalizePacketsResponse
+		s.Require().NoError(res.Unmarshal(resp.MsgResponses[0].Value))
+		return &res
+	}
+	heights := func(res *types.MsgFinalizePacketsResponse) []uint64 {
+		var ret []uint64
+		for _, r := range res.Results {
+			s.Require().Empty(r.Error)
+			ret = append(ret, r.PacketProofHeight)
+		}
+		return ret
+	}
+
+	res := finalize(4, 0)
+	s.Require().Equal([]uint64{3}, heights(res))
+	s.Require().Equal(uint64(4), res.FinalizedHeight)
+	s.Require().False(res.HasMore)
+	s.Require().Equal(commontypes.EncodePacketKey(packets[0].RollappPacketKey()), res.Results[0].PacketKey)
+
+	res = finalize(0, 1)
+	s.Require().Equal([]uint64{5}, heights(res))
+	s.Require().Equal(uint64(10), res.FinalizedHeight)
+	s.Require().True(res.HasMore)
+
+	res = finalize(0, 0)
+	s.Require().Equal([]uint64{8}, heights(res))
+	s.Require().False(res.HasMore)
+	s.Require().Empty(finalize(0, 0).Results)
+
+	for i, p := range packets {
+		status := commontypes.Status_FINALIZED
+		if i == len(packets)-1 {
+			status = commontypes.Status_PENDING
+		}
+		_, err := s.App.DelayedAckKeeper.GetRollappPacket(s.Ctx, string(commontypes.RollappPacketKey(
+			status, p.RollappId, p.ProofHeight, p.Type, p.Packet.SourceChannel, p.Packet.Sequence,
+		)))
+		s.Require().NoError(err)
+	}
+}
+
+func (s *DelayedAckTestSuite) TestFinalizePacketsGasBound() {
+	rollapp := "rollapp_1234-1"
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      10,
+		Status:         commontypes.Status_FINALIZED,
+		Sequencer:      proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+	for seq := uint64(1); seq <= 4; seq++ {
+		s.App.DelayedAckKeeper.SetRollappPacket(s.Ctx, commontypes.RollappPacket{
+			RollappId:   rollapp,
+			Status:      commontypes.Status_PENDING,
+			ProofHeight: seq,
+			Packet:      apptesting.GenerateTestPacket(s.T(), seq),
+		})
+	}
+
+	// measure one packet on a throwaway branch
+	k := s.App.DelayedAckKeeper
+	ibc := s.App.DelayedAckMiddleware.NextIBCMiddleware()
+	measure, _ := s.Ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).CacheContext()
+	_, _, _, err := k.FinalizeRollappPackets(measure, ibc, rollapp, 0, 1)
+	s.Require().NoError(err)
+	perPacket := measure.GasMeter().GasConsumed()
+
+	// enough gas for two packets and a half: the third is not started
+	ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(perPacket * 5 / 2))
+	results, _, hasMore, err := k.FinalizeRollappPackets(ctx, ibc, rollapp, 0, 4)
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.Require().True(hasMore)
+}
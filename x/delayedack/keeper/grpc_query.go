@@ -72,3 +72,31 @@ func (q Querier) GetPendingPacketsByAddress(goCtx context.Context, req *types.Qu
 		Pagination:     pageResp,
 	}, nil
 }
+
+func (q Querier) FinalizationQueue(goCtx context.Context, req *types.QueryFinalizationQueueRequest) (*types.QueryFinalizationQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var depths []types.FinalizationQueueDepth
+	if req.RollappId != "" {
+		depth, err := q.FinalizationQueueDepth(ctx, req.RollappId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		depths = []types.FinalizationQueueDepth{{RollappId: req.RollappId, Depth: depth}}
+	} else {
+		var err error
+		depths, err = q.FinalizationQueueDepths(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	res := &types.QueryFinalizationQueueResponse{Depths: depths}
+	for _, d := range depths {
+		res.Total += d.Depth
+	}
+	return res, nil
+}
@@ -32,6 +32,12 @@ type Keeper struct {
 	// Index key: receiver address + packet key.
 	pendingPacketsByAddress collections.KeySet[collections.Pair[string, []byte]]
 
+	// finalizationQueue holds the packets of finalized states, finalized in
+	// EndBlock in the order queued. Key: queue sequence + rollapp id + packet key.
+	finalizationQueue      collections.KeySet[collections.Triple[uint64, string, []byte]]
+	finalizationQueueSeq   collections.Sequence
+	finalizationQueueDepth collections.Map[string, uint64]
+
 	rollappKeeper types.RollappKeeper
 	porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
@@ -51,6 +57,7 @@ func NewKeeper(
 	channelKeeper types.ChannelKeeper,
 	eibcKeeper types.EIBCKeeper,
 ) *Keeper {
+	sb := collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey))
 	return &Keeper{
 		cdc:                   cdc,
 		storeKey:              storeKey,
@@ -62,6 +69,24 @@ func NewKeeper(
 			"pending_packets_by_receiver",
 			collections.PairKeyCodec(collections.StringKey, collcodec.NewBytesKey[[]byte]()),
 		),
+		finalizationQueue: collections.NewKeySet(
+			sb,
+			collections.NewPrefix(types.FinalizationQueueKeyPrefix),
+			"finalization_queue",
+			collections.TripleKeyCodec(collections.Uint64Key, collections.StringKey, collcodec.NewBytesKey[[]byte]()),
+		),
+		finalizationQueueSeq: collections.NewSequence(
+			sb,
+			collections.NewPrefix(types.FinalizationQueueSeqKey),
+			"finalization_queue_seq",
+		),
+		finalizationQueueDepth: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.FinalizationQueueDepthKeyPrefix),
+			"finalization_queue_depth",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
 		rollappKeeper:   rollappKeeper,
 		ICS4Wrapper:     ics4Wrapper,
 		channelKeeper:   channelKeeper,
@@ -115,3 +115,39 @@ func (m MsgServer) FinalizePacketByPacketKey(goCtx context.Context, msg *types.M
 
 	return &types.MsgFinalizePacketByPacketKeyResponse{}, nil
 }
+
+func (m MsgServer) FinalizePackets(goCtx context.Context, msg *types.MsgFinalizePackets) (*types.MsgFinalizePacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	results, height, hasMore, err := m.k.FinalizeRollappPackets(ctx, m.ibc.NextIBCMiddleware(), msg.RollappId, msg.MaxProofHeight, msg.Count())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, res := range results {
+		if res.Error != "" {
+			continue
+		}
+		err = uevent.EmitTypedEvent(ctx, &types.EventFinalizePacket{
+			Sender:            msg.Sender,
+			RollappId:         msg.RollappId,
+			PacketProofHeight: res.PacketProofHeight,
+			PacketType:        res.PacketType,
+			PacketSrcChannel:  res.PacketSrcChannel,
+			PacketSequence:    res.PacketSequence,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("emit event: %w", err)
+		}
+	}
+
+	return &types.MsgFinalizePacketsResponse{
+		Results:         results,
+		FinalizedHeight: height,
+		HasMore:         hasMore,
+	}, nil
+}
@@ -107,7 +107,8 @@ func (k Keeper) GetRollappPacket(ctx sdk.Context, rollappPacketKey string) (*com
 }
 
 // UpdateRollappPacketTransferAddress updates the recipient of the underlying packet.
-// Only pending packets can be updated.
+// Only pending packets can be updated. The packet keeps the target it was
+// received with as its original, however many times it is updated.
 func (k Keeper) UpdateRollappPacketTransferAddress(
 	ctx sdk.Context,
 	rollappPacketKey string,
@@ -128,18 +129,18 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 
 	// Set the recipient and sender based on the rollapp packet type
 	var (
-		recipient         = transferPacketData.Receiver
-		sender            = transferPacketData.Sender
-		originalRecipient string
+		recipient        = transferPacketData.Receiver
+		sender           = transferPacketData.Sender
+		currentRecipient string
 	)
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_RECV:
 		// recipient will get credited
-		originalRecipient = recipient
+		currentRecipient = recipient
 		recipient = newRecipient
 	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
 		// sender will get refunded
-		originalRecipient = sender
+		currentRecipient = sender
 		sender = newRecipient
 	}
 
@@ -155,10 +156,12 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 	packet := rollappPacket.Packet
 	packet.Data = newPacketData.GetBytes()
 	rollappPacket.Packet = packet
-	rollappPacket.OriginalTransferTarget = originalRecipient
+	if rollappPacket.OriginalTransferTarget == "" {
+		rollappPacket.OriginalTransferTarget = currentRecipient
+	}
 
 	// Update index: delete the old packet and save the new one
-	k.MustDeletePendingPacketByAddress(ctx, originalRecipient, []byte(rollappPacketKey))
+	k.MustDeletePendingPacketByAddress(ctx, currentRecipient, []byte(rollappPacketKey))
 	k.MustSetPendingPacketByAddress(ctx, newRecipient, rollappPacket.RollappPacketKey())
 
 	k.SetRollappPacket(ctx, *rollappPacket)
@@ -245,6 +245,20 @@ func (suite *DelayedAckTestSuite) TestUpdateRollappPacketTransferAddress_ON_RECV
 	suite.Require().NoError(err)
 	suite.Require().Empty(byReceiverOld)
 
+	// A second update moves the index but keeps the original target
+	const nextReceiver = "nextReceiver"
+	err = keeper.UpdateRollappPacketTransferAddress(ctx, string(packet.RollappPacketKey()), nextReceiver)
+	suite.Require().NoError(err)
+	actualPacket, err = keeper.GetRollappPacket(ctx, string(packet.RollappPacketKey()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(apptesting.TestPacketReceiver, actualPacket.OriginalTransferTarget)
+	byReceiverNew, err = keeper.GetPendingPacketsByAddress(ctx, newReceiver)
+	suite.Require().NoError(err)
+	suite.Require().Empty(byReceiverNew)
+	byReceiverNext, err := keeper.GetPendingPacketsByAddress(ctx, nextReceiver)
+	suite.Require().NoError(err)
+	suite.Require().Equal(1, len(byReceiverNext))
+
 	// Set the packet and make sure there is only one packet in the store
 	keeper.SetRollappPacket(ctx, packet)
 	packets = keeper.GetAllRollappPackets(ctx)
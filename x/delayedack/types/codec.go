@@ -12,6 +12,7 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFinalizePacket{}, "delayedack/FinalizePacket", nil)
 	cdc.RegisterConcrete(&MsgFinalizePacketByPacketKey{}, "delayedack/FinalizeByPacketKey", nil)
+	cdc.RegisterConcrete(&MsgFinalizePackets{}, "delayedack/FinalizePackets", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "delayedack/UpdateParams", nil)
 	cdc.RegisterConcrete(Params{}, "delayedack/Params", nil)
 }
@@ -22,6 +23,7 @@ func RegisterInterfaces(reg types.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgFinalizePacket{},
 		&MsgFinalizePacketByPacketKey{},
+		&MsgFinalizePackets{},
 		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(reg, &_Msg_serviceDesc)
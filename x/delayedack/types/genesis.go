@@ -1,5 +1,10 @@
 package types
 
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -20,5 +25,15 @@ func (gs GenesisState) Validate() error {
 		}
 		rollappPacketMap[string(rollappPacket.RollappPacketKey())] = struct{}{}
 	}
+	seqs := make(map[uint64]struct{})
+	for _, entry := range gs.FinalizationQueue {
+		if entry.RollappId == "" || len(entry.PacketKey) == 0 {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "finalization queue entry %d: empty rollapp id or packet key", entry.Seq)
+		}
+		if _, ok := seqs[entry.Seq]; ok {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "finalization queue entry %d: duplicate seq", entry.Seq)
+		}
+		seqs[entry.Seq] = struct{}{}
+	}
 	return gs.Params.ValidateBasic()
 }
@@ -30,6 +30,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// streams are all streams that should exist at genesis
 	RollappPackets []types.RollappPacket `protobuf:"bytes,2,rep,name=rollapp_packets,json=rollappPackets,proto3" json:"rollapp_packets"`
+	// finalization_queue are the packets queued for automatic finalization, in
+	// order
+	FinalizationQueue []FinalizationQueueEntry `protobuf:"bytes,3,rep,name=finalization_queue,json=finalizationQueue,proto3" json:"finalization_queue"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,8 +82,79 @@ func (m *GenesisState) GetRollappPackets() []types.RollappPacket {
 	return nil
 }
 
+func (m *GenesisState) GetFinalizationQueue() []FinalizationQueueEntry {
+	if m != nil {
+		return m.FinalizationQueue
+	}
+	return nil
+}
+
+// FinalizationQueueEntry is a packet of a finalized state waiting for
+// automatic finalization.
+type FinalizationQueueEntry struct {
+	// seq orders the entries
+	Seq       uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	PacketKey []byte `protobuf:"bytes,3,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+}
+
+func (m *FinalizationQueueEntry) Reset()         { *m = FinalizationQueueEntry{} }
+func (m *FinalizationQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*FinalizationQueueEntry) ProtoMessage()    {}
+func (*FinalizationQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d8c175b9e6478cc, []int{1}
+}
+func (m *FinalizationQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizationQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizationQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizationQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizationQueueEntry.Merge(m, src)
+}
+func (m *FinalizationQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizationQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizationQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizationQueueEntry proto.InternalMessageInfo
+
+func (m *FinalizationQueueEntry) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *FinalizationQueueEntry) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *FinalizationQueueEntry) GetPacketKey() []byte {
+	if m != nil {
+		return m.PacketKey
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.delayedack.GenesisState")
+	proto.RegisterType((*FinalizationQueueEntry)(nil), "dymensionxyz.dymension.delayedack.FinalizationQueueEntry")
 }
 
 func init() {
@@ -88,24 +162,30 @@ func init() {
 }
 
 var fileDescriptor_1d8c175b9e6478cc = []byte{
-	// 262 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4f, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0x42, 0x70, 0xf4, 0x53, 0x52, 0x73, 0x12, 0x2b,
-	0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x91, 0x35, 0xe8, 0xc1, 0x39, 0x7a, 0x08, 0x0d, 0x52, 0x22, 0xe9,
-	0xf9, 0xe9, 0xf9, 0x60, 0xd5, 0xfa, 0x20, 0x16, 0x44, 0xa3, 0x94, 0x1e, 0x61, 0x9b, 0x0a, 0x12,
-	0x8b, 0x12, 0x73, 0xa1, 0x16, 0x49, 0x19, 0xe1, 0x50, 0x9f, 0x9c, 0x9f, 0x9b, 0x9b, 0x9f, 0xa7,
-	0x5f, 0x94, 0x9f, 0x93, 0x93, 0x58, 0x50, 0x10, 0x5f, 0x90, 0x98, 0x9c, 0x9d, 0x5a, 0x02, 0xd1,
-	0xa3, 0xb4, 0x85, 0x91, 0x8b, 0xc7, 0x1d, 0xe2, 0xdc, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x77,
-	0x2e, 0x36, 0x88, 0xa1, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x9a, 0x7a, 0x04, 0x9d, 0xaf,
-	0x17, 0x00, 0xd6, 0xe0, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xbb, 0x50, 0x34, 0x17,
-	0x3f, 0xaa, 0x8d, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc, 0x46, 0x3a, 0xb8, 0x4c, 0x84, 0xb8,
-	0x53, 0x2f, 0x08, 0xa2, 0x2b, 0x00, 0xac, 0x09, 0x6a, 0x28, 0x5f, 0x11, 0xb2, 0x60, 0xb1, 0x53,
-	0xe0, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x99, 0xa7, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0x81, 0x0c, 0xc3, 0x15, 0x53, 0x65, 0xc6, 0xfa, 0x15, 0xc8, 0x81, 0x58, 0x52, 0x59,
-	0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x10, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x19,
-	0x8d, 0x31, 0xe0, 0x01, 0x00, 0x00,
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0x6a, 0xea, 0x40,
+	0x14, 0xc6, 0x13, 0x23, 0x82, 0xa3, 0xdc, 0x3f, 0xe1, 0x72, 0x09, 0x42, 0xd3, 0xd4, 0x55, 0x0a,
+	0x25, 0x01, 0x5d, 0x94, 0x6e, 0x85, 0x56, 0x4a, 0x37, 0x9a, 0xee, 0xda, 0x85, 0x8c, 0x66, 0x8c,
+	0x83, 0xc9, 0x4c, 0xcc, 0x8c, 0xc5, 0xf1, 0x29, 0xfa, 0x10, 0x7d, 0x18, 0x97, 0x2e, 0xbb, 0x2a,
+	0x45, 0x5f, 0xa4, 0x24, 0x93, 0xd6, 0xb4, 0x54, 0xec, 0x6e, 0xe6, 0xcc, 0xf9, 0x7d, 0xdf, 0x97,
+	0x93, 0x03, 0x5c, 0x5f, 0x44, 0x88, 0x30, 0x4c, 0xc9, 0x42, 0x2c, 0x77, 0x17, 0xd7, 0x47, 0x21,
+	0x14, 0xc8, 0x87, 0xa3, 0xa9, 0x1b, 0x20, 0x82, 0x18, 0x66, 0x4e, 0x9c, 0x50, 0x4e, 0xf5, 0x93,
+	0x22, 0xe0, 0x7c, 0x5c, 0x9c, 0x1d, 0xd0, 0xf8, 0x17, 0xd0, 0x80, 0x66, 0xdd, 0x6e, 0x7a, 0x92,
+	0x60, 0xc3, 0x39, 0xec, 0x14, 0xc3, 0x04, 0x46, 0xb9, 0x51, 0xa3, 0xb5, 0xa7, 0x7f, 0x44, 0xa3,
+	0x88, 0x12, 0x37, 0xa1, 0x61, 0x08, 0xe3, 0x78, 0x10, 0xc3, 0xd1, 0x14, 0x71, 0xc9, 0x34, 0x9f,
+	0x4a, 0xa0, 0xde, 0x95, 0x71, 0x6f, 0x39, 0xe4, 0x48, 0xef, 0x82, 0x8a, 0x14, 0x35, 0x54, 0x4b,
+	0xb5, 0x6b, 0xad, 0x53, 0xe7, 0x60, 0x7c, 0xa7, 0x97, 0x01, 0x9d, 0xf2, 0xea, 0xe5, 0x58, 0xf1,
+	0x72, 0x5c, 0xbf, 0x07, 0xbf, 0x3f, 0x3b, 0x32, 0xa3, 0x64, 0x69, 0x76, 0xad, 0x75, 0xb6, 0x4f,
+	0x51, 0xe6, 0x74, 0x3c, 0x49, 0xf5, 0x32, 0x28, 0x17, 0xfd, 0x95, 0x14, 0x8b, 0x4c, 0x27, 0x40,
+	0x1f, 0x63, 0x02, 0x43, 0xbc, 0x84, 0x1c, 0x53, 0x32, 0x98, 0xcd, 0xd1, 0x1c, 0x19, 0x5a, 0xa6,
+	0x7f, 0xf1, 0x83, 0xc4, 0x57, 0x05, 0xb8, 0x9f, 0xb2, 0x97, 0x84, 0x27, 0x22, 0x37, 0xfb, 0x3b,
+	0xfe, 0xfa, 0xda, 0x9c, 0x80, 0xff, 0xdf, 0x23, 0xfa, 0x1f, 0xa0, 0x31, 0x34, 0xcb, 0x86, 0x55,
+	0xf6, 0xd2, 0xa3, 0x7e, 0x04, 0xc0, 0xfb, 0x87, 0x63, 0xdf, 0x28, 0x59, 0xaa, 0x5d, 0xf5, 0xaa,
+	0x79, 0xe5, 0xda, 0x4f, 0x9f, 0xe5, 0x3c, 0x06, 0x53, 0x24, 0x0c, 0xcd, 0x52, 0xed, 0xba, 0x57,
+	0x95, 0x95, 0x1b, 0x24, 0x3a, 0xfd, 0xd5, 0xc6, 0x54, 0xd7, 0x1b, 0x53, 0x7d, 0xdd, 0x98, 0xea,
+	0xe3, 0xd6, 0x54, 0xd6, 0x5b, 0x53, 0x79, 0xde, 0x9a, 0xca, 0xdd, 0x79, 0x80, 0xf9, 0x64, 0x3e,
+	0x4c, 0xc7, 0xb4, 0x6f, 0x07, 0x1f, 0xda, 0xee, 0xa2, 0xb8, 0x1e, 0x5c, 0xc4, 0x88, 0x0d, 0x2b,
+	0xd9, 0xaf, 0x6e, 0xbf, 0x0d, 0x00, 0x13, 0xc0, 0xe1, 0xf9, 0xba, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -128,6 +208,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FinalizationQueue) > 0 {
+		for iNdEx := len(m.FinalizationQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizationQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.RollappPackets) > 0 {
 		for iNdEx := len(m.RollappPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -155,6 +249,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FinalizationQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizationQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizationQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Seq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -180,6 +316,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FinalizationQueue) > 0 {
+		for _, e := range m.FinalizationQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FinalizationQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Seq != 0 {
+		n += 1 + sovGenesis(uint64(m.Seq))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -285,6 +447,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizationQueue = append(m.FinalizationQueue, FinalizationQueueEntry{})
+			if err := m.FinalizationQueue[len(m.FinalizationQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizationQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizationQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizationQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
+			}
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = append(m.PacketKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PacketKey == nil {
+				m.PacketKey = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
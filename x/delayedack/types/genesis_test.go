@@ -61,6 +61,26 @@ func TestGenesisState_Validate(t *testing.T) {
 				validRollappPacket,
 			}, Params: types.DefaultParams()},
 			valid: false,
+		}, {
+			desc: "valid finalization queue",
+			genState: &types.GenesisState{Params: types.DefaultParams(), FinalizationQueue: []types.FinalizationQueueEntry{
+				{Seq: 0, RollappId: "1", PacketKey: validRollappPacket.RollappPacketKey()},
+				{Seq: 3, RollappId: "1", PacketKey: []byte("key")},
+			}},
+			valid: true,
+		}, {
+			desc: "finalization queue entry without packet key",
+			genState: &types.GenesisState{Params: types.DefaultParams(), FinalizationQueue: []types.FinalizationQueueEntry{
+				{Seq: 0, RollappId: "1"},
+			}},
+			valid: false,
+		}, {
+			desc: "duplicate finalization queue seq",
+			genState: &types.GenesisState{Params: types.DefaultParams(), FinalizationQueue: []types.FinalizationQueueEntry{
+				{Seq: 1, RollappId: "1", PacketKey: []byte("a")},
+				{Seq: 1, RollappId: "2", PacketKey: []byte("b")},
+			}},
+			valid: false,
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -17,4 +17,7 @@ const (
 var (
 	ParamsKey                        = []byte{0x02}
 	PendingPacketsByAddressKeyPrefix = []byte{0x01}
+	FinalizationQueueKeyPrefix       = []byte{0x03}
+	FinalizationQueueSeqKey          = []byte{0x04}
+	FinalizationQueueDepthKeyPrefix  = []byte{0x05}
 )
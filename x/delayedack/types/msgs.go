@@ -15,6 +15,7 @@ import (
 var (
 	_ sdk.Msg = &MsgFinalizePacket{}
 	_ sdk.Msg = &MsgFinalizePacketByPacketKey{}
+	_ sdk.Msg = &MsgFinalizePackets{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -72,6 +73,35 @@ func (m MsgFinalizePacketByPacketKey) MustDecodePacketKey() []byte {
 	return packetKey
 }
 
+// MaxFinalizePacketsCount bounds the packets a single MsgFinalizePackets
+// finalizes.
+const MaxFinalizePacketsCount = 200
+
+func (m MsgFinalizePackets) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	if len(m.RollappId) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("rollappId must be non-empty")
+	}
+	if MaxFinalizePacketsCount < m.MaxCount {
+		return gerrc.ErrInvalidArgument.Wrapf("max count must be at most %d", MaxFinalizePacketsCount)
+	}
+	return nil
+}
+
+// Count returns how many packets the message may finalize.
+func (m MsgFinalizePackets) Count() int {
+	if m.MaxCount == 0 {
+		return MaxFinalizePacketsCount
+	}
+	return int(m.MaxCount)
+}
+
 func (m MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
@@ -38,6 +38,13 @@ type Params struct {
 	// piling up packets that weren't deleted but rather "postponed", to
 	// subsequent epochs.
 	DeletePacketsEpochLimit int32 `protobuf:"varint,3,opt,name=delete_packets_epoch_limit,json=deletePacketsEpochLimit,proto3" json:"delete_packets_epoch_limit,omitempty" yaml:"delete_packets_epoch_limit"`
+	// auto_finalize_max_packets bounds the packets finalized from the
+	// finalization queue each block. Zero turns automatic finalization off:
+	// packets are neither queued nor finalized until a finalize message.
+	AutoFinalizeMaxPackets uint32 `protobuf:"varint,4,opt,name=auto_finalize_max_packets,json=autoFinalizeMaxPackets,proto3" json:"auto_finalize_max_packets,omitempty" yaml:"auto_finalize_max_packets"`
+	// auto_finalize_max_gas bounds the gas spent finalizing queued packets each
+	// block. The packet which crosses it is still finalized. Zero means no bound.
+	AutoFinalizeMaxGas uint64 `protobuf:"varint,5,opt,name=auto_finalize_max_gas,json=autoFinalizeMaxGas,proto3" json:"auto_finalize_max_gas,omitempty" yaml:"auto_finalize_max_gas"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,6 +93,20 @@ func (m *Params) GetDeletePacketsEpochLimit() int32 {
 	return 0
 }
 
+func (m *Params) GetAutoFinalizeMaxPackets() uint32 {
+	if m != nil {
+		return m.AutoFinalizeMaxPackets
+	}
+	return 0
+}
+
+func (m *Params) GetAutoFinalizeMaxGas() uint64 {
+	if m != nil {
+		return m.AutoFinalizeMaxGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.delayedack.Params")
 }
@@ -95,30 +116,34 @@ func init() {
 }
 
 var fileDescriptor_9516cc08de197609 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x52, 0x73, 0x12,
-	0x2b, 0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x91, 0xd5, 0x23, 0x34, 0xeb, 0x21, 0xd4, 0x4b, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x55, 0xeb, 0x83, 0x58, 0x10, 0x8d, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9,
-	0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22, 0xa5, 0xb4, 0x96, 0x89, 0x8b, 0x2d, 0x00, 0x6c, 0x89,
-	0x90, 0x1b, 0x97, 0x40, 0x6a, 0x41, 0x7e, 0x72, 0x46, 0x7c, 0x66, 0x4a, 0x6a, 0x5e, 0x49, 0x66,
-	0x5a, 0x66, 0x6a, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xf4, 0xa7, 0x7b, 0xf2, 0xe2,
-	0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0xe8, 0x2a, 0x94, 0x82, 0xf8, 0xc1, 0x42, 0x9e, 0x70, 0x11,
-	0xa1, 0x42, 0x2e, 0x9e, 0xa4, 0xa2, 0xcc, 0x94, 0xf4, 0xcc, 0xbc, 0xf4, 0xf8, 0xb4, 0xd4, 0x54,
-	0x09, 0x26, 0xb0, 0x19, 0x7e, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0x2f, 0x0d, 0xb1, 0xbe,
-	0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f, 0x3f, 0x37, 0xb1, 0x24, 0x43, 0xcf, 0x27, 0x35, 0x3d, 0x31,
-	0xb9, 0xd2, 0x25, 0x35, 0xf9, 0xd3, 0x3d, 0x79, 0x61, 0x88, 0x35, 0xc8, 0x06, 0x28, 0x5d, 0xda,
-	0xa2, 0x2b, 0x00, 0x75, 0x34, 0x5c, 0x69, 0x10, 0x37, 0x4c, 0x89, 0x5b, 0x6a, 0xaa, 0x50, 0x12,
-	0x97, 0x54, 0x4a, 0x6a, 0x4e, 0x6a, 0x49, 0x6a, 0x7c, 0x41, 0x62, 0x72, 0x76, 0x6a, 0x49, 0x71,
-	0x3c, 0xc4, 0x9d, 0x39, 0x99, 0xb9, 0x99, 0x25, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xac, 0x4e, 0xaa,
-	0x9f, 0xee, 0xc9, 0x2b, 0x42, 0x4c, 0xc7, 0xad, 0x56, 0x29, 0x48, 0x1c, 0x22, 0x19, 0x00, 0x91,
-	0x73, 0x05, 0x49, 0xf9, 0x80, 0x64, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3c, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x1c, 0x11, 0x5b, 0x66, 0xac, 0x5f, 0x81, 0x1c, 0xbb, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x98, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xea,
-	0x4b, 0x2e, 0x0f, 0x02, 0x00, 0x00,
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xcf, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc7, 0x63, 0xd6, 0x4d, 0x22, 0x80, 0x98, 0xc2, 0x9f, 0x65, 0x1d, 0x8a, 0xb3, 0x08, 0xa4,
+	0x5e, 0x48, 0x0e, 0x3b, 0x20, 0xed, 0x18, 0x41, 0x11, 0xd2, 0x40, 0x23, 0xdc, 0xb8, 0x58, 0x6e,
+	0xf2, 0xab, 0x6b, 0x35, 0x8e, 0x43, 0xed, 0xa1, 0x66, 0x4f, 0xc1, 0x91, 0x23, 0x0f, 0xc1, 0x43,
+	0xec, 0x38, 0x71, 0x42, 0x1c, 0x22, 0xd4, 0xbe, 0x41, 0x9e, 0x00, 0x35, 0xee, 0xba, 0xaa, 0xa8,
+	0x37, 0xdb, 0x9f, 0xcf, 0xef, 0xfb, 0xf5, 0xe1, 0x67, 0x87, 0x59, 0x25, 0xa0, 0x50, 0x5c, 0x16,
+	0xd3, 0xea, 0x32, 0x5a, 0x5d, 0xa2, 0x0c, 0x72, 0x5a, 0x41, 0x46, 0xd3, 0x71, 0x54, 0xd2, 0x09,
+	0x15, 0x2a, 0x2c, 0x27, 0x52, 0x4b, 0xe7, 0x78, 0xdd, 0xbf, 0x1d, 0x0e, 0x6f, 0xfd, 0xee, 0x63,
+	0x26, 0x99, 0x6c, 0xed, 0x68, 0x71, 0x32, 0x83, 0xdd, 0xc3, 0x54, 0x2a, 0x21, 0x15, 0x31, 0xc0,
+	0x5c, 0x0c, 0x0a, 0xe6, 0x3b, 0xf6, 0xde, 0x79, 0x5b, 0xe2, 0xf4, 0xed, 0x7d, 0x28, 0x65, 0x3a,
+	0x22, 0x3c, 0x83, 0x42, 0xf3, 0x21, 0x87, 0x89, 0x8b, 0x7c, 0xd4, 0xbb, 0x1b, 0x1f, 0x35, 0x35,
+	0x3e, 0xa8, 0xa8, 0xc8, 0x4f, 0x83, 0x4d, 0x23, 0x48, 0x1e, 0xb6, 0x4f, 0xef, 0x56, 0x2f, 0xce,
+	0x17, 0xfb, 0xfe, 0x60, 0xc2, 0x33, 0xc6, 0x0b, 0x46, 0x86, 0x00, 0xee, 0x9d, 0x36, 0xe3, 0xc3,
+	0x55, 0x8d, 0xad, 0x3f, 0x35, 0x3e, 0x32, 0xf5, 0x2a, 0x1b, 0x87, 0x5c, 0x46, 0x82, 0xea, 0x51,
+	0x78, 0x06, 0x8c, 0xa6, 0xd5, 0x6b, 0x48, 0x9b, 0x1a, 0x3f, 0x32, 0x35, 0xeb, 0x01, 0xc1, 0xaf,
+	0x9f, 0x2f, 0xf7, 0x97, 0x9f, 0x5e, 0xa9, 0xc9, 0xbd, 0x1b, 0xa5, 0x0f, 0xe0, 0x0c, 0xec, 0x6e,
+	0x06, 0x39, 0x68, 0x20, 0x25, 0x4d, 0xc7, 0xa0, 0x15, 0x31, 0xff, 0xcc, 0xb9, 0xe0, 0xda, 0xdd,
+	0xf1, 0x51, 0x6f, 0x37, 0x7e, 0xd1, 0xd4, 0xf8, 0xd8, 0xa4, 0x6f, 0x77, 0x83, 0xe4, 0xc0, 0xc0,
+	0x73, 0xc3, 0xde, 0x2c, 0xd0, 0xd9, 0x82, 0x38, 0xc4, 0x3e, 0xa4, 0x17, 0x5a, 0x92, 0x21, 0x2f,
+	0x68, 0xce, 0x2f, 0x81, 0x08, 0x3a, 0xbd, 0x89, 0x70, 0x3b, 0x3e, 0xea, 0x3d, 0x88, 0x9f, 0x37,
+	0x35, 0xf6, 0x4d, 0xc5, 0x56, 0x35, 0x48, 0x9e, 0x2e, 0x58, 0x7f, 0x89, 0xde, 0xd3, 0xe9, 0xb2,
+	0xca, 0xf9, 0x64, 0x3f, 0xf9, 0x7f, 0x8a, 0x51, 0xe5, 0xee, 0xfa, 0xa8, 0xd7, 0x89, 0xfd, 0xa6,
+	0xc6, 0xcf, 0xb6, 0x85, 0x33, 0xaa, 0x82, 0xc4, 0xd9, 0x08, 0x7e, 0x4b, 0xd5, 0x69, 0xe7, 0xfb,
+	0x0f, 0x6c, 0xc5, 0x1f, 0xaf, 0x66, 0x1e, 0xba, 0x9e, 0x79, 0xe8, 0xef, 0xcc, 0x43, 0xdf, 0xe6,
+	0x9e, 0x75, 0x3d, 0xf7, 0xac, 0xdf, 0x73, 0xcf, 0xfa, 0xfc, 0x8a, 0x71, 0x3d, 0xba, 0x18, 0x84,
+	0xa9, 0x14, 0xd1, 0x96, 0x75, 0xfc, 0x7a, 0x12, 0x4d, 0xd7, 0x77, 0x52, 0x57, 0x25, 0xa8, 0xc1,
+	0x5e, 0xbb, 0x3f, 0x27, 0xff, 0x06, 0x00, 0x7f, 0x5f, 0x02, 0x05, 0xc5, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +166,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoFinalizeMaxGas != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoFinalizeMaxGas))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoFinalizeMaxPackets != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoFinalizeMaxPackets))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DeletePacketsEpochLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DeletePacketsEpochLimit))
 		i--
@@ -192,6 +227,12 @@ func (m *Params) Size() (n int) {
 	if m.DeletePacketsEpochLimit != 0 {
 		n += 1 + sovParams(uint64(m.DeletePacketsEpochLimit))
 	}
+	if m.AutoFinalizeMaxPackets != 0 {
+		n += 1 + sovParams(uint64(m.AutoFinalizeMaxPackets))
+	}
+	if m.AutoFinalizeMaxGas != 0 {
+		n += 1 + sovParams(uint64(m.AutoFinalizeMaxGas))
+	}
 	return n
 }
 
@@ -315,6 +356,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFinalizeMaxPackets", wireType)
+			}
+			m.AutoFinalizeMaxPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoFinalizeMaxPackets |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFinalizeMaxGas", wireType)
+			}
+			m.AutoFinalizeMaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoFinalizeMaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -340,6 +340,156 @@ func (m *QueryPendingPacketByAddressListResponse) GetPagination() *query.PageRes
 	return nil
 }
 
+type QueryFinalizationQueueRequest struct {
+	// optional rollapp id, to get the depth of one rollapp's queue only
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+}
+
+func (m *QueryFinalizationQueueRequest) Reset()         { *m = QueryFinalizationQueueRequest{} }
+func (m *QueryFinalizationQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizationQueueRequest) ProtoMessage()    {}
+func (*QueryFinalizationQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{6}
+}
+func (m *QueryFinalizationQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizationQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizationQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizationQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizationQueueRequest.Merge(m, src)
+}
+func (m *QueryFinalizationQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizationQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizationQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizationQueueRequest proto.InternalMessageInfo
+
+func (m *QueryFinalizationQueueRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+type QueryFinalizationQueueResponse struct {
+	Depths []FinalizationQueueDepth `protobuf:"bytes,1,rep,name=depths,proto3" json:"depths"`
+	// total is the sum of depths
+	Total uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *QueryFinalizationQueueResponse) Reset()         { *m = QueryFinalizationQueueResponse{} }
+func (m *QueryFinalizationQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFinalizationQueueResponse) ProtoMessage()    {}
+func (*QueryFinalizationQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{7}
+}
+func (m *QueryFinalizationQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFinalizationQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFinalizationQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFinalizationQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFinalizationQueueResponse.Merge(m, src)
+}
+func (m *QueryFinalizationQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFinalizationQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFinalizationQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFinalizationQueueResponse proto.InternalMessageInfo
+
+func (m *QueryFinalizationQueueResponse) GetDepths() []FinalizationQueueDepth {
+	if m != nil {
+		return m.Depths
+	}
+	return nil
+}
+
+func (m *QueryFinalizationQueueResponse) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type FinalizationQueueDepth struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Depth     uint64 `protobuf:"varint,2,opt,name=depth,proto3" json:"depth,omitempty"`
+}
+
+func (m *FinalizationQueueDepth) Reset()         { *m = FinalizationQueueDepth{} }
+func (m *FinalizationQueueDepth) String() string { return proto.CompactTextString(m) }
+func (*FinalizationQueueDepth) ProtoMessage()    {}
+func (*FinalizationQueueDepth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{8}
+}
+func (m *FinalizationQueueDepth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizationQueueDepth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizationQueueDepth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizationQueueDepth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizationQueueDepth.Merge(m, src)
+}
+func (m *FinalizationQueueDepth) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizationQueueDepth) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizationQueueDepth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizationQueueDepth proto.InternalMessageInfo
+
+func (m *FinalizationQueueDepth) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *FinalizationQueueDepth) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.delayedack.QueryParamsResponse")
@@ -347,6 +497,9 @@ func init() {
 	proto.RegisterType((*QueryRollappPacketListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketListResponse")
 	proto.RegisterType((*QueryPendingPacketsByAddressRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsByAddressRequest")
 	proto.RegisterType((*QueryPendingPacketByAddressListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketByAddressListResponse")
+	proto.RegisterType((*QueryFinalizationQueueRequest)(nil), "dymensionxyz.dymension.delayedack.QueryFinalizationQueueRequest")
+	proto.RegisterType((*QueryFinalizationQueueResponse)(nil), "dymensionxyz.dymension.delayedack.QueryFinalizationQueueResponse")
+	proto.RegisterType((*FinalizationQueueDepth)(nil), "dymensionxyz.dymension.delayedack.FinalizationQueueDepth")
 }
 
 func init() {
@@ -354,48 +507,55 @@ func init() {
 }
 
 var fileDescriptor_0d5f080aa12bfc36 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x4e, 0x13, 0x41,
-	0x18, 0xef, 0x14, 0xa8, 0x61, 0x48, 0x38, 0x8c, 0x1c, 0x36, 0x1b, 0xb2, 0xe2, 0x1a, 0x05, 0x54,
-	0x66, 0xd2, 0x12, 0xf5, 0x44, 0x0c, 0x24, 0xd0, 0x68, 0x34, 0x81, 0xd5, 0x13, 0x07, 0xcd, 0xb4,
-	0x3b, 0x59, 0x37, 0xb4, 0x3b, 0xcb, 0xce, 0x94, 0xb0, 0x12, 0x2e, 0x5e, 0xf4, 0x48, 0xe2, 0x5b,
-	0xf8, 0x1e, 0x26, 0x9c, 0x0c, 0x89, 0x07, 0x3d, 0x19, 0x03, 0xbe, 0x87, 0x66, 0x67, 0xb6, 0xdb,
-	0x96, 0xb6, 0x74, 0xe9, 0xcd, 0x5b, 0x67, 0xfa, 0xfd, 0xe6, 0xf7, 0x67, 0xbe, 0x6f, 0x16, 0xae,
-	0xb8, 0x71, 0x93, 0x05, 0xc2, 0xe7, 0xc1, 0x61, 0xfc, 0x9e, 0x64, 0x0b, 0xe2, 0xb2, 0x06, 0x8d,
-	0x99, 0x4b, 0xeb, 0x7b, 0x64, 0xbf, 0xc5, 0xa2, 0x18, 0x87, 0x11, 0x97, 0x1c, 0xdd, 0xee, 0x2e,
-	0xc7, 0xd9, 0x02, 0x77, 0xca, 0xcd, 0x39, 0x8f, 0x7b, 0x5c, 0x55, 0x93, 0xe4, 0x97, 0x06, 0x9a,
-	0xf3, 0x1e, 0xe7, 0x5e, 0x83, 0x11, 0x1a, 0xfa, 0x84, 0x06, 0x01, 0x97, 0x54, 0xfa, 0x3c, 0x10,
-	0xe9, 0xbf, 0xf7, 0xeb, 0x5c, 0x34, 0xb9, 0x20, 0x35, 0x2a, 0x98, 0xe6, 0x23, 0x07, 0xe5, 0x1a,
-	0x93, 0xb4, 0x4c, 0x42, 0xea, 0xf9, 0x81, 0x2a, 0x4e, 0x6b, 0xf1, 0x68, 0xc5, 0x21, 0x8d, 0x68,
-	0x33, 0x3b, 0x7b, 0x48, 0x7d, 0x9d, 0x37, 0x9b, 0x3c, 0x20, 0x42, 0x52, 0xd9, 0x6a, 0xd7, 0x56,
-	0xae, 0xae, 0x8d, 0x78, 0xa3, 0x41, 0xc3, 0xf0, 0x6d, 0x48, 0xeb, 0x7b, 0x4c, 0x6a, 0x8c, 0x3d,
-	0x07, 0xd1, 0x4e, 0xa2, 0x78, 0x5b, 0x91, 0x3a, 0x6c, 0xbf, 0xc5, 0x84, 0xb4, 0xdf, 0xc0, 0x9b,
-	0x3d, 0xbb, 0x22, 0xe4, 0x81, 0x60, 0xa8, 0x0a, 0x4b, 0x5a, 0x9c, 0x01, 0x16, 0xc0, 0xd2, 0x4c,
-	0x65, 0x19, 0x8f, 0x0c, 0x14, 0xeb, 0x23, 0x36, 0x26, 0x4f, 0x7f, 0xdd, 0x2a, 0x38, 0x29, 0xdc,
-	0xfe, 0x54, 0x84, 0xa6, 0x22, 0x70, 0xb4, 0xa6, 0x6d, 0x25, 0xa9, 0x4d, 0x8f, 0xe6, 0xe1, 0x74,
-	0x2a, 0xf6, 0x99, 0xab, 0xa8, 0xa6, 0x9d, 0xce, 0x06, 0x5a, 0x83, 0x25, 0x6d, 0xdb, 0x28, 0x2e,
-	0x80, 0xa5, 0xd9, 0xca, 0xdd, 0x61, 0x2a, 0xb4, 0x6f, 0xfc, 0x4a, 0x15, 0x3b, 0x29, 0x08, 0x6d,
-	0xc2, 0x49, 0x19, 0x87, 0xcc, 0x98, 0x50, 0xe0, 0xf2, 0x08, 0x70, 0x8f, 0x40, 0xfc, 0x3a, 0x0e,
-	0x99, 0xa3, 0xe0, 0x68, 0x0b, 0xc2, 0xce, 0xe5, 0x1a, 0x93, 0x2a, 0x8f, 0x7b, 0x58, 0x77, 0x02,
-	0x4e, 0x3a, 0x01, 0xeb, 0xce, 0x4b, 0x3b, 0x01, 0x6f, 0x53, 0x8f, 0xa5, 0xfe, 0x9c, 0x2e, 0xa4,
-	0xfd, 0x15, 0x40, 0xab, 0x3f, 0x8a, 0x17, 0xbe, 0x90, 0x59, 0xec, 0xbb, 0x70, 0x36, 0xea, 0xc9,
-	0xc9, 0x00, 0x0b, 0x13, 0x4b, 0x33, 0x95, 0x87, 0xd7, 0xd1, 0x9e, 0xde, 0xc0, 0xa5, 0x93, 0x50,
-	0xb5, 0xc7, 0x46, 0x51, 0xd9, 0x58, 0x1c, 0x69, 0x43, 0x0b, 0xeb, 0xf1, 0xf1, 0x11, 0xc0, 0x3b,
-	0xba, 0x67, 0x58, 0xe0, 0xfa, 0x81, 0x97, 0x12, 0x6c, 0xc4, 0xeb, 0xae, 0x1b, 0x31, 0x91, 0xdd,
-	0xad, 0x01, 0x6f, 0x50, 0xbd, 0x93, 0xde, 0x6c, 0x7b, 0x79, 0x29, 0xd1, 0xe2, 0xd8, 0x89, 0x7e,
-	0x03, 0x70, 0xb1, 0x5f, 0x49, 0x26, 0xe4, 0xbf, 0x8b, 0xb6, 0x72, 0x32, 0x05, 0xa7, 0x94, 0x21,
-	0xf4, 0x05, 0xc0, 0x92, 0x1e, 0x28, 0xf4, 0x28, 0xc7, 0xec, 0xf5, 0x4f, 0xb6, 0xf9, 0xf8, 0xba,
-	0x30, 0xad, 0xc7, 0x2e, 0x7f, 0xf8, 0xfe, 0xe7, 0x73, 0xf1, 0x01, 0x5a, 0x26, 0x79, 0x1f, 0x30,
-	0xf4, 0x03, 0x40, 0x58, 0x65, 0xb2, 0x1d, 0xc7, 0x5a, 0x5e, 0xe6, 0x81, 0x6f, 0x82, 0xb9, 0x3e,
-	0x16, 0xbc, 0xfb, 0xb2, 0xed, 0xaa, 0xf2, 0xb0, 0x8e, 0x9e, 0xe6, 0xf2, 0xa0, 0xd8, 0xc9, 0x51,
-	0xf6, 0xee, 0x1c, 0x93, 0x23, 0xfd, 0x82, 0x1c, 0xa3, 0xbf, 0x00, 0x9a, 0x89, 0xb3, 0xc1, 0x9d,
-	0x8e, 0xb6, 0x72, 0x67, 0x7c, 0xe5, 0xa8, 0x98, 0xcf, 0xc7, 0x3a, 0x67, 0x60, 0xa3, 0xdb, 0x2f,
-	0x95, 0xf7, 0x2a, 0xda, 0xcc, 0xe3, 0x5d, 0x1f, 0xb7, 0x12, 0xb1, 0x3a, 0xf3, 0x0f, 0x58, 0xb4,
-	0x92, 0x85, 0x91, 0x8e, 0xea, 0xf1, 0xc6, 0xce, 0xe9, 0xb9, 0x05, 0xce, 0xce, 0x2d, 0xf0, 0xfb,
-	0xdc, 0x02, 0x27, 0x17, 0x56, 0xe1, 0xec, 0xc2, 0x2a, 0xfc, 0xbc, 0xb0, 0x0a, 0xbb, 0x4f, 0x3c,
-	0x5f, 0xbe, 0x6b, 0xd5, 0x92, 0x41, 0x19, 0x46, 0x75, 0xb0, 0x4a, 0x0e, 0xbb, 0xf9, 0x92, 0xf7,
-	0x54, 0xd4, 0x4a, 0xea, 0x83, 0xb4, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x19, 0xae, 0x61, 0xeb,
-	0xd4, 0x07, 0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x96, 0x52, 0xc3, 0x90, 0x90, 0x38, 0x12, 0xd3, 0x6c, 0xa0, 0xe2, 0x1a, 0x05, 0xd4,
+	0xee, 0xa4, 0x25, 0x48, 0x3c, 0xa0, 0x96, 0x48, 0x1b, 0x8d, 0x24, 0xb0, 0x9a, 0x98, 0x70, 0xd0,
+	0x4c, 0xbb, 0xe3, 0xb2, 0xa1, 0xdd, 0x59, 0x76, 0xa7, 0x84, 0x85, 0x70, 0xf1, 0xa2, 0x37, 0x4d,
+	0xfc, 0x17, 0xfe, 0x0f, 0x13, 0x4e, 0x86, 0xc4, 0x83, 0x9e, 0x8c, 0x01, 0x4f, 0xfe, 0x09, 0xcd,
+	0xce, 0xcc, 0x2e, 0xad, 0x6d, 0xe9, 0x82, 0x27, 0x6f, 0xcc, 0xec, 0x7b, 0xdf, 0xfb, 0xbe, 0x6f,
+	0xde, 0x7b, 0x14, 0x14, 0xcc, 0xa0, 0x49, 0x1c, 0xdf, 0xa6, 0xce, 0x4e, 0xb0, 0x8b, 0xe2, 0x03,
+	0x32, 0x49, 0x03, 0x07, 0xc4, 0xc4, 0xf5, 0x4d, 0xb4, 0xd5, 0x22, 0x5e, 0xa0, 0xbb, 0x1e, 0x65,
+	0x14, 0x5e, 0x6d, 0x0f, 0xd7, 0xe3, 0x83, 0x7e, 0x12, 0xae, 0x8e, 0x5b, 0xd4, 0xa2, 0x3c, 0x1a,
+	0x85, 0x7f, 0x89, 0x44, 0x75, 0xc2, 0xa2, 0xd4, 0x6a, 0x10, 0x84, 0x5d, 0x1b, 0x61, 0xc7, 0xa1,
+	0x0c, 0x33, 0x9b, 0x3a, 0xbe, 0xfc, 0x7a, 0xb3, 0x4e, 0xfd, 0x26, 0xf5, 0x51, 0x0d, 0xfb, 0x44,
+	0xd4, 0x43, 0xdb, 0xc5, 0x1a, 0x61, 0xb8, 0x88, 0x5c, 0x6c, 0xd9, 0x0e, 0x0f, 0x96, 0xb1, 0xfa,
+	0x60, 0xc6, 0x2e, 0xf6, 0x70, 0x33, 0xc6, 0xee, 0x13, 0x5f, 0xa7, 0xcd, 0x26, 0x75, 0x90, 0xcf,
+	0x30, 0x6b, 0x45, 0xb1, 0xa5, 0xd3, 0x63, 0x3d, 0xda, 0x68, 0x60, 0xd7, 0x7d, 0xe9, 0xe2, 0xfa,
+	0x26, 0x61, 0x22, 0x47, 0x1b, 0x07, 0x70, 0x2d, 0x64, 0xbc, 0xca, 0x8b, 0x1a, 0x64, 0xab, 0x45,
+	0x7c, 0xa6, 0xbd, 0x00, 0x97, 0x3a, 0x6e, 0x7d, 0x97, 0x3a, 0x3e, 0x81, 0x55, 0x90, 0x15, 0xe4,
+	0x72, 0xca, 0x94, 0x32, 0x33, 0x5a, 0x9a, 0xd5, 0x07, 0x1a, 0xaa, 0x0b, 0x88, 0xa5, 0xcc, 0xc1,
+	0xf7, 0x2b, 0x29, 0x43, 0xa6, 0x6b, 0x6f, 0xd3, 0x40, 0xe5, 0x05, 0x0c, 0xc1, 0x69, 0x95, 0x53,
+	0x8a, 0xca, 0xc3, 0x09, 0x30, 0x22, 0xc9, 0x3e, 0x32, 0x79, 0xa9, 0x11, 0xe3, 0xe4, 0x02, 0x2e,
+	0x82, 0xac, 0x90, 0x9d, 0x4b, 0x4f, 0x29, 0x33, 0x63, 0xa5, 0xeb, 0xfd, 0x58, 0x08, 0xdd, 0xfa,
+	0x53, 0x1e, 0x6c, 0xc8, 0x24, 0xb8, 0x0c, 0x32, 0x2c, 0x70, 0x49, 0x6e, 0x88, 0x27, 0x17, 0x07,
+	0x24, 0x77, 0x10, 0xd4, 0x9f, 0x05, 0x2e, 0x31, 0x78, 0x3a, 0xac, 0x00, 0x70, 0xf2, 0xb8, 0xb9,
+	0x0c, 0xf7, 0xe3, 0x86, 0x2e, 0x3a, 0x41, 0x0f, 0x3b, 0x41, 0x17, 0x9d, 0x27, 0x3b, 0x41, 0x5f,
+	0xc5, 0x16, 0x91, 0xfa, 0x8c, 0xb6, 0x4c, 0xed, 0x93, 0x02, 0xf2, 0xdd, 0x56, 0x3c, 0xb1, 0x7d,
+	0x16, 0xdb, 0xbe, 0x0e, 0xc6, 0xbc, 0xf6, 0x8f, 0xa1, 0xfd, 0x43, 0x33, 0xa3, 0xa5, 0xdb, 0x67,
+	0xe1, 0x2e, 0x5f, 0xe0, 0x2f, 0x24, 0x58, 0xed, 0x90, 0x91, 0xe6, 0x32, 0xa6, 0x07, 0xca, 0x10,
+	0xc4, 0x3a, 0x74, 0xbc, 0x51, 0xc0, 0x35, 0xd1, 0x33, 0xc4, 0x31, 0x6d, 0xc7, 0x92, 0x05, 0x96,
+	0x82, 0xb2, 0x69, 0x7a, 0xc4, 0x8f, 0xdf, 0x36, 0x07, 0x2e, 0x60, 0x71, 0x23, 0x5f, 0x36, 0x3a,
+	0xc2, 0x4a, 0x0f, 0x2a, 0xe7, 0x71, 0xf4, 0xb3, 0x02, 0xa6, 0xbb, 0x99, 0xc4, 0x44, 0xfe, 0x3f,
+	0x6b, 0xef, 0x81, 0x49, 0xae, 0xa7, 0x62, 0x3b, 0xb8, 0x61, 0xef, 0xf2, 0xcb, 0xb5, 0x16, 0x69,
+	0x45, 0xea, 0xe1, 0x24, 0x00, 0xd1, 0x70, 0xdb, 0xdd, 0x03, 0xa3, 0xbd, 0x8b, 0x5a, 0xac, 0x07,
+	0x80, 0xf4, 0xe1, 0x39, 0xc8, 0x9a, 0xc4, 0x65, 0x1b, 0x91, 0xfe, 0xbb, 0x09, 0x26, 0xbb, 0x0b,
+	0xed, 0x61, 0x88, 0x10, 0x4d, 0xba, 0x80, 0x83, 0xe3, 0x60, 0x98, 0x51, 0x86, 0x1b, 0x5c, 0x7f,
+	0xc6, 0x10, 0x07, 0x6d, 0x05, 0x5c, 0xee, 0x9d, 0x3d, 0x40, 0x4a, 0x08, 0xc7, 0x81, 0x23, 0x38,
+	0x7e, 0x28, 0xfd, 0xca, 0x82, 0x61, 0x2e, 0x10, 0x7e, 0x54, 0x40, 0x56, 0x6c, 0x1c, 0x38, 0x9f,
+	0x40, 0x42, 0xf7, 0xea, 0x53, 0xef, 0x9c, 0x35, 0x4d, 0x38, 0xa8, 0x15, 0x5f, 0x7f, 0xf9, 0xf9,
+	0x21, 0x7d, 0x0b, 0xce, 0xa2, 0xa4, 0x1b, 0x1e, 0x7e, 0x55, 0x00, 0xa8, 0x12, 0x16, 0xf5, 0xcb,
+	0x62, 0xd2, 0xca, 0x3d, 0x97, 0xa6, 0x5a, 0x3e, 0x57, 0x7a, 0xfb, 0x34, 0x68, 0x55, 0xae, 0xa1,
+	0x0c, 0xef, 0x27, 0xd2, 0xc0, 0xab, 0xa3, 0xbd, 0xf8, 0x71, 0xf6, 0xd1, 0x9e, 0x58, 0xb1, 0xfb,
+	0xf0, 0xb7, 0x02, 0xd4, 0x50, 0x59, 0xef, 0x55, 0x00, 0x2b, 0x89, 0x3d, 0x3e, 0x75, 0x97, 0xa8,
+	0x8f, 0xcf, 0x85, 0xd3, 0x73, 0x13, 0x68, 0x2b, 0x5c, 0x7b, 0x15, 0x2e, 0x27, 0xd1, 0x2e, 0xe0,
+	0x0a, 0x1e, 0xa9, 0x13, 0x7b, 0x9b, 0x78, 0x85, 0xd8, 0x0c, 0xb9, 0xcb, 0xf6, 0xc3, 0xb7, 0xbd,
+	0xd8, 0xd5, 0xe2, 0xf0, 0x41, 0x52, 0xc2, 0xfd, 0x46, 0x5d, 0x2d, 0xff, 0x03, 0x82, 0x54, 0xba,
+	0xc8, 0x95, 0x2e, 0xc0, 0xf9, 0x04, 0x4a, 0x5f, 0xb5, 0xa1, 0x14, 0xb6, 0x42, 0x98, 0xa5, 0xb5,
+	0x83, 0xa3, 0xbc, 0x72, 0x78, 0x94, 0x57, 0x7e, 0x1c, 0xe5, 0x95, 0xf7, 0xc7, 0xf9, 0xd4, 0xe1,
+	0x71, 0x3e, 0xf5, 0xed, 0x38, 0x9f, 0x5a, 0x5f, 0xb0, 0x6c, 0xb6, 0xd1, 0xaa, 0x85, 0x3b, 0xb2,
+	0x1f, 0xf4, 0xf6, 0x1c, 0xda, 0x69, 0xc7, 0x0f, 0xff, 0x95, 0xfa, 0xb5, 0x2c, 0xff, 0x2d, 0x32,
+	0xf7, 0x67, 0x00, 0x60, 0x97, 0xa6, 0x3d, 0xcf, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -416,6 +576,9 @@ type QueryClient interface {
 	GetPackets(ctx context.Context, in *QueryRollappPacketsRequest, opts ...grpc.CallOption) (*QueryRollappPacketListResponse, error)
 	// Queries a list of pending RollappPacket items by rollappID and receiver.
 	GetPendingPacketsByAddress(ctx context.Context, in *QueryPendingPacketsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingPacketByAddressListResponse, error)
+	// FinalizationQueue returns how many packets wait in the automatic
+	// finalization queue, per rollapp.
+	FinalizationQueue(ctx context.Context, in *QueryFinalizationQueueRequest, opts ...grpc.CallOption) (*QueryFinalizationQueueResponse, error)
 }
 
 type queryClient struct {
@@ -453,6 +616,15 @@ func (c *queryClient) GetPendingPacketsByAddress(ctx context.Context, in *QueryP
 	return out, nil
 }
 
+func (c *queryClient) FinalizationQueue(ctx context.Context, in *QueryFinalizationQueueRequest, opts ...grpc.CallOption) (*QueryFinalizationQueueResponse, error) {
+	out := new(QueryFinalizationQueueResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/FinalizationQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -461,6 +633,9 @@ type QueryServer interface {
 	GetPackets(context.Context, *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error)
 	// Queries a list of pending RollappPacket items by rollappID and receiver.
 	GetPendingPacketsByAddress(context.Context, *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error)
+	// FinalizationQueue returns how many packets wait in the automatic
+	// finalization queue, per rollapp.
+	FinalizationQueue(context.Context, *QueryFinalizationQueueRequest) (*QueryFinalizationQueueResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -476,6 +651,9 @@ func (*UnimplementedQueryServer) GetPackets(ctx context.Context, req *QueryRolla
 func (*UnimplementedQueryServer) GetPendingPacketsByAddress(ctx context.Context, req *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsByAddress not implemented")
 }
+func (*UnimplementedQueryServer) FinalizationQueue(ctx context.Context, req *QueryFinalizationQueueRequest) (*QueryFinalizationQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizationQueue not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -535,6 +713,24 @@ func _Query_GetPendingPacketsByAddress_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FinalizationQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFinalizationQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FinalizationQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/FinalizationQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FinalizationQueue(ctx, req.(*QueryFinalizationQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -551,6 +747,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingPacketsByAddress",
 			Handler:    _Query_GetPendingPacketsByAddress_Handler,
 		},
+		{
+			MethodName: "FinalizationQueue",
+			Handler:    _Query_FinalizationQueue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/query.proto",
@@ -804,6 +1004,113 @@ func (m *QueryPendingPacketByAddressListResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFinalizationQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizationQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizationQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFinalizationQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFinalizationQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFinalizationQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Depths) > 0 {
+		for iNdEx := len(m.Depths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Depths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizationQueueDepth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizationQueueDepth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizationQueueDepth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -913,6 +1220,53 @@ func (m *QueryPendingPacketByAddressListResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFinalizationQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFinalizationQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Depths) > 0 {
+		for _, e := range m.Depths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *FinalizationQueueDepth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1566,6 +1920,292 @@ func (m *QueryPendingPacketByAddressListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFinalizationQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizationQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizationQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFinalizationQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFinalizationQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFinalizationQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depths = append(m.Depths, FinalizationQueueDepth{})
+			if err := m.Depths[len(m.Depths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizationQueueDepth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizationQueueDepth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizationQueueDepth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,6 +35,21 @@ func PendingByRollappIDByMaxHeight(
 	}
 }
 
+// PendingByRollappIDByHeightRange lists pending packets with proof heights
+// from fromHeight to toHeight inclusive.
+func PendingByRollappIDByHeightRange(rollappID string, fromHeight, toHeight uint64) RollappPacketListFilter {
+	status := commontypes.Status_PENDING
+	return RollappPacketListFilter{
+		Prefixes: []Prefix{
+			{
+				Start: commontypes.RollappPacketByStatusByRollappIDByProofHeightPrefix(rollappID, status, fromHeight),
+				End:   commontypes.RollappPacketByStatusByRollappIDByProofHeightPrefix(rollappID, status, toHeight+1), // inclusive end
+			},
+		},
+		FilterFunc: bypassFilter,
+	}
+}
+
 func PendingByRollappIDFromHeight(rollappID string, fromHeight uint64) RollappPacketListFilter {
 	return RollappPacketListFilter{
 		Prefixes: []Prefix{
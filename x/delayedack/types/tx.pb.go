@@ -340,6 +340,240 @@ func (m *MsgFinalizePacketByPacketKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFinalizePacketByPacketKeyResponse proto.InternalMessageInfo
 
+// MsgFinalizePackets finalizes the pending packets of a rollapp up to a
+// finalized height.
+type MsgFinalizePackets struct {
+	// Sender is the signer of the message.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// RollappID is the ID of the rollapp.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// MaxProofHeight is the highest proof height of the packets to finalize.
+	// Zero, or a height above the rollapp's latest finalized height, means the
+	// latest finalized height.
+	MaxProofHeight uint64 `protobuf:"varint,3,opt,name=max_proof_height,json=maxProofHeight,proto3" json:"max_proof_height,omitempty"`
+	// MaxCount bounds the number of packets finalized. Zero means the most
+	// allowed, MaxFinalizePacketsCount. Fewer are finalized if the tx runs low
+	// on gas.
+	MaxCount uint32 `protobuf:"varint,4,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
+}
+
+func (m *MsgFinalizePackets) Reset()         { *m = MsgFinalizePackets{} }
+func (m *MsgFinalizePackets) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizePackets) ProtoMessage()    {}
+func (*MsgFinalizePackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{6}
+}
+func (m *MsgFinalizePackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizePackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizePackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizePackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizePackets.Merge(m, src)
+}
+func (m *MsgFinalizePackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizePackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizePackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizePackets proto.InternalMessageInfo
+
+func (m *MsgFinalizePackets) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFinalizePackets) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgFinalizePackets) GetMaxProofHeight() uint64 {
+	if m != nil {
+		return m.MaxProofHeight
+	}
+	return 0
+}
+
+func (m *MsgFinalizePackets) GetMaxCount() uint32 {
+	if m != nil {
+		return m.MaxCount
+	}
+	return 0
+}
+
+type MsgFinalizePacketsResponse struct {
+	// Results has one entry per packet tried, in the order tried.
+	Results []FinalizePacketResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// FinalizedHeight is the height the packets were finalized up to.
+	FinalizedHeight uint64 `protobuf:"varint,2,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
+	// HasMore is set if pending packets up to FinalizedHeight are left for
+	// another message.
+	HasMore bool `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+}
+
+func (m *MsgFinalizePacketsResponse) Reset()         { *m = MsgFinalizePacketsResponse{} }
+func (m *MsgFinalizePacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizePacketsResponse) ProtoMessage()    {}
+func (*MsgFinalizePacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{7}
+}
+func (m *MsgFinalizePacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizePacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizePacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizePacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizePacketsResponse.Merge(m, src)
+}
+func (m *MsgFinalizePacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizePacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizePacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizePacketsResponse proto.InternalMessageInfo
+
+func (m *MsgFinalizePacketsResponse) GetResults() []FinalizePacketResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *MsgFinalizePacketsResponse) GetFinalizedHeight() uint64 {
+	if m != nil {
+		return m.FinalizedHeight
+	}
+	return 0
+}
+
+func (m *MsgFinalizePacketsResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+// FinalizePacketResult is the outcome of finalizing one packet of a batch.
+type FinalizePacketResult struct {
+	// PacketKey is the base64 encoded key of the pending packet.
+	PacketKey string `protobuf:"bytes,1,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+	// PacketProofHeight height at which the proof was retrieved.
+	PacketProofHeight uint64 `protobuf:"varint,2,opt,name=packet_proof_height,json=packetProofHeight,proto3" json:"packet_proof_height,omitempty"`
+	// PacketType is a type of the packet. Eg, RECV, ACK, TIMEOUT.
+	PacketType types.RollappPacket_Type `protobuf:"varint,3,opt,name=packet_type,json=packetType,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"packet_type,omitempty"`
+	// PacketSrcChannel identifies the channel end on the sending chain.
+	PacketSrcChannel string `protobuf:"bytes,4,opt,name=packet_src_channel,json=packetSrcChannel,proto3" json:"packet_src_channel,omitempty"`
+	// PacketSequence is a sequence number of the packet.
+	PacketSequence uint64 `protobuf:"varint,5,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty"`
+	// Error is why the packet could not be finalized, empty on success. A
+	// failed packet stays pending and its changes are discarded.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FinalizePacketResult) Reset()         { *m = FinalizePacketResult{} }
+func (m *FinalizePacketResult) String() string { return proto.CompactTextString(m) }
+func (*FinalizePacketResult) ProtoMessage()    {}
+func (*FinalizePacketResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{8}
+}
+func (m *FinalizePacketResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FinalizePacketResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FinalizePacketResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FinalizePacketResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FinalizePacketResult.Merge(m, src)
+}
+func (m *FinalizePacketResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *FinalizePacketResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_FinalizePacketResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FinalizePacketResult proto.InternalMessageInfo
+
+func (m *FinalizePacketResult) GetPacketKey() string {
+	if m != nil {
+		return m.PacketKey
+	}
+	return ""
+}
+
+func (m *FinalizePacketResult) GetPacketProofHeight() uint64 {
+	if m != nil {
+		return m.PacketProofHeight
+	}
+	return 0
+}
+
+func (m *FinalizePacketResult) GetPacketType() types.RollappPacket_Type {
+	if m != nil {
+		return m.PacketType
+	}
+	return types.RollappPacket_ON_RECV
+}
+
+func (m *FinalizePacketResult) GetPacketSrcChannel() string {
+	if m != nil {
+		return m.PacketSrcChannel
+	}
+	return ""
+}
+
+func (m *FinalizePacketResult) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+func (m *FinalizePacketResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.delayedack.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.delayedack.MsgUpdateParamsResponse")
@@ -347,6 +581,9 @@ func init() {
 	proto.RegisterType((*MsgFinalizePacketResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketResponse")
 	proto.RegisterType((*MsgFinalizePacketByPacketKey)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketByPacketKey")
 	proto.RegisterType((*MsgFinalizePacketByPacketKeyResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketByPacketKeyResponse")
+	proto.RegisterType((*MsgFinalizePackets)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePackets")
+	proto.RegisterType((*MsgFinalizePacketsResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketsResponse")
+	proto.RegisterType((*FinalizePacketResult)(nil), "dymensionxyz.dymension.delayedack.FinalizePacketResult")
 }
 
 func init() {
@@ -354,45 +591,56 @@ func init() {
 }
 
 var fileDescriptor_604a74c1ca57f5ed = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xce, 0x36, 0x69, 0xa4, 0x6c, 0x7e, 0xa5, 0x7f, 0x97, 0x8a, 0x3a, 0x06, 0x4c, 0x88, 0x10,
-	0x84, 0x08, 0x6c, 0x35, 0x45, 0x20, 0x45, 0x48, 0x88, 0x20, 0x51, 0x10, 0x8a, 0x14, 0x5c, 0xb8,
-	0x70, 0x89, 0x1c, 0x7b, 0x71, 0xac, 0xc4, 0xde, 0xc5, 0xeb, 0x54, 0x71, 0x0f, 0x08, 0x21, 0x1e,
-	0x80, 0x67, 0x40, 0xe2, 0x5e, 0x21, 0x6e, 0xbc, 0x40, 0x8f, 0x15, 0x27, 0x4e, 0x08, 0x25, 0x87,
-	0xbe, 0x06, 0xb2, 0x77, 0x9d, 0x34, 0xa9, 0xd2, 0x96, 0x72, 0xb2, 0x67, 0xbe, 0x99, 0x6f, 0x3e,
-	0x7f, 0x3b, 0x5e, 0x58, 0xb5, 0x42, 0x17, 0x7b, 0xcc, 0x21, 0xde, 0x30, 0xdc, 0xd5, 0x26, 0x81,
-	0x66, 0xe1, 0xbe, 0x11, 0x62, 0xcb, 0x30, 0x7b, 0x5a, 0x30, 0x54, 0xa9, 0x4f, 0x02, 0x82, 0xae,
-	0x1d, 0xad, 0x55, 0x27, 0x81, 0x3a, 0xad, 0x95, 0xd7, 0x4d, 0xc2, 0x5c, 0xc2, 0x34, 0x97, 0xd9,
-	0xda, 0xce, 0x46, 0xf4, 0xe0, 0xbd, 0x72, 0x91, 0x03, 0xed, 0x38, 0xd2, 0x78, 0x20, 0xa0, 0x35,
-	0x9b, 0xd8, 0x84, 0xe7, 0xa3, 0x37, 0x91, 0xad, 0x2d, 0x10, 0x66, 0x12, 0xd7, 0x25, 0x9e, 0xe6,
-	0x93, 0x7e, 0xdf, 0xa0, 0xb4, 0x4d, 0x0d, 0xb3, 0x87, 0x03, 0xd1, 0xa3, 0x9e, 0xfe, 0x31, 0xd4,
-	0xf0, 0x0d, 0x57, 0x4c, 0x2e, 0x7f, 0x06, 0x70, 0xa5, 0xc9, 0xec, 0x57, 0xd4, 0x32, 0x02, 0xdc,
-	0x8a, 0x11, 0x74, 0x0f, 0xe6, 0x8c, 0x41, 0xd0, 0x25, 0xbe, 0x13, 0x84, 0x12, 0x28, 0x81, 0x4a,
-	0xae, 0x21, 0xfd, 0xf8, 0x76, 0x67, 0x4d, 0x48, 0x7e, 0x64, 0x59, 0x3e, 0x66, 0x6c, 0x3b, 0xf0,
-	0x1d, 0xcf, 0xd6, 0xa7, 0xa5, 0x68, 0x0b, 0x66, 0x39, 0xb7, 0xb4, 0x54, 0x02, 0x95, 0x7c, 0xed,
-	0x96, 0x7a, 0xaa, 0x5b, 0x2a, 0x1f, 0xd9, 0xc8, 0xec, 0xff, 0xba, 0x9a, 0xd2, 0x45, 0x7b, 0xbd,
-	0xf0, 0xe1, 0x70, 0xaf, 0x3a, 0x25, 0x2e, 0x17, 0xe1, 0xfa, 0x9c, 0x46, 0x1d, 0x33, 0x4a, 0x3c,
-	0x86, 0xcb, 0x5f, 0x97, 0xe0, 0x6a, 0x93, 0xd9, 0x4f, 0x1c, 0xcf, 0xe8, 0x3b, 0xbb, 0xb8, 0x15,
-	0x7b, 0x81, 0x2e, 0xc2, 0x2c, 0xc3, 0x9e, 0x85, 0x7d, 0x2e, 0x5f, 0x17, 0x11, 0xba, 0x02, 0x61,
-	0xe2, 0x9a, 0x63, 0xc5, 0x2a, 0x73, 0x7a, 0x4e, 0x64, 0x9e, 0x59, 0x48, 0x85, 0x17, 0xb8, 0x99,
-	0xd1, 0x19, 0x91, 0x37, 0xed, 0x2e, 0x76, 0xec, 0x6e, 0x20, 0xa5, 0x4b, 0xa0, 0x92, 0xd1, 0x57,
-	0x39, 0xd4, 0x8a, 0x90, 0xa7, 0x31, 0x80, 0x74, 0x98, 0x17, 0xf5, 0x41, 0x48, 0xb1, 0x94, 0x29,
-	0x81, 0x4a, 0xa1, 0xb6, 0xb1, 0xe8, 0xab, 0xf9, 0xb1, 0xa9, 0x3a, 0x1f, 0xc7, 0x95, 0xaa, 0x2f,
-	0x43, 0x8a, 0x75, 0xc8, 0x59, 0xa2, 0x77, 0x74, 0x1b, 0x22, 0xc1, 0xc9, 0x7c, 0xb3, 0x6d, 0x76,
-	0x0d, 0xcf, 0xc3, 0x7d, 0x69, 0x39, 0x96, 0xfa, 0x3f, 0x47, 0xb6, 0x7d, 0xf3, 0x31, 0xcf, 0xa3,
-	0x9b, 0x70, 0x25, 0xa9, 0xc6, 0x6f, 0x07, 0xd8, 0x33, 0xb1, 0x94, 0x8d, 0xd5, 0x16, 0x44, 0xa9,
-	0xc8, 0xd6, 0xf3, 0x91, 0xa5, 0xc2, 0x86, 0xf2, 0x25, 0x58, 0x3c, 0xe6, 0xd9, 0xc4, 0xd1, 0x0e,
-	0xbc, 0x7c, 0x0c, 0x6c, 0x84, 0xfc, 0xf9, 0x1c, 0x87, 0x27, 0x79, 0x2b, 0xa4, 0xf4, 0x70, 0x98,
-	0x78, 0x4b, 0x93, 0xb6, 0x59, 0x01, 0x37, 0xe0, 0xf5, 0x93, 0x66, 0x24, 0x5a, 0x6a, 0xdf, 0xd3,
-	0x30, 0xdd, 0x64, 0x36, 0x7a, 0x07, 0xff, 0x9b, 0xd9, 0xd0, 0xda, 0x19, 0x36, 0x6b, 0x6e, 0x63,
-	0xe4, 0xfa, 0xdf, 0xf7, 0x24, 0x3a, 0xd0, 0x47, 0x00, 0x0b, 0x73, 0x2b, 0x76, 0xf7, 0x6c, 0x74,
-	0xb3, 0x5d, 0xf2, 0x83, 0xf3, 0x74, 0x4d, 0x64, 0x7c, 0x01, 0xb0, 0xb8, 0xf8, 0x60, 0x1e, 0x9e,
-	0x87, 0xfb, 0x08, 0x81, 0xbc, 0xf5, 0x8f, 0x04, 0x89, 0x4e, 0x79, 0xf9, 0xfd, 0xe1, 0x5e, 0x15,
-	0x34, 0x5e, 0xec, 0x8f, 0x14, 0x70, 0x30, 0x52, 0xc0, 0xef, 0x91, 0x02, 0x3e, 0x8d, 0x95, 0xd4,
-	0xc1, 0x58, 0x49, 0xfd, 0x1c, 0x2b, 0xa9, 0xd7, 0xf7, 0x6d, 0x27, 0xe8, 0x0e, 0x3a, 0xd1, 0x2f,
-	0xa1, 0x2d, 0xb8, 0xb0, 0x76, 0x36, 0xb5, 0xe1, 0xcc, 0x15, 0x1c, 0x52, 0xcc, 0x3a, 0xd9, 0xf8,
-	0xd6, 0xda, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe0, 0x03, 0x99, 0xb4, 0x05, 0x00, 0x00,
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xdf, 0x6b, 0xd3, 0x5a,
+	0x1c, 0xef, 0xe9, 0xaf, 0xad, 0xa7, 0xf7, 0xb6, 0x5b, 0x6e, 0xb9, 0x4b, 0xb3, 0x7b, 0x7b, 0x7b,
+	0x8b, 0x68, 0x37, 0x34, 0x61, 0x9d, 0x3a, 0x18, 0x8a, 0xd8, 0x81, 0x53, 0xa4, 0x30, 0x33, 0x45,
+	0xf0, 0xa5, 0x64, 0xc9, 0x59, 0x1a, 0xd6, 0xe4, 0xc4, 0x9c, 0x74, 0x34, 0x7b, 0x10, 0x11, 0xc1,
+	0x57, 0xff, 0x03, 0x41, 0xd0, 0xe7, 0x21, 0xbe, 0xf9, 0x2c, 0xec, 0x71, 0xf8, 0xe4, 0x93, 0xc8,
+	0xf6, 0xb0, 0x7f, 0x43, 0x92, 0x73, 0x92, 0xad, 0xed, 0xba, 0xd5, 0x8a, 0x4f, 0xcd, 0xf7, 0xe7,
+	0xf9, 0x7c, 0x3f, 0x9f, 0x7c, 0x4f, 0x0a, 0xe7, 0x35, 0xcf, 0x44, 0x16, 0x31, 0xb0, 0xd5, 0xf5,
+	0x76, 0xa4, 0xc8, 0x90, 0x34, 0xd4, 0x56, 0x3c, 0xa4, 0x29, 0xea, 0x96, 0xe4, 0x76, 0x45, 0xdb,
+	0xc1, 0x2e, 0xe6, 0xfe, 0x3f, 0x99, 0x2b, 0x46, 0x86, 0x78, 0x9c, 0x2b, 0xcc, 0xa8, 0x98, 0x98,
+	0x98, 0x48, 0x26, 0xd1, 0xa5, 0xed, 0x05, 0xff, 0x87, 0xd6, 0x0a, 0x45, 0x1a, 0x68, 0x06, 0x96,
+	0x44, 0x0d, 0x16, 0x2a, 0xe8, 0x58, 0xc7, 0xd4, 0xef, 0x3f, 0x31, 0x6f, 0x6d, 0x08, 0x30, 0x15,
+	0x9b, 0x26, 0xb6, 0x24, 0x07, 0xb7, 0xdb, 0x8a, 0x6d, 0x37, 0x6d, 0x45, 0xdd, 0x42, 0x2e, 0xab,
+	0x11, 0xcf, 0x1f, 0xc6, 0x56, 0x1c, 0xc5, 0x64, 0x27, 0x57, 0xde, 0x02, 0x98, 0x6f, 0x10, 0xfd,
+	0x91, 0xad, 0x29, 0x2e, 0x5a, 0x0b, 0x22, 0xdc, 0x75, 0x98, 0x51, 0x3a, 0x6e, 0x0b, 0x3b, 0x86,
+	0xeb, 0xf1, 0xa0, 0x0c, 0xaa, 0x99, 0x3a, 0xff, 0xe5, 0xe3, 0x95, 0x02, 0x83, 0x7c, 0x5b, 0xd3,
+	0x1c, 0x44, 0xc8, 0xba, 0xeb, 0x18, 0x96, 0x2e, 0x1f, 0xa7, 0x72, 0xab, 0x30, 0x4d, 0x7b, 0xf3,
+	0xf1, 0x32, 0xa8, 0x66, 0x6b, 0x73, 0xe2, 0xb9, 0x6c, 0x89, 0xf4, 0xc8, 0x7a, 0x72, 0xef, 0xdb,
+	0x7f, 0x31, 0x99, 0x95, 0x2f, 0xe7, 0x5e, 0x1c, 0xed, 0xce, 0x1f, 0x37, 0xae, 0x14, 0xe1, 0x4c,
+	0x1f, 0x46, 0x19, 0x11, 0x1b, 0x5b, 0x04, 0x55, 0x3e, 0xc4, 0xe1, 0x74, 0x83, 0xe8, 0x77, 0x0c,
+	0x4b, 0x69, 0x1b, 0x3b, 0x68, 0x2d, 0xe0, 0x82, 0xfb, 0x1b, 0xa6, 0x09, 0xb2, 0x34, 0xe4, 0x50,
+	0xf8, 0x32, 0xb3, 0xb8, 0x7f, 0x21, 0x0c, 0x59, 0x33, 0xb4, 0x00, 0x65, 0x46, 0xce, 0x30, 0xcf,
+	0x3d, 0x8d, 0x13, 0xe1, 0x5f, 0x94, 0x4c, 0x5f, 0x23, 0xbc, 0xd9, 0x6c, 0x21, 0x43, 0x6f, 0xb9,
+	0x7c, 0xa2, 0x0c, 0xaa, 0x49, 0x79, 0x9a, 0x86, 0xd6, 0xfc, 0xc8, 0xdd, 0x20, 0xc0, 0xc9, 0x30,
+	0xcb, 0xf2, 0x5d, 0xcf, 0x46, 0x7c, 0xb2, 0x0c, 0xaa, 0xb9, 0xda, 0xc2, 0xb0, 0xa9, 0xa9, 0x6c,
+	0xa2, 0x4c, 0x8f, 0xa3, 0x48, 0xc5, 0x87, 0x9e, 0x8d, 0x64, 0x48, 0xbb, 0xf8, 0xcf, 0xdc, 0x65,
+	0xc8, 0xb1, 0x9e, 0xc4, 0x51, 0x9b, 0x6a, 0x4b, 0xb1, 0x2c, 0xd4, 0xe6, 0x53, 0x01, 0xd4, 0x29,
+	0x1a, 0x59, 0x77, 0xd4, 0x15, 0xea, 0xe7, 0x2e, 0xc1, 0x7c, 0x98, 0x8d, 0x9e, 0x76, 0x90, 0xa5,
+	0x22, 0x3e, 0x1d, 0xa0, 0xcd, 0xb1, 0x54, 0xe6, 0x5d, 0xce, 0xfa, 0x94, 0x32, 0x1a, 0x2a, 0xb3,
+	0xb0, 0x38, 0xc0, 0x59, 0xc4, 0xe8, 0x06, 0xfc, 0x67, 0x20, 0x58, 0xf7, 0xe8, 0xef, 0x7d, 0xe4,
+	0x9d, 0xc5, 0x2d, 0x83, 0xb2, 0x85, 0xbc, 0x90, 0x5b, 0x3b, 0x2c, 0xeb, 0x05, 0x70, 0x11, 0x5e,
+	0x38, 0xeb, 0x8c, 0x08, 0xcb, 0x1b, 0x00, 0xb9, 0x81, 0x44, 0x32, 0xae, 0xbc, 0x55, 0x38, 0x65,
+	0x2a, 0xdd, 0xd3, 0xb4, 0xcd, 0x99, 0x4a, 0xf7, 0xa4, 0xb0, 0xb3, 0x30, 0xe3, 0x67, 0xaa, 0xb8,
+	0x63, 0xb9, 0x81, 0xac, 0x7f, 0xca, 0x93, 0xa6, 0xd2, 0x5d, 0xf1, 0xed, 0xde, 0x49, 0x3e, 0x01,
+	0x28, 0x0c, 0x22, 0x0c, 0x07, 0xe0, 0x1e, 0xc3, 0x09, 0x07, 0x91, 0x4e, 0xdb, 0x25, 0x3c, 0x28,
+	0x27, 0xaa, 0xd9, 0xda, 0xd2, 0x08, 0x3b, 0x31, 0x20, 0x4c, 0xa7, 0xed, 0xb2, 0x0d, 0x09, 0xbb,
+	0x71, 0x73, 0x70, 0x6a, 0x93, 0xa5, 0x69, 0xe1, 0x2c, 0xf1, 0x60, 0x96, 0x7c, 0xe4, 0x67, 0xc3,
+	0x14, 0xe1, 0x64, 0x4b, 0x21, 0x4d, 0x13, 0x3b, 0x28, 0x18, 0x77, 0x52, 0x9e, 0x68, 0x29, 0xa4,
+	0x81, 0x1d, 0x54, 0x79, 0x1f, 0x87, 0x85, 0xd3, 0x4e, 0xeb, 0x13, 0x13, 0xf4, 0x89, 0x39, 0x6c,
+	0x51, 0xe2, 0x23, 0x2e, 0x4a, 0xe2, 0xf7, 0x2d, 0x4a, 0x72, 0xf4, 0x45, 0x49, 0x9d, 0xb6, 0x28,
+	0x5c, 0x01, 0xa6, 0x90, 0xe3, 0x60, 0x27, 0xd8, 0xa3, 0x8c, 0x4c, 0x8d, 0xda, 0xe7, 0x24, 0x4c,
+	0x34, 0x88, 0xce, 0x3d, 0x83, 0x7f, 0xf4, 0x5c, 0x95, 0xb5, 0x11, 0xe4, 0xec, 0xbb, 0xba, 0x84,
+	0xe5, 0x9f, 0xaf, 0x89, 0xde, 0xa7, 0x97, 0x00, 0xe6, 0xfa, 0xee, 0xba, 0xab, 0xa3, 0xb5, 0xeb,
+	0xad, 0x12, 0x6e, 0x8c, 0x53, 0x15, 0xc1, 0x78, 0x07, 0x60, 0x71, 0xf8, 0x0d, 0x71, 0x6b, 0x9c,
+	0xde, 0x27, 0x1a, 0x08, 0xab, 0xbf, 0xd8, 0x20, 0xc2, 0xf9, 0x0a, 0xc0, 0x7c, 0xff, 0xe5, 0x71,
+	0x6d, 0x9c, 0xe6, 0x44, 0xb8, 0x39, 0x56, 0x59, 0x88, 0x44, 0x48, 0x3d, 0x3f, 0xda, 0x9d, 0x07,
+	0xf5, 0x07, 0x7b, 0x07, 0x25, 0xb0, 0x7f, 0x50, 0x02, 0xdf, 0x0f, 0x4a, 0xe0, 0xf5, 0x61, 0x29,
+	0xb6, 0x7f, 0x58, 0x8a, 0x7d, 0x3d, 0x2c, 0xc5, 0x9e, 0x2c, 0xe9, 0x86, 0xdb, 0xea, 0x6c, 0xf8,
+	0x2f, 0xbf, 0x34, 0xe4, 0x1b, 0xbe, 0xbd, 0x28, 0x75, 0x7b, 0xfe, 0x95, 0x78, 0x36, 0x22, 0x1b,
+	0xe9, 0xe0, 0x43, 0xbe, 0xf8, 0x63, 0x00, 0xae, 0x54, 0xc6, 0x7d, 0xc7, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -412,6 +660,9 @@ type MsgClient interface {
 	// FinalizePacket finalizes a single packet.
 	FinalizePacket(ctx context.Context, in *MsgFinalizePacket, opts ...grpc.CallOption) (*MsgFinalizePacketResponse, error)
 	FinalizePacketByPacketKey(ctx context.Context, in *MsgFinalizePacketByPacketKey, opts ...grpc.CallOption) (*MsgFinalizePacketByPacketKeyResponse, error)
+	// FinalizePackets finalizes the pending packets of a rollapp up to a
+	// finalized height, lowest proof height first.
+	FinalizePackets(ctx context.Context, in *MsgFinalizePackets, opts ...grpc.CallOption) (*MsgFinalizePacketsResponse, error)
 }
 
 type msgClient struct {
@@ -449,6 +700,15 @@ func (c *msgClient) FinalizePacketByPacketKey(ctx context.Context, in *MsgFinali
 	return out, nil
 }
 
+func (c *msgClient) FinalizePackets(ctx context.Context, in *MsgFinalizePackets, opts ...grpc.CallOption) (*MsgFinalizePacketsResponse, error) {
+	out := new(MsgFinalizePacketsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Msg/FinalizePackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
@@ -456,6 +716,9 @@ type MsgServer interface {
 	// FinalizePacket finalizes a single packet.
 	FinalizePacket(context.Context, *MsgFinalizePacket) (*MsgFinalizePacketResponse, error)
 	FinalizePacketByPacketKey(context.Context, *MsgFinalizePacketByPacketKey) (*MsgFinalizePacketByPacketKeyResponse, error)
+	// FinalizePackets finalizes the pending packets of a rollapp up to a
+	// finalized height, lowest proof height first.
+	FinalizePackets(context.Context, *MsgFinalizePackets) (*MsgFinalizePacketsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -471,6 +734,9 @@ func (*UnimplementedMsgServer) FinalizePacket(ctx context.Context, req *MsgFinal
 func (*UnimplementedMsgServer) FinalizePacketByPacketKey(ctx context.Context, req *MsgFinalizePacketByPacketKey) (*MsgFinalizePacketByPacketKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizePacketByPacketKey not implemented")
 }
+func (*UnimplementedMsgServer) FinalizePackets(ctx context.Context, req *MsgFinalizePackets) (*MsgFinalizePacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizePackets not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -530,6 +796,24 @@ func _Msg_FinalizePacketByPacketKey_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FinalizePackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizePackets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizePackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Msg/FinalizePackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizePackets(ctx, req.(*MsgFinalizePackets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -546,6 +830,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizePacketByPacketKey",
 			Handler:    _Msg_FinalizePacketByPacketKey_Handler,
 		},
+		{
+			MethodName: "FinalizePackets",
+			Handler:    _Msg_FinalizePackets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/tx.proto",
@@ -756,85 +1044,243 @@ func (m *MsgFinalizePacketByPacketKeyResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgFinalizePackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgFinalizePackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgFinalizePacket) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgFinalizePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.PacketProofHeight != 0 {
-		n += 1 + sovTx(uint64(m.PacketProofHeight))
+	if m.MaxCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxCount))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.PacketType != 0 {
-		n += 1 + sovTx(uint64(m.PacketType))
+	if m.MaxProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxProofHeight))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.PacketSrcChannel)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.PacketSequence != 0 {
-		n += 1 + sovTx(uint64(m.PacketSequence))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgFinalizePacketResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgFinalizePacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgFinalizePacketByPacketKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *MsgFinalizePacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizePacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HasMore {
+		i--
+		if m.HasMore {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FinalizedHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FinalizedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FinalizePacketResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FinalizePacketResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FinalizePacketResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PacketSequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PacketSrcChannel) > 0 {
+		i -= len(m.PacketSrcChannel)
+		copy(dAtA[i:], m.PacketSrcChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PacketSrcChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PacketType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PacketType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PacketProofHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PacketProofHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.PacketProofHeight))
+	}
+	if m.PacketType != 0 {
+		n += 1 + sovTx(uint64(m.PacketType))
+	}
+	l = len(m.PacketSrcChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovTx(uint64(m.PacketSequence))
+	}
+	return n
+}
+
+func (m *MsgFinalizePacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFinalizePacketByPacketKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Sender)
 	if l > 0 {
@@ -856,6 +1302,80 @@ func (m *MsgFinalizePacketByPacketKeyResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgFinalizePackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.MaxProofHeight))
+	}
+	if m.MaxCount != 0 {
+		n += 1 + sovTx(uint64(m.MaxCount))
+	}
+	return n
+}
+
+func (m *MsgFinalizePacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.FinalizedHeight != 0 {
+		n += 1 + sovTx(uint64(m.FinalizedHeight))
+	}
+	if m.HasMore {
+		n += 2
+	}
+	return n
+}
+
+func (m *FinalizePacketResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketProofHeight != 0 {
+		n += 1 + sovTx(uint64(m.PacketProofHeight))
+	}
+	if m.PacketType != 0 {
+		n += 1 + sovTx(uint64(m.PacketType))
+	}
+	l = len(m.PacketSrcChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovTx(uint64(m.PacketSequence))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1444,6 +1964,484 @@ func (m *MsgFinalizePacketByPacketKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFinalizePackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProofHeight", wireType)
+			}
+			m.MaxProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCount", wireType)
+			}
+			m.MaxCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizePacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, FinalizePacketResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizedHeight", wireType)
+			}
+			m.FinalizedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinalizedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasMore", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasMore = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FinalizePacketResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FinalizePacketResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FinalizePacketResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketProofHeight", wireType)
+			}
+			m.PacketProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			m.PacketType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketType |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
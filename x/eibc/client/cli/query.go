@@ -29,6 +29,8 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListDemandOrdersByStatus())
 	cmd.AddCommand(CmdQueryOnDemandLPs())
 	cmd.AddCommand(CmdQueryOnDemandLPsAddr())
+	cmd.AddCommand(CmdQueryOnDemandLPMatch())
+	cmd.AddCommand(CmdQueryOrderBook())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
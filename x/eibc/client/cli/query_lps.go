@@ -64,3 +64,27 @@ func CmdQueryOnDemandLPsAddr() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryOnDemandLPMatch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lps-demand-match [order-id]",
+		Short: "Preview which on demand lps would fulfill an order if matched now",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := &types.QueryOnDemandLPMatchRequest{OrderId: args[0]}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OnDemandLPMatch(cmd.Context(), m)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
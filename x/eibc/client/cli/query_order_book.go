@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func CmdQueryOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order-book [rollapp-id] [denom]",
+		Short: "Query the outstanding demand orders of a rollapp, best paying first",
+		Long: `Query the outstanding eIBC demand orders of a rollapp in a denom, sorted by
+their effective fee rate, highest first. Each order shows its escalated fee,
+the unfilled price, the cumulative depth and the profit expected from
+fulfilling it after the bridging fee.
+
+Optional flags:
+  --fee-height  Height to evaluate escalating fees at (default: current height)
+  --limit       Maximum number of orders to return (depth and profit cover all)
+
+Example:
+  dymd query eibc order-book rollapp_1234-1 ibc/9A1EACD53A6A197ADC81DF9A49F0C4A26F7FF685ACF415EE726D7D59796E71A7 --limit 10`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			height, err := cmd.Flags().GetUint64("fee-height")
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint32("limit")
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.OrderBook(cmd.Context(), &types.QueryOrderBookRequest{
+				RollappId: args[0],
+				Denom:     args[1],
+				Height:    height,
+				Limit:     limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64("fee-height", 0, "Height to evaluate escalating fees at")
+	cmd.Flags().Uint32("limit", 0, "Maximum number of orders to return")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -31,6 +31,9 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(NewCmdTryFulfillOnDemand())
 	cmd.AddCommand(NewCmdCreateOnDemandLP())
 	cmd.AddCommand(NewCmdDeleteOnDemandLP())
+	cmd.AddCommand(NewCmdListOrderClaim())
+	cmd.AddCommand(NewCmdDelistOrderClaim())
+	cmd.AddCommand(NewCmdBuyOrderClaim())
 	return cmd
 }
 
@@ -41,6 +44,7 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 		Example: "dymd tx eibc fulfill-order <order-id> <expected-fee-amount>",
 		Long: `Fulfill a new eibc order by providing the order ID and the expected fee amount.
 		The expected fee amount is the amount of fee that the user expects to pay for fulfilling the order.
+		Use --amount to pay only part of the order's unfilled price.
 		`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -56,6 +60,10 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 				orderId,
 				fee,
 			)
+			msg.Amount, err = cmd.Flags().GetString(FlagAmount)
+			if err != nil {
+				return err
+			}
 
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -65,19 +73,24 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagAmount, "", "Part of the unfilled price to pay; empty pays all of it")
 
 	return cmd
 }
 
 const (
-	FlagOperatorFeeAddress = "operator-fee-address"
-	FlagRollappId          = "rollapp-id"
-	FlagPrice              = "price"
-	FlagAmount             = "amount"
-	FlagValidUntilHeight   = "valid-until-height"
-	FlagRateLimitAmount    = "rate-limit-amount"
-	FlagRateLimitBlocks    = "rate-limit-blocks"
-	FlagMinFeeAbsolute     = "min-fee-absolute"
+	FlagOperatorFeeAddress     = "operator-fee-address"
+	FlagRollappId              = "rollapp-id"
+	FlagPrice                  = "price"
+	FlagAmount                 = "amount"
+	FlagValidUntilHeight       = "valid-until-height"
+	FlagRateLimitAmount        = "rate-limit-amount"
+	FlagRateLimitBlocks        = "rate-limit-blocks"
+	FlagMinFeeAbsolute         = "min-fee-absolute"
+	FlagMaxStateUpdateAge      = "max-state-update-age"
+	FlagSkipOnHardFork         = "skip-on-hard-fork"
+	FlagSkipOnProposerChange   = "skip-on-proposer-change"
+	FlagRequireCanonicalClient = "require-canonical-client"
 )
 
 func NewFulfillOrderAuthorizedTxCmd() *cobra.Command {
@@ -308,6 +321,11 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 				return fmt.Errorf("invalid min fee absolute")
 			}
 
+			health, err := healthConditionsFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCreateOnDemandLP{
 				Lp: &types.OnDemandLP{
 					FundsAddr:         clientCtx.GetFromAddress().String(),
@@ -321,6 +339,7 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 					RateLimitAmount:   rateLimitAmount,
 					RateLimitBlocks:   rateLimitBlocks,
 					MinFeeAbsolute:    minFeeAbsolute,
+					Health:            health,
 				},
 				Signer: clientCtx.GetFromAddress().String(),
 			}
@@ -337,10 +356,38 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 	cmd.Flags().String(FlagRateLimitAmount, "", "Max amount spendable within one rate window (empty = disabled)")
 	cmd.Flags().Uint64(FlagRateLimitBlocks, 0, "Length in blocks of the rate window")
 	cmd.Flags().String(FlagMinFeeAbsolute, "0", "Absolute minimum fee (in the LP denom) an order must offer (0 = disabled)")
+	cmd.Flags().Uint64(FlagMaxStateUpdateAge, 0, "Skip the rollapp if its latest state update is older than this many blocks (0 = disabled)")
+	cmd.Flags().Bool(FlagSkipOnHardFork, false, "Skip the rollapp while it recovers from a hard fork")
+	cmd.Flags().Bool(FlagSkipOnProposerChange, false, "Skip the rollapp while its proposer rotates out or may be kicked")
+	cmd.Flags().Bool(FlagRequireCanonicalClient, false, "Skip the rollapp if it has no canonical light client")
 
 	return cmd
 }
 
+// healthConditionsFromFlags returns nil if no health condition is set.
+func healthConditionsFromFlags(cmd *cobra.Command) (*types.LPHealthConditions, error) {
+	var (
+		c   types.LPHealthConditions
+		err error
+	)
+	if c.MaxBlocksSinceStateUpdate, err = cmd.Flags().GetUint64(FlagMaxStateUpdateAge); err != nil {
+		return nil, err
+	}
+	if c.SkipOnHardFork, err = cmd.Flags().GetBool(FlagSkipOnHardFork); err != nil {
+		return nil, err
+	}
+	if c.SkipOnProposerChange, err = cmd.Flags().GetBool(FlagSkipOnProposerChange); err != nil {
+		return nil, err
+	}
+	if c.RequireCanonicalClient, err = cmd.Flags().GetBool(FlagRequireCanonicalClient); err != nil {
+		return nil, err
+	}
+	if c == (types.LPHealthConditions{}) {
+		return nil, nil
+	}
+	return &c, nil
+}
+
 func NewCmdDeleteOnDemandLP() *cobra.Command {
 	short := "Delete on demand lp"
 	cmd := &cobra.Command{
@@ -375,3 +422,100 @@ func NewCmdDeleteOnDemandLP() *cobra.Command {
 
 	return cmd
 }
+
+func NewCmdListOrderClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-claim [order-id] [price]",
+		Short:   "List the claim on a fulfilled demand order for sale",
+		Example: "dymd tx eibc list-claim <order-id> 1000adym",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("parse price: %w", err)
+			}
+
+			msg := &types.MsgListOrderClaim{
+				Seller:  clientCtx.GetFromAddress().String(),
+				OrderId: args[0],
+				Price:   price,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCmdDelistOrderClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "delist-claim [order-id]",
+		Short:   "Withdraw a demand order claim listing",
+		Example: "dymd tx eibc delist-claim <order-id>",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDelistOrderClaim{
+				Seller:  clientCtx.GetFromAddress().String(),
+				OrderId: args[0],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCmdBuyOrderClaim() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "buy-claim [order-id] [price]",
+		Short:   "Buy a listed demand order claim at its listed price",
+		Example: "dymd tx eibc buy-claim <order-id> 1000adym",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return fmt.Errorf("parse price: %w", err)
+			}
+
+			msg := &types.MsgBuyOrderClaim{
+				Buyer:   clientCtx.GetFromAddress().String(),
+				OrderId: args[0],
+				Price:   price,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// claimOrder returns the pending fulfilled order whose claim is traded. Claims
+// on split orders are not tradable: their escrow pays each fulfiller its own
+// share.
+func (k Keeper) claimOrder(ctx sdk.Context, orderID string) (*types.DemandOrder, error) {
+	o, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !o.IsFulfilled() {
+		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "order not fulfilled")
+	}
+	if len(o.Fills) != 0 {
+		return nil, types.ErrDemandOrderPartiallyFilled
+	}
+	return o, nil
+}
+
+// claimHolder returns who the order's packet pays on finalization.
+func (k Keeper) claimHolder(ctx sdk.Context, o *types.DemandOrder) (string, error) {
+	packet, err := k.dack.GetRollappPacket(ctx, o.TrackingPacketKey)
+	if err != nil {
+		return "", errorsmod.Wrap(err, "get rollapp packet")
+	}
+	return packet.TransferTarget()
+}
+
+// ListOrderClaim offers the claim on a fulfilled order for sale. Only the
+// holder, who the packet pays, may list it.
+func (m msgServer) ListOrderClaim(goCtx context.Context, msg *types.MsgListOrderClaim) (*types.MsgListOrderClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	o, err := m.claimOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	holder, err := m.claimHolder(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	if holder != msg.Seller {
+		return nil, errorsmod.Wrapf(gerrc.ErrPermissionDenied, "not the claim holder: require %s, got %s", holder, msg.Seller)
+	}
+
+	o.ClaimListing = &types.ClaimListing{Seller: msg.Seller, Price: msg.Price}
+	if err := m.SetDemandOrder(ctx, o); err != nil {
+		return nil, err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventOrderClaimListed{
+		OrderId: o.Id,
+		Seller:  msg.Seller,
+		Price:   msg.Price,
+	}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgListOrderClaimResponse{}, nil
+}
+
+// DelistOrderClaim withdraws the seller's claim listing.
+func (m msgServer) DelistOrderClaim(goCtx context.Context, msg *types.MsgDelistOrderClaim) (*types.MsgDelistOrderClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	o, err := m.GetDemandOrder(ctx, commontypes.Status_PENDING, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if o.ClaimListing == nil {
+		return nil, types.ErrClaimNotListed
+	}
+	if o.ClaimListing.Seller != msg.Seller {
+		return nil, errorsmod.Wrapf(gerrc.ErrPermissionDenied, "not the seller: require %s, got %s", o.ClaimListing.Seller, msg.Seller)
+	}
+
+	o.ClaimListing = nil
+	if err := m.SetDemandOrder(ctx, o); err != nil {
+		return nil, err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventOrderClaimDelisted{
+		OrderId: o.Id,
+		Seller:  msg.Seller,
+	}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgDelistOrderClaimResponse{}, nil
+}
+
+// BuyOrderClaim pays the seller the listed price and, in the same tx, makes
+// the buyer the order's fulfiller and who its packet pays on finalization.
+func (m msgServer) BuyOrderClaim(goCtx context.Context, msg *types.MsgBuyOrderClaim) (*types.MsgBuyOrderClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	o, err := m.claimOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	l := o.ClaimListing
+	if l == nil {
+		return nil, types.ErrClaimNotListed
+	}
+	if !l.Price.Equal(msg.Price) {
+		return nil, errorsmod.Wrapf(types.ErrPriceMismatch, "listed: %s, got: %s", l.Price, msg.Price)
+	}
+	if l.Seller == msg.Buyer {
+		return nil, errorsmod.Wrap(gerrc.ErrInvalidArgument, "buyer is the seller")
+	}
+	// the listing is dropped whenever the claim moves, so this only guards
+	// against a listing left stale by some other path
+	holder, err := m.claimHolder(ctx, o)
+	if err != nil {
+		return nil, err
+	}
+	if holder != l.Seller {
+		return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "seller no longer holds the claim: %s", l.Seller)
+	}
+
+	buyer := sdk.MustAccAddressFromBech32(msg.Buyer)
+	if m.bk.BlockedAddr(buyer) {
+		return nil, errorsmod.Wrapf(gerrc.ErrPermissionDenied, "blocked buyer: %s", msg.Buyer)
+	}
+	if err := m.bk.SendCoins(ctx, buyer, sdk.MustAccAddressFromBech32(l.Seller), sdk.NewCoins(l.Price)); err != nil {
+		return nil, errorsmod.Wrap(err, "pay seller")
+	}
+	if err := m.dack.UpdateRollappPacketTransferAddress(ctx, o.TrackingPacketKey, msg.Buyer); err != nil {
+		return nil, errorsmod.Wrap(err, "update packet transfer address")
+	}
+
+	o.FulfillerAddress = msg.Buyer
+	o.ClaimListing = nil
+	if err := m.SetDemandOrder(ctx, o); err != nil {
+		return nil, err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventOrderClaimSold{
+		OrderId: o.Id,
+		Seller:  l.Seller,
+		Buyer:   msg.Buyer,
+		Price:   l.Price,
+	}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgBuyOrderClaimResponse{}, nil
+}
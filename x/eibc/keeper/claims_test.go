@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func claimPrice(amount int64) sdk.Coin {
+	return sdk.NewInt64Coin(sdk.DefaultBondDenom, amount)
+}
+
+func (suite *KeeperTestSuite) packetTarget(o *types.DemandOrder) (string, string) {
+	pkt, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, o.TrackingPacketKey)
+	suite.Require().NoError(err)
+	target, err := pkt.TransferTarget()
+	suite.Require().NoError(err)
+	return target, pkt.OriginalTransferTarget
+}
+
+func (suite *KeeperTestSuite) TestOrderClaimSale() {
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1_000))
+	recipient, seller, buyer := addrs[0], addrs[1], addrs[2]
+	orderID := suite.orderWithSeq(1, recipient.String(), math.NewInt(100), math.NewInt(10))
+
+	list := func(from sdk.AccAddress, price int64) error {
+		_, err := suite.msgServer.ListOrderClaim(suite.Ctx, &types.MsgListOrderClaim{Seller: from.String(), OrderId: orderID, Price: claimPrice(price)})
+		return err
+	}
+	buy := func(price int64) error {
+		_, err := suite.msgServer.BuyOrderClaim(suite.Ctx, &types.MsgBuyOrderClaim{Buyer: buyer.String(), OrderId: orderID, Price: claimPrice(price)})
+		return err
+	}
+
+	o, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, orderID)
+	suite.Require().NoError(err)
+	packetRecipient, _ := suite.packetTarget(o)
+
+	// nothing to sell before fulfillment
+	suite.Require().ErrorIs(list(recipient, 105), gerrc.ErrFailedPrecondition)
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(seller.String(), orderID, "10"))
+	suite.Require().NoError(err)
+
+	suite.Require().ErrorIs(list(buyer, 105), gerrc.ErrPermissionDenied)
+	suite.Require().ErrorIs(buy(105), types.ErrClaimNotListed)
+	suite.Require().NoError(list(seller, 108))
+	// relisting replaces the price
+	suite.Require().NoError(list(seller, 105))
+	suite.Require().ErrorIs(buy(108), types.ErrPriceMismatch)
+
+	sellerBefore, buyerBefore := suite.balance(seller), suite.balance(buyer)
+	suite.Require().NoError(buy(105))
+	suite.Require().Equal(sellerBefore.AddRaw(105), suite.balance(seller))
+	suite.Require().Equal(buyerBefore.SubRaw(105), suite.balance(buyer))
+
+	o, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, orderID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(buyer.String(), o.FulfillerAddress)
+	suite.Require().Nil(o.ClaimListing)
+	// the packet pays the buyer, and still knows its original recipient
+	target, original := suite.packetTarget(o)
+	suite.Require().Equal(buyer.String(), target)
+	suite.Require().Equal(packetRecipient, original)
+	suite.Require().ErrorIs(list(seller, 105), gerrc.ErrPermissionDenied)
+
+	// the listing is dropped once the packet settles
+	_, err = suite.msgServer.ListOrderClaim(suite.Ctx, &types.MsgListOrderClaim{Seller: buyer.String(), OrderId: orderID, Price: claimPrice(107)})
+	suite.Require().NoError(err)
+	suite.finalizeOrder(orderID, math.NewInt(110))
+	o, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_FINALIZED, orderID)
+	suite.Require().NoError(err)
+	suite.Require().Nil(o.ClaimListing)
+	suite.Require().Equal(buyerBefore.SubRaw(105).AddRaw(110), suite.balance(buyer))
+}
+
+func (suite *KeeperTestSuite) TestDelistOrderClaim() {
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1_000))
+	recipient, seller, other := addrs[0], addrs[1], addrs[2]
+	orderID := suite.orderWithSeq(1, recipient.String(), math.NewInt(100), math.NewInt(10))
+	_, err := suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(seller.String(), orderID, "10"))
+	suite.Require().NoError(err)
+
+	delist := func(from sdk.AccAddress) error {
+		_, err := suite.msgServer.DelistOrderClaim(suite.Ctx, &types.MsgDelistOrderClaim{Seller: from.String(), OrderId: orderID})
+		return err
+	}
+	suite.Require().ErrorIs(delist(seller), types.ErrClaimNotListed)
+	_, err = suite.msgServer.ListOrderClaim(suite.Ctx, &types.MsgListOrderClaim{Seller: seller.String(), OrderId: orderID, Price: claimPrice(105)})
+	suite.Require().NoError(err)
+	suite.Require().ErrorIs(delist(other), gerrc.ErrPermissionDenied)
+	suite.Require().NoError(delist(seller))
+
+	_, err = suite.msgServer.BuyOrderClaim(suite.Ctx, &types.MsgBuyOrderClaim{Buyer: other.String(), OrderId: orderID, Price: claimPrice(105)})
+	suite.Require().ErrorIs(err, types.ErrClaimNotListed)
+}
+
+func (suite *KeeperTestSuite) TestListOrderClaimSplitOrder() {
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1_000))
+	orderID := suite.orderWithSeq(1, addrs[0].String(), math.NewInt(100), math.NewInt(10))
+	for _, f := range addrs[1:] {
+		msg := types.NewMsgFulfillOrder(f.String(), orderID, "10")
+		msg.Amount = "50"
+		_, err := suite.msgServer.FulfillOrder(suite.Ctx, msg)
+		suite.Require().NoError(err)
+	}
+
+	_, err := suite.msgServer.ListOrderClaim(suite.Ctx, &types.MsgListOrderClaim{Seller: addrs[1].String(), OrderId: orderID, Price: claimPrice(55)})
+	suite.Require().ErrorIs(err, types.ErrDemandOrderPartiallyFilled)
+}
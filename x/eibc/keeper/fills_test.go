@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) math.Int {
+	return suite.App.BankKeeper.GetBalance(suite.Ctx, addr, sdk.DefaultBondDenom).Amount
+}
+
+// finalizeOrder simulates the packet delivering total to its current target
+// and finalizes it.
+func (suite *KeeperTestSuite) finalizeOrder(orderID string, total math.Int) {
+	o, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, orderID)
+	suite.Require().NoError(err)
+	pkt, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, o.TrackingPacketKey)
+	suite.Require().NoError(err)
+	data, err := pkt.GetTransferPacketData()
+	suite.Require().NoError(err)
+	apptesting.FundAccount(suite.App, suite.Ctx, sdk.MustAccAddressFromBech32(data.Receiver), sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, total)))
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *pkt)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestMsgFulfillOrderPartial() {
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1_000))
+	recipient, f1, f2 := addrs[0], addrs[1], addrs[2]
+	orderID := suite.orderWithSeq(1, recipient.String(), math.NewInt(100), math.NewInt(10))
+
+	fill := func(f sdk.AccAddress, amount string) error {
+		msg := types.NewMsgFulfillOrder(f.String(), orderID, "10")
+		msg.Amount = amount
+		_, err := suite.msgServer.FulfillOrder(suite.Ctx, msg)
+		return err
+	}
+
+	suite.Require().NoError(fill(f1, "60"))
+	suite.Require().Equal(math.NewInt(1_060), suite.balance(recipient))
+	o, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, orderID)
+	suite.Require().NoError(err)
+	suite.Require().False(o.IsFulfilled())
+	suite.Require().Equal(math.NewInt(40), o.UnfilledAmount(uint64(suite.Ctx.BlockHeight())))
+
+	// the packet now pays the fill escrow
+	pkt, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, o.TrackingPacketKey)
+	suite.Require().NoError(err)
+	data, err := pkt.GetTransferPacketData()
+	suite.Require().NoError(err)
+	suite.Require().Equal(o.FillEscrowAddress().String(), data.Receiver)
+
+	// the price is locked once split
+	_, err = suite.msgServer.UpdateDemandOrder(suite.Ctx, types.NewMsgUpdateDemandOrder(recipient.String(), orderID, "20"))
+	suite.Require().ErrorIs(err, types.ErrDemandOrderPartiallyFilled)
+	suite.Require().ErrorIs(fill(f2, "41"), gerrc.ErrInvalidArgument)
+
+	// an empty amount pays the rest
+	suite.Require().NoError(fill(f2, ""))
+	o, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, orderID)
+	suite.Require().NoError(err)
+	suite.Require().True(o.IsFulfilled())
+	suite.Require().Equal(o.FillEscrowAddress().String(), o.FulfillerAddress)
+	suite.Require().Equal([]types.OrderFill{
+		{Fulfiller: f1.String(), Amount: math.NewInt(60)},
+		{Fulfiller: f2.String(), Amount: math.NewInt(40)},
+	}, o.Fills)
+	suite.Require().Equal(math.NewInt(1_100), suite.balance(recipient))
+	suite.Require().ErrorIs(fill(f1, "1"), types.ErrDemandAlreadyFulfilled)
+
+	// finalization pays each fulfiller its share of price + fee
+	suite.finalizeOrder(orderID, math.NewInt(110))
+	suite.Require().Equal(math.NewInt(1_006), suite.balance(f1))
+	suite.Require().Equal(math.NewInt(1_004), suite.balance(f2))
+	suite.Require().True(suite.balance(o.FillEscrowAddress()).IsZero())
+	o, err = suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_FINALIZED, orderID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(commontypes.Status_FINALIZED, o.TrackingPacketStatus)
+}
+
+func (suite *KeeperTestSuite) TestPartiallyFilledOrderFinalized() {
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1_000))
+	recipient, f1 := addrs[0], addrs[1]
+	orderID := suite.orderWithSeq(1, recipient.String(), math.NewInt(100), math.NewInt(10))
+
+	msg := types.NewMsgFulfillOrder(f1.String(), orderID, "10")
+	msg.Amount = "30"
+	_, err := suite.msgServer.FulfillOrder(suite.Ctx, msg)
+	suite.Require().NoError(err)
+
+	// authorized fulfillment pays the whole price, so it needs an unsplit order
+	_, err = suite.msgServer.FulfillOrderAuthorized(suite.Ctx, types.NewMsgFulfillOrderAuthorized(
+		orderID, rollappPacket.RollappId, f1.String(), f1.String(), "10",
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100))), math.NewInt(110), math.LegacyZeroDec(), false,
+	))
+	suite.Require().ErrorIs(err, types.ErrDemandOrderPartiallyFilled)
+
+	// the recipient keeps the unfilled part
+	suite.finalizeOrder(orderID, math.NewInt(110))
+	suite.Require().Equal(math.NewInt(1_003), suite.balance(f1))
+	suite.Require().Equal(math.NewInt(1_107), suite.balance(recipient))
+}
+
+func (suite *KeeperTestSuite) TestFulfillPartCompletionHook() {
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1_000))
+	orderID := suite.orderWithSeq(1, addrs[0].String(), math.NewInt(100), math.NewInt(10))
+	o, err := suite.App.EIBCKeeper.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, orderID)
+	suite.Require().NoError(err)
+	o.CompletionHook = &commontypes.CompletionHookCall{Name: "hook"}
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, o))
+
+	msg := types.NewMsgFulfillOrder(addrs[1].String(), orderID, "10")
+	msg.Amount = "50"
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, gerrc.ErrFailedPrecondition)
+}
+
+func (suite *KeeperTestSuite) TestFulfillByOnDemandLPSplit() {
+	k := suite.App.EIBCKeeper
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	recipient := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1_000))[0]
+	lpAddrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1_000))
+	// the last LP is short of funds
+	suite.Require().NoError(suite.App.BankKeeper.SendCoins(suite.Ctx, lpAddrs[2], recipient,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(990)))))
+	ids := make(map[string]uint64)
+	for _, addr := range lpAddrs {
+		id, err := k.LPs.Create(suite.Ctx, &types.OnDemandLP{
+			FundsAddr:  addr.String(),
+			Rollapp:    rollappPacket.RollappId,
+			Denom:      sdk.DefaultBondDenom,
+			MaxPrice:   math.NewInt(50),
+			MinFee:     math.LegacyZeroDec(),
+			SpendLimit: math.NewInt(1_000),
+		})
+		suite.Require().NoError(err)
+		ids[addr.String()] = id
+	}
+	recipientBefore := suite.balance(recipient)
+
+	// more than all LPs together: nothing is filled
+	tooBig := suite.orderWithSeq(1, recipient.String(), math.NewInt(111), math.NewInt(10))
+	err := k.FulfillByOnDemandLP(suite.Ctx, tooBig, 0)
+	suite.Require().True(errorsmod.IsOf(err, gerrc.ErrNotFound), "expected no compatible lp, got %v", err)
+	o, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, tooBig)
+	suite.Require().NoError(err)
+	suite.Require().Empty(o.Fills)
+
+	orderID := suite.orderWithSeq(2, recipient.String(), math.NewInt(105), math.NewInt(10))
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, orderID, 0))
+	o, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, orderID)
+	suite.Require().NoError(err)
+	suite.Require().True(o.IsFulfilled())
+	suite.Require().Len(o.Fills, 3)
+	suite.Require().Equal(recipientBefore.Add(math.NewInt(105)), suite.balance(recipient))
+
+	// each LP's spend is recorded against its limits
+	for _, f := range o.Fills {
+		lp, err := k.LPs.Get(suite.Ctx, ids[f.Fulfiller])
+		suite.Require().NoError(err)
+		suite.Require().Equal(f.Amount, lp.Spent)
+		if f.Fulfiller == lpAddrs[2].String() {
+			suite.Require().True(f.Amount.LTE(math.NewInt(10)))
+		}
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 )
 
@@ -60,3 +62,108 @@ func (k Keeper) fulfill(ctx sdk.Context,
 
 	return nil
 }
+
+// fulfillAmount pays amount of the order's unfilled price from fulfiller.
+// Paying the whole price of an order not yet split is a basic fulfillment;
+// anything else is a fill (see fulfillPart).
+func (k Keeper) fulfillAmount(ctx sdk.Context,
+	o *types.DemandOrder,
+	fulfiller sdk.AccAddress,
+	amount math.Int,
+) error {
+	if len(o.Fills) == 0 && amount.Equal(o.PriceAmount()) {
+		return k.fulfillBasic(ctx, o, fulfiller)
+	}
+	return k.fulfillPart(ctx, o, fulfiller, amount)
+}
+
+// fulfillPart pays amount of the order's unfilled price from fulfiller to the
+// recipient and records the fill. The first fill redirects the packet to the
+// order's fill escrow, which pays each fulfiller its share when the packet
+// finalizes (see settleFills). The order is fulfilled, by the escrow, once the
+// fills cover its price.
+func (k Keeper) fulfillPart(ctx sdk.Context,
+	o *types.DemandOrder,
+	fulfiller sdk.AccAddress,
+	amount math.Int,
+) error {
+	// the fee is locked by now, so the height does not matter
+	unfilled := o.UnfilledAmount(uint64(ctx.BlockHeight())) //nolint:gosec
+	if !amount.IsPositive() || amount.GT(unfilled) {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "fill amount: %s, unfilled: %s", amount, unfilled)
+	}
+	if o.CompletionHook != nil {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "order with completion hook must be fulfilled whole")
+	}
+	if types.MaxOrderFills <= len(o.Fills) {
+		return errorsmod.Wrapf(gerrc.ErrResourceExhausted, "order fills: max %d", types.MaxOrderFills)
+	}
+
+	if err := k.ensureAccount(ctx, fulfiller); err != nil {
+		return errorsmod.Wrap(err, "ensure fulfiller account")
+	}
+	err := k.bk.SendCoins(ctx, fulfiller, o.GetRecipientBech32Address(), sdk.NewCoins(sdk.NewCoin(o.Denom(), amount)))
+	if err != nil {
+		return errorsmod.Wrap(err, "send coins")
+	}
+
+	first := len(o.Fills) == 0
+	o.Fills = append(o.Fills, types.OrderFill{Fulfiller: fulfiller.String(), Amount: amount})
+	escrow := o.FillEscrowAddress()
+	unfilled = unfilled.Sub(amount)
+	if unfilled.IsZero() {
+		o.FulfillerAddress = escrow.String()
+	}
+	if err := k.SetDemandOrder(ctx, o); err != nil {
+		return err
+	}
+
+	if first {
+		// completion hooks are excluded above, so this only redirects the packet
+		if err := k.hooks.AfterDemandOrderFulfilled(ctx, o, escrow.String()); err != nil {
+			return err
+		}
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventDemandOrderFilled{
+		OrderId:   o.Id,
+		Fulfiller: fulfiller.String(),
+		Amount:    amount.String(),
+		Unfilled:  unfilled.String(),
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	if o.IsFulfilled() {
+		if err := uevent.EmitTypedEvent(ctx, types.GetFulfilledEvent(o)); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+	}
+	return nil
+}
+
+// settleFills pays out the fill escrow of a split order whose packet has
+// finalized, whatever the packet delivered to it.
+func (k Keeper) settleFills(ctx sdk.Context, o *types.DemandOrder) error {
+	escrow := o.FillEscrowAddress()
+	total := k.bk.SpendableCoins(ctx, escrow).AmountOf(o.Denom())
+	for _, p := range o.FillPayouts(total) {
+		if !p.Amount.IsPositive() {
+			continue
+		}
+		to, err := sdk.AccAddressFromBech32(p.Recipient)
+		if err != nil {
+			return errorsmod.Wrap(err, "payout recipient")
+		}
+		if err := k.bk.SendCoins(ctx, escrow, to, sdk.NewCoins(sdk.NewCoin(o.Denom(), p.Amount))); err != nil {
+			return errorsmod.Wrapf(err, "pay fill: %s", p.Recipient)
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventDemandOrderFillSettled{
+			OrderId:   o.Id,
+			Recipient: p.Recipient,
+			Amount:    p.Amount.String(),
+		}); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+	}
+	return nil
+}
@@ -7,6 +7,7 @@ import (
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
@@ -171,3 +172,27 @@ func (q Querier) OnDemandLPsByByAddr(gctx context.Context, r *types.QueryOnDeman
 	}
 	return &types.QueryOnDemandLPsByAddrResponse{Lps: lps}, nil
 }
+
+// OnDemandLPMatch previews which on-demand LPs would fulfill the order, and
+// how much each would pay, were it matched now. Ties between equally ranked
+// LPs are broken with the current block hash, so the preview holds only for
+// the block it was queried at.
+func (q Querier) OnDemandLPMatch(gctx context.Context, r *types.QueryOnDemandLPMatchRequest) (*types.QueryOnDemandLPMatchResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+	if _, err := q.GetOutstandingOrder(ctx, r.OrderId); err != nil {
+		return nil, errorsmod.Wrap(err, "get outstanding order")
+	}
+	// match on a throwaway branch of the state
+	cacheCtx, _ := ctx.CacheContext()
+	matches, err := q.fulfillByOnDemandLP(cacheCtx, r.OrderId, deterministicFulfillSeed(ctx, r.OrderId))
+	if errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return &types.QueryOnDemandLPMatchResponse{}, nil
+	}
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "match")
+	}
+	return &types.QueryOnDemandLPMatchResponse{Matches: matches}, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
@@ -45,11 +46,20 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 		return err
 	}
 	demandOrder.TrackingPacketKey = newPacketKey
-	_, err = d.UpdateDemandOrderWithStatus(ctx, demandOrder, packet.Status)
+	// the claim is settled, so there is nothing left to sell
+	demandOrder.ClaimListing = nil
+	demandOrder, err = d.UpdateDemandOrderWithStatus(ctx, demandOrder, packet.Status)
 	if err != nil {
 		return err
 	}
 
+	// the packet has delivered to the fill escrow by now
+	if packet.Status == commontypes.Status_FINALIZED && len(demandOrder.Fills) != 0 {
+		if err := d.settleFills(ctx, demandOrder); err != nil {
+			return errorsmod.Wrap(err, "settle fills")
+		}
+	}
+
 	return nil
 }
 
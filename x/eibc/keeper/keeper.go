@@ -49,6 +49,7 @@ func NewKeeper(
 	service := collcompat.NewKVStoreService(storeKey)
 	sb := collections.NewSchemaBuilder(service)
 	lps := makeLPsStore(sb, cdc)
+	lps.rollapps = rk
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -295,3 +296,16 @@ func (k *Keeper) SetDelayedAckKeeper(delayedAckKeeper types.DelayedAckKeeper) {
 func (k *Keeper) SetAgentKeeper(agentKeeper types.AgentKeeper) {
 	k.LPs.agents = agentKeeper
 }
+
+// SetSequencerKeeper sets the sequencer keeper used by LP health conditions.
+// must be called when initializing the keeper.
+func (k *Keeper) SetSequencerKeeper(sequencerKeeper types.SequencerKeeper) {
+	k.LPs.sequencers = sequencerKeeper
+}
+
+// SetLightClientKeeper sets the light client keeper used by LP health
+// conditions.
+// must be called when initializing the keeper.
+func (k *Keeper) SetLightClientKeeper(lightClientKeeper types.LightClientKeeper) {
+	k.LPs.lightClients = lightClientKeeper
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (suite *KeeperTestSuite) TestOnDemandLPHealthConditions() {
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1_000))
+	var ids []uint64
+	for i, health := range []*types.LPHealthConditions{
+		{MaxBlocksSinceStateUpdate: 5, RequireCanonicalClient: true},
+		nil,
+	} {
+		id, err := k.LPs.Create(suite.Ctx, &types.OnDemandLP{
+			FundsAddr:  addrs[i+1].String(),
+			Rollapp:    rollappPacket.RollappId,
+			Denom:      sdk.DefaultBondDenom,
+			MaxPrice:   math.NewInt(100),
+			MinFee:     math.LegacyZeroDec(),
+			SpendLimit: math.NewInt(1_000),
+			Health:     health,
+		})
+		suite.Require().NoError(err)
+		ids = append(ids, id)
+	}
+	orderID := suite.orderWithSeq(1, addrs[0].String(), math.NewInt(90), math.NewInt(10))
+	o, err := k.GetOutstandingOrder(suite.Ctx, orderID)
+	suite.Require().NoError(err)
+
+	// compatible returns the ids of the LPs matched, and the reason the
+	// conditioned LP was skipped, if it was
+	compatible := func() ([]uint64, string) {
+		suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+		lps, err := k.LPs.GetOrderCompatibleLPs(suite.Ctx, *o)
+		suite.Require().NoError(err)
+		var got []uint64
+		for _, lpr := range lps {
+			got = append(got, lpr.Id)
+		}
+		e, ok := suite.FindLastEventOfType(suite.Ctx.EventManager().Events(), proto.MessageName(&types.EventOnDemandLPSkipped{}))
+		if !ok {
+			return got, ""
+		}
+		attrs := make(map[string]string)
+		for _, a := range e.Attributes {
+			attrs[a.Key] = a.Value
+		}
+		expect, err := uevent.TypedEventToEvent(&types.EventOnDemandLPSkipped{LpId: ids[0]})
+		suite.Require().NoError(err)
+		for _, a := range expect.Attributes {
+			if a.Key == "lp_id" {
+				suite.Require().Equal(a.Value, attrs["lp_id"])
+			}
+		}
+		return got, attrs["reason"]
+	}
+
+	got, reason := compatible()
+	suite.Require().Equal([]uint64{ids[1]}, got)
+	suite.Require().Contains(reason, "stale state updates")
+
+	suite.App.RollappKeeper.SetLatestStateInfoIndex(suite.Ctx, rollapptypes.StateInfoIndex{RollappId: rollappPacket.RollappId, Index: 1})
+	suite.App.RollappKeeper.SetStateInfo(suite.Ctx, rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{RollappId: rollappPacket.RollappId, Index: 1},
+		StartHeight:    1,
+		NumBlocks:      1,
+		CreationHeight: 8,
+	})
+	got, reason = compatible()
+	suite.Require().Equal([]uint64{ids[1]}, got)
+	suite.Require().Contains(reason, "no canonical light client")
+
+	suite.App.LightClientKeeper.SetCanonicalClient(suite.Ctx, rollappPacket.RollappId, "07-tendermint-0")
+	got, reason = compatible()
+	suite.Require().ElementsMatch(ids, got)
+	suite.Require().Empty(reason)
+
+	// the state update ages out
+	suite.Ctx = suite.Ctx.WithBlockHeight(14)
+	got, reason = compatible()
+	suite.Require().Equal([]uint64{ids[1]}, got)
+	suite.Require().Contains(reason, "stale state updates")
+}
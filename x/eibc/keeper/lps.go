@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"math/rand/v2"
+	"sort"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
@@ -34,6 +35,11 @@ type LPs struct {
 	nextID collections.Sequence
 	// liveness oracle for agent-bound LPs, set after construction (see Keeper.SetAgentKeeper)
 	agents types.AgentKeeper
+	// rollapp health sources for LP health conditions; sequencers and
+	// lightClients are set after construction
+	rollapps     types.RollappKeeper
+	sequencers   types.SequencerKeeper
+	lightClients types.LightClientKeeper
 }
 
 func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
@@ -170,7 +176,30 @@ func (s LPs) GetByAddr(ctx sdk.Context, addr sdk.AccAddress) ([]*types.OnDemandL
 	return ret, err
 }
 
+// GetOrderCompatibleLPs returns the live LPs which would pay all of the order's
+// unfilled price. An LP skipped only for the rollapp's health is reported in
+// an event.
 func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]types.OnDemandLPRecord, error) {
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	return s.orderLPs(ctx, o, func(lpr types.OnDemandLPRecord) bool {
+		return lpr.Accepts(h, &o)
+	}, true)
+}
+
+// GetOrderPartialLPs returns the live LPs which would pay at least part of the
+// order's unfilled price, funds permitting.
+func (s LPs) GetOrderPartialLPs(ctx sdk.Context, o types.DemandOrder) ([]types.OnDemandLPRecord, error) {
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	unfilled := o.UnfilledAmount(h)
+	return s.orderLPs(ctx, o, func(lpr types.OnDemandLPRecord) bool {
+		return lpr.FillCapacity(h, &o, unfilled).IsPositive()
+	}, false)
+}
+
+// orderLPs returns the LPs for the order's rollapp and denom which accept it,
+// whose bound agent, if any, is live and whose health conditions, if any, the
+// rollapp meets. If report is set, each LP skipped for health emits an event.
+func (s LPs) orderLPs(ctx sdk.Context, o types.DemandOrder, accepts func(types.OnDemandLPRecord) bool, report bool) ([]types.OnDemandLPRecord, error) {
 	rol := o.RollappId
 	denom := o.Denom()
 	ranger := collections.NewSuperPrefixedTripleRange[string, string, uint64](rol, denom)
@@ -181,6 +210,8 @@ func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]type
 	defer iter.Close()
 
 	var compat []types.OnDemandLPRecord
+	// read once, and only if some LP has conditions
+	var health *types.RollappHealth
 	for ; iter.Valid(); iter.Next() {
 		key, err := iter.Key()
 		if err != nil {
@@ -192,14 +223,56 @@ func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]type
 		if err != nil {
 			return nil, err
 		}
-		h := uint64(ctx.BlockHeight()) //nolint:gosec
-		if lpr.Accepts(h, &o) && s.agentLive(ctx, lpr.Lp.AgentId) {
-			compat = append(compat, lpr)
+		if !accepts(lpr) || !s.agentLive(ctx, lpr.Lp.AgentId) {
+			continue
 		}
+		if lpr.Lp.Health != nil {
+			if health == nil {
+				h := s.rollappHealth(ctx, rol)
+				health = &h
+			}
+			if reason := lpr.Lp.Health.SkipReason(*health); reason != "" {
+				if report {
+					if err := uevent.EmitTypedEvent(ctx, &types.EventOnDemandLPSkipped{
+						LpId:      lpr.Id,
+						OrderId:   o.Id,
+						RollappId: rol,
+						Reason:    reason,
+					}); err != nil {
+						return nil, errorsmod.Wrap(err, "event")
+					}
+				}
+				continue
+			}
+		}
+		compat = append(compat, lpr)
 	}
 	return compat, nil
 }
 
+// rollappHealth reads the state of the rollapp which LP health conditions
+// check.
+func (s LPs) rollappHealth(ctx sdk.Context, rollappID string) types.RollappHealth {
+	now := uint64(ctx.BlockHeight()) //nolint:gosec
+	var h types.RollappHealth
+	latest, ok := s.rollapps.GetLatestStateInfo(ctx, rollappID)
+	if ok {
+		h.HasStateUpdate = true
+		if latest.CreationHeight < now {
+			h.BlocksSinceStateUpdate = now - latest.CreationHeight
+		}
+	}
+	// a fork reverts states to before the new revision, which is active until
+	// the rollapp posts a state on it
+	if ra, found := s.rollapps.GetRollapp(ctx, rollappID); found {
+		h.HardForkActive = ra.DidFork() && latest.GetLatestHeight() < ra.LatestRevision().StartHeight
+	}
+	h.ProposerChanging = s.sequencers.RotationInProgress(ctx, rollappID) ||
+		s.sequencers.Kickable(ctx, s.sequencers.GetProposer(ctx, rollappID))
+	_, h.HasCanonicalClient = s.lightClients.GetCanonicalClient(ctx, rollappID)
+	return h
+}
+
 // agentLive reports whether an LP bound to agentID may fulfill. Unbound LPs
 // (empty id) are always live.
 func (s LPs) agentLive(ctx sdk.Context, agentID string) bool {
@@ -209,6 +282,19 @@ func (s LPs) agentLive(ctx sdk.Context, agentID string) bool {
 	return s.agents.IsAgentLive(ctx, agentID)
 }
 
+// reputation is the verified average score of the agent an LP is bound to.
+// Unbound LPs, and agents without verified actions, score zero.
+func (s LPs) reputation(ctx sdk.Context, agentID string) uint32 {
+	if agentID == "" {
+		return 0
+	}
+	rep, ok := s.agents.GetReputation(ctx, agentID)
+	if !ok {
+		return 0
+	}
+	return rep.VerifiedAverageScore()
+}
+
 // NextID returns the current value of the LP id sequence.
 func (s LPs) NextID(ctx sdk.Context) (uint64, error) {
 	return s.nextID.Peek(ctx)
@@ -233,48 +319,156 @@ func deterministicFulfillSeed(ctx sdk.Context, orderID string) uint64 {
 	return binary.BigEndian.Uint64(sum[:8])
 }
 
+// FulfillByOnDemandLP pays the order's unfilled price from a single LP able to
+// cover it, chosen according to the lp_matching param. If none can, it splits
+// the order between LPs which together cover it.
 func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) error {
+	_, err := k.fulfillByOnDemandLP(ctx, order, rng)
+	return err
+}
+
+// fulfillByOnDemandLP is FulfillByOnDemandLP, returning the LPs which paid and
+// how much each paid.
+func (k Keeper) fulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) ([]types.OnDemandLPMatch, error) {
 	o, err := k.GetOutstandingOrder(ctx, order)
 	if err != nil {
-		return errorsmod.Wrap(err, "get outstanding order")
+		return nil, errorsmod.Wrap(err, "get outstanding order")
 	}
 	lps, err := k.LPs.GetOrderCompatibleLPs(ctx, *o)
 	if err != nil {
-		return errorsmod.Wrap(err, "get compatible lp")
+		return nil, errorsmod.Wrap(err, "get compatible lp")
 	}
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
 	// Lock the price/fee to the current height before moving funds and bookkeeping.
-	o.ApplyEffectiveFee(uint64(ctx.BlockHeight())) //nolint:gosec
-	r := rand.New(rand.NewPCG(rng, 0))             //nolint:gosec // This is used for deterministic shuffling in order processing
+	o.ApplyEffectiveFee(h)
+	r := rand.New(rand.NewPCG(rng, 0)) //nolint:gosec // This is used for deterministic shuffling in order processing
 	r.Shuffle(len(lps), func(i, j int) {
 		lps[i], lps[j] = lps[j], lps[i]
 	})
+	k.rankLPs(ctx, o, lps)
+	amount := o.UnfilledAmount(h)
 	for _, lp := range lps {
-		err := k.fulfillBasic(ctx, o, lp.Lp.MustAddr())
+		err := k.fulfillAmount(ctx, o, lp.Lp.MustAddr(), amount)
 		if err != nil {
 			if errorsmod.IsOf(err, sdkerrors.ErrInsufficientFunds) {
 				if err := k.LPs.Del(ctx, lp.Id, "out of funds"); err != nil {
-					return errorsmod.Wrapf(err, "delete lp: %d", lp.Id)
+					return nil, errorsmod.Wrapf(err, "delete lp: %d", lp.Id)
 				}
 				ctx.Logger().Error("Fulfill via on demand dlp - insufficient funds.", "lp", lp.Id)
 				// note: in case fulfill will get more complicated, we'll need to wrap this with cache ctx
 				continue
 			}
-			return errorsmod.Wrap(err, "fulfill lp")
+			return nil, errorsmod.Wrap(err, "fulfill lp")
 		}
-		if err = uevent.EmitTypedEvent(ctx, &types.EventMatchedOnDemandLP{
-			OrderId:   o.Id,
-			LpId:      lp.Id,
-			Fulfiller: lp.Lp.MustAddr().String(),
-		}); err != nil {
-			return errorsmod.Wrap(err, "emit event")
+		if err := k.recordLPFill(ctx, o, lp, amount); err != nil {
+			return nil, err
 		}
-		lp.RecordSpend(uint64(ctx.BlockHeight()), o.PriceAmount()) //nolint:gosec
-		if err = k.LPs.Set(ctx, lp); err != nil {
-			return errorsmod.Wrap(err, "set lp")
+		return []types.OnDemandLPMatch{{LpId: lp.Id, Amount: amount}}, nil
+	}
+	return k.fulfillSplitByOnDemandLPs(ctx, o, r)
+}
+
+// rankLPs orders shuffled LPs for matching. Under LP_MATCHING_BEST_PRICE the
+// LP quoting the lowest fee for the order comes first, then the one bound to
+// the better reputed agent; the shuffle breaks remaining ties. Under
+// LP_MATCHING_RANDOM the shuffle is kept as is.
+func (k Keeper) rankLPs(ctx sdk.Context, o *types.DemandOrder, lps []types.OnDemandLPRecord) {
+	if k.GetParams(ctx).LpMatching != types.LPMatching_LP_MATCHING_BEST_PRICE {
+		return
+	}
+	type rank struct {
+		fee        math.LegacyDec
+		reputation uint32
+	}
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	ranks := make(map[uint64]rank, len(lps))
+	for _, lp := range lps {
+		ranks[lp.Id] = rank{
+			fee:        lp.QuotedFee(h, o),
+			reputation: k.LPs.reputation(ctx, lp.Lp.AgentId),
 		}
-		return nil
 	}
-	return errorsmod.Wrap(gerrc.ErrNotFound, "no compatible lp")
+	sort.SliceStable(lps, func(i, j int) bool {
+		a, b := ranks[lps[i].Id], ranks[lps[j].Id]
+		if !a.fee.Equal(b.fee) {
+			return a.fee.LT(b.fee)
+		}
+		return a.reputation > b.reputation
+	})
+}
+
+// fulfillSplitByOnDemandLPs fills the order in parts from LPs which can each
+// pay only some of it, taking from each as much as it accepts and can fund.
+// It fills nothing unless together they cover the unfilled price.
+func (k Keeper) fulfillSplitByOnDemandLPs(ctx sdk.Context, o *types.DemandOrder, r *rand.Rand) ([]types.OnDemandLPMatch, error) {
+	lps, err := k.LPs.GetOrderPartialLPs(ctx, *o)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get partial lp")
+	}
+	r.Shuffle(len(lps), func(i, j int) {
+		lps[i], lps[j] = lps[j], lps[i]
+	})
+	k.rankLPs(ctx, o, lps)
+
+	type lpFill struct {
+		lp     types.OnDemandLPRecord
+		amount math.Int
+	}
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	unfilled := o.UnfilledAmount(h)
+	// several LPs may share a funds address
+	committed := make(map[string]math.Int)
+	var fills []lpFill
+	for _, lp := range lps {
+		if !unfilled.IsPositive() || types.MaxOrderFills <= len(o.Fills)+len(fills) {
+			break
+		}
+		spent, ok := committed[lp.Lp.FundsAddr]
+		if !ok {
+			spent = math.ZeroInt()
+		}
+		funds := k.bk.SpendableCoins(ctx, lp.Lp.MustAddr()).AmountOf(o.Denom()).Sub(spent)
+		amount := lp.FillCapacity(h, o, math.MinInt(funds, unfilled))
+		if !amount.IsPositive() {
+			continue
+		}
+		fills = append(fills, lpFill{lp: lp, amount: amount})
+		committed[lp.Lp.FundsAddr] = spent.Add(amount)
+		unfilled = unfilled.Sub(amount)
+	}
+	if unfilled.IsPositive() {
+		return nil, errorsmod.Wrap(gerrc.ErrNotFound, "no compatible lp")
+	}
+
+	matches := make([]types.OnDemandLPMatch, 0, len(fills))
+	for _, f := range fills {
+		if err := k.fulfillPart(ctx, o, f.lp.Lp.MustAddr(), f.amount); err != nil {
+			return nil, errorsmod.Wrapf(err, "fill lp: %d", f.lp.Id)
+		}
+		if err := k.recordLPFill(ctx, o, f.lp, f.amount); err != nil {
+			return nil, err
+		}
+		matches = append(matches, types.OnDemandLPMatch{LpId: f.lp.Id, Amount: f.amount})
+	}
+	return matches, nil
+}
+
+// recordLPFill accounts amount against the LP's limits after it paid that
+// part of the order.
+func (k Keeper) recordLPFill(ctx sdk.Context, o *types.DemandOrder, lp types.OnDemandLPRecord, amount math.Int) error {
+	if err := uevent.EmitTypedEvent(ctx, &types.EventMatchedOnDemandLP{
+		OrderId:   o.Id,
+		LpId:      lp.Id,
+		Fulfiller: lp.Lp.MustAddr().String(),
+		Amount:    amount.String(),
+	}); err != nil {
+		return errorsmod.Wrap(err, "emit event")
+	}
+	lp.RecordSpend(uint64(ctx.BlockHeight()), amount) //nolint:gosec
+	if err := k.LPs.Set(ctx, lp); err != nil {
+		return errorsmod.Wrap(err, "set lp")
+	}
+	return nil
 }
 
 func (k Keeper) CreateLP(ctx sdk.Context, lp *types.OnDemandLP) (uint64, error) {
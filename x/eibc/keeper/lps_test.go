@@ -4,6 +4,8 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	agenttypes "github.com/dymensionxyz/dymension/v3/x/agent/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -71,3 +73,97 @@ func (suite *KeeperTestSuite) TestTryFulfillOnDemandEmptyHeaderHashDeterministic
 	winner := suite.fulfillWinner(301, nil, -1, addrs)
 	suite.Require().Equal(winner, suite.fulfillWinner(301, nil, 42, addrs))
 }
+
+func (suite *KeeperTestSuite) setLPMatching(mode types.LPMatching) {
+	params := suite.App.EIBCKeeper.GetParams(suite.Ctx)
+	params.LpMatching = mode
+	suite.App.EIBCKeeper.SetParams(suite.Ctx, params)
+}
+
+// bestPriceWinner creates one LP per term on a fresh chain, after setup, and
+// returns the index of the LP which fulfilled an order under
+// LP_MATCHING_BEST_PRICE.
+func (suite *KeeperTestSuite) bestPriceWinner(headerHash []byte, terms []types.OnDemandLP, setup func()) int {
+	suite.SetupTest()
+	suite.Ctx = suite.Ctx.WithBlockHeight(10).WithHeaderHash(headerHash)
+	setup()
+	suite.setLPMatching(types.LPMatching_LP_MATCHING_BEST_PRICE)
+	k := suite.App.EIBCKeeper
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, len(terms)+1, math.NewInt(1_000))
+	ids := make(map[uint64]int)
+	for i, lp := range terms {
+		lp.FundsAddr = addrs[i+1].String()
+		lp.Rollapp = rollappPacket.RollappId
+		lp.Denom = sdk.DefaultBondDenom
+		lp.MaxPrice = math.NewInt(100)
+		lp.SpendLimit = math.NewInt(1_000)
+		id, err := k.LPs.Create(suite.Ctx, &lp)
+		suite.Require().NoError(err)
+		ids[id] = i
+	}
+
+	orderID := suite.orderWithSeq(1, addrs[0].String(), math.NewInt(90), math.NewInt(10))
+	preview, err := keeper.NewQuerier(k).OnDemandLPMatch(suite.Ctx, &types.QueryOnDemandLPMatchRequest{OrderId: orderID})
+	suite.Require().NoError(err)
+	suite.Require().NoError(k.FulfillByOnDemandLP(suite.Ctx, orderID, 0))
+
+	// the preview named the LP which then paid
+	suite.Require().Equal([]types.OnDemandLPMatch{{LpId: preview.Matches[0].LpId, Amount: math.NewInt(90)}}, preview.Matches)
+	lp, err := k.LPs.Get(suite.Ctx, preview.Matches[0].LpId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(90), lp.Spent)
+	return ids[lp.Id]
+}
+
+func (suite *KeeperTestSuite) TestFulfillOnDemandBestPrice() {
+	terms := []types.OnDemandLP{
+		{MinFee: math.LegacyMustNewDecFromStr("0.05")},
+		// the lowest rate, but its absolute minimum quotes 5
+		{MinFee: math.LegacyMustNewDecFromStr("0.01"), MinFeeAbsolute: math.NewInt(5)},
+		// quotes 1.8
+		{MinFee: math.LegacyMustNewDecFromStr("0.02")},
+	}
+	for _, hash := range [][]byte{nil, []byte("a"), []byte("b"), []byte("c")} {
+		suite.Require().Equal(2, suite.bestPriceWinner(hash, terms, func() {}))
+	}
+}
+
+func (suite *KeeperTestSuite) TestFulfillOnDemandBestPriceReputation() {
+	fee := math.LegacyMustNewDecFromStr("0.02")
+	terms := []types.OnDemandLP{
+		{MinFee: fee},
+		{MinFee: fee, AgentId: "low"},
+		{MinFee: fee, AgentId: "high"},
+	}
+	setAgents := func() {
+		for id, score := range map[string]uint64{"low": 20, "high": 90} {
+			suite.Require().NoError(suite.App.AgentKeeper.SetAgent(suite.Ctx, agenttypes.Agent{
+				Id:     id,
+				Owner:  apptesting.CreateRandomAccounts(1)[0].String(),
+				Active: true,
+			}))
+			suite.Require().NoError(suite.App.AgentKeeper.SetReputation(suite.Ctx, agenttypes.Reputation{
+				AgentId:          id,
+				Count:            1,
+				ScoreSum:         score,
+				VerifiedCount:    1,
+				VerifiedScoreSum: score,
+			}))
+		}
+	}
+	for _, hash := range [][]byte{nil, []byte("a"), []byte("b"), []byte("c")} {
+		suite.Require().Equal(2, suite.bestPriceWinner(hash, terms, setAgents))
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnDemandLPMatchNoLP() {
+	suite.Ctx = suite.Ctx.WithBlockHeight(10)
+	recipient := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1_000))[0]
+	orderID := suite.orderWithSeq(1, recipient.String(), math.NewInt(90), math.NewInt(10))
+
+	res, err := keeper.NewQuerier(suite.App.EIBCKeeper).OnDemandLPMatch(suite.Ctx, &types.QueryOnDemandLPMatchRequest{OrderId: orderID})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Matches)
+	_, err = keeper.NewQuerier(suite.App.EIBCKeeper).OnDemandLPMatch(suite.Ctx, &types.QueryOnDemandLPMatchRequest{OrderId: "missing"})
+	suite.Require().Error(err)
+}
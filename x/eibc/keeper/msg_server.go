@@ -72,9 +72,16 @@ func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrde
 		return nil, types.ErrExpectedFeeNotMet
 	}
 
-	demandOrder.ApplyEffectiveFee(uint64(ctx.BlockHeight())) //nolint:gosec
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	demandOrder.ApplyEffectiveFee(h)
+
+	// an empty amount pays the rest of the price
+	amount := demandOrder.UnfilledAmount(h)
+	if msg.Amount != "" {
+		amount, _ = math.NewIntFromString(msg.Amount)
+	}
 
-	err = m.fulfillBasic(ctx, demandOrder, msg.GetFulfillerBech32Address())
+	err = m.fulfillAmount(ctx, demandOrder, msg.GetFulfillerBech32Address(), amount)
 	if err != nil {
 		logger.Error("Fulfill order", "error", err)
 		return nil, err
@@ -96,6 +103,9 @@ func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgF
 	if err != nil {
 		return nil, err
 	}
+	if len(demandOrder.Fills) != 0 {
+		return nil, types.ErrDemandOrderPartiallyFilled
+	}
 
 	// check compat between the fulfillment and current order and packet status
 	if err := m.validateOrder(ctx, demandOrder, msg); err != nil {
@@ -217,6 +227,10 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 	if err != nil {
 		return nil, err
 	}
+	// fills were paid at the current price, so it cannot change
+	if len(demandOrder.Fills) != 0 {
+		return nil, types.ErrDemandOrderPartiallyFilled
+	}
 
 	// Check that the signer is the order owner
 	orderOwner := demandOrder.GetRecipientBech32Address()
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// OrderBook returns the outstanding orders of a rollapp in a denom, sorted by
+// their effective fee rate at the requested height, highest first, so
+// fulfillers need not rebuild escalated fees themselves.
+func (q Querier) OrderBook(gctx context.Context, r *types.QueryOrderBookRequest) (*types.QueryOrderBookResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if r.RollappId == "" || r.Denom == "" {
+		return nil, status.Error(codes.InvalidArgument, "rollapp id and denom are required")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+	height := r.Height
+	if height == 0 {
+		height = uint64(ctx.BlockHeight()) //nolint:gosec
+	}
+
+	orders, err := q.ListDemandOrdersByStatus(ctx, commontypes.Status_PENDING, 0,
+		isRollappId(r.RollappId),
+		isFulfillmentState(types.FulfillmentState_UNFULFILLED),
+		func(o types.DemandOrder) bool { return o.Denom() == r.Denom },
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryOrderBookResponse{
+		Height:         height,
+		Depth:          math.ZeroInt(),
+		ExpectedProfit: math.ZeroInt(),
+	}
+	for _, o := range orders {
+		e, ok, err := q.orderBookEntry(ctx, o, height)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if ok {
+			res.Orders = append(res.Orders, e)
+		}
+	}
+	slices.SortStableFunc(res.Orders, func(a, b types.OrderBookEntry) int {
+		if !a.FeeRate.Equal(b.FeeRate) {
+			if a.FeeRate.GT(b.FeeRate) {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(a.OrderId, b.OrderId)
+	})
+	for i := range res.Orders {
+		e := &res.Orders[i]
+		res.Depth = res.Depth.Add(e.Amount)
+		res.ExpectedProfit = res.ExpectedProfit.Add(e.ExpectedProfit)
+		e.CumulativeAmount = res.Depth
+	}
+	if r.Limit != 0 && int(r.Limit) < len(res.Orders) {
+		res.Orders = res.Orders[:r.Limit]
+	}
+	return res, nil
+}
+
+// orderBookEntry prices the rest of an order at the given height. Orders
+// which can no longer be fulfilled are left out.
+func (q Querier) orderBookEntry(ctx sdk.Context, o *types.DemandOrder, height uint64) (types.OrderBookEntry, bool, error) {
+	if o.TrackingPacketStatus != commontypes.Status_PENDING {
+		return types.OrderBookEntry{}, false, nil
+	}
+	unfilled := o.UnfilledAmount(height)
+	if !unfilled.IsPositive() {
+		return types.OrderBookEntry{}, false, nil
+	}
+	packet, err := q.dack.GetRollappPacket(ctx, o.TrackingPacketKey)
+	if err != nil {
+		return types.OrderBookEntry{}, false, errorsmod.Wrap(err, "get rollapp packet")
+	}
+	// due to be finalized, see GetOutstandingOrder
+	if q.rk.IsHeightFinalized(ctx, o.RollappId, packet.ProofHeight) {
+		return types.OrderBookEntry{}, false, nil
+	}
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetPacket().GetData(), &data); err != nil {
+		return types.OrderBookEntry{}, false, errorsmod.Wrap(err, "unmarshal packet data")
+	}
+	received, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return types.OrderBookEntry{}, false, errorsmod.Wrapf(gerrc.ErrInvalidArgument, "packet amount: %s", data.Amount)
+	}
+	// ErrAck or Timeout packets do not incur bridging fees
+	if o.Type == commontypes.RollappPacket_ON_RECV {
+		received = received.Sub(q.dack.BridgingFee(ctx).MulInt(received).TruncateInt())
+	}
+	return types.OrderBookEntry{
+		OrderId:        o.Id,
+		Type:           o.Type,
+		Fee:            o.EffectiveFeeAmount(height),
+		FeeRate:        o.EffectiveFeePercent(height),
+		Amount:         unfilled,
+		ExpectedProfit: o.ExpectedProfit(height, received),
+	}, true, nil
+}
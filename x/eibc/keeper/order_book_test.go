@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+func (suite *KeeperTestSuite) TestOrderBook() {
+	suite.Ctx = suite.Ctx.WithBlockHeight(1)
+	dackParams := suite.App.DelayedAckKeeper.GetParams(suite.Ctx)
+	dackParams.BridgingFee = math.LegacyNewDecWithPrec(1, 2)
+	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(10_000))
+	recipient := addrs[0].String()
+
+	// every packet transfers 1000, so after the 1% bridging fee each order
+	// pays 990 on finalization
+	low := suite.orderWithSeq(1, recipient, math.NewInt(980), math.NewInt(10))
+	high := suite.orderWithSeq(2, recipient, math.NewInt(890), math.NewInt(100))
+	pkt := *rollappPacket
+	innerPkt := *pkt.Packet
+	innerPkt.Sequence = 3
+	pkt.Packet = &innerPkt
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, pkt)
+	o := types.NewDemandOrder(pkt, math.NewInt(900), math.NewInt(50), sdk.DefaultBondDenom, recipient, 1, nil,
+		&types.FeeEscalation{MaxFeeAmount: math.NewInt(150), DurationBlocks: 10})
+	suite.Require().NoError(suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, o))
+	escalating := o.Id
+	fulfilled := suite.orderWithSeq(4, recipient, math.NewInt(500), math.NewInt(400))
+	_, err := suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(addrs[1].String(), fulfilled, "400"))
+	suite.Require().NoError(err)
+
+	book := func(height uint64, limit uint32) *types.QueryOrderBookResponse {
+		res, err := keeper.NewQuerier(suite.App.EIBCKeeper).OrderBook(suite.Ctx, &types.QueryOrderBookRequest{
+			RollappId: rollappPacket.RollappId,
+			Denom:     sdk.DefaultBondDenom,
+			Height:    height,
+			Limit:     limit,
+		})
+		suite.Require().NoError(err)
+		return res
+	}
+	ids := func(res *types.QueryOrderBookResponse) []string {
+		var ret []string
+		for _, e := range res.Orders {
+			ret = append(ret, e.OrderId)
+		}
+		return ret
+	}
+
+	res := book(0, 0)
+	suite.Require().Equal(uint64(1), res.Height)
+	suite.Require().Equal([]string{high, escalating, low}, ids(res))
+	suite.Require().Equal(math.NewInt(890+900+980), res.Depth)
+	suite.Require().Equal(math.NewInt(100+90+10), res.ExpectedProfit)
+	suite.Require().Equal(math.NewInt(890+900), res.Orders[1].CumulativeAmount)
+
+	// halfway through its escalation the order pays fee 100 for price 850
+	res = book(6, 0)
+	suite.Require().Equal([]string{escalating, high, low}, ids(res))
+	suite.Require().Equal(types.OrderBookEntry{
+		OrderId:          escalating,
+		Type:             rollappPacket.Type,
+		Fee:              math.NewInt(100),
+		FeeRate:          math.LegacyNewDec(100).Quo(math.LegacyNewDec(850)),
+		Amount:           math.NewInt(850),
+		CumulativeAmount: math.NewInt(850),
+		ExpectedProfit:   math.NewInt(140),
+	}, res.Orders[0])
+
+	// the limit trims the orders, not the totals
+	res = book(6, 1)
+	suite.Require().Equal([]string{escalating}, ids(res))
+	suite.Require().Equal(math.NewInt(850+890+980), res.Depth)
+
+	_, err = keeper.NewQuerier(suite.App.EIBCKeeper).OrderBook(suite.Ctx, &types.QueryOrderBookRequest{RollappId: rollappPacket.RollappId})
+	suite.Require().Error(err)
+}
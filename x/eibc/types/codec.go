@@ -15,6 +15,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateOnDemandLP{}, "eibc/CreateOnDemandLP", nil)
 	cdc.RegisterConcrete(&MsgDeleteOnDemandLP{}, "eibc/DeleteOnDemandLP", nil)
 	cdc.RegisterConcrete(&MsgTryFulfillOnDemand{}, "eibc/TryFulfillOnDemand", nil)
+	cdc.RegisterConcrete(&MsgListOrderClaim{}, "eibc/ListOrderClaim", nil)
+	cdc.RegisterConcrete(&MsgDelistOrderClaim{}, "eibc/DelistOrderClaim", nil)
+	cdc.RegisterConcrete(&MsgBuyOrderClaim{}, "eibc/BuyOrderClaim", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "eibc/UpdateParams", nil)
 	cdc.RegisterConcrete(Params{}, "eibc/Params", nil)
 	cdc.RegisterConcrete(&FulfillOrderAuthorization{}, "eibc/FulfillOrderAuthorization", nil)
@@ -30,6 +33,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateOnDemandLP{},
 		&MsgDeleteOnDemandLP{},
 		&MsgTryFulfillOnDemand{},
+		&MsgListOrderClaim{},
+		&MsgDelistOrderClaim{},
+		&MsgBuyOrderClaim{},
 		&MsgUpdateParams{},
 	)
 	registry.RegisterImplementations(
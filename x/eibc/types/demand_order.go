@@ -5,13 +5,19 @@ import (
 	"encoding/hex"
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
 
+// MaxOrderFills bounds how many parts an order can be split into, and so the
+// payouts made when its packet finalizes.
+const MaxOrderFills = 32
+
 // NewDemandOrder creates a new demand order.
 // Price is the cost to a market maker to buy the option, (recipient receives straight away).
 // Fee is what the market maker gets in return.
@@ -67,6 +73,61 @@ func (m *DemandOrder) ValidateBasic() error {
 		return ErrInvalidCreationHeight
 	}
 
+	if err := m.validateFills(); err != nil {
+		return err
+	}
+	return m.validateClaimListing()
+}
+
+func (m *DemandOrder) validateClaimListing() error {
+	l := m.ClaimListing
+	if l == nil {
+		return nil
+	}
+	if !m.IsFulfilled() || len(m.Fills) != 0 {
+		return errorsmod.Wrap(ErrInvalidClaimListing, "only the claim on an unsplit fulfilled order can be listed")
+	}
+	if _, err := sdk.AccAddressFromBech32(l.Seller); err != nil {
+		return errorsmod.Wrap(ErrInvalidClaimListing, "seller")
+	}
+	return errorsmod.Wrap(validateClaimPrice(l.Price), "claim listing")
+}
+
+// validateClaimPrice checks the price of a claim listing is a valid positive
+// coin.
+func validateClaimPrice(price sdk.Coin) error {
+	if err := price.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidClaimListing, err.Error())
+	}
+	if !price.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidClaimListing, "price must be positive")
+	}
+	return nil
+}
+
+func (m *DemandOrder) validateFills() error {
+	if len(m.Fills) == 0 {
+		return nil
+	}
+	if MaxOrderFills < len(m.Fills) {
+		return errorsmod.Wrapf(ErrInvalidFill, "too many fills: %d", len(m.Fills))
+	}
+	if m.CompletionHook != nil {
+		return errorsmod.Wrap(ErrInvalidFill, "order with completion hook")
+	}
+	filled := math.ZeroInt()
+	for _, f := range m.Fills {
+		if _, err := sdk.AccAddressFromBech32(f.Fulfiller); err != nil {
+			return errorsmod.Wrap(ErrInvalidFill, "fulfiller")
+		}
+		if f.Amount.IsNil() || !f.Amount.IsPositive() {
+			return errorsmod.Wrap(ErrInvalidFill, "amount must be positive")
+		}
+		filled = filled.Add(f.Amount)
+	}
+	if filled.GT(m.PriceAmount()) {
+		return errorsmod.Wrapf(ErrInvalidFill, "fills exceed price: %s > %s", filled, m.PriceAmount())
+	}
 	return nil
 }
 
@@ -165,6 +226,84 @@ func (m *DemandOrder) ApplyEffectiveFee(height uint64) {
 	m.FeeEscalation = nil
 }
 
+// FilledAmount returns the part of the price paid by the order's fills.
+func (m *DemandOrder) FilledAmount() math.Int {
+	filled := math.ZeroInt()
+	for _, f := range m.Fills {
+		filled = filled.Add(f.Amount)
+	}
+	return filled
+}
+
+// UnfilledAmount returns the part of the price at the given height not yet
+// paid by fills. Equals EffectivePriceAmount(height) for orders not split.
+func (m *DemandOrder) UnfilledAmount(height uint64) math.Int {
+	return m.EffectivePriceAmount(height).Sub(m.FilledAmount())
+}
+
+// FeeShare returns the part of the fee at the given height earned by paying
+// amount of the price, rounded down.
+func (m *DemandOrder) FeeShare(height uint64, amount math.Int) math.Int {
+	fee := m.EffectiveFeeAmount(height)
+	price := m.EffectivePriceAmount(height)
+	if !price.IsPositive() {
+		return fee
+	}
+	return fee.Mul(amount).Quo(price)
+}
+
+// ExpectedProfit returns what fulfilling the rest of the order at the given
+// height earns: its share of received, what the packet pays on finalization,
+// less the unfilled price paid for it. Split orders share received pro rata
+// to the part of the price each fill paid.
+func (m *DemandOrder) ExpectedProfit(height uint64, received math.Int) math.Int {
+	unfilled := m.UnfilledAmount(height)
+	price := m.EffectivePriceAmount(height)
+	share := received
+	if price.IsPositive() && unfilled.LT(price) {
+		share = received.Mul(unfilled).Quo(price)
+	}
+	return share.Sub(unfilled)
+}
+
+// FillEscrowAddress is the account a split order's packet is redirected to.
+// It holds the transfer until finalization, then pays out FillPayouts.
+func (m *DemandOrder) FillEscrowAddress() sdk.AccAddress {
+	return address.Module(ModuleName, []byte("fills"), []byte(m.Id))
+}
+
+// FillPayout is one share of a split order's escrowed transfer.
+type FillPayout struct {
+	Recipient string
+	Amount    math.Int
+}
+
+// FillPayouts splits total, the transfer escrowed for a split order, between
+// its fulfillers pro rata to the part of the price each paid, rounding down.
+// The recipient keeps the share of any price left unfilled; otherwise rounding
+// dust goes to the first fulfiller.
+func (m *DemandOrder) FillPayouts(total math.Int) []FillPayout {
+	if len(m.Fills) == 0 {
+		return nil
+	}
+	price := m.PriceAmount()
+	payouts := make([]FillPayout, 0, len(m.Fills)+1)
+	rest := total
+	for _, f := range m.Fills {
+		share := total.Mul(f.Amount).Quo(price)
+		payouts = append(payouts, FillPayout{Recipient: f.Fulfiller, Amount: share})
+		rest = rest.Sub(share)
+	}
+	if !rest.IsPositive() {
+		return payouts
+	}
+	if m.FilledAmount().LT(price) {
+		return append(payouts, FillPayout{Recipient: m.Recipient, Amount: rest})
+	}
+	payouts[0].Amount = payouts[0].Amount.Add(rest)
+	return payouts
+}
+
 func (m *DemandOrder) ValidateOrderIsOutstanding() error {
 	// Check that the order is not fulfilled yet
 	if m.IsFulfilled() {
@@ -60,6 +60,14 @@ type DemandOrder struct {
 	// an optional time-based competitive fee escalation spec. nil means the fee
 	// is static (fee/price above are authoritative for all heights).
 	FeeEscalation *FeeEscalation `protobuf:"bytes,14,opt,name=fee_escalation,json=feeEscalation,proto3" json:"fee_escalation,omitempty"`
+	// fills records each part of the price paid by a fulfiller when the order is
+	// split. Empty when a single fulfiller paid the whole price. Once fills cover
+	// the price, fulfiller_address is the order's fill escrow, which pays each
+	// fulfiller its share when the packet finalizes.
+	Fills []OrderFill `protobuf:"bytes,15,rep,name=fills,proto3" json:"fills"`
+	// claim_listing, if set, offers the claim on the fulfilled order's packet
+	// for sale. It is cleared when the claim is sold or the packet settles.
+	ClaimListing *ClaimListing `protobuf:"bytes,16,opt,name=claim_listing,json=claimListing,proto3" json:"claim_listing,omitempty"`
 }
 
 func (m *DemandOrder) Reset()         { *m = DemandOrder{} }
@@ -187,6 +195,125 @@ func (m *DemandOrder) GetFeeEscalation() *FeeEscalation {
 	return nil
 }
 
+func (m *DemandOrder) GetFills() []OrderFill {
+	if m != nil {
+		return m.Fills
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetClaimListing() *ClaimListing {
+	if m != nil {
+		return m.ClaimListing
+	}
+	return nil
+}
+
+// ClaimListing is a holder's offer to sell the claim on a fulfilled order's
+// packet, i.e. the right to its funds on finalization.
+type ClaimListing struct {
+	// seller is the bech32-encoded address of the claim holder which listed it.
+	Seller string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	// price is what a buyer pays the seller for the claim.
+	Price types.Coin `protobuf:"bytes,2,opt,name=price,proto3" json:"price"`
+}
+
+func (m *ClaimListing) Reset()         { *m = ClaimListing{} }
+func (m *ClaimListing) String() string { return proto.CompactTextString(m) }
+func (*ClaimListing) ProtoMessage()    {}
+func (*ClaimListing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{1}
+}
+func (m *ClaimListing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimListing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimListing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimListing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimListing.Merge(m, src)
+}
+func (m *ClaimListing) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimListing) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimListing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimListing proto.InternalMessageInfo
+
+func (m *ClaimListing) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *ClaimListing) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+// OrderFill is one fulfiller's part of a split demand order.
+type OrderFill struct {
+	// fulfiller is the bech32-encoded address which paid amount and receives its
+	// share of the transfer on finalization.
+	Fulfiller string `protobuf:"bytes,1,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the part of the price paid to the recipient.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *OrderFill) Reset()         { *m = OrderFill{} }
+func (m *OrderFill) String() string { return proto.CompactTextString(m) }
+func (*OrderFill) ProtoMessage()    {}
+func (*OrderFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{2}
+}
+func (m *OrderFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderFill.Merge(m, src)
+}
+func (m *OrderFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderFill proto.InternalMessageInfo
+
+func (m *OrderFill) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
 // FeeEscalation makes the offered fee rise (and the price fall) deterministically
 // with block height, from the base fee up to max_fee_amount over duration_blocks.
 // This is a Dutch auction from the recipient's cost perspective: price only falls.
@@ -202,7 +329,7 @@ func (m *FeeEscalation) Reset()         { *m = FeeEscalation{} }
 func (m *FeeEscalation) String() string { return proto.CompactTextString(m) }
 func (*FeeEscalation) ProtoMessage()    {}
 func (*FeeEscalation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2fc99140861fbacd, []int{1}
+	return fileDescriptor_2fc99140861fbacd, []int{3}
 }
 func (m *FeeEscalation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -240,6 +367,8 @@ func (m *FeeEscalation) GetDurationBlocks() uint64 {
 
 func init() {
 	proto.RegisterType((*DemandOrder)(nil), "dymensionxyz.dymension.eibc.DemandOrder")
+	proto.RegisterType((*ClaimListing)(nil), "dymensionxyz.dymension.eibc.ClaimListing")
+	proto.RegisterType((*OrderFill)(nil), "dymensionxyz.dymension.eibc.OrderFill")
 	proto.RegisterType((*FeeEscalation)(nil), "dymensionxyz.dymension.eibc.FeeEscalation")
 }
 
@@ -248,48 +377,55 @@ func init() {
 }
 
 var fileDescriptor_2fc99140861fbacd = []byte{
-	// 654 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x96, 0x42, 0x60, 0x0a, 0x8b, 0x8c, 0xa0, 0x2b, 0x4a, 0x69, 0x48, 0x8c, 0x0d, 0xc6,
-	0x59, 0x0b, 0x37, 0x6f, 0xb4, 0x42, 0x20, 0x1c, 0xd4, 0xd5, 0x13, 0xc6, 0x6c, 0xa6, 0x3b, 0xaf,
-	0xed, 0x64, 0xff, 0xcc, 0x66, 0x67, 0x4a, 0x5a, 0x13, 0xbf, 0x83, 0x9f, 0xc3, 0x9b, 0xdf, 0x82,
-	0x23, 0x47, 0xe3, 0x01, 0x0d, 0x7c, 0x11, 0xb3, 0x33, 0xdb, 0xf2, 0x27, 0x01, 0x2e, 0x9e, 0x76,
-	0xe7, 0xf7, 0xde, 0x6f, 0x7e, 0xf3, 0x7e, 0xf3, 0xde, 0x20, 0xc2, 0x46, 0x31, 0x24, 0x92, 0x8b,
-	0x64, 0x38, 0xfa, 0xea, 0x4e, 0x16, 0x2e, 0xf0, 0x4e, 0xe0, 0x32, 0x88, 0x69, 0xc2, 0x7c, 0x91,
-	0x31, 0xc8, 0x48, 0x9a, 0x09, 0x25, 0xf0, 0xd3, 0xab, 0xf9, 0x97, 0x64, 0x92, 0xe7, 0xaf, 0xd6,
-	0x02, 0x21, 0x63, 0x21, 0xdd, 0x0e, 0x95, 0xe0, 0x1e, 0x37, 0x3b, 0xa0, 0x68, 0xd3, 0x0d, 0x04,
-	0x4f, 0x0c, 0x79, 0x75, 0xfb, 0x16, 0xb1, 0x40, 0xc4, 0xb1, 0xf9, 0xa4, 0x11, 0x28, 0x2e, 0x12,
-	0xbf, 0x2f, 0x44, 0x58, 0x90, 0xb6, 0xee, 0x26, 0x65, 0x22, 0x8a, 0x68, 0x9a, 0xfa, 0x29, 0x0d,
-	0x42, 0x50, 0x05, 0x67, 0xf3, 0x6e, 0x8e, 0x54, 0x54, 0x0d, 0x64, 0x91, 0xbb, 0xdc, 0x13, 0x3d,
-	0xa1, 0x7f, 0xdd, 0xfc, 0xcf, 0xa0, 0x1b, 0x3f, 0x67, 0x50, 0xf5, 0xad, 0x2e, 0xff, 0x5d, 0x5e,
-	0x3d, 0xb6, 0x51, 0x99, 0x33, 0xc7, 0xaa, 0x5b, 0x8d, 0x39, 0xaf, 0xcc, 0x19, 0x26, 0xe8, 0xa1,
-	0xca, 0x68, 0x10, 0xf2, 0xa4, 0x57, 0x48, 0xfb, 0x21, 0x8c, 0x9c, 0xb2, 0x4e, 0x58, 0x1a, 0x87,
-	0xde, 0xeb, 0xc8, 0x21, 0x8c, 0x30, 0x45, 0xd3, 0x69, 0xc6, 0x03, 0x70, 0xa6, 0xea, 0x53, 0x8d,
-	0xea, 0xd6, 0x13, 0x62, 0xac, 0x22, 0xb9, 0x55, 0xa4, 0xb0, 0x8a, 0xb4, 0x05, 0x4f, 0x5a, 0xaf,
-	0x4f, 0xce, 0xd6, 0x4b, 0x3f, 0xfe, 0xac, 0x37, 0x7a, 0x5c, 0xf5, 0x07, 0x1d, 0x12, 0x88, 0xd8,
-	0x2d, 0x7c, 0x35, 0x9f, 0x57, 0x92, 0x85, 0xae, 0x1a, 0xa5, 0x20, 0x35, 0x41, 0x7a, 0x66, 0x67,
-	0xfc, 0x05, 0x4d, 0x75, 0x01, 0x9c, 0xca, 0xff, 0x17, 0xc8, 0xf7, 0xc5, 0xcf, 0xd0, 0x5c, 0x06,
-	0x01, 0x4f, 0x39, 0x24, 0xca, 0x99, 0xd6, 0x75, 0x5e, 0x02, 0xf8, 0x0d, 0x7a, 0xcc, 0x20, 0xcd,
-	0x20, 0xa0, 0x0a, 0x98, 0xcf, 0xa5, 0xdf, 0x1d, 0x44, 0x5d, 0x1e, 0x45, 0xc0, 0x9c, 0x99, 0xba,
-	0xd5, 0x98, 0x6d, 0x95, 0x1d, 0xcb, 0x5b, 0xb9, 0x4c, 0x39, 0x90, 0x7b, 0xe3, 0x04, 0xfc, 0x19,
-	0x3d, 0xba, 0xe9, 0xa5, 0xb9, 0x21, 0x67, 0xb6, 0x6e, 0x35, 0xec, 0xad, 0xe7, 0xe4, 0x96, 0xa6,
-	0x33, 0xd7, 0x49, 0x3e, 0xea, 0x64, 0x6f, 0xf9, 0xba, 0xeb, 0x06, 0xc5, 0x6b, 0x08, 0x8d, 0x5b,
-	0x84, 0x33, 0x67, 0xae, 0x38, 0xb7, 0x41, 0x0e, 0x18, 0xde, 0x45, 0x95, 0xbc, 0x52, 0x07, 0x69,
-	0xa5, 0xe6, 0x3d, 0x4a, 0x9e, 0xe1, 0x19, 0x01, 0xf2, 0x69, 0x94, 0x82, 0xa7, 0xe9, 0xf8, 0x25,
-	0x5a, 0x1a, 0x17, 0x9c, 0xf9, 0x94, 0xb1, 0x0c, 0xa4, 0x74, 0xaa, 0x5a, 0xec, 0xc1, 0x24, 0xb0,
-	0x63, 0x70, 0xfc, 0x02, 0x2d, 0x06, 0x19, 0x50, 0xd3, 0xe8, 0xc0, 0x7b, 0x7d, 0xe5, 0xcc, 0xd7,
-	0xad, 0x46, 0xc5, 0xb3, 0xc7, 0xf0, 0xbe, 0x46, 0xf1, 0x11, 0x5a, 0xbc, 0x31, 0x13, 0xce, 0x42,
-	0xdd, 0x6a, 0x54, 0xef, 0x3d, 0x67, 0x7b, 0xc2, 0xda, 0x17, 0x22, 0x6c, 0xd3, 0x28, 0xf2, 0xec,
-	0xe0, 0x1a, 0x86, 0x3f, 0x20, 0xbb, 0x0b, 0xe0, 0x83, 0x0c, 0x68, 0xa4, 0x35, 0x1d, 0x5b, 0x6f,
-	0xbd, 0x49, 0xee, 0x98, 0x70, 0xb2, 0x07, 0xb0, 0x3b, 0x61, 0x78, 0x0b, 0xdd, 0xab, 0xcb, 0x8d,
-	0x6f, 0x68, 0xe1, 0x5a, 0x1c, 0xb7, 0x91, 0x1d, 0xd3, 0xa1, 0x9f, 0xeb, 0xd0, 0x58, 0x0c, 0x12,
-	0x65, 0x06, 0xa8, 0xb5, 0x96, 0x77, 0xe0, 0xef, 0xb3, 0xf5, 0x15, 0xd3, 0x6f, 0x92, 0x85, 0x84,
-	0x0b, 0x37, 0xa6, 0xaa, 0x4f, 0x0e, 0x12, 0xe5, 0xcd, 0xc7, 0x74, 0xb8, 0x07, 0xb0, 0xa3, 0x29,
-	0xb9, 0x5b, 0x6c, 0x90, 0x19, 0xb7, 0x3a, 0x91, 0x08, 0x42, 0xa9, 0xa7, 0xac, 0xe2, 0xd9, 0x63,
-	0xb8, 0xa5, 0xd1, 0xd6, 0xe1, 0xc9, 0x79, 0xcd, 0x3a, 0x3d, 0xaf, 0x59, 0x7f, 0xcf, 0x6b, 0xd6,
-	0xf7, 0x8b, 0x5a, 0xe9, 0xf4, 0xa2, 0x56, 0xfa, 0x75, 0x51, 0x2b, 0x1d, 0x35, 0xaf, 0x74, 0xfa,
-	0x2d, 0x2f, 0xc3, 0xf1, 0xb6, 0x3b, 0x34, 0x8f, 0x9e, 0x6e, 0xfc, 0xce, 0x8c, 0x7e, 0x06, 0xb6,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x20, 0x75, 0xce, 0x20, 0x05, 0x00, 0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x8e, 0x1b, 0x35,
+	0x14, 0xde, 0xc9, 0x66, 0x97, 0xae, 0x93, 0xcc, 0xb6, 0xa6, 0x2d, 0xa6, 0xd0, 0x6c, 0x14, 0x09,
+	0x08, 0x45, 0x78, 0xc8, 0xae, 0x7a, 0xc3, 0x5d, 0x13, 0x1a, 0x75, 0x55, 0xc4, 0xcf, 0xc0, 0x55,
+	0x51, 0x35, 0x38, 0xf6, 0x49, 0x62, 0xcd, 0x8f, 0x47, 0x63, 0xa7, 0x4a, 0x90, 0x78, 0x07, 0x9e,
+	0x83, 0xf7, 0x40, 0xea, 0x65, 0x2f, 0x11, 0x17, 0x05, 0xed, 0xbe, 0x08, 0x1a, 0x7b, 0xf2, 0xb3,
+	0x95, 0x36, 0xe5, 0x82, 0xab, 0xb1, 0x3f, 0x9f, 0xef, 0xfc, 0x9f, 0x33, 0x88, 0x8a, 0x65, 0x0a,
+	0x99, 0x96, 0x2a, 0x5b, 0x2c, 0x7f, 0x09, 0xd6, 0x97, 0x00, 0xe4, 0x98, 0x07, 0x02, 0x52, 0x96,
+	0x89, 0x48, 0x15, 0x02, 0x0a, 0x9a, 0x17, 0xca, 0x28, 0xfc, 0xc1, 0xb6, 0xfc, 0x86, 0x4c, 0x4b,
+	0xf9, 0x7b, 0x6d, 0xae, 0x74, 0xaa, 0x74, 0x30, 0x66, 0x1a, 0x82, 0x17, 0xfd, 0x31, 0x18, 0xd6,
+	0x0f, 0xb8, 0x92, 0x99, 0x23, 0xdf, 0x3b, 0xbb, 0xc6, 0x18, 0x57, 0x69, 0xea, 0x3e, 0x79, 0x02,
+	0x46, 0xaa, 0x2c, 0x9a, 0x29, 0x15, 0x57, 0xa4, 0xd3, 0xdd, 0xa4, 0x42, 0x25, 0x09, 0xcb, 0xf3,
+	0x28, 0x67, 0x3c, 0x06, 0x53, 0x71, 0x1e, 0xec, 0xe6, 0x68, 0xc3, 0xcc, 0x5c, 0x57, 0xb2, 0xb7,
+	0xa7, 0x6a, 0xaa, 0xec, 0x31, 0x28, 0x4f, 0x0e, 0xed, 0xfe, 0xf1, 0x0e, 0x6a, 0x7c, 0x65, 0xc3,
+	0xff, 0xb6, 0x8c, 0x1e, 0xfb, 0xa8, 0x26, 0x05, 0xf1, 0x3a, 0x5e, 0xef, 0x28, 0xac, 0x49, 0x81,
+	0x29, 0x7a, 0xd7, 0x14, 0x8c, 0xc7, 0x32, 0x9b, 0x56, 0xa6, 0xa3, 0x18, 0x96, 0xa4, 0x66, 0x05,
+	0x6e, 0xad, 0x9e, 0xbe, 0xb3, 0x2f, 0x4f, 0x61, 0x89, 0x19, 0x3a, 0xc8, 0x0b, 0xc9, 0x81, 0xec,
+	0x77, 0xf6, 0x7b, 0x8d, 0xd3, 0xf7, 0xa9, 0x4b, 0x15, 0x2d, 0x53, 0x45, 0xab, 0x54, 0xd1, 0xa1,
+	0x92, 0xd9, 0xe0, 0x8b, 0x97, 0xaf, 0x4f, 0xf6, 0x7e, 0xff, 0xfb, 0xa4, 0x37, 0x95, 0x66, 0x36,
+	0x1f, 0x53, 0xae, 0xd2, 0xa0, 0xca, 0xab, 0xfb, 0x7c, 0xae, 0x45, 0x1c, 0x98, 0x65, 0x0e, 0xda,
+	0x12, 0x74, 0xe8, 0x34, 0xe3, 0xe7, 0x68, 0x7f, 0x02, 0x40, 0xea, 0xff, 0xbf, 0x81, 0x52, 0x2f,
+	0xfe, 0x10, 0x1d, 0x15, 0xc0, 0x65, 0x2e, 0x21, 0x33, 0xe4, 0xc0, 0xc6, 0xb9, 0x01, 0xf0, 0x97,
+	0xe8, 0x3d, 0x01, 0x79, 0x01, 0x9c, 0x19, 0x10, 0x91, 0xd4, 0xd1, 0x64, 0x9e, 0x4c, 0x64, 0x92,
+	0x80, 0x20, 0x87, 0x1d, 0xaf, 0x77, 0x63, 0x50, 0x23, 0x5e, 0x78, 0x67, 0x23, 0x72, 0xae, 0x47,
+	0x2b, 0x01, 0xfc, 0x13, 0xba, 0xfb, 0x66, 0x2e, 0x5d, 0x85, 0xc8, 0x8d, 0x8e, 0xd7, 0xf3, 0x4f,
+	0x3f, 0xa2, 0xd7, 0x34, 0x9d, 0x2b, 0x27, 0xfd, 0xc1, 0x0a, 0x87, 0xb7, 0xaf, 0x66, 0xdd, 0xa1,
+	0xf8, 0x3e, 0x42, 0xab, 0x16, 0x91, 0x82, 0x1c, 0x55, 0x7e, 0x3b, 0xe4, 0x5c, 0xe0, 0xc7, 0xa8,
+	0x5e, 0x46, 0x4a, 0x90, 0xb5, 0xd4, 0x7f, 0x8b, 0xa5, 0xd0, 0xf1, 0x9c, 0x01, 0xfa, 0xe3, 0x32,
+	0x87, 0xd0, 0xd2, 0xf1, 0x67, 0xe8, 0xd6, 0x2a, 0xe0, 0x22, 0x62, 0x42, 0x14, 0xa0, 0x35, 0x69,
+	0x58, 0x63, 0x37, 0xd7, 0x0f, 0x8f, 0x1c, 0x8e, 0x3f, 0x41, 0xc7, 0xbc, 0x00, 0xe6, 0x1a, 0x1d,
+	0xe4, 0x74, 0x66, 0x48, 0xb3, 0xe3, 0xf5, 0xea, 0xa1, 0xbf, 0x82, 0x9f, 0x58, 0x14, 0x3f, 0x43,
+	0xc7, 0x6f, 0xcc, 0x04, 0x69, 0x75, 0xbc, 0x5e, 0xe3, 0xad, 0x7e, 0x0e, 0xd7, 0xac, 0x27, 0x4a,
+	0xc5, 0x43, 0x96, 0x24, 0xa1, 0xcf, 0xaf, 0x60, 0xf8, 0x7b, 0xe4, 0x4f, 0x00, 0x22, 0xd0, 0x9c,
+	0x25, 0xd6, 0x26, 0xf1, 0xad, 0xea, 0x07, 0x74, 0xc7, 0x84, 0xd3, 0x11, 0xc0, 0xe3, 0x35, 0x23,
+	0x6c, 0x4d, 0xb6, 0xaf, 0x78, 0x80, 0x0e, 0xca, 0x40, 0x35, 0x39, 0xb6, 0x2d, 0xf8, 0xf1, 0x4e,
+	0x4d, 0x76, 0xac, 0x46, 0x32, 0x49, 0x06, 0xf5, 0xb2, 0x1f, 0x43, 0x47, 0xc5, 0xdf, 0xa0, 0x16,
+	0x4f, 0x98, 0x4c, 0xa3, 0x44, 0x6a, 0x23, 0xb3, 0x29, 0xb9, 0x69, 0xbd, 0xfa, 0x74, 0xa7, 0xae,
+	0x61, 0xc9, 0xf8, 0xda, 0x11, 0xc2, 0x26, 0xdf, 0xba, 0x75, 0x9f, 0xa3, 0xe6, 0xf6, 0x2b, 0xbe,
+	0x8b, 0x0e, 0x35, 0x94, 0xc5, 0xa8, 0x66, 0xb9, 0xba, 0xe1, 0x87, 0xab, 0xf9, 0xac, 0x75, 0xbc,
+	0xdd, 0xe3, 0x53, 0xb9, 0x6b, 0xa5, 0xbb, 0x3f, 0xa3, 0xa3, 0x75, 0x20, 0xe5, 0x84, 0xac, 0x6b,
+	0x5d, 0xa9, 0xdf, 0x00, 0xf8, 0x21, 0x3a, 0x64, 0xa9, 0x9a, 0x67, 0xc6, 0x2d, 0x89, 0xc1, 0xfd,
+	0x52, 0xcf, 0x5f, 0xaf, 0x4f, 0xee, 0x38, 0x4b, 0x5a, 0xc4, 0x54, 0xaa, 0x20, 0x65, 0x66, 0x46,
+	0xcf, 0x33, 0x13, 0x56, 0xc2, 0xdd, 0x5f, 0x51, 0xeb, 0x4a, 0xd2, 0xf1, 0x10, 0xf9, 0x29, 0x5b,
+	0x44, 0x65, 0xf1, 0x2a, 0x7d, 0xde, 0x7f, 0xd1, 0xd7, 0x4c, 0xd9, 0x62, 0x04, 0xf0, 0xc8, 0x52,
+	0xca, 0x16, 0x14, 0xf3, 0xc2, 0xb5, 0xe0, 0x38, 0x51, 0x3c, 0xd6, 0xd6, 0xab, 0x7a, 0xe8, 0xaf,
+	0xe0, 0x81, 0x45, 0x07, 0x4f, 0x5f, 0x5e, 0xb4, 0xbd, 0x57, 0x17, 0x6d, 0xef, 0x9f, 0x8b, 0xb6,
+	0xf7, 0xdb, 0x65, 0x7b, 0xef, 0xd5, 0x65, 0x7b, 0xef, 0xcf, 0xcb, 0xf6, 0xde, 0xb3, 0xfe, 0xd6,
+	0xfa, 0xb8, 0x66, 0xdd, 0xbe, 0x38, 0x0b, 0x16, 0xee, 0x4f, 0x62, 0xb7, 0xc9, 0xf8, 0xd0, 0xee,
+	0xd6, 0xb3, 0x7f, 0x07, 0x00, 0x57, 0xba, 0x64, 0x78, 0x75, 0x06, 0x00, 0x00,
 }
 
 func (m *DemandOrder) Marshal() (dAtA []byte, err error) {
@@ -312,6 +448,34 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ClaimListing != nil {
+		{
+			size, err := m.ClaimListing.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Fills) > 0 {
+		for iNdEx := len(m.Fills) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fills[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if m.FeeEscalation != nil {
 		{
 			size, err := m.FeeEscalation.MarshalToSizedBuffer(dAtA[:i])
@@ -427,6 +591,86 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ClaimListing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimListing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimListing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FeeEscalation) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -534,6 +778,46 @@ func (m *DemandOrder) Size() (n int) {
 		l = m.FeeEscalation.Size()
 		n += 1 + l + sovDemandOrder(uint64(l))
 	}
+	if len(m.Fills) > 0 {
+		for _, e := range m.Fills {
+			l = e.Size()
+			n += 1 + l + sovDemandOrder(uint64(l))
+		}
+	}
+	if m.ClaimListing != nil {
+		l = m.ClaimListing.Size()
+		n += 2 + l + sovDemandOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *ClaimListing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	return n
+}
+
+func (m *OrderFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
 	return n
 }
 
@@ -963,6 +1247,307 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fills = append(m.Fills, OrderFill{})
+			if err := m.Fills[len(m.Fills)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimListing", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClaimListing == nil {
+				m.ClaimListing = &ClaimListing{}
+			}
+			if err := m.ClaimListing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimListing) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimListing: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimListing: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderFill) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderFill: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderFill: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDemandOrder(dAtA[iNdEx:])
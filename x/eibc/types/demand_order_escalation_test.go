@@ -6,6 +6,8 @@ import (
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
 )
 
 const escDenom = "stake"
@@ -77,3 +79,18 @@ func TestApplyEffectiveFee(t *testing.T) {
 	require.Equal(t, math.NewInt(50), static.GetFeeAmount())
 	require.Equal(t, math.NewInt(150), static.PriceAmount())
 }
+
+func TestExpectedProfit(t *testing.T) {
+	// the packet pays 200 after the bridging fee: price 150 + fee 50
+	received := math.NewInt(200)
+	esc := escOrder(&FeeEscalation{MaxFeeAmount: math.NewInt(150), DurationBlocks: 10})
+	require.Equal(t, math.NewInt(50), esc.ExpectedProfit(100, received))
+	// escalation lowers the price paid for the same transfer
+	require.Equal(t, math.NewInt(100), esc.ExpectedProfit(105, received))
+	// a raised bridging fee eats into the fee
+	require.Equal(t, math.NewInt(40), escOrder(nil).ExpectedProfit(105, math.NewInt(190)))
+
+	// the rest of a split order earns its share of the transfer
+	split := fillOrder(OrderFill{Fulfiller: sample.AccAddress(), Amount: math.NewInt(60)})
+	require.Equal(t, math.NewInt(4), split.ExpectedProfit(1, math.NewInt(110)))
+}
@@ -0,0 +1,102 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+)
+
+func fillOrder(fills ...OrderFill) *DemandOrder {
+	return &DemandOrder{
+		Id:             "order",
+		Price:          sdk.NewCoins(sdk.NewCoin(escDenom, math.NewInt(100))),
+		Fee:            sdk.NewCoins(sdk.NewCoin(escDenom, math.NewInt(10))),
+		Recipient:      sample.AccAddress(),
+		CreationHeight: 1,
+		Fills:          fills,
+	}
+}
+
+func TestFillPayouts(t *testing.T) {
+	a, b := sample.AccAddress(), sample.AccAddress()
+
+	o := fillOrder(OrderFill{Fulfiller: a, Amount: math.NewInt(70)}, OrderFill{Fulfiller: b, Amount: math.NewInt(30)})
+	require.True(t, o.UnfilledAmount(1).IsZero())
+	require.Equal(t, math.NewInt(3), o.FeeShare(1, math.NewInt(30)))
+	// 110 delivered: pro rata, with the rounding dust to the first fulfiller
+	require.Equal(t, []FillPayout{
+		{Recipient: a, Amount: math.NewInt(77)},
+		{Recipient: b, Amount: math.NewInt(33)},
+	}, o.FillPayouts(math.NewInt(110)))
+	require.Equal(t, []FillPayout{
+		{Recipient: a, Amount: math.NewInt(8)},
+		{Recipient: b, Amount: math.NewInt(3)},
+	}, o.FillPayouts(math.NewInt(11)))
+
+	// the recipient keeps the share of the unfilled price
+	o = fillOrder(OrderFill{Fulfiller: a, Amount: math.NewInt(45)})
+	require.Equal(t, math.NewInt(55), o.UnfilledAmount(1))
+	require.Equal(t, []FillPayout{
+		{Recipient: a, Amount: math.NewInt(49)},
+		{Recipient: o.Recipient, Amount: math.NewInt(61)},
+	}, o.FillPayouts(math.NewInt(110)))
+
+	require.Nil(t, fillOrder().FillPayouts(math.NewInt(110)))
+	require.Equal(t, fillOrder().FillEscrowAddress(), fillOrder().FillEscrowAddress())
+}
+
+func TestValidateFills(t *testing.T) {
+	a := sample.AccAddress()
+	require.NoError(t, fillOrder(OrderFill{Fulfiller: a, Amount: math.NewInt(100)}).ValidateBasic())
+	require.ErrorIs(t, fillOrder(OrderFill{Fulfiller: a, Amount: math.NewInt(101)}).ValidateBasic(), ErrInvalidFill)
+	require.ErrorIs(t, fillOrder(OrderFill{Fulfiller: a, Amount: math.ZeroInt()}).ValidateBasic(), ErrInvalidFill)
+	require.ErrorIs(t, fillOrder(OrderFill{Fulfiller: "x", Amount: math.NewInt(1)}).ValidateBasic(), ErrInvalidFill)
+
+	o := fillOrder(OrderFill{Fulfiller: a, Amount: math.NewInt(1)})
+	o.CompletionHook = &commontypes.CompletionHookCall{Name: "hook"}
+	require.ErrorIs(t, o.ValidateBasic(), ErrInvalidFill)
+}
+
+func TestLPFillCapacity(t *testing.T) {
+	r := OnDemandLPRecord{
+		Lp: &OnDemandLP{
+			MaxPrice:        math.NewInt(60),
+			MinFee:          math.LegacyMustNewDecFromStr("0.05"),
+			SpendLimit:      math.NewInt(1000),
+			MinFeeAbsolute:  math.NewInt(2),
+			RateLimitAmount: math.NewInt(50),
+			RateLimitBlocks: 10,
+		},
+		Spent:       math.ZeroInt(),
+		WindowSpent: math.ZeroInt(),
+	}
+	o := fillOrder()
+	require.False(t, r.Accepts(5, o))
+	// bounded by the rate window, then by funds
+	require.Equal(t, math.NewInt(50), r.FillCapacity(5, o, math.NewInt(1000)))
+	require.Equal(t, math.NewInt(20), r.FillCapacity(5, o, math.NewInt(20)))
+	// a fee share under the absolute minimum is refused
+	require.True(t, r.FillCapacity(5, o, math.NewInt(19)).IsZero())
+
+	r.Lp.MinFee = math.LegacyMustNewDecFromStr("0.2")
+	require.True(t, r.FillCapacity(5, o, math.NewInt(1000)).IsZero())
+}
+
+func TestValidateClaimListing(t *testing.T) {
+	o := fillOrder()
+	o.ClaimListing = &ClaimListing{Seller: sample.AccAddress(), Price: sdk.NewInt64Coin(escDenom, 105)}
+	require.ErrorIs(t, o.ValidateBasic(), ErrInvalidClaimListing)
+
+	o.FulfillerAddress = sample.AccAddress()
+	require.NoError(t, o.ValidateBasic())
+
+	o.ClaimListing.Price = sdk.NewInt64Coin(escDenom, 0)
+	require.ErrorIs(t, o.ValidateBasic(), ErrInvalidClaimListing)
+	o.ClaimListing = &ClaimListing{Seller: "x", Price: sdk.NewInt64Coin(escDenom, 105)}
+	require.ErrorIs(t, o.ValidateBasic(), ErrInvalidClaimListing)
+}
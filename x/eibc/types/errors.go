@@ -15,6 +15,10 @@ var (
 	ErrEmptyPrice                  = gerrc.ErrInvalidArgument.Wrap("price must be greater than 0")
 	ErrDemandAlreadyFulfilled      = gerrc.ErrFailedPrecondition.Wrap("demand order already fulfilled")
 	ErrDemandOrderInactive         = gerrc.ErrInvalidArgument.Wrap("demand order inactive")
+	ErrDemandOrderPartiallyFilled  = gerrc.ErrFailedPrecondition.Wrap("demand order partially filled")
+	ErrInvalidFill                 = gerrc.ErrInvalidArgument.Wrap("order fill")
+	ErrInvalidClaimListing         = gerrc.ErrInvalidArgument.Wrap("claim listing")
+	ErrClaimNotListed              = gerrc.ErrNotFound.Wrap("order claim not listed")
 	ErrInvalidOrderID              = errorsmod.Register(ModuleName, 3, "invalid order ID")
 	ErrDemandOrderAlreadyExist     = errorsmod.Register(ModuleName, 4, "demand order already exists")
 	ErrDemandOrderDoesNotExist     = errorsmod.Register(ModuleName, 5, "demand order does not exist")
@@ -5,7 +5,7 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	types "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -672,6 +672,8 @@ type EventMatchedOnDemandLP struct {
 	OrderId   string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	LpId      uint64 `protobuf:"varint,2,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
 	Fulfiller string `protobuf:"bytes,3,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the part of the price the LP paid.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
 func (m *EventMatchedOnDemandLP) Reset()         { *m = EventMatchedOnDemandLP{} }
@@ -728,6 +730,337 @@ func (m *EventMatchedOnDemandLP) GetFulfiller() string {
 	return ""
 }
 
+func (m *EventMatchedOnDemandLP) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// EventDemandOrderFilled is emitted when a fulfiller pays part of a split
+// demand order.
+type EventDemandOrderFilled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// fulfiller is the address of the fulfiller.
+	Fulfiller string `protobuf:"bytes,2,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the part of the price paid.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// unfilled is the part of the price still unpaid.
+	Unfilled string `protobuf:"bytes,4,opt,name=unfilled,proto3" json:"unfilled,omitempty"`
+}
+
+func (m *EventDemandOrderFilled) Reset()         { *m = EventDemandOrderFilled{} }
+func (m *EventDemandOrderFilled) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderFilled) ProtoMessage()    {}
+func (*EventDemandOrderFilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{7}
+}
+func (m *EventDemandOrderFilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderFilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderFilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDemandOrderFilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderFilled.Merge(m, src)
+}
+func (m *EventDemandOrderFilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderFilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderFilled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderFilled proto.InternalMessageInfo
+
+func (m *EventDemandOrderFilled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderFilled) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *EventDemandOrderFilled) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventDemandOrderFilled) GetUnfilled() string {
+	if m != nil {
+		return m.Unfilled
+	}
+	return ""
+}
+
+// EventDemandOrderFillSettled is emitted for each share of a split demand
+// order paid out from its fill escrow when the packet finalizes.
+type EventDemandOrderFillSettled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// recipient is a fulfiller, or the order recipient for the unfilled part.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount is the share paid.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *EventDemandOrderFillSettled) Reset()         { *m = EventDemandOrderFillSettled{} }
+func (m *EventDemandOrderFillSettled) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderFillSettled) ProtoMessage()    {}
+func (*EventDemandOrderFillSettled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{8}
+}
+func (m *EventDemandOrderFillSettled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderFillSettled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderFillSettled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDemandOrderFillSettled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderFillSettled.Merge(m, src)
+}
+func (m *EventDemandOrderFillSettled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderFillSettled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderFillSettled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderFillSettled proto.InternalMessageInfo
+
+func (m *EventDemandOrderFillSettled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderFillSettled) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventDemandOrderFillSettled) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// EventOrderClaimListed is emitted when the claim on a fulfilled demand order
+// is listed for sale, or its listing price changes.
+type EventOrderClaimListed struct {
+	OrderId string      `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Seller  string      `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Price   types1.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *EventOrderClaimListed) Reset()         { *m = EventOrderClaimListed{} }
+func (m *EventOrderClaimListed) String() string { return proto.CompactTextString(m) }
+func (*EventOrderClaimListed) ProtoMessage()    {}
+func (*EventOrderClaimListed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{9}
+}
+func (m *EventOrderClaimListed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderClaimListed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderClaimListed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderClaimListed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderClaimListed.Merge(m, src)
+}
+func (m *EventOrderClaimListed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderClaimListed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderClaimListed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderClaimListed proto.InternalMessageInfo
+
+func (m *EventOrderClaimListed) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventOrderClaimListed) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventOrderClaimListed) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types1.Coin{}
+}
+
+// EventOrderClaimDelisted is emitted when a claim listing is withdrawn.
+type EventOrderClaimDelisted struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Seller  string `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+}
+
+func (m *EventOrderClaimDelisted) Reset()         { *m = EventOrderClaimDelisted{} }
+func (m *EventOrderClaimDelisted) String() string { return proto.CompactTextString(m) }
+func (*EventOrderClaimDelisted) ProtoMessage()    {}
+func (*EventOrderClaimDelisted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{10}
+}
+func (m *EventOrderClaimDelisted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderClaimDelisted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderClaimDelisted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderClaimDelisted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderClaimDelisted.Merge(m, src)
+}
+func (m *EventOrderClaimDelisted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderClaimDelisted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderClaimDelisted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderClaimDelisted proto.InternalMessageInfo
+
+func (m *EventOrderClaimDelisted) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventOrderClaimDelisted) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+// EventOrderClaimSold is emitted when a listed claim is bought. The buyer is
+// the order's fulfiller from then on.
+type EventOrderClaimSold struct {
+	OrderId string      `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Seller  string      `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	Buyer   string      `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Price   types1.Coin `protobuf:"bytes,4,opt,name=price,proto3" json:"price"`
+}
+
+func (m *EventOrderClaimSold) Reset()         { *m = EventOrderClaimSold{} }
+func (m *EventOrderClaimSold) String() string { return proto.CompactTextString(m) }
+func (*EventOrderClaimSold) ProtoMessage()    {}
+func (*EventOrderClaimSold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{11}
+}
+func (m *EventOrderClaimSold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderClaimSold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderClaimSold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderClaimSold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderClaimSold.Merge(m, src)
+}
+func (m *EventOrderClaimSold) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderClaimSold) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderClaimSold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderClaimSold proto.InternalMessageInfo
+
+func (m *EventOrderClaimSold) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventOrderClaimSold) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *EventOrderClaimSold) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *EventOrderClaimSold) GetPrice() types1.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types1.Coin{}
+}
+
 type EventCreatedOnDemandLP struct {
 	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
@@ -737,7 +1070,7 @@ func (m *EventCreatedOnDemandLP) Reset()         { *m = EventCreatedOnDemandLP{}
 func (m *EventCreatedOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedOnDemandLP) ProtoMessage()    {}
 func (*EventCreatedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{7}
+	return fileDescriptor_87abe4479c806cf7, []int{12}
 }
 func (m *EventCreatedOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,6 +1113,77 @@ func (m *EventCreatedOnDemandLP) GetFundsAddr() string {
 	return ""
 }
 
+// EventOnDemandLPSkipped is emitted when an LP which would accept an order is
+// not matched because a health condition of the rollapp is violated.
+type EventOnDemandLPSkipped struct {
+	LpId      uint64 `protobuf:"varint,1,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	OrderId   string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// human readable
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventOnDemandLPSkipped) Reset()         { *m = EventOnDemandLPSkipped{} }
+func (m *EventOnDemandLPSkipped) String() string { return proto.CompactTextString(m) }
+func (*EventOnDemandLPSkipped) ProtoMessage()    {}
+func (*EventOnDemandLPSkipped) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{13}
+}
+func (m *EventOnDemandLPSkipped) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOnDemandLPSkipped) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOnDemandLPSkipped.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOnDemandLPSkipped) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOnDemandLPSkipped.Merge(m, src)
+}
+func (m *EventOnDemandLPSkipped) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOnDemandLPSkipped) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOnDemandLPSkipped.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOnDemandLPSkipped proto.InternalMessageInfo
+
+func (m *EventOnDemandLPSkipped) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+func (m *EventOnDemandLPSkipped) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventOnDemandLPSkipped) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventOnDemandLPSkipped) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 type EventDeletedOnDemandLP struct {
 	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	FundsAddr string `protobuf:"bytes,2,opt,name=funds_addr,json=fundsAddr,proto3" json:"funds_addr,omitempty"`
@@ -791,7 +1195,7 @@ func (m *EventDeletedOnDemandLP) Reset()         { *m = EventDeletedOnDemandLP{}
 func (m *EventDeletedOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*EventDeletedOnDemandLP) ProtoMessage()    {}
 func (*EventDeletedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{8}
+	return fileDescriptor_87abe4479c806cf7, []int{14}
 }
 func (m *EventDeletedOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -849,7 +1253,13 @@ func init() {
 	proto.RegisterType((*EventDemandOrderFulfilledAuthorized)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilledAuthorized")
 	proto.RegisterType((*EventDemandOrderDeleted)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderDeleted")
 	proto.RegisterType((*EventMatchedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventMatchedOnDemandLP")
+	proto.RegisterType((*EventDemandOrderFilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFilled")
+	proto.RegisterType((*EventDemandOrderFillSettled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFillSettled")
+	proto.RegisterType((*EventOrderClaimListed)(nil), "dymensionxyz.dymension.eibc.EventOrderClaimListed")
+	proto.RegisterType((*EventOrderClaimDelisted)(nil), "dymensionxyz.dymension.eibc.EventOrderClaimDelisted")
+	proto.RegisterType((*EventOrderClaimSold)(nil), "dymensionxyz.dymension.eibc.EventOrderClaimSold")
 	proto.RegisterType((*EventCreatedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventCreatedOnDemandLP")
+	proto.RegisterType((*EventOnDemandLPSkipped)(nil), "dymensionxyz.dymension.eibc.EventOnDemandLPSkipped")
 	proto.RegisterType((*EventDeletedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventDeletedOnDemandLP")
 }
 
@@ -858,57 +1268,68 @@ func init() {
 }
 
 var fileDescriptor_87abe4479c806cf7 = []byte{
-	// 789 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x52, 0xdb, 0x48,
-	0x10, 0x46, 0xf2, 0x7f, 0xdb, 0xd8, 0xac, 0x96, 0x02, 0xe1, 0x05, 0x2f, 0x98, 0xa2, 0xd6, 0xbb,
-	0x07, 0xab, 0x58, 0x9e, 0x00, 0x96, 0xf5, 0x2e, 0xc5, 0x6e, 0x41, 0x9c, 0xe4, 0x92, 0x8b, 0x4b,
-	0x96, 0x5a, 0xf6, 0x14, 0xb2, 0x46, 0x25, 0x8d, 0x0d, 0xe6, 0x11, 0x72, 0xca, 0x73, 0xe4, 0x01,
-	0x52, 0x79, 0x84, 0x1c, 0x39, 0xe6, 0x48, 0x41, 0xe5, 0x3d, 0x52, 0x33, 0x1a, 0x19, 0x5b, 0xe6,
-	0x27, 0x45, 0xe5, 0x94, 0x9b, 0xfb, 0x9b, 0x56, 0x77, 0x4f, 0x7f, 0x5f, 0xf7, 0x18, 0x1a, 0xf6,
-	0x78, 0x80, 0x5e, 0x48, 0xa8, 0x77, 0x31, 0xbe, 0x34, 0x26, 0x86, 0x81, 0xa4, 0x6b, 0x19, 0x38,
-	0x42, 0x8f, 0x85, 0x4d, 0x3f, 0xa0, 0x8c, 0x6a, 0xbf, 0x4c, 0x7b, 0x36, 0x27, 0x46, 0x93, 0x7b,
-	0x56, 0x97, 0x7b, 0xb4, 0x47, 0x85, 0x9f, 0xc1, 0x7f, 0x45, 0x9f, 0x54, 0xff, 0x78, 0x20, 0xb8,
-	0x45, 0x07, 0x03, 0xea, 0x19, 0x21, 0x33, 0xd9, 0x50, 0x86, 0xaf, 0xd6, 0x2c, 0x1a, 0x0e, 0x68,
-	0x68, 0x74, 0xcd, 0x10, 0x8d, 0xd1, 0x6e, 0x17, 0x99, 0xb9, 0x6b, 0x58, 0x94, 0x78, 0xd1, 0x79,
-	0xfd, 0x5a, 0x85, 0xd5, 0xbf, 0x79, 0x3d, 0x87, 0x38, 0x30, 0x3d, 0xfb, 0x24, 0xb0, 0x31, 0xf8,
-	0x2b, 0x40, 0x93, 0xa1, 0xad, 0xad, 0x41, 0x9e, 0x72, 0xbb, 0x43, 0x6c, 0x5d, 0xd9, 0x54, 0x1a,
-	0x85, 0x76, 0x4e, 0xd8, 0x47, 0xb6, 0xb6, 0x0c, 0x19, 0x3f, 0x20, 0x16, 0xea, 0xaa, 0xc0, 0x23,
-	0x43, 0x5b, 0x82, 0x94, 0x83, 0xa8, 0xa7, 0x04, 0xc6, 0x7f, 0x6a, 0x3b, 0x50, 0x22, 0x61, 0xc7,
-	0x19, 0xba, 0x0e, 0x71, 0x5d, 0xb4, 0xf5, 0xf4, 0xa6, 0xd2, 0xc8, 0x1f, 0xa8, 0xba, 0xd2, 0x2e,
-	0x92, 0xb0, 0x15, 0xc3, 0xda, 0x36, 0x2c, 0xfa, 0xa6, 0x75, 0x86, 0xac, 0x13, 0x15, 0xaf, 0x67,
-	0x44, 0x88, 0x52, 0x04, 0xbe, 0x14, 0x98, 0xb6, 0x01, 0x20, 0x9d, 0xce, 0x70, 0xac, 0x67, 0x85,
-	0x47, 0x21, 0x42, 0x8e, 0x71, 0xcc, 0x8f, 0x03, 0xea, 0xba, 0xa6, 0xef, 0xf3, 0x7a, 0x73, 0xd1,
-	0xb1, 0x44, 0x8e, 0x6c, 0x6d, 0x1d, 0x0a, 0x01, 0x5a, 0xc4, 0x27, 0xe8, 0x31, 0x3d, 0x2f, 0x4f,
-	0x63, 0x40, 0xfb, 0x15, 0x8a, 0x32, 0x36, 0x1b, 0xfb, 0xa8, 0x17, 0xc4, 0xb9, 0x4c, 0xf7, 0x6a,
-	0xec, 0xa3, 0xb6, 0x05, 0x25, 0x3f, 0xa0, 0xd4, 0xe9, 0xf4, 0x91, 0xf4, 0xfa, 0x4c, 0x87, 0x4d,
-	0xa5, 0x91, 0x6e, 0x17, 0x05, 0xf6, 0xaf, 0x80, 0xb4, 0x15, 0xc8, 0x9a, 0x03, 0x3a, 0xf4, 0x98,
-	0x5e, 0x14, 0x9f, 0x4b, 0xab, 0xfe, 0x41, 0x81, 0xed, 0x64, 0x8b, 0x4f, 0xa7, 0x2e, 0xf6, 0xda,
-	0xb7, 0x9f, 0x6a, 0xf7, 0x0b, 0xf8, 0xc9, 0xc3, 0xf3, 0xce, 0x6c, 0x8f, 0x78, 0xeb, 0xcb, 0x7f,
-	0xee, 0x34, 0x1f, 0x10, 0x50, 0xa4, 0x86, 0x66, 0x94, 0xa3, 0x5d, 0xf1, 0xf0, 0x7c, 0x3a, 0x29,
-	0xbf, 0xd0, 0x0c, 0x33, 0x9c, 0xb4, 0xfc, 0x0c, 0x2b, 0xf5, 0x2f, 0x0a, 0x54, 0x93, 0x85, 0xb7,
-	0x10, 0xbf, 0xa1, 0xde, 0x55, 0xc8, 0xf1, 0x7a, 0xb9, 0x18, 0x22, 0x81, 0x64, 0x3d, 0x3c, 0x6f,
-	0x21, 0xde, 0xe9, 0x26, 0x35, 0xad, 0x9b, 0x39, 0xfa, 0xd3, 0xf7, 0xd3, 0x3f, 0xc5, 0x6f, 0x26,
-	0xc9, 0x6f, 0x92, 0xa0, 0xec, 0x63, 0x04, 0xe5, 0x66, 0x08, 0x7a, 0xab, 0xc2, 0xda, 0xdc, 0x3d,
-	0x27, 0xda, 0xfc, 0x0e, 0x53, 0xb0, 0x75, 0xdf, 0x14, 0x3c, 0x63, 0x02, 0xd6, 0xa1, 0x10, 0x07,
-	0x09, 0xa4, 0x46, 0xef, 0x80, 0xa4, 0x86, 0x61, 0x4e, 0xc3, 0xdb, 0xb0, 0x88, 0x8e, 0x83, 0x16,
-	0x23, 0x23, 0x14, 0xdc, 0x44, 0x3a, 0x2d, 0x4d, 0xc0, 0x16, 0x62, 0xfd, 0x7d, 0x6a, 0x5e, 0xad,
-	0x93, 0x32, 0xf7, 0x87, 0xac, 0x4f, 0x03, 0x72, 0xf9, 0x43, 0xb5, 0xe5, 0x37, 0xa8, 0x58, 0x7c,
-	0xe3, 0x11, 0xea, 0xc5, 0xe2, 0x29, 0x0a, 0xf1, 0x94, 0x63, 0x58, 0xea, 0x67, 0x03, 0xc0, 0xf5,
-	0x3b, 0xa6, 0x6d, 0x07, 0x18, 0x86, 0x7a, 0x29, 0x4a, 0xe4, 0xfa, 0xfb, 0x11, 0xa0, 0xfd, 0x0e,
-	0x4b, 0xd4, 0xc7, 0xc0, 0x64, 0x34, 0x98, 0x38, 0x2d, 0x0a, 0xa7, 0x4a, 0x8c, 0xc7, 0xae, 0x5b,
-	0x50, 0x9a, 0xb8, 0xf2, 0xa6, 0x94, 0x85, 0x5b, 0x31, 0xc6, 0xf8, 0xa4, 0xcc, 0x91, 0x55, 0xb9,
-	0x87, 0xac, 0x8f, 0xca, 0xfc, 0xf6, 0x3e, 0x44, 0x17, 0x9f, 0x18, 0xcf, 0xd9, 0x4d, 0xaa, 0x26,
-	0x37, 0xe9, 0x5c, 0xd3, 0x53, 0x4f, 0x8e, 0x63, 0x3a, 0x39, 0x8e, 0x89, 0xae, 0x67, 0x92, 0x5d,
-	0xaf, 0x3b, 0xb0, 0x22, 0x2a, 0xff, 0xdf, 0x64, 0x56, 0x1f, 0xed, 0x13, 0x2f, 0xba, 0xc2, 0x7f,
-	0xa7, 0x8f, 0x15, 0xfe, 0x33, 0x64, 0x5c, 0x91, 0x4f, 0x15, 0x04, 0xa5, 0x5d, 0xb9, 0xd9, 0xef,
-	0xe8, 0x4f, 0x25, 0xe8, 0xaf, 0xff, 0x23, 0xf3, 0xc8, 0x47, 0x6d, 0x2a, 0x4f, 0x19, 0x54, 0x99,
-	0x21, 0xdd, 0x56, 0x89, 0xe8, 0x8a, 0x33, 0xf4, 0xec, 0x50, 0x90, 0x17, 0x77, 0x45, 0x20, 0x9c,
-	0xb6, 0x7a, 0x47, 0x06, 0x92, 0xfd, 0x7d, 0x76, 0x20, 0xbe, 0x86, 0x02, 0x34, 0x43, 0xea, 0xc9,
-	0x62, 0xa5, 0x75, 0x70, 0xfc, 0xe9, 0xa6, 0xa6, 0x5c, 0xdd, 0xd4, 0x94, 0xeb, 0x9b, 0x9a, 0xf2,
-	0xee, 0xb6, 0xb6, 0x70, 0x75, 0x5b, 0x5b, 0xf8, 0x7c, 0x5b, 0x5b, 0x78, 0xb3, 0xdb, 0x23, 0xac,
-	0x3f, 0xec, 0xf2, 0x95, 0x6e, 0x3c, 0xf0, 0xf6, 0x8f, 0xf6, 0x8c, 0x8b, 0xe8, 0xdf, 0x05, 0xef,
-	0x77, 0xd8, 0xcd, 0x8a, 0xe7, 0x7d, 0xef, 0x6b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x09, 0xf4, 0x0a,
-	0xe1, 0x89, 0x08, 0x00, 0x00,
+	// 972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0xa9, 0x1f, 0x4b, 0x23, 0xd9, 0x4e, 0x19, 0xd7, 0xa6, 0x95, 0x44, 0xb1, 0x69, 0x04,
+	0x75, 0x7b, 0x10, 0xe1, 0x04, 0x7d, 0x80, 0x38, 0xae, 0xda, 0x20, 0x2e, 0x92, 0xca, 0xed, 0xa5,
+	0x17, 0x81, 0x22, 0x47, 0xd6, 0xc2, 0xd4, 0x2e, 0x41, 0xae, 0xec, 0x28, 0x87, 0x16, 0x3d, 0xf4,
+	0xd2, 0x53, 0x2f, 0x7d, 0x89, 0x3e, 0x40, 0xd1, 0x47, 0xc8, 0x31, 0xc7, 0x9e, 0x8a, 0xc0, 0x46,
+	0xdf, 0xa3, 0xe0, 0xee, 0x92, 0xa2, 0x28, 0xc9, 0x6a, 0x8d, 0x9e, 0x7a, 0xd3, 0xcc, 0x8e, 0x76,
+	0x7e, 0xbe, 0xef, 0x1b, 0x2e, 0x1c, 0x78, 0xe3, 0x21, 0xd2, 0x88, 0x30, 0xfa, 0x7a, 0xfc, 0xc6,
+	0x4e, 0x0d, 0x1b, 0x49, 0xcf, 0xb5, 0xf1, 0x02, 0x29, 0x8f, 0x5a, 0x41, 0xc8, 0x38, 0x33, 0xee,
+	0x65, 0x23, 0x5b, 0xa9, 0xd1, 0x8a, 0x23, 0x1b, 0x9b, 0x67, 0xec, 0x8c, 0x89, 0x38, 0x3b, 0xfe,
+	0x25, 0xff, 0xd2, 0xf8, 0x64, 0xc1, 0xe5, 0x2e, 0x1b, 0x0e, 0x19, 0xb5, 0x23, 0xee, 0xf0, 0x91,
+	0xba, 0xbe, 0xd1, 0x74, 0x59, 0x34, 0x64, 0x91, 0xdd, 0x73, 0x22, 0xb4, 0x2f, 0x0e, 0x7b, 0xc8,
+	0x9d, 0x43, 0xdb, 0x65, 0x84, 0xca, 0x73, 0xeb, 0xbd, 0x0e, 0xdb, 0x9f, 0xc5, 0xf5, 0x1c, 0xe3,
+	0xd0, 0xa1, 0xde, 0xcb, 0xd0, 0xc3, 0xf0, 0x59, 0x88, 0x0e, 0x47, 0xcf, 0xd8, 0x81, 0x0a, 0x8b,
+	0xed, 0x2e, 0xf1, 0x4c, 0x6d, 0x57, 0x3b, 0xa8, 0x76, 0x56, 0x85, 0xfd, 0xdc, 0x33, 0x36, 0xa1,
+	0x14, 0x84, 0xc4, 0x45, 0x53, 0x17, 0x7e, 0x69, 0x18, 0x77, 0xa0, 0xd0, 0x47, 0x34, 0x0b, 0xc2,
+	0x17, 0xff, 0x34, 0x1e, 0x41, 0x9d, 0x44, 0xdd, 0xfe, 0xc8, 0xef, 0x13, 0xdf, 0x47, 0xcf, 0x2c,
+	0xee, 0x6a, 0x07, 0x95, 0x23, 0xdd, 0xd4, 0x3a, 0x35, 0x12, 0xb5, 0x13, 0xb7, 0xb1, 0x0f, 0x6b,
+	0x81, 0xe3, 0x9e, 0x23, 0xef, 0xca, 0xe2, 0xcd, 0x92, 0xb8, 0xa2, 0x2e, 0x9d, 0xa7, 0xc2, 0x67,
+	0x3c, 0x00, 0x50, 0x41, 0xe7, 0x38, 0x36, 0xcb, 0x22, 0xa2, 0x2a, 0x3d, 0x2f, 0x70, 0x1c, 0x1f,
+	0x87, 0xcc, 0xf7, 0x9d, 0x20, 0x88, 0xeb, 0x5d, 0x95, 0xc7, 0xca, 0xf3, 0xdc, 0x33, 0xee, 0x43,
+	0x35, 0x44, 0x97, 0x04, 0x04, 0x29, 0x37, 0x2b, 0xea, 0x34, 0x71, 0x18, 0x0f, 0xa1, 0xa6, 0xee,
+	0xe6, 0xe3, 0x00, 0xcd, 0xaa, 0x38, 0x57, 0xe9, 0xbe, 0x1e, 0x07, 0x68, 0xec, 0x41, 0x3d, 0x08,
+	0x19, 0xeb, 0x77, 0x07, 0x48, 0xce, 0x06, 0xdc, 0x84, 0x5d, 0xed, 0xa0, 0xd8, 0xa9, 0x09, 0xdf,
+	0x17, 0xc2, 0x65, 0x6c, 0x41, 0xd9, 0x19, 0xb2, 0x11, 0xe5, 0x66, 0x4d, 0xfc, 0x5d, 0x59, 0xd6,
+	0x6f, 0x1a, 0xec, 0xe7, 0x47, 0xfc, 0x2a, 0xd3, 0xd8, 0x37, 0x81, 0xb7, 0x6c, 0xdc, 0x5f, 0xc1,
+	0x07, 0x14, 0x2f, 0xbb, 0xd3, 0x33, 0x8a, 0x47, 0xbf, 0xfe, 0xf8, 0x51, 0x6b, 0x01, 0x81, 0x24,
+	0x1b, 0x5a, 0x32, 0x47, 0x67, 0x83, 0xe2, 0x65, 0x36, 0xa9, 0xb1, 0x97, 0x43, 0x26, 0x06, 0xad,
+	0x32, 0x85, 0x8a, 0xf5, 0x97, 0x06, 0x8d, 0x7c, 0xe1, 0x6d, 0xc4, 0x7f, 0x50, 0xef, 0x36, 0xac,
+	0xc6, 0xf5, 0xc6, 0x64, 0x90, 0x04, 0x29, 0x53, 0xbc, 0x6c, 0x23, 0x4e, 0x78, 0x53, 0xc8, 0xf2,
+	0x66, 0x06, 0xfe, 0xe2, 0x7c, 0xf8, 0x33, 0xf8, 0x96, 0xf2, 0xf8, 0xe6, 0x01, 0x2a, 0xdf, 0x04,
+	0xd0, 0xea, 0x14, 0x40, 0x3f, 0xe9, 0xb0, 0x33, 0xd3, 0x67, 0xca, 0xcd, 0xff, 0x40, 0x05, 0x7b,
+	0xf3, 0x54, 0x70, 0x0b, 0x05, 0xdc, 0x87, 0x6a, 0x72, 0x49, 0xa8, 0x38, 0x3a, 0x71, 0xe4, 0x39,
+	0x0c, 0x33, 0x1c, 0xde, 0x87, 0x35, 0xec, 0xf7, 0xd1, 0xe5, 0xe4, 0x02, 0x05, 0x36, 0x92, 0xa7,
+	0xf5, 0xd4, 0xd9, 0x46, 0xb4, 0x7e, 0x2d, 0xcc, 0xb2, 0x35, 0x2d, 0xf3, 0xe9, 0x88, 0x0f, 0x58,
+	0x48, 0xde, 0xfc, 0xaf, 0xc6, 0xf2, 0x11, 0x6c, 0xb8, 0xf1, 0xc6, 0x23, 0x8c, 0x26, 0xe4, 0xa9,
+	0x09, 0xf2, 0xac, 0x27, 0x6e, 0xc5, 0x9f, 0x07, 0x00, 0x7e, 0xd0, 0x75, 0x3c, 0x2f, 0xc4, 0x28,
+	0x32, 0xeb, 0x32, 0x91, 0x1f, 0x3c, 0x95, 0x0e, 0xe3, 0x63, 0xb8, 0xc3, 0x02, 0x0c, 0x1d, 0xce,
+	0xc2, 0x34, 0x68, 0x4d, 0x04, 0x6d, 0x24, 0xfe, 0x24, 0x74, 0x0f, 0xea, 0x69, 0x68, 0x3c, 0x94,
+	0x75, 0x11, 0x56, 0x4b, 0x7c, 0x6d, 0x9c, 0x03, 0xd6, 0xc6, 0x1c, 0xb0, 0x7e, 0xd7, 0x66, 0xb7,
+	0xf7, 0x31, 0xfa, 0xb8, 0x44, 0x9e, 0xd3, 0x9b, 0x54, 0xcf, 0x6f, 0xd2, 0x99, 0xa1, 0x17, 0x96,
+	0xca, 0xb1, 0x98, 0x97, 0x63, 0x6e, 0xea, 0xa5, 0xfc, 0xd4, 0xad, 0xef, 0x60, 0x4b, 0x54, 0xfe,
+	0xa5, 0xc3, 0xdd, 0x01, 0x7a, 0x2f, 0xa9, 0x6c, 0xe1, 0xe4, 0xd5, 0x4d, 0x85, 0xdf, 0x85, 0x92,
+	0x2f, 0xf2, 0xe9, 0x02, 0xa0, 0xa2, 0xaf, 0x36, 0xfb, 0x04, 0xfe, 0x42, 0x1e, 0xfe, 0x89, 0xe8,
+	0x8b, 0x53, 0xa2, 0xff, 0x51, 0x53, 0x05, 0x64, 0x79, 0xbe, 0x54, 0xf1, 0x53, 0xb9, 0xf4, 0xc5,
+	0xb9, 0x0a, 0xd9, 0x5c, 0x46, 0x03, 0x2a, 0x23, 0x9a, 0x21, 0x79, 0xb5, 0x93, 0xda, 0x16, 0x85,
+	0x7b, 0xf3, 0xca, 0x38, 0x45, 0xce, 0x97, 0xd7, 0x32, 0xf9, 0xa2, 0xe9, 0xf9, 0x2f, 0xda, 0x82,
+	0x5a, 0xac, 0x1f, 0x34, 0xf8, 0x50, 0x24, 0x94, 0x9f, 0x7a, 0xdf, 0x21, 0xc3, 0x13, 0x12, 0x2d,
+	0x21, 0xcc, 0x16, 0x94, 0x23, 0xcc, 0xf4, 0xac, 0x2c, 0xe3, 0xd3, 0xec, 0x3a, 0xaf, 0x3d, 0xde,
+	0x69, 0xc9, 0xd7, 0x46, 0x2b, 0x7e, 0x6d, 0xb4, 0xd4, 0x6b, 0xa3, 0xf5, 0x8c, 0x11, 0x7a, 0x54,
+	0x7c, 0xfb, 0xe7, 0xc3, 0x15, 0xb5, 0x0a, 0xac, 0x13, 0xd8, 0xce, 0x95, 0x70, 0x8c, 0xfe, 0x6d,
+	0x8b, 0xb0, 0x7e, 0xd1, 0xe0, 0x6e, 0xee, 0xba, 0x53, 0xe6, 0xdf, 0xaa, 0x9f, 0x4d, 0x28, 0xf5,
+	0x46, 0xe3, 0x94, 0x46, 0xd2, 0x98, 0x74, 0x59, 0xfc, 0x57, 0x5d, 0x7e, 0xae, 0x08, 0xa6, 0x9e,
+	0x53, 0x19, 0x86, 0xaf, 0x83, 0xae, 0x6a, 0x2a, 0x76, 0x74, 0x22, 0xf4, 0xd8, 0x1f, 0x51, 0x2f,
+	0x12, 0x6b, 0x63, 0x42, 0x2b, 0xea, 0x45, 0xf1, 0xc2, 0xb0, 0xbe, 0x57, 0x17, 0x4d, 0x6e, 0x38,
+	0x3d, 0x27, 0x41, 0x80, 0x19, 0x3d, 0x68, 0x19, 0x3d, 0x64, 0xfb, 0xd6, 0x67, 0x84, 0x9f, 0x11,
+	0x6d, 0x21, 0x2f, 0xda, 0x2d, 0x28, 0x87, 0xe8, 0x44, 0x8c, 0x26, 0x5a, 0x91, 0x96, 0xd5, 0x4d,
+	0xa5, 0x22, 0x56, 0xcb, 0xad, 0x3b, 0xc9, 0x24, 0x28, 0x64, 0x13, 0x1c, 0xbd, 0x78, 0x7b, 0xd5,
+	0xd4, 0xde, 0x5d, 0x35, 0xb5, 0xf7, 0x57, 0x4d, 0xed, 0xe7, 0xeb, 0xe6, 0xca, 0xbb, 0xeb, 0xe6,
+	0xca, 0x1f, 0xd7, 0xcd, 0x95, 0x6f, 0x0f, 0xcf, 0x08, 0x1f, 0x8c, 0x7a, 0xf1, 0x6b, 0xc6, 0x5e,
+	0xf0, 0xec, 0xbd, 0x78, 0x62, 0xbf, 0x96, 0x0f, 0xeb, 0x78, 0xd5, 0x44, 0xbd, 0xb2, 0x78, 0xd9,
+	0x3e, 0xf9, 0x7b, 0x00, 0xf5, 0x13, 0x05, 0x45, 0x84, 0x0b, 0x00, 0x00,
 }
 
 func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
@@ -1395,6 +1816,13 @@ func (m *EventMatchedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Fulfiller) > 0 {
 		i -= len(m.Fulfiller)
 		copy(dAtA[i:], m.Fulfiller)
@@ -1417,6 +1845,239 @@ func (m *EventMatchedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDemandOrderFilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Unfilled) > 0 {
+		i -= len(m.Unfilled)
+		copy(dAtA[i:], m.Unfilled)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Unfilled)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDemandOrderFillSettled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderFillSettled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderFillSettled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderClaimListed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderClaimListed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderClaimListed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderClaimDelisted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderClaimDelisted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderClaimDelisted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOrderClaimSold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderClaimSold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderClaimSold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventCreatedOnDemandLP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1452,6 +2113,55 @@ func (m *EventCreatedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *EventOnDemandLPSkipped) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOnDemandLPSkipped) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOnDemandLPSkipped) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LpId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventDeletedOnDemandLP) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1752,32 +2462,165 @@ func (m *EventMatchedOnDemandLP) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
 	return n
 }
 
-func (m *EventCreatedOnDemandLP) Size() (n int) {
+func (m *EventDemandOrderFilled) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovEvents(uint64(m.Id))
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
 	}
-	l = len(m.FundsAddr)
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Unfilled)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
 	return n
 }
 
-func (m *EventDeletedOnDemandLP) Size() (n int) {
+func (m *EventDemandOrderFillSettled) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Id != 0 {
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderClaimListed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventOrderClaimDelisted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOrderClaimSold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventCreatedOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovEvents(uint64(m.Id))
+	}
+	l = len(m.FundsAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOnDemandLPSkipped) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LpId != 0 {
+		n += 1 + sovEvents(uint64(m.LpId))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDeletedOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
 		n += 1 + sovEvents(uint64(m.Id))
 	}
 	l = len(m.FundsAddr)
@@ -3140,11 +3983,856 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 13:
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderDeleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventMatchedOnDemandLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventMatchedOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+			}
+			m.LpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unfilled", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unfilled = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderFillSettled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderFillSettled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderFillSettled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderClaimListed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderClaimListed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderClaimListed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3172,11 +4860,11 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3204,13 +4892,13 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorFee = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3220,23 +4908,24 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EffectiveFee = string(dAtA[iNdEx:postIndex])
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3259,7 +4948,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
+func (m *EventOrderClaimDelisted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3282,10 +4971,10 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDemandOrderDeleted: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOrderClaimDelisted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDemandOrderDeleted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOrderClaimDelisted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3322,7 +5011,7 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3350,11 +5039,61 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOrderClaimSold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderClaimSold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderClaimSold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3382,11 +5121,11 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3414,11 +5153,11 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3446,7 +5185,40 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3469,7 +5241,7 @@ func (m *EventDemandOrderDeleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *EventCreatedOnDemandLP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3492,49 +5264,17 @@ func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventMatchedOnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCreatedOnDemandLP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventMatchedOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCreatedOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.LpId = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3544,14 +5284,14 @@ func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LpId |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3579,7 +5319,7 @@ func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.FundsAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3602,7 +5342,7 @@ func (m *EventMatchedOnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCreatedOnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *EventOnDemandLPSkipped) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3625,17 +5365,17 @@ func (m *EventCreatedOnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCreatedOnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOnDemandLPSkipped: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCreatedOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOnDemandLPSkipped: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
 			}
-			m.Id = 0
+			m.LpId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3645,14 +5385,14 @@ func (m *EventCreatedOnDemandLP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				m.LpId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3680,7 +5420,71 @@ func (m *EventCreatedOnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundsAddr = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9,6 +9,7 @@ import (
 	agenttypes "github.com/dymensionxyz/dymension/v3/x/agent/types"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -18,24 +19,40 @@ type AccountKeeper interface {
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
-	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins // TODO: remove, not used
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 type DelayedAckKeeper interface {
 	GetRollappPacket(ctx sdk.Context, rollappPacketKey string) (*commontypes.RollappPacket, error)
+	UpdateRollappPacketTransferAddress(ctx sdk.Context, rollappPacketKey string, newRecipient string) error
 	BridgingFee(ctx sdk.Context) (res math.LegacyDec)
 	ValidateCompletionHook(info commontypes.CompletionHookCall) error
 }
 
 type RollappKeeper interface {
+	GetRollapp(ctx sdk.Context, rollappId string) (val rollapptypes.Rollapp, found bool)
 	GetLatestStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool)
 	IsHeightFinalized(ctx sdk.Context, rollappID string, height uint64) bool
 }
 
-// AgentKeeper provides liveness checks for LPs bound to an x/agent agent.
+// SequencerKeeper reports proposer changes for LP health conditions.
+type SequencerKeeper interface {
+	GetProposer(ctx sdk.Context, rollapp string) sequencertypes.Sequencer
+	RotationInProgress(ctx sdk.Context, rollapp string) bool
+	Kickable(ctx sdk.Context, proposer sequencertypes.Sequencer) bool
+}
+
+// LightClientKeeper reports canonical light clients for LP health conditions.
+type LightClientKeeper interface {
+	GetCanonicalClient(ctx sdk.Context, rollappId string) (string, bool)
+}
+
+// AgentKeeper provides liveness checks and reputation for LPs bound to an
+// x/agent agent.
 type AgentKeeper interface {
 	GetAgent(ctx sdk.Context, id string) (agenttypes.Agent, bool)
 	IsAgentLive(ctx sdk.Context, id string) bool
+	GetReputation(ctx sdk.Context, agentID string) (agenttypes.Reputation, bool)
 }
@@ -24,6 +24,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		EpochIdentifier: "hour",
 		TimeoutFee:      math.LegacyNewDecWithPrec(1, 1),
 		ErrackFee:       math.LegacyNewDecWithPrec(1, 1),
+		LpMatching:      types.LPMatching_LP_MATCHING_BEST_PRICE,
 	}
 
 	for _, tc := range []struct {
@@ -51,6 +52,17 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 			},
 			valid: false,
+		}, {
+			desc: "unknown lp matching mode",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					EpochIdentifier: "hour",
+					TimeoutFee:      math.LegacyNewDecWithPrec(1, 1),
+					ErrackFee:       math.LegacyNewDecWithPrec(1, 1),
+					LpMatching:      types.LPMatching(2),
+				},
+			},
+			valid: false,
 		}, {
 			desc:     "invalid demand order",
 			genState: &types.GenesisState{DemandOrders: []types.DemandOrder{{}}, Params: types.DefaultParams()},
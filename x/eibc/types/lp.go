@@ -111,11 +111,17 @@ func (r OnDemandLPRecord) RateAllows(nowHeight uint64, price math.Int) bool {
 	if !r.Lp.rateLimitEnabled() {
 		return true
 	}
+	return price.LTE(r.rateRemaining(nowHeight))
+}
+
+// rateRemaining returns what the LP may still spend in nowHeight's window.
+// Only meaningful when rate limiting is enabled.
+func (r OnDemandLPRecord) rateRemaining(nowHeight uint64) math.Int {
 	spent := math.ZeroInt()
 	if r.Lp.Bucket(nowHeight) == r.WindowStartHeight {
 		spent = r.windowSpentAmount()
 	}
-	return price.LTE(r.Lp.RateLimitAmount.Sub(spent))
+	return r.Lp.RateLimitAmount.Sub(spent)
 }
 
 // RecordSpend accounts a successful fill of price at nowHeight, updating both
@@ -134,12 +140,80 @@ func (r *OnDemandLPRecord) RecordSpend(nowHeight uint64, price math.Int) {
 	}
 }
 
+// Accepts reports whether the LP would pay all of the order's unfilled price
+// at nowHeight.
 func (r OnDemandLPRecord) Accepts(nowHeight uint64, o *DemandOrder) bool {
-	priceOK := o.EffectivePriceAmount(nowHeight).LTE(r.MaxSpend())
+	unfilled := o.UnfilledAmount(nowHeight)
+	priceOK := unfilled.LTE(r.MaxSpend())
+	minFeeAbsOK := o.FeeShare(nowHeight, unfilled).GTE(r.Lp.minFeeAbsolute())
+	rateOK := r.RateAllows(nowHeight, unfilled)
+	return priceOK && minFeeAbsOK && rateOK && r.acceptsTerms(nowHeight, o)
+}
+
+// FillCapacity returns the most of the order's unfilled price the LP would pay
+// at nowHeight out of funds, bounded by its spend limit, max price and rate
+// window. Zero if it rejects the order's terms, or if the fee share of that
+// part is under its absolute minimum.
+func (r OnDemandLPRecord) FillCapacity(nowHeight uint64, o *DemandOrder, funds math.Int) math.Int {
+	amount := math.MinInt(o.UnfilledAmount(nowHeight), math.MinInt(r.MaxSpend(), funds))
+	if r.Lp.rateLimitEnabled() {
+		amount = math.MinInt(amount, r.rateRemaining(nowHeight))
+	}
+	if !amount.IsPositive() || !r.acceptsTerms(nowHeight, o) || o.FeeShare(nowHeight, amount).LT(r.Lp.minFeeAbsolute()) {
+		return math.ZeroInt()
+	}
+	return amount
+}
+
+// QuotedFee is the least fee the LP asks for paying the order's unfilled price
+// at nowHeight: the larger of its spread and its absolute minimum.
+func (r OnDemandLPRecord) QuotedFee(nowHeight uint64, o *DemandOrder) math.LegacyDec {
+	spread := r.Lp.MinFee.MulInt(o.UnfilledAmount(nowHeight))
+	return math.LegacyMaxDec(spread, math.LegacyNewDecFromInt(r.Lp.minFeeAbsolute()))
+}
+
+// acceptsTerms checks everything but the amount: the fee rate, the order's
+// age and the LP's own validity window.
+func (r OnDemandLPRecord) acceptsTerms(nowHeight uint64, o *DemandOrder) bool {
 	feeOK := r.Lp.MinFee.LTE(o.EffectiveFeePercent(nowHeight))
-	minFeeAbsOK := o.EffectiveFeeAmount(nowHeight).GTE(r.Lp.minFeeAbsolute())
 	ageOK := r.Lp.OrderMinAgeBlocks <= nowHeight-o.CreationHeight
 	validOK := r.Lp.ValidUntilHeight == 0 || nowHeight < r.Lp.ValidUntilHeight
-	rateOK := r.RateAllows(nowHeight, o.PriceAmount())
-	return priceOK && feeOK && minFeeAbsOK && ageOK && validOK && rateOK
+	return feeOK && ageOK && validOK
+}
+
+// RollappHealth is the state of a rollapp which LP health conditions check.
+type RollappHealth struct {
+	// HasStateUpdate is false if the rollapp never posted a state update.
+	HasStateUpdate bool
+	// BlocksSinceStateUpdate counts hub blocks since the latest state update
+	// was created.
+	BlocksSinceStateUpdate uint64
+	// HardForkActive is set while the rollapp forked and posted no state
+	// update on the new revision yet.
+	HardForkActive bool
+	// ProposerChanging is set while the proposer rotates out or may be kicked.
+	ProposerChanging bool
+	// HasCanonicalClient is false if the rollapp has no canonical light client.
+	HasCanonicalClient bool
+}
+
+// SkipReason returns why an LP with these conditions does not fulfill for a
+// rollapp in health h, or "" if it does. Nil conditions never skip.
+func (c *LPHealthConditions) SkipReason(h RollappHealth) string {
+	if c == nil {
+		return ""
+	}
+	if 0 < c.MaxBlocksSinceStateUpdate && (!h.HasStateUpdate || c.MaxBlocksSinceStateUpdate < h.BlocksSinceStateUpdate) {
+		return "stale state updates"
+	}
+	if c.SkipOnHardFork && h.HardForkActive {
+		return "hard fork"
+	}
+	if c.SkipOnProposerChange && h.ProposerChanging {
+		return "proposer change"
+	}
+	if c.RequireCanonicalClient && !h.HasCanonicalClient {
+		return "no canonical light client"
+	}
+	return ""
 }
@@ -59,6 +59,9 @@ type OnDemandLP struct {
 	// disabled. Lets an autonomous MM ignore dust orders whose ratio-fee is
 	// below its fixed per-fill gas cost.
 	MinFeeAbsolute cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=min_fee_absolute,json=minFeeAbsolute,proto3,customtype=cosmossdk.io/math.Int" json:"min_fee_absolute"`
+	// Optional conditions on the rollapp's health. While any is violated the LP
+	// is skipped for the rollapp's orders. nil = no conditions.
+	Health *LPHealthConditions `protobuf:"bytes,13,opt,name=health,proto3" json:"health,omitempty"`
 }
 
 func (m *OnDemandLP) Reset()         { *m = OnDemandLP{} }
@@ -143,6 +146,89 @@ func (m *OnDemandLP) GetAgentId() string {
 	return ""
 }
 
+func (m *OnDemandLP) GetHealth() *LPHealthConditions {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+// LPHealthConditions stop an LP fulfilling for a rollapp while it is risky.
+// Each condition is disabled at its zero value.
+type LPHealthConditions struct {
+	// Skip if the rollapp's latest state update was created more than this many
+	// hub blocks ago, or it has none.
+	MaxBlocksSinceStateUpdate uint64 `protobuf:"varint,1,opt,name=max_blocks_since_state_update,json=maxBlocksSinceStateUpdate,proto3" json:"max_blocks_since_state_update,omitempty"`
+	// Skip while the rollapp is hard forked and has not yet posted a state
+	// update on the new revision.
+	SkipOnHardFork bool `protobuf:"varint,2,opt,name=skip_on_hard_fork,json=skipOnHardFork,proto3" json:"skip_on_hard_fork,omitempty"`
+	// Skip while the rollapp's proposer is rotating out, or may be kicked.
+	SkipOnProposerChange bool `protobuf:"varint,3,opt,name=skip_on_proposer_change,json=skipOnProposerChange,proto3" json:"skip_on_proposer_change,omitempty"`
+	// Skip if the rollapp has no canonical light client.
+	RequireCanonicalClient bool `protobuf:"varint,4,opt,name=require_canonical_client,json=requireCanonicalClient,proto3" json:"require_canonical_client,omitempty"`
+}
+
+func (m *LPHealthConditions) Reset()         { *m = LPHealthConditions{} }
+func (m *LPHealthConditions) String() string { return proto.CompactTextString(m) }
+func (*LPHealthConditions) ProtoMessage()    {}
+func (*LPHealthConditions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13de3de2ae42eb80, []int{1}
+}
+func (m *LPHealthConditions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LPHealthConditions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LPHealthConditions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LPHealthConditions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LPHealthConditions.Merge(m, src)
+}
+func (m *LPHealthConditions) XXX_Size() int {
+	return m.Size()
+}
+func (m *LPHealthConditions) XXX_DiscardUnknown() {
+	xxx_messageInfo_LPHealthConditions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LPHealthConditions proto.InternalMessageInfo
+
+func (m *LPHealthConditions) GetMaxBlocksSinceStateUpdate() uint64 {
+	if m != nil {
+		return m.MaxBlocksSinceStateUpdate
+	}
+	return 0
+}
+
+func (m *LPHealthConditions) GetSkipOnHardFork() bool {
+	if m != nil {
+		return m.SkipOnHardFork
+	}
+	return false
+}
+
+func (m *LPHealthConditions) GetSkipOnProposerChange() bool {
+	if m != nil {
+		return m.SkipOnProposerChange
+	}
+	return false
+}
+
+func (m *LPHealthConditions) GetRequireCanonicalClient() bool {
+	if m != nil {
+		return m.RequireCanonicalClient
+	}
+	return false
+}
+
 type OnDemandLPRecord struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// amt spent so far
@@ -158,7 +244,7 @@ func (m *OnDemandLPRecord) Reset()         { *m = OnDemandLPRecord{} }
 func (m *OnDemandLPRecord) String() string { return proto.CompactTextString(m) }
 func (*OnDemandLPRecord) ProtoMessage()    {}
 func (*OnDemandLPRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_13de3de2ae42eb80, []int{1}
+	return fileDescriptor_13de3de2ae42eb80, []int{2}
 }
 func (m *OnDemandLPRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,6 +296,7 @@ func (m *OnDemandLPRecord) GetWindowStartHeight() uint64 {
 
 func init() {
 	proto.RegisterType((*OnDemandLP)(nil), "dymensionxyz.dymension.eibc.OnDemandLP")
+	proto.RegisterType((*LPHealthConditions)(nil), "dymensionxyz.dymension.eibc.LPHealthConditions")
 	proto.RegisterType((*OnDemandLPRecord)(nil), "dymensionxyz.dymension.eibc.OnDemandLPRecord")
 }
 
@@ -218,46 +305,56 @@ func init() {
 }
 
 var fileDescriptor_13de3de2ae42eb80 = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x5f, 0x4b, 0x1b, 0x4d,
-	0x14, 0xc6, 0x93, 0xbc, 0x89, 0x7f, 0x8e, 0xe2, 0x1b, 0xa7, 0x16, 0x56, 0xa5, 0xab, 0x48, 0xa1,
-	0x62, 0x75, 0x17, 0xf5, 0xc2, 0xeb, 0x88, 0xb4, 0x15, 0x6d, 0x95, 0x14, 0x29, 0xf4, 0x66, 0x99,
-	0xdd, 0x19, 0x37, 0x83, 0x3b, 0x33, 0xcb, 0xce, 0x44, 0x4d, 0x3f, 0x45, 0x6f, 0x7a, 0xdb, 0x4f,
-	0xe1, 0x87, 0xf0, 0x52, 0xbc, 0x2a, 0xbd, 0x90, 0xa2, 0x5f, 0xa4, 0xec, 0xcc, 0x18, 0x53, 0x44,
-	0x21, 0x77, 0x39, 0xe7, 0x79, 0xce, 0xb3, 0x3f, 0x38, 0x27, 0x03, 0xaf, 0x49, 0x8f, 0x53, 0xa1,
-	0x98, 0x14, 0xe7, 0xbd, 0x6f, 0x61, 0xbf, 0x08, 0x29, 0x8b, 0x93, 0x30, 0xcb, 0x83, 0xbc, 0x90,
-	0x5a, 0xa2, 0xf9, 0x41, 0x57, 0xd0, 0x2f, 0x82, 0xd2, 0x35, 0x37, 0x93, 0xca, 0x54, 0x1a, 0x5f,
-	0x58, 0xfe, 0xb2, 0x23, 0x73, 0x2b, 0x4f, 0x04, 0x27, 0x92, 0x73, 0x29, 0x42, 0xa5, 0xb1, 0xee,
-	0x2a, 0xe7, 0xdd, 0x78, 0xde, 0x5b, 0xc8, 0x2c, 0xc3, 0x79, 0x1e, 0xe5, 0x38, 0x39, 0xa1, 0xda,
-	0xcd, 0xf8, 0x89, 0x54, 0x5c, 0xaa, 0x30, 0xc6, 0x8a, 0x86, 0xa7, 0xeb, 0x31, 0xd5, 0x78, 0x3d,
-	0x4c, 0x24, 0x13, 0x4e, 0x9f, 0xb5, 0x7a, 0x64, 0xc1, 0x6c, 0x61, 0xa5, 0xa5, 0x1f, 0x0d, 0x80,
-	0x03, 0xb1, 0x43, 0x39, 0x16, 0x64, 0xff, 0x10, 0xbd, 0x02, 0x38, 0xee, 0x0a, 0xa2, 0x22, 0x4c,
-	0x48, 0xe1, 0x55, 0x17, 0xab, 0xcb, 0xe3, 0xed, 0x71, 0xd3, 0x69, 0x11, 0x52, 0x20, 0x0f, 0x46,
-	0x1d, 0x80, 0x57, 0x33, 0xda, 0x7d, 0x89, 0x66, 0xa0, 0x41, 0xa8, 0x90, 0xdc, 0xfb, 0xcf, 0xf4,
-	0x6d, 0x81, 0xde, 0xc3, 0x18, 0xc7, 0xe7, 0x87, 0x05, 0x4b, 0xa8, 0x57, 0x2f, 0x85, 0xed, 0xb7,
-	0x97, 0x37, 0x0b, 0x95, 0xdf, 0x37, 0x0b, 0x2f, 0x2d, 0x85, 0x22, 0x27, 0x01, 0x93, 0x21, 0xc7,
-	0xba, 0x13, 0xec, 0x0a, 0x7d, 0x7d, 0xb1, 0x06, 0x0e, 0x6f, 0x57, 0xe8, 0x76, 0x7f, 0x18, 0x1d,
-	0xc0, 0x08, 0x67, 0xe2, 0x1d, 0xa5, 0x5e, 0xc3, 0xc4, 0x6c, 0xb9, 0x98, 0xf9, 0xc7, 0x31, 0xfb,
-	0x34, 0xc5, 0x49, 0x6f, 0x87, 0x26, 0xd7, 0x17, 0x6b, 0x4d, 0x17, 0xd6, 0xef, 0xb5, 0x5d, 0x0c,
-	0xda, 0x03, 0x50, 0x39, 0x15, 0x64, 0x9f, 0x71, 0xa6, 0xbd, 0x91, 0xe1, 0xd9, 0x06, 0xc6, 0xd1,
-	0x2a, 0x4c, 0xcb, 0x82, 0xd0, 0xe2, 0x23, 0x13, 0xad, 0x94, 0x6e, 0x67, 0x32, 0x39, 0x51, 0xde,
-	0xe8, 0x62, 0x75, 0xb9, 0xde, 0x7e, 0x2c, 0xa0, 0x55, 0x40, 0xa7, 0x38, 0x63, 0x24, 0xea, 0x0a,
-	0xcd, 0xb2, 0xa8, 0x43, 0x59, 0xda, 0xd1, 0xde, 0x98, 0xb1, 0x37, 0x8d, 0x72, 0x54, 0x0a, 0x1f,
-	0x4c, 0x1f, 0x7d, 0x81, 0xe9, 0x02, 0x6b, 0x1a, 0x65, 0xe5, 0x97, 0x22, 0xcc, 0x65, 0x57, 0x68,
-	0x6f, 0x7c, 0x78, 0xde, 0xff, 0xcb, 0x14, 0x83, 0xdb, 0x32, 0x19, 0x68, 0xe5, 0x9f, 0xe0, 0xd8,
-	0x42, 0x83, 0xa1, 0x78, 0xf0, 0x3a, 0xe4, 0x59, 0x18, 0xc3, 0x29, 0x15, 0x3a, 0x62, 0xc4, 0x9b,
-	0xb0, 0x8b, 0x37, 0xf5, 0x2e, 0x41, 0x47, 0xd0, 0xe4, 0x4c, 0x44, 0xc7, 0x94, 0x46, 0x38, 0x56,
-	0x32, 0xeb, 0x6a, 0xea, 0x4d, 0x0e, 0x8f, 0x37, 0x65, 0xf7, 0xd2, 0x72, 0x11, 0x4b, 0x3f, 0x6b,
-	0xd0, 0x7c, 0xb8, 0xcb, 0x36, 0x4d, 0x64, 0x41, 0xd0, 0x14, 0xd4, 0x18, 0x31, 0x57, 0x59, 0x6f,
-	0xd7, 0x18, 0x41, 0x2d, 0x68, 0x94, 0x5b, 0xd0, 0xf6, 0x18, 0x87, 0xfb, 0xa0, 0x9d, 0x44, 0x5b,
-	0x50, 0xcb, 0x72, 0x73, 0xb4, 0x13, 0x1b, 0x6f, 0x82, 0x67, 0xfe, 0xda, 0xc1, 0x00, 0x4d, 0x2d,
-	0xcb, 0x51, 0x00, 0x2f, 0xce, 0x98, 0x20, 0xf2, 0x2c, 0x52, 0x1a, 0x17, 0xfa, 0x7e, 0x8d, 0x75,
-	0xbb, 0x75, 0x2b, 0x7d, 0x2e, 0x15, 0xb7, 0xc7, 0x4f, 0x30, 0x79, 0xef, 0x37, 0xc8, 0x8d, 0xe1,
-	0x91, 0x27, 0x5c, 0x6a, 0x39, 0xbf, 0xbd, 0x77, 0x79, 0xeb, 0x57, 0xaf, 0x6e, 0xfd, 0xea, 0x9f,
-	0x5b, 0xbf, 0xfa, 0xfd, 0xce, 0xaf, 0x5c, 0xdd, 0xf9, 0x95, 0x5f, 0x77, 0x7e, 0xe5, 0xeb, 0x7a,
-	0xca, 0x74, 0xa7, 0x1b, 0x07, 0x89, 0xe4, 0xe1, 0x13, 0x8f, 0xc9, 0xe9, 0x66, 0x78, 0x6e, 0x9f,
-	0x35, 0xdd, 0xcb, 0xa9, 0x8a, 0x47, 0xcc, 0x63, 0xb0, 0xf9, 0x37, 0x00, 0x00, 0xff, 0xff, 0xb5,
-	0xab, 0x93, 0x7c, 0x02, 0x05, 0x00, 0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xd1, 0x4e, 0x1c, 0x37,
+	0x14, 0x86, 0x99, 0x2d, 0x4b, 0x16, 0x93, 0x52, 0x70, 0x69, 0x3b, 0x24, 0xca, 0x06, 0xa1, 0x4a,
+	0xa5, 0x69, 0x32, 0x23, 0x88, 0xaa, 0xf4, 0xb2, 0x0b, 0x51, 0x02, 0x0a, 0x2d, 0x68, 0x10, 0xaa,
+	0xd4, 0x1b, 0xcb, 0x6b, 0x1f, 0x66, 0xac, 0x9d, 0xb1, 0xa7, 0xb6, 0x87, 0x2c, 0x7d, 0x8a, 0x3e,
+	0x41, 0x9f, 0x22, 0x0f, 0x91, 0xcb, 0x28, 0x57, 0x55, 0x2f, 0x50, 0x05, 0x6f, 0xd0, 0x27, 0xa8,
+	0xc6, 0xf6, 0x12, 0x2a, 0x14, 0xa4, 0xbd, 0xdb, 0xe3, 0xef, 0x3f, 0xff, 0xfe, 0x1e, 0xcf, 0xf1,
+	0xa0, 0xaf, 0xf9, 0x59, 0x05, 0xd2, 0x08, 0x25, 0xc7, 0x67, 0xbf, 0xa7, 0x57, 0x45, 0x0a, 0x62,
+	0xc8, 0xd2, 0xb2, 0x4e, 0x6a, 0xad, 0xac, 0xc2, 0xf7, 0xaf, 0xab, 0x92, 0xab, 0x22, 0x69, 0x55,
+	0xf7, 0x56, 0x72, 0x95, 0x2b, 0xa7, 0x4b, 0xdb, 0x5f, 0xbe, 0xe5, 0xde, 0xa3, 0x8f, 0x18, 0x33,
+	0x55, 0x55, 0x4a, 0xa6, 0xc6, 0x52, 0xdb, 0x98, 0xa0, 0xdd, 0xba, 0x5d, 0xab, 0x55, 0x59, 0xd2,
+	0xba, 0x26, 0x35, 0x65, 0x23, 0xb0, 0xa1, 0xa7, 0xcf, 0x94, 0xa9, 0x94, 0x49, 0x87, 0xd4, 0x40,
+	0x7a, 0xba, 0x39, 0x04, 0x4b, 0x37, 0x53, 0xa6, 0x84, 0x0c, 0x7c, 0xd5, 0x73, 0xe2, 0x83, 0xf9,
+	0xc2, 0xa3, 0xf5, 0xf3, 0x2e, 0x42, 0x07, 0xf2, 0x39, 0x54, 0x54, 0xf2, 0xfd, 0x43, 0xfc, 0x00,
+	0xa1, 0x93, 0x46, 0x72, 0x43, 0x28, 0xe7, 0x3a, 0x8e, 0xd6, 0xa2, 0x8d, 0xf9, 0x6c, 0xde, 0xad,
+	0x0c, 0x38, 0xd7, 0x38, 0x46, 0x77, 0x42, 0x80, 0xb8, 0xe3, 0xd8, 0xa4, 0xc4, 0x2b, 0xa8, 0xcb,
+	0x41, 0xaa, 0x2a, 0xfe, 0xc4, 0xad, 0xfb, 0x02, 0xbf, 0x44, 0xbd, 0x8a, 0x8e, 0x0f, 0xb5, 0x60,
+	0x10, 0xcf, 0xb6, 0x60, 0xfb, 0xbb, 0xb7, 0xe7, 0x0f, 0x67, 0xfe, 0x3e, 0x7f, 0xf8, 0x85, 0x4f,
+	0x61, 0xf8, 0x28, 0x11, 0x2a, 0xad, 0xa8, 0x2d, 0x92, 0x3d, 0x69, 0xdf, 0xbf, 0x79, 0x82, 0x42,
+	0xbc, 0x3d, 0x69, 0xb3, 0xab, 0x66, 0x7c, 0x80, 0xe6, 0x2a, 0x21, 0x5f, 0x00, 0xc4, 0x5d, 0x67,
+	0xf3, 0x2c, 0xd8, 0xdc, 0xbf, 0x69, 0xb3, 0x0f, 0x39, 0x65, 0x67, 0xcf, 0x81, 0xbd, 0x7f, 0xf3,
+	0x64, 0x29, 0x98, 0x5d, 0xad, 0x65, 0xc1, 0x06, 0xbf, 0x42, 0xc8, 0xd4, 0x20, 0xf9, 0xbe, 0xa8,
+	0x84, 0x8d, 0xe7, 0xa6, 0xcf, 0x76, 0xad, 0x1d, 0x3f, 0x46, 0xcb, 0x4a, 0x73, 0xd0, 0x3f, 0x09,
+	0x39, 0xc8, 0x61, 0xbb, 0x54, 0x6c, 0x64, 0xe2, 0x3b, 0x6b, 0xd1, 0xc6, 0x6c, 0x76, 0x13, 0xe0,
+	0xc7, 0x08, 0x9f, 0xd2, 0x52, 0x70, 0xd2, 0x48, 0x2b, 0x4a, 0x52, 0x80, 0xc8, 0x0b, 0x1b, 0xf7,
+	0x9c, 0x7c, 0xc9, 0x91, 0xe3, 0x16, 0xec, 0xba, 0x75, 0xfc, 0x0b, 0x5a, 0xd6, 0xd4, 0x02, 0x29,
+	0xdb, 0x7f, 0x22, 0xb4, 0x52, 0x8d, 0xb4, 0xf1, 0xfc, 0xf4, 0x79, 0x3f, 0x6b, 0x5d, 0x5c, 0xdc,
+	0x81, 0xf3, 0xc0, 0x8f, 0xfe, 0x67, 0x3c, 0xf4, 0xa1, 0x91, 0x4b, 0xf1, 0x41, 0x1b, 0x22, 0xaf,
+	0xa2, 0x1e, 0xcd, 0x41, 0x5a, 0x22, 0x78, 0xbc, 0xe0, 0x0f, 0xde, 0xd5, 0x7b, 0x1c, 0x1f, 0xa3,
+	0xa5, 0x4a, 0x48, 0x72, 0x02, 0x40, 0xe8, 0xd0, 0xa8, 0xb2, 0xb1, 0x10, 0xdf, 0x9d, 0x3e, 0xde,
+	0xa2, 0x3f, 0x97, 0x41, 0xb0, 0xc0, 0x2f, 0xd1, 0x5c, 0x01, 0xb4, 0xb4, 0x45, 0xfc, 0xe9, 0x5a,
+	0xb4, 0xb1, 0xb0, 0x95, 0x26, 0xb7, 0x8c, 0x5d, 0xb2, 0x7f, 0xb8, 0xeb, 0xc4, 0x3b, 0x4a, 0x72,
+	0x61, 0x85, 0x92, 0x26, 0x0b, 0xed, 0xeb, 0xff, 0x46, 0x08, 0xdf, 0xc4, 0xf8, 0x47, 0xf4, 0xa0,
+	0xa2, 0xe3, 0xb0, 0x6d, 0x62, 0x84, 0x64, 0x40, 0xda, 0x39, 0x04, 0xd2, 0xd4, 0x9c, 0x5a, 0x70,
+	0xef, 0xfe, 0x6c, 0xb6, 0x5a, 0xd1, 0xb1, 0x7f, 0x06, 0x47, 0xad, 0xe4, 0xa8, 0x55, 0x1c, 0x3b,
+	0x01, 0xfe, 0x16, 0x2d, 0x9b, 0x91, 0xa8, 0x89, 0x92, 0xa4, 0xa0, 0x9a, 0x93, 0x13, 0xa5, 0x47,
+	0x6e, 0x2a, 0x7a, 0xd9, 0x62, 0x0b, 0x0e, 0xe4, 0x2e, 0xd5, 0xfc, 0x85, 0xd2, 0x23, 0xfc, 0x3d,
+	0xfa, 0x6a, 0x22, 0xad, 0xb5, 0xaa, 0x95, 0x01, 0x4d, 0x58, 0x41, 0x65, 0x0e, 0x6e, 0x5c, 0x7a,
+	0xd9, 0x8a, 0x6f, 0x38, 0x0c, 0x70, 0xc7, 0x31, 0xfc, 0x03, 0x8a, 0x35, 0xfc, 0xd6, 0x08, 0x0d,
+	0x84, 0x51, 0xa9, 0xa4, 0x60, 0xb4, 0x24, 0xac, 0x14, 0x20, 0xad, 0x9b, 0xa6, 0x5e, 0xf6, 0x65,
+	0xe0, 0x3b, 0x13, 0xbc, 0xe3, 0xe8, 0xfa, 0x9f, 0x1d, 0xb4, 0xf4, 0x61, 0xaa, 0x33, 0x60, 0x4a,
+	0x73, 0xbc, 0x88, 0x3a, 0x82, 0x87, 0x7d, 0x75, 0x04, 0xc7, 0x03, 0xd4, 0x6d, 0xdf, 0x61, 0x1b,
+	0x77, 0xa6, 0x3f, 0x2e, 0xdf, 0x89, 0x9f, 0xa1, 0x4e, 0x59, 0xbb, 0x3d, 0x2c, 0x6c, 0x7d, 0x73,
+	0xeb, 0x09, 0x5d, 0x4b, 0xd3, 0x29, 0x6b, 0x9c, 0xa0, 0xcf, 0x5f, 0x0b, 0xc9, 0xd5, 0xeb, 0xf6,
+	0xa1, 0x6b, 0x3b, 0x19, 0x82, 0x59, 0x3f, 0x33, 0x1e, 0x1d, 0xb5, 0x24, 0x4c, 0xc1, 0xcf, 0xe8,
+	0xee, 0x44, 0xef, 0x22, 0x77, 0xa7, 0x8f, 0xbc, 0x10, 0x5c, 0xdb, 0xfe, 0xed, 0x57, 0x6f, 0x2f,
+	0xfa, 0xd1, 0xbb, 0x8b, 0x7e, 0xf4, 0xcf, 0x45, 0x3f, 0xfa, 0xe3, 0xb2, 0x3f, 0xf3, 0xee, 0xb2,
+	0x3f, 0xf3, 0xd7, 0x65, 0x7f, 0xe6, 0xd7, 0xcd, 0x5c, 0xd8, 0xa2, 0x19, 0x26, 0x4c, 0x55, 0xe9,
+	0x47, 0xae, 0xe2, 0xd3, 0xa7, 0xe9, 0xd8, 0x7f, 0x14, 0xec, 0x59, 0x0d, 0x66, 0x38, 0xe7, 0xae,
+	0xd2, 0xa7, 0xff, 0x0d, 0x00, 0xe9, 0xac, 0x37, 0xcc, 0x40, 0x06, 0x00, 0x00,
 }
 
 func (m *OnDemandLP) Marshal() (dAtA []byte, err error) {
@@ -280,6 +377,18 @@ func (m *OnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintLp(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
 	{
 		size := m.MinFeeAbsolute.Size()
 		i -= size
@@ -376,6 +485,64 @@ func (m *OnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LPHealthConditions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LPHealthConditions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LPHealthConditions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireCanonicalClient {
+		i--
+		if m.RequireCanonicalClient {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SkipOnProposerChange {
+		i--
+		if m.SkipOnProposerChange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SkipOnHardFork {
+		i--
+		if m.SkipOnHardFork {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxBlocksSinceStateUpdate != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.MaxBlocksSinceStateUpdate))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *OnDemandLPRecord) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -493,6 +660,31 @@ func (m *OnDemandLP) Size() (n int) {
 	}
 	l = m.MinFeeAbsolute.Size()
 	n += 1 + l + sovLp(uint64(l))
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovLp(uint64(l))
+	}
+	return n
+}
+
+func (m *LPHealthConditions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxBlocksSinceStateUpdate != 0 {
+		n += 1 + sovLp(uint64(m.MaxBlocksSinceStateUpdate))
+	}
+	if m.SkipOnHardFork {
+		n += 2
+	}
+	if m.SkipOnProposerChange {
+		n += 2
+	}
+	if m.RequireCanonicalClient {
+		n += 2
+	}
 	return n
 }
 
@@ -909,6 +1101,171 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &LPHealthConditions{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LPHealthConditions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LPHealthConditions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LPHealthConditions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksSinceStateUpdate", wireType)
+			}
+			m.MaxBlocksSinceStateUpdate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlocksSinceStateUpdate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipOnHardFork", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipOnHardFork = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipOnProposerChange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipOnProposerChange = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireCanonicalClient", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireCanonicalClient = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
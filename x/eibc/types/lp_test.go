@@ -180,6 +180,24 @@ func TestRateAllowsTumblingWindow(t *testing.T) {
 	require.Equal(t, math.NewInt(30), r.WindowSpent)
 }
 
+// The rate limit applies to what the LP would pay at the height, which falls
+// as the fee escalates.
+func TestAcceptsRateLimitEscalation(t *testing.T) {
+	lp := baseLP()
+	lp.MaxPrice = math.NewInt(200)
+	lp.RateLimitAmount = math.NewInt(60)
+	lp.RateLimitBlocks = 10
+	r := OnDemandLPRecord{Lp: &lp, Spent: math.ZeroInt(), WindowSpent: math.ZeroInt()}
+
+	// price=150 at creation, falls to 50 as the fee escalates over 10 blocks
+	o := orderWithFee(150, 50)
+	o.CreationHeight = 100
+	o.FeeEscalation = &FeeEscalation{MaxFeeAmount: math.NewInt(150), DurationBlocks: 10}
+
+	require.False(t, r.Accepts(100, o), "over the window at creation")
+	require.True(t, r.Accepts(110, o), "within the window once escalated")
+}
+
 func TestRateLimitDisabledNoPanic(t *testing.T) {
 	lp := baseLP() // RateLimitBlocks == 0
 	r := OnDemandLPRecord{Lp: &lp, Spent: math.ZeroInt(), WindowSpent: math.ZeroInt()}
@@ -230,3 +248,36 @@ func TestRecordProtoRoundTrip(t *testing.T) {
 	require.Equal(t, uint64(0), decoded.Lp.ValidUntilHeight)
 	require.True(t, decoded.RateAllows(5, math.NewInt(99999)), "feature disabled")
 }
+
+func TestLPHealthSkipReason(t *testing.T) {
+	healthy := RollappHealth{HasStateUpdate: true, BlocksSinceStateUpdate: 3, HasCanonicalClient: true}
+	all := &LPHealthConditions{
+		MaxBlocksSinceStateUpdate: 3,
+		SkipOnHardFork:            true,
+		SkipOnProposerChange:      true,
+		RequireCanonicalClient:    true,
+	}
+	var none *LPHealthConditions
+
+	require.Empty(t, none.SkipReason(RollappHealth{HardForkActive: true}))
+	require.Empty(t, (&LPHealthConditions{}).SkipReason(RollappHealth{HardForkActive: true}))
+	require.Empty(t, all.SkipReason(healthy))
+
+	for _, tc := range []struct {
+		name   string
+		health func(*RollappHealth)
+		reason string
+	}{
+		{"never updated", func(h *RollappHealth) { *h = RollappHealth{HasCanonicalClient: true} }, "stale state updates"},
+		{"stale", func(h *RollappHealth) { h.BlocksSinceStateUpdate = 4 }, "stale state updates"},
+		{"hard fork", func(h *RollappHealth) { h.HardForkActive = true }, "hard fork"},
+		{"proposer change", func(h *RollappHealth) { h.ProposerChanging = true }, "proposer change"},
+		{"no client", func(h *RollappHealth) { h.HasCanonicalClient = false }, "no canonical light client"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := healthy
+			tc.health(&h)
+			require.Equal(t, tc.reason, all.SkipReason(h))
+		})
+	}
+}
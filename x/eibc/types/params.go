@@ -38,6 +38,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateErrAckFee(p.ErrackFee); err != nil {
 		return fmt.Errorf("error acknowledgement fee: %w", err)
 	}
+	if _, ok := LPMatching_name[int32(p.LpMatching)]; !ok {
+		return fmt.Errorf("lp matching: unknown mode: %d", p.LpMatching)
+	}
 	return nil
 }
 
@@ -25,11 +25,42 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// LPMatching orders the compatible on-demand LPs tried for an order.
+type LPMatching int32
+
+const (
+	// LP_MATCHING_RANDOM shuffles them, seeded by the order and block.
+	LPMatching_LP_MATCHING_RANDOM LPMatching = 0
+	// LP_MATCHING_BEST_PRICE tries the lowest quoted fee first, then the highest
+	// agent reputation, shuffling only ties.
+	LPMatching_LP_MATCHING_BEST_PRICE LPMatching = 1
+)
+
+var LPMatching_name = map[int32]string{
+	0: "LP_MATCHING_RANDOM",
+	1: "LP_MATCHING_BEST_PRICE",
+}
+
+var LPMatching_value = map[string]int32{
+	"LP_MATCHING_RANDOM":     0,
+	"LP_MATCHING_BEST_PRICE": 1,
+}
+
+func (x LPMatching) String() string {
+	return proto.EnumName(LPMatching_name, int32(x))
+}
+
+func (LPMatching) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_fa18b53f607a3f90, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	EpochIdentifier string                      `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
 	TimeoutFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"timeout_fee" yaml:"timeout_fee"`
 	ErrackFee       cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=errack_fee,json=errackFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"errack_fee" yaml:"errack_fee"`
+	// lp_matching is how on-demand LPs able to fulfill an order are ranked.
+	LpMatching LPMatching `protobuf:"varint,4,opt,name=lp_matching,json=lpMatching,proto3,enum=dymensionxyz.dymension.eibc.LPMatching" json:"lp_matching,omitempty" yaml:"lp_matching"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -71,7 +102,15 @@ func (m *Params) GetEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetLpMatching() LPMatching {
+	if m != nil {
+		return m.LpMatching
+	}
+	return LPMatching_LP_MATCHING_RANDOM
+}
+
 func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.eibc.LPMatching", LPMatching_name, LPMatching_value)
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.eibc.Params")
 }
 
@@ -80,28 +119,34 @@ func init() {
 }
 
 var fileDescriptor_fa18b53f607a3f90 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75, 0xfa,
-	0x20, 0x16, 0x44, 0x8b, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2,
-	0x81, 0x48, 0x29, 0x6d, 0x66, 0xe2, 0x62, 0x0b, 0x00, 0x1b, 0x2f, 0xe4, 0xc6, 0x25, 0x90, 0x5a,
-	0x90, 0x9f, 0x9c, 0x11, 0x9f, 0x99, 0x92, 0x9a, 0x57, 0x92, 0x99, 0x96, 0x99, 0x5a, 0x24, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xfd, 0xe9, 0x9e, 0xbc, 0x78, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0xba, 0x0a, 0xa5, 0x20, 0x7e, 0xb0, 0x90, 0x27, 0x5c, 0x44, 0x28, 0x8f, 0x8b, 0xbb, 0x24,
-	0x33, 0x37, 0x35, 0xbf, 0xb4, 0x24, 0x3e, 0x2d, 0x35, 0x55, 0x82, 0x09, 0x6c, 0x84, 0xef, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0x4b, 0x43, 0x6c, 0x2f, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7,
-	0xcf, 0x4d, 0x2c, 0xc9, 0xd0, 0xf3, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x49, 0x4d, 0xfe, 0x74,
-	0x4f, 0x5e, 0x08, 0x62, 0x0b, 0x92, 0x7e, 0xa5, 0x4b, 0x5b, 0x74, 0x05, 0xa0, 0x4e, 0x86, 0xab,
-	0x0c, 0xe2, 0x82, 0xaa, 0x70, 0x4b, 0x4d, 0x15, 0xca, 0xe6, 0xe2, 0x4a, 0x2d, 0x2a, 0x4a, 0x4c,
-	0xce, 0x06, 0x5b, 0xc7, 0x0c, 0xb6, 0xce, 0x87, 0x38, 0xeb, 0x04, 0xa1, 0x9e, 0x82, 0x6b, 0xc7,
-	0x6e, 0x1b, 0x27, 0x44, 0x81, 0x5b, 0x6a, 0xaa, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0xde,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x98, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x23, 0x4a, 0xcb, 0x8c, 0xf5, 0x2b, 0x20, 0xf1, 0x5a,
-	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x09, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd1, 0xfa, 0x51, 0xb8, 0x03, 0x02, 0x00, 0x00,
+	// 420 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0x87, 0x13, 0x77, 0x59, 0xd8, 0x59, 0xd0, 0x38, 0x48, 0xad, 0x2d, 0x24, 0x4b, 0x2e, 0x16,
+	0xc1, 0x09, 0xba, 0xb7, 0x3d, 0xb9, 0xd9, 0x3f, 0x5a, 0x4c, 0xd6, 0x10, 0xf7, 0xe4, 0x25, 0xa6,
+	0xd3, 0xd9, 0x64, 0x68, 0x27, 0x13, 0x92, 0x59, 0x69, 0xfc, 0x14, 0x5e, 0x04, 0x8f, 0x7e, 0x08,
+	0x3f, 0x44, 0x8f, 0xc5, 0x93, 0x78, 0x08, 0xd2, 0x7e, 0x83, 0x7e, 0x02, 0x69, 0x26, 0xa6, 0x41,
+	0x54, 0xbc, 0xe5, 0x7d, 0xe7, 0xf9, 0xbd, 0x4f, 0x86, 0x79, 0xc1, 0x60, 0x5c, 0x30, 0x92, 0xe4,
+	0x94, 0x27, 0xb3, 0xe2, 0xbd, 0xd5, 0x14, 0x16, 0xa1, 0x23, 0x6c, 0xa5, 0x61, 0x16, 0xb2, 0x1c,
+	0xa5, 0x19, 0x17, 0x1c, 0xf6, 0xdb, 0x24, 0x6a, 0x0a, 0xb4, 0x21, 0x7b, 0xf7, 0x22, 0x1e, 0xf1,
+	0x8a, 0xb3, 0x36, 0x5f, 0x32, 0xd2, 0x7b, 0x80, 0x79, 0xce, 0x78, 0x1e, 0xc8, 0x03, 0x59, 0xc8,
+	0x23, 0xf3, 0xe3, 0x0e, 0xd8, 0xf3, 0xaa, 0xf1, 0xf0, 0x02, 0x68, 0x24, 0xe5, 0x38, 0x0e, 0xe8,
+	0x98, 0x24, 0x82, 0x5e, 0x53, 0x92, 0x75, 0xd5, 0x43, 0x75, 0xb0, 0x6f, 0xf7, 0xd7, 0xa5, 0x71,
+	0xbf, 0x08, 0xd9, 0xf4, 0xd8, 0xfc, 0x9d, 0x30, 0xfd, 0x3b, 0x55, 0x6b, 0xd8, 0x74, 0x60, 0x02,
+	0x0e, 0x04, 0x65, 0x84, 0xdf, 0x88, 0xe0, 0x9a, 0x90, 0xee, 0xad, 0x6a, 0x84, 0x3b, 0x2f, 0x0d,
+	0xe5, 0x7b, 0x69, 0xf4, 0xa5, 0x3d, 0x1f, 0x4f, 0x10, 0xe5, 0x16, 0x0b, 0x45, 0x8c, 0x1c, 0x12,
+	0x85, 0xb8, 0x38, 0x23, 0x78, 0x5d, 0x1a, 0x50, 0x5a, 0x5a, 0x79, 0xf3, 0xeb, 0x97, 0xc7, 0x5a,
+	0xfd, 0xcb, 0x0d, 0xe9, 0x83, 0x9a, 0xb8, 0x20, 0x04, 0x4e, 0x00, 0x20, 0x59, 0x16, 0xe2, 0x49,
+	0xa5, 0xdb, 0xa9, 0x74, 0xce, 0xff, 0xe9, 0xee, 0xd6, 0x97, 0x6a, 0xe2, 0x7f, 0xb6, 0xed, 0x4b,
+	0x60, 0x23, 0x7b, 0x0b, 0x0e, 0xa6, 0x69, 0xc0, 0x42, 0x81, 0x63, 0x9a, 0x44, 0xdd, 0xdd, 0x43,
+	0x75, 0x70, 0xfb, 0xe9, 0x43, 0xf4, 0x8f, 0x37, 0x41, 0x8e, 0xe7, 0xd6, 0xb8, 0xdd, 0xd9, 0x5e,
+	0xb1, 0x35, 0xc5, 0xf4, 0xc1, 0x34, 0xfd, 0xc5, 0x1c, 0xef, 0x7e, 0xfa, 0x6c, 0x28, 0x8f, 0x9e,
+	0x01, 0xb0, 0xcd, 0xc1, 0x0e, 0x80, 0x8e, 0x17, 0xb8, 0x27, 0x57, 0xa7, 0x2f, 0x86, 0x97, 0xcf,
+	0x03, 0xff, 0xe4, 0xf2, 0xec, 0x95, 0xab, 0x29, 0xb0, 0x07, 0x3a, 0xed, 0xbe, 0x7d, 0xfe, 0xfa,
+	0x2a, 0xf0, 0xfc, 0xe1, 0xe9, 0xb9, 0xa6, 0xda, 0x2f, 0xe7, 0x4b, 0x5d, 0x5d, 0x2c, 0x75, 0xf5,
+	0xc7, 0x52, 0x57, 0x3f, 0xac, 0x74, 0x65, 0xb1, 0xd2, 0x95, 0x6f, 0x2b, 0x5d, 0x79, 0xf3, 0x24,
+	0xa2, 0x22, 0xbe, 0x19, 0x21, 0xcc, 0x99, 0xf5, 0x97, 0xb5, 0x7b, 0x77, 0x64, 0xcd, 0xe4, 0xee,
+	0x89, 0x22, 0x25, 0xf9, 0x68, 0xaf, 0xda, 0x96, 0xa3, 0x9f, 0x03, 0x00, 0x55, 0xf8, 0xe8, 0x32,
+	0xa7, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +169,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LpMatching != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LpMatching))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.ErrackFee.Size()
 		i -= size
@@ -179,6 +229,9 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.ErrackFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.LpMatching != 0 {
+		n += 1 + sovParams(uint64(m.LpMatching))
+	}
 	return n
 }
 
@@ -317,6 +370,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpMatching", wireType)
+			}
+			m.LpMatching = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpMatching |= LPMatching(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -585,6 +586,336 @@ func (m *QueryOnDemandLPsByAddrResponse) GetLps() []*OnDemandLPRecord {
 	return nil
 }
 
+type QueryOnDemandLPMatchRequest struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *QueryOnDemandLPMatchRequest) Reset()         { *m = QueryOnDemandLPMatchRequest{} }
+func (m *QueryOnDemandLPMatchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOnDemandLPMatchRequest) ProtoMessage()    {}
+func (*QueryOnDemandLPMatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{10}
+}
+func (m *QueryOnDemandLPMatchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnDemandLPMatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnDemandLPMatchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOnDemandLPMatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnDemandLPMatchRequest.Merge(m, src)
+}
+func (m *QueryOnDemandLPMatchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnDemandLPMatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnDemandLPMatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOnDemandLPMatchRequest proto.InternalMessageInfo
+
+func (m *QueryOnDemandLPMatchRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type QueryOnDemandLPMatchResponse struct {
+	// matches lists each LP which would pay part of the order, in the order
+	// tried. A single entry covers the whole unfilled price.
+	Matches []OnDemandLPMatch `protobuf:"bytes,1,rep,name=matches,proto3" json:"matches"`
+}
+
+func (m *QueryOnDemandLPMatchResponse) Reset()         { *m = QueryOnDemandLPMatchResponse{} }
+func (m *QueryOnDemandLPMatchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOnDemandLPMatchResponse) ProtoMessage()    {}
+func (*QueryOnDemandLPMatchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{11}
+}
+func (m *QueryOnDemandLPMatchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOnDemandLPMatchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOnDemandLPMatchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOnDemandLPMatchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOnDemandLPMatchResponse.Merge(m, src)
+}
+func (m *QueryOnDemandLPMatchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOnDemandLPMatchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOnDemandLPMatchResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOnDemandLPMatchResponse proto.InternalMessageInfo
+
+func (m *QueryOnDemandLPMatchResponse) GetMatches() []OnDemandLPMatch {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+type OnDemandLPMatch struct {
+	LpId uint64 `protobuf:"varint,1,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	// amount is the part of the price the LP would pay.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *OnDemandLPMatch) Reset()         { *m = OnDemandLPMatch{} }
+func (m *OnDemandLPMatch) String() string { return proto.CompactTextString(m) }
+func (*OnDemandLPMatch) ProtoMessage()    {}
+func (*OnDemandLPMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{12}
+}
+func (m *OnDemandLPMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnDemandLPMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnDemandLPMatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OnDemandLPMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnDemandLPMatch.Merge(m, src)
+}
+func (m *OnDemandLPMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnDemandLPMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnDemandLPMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnDemandLPMatch proto.InternalMessageInfo
+
+func (m *OnDemandLPMatch) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+type QueryOrderBookRequest struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// denom of the orders' price, on the hub
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// optional height to evaluate escalating fees at, defaults to the current
+	// height
+	Height uint64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// optional limit on the orders returned. Depth and expected profit cover the
+	// whole book.
+	Limit uint32 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryOrderBookRequest) Reset()         { *m = QueryOrderBookRequest{} }
+func (m *QueryOrderBookRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookRequest) ProtoMessage()    {}
+func (*QueryOrderBookRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{13}
+}
+func (m *QueryOrderBookRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrderBookRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookRequest.Merge(m, src)
+}
+func (m *QueryOrderBookRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrderBookRequest proto.InternalMessageInfo
+
+func (m *QueryOrderBookRequest) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *QueryOrderBookRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryOrderBookRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryOrderBookRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type QueryOrderBookResponse struct {
+	// height the fees were evaluated at
+	Height uint64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Orders []OrderBookEntry `protobuf:"bytes,2,rep,name=orders,proto3" json:"orders"`
+	// depth is the unfilled price of all the orders
+	Depth cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=depth,proto3,customtype=cosmossdk.io/math.Int" json:"depth"`
+	// expected_profit sums the expected profit of all the orders
+	ExpectedProfit cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=expected_profit,json=expectedProfit,proto3,customtype=cosmossdk.io/math.Int" json:"expected_profit"`
+}
+
+func (m *QueryOrderBookResponse) Reset()         { *m = QueryOrderBookResponse{} }
+func (m *QueryOrderBookResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOrderBookResponse) ProtoMessage()    {}
+func (*QueryOrderBookResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{14}
+}
+func (m *QueryOrderBookResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOrderBookResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOrderBookResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOrderBookResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOrderBookResponse.Merge(m, src)
+}
+func (m *QueryOrderBookResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOrderBookResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOrderBookResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOrderBookResponse proto.InternalMessageInfo
+
+func (m *QueryOrderBookResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryOrderBookResponse) GetOrders() []OrderBookEntry {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type OrderBookEntry struct {
+	OrderId string                   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Type    types.RollappPacket_Type `protobuf:"varint,2,opt,name=type,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"type,omitempty"`
+	// fee is the effective fee at the height
+	Fee cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+	// fee_rate is the effective fee over the effective price. The book is
+	// sorted by it, highest first.
+	FeeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee_rate,json=feeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_rate"`
+	// amount is the unfilled price: what fulfilling the rest of the order costs
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// cumulative_amount sums amount over this order and every order before it
+	CumulativeAmount cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=cumulative_amount,json=cumulativeAmount,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_amount"`
+	// expected_profit is what fulfilling the rest of the order pays on
+	// finalization, after the current bridging fee, less amount
+	ExpectedProfit cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=expected_profit,json=expectedProfit,proto3,customtype=cosmossdk.io/math.Int" json:"expected_profit"`
+}
+
+func (m *OrderBookEntry) Reset()         { *m = OrderBookEntry{} }
+func (m *OrderBookEntry) String() string { return proto.CompactTextString(m) }
+func (*OrderBookEntry) ProtoMessage()    {}
+func (*OrderBookEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{15}
+}
+func (m *OrderBookEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrderBookEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrderBookEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrderBookEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderBookEntry.Merge(m, src)
+}
+func (m *OrderBookEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrderBookEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderBookEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderBookEntry proto.InternalMessageInfo
+
+func (m *OrderBookEntry) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *OrderBookEntry) GetType() types.RollappPacket_Type {
+	if m != nil {
+		return m.Type
+	}
+	return types.RollappPacket_ON_RECV
+}
+
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
@@ -597,6 +928,12 @@ func init() {
 	proto.RegisterType((*QueryOnDemandLPsResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsResponse")
 	proto.RegisterType((*QueryOnDemandLPsByAddrRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrRequest")
 	proto.RegisterType((*QueryOnDemandLPsByAddrResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrResponse")
+	proto.RegisterType((*QueryOnDemandLPMatchRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPMatchRequest")
+	proto.RegisterType((*QueryOnDemandLPMatchResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPMatchResponse")
+	proto.RegisterType((*OnDemandLPMatch)(nil), "dymensionxyz.dymension.eibc.OnDemandLPMatch")
+	proto.RegisterType((*QueryOrderBookRequest)(nil), "dymensionxyz.dymension.eibc.QueryOrderBookRequest")
+	proto.RegisterType((*QueryOrderBookResponse)(nil), "dymensionxyz.dymension.eibc.QueryOrderBookResponse")
+	proto.RegisterType((*OrderBookEntry)(nil), "dymensionxyz.dymension.eibc.OrderBookEntry")
 }
 
 func init() {
@@ -604,65 +941,89 @@ func init() {
 }
 
 var fileDescriptor_d85bfe71ceb5f8dc = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
-	0x14, 0xf5, 0xda, 0x8e, 0x8b, 0x6f, 0xfa, 0x61, 0xa6, 0x91, 0x58, 0xb9, 0xad, 0x09, 0x5b, 0x4a,
-	0xa3, 0x16, 0x76, 0x12, 0x87, 0x96, 0x88, 0xaa, 0x45, 0xb1, 0x12, 0x57, 0x51, 0x43, 0x1a, 0x16,
-	0x22, 0xa1, 0xf2, 0x60, 0xad, 0x3d, 0x13, 0x33, 0xb0, 0xbb, 0xb3, 0xdd, 0x5d, 0x57, 0x35, 0x51,
-	0x5e, 0xf8, 0x05, 0x48, 0xbc, 0xf0, 0x43, 0x78, 0x03, 0xf1, 0xdc, 0x27, 0x54, 0xd1, 0x17, 0x9e,
-	0x10, 0x4a, 0xf8, 0x21, 0x68, 0x3e, 0x6c, 0xaf, 0xf3, 0xb1, 0x8e, 0xab, 0xbe, 0x44, 0x3b, 0xd7,
-	0xe7, 0xcc, 0x3d, 0xe7, 0xce, 0xbd, 0x33, 0x81, 0x9b, 0xa4, 0xef, 0xd3, 0x20, 0x66, 0x3c, 0x78,
-	0xde, 0xff, 0x01, 0x0f, 0x17, 0x98, 0xb2, 0x76, 0x07, 0x3f, 0xed, 0xd1, 0xa8, 0x6f, 0x87, 0x11,
-	0x4f, 0x38, 0xba, 0x92, 0x06, 0xda, 0xc3, 0x85, 0x2d, 0x80, 0xd5, 0xb9, 0x2e, 0xef, 0x72, 0x89,
-	0xc3, 0xe2, 0x4b, 0x51, 0xaa, 0x57, 0xbb, 0x9c, 0x77, 0x3d, 0x8a, 0xdd, 0x90, 0x61, 0x37, 0x08,
-	0x78, 0xe2, 0x26, 0x8c, 0x07, 0xb1, 0xfe, 0xf5, 0x56, 0x87, 0xc7, 0x3e, 0x8f, 0x71, 0xdb, 0x8d,
-	0xa9, 0xca, 0x84, 0x9f, 0x2d, 0xb5, 0x69, 0xe2, 0x2e, 0xe1, 0xd0, 0xed, 0xb2, 0x40, 0x82, 0x35,
-	0x76, 0x21, 0x4b, 0x65, 0xe8, 0x46, 0xae, 0x3f, 0xdc, 0xf5, 0x14, 0x64, 0x87, 0xfb, 0x3e, 0x0f,
-	0x70, 0x9c, 0xb8, 0x49, 0x6f, 0x80, 0xad, 0x67, 0x63, 0x23, 0xee, 0x79, 0x6e, 0x18, 0xb6, 0x42,
-	0xb7, 0xf3, 0x3d, 0x4d, 0x34, 0xc7, 0xce, 0x52, 0x42, 0xa8, 0xef, 0x06, 0xa4, 0xc5, 0x23, 0x42,
-	0x23, 0x8d, 0x7f, 0x3f, 0x0b, 0xef, 0x85, 0x0a, 0x65, 0xcd, 0x01, 0xfa, 0x42, 0x54, 0x60, 0x5b,
-	0x5a, 0x71, 0xe8, 0xd3, 0x1e, 0x8d, 0x13, 0xeb, 0x6b, 0xb8, 0x3c, 0x16, 0x8d, 0x43, 0x1e, 0xc4,
-	0x14, 0xad, 0x42, 0x49, 0x59, 0x36, 0x8d, 0x79, 0x63, 0x61, 0xb6, 0x7e, 0xdd, 0xce, 0x38, 0x1a,
-	0x5b, 0x91, 0x1b, 0xc5, 0x17, 0xff, 0xbc, 0x9b, 0x73, 0x34, 0xd1, 0xfa, 0x10, 0xaa, 0x72, 0xe7,
-	0x87, 0x34, 0x59, 0x93, 0x9a, 0x1f, 0x0b, 0xc9, 0x3a, 0x2f, 0xba, 0x08, 0x79, 0x46, 0xe4, 0xe6,
-	0x65, 0x27, 0xcf, 0x88, 0xf5, 0xaa, 0x00, 0xf3, 0x12, 0x9e, 0xc2, 0xc6, 0x8d, 0xfe, 0x97, 0xb2,
-	0x96, 0x03, 0xd2, 0x7d, 0x28, 0xa9, 0xe2, 0x4a, 0xe2, 0xc5, 0xfa, 0x8d, 0xd3, 0x54, 0xa9, 0xea,
-	0xda, 0x9a, 0xad, 0x49, 0x68, 0x1d, 0x8a, 0x49, 0x3f, 0xa4, 0x66, 0x5e, 0x92, 0x97, 0x26, 0x90,
-	0x1d, 0x75, 0x34, 0xdb, 0xea, 0x64, 0xbe, 0xea, 0x87, 0xd4, 0x91, 0x74, 0x74, 0x0d, 0x60, 0x70,
-	0x6c, 0x8c, 0x98, 0x05, 0x69, 0xa1, 0xac, 0x23, 0x1b, 0x04, 0xcd, 0xc1, 0x8c, 0xc7, 0x7c, 0x96,
-	0x98, 0xc5, 0x79, 0x63, 0x61, 0xc6, 0x51, 0x0b, 0xf4, 0x04, 0xde, 0xde, 0xed, 0x79, 0xbb, 0xcc,
-	0xf3, 0x7c, 0x1a, 0x24, 0x2d, 0xa1, 0x88, 0x9a, 0x33, 0x52, 0xc8, 0x47, 0x99, 0xb5, 0x6d, 0x8e,
-	0x58, 0xc2, 0x0e, 0x75, 0x2a, 0xbb, 0x47, 0x22, 0xe8, 0x2a, 0x94, 0x75, 0x8c, 0x46, 0x66, 0x49,
-	0xe9, 0x19, 0x06, 0x84, 0x1e, 0x42, 0x03, 0xee, 0x9b, 0xe7, 0xe4, 0x2f, 0x6a, 0x21, 0x38, 0x11,
-	0xed, 0xb0, 0x90, 0xd1, 0x20, 0x31, 0xdf, 0xd2, 0x1e, 0x06, 0x01, 0xd4, 0x04, 0x18, 0xcd, 0x87,
-	0x59, 0x96, 0x2d, 0xf0, 0x81, 0xad, 0x86, 0xc9, 0x16, 0xc3, 0x64, 0xab, 0xb1, 0xd5, 0xc3, 0x64,
-	0x6f, 0xbb, 0x5d, 0xaa, 0x0f, 0xc9, 0x49, 0x31, 0xad, 0xef, 0xe0, 0xca, 0x89, 0x3d, 0xa0, 0xbb,
-	0xec, 0x11, 0x9c, 0x4f, 0xb7, 0xb3, 0xee, 0xb5, 0x85, 0xcc, 0x7a, 0xa4, 0xf7, 0x99, 0x25, 0xa3,
-	0x85, 0xf5, 0x9b, 0x01, 0xef, 0x65, 0x74, 0x90, 0x4e, 0xf9, 0x39, 0x5c, 0x48, 0xa7, 0x14, 0x9d,
-	0x54, 0x98, 0x2a, 0xe7, 0xf9, 0x54, 0xce, 0x18, 0x3d, 0x1c, 0x2b, 0x54, 0x5e, 0xea, 0xbf, 0x39,
-	0xb1, 0x50, 0x4a, 0xcb, 0x58, 0xa5, 0x6e, 0xc3, 0x3b, 0x52, 0xfc, 0xe3, 0x40, 0x25, 0xdb, 0xdc,
-	0x1e, 0x76, 0x7d, 0x05, 0x0a, 0x8c, 0x28, 0xa1, 0x45, 0x47, 0x7c, 0x5a, 0xdf, 0x80, 0x79, 0x1c,
-	0xac, 0x0d, 0x7e, 0x06, 0x05, 0x2f, 0x1c, 0xd8, 0xca, 0x6e, 0xad, 0x11, 0xdd, 0xa1, 0x1d, 0x1e,
-	0x11, 0x47, 0x30, 0xad, 0x65, 0xb8, 0x76, 0x74, 0xf3, 0x46, 0x7f, 0x95, 0x90, 0xe1, 0xe8, 0x22,
-	0x28, 0xba, 0x84, 0x44, 0x7a, 0x78, 0xe5, 0xb7, 0xe5, 0x42, 0xed, 0x34, 0xd2, 0x1b, 0xd2, 0x75,
-	0x6b, 0x15, 0x2a, 0x47, 0x67, 0x01, 0x5d, 0x80, 0xf2, 0xce, 0xd6, 0xda, 0x7a, 0x73, 0x63, 0x6b,
-	0x7d, 0xad, 0x92, 0x13, 0xcb, 0xe6, 0xce, 0x66, 0x73, 0x63, 0x73, 0x73, 0x7d, 0xad, 0x62, 0xa0,
-	0x4b, 0x30, 0xbb, 0xb3, 0x35, 0x0a, 0xe4, 0xeb, 0x7f, 0x9c, 0x83, 0x19, 0x29, 0x13, 0xfd, 0x62,
-	0x40, 0x49, 0xdd, 0x5a, 0x08, 0x67, 0x6a, 0x39, 0x7e, 0x65, 0x56, 0x17, 0xcf, 0x4e, 0x50, 0xde,
-	0xad, 0xdb, 0x3f, 0xbe, 0xfa, 0xef, 0xe7, 0xfc, 0x0d, 0x74, 0x1d, 0x4f, 0x7e, 0x63, 0xd0, 0xef,
-	0x06, 0x5c, 0x4a, 0x35, 0x5c, 0xa3, 0xbf, 0x41, 0xd0, 0x27, 0x93, 0x53, 0x9e, 0x78, 0xcd, 0x56,
-	0x57, 0xa6, 0x27, 0x6a, 0xcd, 0x77, 0xa5, 0xe6, 0x45, 0x64, 0xe3, 0xb3, 0xbe, 0x46, 0x78, 0x8f,
-	0x91, 0x7d, 0xf4, 0x97, 0x01, 0x73, 0x27, 0x4d, 0x20, 0xba, 0x3f, 0x59, 0x4a, 0xc6, 0xdd, 0x5f,
-	0x7d, 0xf0, 0xba, 0x74, 0xed, 0xe7, 0x9e, 0xf4, 0x73, 0x07, 0x2d, 0x9f, 0xd9, 0x4f, 0x8c, 0xf7,
-	0xd4, 0xc3, 0xb1, 0x8f, 0x7e, 0x35, 0x60, 0x36, 0xd5, 0xda, 0xe8, 0xe3, 0xc9, 0x62, 0x8e, 0x0f,
-	0x72, 0xf5, 0xce, 0x94, 0x2c, 0xad, 0x7c, 0x45, 0x2a, 0xaf, 0xa3, 0xc5, 0x4c, 0xe5, 0x3c, 0x68,
-	0x69, 0xf1, 0x5e, 0x18, 0x8b, 0xa3, 0x88, 0xf7, 0xd1, 0x9f, 0x06, 0x5c, 0x1e, 0x9b, 0x48, 0x35,
-	0x93, 0xe8, 0xd3, 0xa9, 0x84, 0x8c, 0x4d, 0x7f, 0xf5, 0xde, 0x6b, 0x71, 0xb5, 0x95, 0x07, 0xd2,
-	0xca, 0x0a, 0xba, 0x7b, 0x76, 0x2b, 0x2d, 0x71, 0xbf, 0xe0, 0x3d, 0xf1, 0x77, 0xbf, 0xf1, 0xe8,
-	0xc5, 0x41, 0xcd, 0x78, 0x79, 0x50, 0x33, 0xfe, 0x3d, 0xa8, 0x19, 0x3f, 0x1d, 0xd6, 0x72, 0x2f,
-	0x0f, 0x6b, 0xb9, 0xbf, 0x0f, 0x6b, 0xb9, 0x27, 0x4b, 0x5d, 0x96, 0x7c, 0xdb, 0x6b, 0x8b, 0xc7,
-	0xfb, 0xb4, 0xbd, 0x9f, 0x2d, 0xe3, 0xe7, 0x2a, 0x81, 0x78, 0xc6, 0xe3, 0x76, 0x49, 0xfe, 0x5f,
-	0xb4, 0xfc, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x9e, 0x50, 0x94, 0x9f, 0x0a, 0x00, 0x00,
+	// 1308 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0xda, 0x8e, 0x83, 0x5f, 0x20, 0x09, 0x43, 0xa0, 0xae, 0x01, 0x43, 0x97, 0x52, 0x22,
+	0xa0, 0xbb, 0xc4, 0x06, 0x9a, 0xf2, 0xaf, 0x8a, 0x95, 0x18, 0xb9, 0x18, 0x48, 0xb7, 0x45, 0xaa,
+	0xa8, 0x2a, 0x6b, 0xed, 0x1d, 0xdb, 0xdb, 0xec, 0xee, 0x2c, 0xbb, 0x6b, 0x84, 0x89, 0x7c, 0xe9,
+	0x27, 0xa8, 0xd4, 0x4b, 0x3f, 0x45, 0x0f, 0x55, 0x6f, 0xed, 0xa1, 0x47, 0xd4, 0x43, 0x85, 0xca,
+	0xa5, 0xea, 0x01, 0x55, 0xa4, 0x1f, 0xa2, 0xc7, 0x6a, 0xfe, 0xac, 0xff, 0xc5, 0x59, 0xdb, 0x11,
+	0x97, 0xc8, 0x33, 0xfb, 0x7e, 0x6f, 0x7e, 0xbf, 0x37, 0x6f, 0xde, 0x7b, 0x81, 0x0b, 0x46, 0xdb,
+	0xc6, 0x8e, 0x6f, 0x12, 0xe7, 0x59, 0xfb, 0xb9, 0xda, 0x5d, 0xa8, 0xd8, 0xac, 0xd6, 0xd4, 0x27,
+	0x2d, 0xec, 0xb5, 0x15, 0xd7, 0x23, 0x01, 0x41, 0x27, 0xfb, 0x0d, 0x95, 0xee, 0x42, 0xa1, 0x86,
+	0x99, 0xe5, 0x06, 0x69, 0x10, 0x66, 0xa7, 0xd2, 0x5f, 0x1c, 0x92, 0x39, 0xd5, 0x20, 0xa4, 0x61,
+	0x61, 0x55, 0x77, 0x4d, 0x55, 0x77, 0x1c, 0x12, 0xe8, 0x81, 0x49, 0x1c, 0x5f, 0x7c, 0xbd, 0x58,
+	0x23, 0xbe, 0x4d, 0x7c, 0xb5, 0xaa, 0xfb, 0x98, 0x9f, 0xa4, 0x3e, 0x5d, 0xad, 0xe2, 0x40, 0x5f,
+	0x55, 0x5d, 0xbd, 0x61, 0x3a, 0xcc, 0x58, 0xd8, 0xae, 0x44, 0xb1, 0x74, 0x75, 0x4f, 0xb7, 0xbb,
+	0x5e, 0xf7, 0xb1, 0xac, 0x11, 0xdb, 0x26, 0x8e, 0xea, 0x07, 0x7a, 0xd0, 0x0a, 0x6d, 0x73, 0xd1,
+	0xb6, 0x1e, 0xb1, 0x2c, 0xdd, 0x75, 0x2b, 0xae, 0x5e, 0xdb, 0xc6, 0x81, 0xc0, 0x28, 0x51, 0x4c,
+	0x0c, 0x6c, 0xeb, 0x8e, 0x51, 0x21, 0x9e, 0x81, 0x3d, 0x61, 0xff, 0x7e, 0x94, 0xbd, 0xe5, 0x72,
+	0x2b, 0x79, 0x19, 0xd0, 0x67, 0x34, 0x02, 0x5b, 0x4c, 0x8a, 0x86, 0x9f, 0xb4, 0xb0, 0x1f, 0xc8,
+	0x5f, 0xc2, 0xb1, 0x81, 0x5d, 0xdf, 0x25, 0x8e, 0x8f, 0xd1, 0x3a, 0x24, 0xb9, 0xe4, 0xb4, 0x74,
+	0x56, 0x5a, 0x99, 0xcf, 0x9d, 0x53, 0x22, 0xae, 0x46, 0xe1, 0xe0, 0x42, 0xe2, 0xc5, 0xeb, 0x33,
+	0x33, 0x9a, 0x00, 0xca, 0x97, 0x21, 0xc3, 0x3c, 0xdf, 0xc5, 0xc1, 0x06, 0xe3, 0xfc, 0x90, 0x52,
+	0x16, 0xe7, 0xa2, 0x05, 0x88, 0x99, 0x06, 0x73, 0x9e, 0xd2, 0x62, 0xa6, 0x21, 0xbf, 0x8a, 0xc3,
+	0x59, 0x66, 0xde, 0x67, 0xeb, 0x17, 0xda, 0x9f, 0xb3, 0x58, 0x86, 0xa0, 0xdb, 0x90, 0xe4, 0xc1,
+	0x65, 0xc0, 0x85, 0xdc, 0xf9, 0xfd, 0x58, 0xf1, 0xe8, 0x2a, 0x02, 0x2d, 0x40, 0x68, 0x13, 0x12,
+	0x41, 0xdb, 0xc5, 0xe9, 0x18, 0x03, 0xaf, 0x8e, 0x01, 0x6b, 0xfc, 0x6a, 0xb6, 0xf8, 0xcd, 0x7c,
+	0xd1, 0x76, 0xb1, 0xc6, 0xe0, 0xe8, 0x34, 0x40, 0x78, 0x6d, 0xa6, 0x91, 0x8e, 0x33, 0x09, 0x29,
+	0xb1, 0x53, 0x32, 0xd0, 0x32, 0xcc, 0x5a, 0xa6, 0x6d, 0x06, 0xe9, 0xc4, 0x59, 0x69, 0x65, 0x56,
+	0xe3, 0x0b, 0xf4, 0x18, 0x8e, 0xd6, 0x5b, 0x56, 0xdd, 0xb4, 0x2c, 0x1b, 0x3b, 0x41, 0x85, 0x32,
+	0xc2, 0xe9, 0x59, 0x46, 0xe4, 0xc3, 0xc8, 0xd8, 0x16, 0x7b, 0x28, 0x2a, 0x07, 0x6b, 0x4b, 0xf5,
+	0xa1, 0x1d, 0x74, 0x0a, 0x52, 0x62, 0x0f, 0x7b, 0xe9, 0x24, 0xe7, 0xd3, 0xdd, 0xa0, 0x7c, 0x0c,
+	0xec, 0x10, 0x3b, 0x3d, 0xc7, 0xbe, 0xf0, 0x05, 0xc5, 0x78, 0xb8, 0x66, 0xba, 0x26, 0x76, 0x82,
+	0xf4, 0x21, 0xa1, 0x21, 0xdc, 0x40, 0x45, 0x80, 0xde, 0xfb, 0x48, 0xa7, 0x58, 0x0a, 0x7c, 0xa0,
+	0xf0, 0xc7, 0xa4, 0xd0, 0xc7, 0xa4, 0xf0, 0x67, 0x2b, 0x1e, 0x93, 0xb2, 0xa5, 0x37, 0xb0, 0xb8,
+	0x24, 0xad, 0x0f, 0x29, 0x7f, 0x03, 0x27, 0x47, 0xe6, 0x80, 0xc8, 0xb2, 0x7b, 0x70, 0xb8, 0x3f,
+	0x9d, 0x45, 0xae, 0xad, 0x44, 0xc6, 0xa3, 0xdf, 0xcf, 0xbc, 0xd1, 0x5b, 0xc8, 0xbf, 0x48, 0xf0,
+	0x5e, 0x44, 0x06, 0x89, 0x23, 0xef, 0xc3, 0x91, 0xfe, 0x23, 0x69, 0x26, 0xc5, 0xa7, 0x3a, 0xf3,
+	0x70, 0xdf, 0x99, 0x3e, 0xba, 0x3b, 0x10, 0xa8, 0x18, 0xe3, 0x7f, 0x61, 0x6c, 0xa0, 0x38, 0x97,
+	0x81, 0x48, 0x5d, 0x82, 0x77, 0x18, 0xf9, 0x87, 0x0e, 0x3f, 0xac, 0xbc, 0xd5, 0xcd, 0xfa, 0x25,
+	0x88, 0x9b, 0x06, 0x27, 0x9a, 0xd0, 0xe8, 0x4f, 0xf9, 0x2b, 0x48, 0xef, 0x35, 0x16, 0x02, 0x3f,
+	0x81, 0xb8, 0xe5, 0x86, 0xb2, 0xa2, 0x53, 0xab, 0x07, 0xd7, 0x70, 0x8d, 0x78, 0x86, 0x46, 0x91,
+	0x72, 0x1e, 0x4e, 0x0f, 0x3b, 0x2f, 0xb4, 0xd7, 0x0d, 0xa3, 0xfb, 0x74, 0x11, 0x24, 0x74, 0xc3,
+	0xf0, 0xc4, 0xe3, 0x65, 0xbf, 0x65, 0x1d, 0xb2, 0xfb, 0x81, 0xde, 0x16, 0xaf, 0x35, 0x91, 0x4b,
+	0xbd, 0xaf, 0xf7, 0xf5, 0xa0, 0xd6, 0x0c, 0x59, 0xbd, 0x0b, 0x87, 0xd8, 0x8d, 0x56, 0xba, 0x65,
+	0x65, 0x8e, 0xad, 0x4b, 0x86, 0x6c, 0xc1, 0xa9, 0xd1, 0x48, 0x41, 0xad, 0x0c, 0x73, 0x36, 0xdd,
+	0xc0, 0x21, 0xbd, 0xcb, 0x13, 0xd2, 0x63, 0x6e, 0x44, 0xd9, 0x0b, 0x5d, 0xc8, 0x5f, 0xc3, 0xe2,
+	0x90, 0x05, 0x3a, 0x06, 0xb3, 0x96, 0x1b, 0x12, 0x4b, 0x68, 0x09, 0x8b, 0xd6, 0x89, 0x6b, 0x90,
+	0xd4, 0x6d, 0xd2, 0x72, 0x02, 0x96, 0x36, 0xa9, 0xc2, 0x69, 0xea, 0xe6, 0xef, 0xd7, 0x67, 0x8e,
+	0xf3, 0xec, 0xf1, 0x8d, 0x6d, 0xc5, 0x24, 0xaa, 0xad, 0x07, 0x4d, 0xa5, 0xe4, 0x04, 0x9a, 0x30,
+	0x96, 0x9f, 0xc3, 0x71, 0x2e, 0x86, 0x8a, 0x2b, 0x10, 0xb2, 0x1d, 0x06, 0x60, 0xb0, 0x2c, 0x49,
+	0x23, 0xca, 0x12, 0x2f, 0x03, 0xb1, 0xfe, 0x32, 0x70, 0x02, 0x92, 0x4d, 0x6c, 0x36, 0x9a, 0x01,
+	0xab, 0x63, 0x09, 0x4d, 0xac, 0x06, 0x8b, 0xd8, 0x11, 0x51, 0xc4, 0xe4, 0xff, 0x24, 0x38, 0x31,
+	0x7c, 0xb8, 0x88, 0x61, 0xcf, 0x91, 0x34, 0xe0, 0xa8, 0x04, 0x49, 0xf1, 0xd0, 0x62, 0x2c, 0xb4,
+	0x97, 0xa2, 0x43, 0x1b, 0xfa, 0xdd, 0x74, 0x02, 0xaf, 0x1d, 0x36, 0x14, 0xee, 0x00, 0xe5, 0xa9,
+	0x02, 0x37, 0x68, 0xa6, 0xe3, 0x93, 0xc4, 0x8b, 0xdb, 0xa2, 0x22, 0x2c, 0xe2, 0x67, 0x2e, 0xae,
+	0x05, 0xd8, 0xa8, 0xb8, 0x1e, 0xa9, 0x0b, 0x49, 0x63, 0xe1, 0x0b, 0x21, 0x6a, 0x8b, 0x81, 0xe4,
+	0x1f, 0xe3, 0xb0, 0x30, 0xc8, 0x2e, 0x22, 0xe3, 0xde, 0x56, 0xa7, 0x51, 0x21, 0x5e, 0xc7, 0x78,
+	0x32, 0xbd, 0xd4, 0x12, 0xdd, 0x81, 0x43, 0x75, 0x8c, 0x2b, 0x1e, 0x6d, 0x2e, 0x5c, 0xe6, 0x39,
+	0x81, 0x3a, 0xb9, 0x17, 0x55, 0xc6, 0x0d, 0xbd, 0xd6, 0xde, 0xc0, 0x35, 0x6d, 0xae, 0x8e, 0xb1,
+	0x46, 0x3b, 0x49, 0x2f, 0x27, 0x67, 0xa7, 0xc8, 0x49, 0xf4, 0x29, 0x1c, 0xad, 0xb5, 0xec, 0x96,
+	0xa5, 0x07, 0xe6, 0x53, 0x5c, 0x11, 0x1e, 0x92, 0x93, 0x78, 0x58, 0xea, 0xe1, 0xd6, 0xb9, 0xaf,
+	0x11, 0x17, 0x36, 0x77, 0x80, 0x0b, 0xbb, 0xb8, 0x0e, 0x4b, 0xc3, 0xad, 0x13, 0x1d, 0x81, 0xd4,
+	0xa3, 0x07, 0x1b, 0x9b, 0xc5, 0xd2, 0x83, 0xcd, 0x8d, 0xa5, 0x19, 0xba, 0x2c, 0x3e, 0x2a, 0x17,
+	0x4b, 0xe5, 0xf2, 0xe6, 0xc6, 0x92, 0x84, 0x16, 0x61, 0xfe, 0xd1, 0x83, 0xde, 0x46, 0x2c, 0xf7,
+	0x1b, 0xc0, 0x2c, 0x4b, 0x77, 0xf4, 0x83, 0x04, 0x49, 0x3e, 0xe4, 0x20, 0x35, 0x32, 0x81, 0xf7,
+	0x4e, 0x58, 0x99, 0x2b, 0x93, 0x03, 0xf8, 0x5b, 0x92, 0x2f, 0x7d, 0xfb, 0xea, 0xdf, 0xef, 0x63,
+	0xe7, 0xd1, 0x39, 0x75, 0xfc, 0x48, 0x8a, 0x7e, 0x95, 0x60, 0xb1, 0xaf, 0x3f, 0x15, 0xda, 0x25,
+	0x03, 0x7d, 0x34, 0xfe, 0xc8, 0x91, 0x53, 0x59, 0x66, 0x6d, 0x7a, 0xa0, 0xe0, 0x7c, 0x9d, 0x71,
+	0xbe, 0x82, 0x14, 0x75, 0xd2, 0xe1, 0x55, 0xdd, 0x31, 0x8d, 0x0e, 0xfa, 0x53, 0x82, 0xe5, 0x51,
+	0x0d, 0x1b, 0xdd, 0x1e, 0x4f, 0x25, 0x62, 0x54, 0xcc, 0xdc, 0x39, 0x28, 0x5c, 0xe8, 0xb9, 0xc9,
+	0xf4, 0x5c, 0x43, 0xf9, 0x89, 0xf5, 0xf8, 0xea, 0x0e, 0x9f, 0x33, 0x3b, 0xe8, 0x67, 0x09, 0xe6,
+	0xfb, 0x3a, 0x21, 0xba, 0x3a, 0x9e, 0xcc, 0xde, 0xbe, 0x9f, 0xb9, 0x36, 0x25, 0x4a, 0x30, 0x5f,
+	0x63, 0xcc, 0x73, 0xe8, 0x4a, 0x24, 0x73, 0xe2, 0x54, 0x04, 0x79, 0xcb, 0xf5, 0xe9, 0x55, 0xf8,
+	0x1d, 0xf4, 0x87, 0x04, 0xc7, 0x06, 0x1a, 0x38, 0x6f, 0xe1, 0xe8, 0xc6, 0x54, 0x44, 0x06, 0x86,
+	0x85, 0xcc, 0xcd, 0x03, 0x61, 0x85, 0x94, 0x3b, 0x4c, 0xca, 0x1a, 0xba, 0x3e, 0xb9, 0x94, 0x0a,
+	0x1d, 0x47, 0xd4, 0x1d, 0xfa, 0xb7, 0x83, 0x7e, 0x97, 0xf6, 0xf6, 0xe2, 0xb5, 0x69, 0x08, 0xf5,
+	0x4f, 0x18, 0x99, 0x8f, 0x0f, 0x80, 0x14, 0x42, 0x0a, 0x4c, 0xc8, 0x2d, 0x74, 0x63, 0x62, 0x21,
+	0x15, 0x36, 0x4e, 0xa8, 0x3b, 0x61, 0x87, 0xe9, 0xa0, 0x9f, 0x24, 0x48, 0x75, 0x3b, 0x10, 0xca,
+	0x4d, 0x40, 0x66, 0x68, 0x42, 0xc8, 0xe4, 0xa7, 0xc2, 0x08, 0xea, 0xb7, 0x18, 0xf5, 0xeb, 0xe8,
+	0x6a, 0x34, 0x75, 0x46, 0xb3, 0x4a, 0xc8, 0xb6, 0xba, 0xd3, 0x9b, 0x42, 0x3a, 0x85, 0x7b, 0x2f,
+	0xde, 0x64, 0xa5, 0x97, 0x6f, 0xb2, 0xd2, 0x3f, 0x6f, 0xb2, 0xd2, 0x77, 0xbb, 0xd9, 0x99, 0x97,
+	0xbb, 0xd9, 0x99, 0xbf, 0x76, 0xb3, 0x33, 0x8f, 0x57, 0x1b, 0x66, 0xd0, 0x6c, 0x55, 0x69, 0x0f,
+	0xdc, 0xcf, 0xf3, 0xd3, 0xbc, 0xfa, 0x8c, 0xbb, 0xa7, 0xdd, 0xd0, 0xaf, 0x26, 0xd9, 0x3f, 0xb2,
+	0xf9, 0xff, 0x07, 0x00, 0xb5, 0x38, 0xd0, 0x38, 0x50, 0x10, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -685,6 +1046,13 @@ type QueryClient interface {
 	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
 	OnDemandLPs(ctx context.Context, in *QueryOnDemandLPsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsResponse, error)
 	OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemandLPsByAddrRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsByAddrResponse, error)
+	// Previews which on-demand LPs would fulfill an order, and for how much, in
+	// the current state.
+	OnDemandLPMatch(ctx context.Context, in *QueryOnDemandLPMatchRequest, opts ...grpc.CallOption) (*QueryOnDemandLPMatchResponse, error)
+	// Queries the outstanding orders of a rollapp in a denom, best paying first
+	// by their effective fee at a height, with the depth and expected profit of
+	// the book.
+	OrderBook(ctx context.Context, in *QueryOrderBookRequest, opts ...grpc.CallOption) (*QueryOrderBookResponse, error)
 }
 
 type queryClient struct {
@@ -740,6 +1108,24 @@ func (c *queryClient) OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemand
 	return out, nil
 }
 
+func (c *queryClient) OnDemandLPMatch(ctx context.Context, in *QueryOnDemandLPMatchRequest, opts ...grpc.CallOption) (*QueryOnDemandLPMatchResponse, error) {
+	out := new(QueryOnDemandLPMatchResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/OnDemandLPMatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OrderBook(ctx context.Context, in *QueryOrderBookRequest, opts ...grpc.CallOption) (*QueryOrderBookResponse, error) {
+	out := new(QueryOrderBookResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/OrderBook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -750,6 +1136,13 @@ type QueryServer interface {
 	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
 	OnDemandLPs(context.Context, *QueryOnDemandLPsRequest) (*QueryOnDemandLPsResponse, error)
 	OnDemandLPsByByAddr(context.Context, *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error)
+	// Previews which on-demand LPs would fulfill an order, and for how much, in
+	// the current state.
+	OnDemandLPMatch(context.Context, *QueryOnDemandLPMatchRequest) (*QueryOnDemandLPMatchResponse, error)
+	// Queries the outstanding orders of a rollapp in a denom, best paying first
+	// by their effective fee at a height, with the depth and expected profit of
+	// the book.
+	OrderBook(context.Context, *QueryOrderBookRequest) (*QueryOrderBookResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -771,6 +1164,12 @@ func (*UnimplementedQueryServer) OnDemandLPs(ctx context.Context, req *QueryOnDe
 func (*UnimplementedQueryServer) OnDemandLPsByByAddr(ctx context.Context, req *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPsByByAddr not implemented")
 }
+func (*UnimplementedQueryServer) OnDemandLPMatch(ctx context.Context, req *QueryOnDemandLPMatchRequest) (*QueryOnDemandLPMatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPMatch not implemented")
+}
+func (*UnimplementedQueryServer) OrderBook(ctx context.Context, req *QueryOrderBookRequest) (*QueryOrderBookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OrderBook not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -866,6 +1265,42 @@ func _Query_OnDemandLPsByByAddr_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_OnDemandLPMatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOnDemandLPMatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OnDemandLPMatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/OnDemandLPMatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OnDemandLPMatch(ctx, req.(*QueryOnDemandLPMatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OrderBook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOrderBookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OrderBook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/OrderBook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OrderBook(ctx, req.(*QueryOrderBookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.eibc.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -890,6 +1325,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OnDemandLPsByByAddr",
 			Handler:    _Query_OnDemandLPsByByAddr_Handler,
 		},
+		{
+			MethodName: "OnDemandLPMatch",
+			Handler:    _Query_OnDemandLPMatch_Handler,
+		},
+		{
+			MethodName: "OrderBook",
+			Handler:    _Query_OrderBook_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/eibc/query.proto",
@@ -1293,31 +1736,330 @@ func (m *QueryOnDemandLPsByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryOnDemandLPMatchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryOnDemandLPMatchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *QueryOnDemandLPMatchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOnDemandLPMatchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOnDemandLPMatchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOnDemandLPMatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Matches) > 0 {
+		for iNdEx := len(m.Matches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Matches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OnDemandLPMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OnDemandLPMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnDemandLPMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.LpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOrderBookResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOrderBookResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOrderBookResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExpectedProfit.Size()
+		i -= size
+		if _, err := m.ExpectedProfit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Depth.Size()
+		i -= size
+		if _, err := m.Depth.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Orders) > 0 {
+		for iNdEx := len(m.Orders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Orders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OrderBookEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OrderBookEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrderBookEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExpectedProfit.Size()
+		i -= size
+		if _, err := m.ExpectedProfit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.CumulativeAmount.Size()
+		i -= size
+		if _, err := m.CumulativeAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.FeeRate.Size()
+		i -= size
+		if _, err := m.FeeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Type != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovQuery(uint64(l))
@@ -1469,15 +2211,128 @@ func (m *QueryOnDemandLPsByAddrResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
+func (m *QueryOnDemandLPMatchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPMatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Matches) > 0 {
+		for _, e := range m.Matches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OnDemandLPMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LpId != 0 {
+		n += 1 + sovQuery(uint64(m.LpId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOrderBookRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryOrderBookResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if len(m.Orders) > 0 {
+		for _, e := range m.Orders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Depth.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExpectedProfit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *OrderBookEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CumulativeAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ExpectedProfit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
@@ -2562,6 +3417,869 @@ func (m *QueryOnDemandLPsByAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryOnDemandLPMatchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOnDemandLPMatchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOnDemandLPMatchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOnDemandLPMatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOnDemandLPMatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOnDemandLPMatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Matches = append(m.Matches, OnDemandLPMatch{})
+			if err := m.Matches[len(m.Matches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnDemandLPMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnDemandLPMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnDemandLPMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+			}
+			m.LpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOrderBookResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOrderBookResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOrderBookResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orders = append(m.Orders, OrderBookEntry{})
+			if err := m.Orders[len(m.Orders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Depth.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedProfit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedProfit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OrderBookEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OrderBookEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OrderBookEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CumulativeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedProfit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedProfit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
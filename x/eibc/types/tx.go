@@ -23,6 +23,9 @@ var (
 	_ sdk.Msg = &MsgTryFulfillOnDemand{}
 	_ sdk.Msg = &MsgCreateOnDemandLP{}
 	_ sdk.Msg = &MsgDeleteOnDemandLP{}
+	_ sdk.Msg = &MsgListOrderClaim{}
+	_ sdk.Msg = &MsgDelistOrderClaim{}
+	_ sdk.Msg = &MsgBuyOrderClaim{}
 )
 
 func NewMsgFulfillOrder(fulfillerAddress, orderId, expectedFee string) *MsgFulfillOrder {
@@ -38,6 +41,12 @@ func (msg *MsgFulfillOrder) ValidateBasic() error {
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error()) // TODO: join
 	}
+	if msg.Amount != "" {
+		amount, ok := math.NewIntFromString(msg.Amount)
+		if !ok || !amount.IsPositive() {
+			return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount must be a positive integer: %s", msg.Amount)
+		}
+	}
 	return nil
 }
 
@@ -216,6 +225,34 @@ func (m *MsgDeleteOnDemandLP) MustAcc() sdk.AccAddress {
 	return a
 }
 
+func (m *MsgListOrderClaim) ValidateBasic() error {
+	if err := validateClaimMsg(m.Seller, m.OrderId); err != nil {
+		return err
+	}
+	return validateClaimPrice(m.Price)
+}
+
+func (m *MsgDelistOrderClaim) ValidateBasic() error {
+	return validateClaimMsg(m.Seller, m.OrderId)
+}
+
+func (m *MsgBuyOrderClaim) ValidateBasic() error {
+	if err := validateClaimMsg(m.Buyer, m.OrderId); err != nil {
+		return err
+	}
+	return validateClaimPrice(m.Price)
+}
+
+func validateClaimMsg(signer, orderId string) error {
+	if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "signer")
+	}
+	if !isValidOrderId(orderId) {
+		return errorsmod.Wrapf(ErrInvalidOrderID, "%s", orderId)
+	}
+	return nil
+}
+
 func (m MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
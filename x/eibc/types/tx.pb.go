@@ -134,6 +134,9 @@ type MsgFulfillOrder struct {
 	// expected_fee is the nominal fee set in the order. Fulfiller will generally
 	// make less profit (after deducting bridge fee)
 	ExpectedFee string `protobuf:"bytes,3,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+	// amount is the part of the order's unfilled price to pay. Empty pays all of
+	// it.
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
 func (m *MsgFulfillOrder) Reset()         { *m = MsgFulfillOrder{} }
@@ -190,6 +193,13 @@ func (m *MsgFulfillOrder) GetExpectedFee() string {
 	return ""
 }
 
+func (m *MsgFulfillOrder) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
 // MsgFulfillOrderResponse defines the FulfillOrder response type.
 type MsgFulfillOrderResponse struct {
 }
@@ -758,6 +768,295 @@ func (m *MsgDeleteOnDemandLPResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteOnDemandLPResponse proto.InternalMessageInfo
 
+// MsgListOrderClaim offers the claim on a fulfilled order's packet for sale.
+// Listing an order already listed replaces its price.
+type MsgListOrderClaim struct {
+	// seller must hold the claim, i.e. be who the packet pays on finalization.
+	Seller  string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// price is what a buyer pays the seller for the claim.
+	Price types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *MsgListOrderClaim) Reset()         { *m = MsgListOrderClaim{} }
+func (m *MsgListOrderClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgListOrderClaim) ProtoMessage()    {}
+func (*MsgListOrderClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{14}
+}
+func (m *MsgListOrderClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListOrderClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListOrderClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgListOrderClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListOrderClaim.Merge(m, src)
+}
+func (m *MsgListOrderClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListOrderClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListOrderClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListOrderClaim proto.InternalMessageInfo
+
+func (m *MsgListOrderClaim) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *MsgListOrderClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *MsgListOrderClaim) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+type MsgListOrderClaimResponse struct {
+}
+
+func (m *MsgListOrderClaimResponse) Reset()         { *m = MsgListOrderClaimResponse{} }
+func (m *MsgListOrderClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgListOrderClaimResponse) ProtoMessage()    {}
+func (*MsgListOrderClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{15}
+}
+func (m *MsgListOrderClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgListOrderClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgListOrderClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgListOrderClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgListOrderClaimResponse.Merge(m, src)
+}
+func (m *MsgListOrderClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgListOrderClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgListOrderClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgListOrderClaimResponse proto.InternalMessageInfo
+
+// MsgDelistOrderClaim withdraws a claim listing.
+type MsgDelistOrderClaim struct {
+	Seller  string `protobuf:"bytes,1,opt,name=seller,proto3" json:"seller,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *MsgDelistOrderClaim) Reset()         { *m = MsgDelistOrderClaim{} }
+func (m *MsgDelistOrderClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistOrderClaim) ProtoMessage()    {}
+func (*MsgDelistOrderClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{16}
+}
+func (m *MsgDelistOrderClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistOrderClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistOrderClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistOrderClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistOrderClaim.Merge(m, src)
+}
+func (m *MsgDelistOrderClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistOrderClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistOrderClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistOrderClaim proto.InternalMessageInfo
+
+func (m *MsgDelistOrderClaim) GetSeller() string {
+	if m != nil {
+		return m.Seller
+	}
+	return ""
+}
+
+func (m *MsgDelistOrderClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type MsgDelistOrderClaimResponse struct {
+}
+
+func (m *MsgDelistOrderClaimResponse) Reset()         { *m = MsgDelistOrderClaimResponse{} }
+func (m *MsgDelistOrderClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistOrderClaimResponse) ProtoMessage()    {}
+func (*MsgDelistOrderClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{17}
+}
+func (m *MsgDelistOrderClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistOrderClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistOrderClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistOrderClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistOrderClaimResponse.Merge(m, src)
+}
+func (m *MsgDelistOrderClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistOrderClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistOrderClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistOrderClaimResponse proto.InternalMessageInfo
+
+// MsgBuyOrderClaim buys a listed claim: the buyer pays the seller, becomes the
+// order's fulfiller and is paid by the packet on finalization.
+type MsgBuyOrderClaim struct {
+	Buyer   string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// price must equal the listed price, so a relisting cannot charge the buyer
+	// more than they agreed to.
+	Price types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *MsgBuyOrderClaim) Reset()         { *m = MsgBuyOrderClaim{} }
+func (m *MsgBuyOrderClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyOrderClaim) ProtoMessage()    {}
+func (*MsgBuyOrderClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{18}
+}
+func (m *MsgBuyOrderClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyOrderClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyOrderClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyOrderClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyOrderClaim.Merge(m, src)
+}
+func (m *MsgBuyOrderClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyOrderClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyOrderClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyOrderClaim proto.InternalMessageInfo
+
+func (m *MsgBuyOrderClaim) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *MsgBuyOrderClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *MsgBuyOrderClaim) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+type MsgBuyOrderClaimResponse struct {
+}
+
+func (m *MsgBuyOrderClaimResponse) Reset()         { *m = MsgBuyOrderClaimResponse{} }
+func (m *MsgBuyOrderClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBuyOrderClaimResponse) ProtoMessage()    {}
+func (*MsgBuyOrderClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{19}
+}
+func (m *MsgBuyOrderClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBuyOrderClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBuyOrderClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBuyOrderClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBuyOrderClaimResponse.Merge(m, src)
+}
+func (m *MsgBuyOrderClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBuyOrderClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBuyOrderClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBuyOrderClaimResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParamsResponse")
@@ -773,6 +1072,12 @@ func init() {
 	proto.RegisterType((*MsgCreateOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLPResponse")
 	proto.RegisterType((*MsgDeleteOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLP")
 	proto.RegisterType((*MsgDeleteOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLPResponse")
+	proto.RegisterType((*MsgListOrderClaim)(nil), "dymensionxyz.dymension.eibc.MsgListOrderClaim")
+	proto.RegisterType((*MsgListOrderClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgListOrderClaimResponse")
+	proto.RegisterType((*MsgDelistOrderClaim)(nil), "dymensionxyz.dymension.eibc.MsgDelistOrderClaim")
+	proto.RegisterType((*MsgDelistOrderClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgDelistOrderClaimResponse")
+	proto.RegisterType((*MsgBuyOrderClaim)(nil), "dymensionxyz.dymension.eibc.MsgBuyOrderClaim")
+	proto.RegisterType((*MsgBuyOrderClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgBuyOrderClaimResponse")
 }
 
 func init() {
@@ -780,71 +1085,80 @@ func init() {
 }
 
 var fileDescriptor_47537f11f512b254 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0xc7, 0x45, 0x4b, 0x96, 0xad, 0x63, 0x7f, 0x89, 0xc2, 0x28, 0xb6, 0x24, 0x7f, 0x96, 0x1d,
-	0xb9, 0x40, 0x85, 0xb4, 0x26, 0x2d, 0xa7, 0x48, 0x5b, 0x2d, 0x0a, 0xc4, 0x11, 0x8c, 0x1a, 0xb5,
-	0x51, 0x83, 0x69, 0xbb, 0x28, 0x0a, 0x08, 0x94, 0x78, 0x4c, 0x13, 0xa1, 0x38, 0x04, 0x67, 0x7c,
-	0x51, 0x16, 0x45, 0xd0, 0x00, 0x5d, 0x17, 0x45, 0x1f, 0xa0, 0x40, 0x77, 0x5e, 0x65, 0x91, 0x87,
-	0xc8, 0xaa, 0x08, 0xb2, 0x2a, 0xba, 0x88, 0x0b, 0x7b, 0x91, 0xd7, 0x28, 0x86, 0x1c, 0x51, 0xa4,
-	0xae, 0x51, 0x57, 0xe4, 0xcc, 0xb9, 0xcc, 0xef, 0xcf, 0x39, 0x67, 0x86, 0xf0, 0x81, 0xd1, 0x69,
-	0xa3, 0x43, 0x2d, 0xe2, 0x9c, 0x77, 0x9e, 0xaa, 0xe1, 0x40, 0x45, 0xab, 0xd9, 0x52, 0xd9, 0xb9,
-	0xe2, 0x7a, 0x84, 0x11, 0x79, 0x25, 0xea, 0xa5, 0x84, 0x03, 0x85, 0x7b, 0x15, 0x97, 0x5b, 0x84,
-	0xb6, 0x09, 0x55, 0xdb, 0xd4, 0x54, 0x4f, 0xab, 0xfc, 0x11, 0x44, 0x15, 0x0b, 0x81, 0xa1, 0xe1,
-	0x8f, 0xd4, 0x60, 0x20, 0x4c, 0x39, 0x93, 0x98, 0x24, 0x98, 0xe7, 0x6f, 0x62, 0xb6, 0x24, 0x32,
-	0x35, 0x75, 0x8a, 0xea, 0x69, 0xb5, 0x89, 0x4c, 0xaf, 0xaa, 0x2d, 0x62, 0x39, 0xc2, 0x3e, 0x16,
-	0xd6, 0x76, 0x85, 0x57, 0x65, 0x9c, 0x97, 0xab, 0x7b, 0x7a, 0x5b, 0x50, 0x94, 0xff, 0x90, 0xe0,
-	0xe6, 0x01, 0x35, 0xbf, 0x75, 0x0d, 0x9d, 0xe1, 0xa1, 0x6f, 0x91, 0x1f, 0x40, 0x46, 0x3f, 0x61,
-	0xc7, 0xc4, 0xb3, 0x58, 0x27, 0x2f, 0xad, 0x4b, 0x95, 0xcc, 0x4e, 0xfe, 0xcd, 0xcb, 0xcd, 0x9c,
-	0xc0, 0x7f, 0x68, 0x18, 0x1e, 0x52, 0xfa, 0x98, 0x79, 0x96, 0x63, 0x6a, 0x3d, 0x57, 0xf9, 0x4b,
-	0x00, 0x07, 0xcf, 0x1a, 0x41, 0xfe, 0xfc, 0xcc, 0xba, 0x54, 0x59, 0xd8, 0xde, 0x50, 0xc6, 0x7c,
-	0x37, 0x25, 0x58, 0x70, 0x27, 0xf5, 0xea, 0xed, 0x5a, 0x42, 0xcb, 0x38, 0x78, 0x16, 0x4c, 0xd4,
-	0x6e, 0xfc, 0xf4, 0xee, 0xc5, 0xbd, 0x5e, 0xe6, 0x72, 0x01, 0x96, 0xfb, 0x20, 0x35, 0xa4, 0x2e,
-	0x71, 0x28, 0x96, 0x7f, 0x0b, 0x04, 0xec, 0x9e, 0xd8, 0x47, 0x96, 0x6d, 0x7f, 0xed, 0x19, 0xe8,
-	0xc9, 0x1f, 0xc1, 0xad, 0xa3, 0x60, 0x8c, 0x5e, 0x43, 0x0f, 0x70, 0x03, 0x21, 0x5a, 0x36, 0x34,
-	0x08, 0x19, 0x72, 0x01, 0xe6, 0x09, 0x8f, 0x6a, 0x58, 0x86, 0xcf, 0x9c, 0xd1, 0xe6, 0xfc, 0xf1,
-	0x9e, 0x21, 0xdf, 0x85, 0x45, 0x3c, 0x77, 0xb1, 0xc5, 0xd0, 0x68, 0x1c, 0x21, 0xe6, 0x93, 0xbe,
-	0x79, 0xa1, 0x3b, 0xb7, 0x8b, 0x58, 0x5b, 0xe2, 0xa4, 0x83, 0xab, 0x09, 0xe2, 0x28, 0x55, 0x48,
-	0xfc, 0x67, 0x0a, 0x0a, 0x7d, 0xb6, 0x87, 0x81, 0xd2, 0xa7, 0x68, 0xc4, 0x70, 0xa4, 0x38, 0xce,
-	0x2a, 0x80, 0x47, 0x6c, 0x5b, 0x77, 0xdd, 0x1e, 0x6b, 0x46, 0xcc, 0xec, 0x19, 0xb2, 0x0e, 0xb3,
-	0xae, 0x67, 0xb5, 0x38, 0x66, 0xb2, 0xb2, 0xb0, 0x5d, 0x50, 0xc4, 0x7e, 0xf1, 0x52, 0x52, 0x44,
-	0x29, 0x29, 0x8f, 0x88, 0xe5, 0xec, 0x6c, 0xf1, 0xef, 0x7d, 0x71, 0xb9, 0x56, 0x31, 0x2d, 0x76,
-	0x7c, 0xd2, 0x54, 0x5a, 0xa4, 0x2d, 0x6a, 0x53, 0x3c, 0x36, 0xa9, 0xf1, 0x44, 0x65, 0x1d, 0x17,
-	0xa9, 0x1f, 0x40, 0xb5, 0x20, 0xb3, 0xfc, 0x03, 0xa4, 0xf5, 0x36, 0x39, 0x71, 0x58, 0x3e, 0xe5,
-	0x97, 0x45, 0x9d, 0x27, 0xfa, 0xfb, 0xed, 0xda, 0x9d, 0x20, 0x8c, 0x1a, 0x4f, 0x14, 0x8b, 0xa8,
-	0x6d, 0x9d, 0x1d, 0x2b, 0x7b, 0x0e, 0xbb, 0xb8, 0x1c, 0x61, 0x78, 0xf3, 0x72, 0x13, 0x04, 0xdc,
-	0x9e, 0xc3, 0x34, 0x91, 0x93, 0xeb, 0xb3, 0xdd, 0x70, 0xbf, 0x66, 0x03, 0x7d, 0xb6, 0xdb, 0xdd,
-	0xa8, 0x2d, 0xc8, 0x11, 0x17, 0x3d, 0x9d, 0x11, 0x8f, 0xef, 0x46, 0xe8, 0x98, 0xf6, 0x1d, 0xe5,
-	0xae, 0x6d, 0x17, 0xb1, 0x1b, 0xd1, 0xbf, 0x7f, 0x73, 0x03, 0xfb, 0x27, 0xff, 0x08, 0x72, 0x2c,
-	0x29, 0x3d, 0xd6, 0x3d, 0xcc, 0xcf, 0xfb, 0xea, 0x0e, 0x85, 0xba, 0x95, 0x41, 0x11, 0xfb, 0x68,
-	0xea, 0xad, 0x4e, 0x1d, 0x5b, 0x17, 0x97, 0x63, 0xcd, 0x11, 0xa5, 0x75, 0x6c, 0x69, 0xd9, 0x08,
-	0xe4, 0x63, 0xbe, 0x92, 0x5c, 0x85, 0x1c, 0x45, 0xc6, 0x6c, 0x6c, 0xa3, 0xc3, 0x1a, 0xa7, 0xba,
-	0x6d, 0xf1, 0x1a, 0x37, 0xf2, 0x99, 0x75, 0xa9, 0x32, 0xaf, 0xdd, 0xee, 0xd9, 0xbe, 0xeb, 0x9a,
-	0x6a, 0x37, 0x79, 0xc9, 0x45, 0xbe, 0x54, 0x79, 0x03, 0xee, 0x8e, 0xac, 0xa7, 0xb0, 0xea, 0x9e,
-	0x4b, 0x90, 0x0b, 0x7b, 0xa8, 0x8e, 0x6d, 0xdd, 0x31, 0x82, 0x66, 0xd9, 0x80, 0xff, 0x91, 0x33,
-	0x67, 0xa0, 0x51, 0x16, 0xfd, 0xc9, 0xf7, 0x68, 0x92, 0x65, 0x98, 0xe3, 0x5d, 0xdf, 0xeb, 0x8f,
-	0xb4, 0x83, 0x67, 0xbc, 0x35, 0x64, 0xce, 0x19, 0xcf, 0x5d, 0x2e, 0xc1, 0xff, 0x87, 0x41, 0x84,
-	0x94, 0x16, 0xdc, 0x39, 0xa0, 0xe6, 0x37, 0x5e, 0xa7, 0xab, 0xc6, 0x09, 0xbc, 0xe4, 0x25, 0x48,
-	0x53, 0xcb, 0x74, 0xd0, 0x13, 0xcb, 0x8b, 0xd1, 0xb8, 0x76, 0xc9, 0x42, 0xd2, 0x73, 0x4c, 0x1f,
-	0x2a, 0xa9, 0xf1, 0xd7, 0xda, 0x02, 0x27, 0x12, 0x91, 0xe5, 0x35, 0x58, 0x1d, 0xba, 0x54, 0xc8,
-	0x42, 0xe1, 0xf6, 0x01, 0x35, 0x1f, 0x79, 0xa8, 0x33, 0xec, 0x1a, 0xf7, 0x0f, 0x23, 0x24, 0xc9,
-	0x18, 0xc9, 0xa7, 0x30, 0x63, 0xbb, 0xe2, 0xd4, 0xfb, 0x70, 0xec, 0xa9, 0xd7, 0x4b, 0xa6, 0xcd,
-	0xd8, 0x6e, 0x9c, 0x6a, 0x13, 0x56, 0x86, 0x2c, 0xda, 0x65, 0x92, 0x6f, 0xc0, 0x8c, 0x10, 0x9a,
-	0xd2, 0x66, 0x2c, 0xa3, 0xbc, 0xef, 0x33, 0xd6, 0xd1, 0xc6, 0x11, 0x8c, 0x52, 0x8c, 0x31, 0x0b,
-	0x49, 0xcb, 0xe0, 0x47, 0x73, 0xb2, 0x92, 0xd2, 0xf8, 0x6b, 0x7c, 0xf1, 0x55, 0x7f, 0xf1, 0xfe,
-	0x6c, 0xdd, 0xc5, 0xb7, 0x7f, 0x9f, 0x83, 0xe4, 0x01, 0x35, 0x65, 0x0f, 0x16, 0x63, 0xf7, 0xc5,
-	0xc7, 0x63, 0xd5, 0xf6, 0x1d, 0xdc, 0xc5, 0x4f, 0xa6, 0xf1, 0x0e, 0x85, 0xff, 0x2c, 0x81, 0x3c,
-	0xa4, 0x2c, 0xb6, 0x27, 0x25, 0x1b, 0x8c, 0x29, 0xd6, 0xa6, 0x8f, 0x09, 0x6b, 0x22, 0x21, 0x33,
-	0x58, 0x8c, 0xdd, 0x35, 0x13, 0xc5, 0x47, 0xbd, 0x27, 0x8b, 0x1f, 0x7a, 0x63, 0x24, 0xe4, 0x5f,
-	0x25, 0x58, 0x1a, 0x71, 0x61, 0x3c, 0x98, 0x26, 0x65, 0x2f, 0xae, 0xf8, 0xc5, 0x7f, 0x8b, 0x8b,
-	0x40, 0x3d, 0x97, 0xe0, 0xd6, 0xe0, 0x79, 0x52, 0x7d, 0xbf, 0xfd, 0x8d, 0x84, 0x14, 0x3f, 0x9f,
-	0x3a, 0x24, 0x42, 0xf1, 0x4c, 0x82, 0xec, 0x40, 0x93, 0x6e, 0x4d, 0xca, 0xd8, 0x1f, 0x51, 0xfc,
-	0x6c, 0xda, 0x88, 0x3e, 0x84, 0x81, 0x1e, 0x9c, 0x88, 0xd0, 0x1f, 0x31, 0x19, 0x61, 0x54, 0x67,
-	0x96, 0x13, 0xc5, 0xd9, 0x67, 0xef, 0x5e, 0xdc, 0x93, 0x76, 0xbe, 0x7a, 0x75, 0x55, 0x92, 0x5e,
-	0x5f, 0x95, 0xa4, 0x7f, 0xae, 0x4a, 0xd2, 0x2f, 0xd7, 0xa5, 0xc4, 0xeb, 0xeb, 0x52, 0xe2, 0xaf,
-	0xeb, 0x52, 0xe2, 0xfb, 0x6a, 0xe4, 0xae, 0x1f, 0xf1, 0x77, 0x78, 0x7a, 0x5f, 0x3d, 0x17, 0x7f,
-	0xbd, 0xfc, 0xea, 0x6f, 0xa6, 0xfd, 0x5f, 0xc4, 0xfb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x33, 0xd9, 0xee, 0x21, 0x0b, 0x00, 0x00,
+	// 1159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x97, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0xc0, 0x4d, 0xcb, 0xbf, 0xf4, 0xec, 0x38, 0x32, 0xa3, 0xd8, 0x12, 0xfd, 0xb5, 0xec, 0xc8,
+	0x5f, 0xa0, 0x42, 0x5a, 0x93, 0x96, 0x93, 0xb8, 0xae, 0x86, 0x02, 0x91, 0x0d, 0xa3, 0x46, 0x2d,
+	0xd4, 0x60, 0x9a, 0x0e, 0x45, 0x01, 0x81, 0x12, 0xcf, 0x34, 0x11, 0x8a, 0x24, 0x78, 0x27, 0xdb,
+	0xf2, 0x50, 0x04, 0x0d, 0x90, 0xa1, 0x53, 0xd1, 0xa9, 0x7b, 0x37, 0xa3, 0x43, 0x86, 0xfc, 0x11,
+	0x99, 0x8a, 0x20, 0x53, 0xd1, 0x21, 0x2e, 0xec, 0x21, 0xff, 0x46, 0x71, 0xbc, 0x23, 0x45, 0x4a,
+	0xb2, 0x64, 0xb5, 0xe8, 0x24, 0xdd, 0xbd, 0x5f, 0x9f, 0x77, 0xef, 0xdd, 0x23, 0x09, 0xff, 0xd7,
+	0x5b, 0x0d, 0x64, 0x63, 0xd3, 0xb1, 0x4f, 0x5b, 0x67, 0x4a, 0xb8, 0x50, 0x90, 0x59, 0xab, 0x2b,
+	0xe4, 0x54, 0x76, 0x3d, 0x87, 0x38, 0xe2, 0x62, 0x54, 0x4b, 0x0e, 0x17, 0x32, 0xd5, 0x92, 0x16,
+	0xea, 0x0e, 0x6e, 0x38, 0x58, 0x69, 0x60, 0x43, 0x39, 0x2e, 0xd2, 0x1f, 0x66, 0x25, 0x65, 0x99,
+	0xa0, 0xea, 0xaf, 0x14, 0xb6, 0xe0, 0xa2, 0xb4, 0xe1, 0x18, 0x0e, 0xdb, 0xa7, 0xff, 0xf8, 0x6e,
+	0x8e, 0x7b, 0xaa, 0x69, 0x18, 0x29, 0xc7, 0xc5, 0x1a, 0x22, 0x5a, 0x51, 0xa9, 0x3b, 0xa6, 0xcd,
+	0xe5, 0x7d, 0x61, 0x2d, 0x97, 0x6b, 0x15, 0xfa, 0x69, 0xb9, 0x9a, 0xa7, 0x35, 0x38, 0x45, 0xfe,
+	0x57, 0x01, 0x6e, 0x57, 0xb0, 0xf1, 0xd4, 0xd5, 0x35, 0x82, 0x0e, 0x7c, 0x89, 0xb8, 0x09, 0x49,
+	0xad, 0x49, 0x8e, 0x1c, 0xcf, 0x24, 0xad, 0x8c, 0xb0, 0x22, 0x14, 0x92, 0xe5, 0xcc, 0xbb, 0xd7,
+	0x6b, 0x69, 0x8e, 0xff, 0x58, 0xd7, 0x3d, 0x84, 0xf1, 0x13, 0xe2, 0x99, 0xb6, 0xa1, 0xb6, 0x55,
+	0xc5, 0x2f, 0x00, 0x6c, 0x74, 0x52, 0x65, 0xfe, 0x33, 0xa3, 0x2b, 0x42, 0x61, 0x7a, 0x63, 0x55,
+	0xee, 0x73, 0x6e, 0x32, 0x0b, 0x58, 0x1e, 0x7b, 0xf3, 0x7e, 0x79, 0x44, 0x4d, 0xda, 0xe8, 0x84,
+	0x6d, 0x94, 0x66, 0x7f, 0xf8, 0xf0, 0xea, 0x7e, 0xdb, 0x73, 0x3e, 0x0b, 0x0b, 0x1d, 0x90, 0x2a,
+	0xc2, 0xae, 0x63, 0x63, 0x94, 0xff, 0x8d, 0x25, 0xb0, 0xdb, 0xb4, 0x0e, 0x4d, 0xcb, 0xfa, 0xca,
+	0xd3, 0x91, 0x27, 0x7e, 0x0c, 0x73, 0x87, 0x6c, 0x8d, 0xbc, 0xaa, 0xc6, 0x70, 0x59, 0x22, 0x6a,
+	0x2a, 0x14, 0xf0, 0x34, 0xc4, 0x2c, 0x4c, 0x39, 0xd4, 0xaa, 0x6a, 0xea, 0x3e, 0x73, 0x52, 0x9d,
+	0xf4, 0xd7, 0x7b, 0xba, 0x78, 0x0f, 0x66, 0xd0, 0xa9, 0x8b, 0xea, 0x04, 0xe9, 0xd5, 0x43, 0x84,
+	0x32, 0x09, 0x5f, 0x3c, 0x1d, 0xec, 0xed, 0x22, 0x24, 0xce, 0xc3, 0x84, 0xd6, 0x70, 0x9a, 0x36,
+	0xc9, 0x8c, 0xf9, 0x42, 0xbe, 0x2a, 0xcd, 0xd3, 0x0c, 0xba, 0x29, 0x78, 0x26, 0x51, 0xda, 0x30,
+	0x93, 0xdf, 0xc7, 0x20, 0xdb, 0x21, 0x7b, 0xcc, 0x4e, 0xe0, 0x0c, 0xe9, 0x31, 0x4c, 0x21, 0x8e,
+	0xb9, 0x04, 0xe0, 0x39, 0x96, 0xa5, 0xb9, 0x6e, 0x3b, 0x87, 0x24, 0xdf, 0xd9, 0xd3, 0x45, 0x0d,
+	0xc6, 0x5d, 0xcf, 0xac, 0x53, 0xfc, 0x44, 0x61, 0x7a, 0x23, 0x2b, 0xf3, 0x3a, 0xd2, 0x16, 0x93,
+	0x79, 0x8b, 0xc9, 0xdb, 0x8e, 0x69, 0x97, 0xd7, 0x69, 0x1d, 0xce, 0x2f, 0x96, 0x0b, 0x86, 0x49,
+	0x8e, 0x9a, 0x35, 0xb9, 0xee, 0x34, 0x78, 0xcf, 0xf2, 0x9f, 0x35, 0xac, 0x3f, 0x53, 0x48, 0xcb,
+	0x45, 0xd8, 0x37, 0xc0, 0x2a, 0xf3, 0x2c, 0x7e, 0x17, 0x3f, 0x85, 0xf2, 0x0e, 0x75, 0xf4, 0xe7,
+	0xfb, 0xe5, 0xbb, 0xcc, 0x0c, 0xeb, 0xcf, 0x64, 0xd3, 0x51, 0x1a, 0x1a, 0x39, 0x92, 0xf7, 0x6c,
+	0x72, 0x7e, 0x71, 0x8d, 0xe0, 0xdd, 0xeb, 0x35, 0xe0, 0x70, 0x7b, 0x36, 0x09, 0xce, 0x92, 0xe6,
+	0x67, 0xb9, 0x61, 0x1d, 0xc7, 0x59, 0x7e, 0x96, 0x1b, 0x14, 0x70, 0x1d, 0xd2, 0x8e, 0x8b, 0x3c,
+	0x8d, 0x38, 0x1e, 0xad, 0x52, 0xa8, 0x38, 0xe1, 0x2b, 0x8a, 0x81, 0x6c, 0x17, 0xa1, 0xc0, 0xa2,
+	0xb3, 0xae, 0x93, 0xdd, 0x75, 0xfd, 0x1e, 0xc4, 0x98, 0x53, 0x7c, 0xa4, 0x79, 0x28, 0x33, 0xe5,
+	0x67, 0x77, 0xc0, 0xb3, 0x5b, 0xec, 0x4e, 0x62, 0x1f, 0x19, 0x5a, 0xbd, 0xb5, 0x83, 0xea, 0xe7,
+	0x17, 0x7d, 0xc5, 0x91, 0x4c, 0x77, 0x50, 0x5d, 0x4d, 0x45, 0x20, 0x9f, 0xd0, 0x48, 0x62, 0x11,
+	0xd2, 0x18, 0x11, 0x62, 0xa1, 0x06, 0xb2, 0x49, 0xf5, 0x58, 0xb3, 0x4c, 0xda, 0xfb, 0x7a, 0x26,
+	0xb9, 0x22, 0x14, 0xa6, 0xd4, 0x3b, 0x6d, 0xd9, 0x37, 0x81, 0xa8, 0x74, 0x9b, 0xb6, 0x5c, 0xe4,
+	0xa4, 0xf2, 0xab, 0x70, 0xef, 0xda, 0x7e, 0x0a, 0xbb, 0xee, 0x85, 0x00, 0xe9, 0xf0, 0x6e, 0xed,
+	0xa0, 0x86, 0x66, 0xeb, 0xec, 0x12, 0xad, 0xc2, 0x2d, 0xe7, 0xc4, 0xee, 0xba, 0x40, 0x33, 0xfe,
+	0xe6, 0x0d, 0x2e, 0xcf, 0x02, 0x4c, 0xd2, 0x69, 0xd0, 0xbe, 0x37, 0x13, 0x36, 0x3a, 0xd9, 0x45,
+	0xa8, 0x24, 0x52, 0xce, 0xb8, 0xef, 0x7c, 0x0e, 0xfe, 0xd7, 0x0b, 0x22, 0xa4, 0x34, 0xe1, 0x6e,
+	0x05, 0x1b, 0x5f, 0x7b, 0xad, 0x20, 0x1b, 0x9b, 0x69, 0xd1, 0xfb, 0x87, 0x4d, 0xc3, 0x46, 0x1e,
+	0x0f, 0xcf, 0x57, 0xfd, 0xae, 0x4b, 0x0a, 0x12, 0x9e, 0x6d, 0xf8, 0x50, 0x09, 0x95, 0xfe, 0x2d,
+	0x4d, 0x53, 0x22, 0x6e, 0x99, 0x5f, 0x86, 0xa5, 0x9e, 0xa1, 0x42, 0x16, 0x0c, 0x77, 0x2a, 0xd8,
+	0xd8, 0xf6, 0x90, 0x46, 0x50, 0x20, 0xdc, 0x3f, 0x88, 0x90, 0x24, 0x62, 0x24, 0x9f, 0xc2, 0xa8,
+	0xe5, 0xf2, 0x69, 0xf8, 0x51, 0xdf, 0x69, 0xd8, 0x76, 0xa6, 0x8e, 0x5a, 0x6e, 0x9c, 0x6a, 0x0d,
+	0x16, 0x7b, 0x04, 0x0d, 0x98, 0xc4, 0x59, 0x18, 0xe5, 0x89, 0x8e, 0xa9, 0xa3, 0xa6, 0x9e, 0xdf,
+	0xf7, 0x19, 0x77, 0x90, 0x85, 0xae, 0x61, 0x14, 0x62, 0x8c, 0x29, 0x48, 0x98, 0x3a, 0x1d, 0xd9,
+	0x89, 0xc2, 0x98, 0x4a, 0xff, 0xc6, 0x83, 0x2f, 0xc1, 0x62, 0x0f, 0x6f, 0xe1, 0x81, 0xfc, 0x28,
+	0xc0, 0x5c, 0x05, 0x1b, 0xfb, 0x26, 0x26, 0x7e, 0xd5, 0xb6, 0x2d, 0xcd, 0x6c, 0xf8, 0xb1, 0x90,
+	0x65, 0x45, 0x62, 0xf9, 0xab, 0x7e, 0x2d, 0xf3, 0xa8, 0x3d, 0xa9, 0x84, 0xfe, 0x93, 0x8a, 0x3d,
+	0x31, 0x98, 0x76, 0xc0, 0xea, 0xbb, 0xcf, 0x2f, 0x42, 0xb6, 0x8b, 0x25, 0x24, 0x7d, 0x1a, 0x1c,
+	0xcb, 0xbf, 0x45, 0x8d, 0xc7, 0x0c, 0xcf, 0xa7, 0x77, 0xd4, 0x97, 0x02, 0xa4, 0x2a, 0xd8, 0x28,
+	0x37, 0x5b, 0x91, 0x98, 0x69, 0x18, 0xaf, 0x35, 0x5b, 0x61, 0x48, 0xb6, 0xf8, 0x0f, 0x0e, 0x07,
+	0x28, 0x28, 0xf3, 0x9e, 0x97, 0x20, 0xd3, 0xc9, 0x11, 0x40, 0x6e, 0xfc, 0x02, 0x90, 0xa8, 0x60,
+	0x43, 0xf4, 0x60, 0x26, 0xf6, 0x32, 0xf0, 0x49, 0xdf, 0x96, 0xed, 0x78, 0x2a, 0x4b, 0x0f, 0x87,
+	0xd1, 0x0e, 0xbb, 0xf7, 0xa5, 0x00, 0x62, 0x8f, 0xbb, 0xbd, 0x31, 0xc8, 0x59, 0xb7, 0x8d, 0x54,
+	0x1a, 0xde, 0x26, 0xac, 0xd3, 0x88, 0x48, 0x60, 0x26, 0xf6, 0x22, 0x31, 0x30, 0xf9, 0xa8, 0xb6,
+	0xf4, 0x70, 0x18, 0xed, 0x48, 0xd4, 0x9f, 0x05, 0x98, 0xbf, 0xe6, 0xa9, 0xbf, 0x39, 0x8c, 0xcb,
+	0xb6, 0x9d, 0xf4, 0xf9, 0x3f, 0xb3, 0x8b, 0x40, 0xbd, 0x10, 0x60, 0xae, 0xfb, 0xa1, 0x50, 0xbc,
+	0x59, 0x7d, 0x23, 0x26, 0xd2, 0x67, 0x43, 0x9b, 0x44, 0x28, 0x9e, 0x0b, 0x90, 0xea, 0x9a, 0xb4,
+	0xeb, 0x83, 0x3c, 0x76, 0x5a, 0x48, 0x5b, 0xc3, 0x5a, 0x74, 0x20, 0x74, 0x0d, 0xd2, 0x81, 0x08,
+	0x9d, 0x16, 0xd2, 0xd6, 0xb0, 0x16, 0x11, 0x84, 0x33, 0x98, 0xed, 0x18, 0xae, 0xf2, 0x20, 0x6f,
+	0x71, 0x7d, 0x69, 0x73, 0x38, 0xfd, 0xee, 0xf4, 0xe3, 0xe1, 0x6f, 0x92, 0x7e, 0x1c, 0x60, 0x6b,
+	0x58, 0x8b, 0x08, 0xc2, 0x09, 0xdc, 0x8a, 0xcf, 0xce, 0xb5, 0x41, 0xce, 0x62, 0xea, 0xd2, 0xa3,
+	0xa1, 0xd4, 0xdb, 0x81, 0xa5, 0xf1, 0xe7, 0x1f, 0x5e, 0xdd, 0x17, 0xca, 0x5f, 0xbe, 0xb9, 0xcc,
+	0x09, 0x6f, 0x2f, 0x73, 0xc2, 0x5f, 0x97, 0x39, 0xe1, 0xa7, 0xab, 0xdc, 0xc8, 0xdb, 0xab, 0xdc,
+	0xc8, 0x1f, 0x57, 0xb9, 0x91, 0x6f, 0x8b, 0x91, 0x17, 0xe5, 0x6b, 0x3e, 0xb9, 0x8e, 0x1f, 0x28,
+	0xa7, 0xfc, 0x53, 0x92, 0xbe, 0x37, 0xd7, 0x26, 0xfc, 0xef, 0xae, 0x07, 0x7f, 0x0f, 0x00, 0xf9,
+	0x84, 0xc4, 0x31, 0x76, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -867,6 +1181,9 @@ type MsgClient interface {
 	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
 	CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error)
 	DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error)
+	ListOrderClaim(ctx context.Context, in *MsgListOrderClaim, opts ...grpc.CallOption) (*MsgListOrderClaimResponse, error)
+	DelistOrderClaim(ctx context.Context, in *MsgDelistOrderClaim, opts ...grpc.CallOption) (*MsgDelistOrderClaimResponse, error)
+	BuyOrderClaim(ctx context.Context, in *MsgBuyOrderClaim, opts ...grpc.CallOption) (*MsgBuyOrderClaimResponse, error)
 }
 
 type msgClient struct {
@@ -940,6 +1257,33 @@ func (c *msgClient) DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandL
 	return out, nil
 }
 
+func (c *msgClient) ListOrderClaim(ctx context.Context, in *MsgListOrderClaim, opts ...grpc.CallOption) (*MsgListOrderClaimResponse, error) {
+	out := new(MsgListOrderClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/ListOrderClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelistOrderClaim(ctx context.Context, in *MsgDelistOrderClaim, opts ...grpc.CallOption) (*MsgDelistOrderClaimResponse, error) {
+	out := new(MsgDelistOrderClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/DelistOrderClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BuyOrderClaim(ctx context.Context, in *MsgBuyOrderClaim, opts ...grpc.CallOption) (*MsgBuyOrderClaimResponse, error) {
+	out := new(MsgBuyOrderClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/BuyOrderClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
@@ -950,6 +1294,9 @@ type MsgServer interface {
 	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
 	CreateOnDemandLP(context.Context, *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error)
 	DeleteOnDemandLP(context.Context, *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error)
+	ListOrderClaim(context.Context, *MsgListOrderClaim) (*MsgListOrderClaimResponse, error)
+	DelistOrderClaim(context.Context, *MsgDelistOrderClaim) (*MsgDelistOrderClaimResponse, error)
+	BuyOrderClaim(context.Context, *MsgBuyOrderClaim) (*MsgBuyOrderClaimResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -977,6 +1324,15 @@ func (*UnimplementedMsgServer) CreateOnDemandLP(ctx context.Context, req *MsgCre
 func (*UnimplementedMsgServer) DeleteOnDemandLP(ctx context.Context, req *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnDemandLP not implemented")
 }
+func (*UnimplementedMsgServer) ListOrderClaim(ctx context.Context, req *MsgListOrderClaim) (*MsgListOrderClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrderClaim not implemented")
+}
+func (*UnimplementedMsgServer) DelistOrderClaim(ctx context.Context, req *MsgDelistOrderClaim) (*MsgDelistOrderClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistOrderClaim not implemented")
+}
+func (*UnimplementedMsgServer) BuyOrderClaim(ctx context.Context, req *MsgBuyOrderClaim) (*MsgBuyOrderClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyOrderClaim not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1108,6 +1464,60 @@ func _Msg_DeleteOnDemandLP_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ListOrderClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgListOrderClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ListOrderClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/ListOrderClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ListOrderClaim(ctx, req.(*MsgListOrderClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelistOrderClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistOrderClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelistOrderClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/DelistOrderClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistOrderClaim(ctx, req.(*MsgDelistOrderClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BuyOrderClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuyOrderClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BuyOrderClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/BuyOrderClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BuyOrderClaim(ctx, req.(*MsgBuyOrderClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.eibc.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1140,6 +1550,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteOnDemandLP",
 			Handler:    _Msg_DeleteOnDemandLP_Handler,
 		},
+		{
+			MethodName: "ListOrderClaim",
+			Handler:    _Msg_ListOrderClaim_Handler,
+		},
+		{
+			MethodName: "DelistOrderClaim",
+			Handler:    _Msg_DelistOrderClaim_Handler,
+		},
+		{
+			MethodName: "BuyOrderClaim",
+			Handler:    _Msg_BuyOrderClaim_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
@@ -1228,6 +1650,13 @@ func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.ExpectedFee) > 0 {
 		i -= len(m.ExpectedFee)
 		copy(dAtA[i:], m.ExpectedFee)
@@ -1673,17 +2102,217 @@ func (m *MsgDeleteOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
+func (m *MsgListOrderClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgListOrderClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgListOrderClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgListOrderClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgListOrderClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgListOrderClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistOrderClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistOrderClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistOrderClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistOrderClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistOrderClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistOrderClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyOrderClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyOrderClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyOrderClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBuyOrderClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBuyOrderClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBuyOrderClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
 func (m *MsgUpdateParams) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1726,6 +2355,10 @@ func (m *MsgFulfillOrder) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1906,6 +2539,88 @@ func (m *MsgDeleteOnDemandLPResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgListOrderClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgListOrderClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelistOrderClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelistOrderClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBuyOrderClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBuyOrderClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2202,11 +2917,43 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			}
 			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
@@ -3429,6 +4176,564 @@ func (m *MsgDeleteOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgListOrderClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgListOrderClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgListOrderClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgListOrderClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgListOrderClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgListOrderClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelistOrderClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelistOrderClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelistOrderClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelistOrderClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelistOrderClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelistOrderClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyOrderClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyOrderClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyOrderClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBuyOrderClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBuyOrderClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBuyOrderClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
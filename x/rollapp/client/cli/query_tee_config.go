@@ -37,6 +37,11 @@ func CmdQueryTeeConfig() *cobra.Command {
 
 			fmt.Printf("Enabled: %v\n", teeConfig.Enabled)
 			fmt.Printf("Verify:  %v\n", teeConfig.Verify)
+			fmt.Printf("Type:    %s\n", teeConfig.TeeType)
+			fmt.Printf("Max age: %s\n", teeConfig.MaxAttestationAge)
+			if teeConfig.PolicyId != "" {
+				fmt.Printf("Policy:  %s (registered in x/agent; the fields below are unused)\n", teeConfig.PolicyId)
+			}
 			fmt.Println()
 
 			if teeConfig.PolicyValues != "" {
@@ -67,8 +72,8 @@ func CmdQueryTeeConfig() *cobra.Command {
 			}
 
 			if teeConfig.GcpRootCertPem != "" {
-				fmt.Println("GCP Root Certificate:")
-				fmt.Println("--------------------")
+				fmt.Println("Root Certificate:")
+				fmt.Println("----------------")
 				certLines := strings.Split(teeConfig.GcpRootCertPem, "\n")
 				if len(certLines) > 0 {
 					fmt.Println(certLines[0])
@@ -26,6 +26,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateApp())
 	cmd.AddCommand(CmdRemoveApp())
 	cmd.AddCommand(CmdToggleTEE())
+	cmd.AddCommand(CmdUpdateProposerSelection())
 
 	return cmd
 }
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+const FlagRotationPeriod = "rotation-period"
+
+func CmdUpdateProposerSelection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-proposer-selection [rollapp-id] [strategy] [priority-list]",
+		Short: "Set how the proposers of a rollapp are chosen",
+		Long: "Set how the proposers of a rollapp are chosen. Strategy is one of MostStake, WeightedRandom, RoundRobin or PriorityList. " +
+			"The priority list is a comma separated list of sequencer addresses, only used with PriorityList. " +
+			"With a rotation period, the proposer is rotated out after serving that long.",
+		Example: "dymd tx rollapp update-proposer-selection ROLLAPP_CHAIN_ID PriorityList dym1...,dym1... --rotation-period 24h",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			strategy, ok := types.ProposerSelection_Strategy_value[args[1]]
+			if !ok {
+				return fmt.Errorf("unknown strategy: %s", args[1])
+			}
+			selection := types.ProposerSelection{Strategy: types.ProposerSelection_Strategy(strategy)}
+			if len(args) > 2 {
+				selection.PriorityList = strings.Split(args[2], ",")
+			}
+			selection.RotationPeriod, err = cmd.Flags().GetDuration(FlagRotationPeriod)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateProposerSelection(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				selection,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Duration(FlagRotationPeriod, 0, "How long a proposer serves before it is rotated out, 0 to disable")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
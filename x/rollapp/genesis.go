@@ -58,6 +58,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+	if err := k.SetConsumedAttestations(ctx, genState.ConsumedAttestations); err != nil {
+		panic(err)
+	}
 
 	k.SetParams(ctx, genState.Params)
 }
@@ -108,5 +111,10 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.ObsoleteDrsVersions = drsVersions
 
+	genesis.ConsumedAttestations, err = k.GetAllConsumedAttestations(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return genesis
 }
@@ -2,12 +2,14 @@ package rollapp_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
 	"github.com/dymensionxyz/dymension/v3/testutil/nullify"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
@@ -101,6 +103,12 @@ func TestInitExportGenesis(t *testing.T) {
 				HubHeight: 44,
 			},
 		},
+		ConsumedAttestations: []tee.ConsumedAttestation{
+			{
+				Digest: tee.SignedDigest([]byte("token")),
+				Expiry: time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC),
+			},
+		},
 	}
 
 	k, ctx := keepertest.RollappKeeper(t)
@@ -116,4 +124,5 @@ func TestInitExportGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.LatestStateInfoIndexList, got.LatestStateInfoIndexList)
 	require.ElementsMatch(t, genesisState.BlockHeightToFinalizationQueueList, got.BlockHeightToFinalizationQueueList)
 	require.ElementsMatch(t, genesisState.AppList, got.AppList)
+	require.Equal(t, genesisState.ConsumedAttestations, got.ConsumedAttestations)
 }
@@ -8,6 +8,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	"github.com/cosmos/ibc-go/v8/modules/core/exported"
 
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
@@ -33,6 +34,12 @@ type CanonicalLightClientKeeper interface {
 	GetRollappForClientID(ctx sdk.Context, clientID string) (string, bool)
 }
 
+// PolicyRegistry resolves TEE policies registered in x/agent by id.
+type PolicyRegistry interface {
+	ResolvePolicy(ctx sdk.Context, id string) (tee.Policy, error)
+	RegisteredPolicyAdmin(ctx sdk.Context, id string) (string, error)
+}
+
 type TransferKeeper interface {
 	GetDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes) (transfertypes.DenomTrace, bool)
 }
@@ -12,6 +12,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 )
 
@@ -37,6 +38,7 @@ type Keeper struct {
 	SequencerK            SequencerKeeper
 	bankKeeper            BankKeeper
 	transferKeeper        TransferKeeper
+	policyRegistry        PolicyRegistry
 
 	obsoleteDRSVersions     collections.KeySet[uint32]
 	registeredRollappDenoms collections.KeySet[collections.Pair[string, string]] // [ rollappID, denom ]
@@ -48,6 +50,8 @@ type Keeper struct {
 
 	finalizePending        func(ctx sdk.Context, stateInfoIndex types.StateInfoIndex) error
 	seqToUnfinalizedHeight collections.KeySet[collections.Pair[string, uint64]]
+	// replay remembers consumed TEE attestation tokens until they expire.
+	replay tee.ReplayGuard
 }
 
 func NewKeeper(
@@ -111,6 +115,7 @@ func NewKeeper(
 			"seq_to_unfinalized_height",
 			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
 		),
+		replay: tee.NewReplayGuard(sb, types.ConsumedAttestationsKeyPrefix, types.ConsumedAttestationsByExpiryKeyPrefix),
 	}
 	k.SetFinalizePendingFn(k.finalizePendingState)
 	return k
@@ -136,6 +141,12 @@ func (k *Keeper) SetTransferKeeper(transferKeeper TransferKeeper) {
 	k.transferKeeper = transferKeeper
 }
 
+// SetPolicyRegistry sets the registry TEE configs referencing a policy by id
+// resolve through.
+func (k *Keeper) SetPolicyRegistry(r PolicyRegistry) {
+	k.policyRegistry = r
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                    Hooks                                   */
 /* -------------------------------------------------------------------------- */
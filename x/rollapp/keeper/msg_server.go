@@ -35,6 +35,11 @@ func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	if err != nil {
 		return nil, err
 	}
+	if id := req.Params.TeeConfig.PolicyId; id != "" {
+		if err := m.validateTeePolicyID(ctx, id); err != nil {
+			return nil, errorsmod.Wrap(err, "tee config")
+		}
+	}
 
 	m.SetParams(ctx, req.Params)
 
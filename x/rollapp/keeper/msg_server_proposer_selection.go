@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// UpdateProposerSelection sets how the proposers of the rollapp are chosen. It
+// applies from the next proposer change.
+func (k msgServer) UpdateProposerSelection(goCtx context.Context, msg *types.MsgUpdateProposerSelection) (*types.MsgUpdateProposerSelectionResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+
+	if rollapp.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	rollapp.ProposerSelection = msg.ProposerSelection
+	k.SetRollapp(ctx, rollapp)
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUpdateProposerSelection{
+		RollappId:         rollapp.RollappId,
+		ProposerSelection: rollapp.ProposerSelection,
+	}); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgUpdateProposerSelectionResponse{}, nil
+}
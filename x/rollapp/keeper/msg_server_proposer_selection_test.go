@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestUpdateProposerSelection() {
+	const rollappId = "rollapp_1234-1"
+
+	priority := types.ProposerSelection{
+		Strategy:     types.ProposerSelection_PriorityList,
+		PriorityList: []string{bob, alice},
+	}
+
+	tests := []struct {
+		name     string
+		request  *types.MsgUpdateProposerSelection
+		expError error
+	}{
+		{
+			name:    "success",
+			request: types.NewMsgUpdateProposerSelection(alice, rollappId, priority),
+		}, {
+			name:    "success: back to default",
+			request: types.NewMsgUpdateProposerSelection(alice, rollappId, types.ProposerSelection{}),
+		}, {
+			name:     "rollapp not found",
+			request:  types.NewMsgUpdateProposerSelection(alice, "rollapp_1235-2", priority),
+			expError: types.ErrUnknownRollappID,
+		}, {
+			name:     "unauthorized signer",
+			request:  types.NewMsgUpdateProposerSelection(bob, rollappId, priority),
+			expError: types.ErrUnauthorizedSigner,
+		}, {
+			name: "priority list without priority strategy",
+			request: types.NewMsgUpdateProposerSelection(alice, rollappId, types.ProposerSelection{
+				Strategy:     types.ProposerSelection_RoundRobin,
+				PriorityList: []string{bob},
+			}),
+			expError: types.ErrInvalidRequest,
+		}, {
+			name: "duplicate in priority list",
+			request: types.NewMsgUpdateProposerSelection(alice, rollappId, types.ProposerSelection{
+				Strategy:     types.ProposerSelection_PriorityList,
+				PriorityList: []string{bob, bob},
+			}),
+			expError: types.ErrInvalidRequest,
+		}, {
+			name: "unknown strategy",
+			request: types.NewMsgUpdateProposerSelection(alice, rollappId, types.ProposerSelection{
+				Strategy: 42,
+			}),
+			expError: types.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range tests {
+		s.Run(tc.name, func() {
+			s.k().SetRollapp(s.Ctx, types.Rollapp{
+				RollappId:   rollappId,
+				Owner:       alice,
+				GenesisInfo: *mockGenesisInfo,
+			})
+
+			_, err := s.msgServer.UpdateProposerSelection(s.Ctx, tc.request)
+			if tc.expError != nil {
+				s.ErrorIs(err, tc.expError)
+				return
+			}
+			s.Require().NoError(err)
+			rollapp := s.k().MustGetRollapp(s.Ctx, rollappId)
+			s.Equal(tc.request.ProposerSelection, rollapp.ProposerSelection)
+		})
+	}
+}
@@ -1,11 +1,75 @@
 package keeper
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
 	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 )
 
+// ValidateAttestation verifies token against the TEE policy over nonce and
+// consumes it, rejecting tokens older than the configured max age or already
+// consumed.
 func (k Keeper) ValidateAttestation(ctx sdk.Context, nonce, token string) error {
-	policy := k.GetParams(ctx).TeeConfig.Policy()
-	return tee.NewVerifier().Verify(ctx, policy, nonce, token)
+	policy, err := k.teePolicy(ctx)
+	if err != nil {
+		return err
+	}
+	att, err := tee.NewVerifier().Verify(ctx, policy, nonce, token)
+	if err != nil {
+		return err
+	}
+	return k.replay.Consume(ctx, att, k.GetParams(ctx).TeeConfig.MaxAttestationAge)
+}
+
+// PruneConsumedAttestations forgets up to limit consumed attestations old
+// enough to fail the max attestation age check on their own.
+func (k Keeper) PruneConsumedAttestations(ctx sdk.Context, limit uint64) error {
+	_, err := k.replay.Prune(ctx, limit)
+	return errorsmod.Wrap(err, "prune consumed attestations")
+}
+
+// teePolicy returns the policy attestations are verified against: the head of
+// the registered lineage the TEE config references, else the embedded policy.
+func (k Keeper) teePolicy(ctx sdk.Context) (tee.Policy, error) {
+	conf := k.GetParams(ctx).TeeConfig
+	if conf.PolicyId == "" {
+		return conf.Policy(), nil
+	}
+	if err := k.validateTeePolicyID(ctx, conf.PolicyId); err != nil {
+		return tee.Policy{}, err
+	}
+	policy, err := k.policyRegistry.ResolvePolicy(ctx, conf.PolicyId)
+	if err != nil {
+		return tee.Policy{}, errorsmod.Wrap(err, "resolve registered policy")
+	}
+	return policy, nil
+}
+
+// validateTeePolicyID checks the registered lineage is administered by
+// governance: its admin picks the policy fast finalization trusts, which is
+// for governance alone to do.
+func (k Keeper) validateTeePolicyID(ctx sdk.Context, id string) error {
+	if k.policyRegistry == nil {
+		return gerrc.ErrFailedPrecondition.Wrap("no policy registry")
+	}
+	admin, err := k.policyRegistry.RegisteredPolicyAdmin(ctx, id)
+	if err != nil {
+		return errorsmod.Wrap(err, "registered policy admin")
+	}
+	if admin != k.authority {
+		return gerrc.ErrPermissionDenied.Wrapf("registered policy %s is not administered by governance", id)
+	}
+	return nil
+}
+
+// GetAllConsumedAttestations returns every remembered consumed attestation.
+func (k Keeper) GetAllConsumedAttestations(ctx sdk.Context) ([]tee.ConsumedAttestation, error) {
+	return k.replay.Export(ctx)
+}
+
+// SetConsumedAttestations restores consumed attestations from genesis.
+func (k Keeper) SetConsumedAttestations(ctx sdk.Context, consumed []tee.ConsumedAttestation) error {
+	return k.replay.Import(ctx, consumed)
 }
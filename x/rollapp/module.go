@@ -163,10 +163,14 @@ func (am AppModule) GetHooks() []types.RollappHooks {
 }
 
 // EndBlock finalizes states from rollapps (after dispute period) and corresponding packets. It slashes and jails
-// sequencers of inactive rollapps.
+// sequencers of inactive rollapps, and prunes expired consumed TEE attestations.
 func (am AppModule) EndBlock(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	am.keeper.FinalizeRollappStates(ctx)
 	am.keeper.CheckLiveness(ctx)
-	return nil
+	return am.keeper.PruneConsumedAttestations(ctx, consumedAttestationPruneLimit)
 }
+
+// consumedAttestationPruneLimit bounds the consumed attestations forgotten per
+// block; fast finalization consumes at most one per tx.
+const consumedAttestationPruneLimit = 100
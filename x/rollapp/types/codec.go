@@ -22,6 +22,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgForceGenesisInfoChange{}, "rollapp/ForceGenesisInfoChange", nil)
 	cdc.RegisterConcrete(&GenesisInfo{}, "rollapp/GenesisInfo", nil)
 	cdc.RegisterConcrete(&MsgToggleTEE{}, "rollapp/ToggleTEE", nil)
+	cdc.RegisterConcrete(&MsgUpdateProposerSelection{}, "rollapp/UpdateProposerSelection", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -38,6 +39,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgForceGenesisInfoChange{},
 		&MsgUpdateParams{},
 		&MsgToggleTEE{},
+		&MsgUpdateProposerSelection{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -208,11 +209,64 @@ func (m *EventMarkObsoleteRollapps) GetDrsVersions() []uint32 {
 	return nil
 }
 
+type EventUpdateProposerSelection struct {
+	RollappId         string            `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	ProposerSelection ProposerSelection `protobuf:"bytes,2,opt,name=proposer_selection,json=proposerSelection,proto3" json:"proposer_selection"`
+}
+
+func (m *EventUpdateProposerSelection) Reset()         { *m = EventUpdateProposerSelection{} }
+func (m *EventUpdateProposerSelection) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateProposerSelection) ProtoMessage()    {}
+func (*EventUpdateProposerSelection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{4}
+}
+func (m *EventUpdateProposerSelection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateProposerSelection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateProposerSelection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateProposerSelection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateProposerSelection.Merge(m, src)
+}
+func (m *EventUpdateProposerSelection) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateProposerSelection) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateProposerSelection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateProposerSelection proto.InternalMessageInfo
+
+func (m *EventUpdateProposerSelection) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventUpdateProposerSelection) GetProposerSelection() ProposerSelection {
+	if m != nil {
+		return m.ProposerSelection
+	}
+	return ProposerSelection{}
+}
+
 func init() {
 	proto.RegisterType((*EventAppAdded)(nil), "dymensionxyz.dymension.rollapp.EventAppAdded")
 	proto.RegisterType((*EventAppUpdated)(nil), "dymensionxyz.dymension.rollapp.EventAppUpdated")
 	proto.RegisterType((*EventAppRemoved)(nil), "dymensionxyz.dymension.rollapp.EventAppRemoved")
 	proto.RegisterType((*EventMarkObsoleteRollapps)(nil), "dymensionxyz.dymension.rollapp.EventMarkObsoleteRollapps")
+	proto.RegisterType((*EventUpdateProposerSelection)(nil), "dymensionxyz.dymension.rollapp.EventUpdateProposerSelection")
 }
 
 func init() {
@@ -220,25 +274,30 @@ func init() {
 }
 
 var fileDescriptor_e0f74405c12dec3c = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x53, 0xcb, 0x52, 0xf3, 0x4a, 0x8a, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xe4, 0x90, 0x15, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xc5, 0x52, 0x1a, 0x04, 0x0c, 0x4b, 0x2c,
-	0x28, 0x80, 0x98, 0xa4, 0xe4, 0xc6, 0xc5, 0xeb, 0x0a, 0x32, 0xd9, 0xb1, 0xa0, 0xc0, 0x31, 0x25,
-	0x25, 0x35, 0x45, 0xc8, 0x94, 0x8b, 0x39, 0xb1, 0xa0, 0x40, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x59, 0x0f, 0xbf, 0x45, 0x7a, 0x8e, 0x05, 0x05, 0x41, 0x20, 0xf5, 0x4a, 0x1e, 0x5c, 0xfc,
-	0x30, 0x73, 0x42, 0x0b, 0x52, 0x12, 0x4b, 0xa8, 0x62, 0x52, 0x50, 0x6a, 0x6e, 0x7e, 0x19, 0xf9,
-	0x26, 0x15, 0x70, 0x49, 0x82, 0x4d, 0xf2, 0x4d, 0x2c, 0xca, 0xf6, 0x4f, 0x2a, 0xce, 0xcf, 0x49,
-	0x2d, 0x49, 0x0d, 0x82, 0x28, 0x2a, 0x16, 0x32, 0xe0, 0x12, 0xc9, 0x87, 0x8a, 0xc5, 0x43, 0x75,
-	0xc6, 0xe7, 0x95, 0xe6, 0x82, 0x2d, 0x61, 0x09, 0x12, 0xca, 0x47, 0x55, 0xef, 0x57, 0x9a, 0x2b,
-	0xa4, 0xc8, 0xc5, 0x93, 0x52, 0x54, 0x1c, 0x5f, 0x96, 0x5a, 0x04, 0xb2, 0xae, 0x58, 0x82, 0x49,
-	0x81, 0x59, 0x83, 0x37, 0x88, 0x3b, 0xa5, 0xa8, 0x38, 0x0c, 0x2a, 0xe4, 0xe4, 0x77, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x26, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0x22, 0xa7, 0xcc, 0x58, 0xbf, 0x02, 0x1e, 0x43, 0x25, 0x95, 0x05,
-	0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x48, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x93, 0xbd,
-	0x81, 0x1d, 0x02, 0x00, 0x00,
+	// 367 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x5b, 0x20, 0x37, 0x61, 0xb8, 0xe4, 0xe6, 0x4e, 0x58, 0x70, 0xc9, 0xb5, 0x22, 0x6e,
+	0x48, 0x34, 0xad, 0x8a, 0x3e, 0x00, 0x26, 0x1a, 0x5d, 0x88, 0xa6, 0x46, 0x17, 0x6e, 0x9a, 0xc2,
+	0x8c, 0xd8, 0xd8, 0xf6, 0x9c, 0xcc, 0x94, 0x06, 0x7c, 0x0a, 0x5f, 0xc2, 0x77, 0x61, 0xc9, 0xd2,
+	0x95, 0x31, 0xf0, 0x22, 0xa6, 0xed, 0x40, 0x34, 0x44, 0x49, 0x8c, 0xab, 0xce, 0xfc, 0xfd, 0xce,
+	0xff, 0x9f, 0x33, 0x39, 0x64, 0x8b, 0x8d, 0x02, 0x1e, 0x4a, 0x0f, 0xc2, 0xe1, 0xe8, 0xc1, 0x5a,
+	0x5c, 0x2c, 0x01, 0xbe, 0xef, 0x22, 0x5a, 0x3c, 0xe6, 0x61, 0x24, 0x4d, 0x14, 0x10, 0x01, 0x35,
+	0xde, 0xc3, 0xe6, 0xe2, 0x62, 0x2a, 0xb8, 0xd6, 0x5c, 0x61, 0xe6, 0x22, 0x66, 0x4e, 0xb5, 0xed,
+	0x15, 0xa4, 0xfa, 0x2a, 0xba, 0xd2, 0x87, 0x3e, 0xa4, 0x47, 0x2b, 0x39, 0x65, 0x6a, 0xe3, 0x98,
+	0x94, 0x8f, 0x92, 0xee, 0xda, 0x88, 0x6d, 0xc6, 0x38, 0xa3, 0x07, 0x24, 0xef, 0x22, 0x56, 0xf5,
+	0xba, 0xde, 0x2c, 0xed, 0x6d, 0x9a, 0x5f, 0x37, 0x6b, 0xb6, 0x11, 0xed, 0x84, 0x6f, 0x9c, 0x90,
+	0x3f, 0x73, 0x9f, 0x2b, 0x64, 0x6e, 0xf4, 0x23, 0x4e, 0x36, 0x0f, 0x20, 0xfe, 0xbe, 0x13, 0x92,
+	0x7f, 0xa9, 0xd3, 0x99, 0x2b, 0xee, 0xcf, 0xbb, 0x12, 0x7c, 0x1e, 0x71, 0x3b, 0x83, 0x24, 0xdd,
+	0x21, 0x15, 0x50, 0x9a, 0xa3, 0x2a, 0x9d, 0x70, 0x10, 0xa4, 0x21, 0x05, 0x9b, 0xc2, 0x47, 0xbe,
+	0x33, 0x08, 0xe8, 0x06, 0xf9, 0xcd, 0x84, 0x74, 0x62, 0x2e, 0x92, 0x38, 0x59, 0xcd, 0xd5, 0xf3,
+	0xcd, 0xb2, 0x5d, 0x62, 0x42, 0x5e, 0x2b, 0xa9, 0xf1, 0xa4, 0x93, 0xff, 0x69, 0x64, 0xf6, 0x06,
+	0x17, 0x02, 0x10, 0x24, 0x17, 0x97, 0xdc, 0xe7, 0xbd, 0xc8, 0x83, 0x90, 0xae, 0x11, 0x32, 0x0f,
+	0xf3, 0x58, 0x9a, 0x55, 0xb4, 0x8b, 0x4a, 0x39, 0x65, 0xf4, 0x96, 0x50, 0x54, 0x35, 0x8e, 0x9c,
+	0x17, 0x55, 0x73, 0xe9, 0xdc, 0xbb, 0xab, 0xe6, 0x5e, 0x4a, 0x3b, 0x2c, 0x8c, 0x5f, 0xd6, 0x35,
+	0xfb, 0x2f, 0x2e, 0xfd, 0xe8, 0x8c, 0xa7, 0x86, 0x3e, 0x99, 0x1a, 0xfa, 0xeb, 0xd4, 0xd0, 0x1f,
+	0x67, 0x86, 0x36, 0x99, 0x19, 0xda, 0xf3, 0xcc, 0xd0, 0x6e, 0xf6, 0xfb, 0x5e, 0x74, 0x37, 0xe8,
+	0x9a, 0x3d, 0x08, 0xac, 0x4f, 0xd6, 0x2b, 0x6e, 0x59, 0xc3, 0xc5, 0x8e, 0x45, 0x23, 0xe4, 0xb2,
+	0xfb, 0x2b, 0x5d, 0xa6, 0xd6, 0xdb, 0x00, 0x29, 0x30, 0x23, 0xf7, 0x09, 0x03, 0x00, 0x00,
 }
 
 func (m *EventAppAdded) Marshal() (dAtA []byte, err error) {
@@ -392,6 +451,46 @@ func (m *EventMarkObsoleteRollapps) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventUpdateProposerSelection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateProposerSelection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateProposerSelection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProposerSelection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -461,6 +560,21 @@ func (m *EventMarkObsoleteRollapps) Size() (n int) {
 	return n
 }
 
+func (m *EventUpdateProposerSelection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.ProposerSelection.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -870,6 +984,121 @@ func (m *EventMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventUpdateProposerSelection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateProposerSelection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateProposerSelection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposerSelection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
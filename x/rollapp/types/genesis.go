@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	fmt "fmt"
+
+	"github.com/dymensionxyz/dymension/v3/x/common/tee"
 )
 
 // DefaultIndex is the default capability global index
@@ -153,5 +155,9 @@ func (gs GenesisState) Validate() error {
 		obsoleteDRSVersionIndexMap[elem] = struct{}{}
 	}
 
+	if err := tee.ValidateConsumedAttestations(gs.ConsumedAttestations); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
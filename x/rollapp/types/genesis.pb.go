@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	tee "github.com/dymensionxyz/dymension/v3/x/common/tee"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -38,6 +39,9 @@ type GenesisState struct {
 	SequencerHeightPairs []SequencerHeightPair     `protobuf:"bytes,10,rep,name=sequencerHeightPairs,proto3" json:"sequencerHeightPairs"`
 	// ObsoleteDrsVersions is a list of DRS versions that are marked obsolete
 	ObsoleteDrsVersions []uint32 `protobuf:"varint,11,rep,packed,name=obsolete_drs_versions,json=obsoleteDrsVersions,proto3" json:"obsolete_drs_versions,omitempty"`
+	// consumed_attestations are the TEE attestation tokens accepted for fast
+	// finalization and not yet expired.
+	ConsumedAttestations []tee.ConsumedAttestation `protobuf:"bytes,12,rep,name=consumed_attestations,json=consumedAttestations,proto3" json:"consumed_attestations"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +154,13 @@ func (m *GenesisState) GetObsoleteDrsVersions() []uint32 {
 	return nil
 }
 
+func (m *GenesisState) GetConsumedAttestations() []tee.ConsumedAttestation {
+	if m != nil {
+		return m.ConsumedAttestations
+	}
+	return nil
+}
+
 type SequencerHeightPair struct {
 	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
 	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -265,44 +276,47 @@ func init() {
 }
 
 var fileDescriptor_b76890aebc09aa04 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xb6, 0x5f, 0xda, 0x4c, 0xbe, 0x22, 0x34, 0x2d, 0x60, 0x45, 0xd4, 0x44, 0x41,
-	0x82, 0x20, 0xa8, 0x2d, 0x25, 0x48, 0xec, 0x90, 0x08, 0xe1, 0x4f, 0x44, 0x05, 0xc1, 0x05, 0x16,
-	0xb0, 0x88, 0x9c, 0xf8, 0xd6, 0x19, 0xe1, 0x78, 0x8c, 0x67, 0x12, 0x25, 0x79, 0x0a, 0x16, 0xbc,
-	0x08, 0x6f, 0xd1, 0x65, 0x97, 0xac, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0x3c, 0x36, 0xa1, 0x6d, 0x32,
-	0x91, 0x58, 0x39, 0xe3, 0x39, 0xf7, 0x77, 0x8e, 0x6f, 0xae, 0x2e, 0x7a, 0xe0, 0x4e, 0x06, 0x10,
-	0x30, 0x42, 0x83, 0xf1, 0x64, 0x6a, 0x65, 0x07, 0x2b, 0xa2, 0xbe, 0xef, 0x84, 0xa1, 0xe5, 0x41,
-	0x00, 0x8c, 0x30, 0x33, 0x8c, 0x28, 0xa7, 0xd8, 0x58, 0x54, 0x9b, 0xd9, 0xc1, 0x94, 0xea, 0xd2,
-	0xbe, 0x47, 0x3d, 0x2a, 0xa4, 0x56, 0xfc, 0x2b, 0xa9, 0x2a, 0xdd, 0x57, 0x78, 0x84, 0x4e, 0xe4,
-	0x0c, 0xa4, 0x45, 0x49, 0x15, 0x48, 0x3e, 0xa5, 0xda, 0x52, 0xa8, 0x19, 0x77, 0x38, 0x74, 0x48,
-	0x70, 0x92, 0x66, 0x39, 0x54, 0x14, 0xf8, 0x64, 0x14, 0x7f, 0x71, 0x9a, 0xa6, 0xaa, 0x90, 0x67,
-	0x49, 0x2a, 0xdf, 0x77, 0xd0, 0xff, 0x2f, 0x92, 0x66, 0x1d, 0xc7, 0xa6, 0xb8, 0x89, 0xf2, 0xc9,
-	0x87, 0xe9, 0x5a, 0x59, 0xab, 0x16, 0x6b, 0x77, 0xcc, 0xd5, 0xcd, 0x33, 0xdb, 0x42, 0xdd, 0xd8,
-	0x3a, 0xfd, 0x79, 0x2b, 0x67, 0xcb, 0x5a, 0xfc, 0x06, 0x15, 0xe5, 0xfd, 0x11, 0x61, 0x5c, 0xdf,
-	0x28, 0x6f, 0x56, 0x8b, 0xb5, 0xbb, 0x2a, 0x94, 0x9d, 0x3c, 0x25, 0x6b, 0x91, 0x80, 0xdf, 0xa3,
-	0x5d, 0xd1, 0x94, 0x56, 0x70, 0x42, 0x05, 0x72, 0x53, 0x20, 0xef, 0xa9, 0x90, 0xc7, 0x69, 0x91,
-	0x84, 0xfe, 0x4d, 0xc1, 0x21, 0xd2, 0x7d, 0x87, 0x03, 0xe3, 0x99, 0xae, 0x15, 0xb8, 0x30, 0x16,
-	0x0e, 0x5b, 0xc2, 0xc1, 0x5c, 0xdb, 0x41, 0x54, 0x4a, 0x9b, 0xa5, 0x54, 0x3c, 0x45, 0x07, 0xc9,
-	0xdd, 0x73, 0x12, 0x38, 0x3e, 0x99, 0x82, 0x2b, 0x45, 0xa9, 0xed, 0x7f, 0xff, 0x60, 0xbb, 0x1a,
-	0x8d, 0xbf, 0x69, 0xa8, 0xd2, 0xf5, 0x69, 0xef, 0xf3, 0x4b, 0x20, 0x5e, 0x9f, 0xbf, 0xa3, 0x52,
-	0xe8, 0x70, 0x42, 0x83, 0xb7, 0x43, 0x18, 0x82, 0x48, 0x90, 0x17, 0x09, 0x1e, 0xab, 0x12, 0x34,
-	0x56, 0x92, 0x64, 0xa2, 0x35, 0xfc, 0xf0, 0x27, 0x74, 0x25, 0x9d, 0xdf, 0x67, 0x23, 0x08, 0x38,
-	0xd3, 0xb7, 0x45, 0x82, 0x43, 0x55, 0x82, 0xa3, 0xc5, 0x2a, 0x69, 0x78, 0x0e, 0x85, 0x9f, 0xa2,
-	0xed, 0x74, 0x0a, 0x77, 0x04, 0xf5, 0xb6, 0x8a, 0xfa, 0x24, 0x9b, 0xc0, 0xb4, 0x12, 0x13, 0x74,
-	0x35, 0x02, 0x8f, 0x30, 0x0e, 0x11, 0xb8, 0x4d, 0x08, 0xe8, 0x80, 0xe9, 0x05, 0x41, 0x7b, 0xb4,
-	0xe6, 0x4c, 0xdb, 0xe7, 0xca, 0xa5, 0xc3, 0x05, 0x2c, 0x1e, 0xa0, 0x7d, 0x06, 0x5f, 0x86, 0x10,
-	0xf4, 0x20, 0x4a, 0xda, 0xd6, 0x76, 0x48, 0xc4, 0x74, 0x24, 0xec, 0xea, 0xca, 0xb1, 0xb8, 0x58,
-	0x2b, 0xad, 0x2e, 0xc5, 0xe2, 0x1a, 0xba, 0x46, 0xbb, 0x8c, 0xfa, 0xc0, 0xa1, 0xe3, 0x46, 0xac,
-	0x33, 0x82, 0x28, 0xe6, 0x31, 0xbd, 0x58, 0xde, 0xac, 0xee, 0xda, 0x7b, 0xe9, 0x65, 0x33, 0x62,
-	0x1f, 0xe4, 0x55, 0xe5, 0x15, 0xda, 0xbb, 0xc4, 0x06, 0xdf, 0x44, 0x85, 0xcc, 0x42, 0x2c, 0x8f,
-	0x82, 0xfd, 0xe7, 0x05, 0xbe, 0x8e, 0xf2, 0x7d, 0xa1, 0xd5, 0x37, 0xca, 0x5a, 0x75, 0xcb, 0x96,
-	0xa7, 0x4a, 0x1b, 0xdd, 0x58, 0xd2, 0x22, 0x7c, 0x80, 0x90, 0xfc, 0xac, 0x0e, 0x71, 0x53, 0xa2,
-	0x7c, 0xd3, 0x72, 0x63, 0xa2, 0x9b, 0xfc, 0x15, 0xf1, 0x7a, 0x29, 0xd8, 0xf2, 0xd4, 0x78, 0x7d,
-	0x3a, 0x33, 0xb4, 0xb3, 0x99, 0xa1, 0xfd, 0x9a, 0x19, 0xda, 0xd7, 0xb9, 0x91, 0x3b, 0x9b, 0x1b,
-	0xb9, 0x1f, 0x73, 0x23, 0xf7, 0xf1, 0xa1, 0x47, 0x78, 0x7f, 0xd8, 0x35, 0x7b, 0x74, 0xb0, 0x6c,
-	0x03, 0x8f, 0xea, 0xd6, 0x38, 0x5b, 0x93, 0x7c, 0x12, 0x02, 0xeb, 0xe6, 0xc5, 0xa6, 0xac, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x21, 0x55, 0x13, 0x74, 0x06, 0x00, 0x00,
+	// 632 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xdb, 0x92, 0x92, 0x4d, 0x8b, 0xd0, 0xb6, 0x05, 0xab, 0xa2, 0x26, 0x0a, 0x12, 0x0d,
+	0x82, 0xda, 0x52, 0x8a, 0xc4, 0x0d, 0xa9, 0x3f, 0xfc, 0x54, 0x54, 0x50, 0x5c, 0xe0, 0x00, 0x87,
+	0xc8, 0x89, 0xa7, 0xee, 0x0a, 0x7b, 0xd7, 0x78, 0x37, 0x51, 0x92, 0xa7, 0xe0, 0xc0, 0x03, 0xf0,
+	0x38, 0x3d, 0xf6, 0xc8, 0x09, 0xa1, 0xe4, 0x45, 0x90, 0xd7, 0x6b, 0x27, 0x34, 0x4d, 0x1c, 0x89,
+	0x93, 0xb3, 0x9e, 0xef, 0x6f, 0xc7, 0xa3, 0x09, 0x7a, 0xe2, 0xf6, 0x02, 0xa0, 0x9c, 0x30, 0xda,
+	0xed, 0xf5, 0xad, 0xec, 0x60, 0x45, 0xcc, 0xf7, 0x9d, 0x30, 0xb4, 0x3c, 0xa0, 0xc0, 0x09, 0x37,
+	0xc3, 0x88, 0x09, 0x86, 0x8d, 0x71, 0xb4, 0x99, 0x1d, 0x4c, 0x85, 0xde, 0x5c, 0xf7, 0x98, 0xc7,
+	0x24, 0xd4, 0x8a, 0x7f, 0x25, 0xac, 0xcd, 0xc7, 0x39, 0x1e, 0xa1, 0x13, 0x39, 0x81, 0xb2, 0xd8,
+	0xcc, 0x0b, 0xa4, 0x9e, 0x0a, 0x6d, 0xe5, 0xa0, 0xb9, 0x70, 0x04, 0x34, 0x08, 0x3d, 0x4b, 0xb3,
+	0xec, 0xe4, 0x10, 0x7c, 0xd2, 0x89, 0x6f, 0x9c, 0xa6, 0xa9, 0xe5, 0xc0, 0x47, 0x49, 0xb6, 0xa7,
+	0x20, 0x5b, 0x2c, 0x08, 0x18, 0xb5, 0x04, 0x40, 0x02, 0xac, 0xfe, 0x2c, 0xa1, 0x95, 0x57, 0x49,
+	0x57, 0x4f, 0xe3, 0x74, 0xf8, 0x10, 0x15, 0x93, 0x0e, 0xe8, 0x5a, 0x45, 0xab, 0x95, 0xeb, 0x0f,
+	0xcd, 0xd9, 0x5d, 0x36, 0x4f, 0x24, 0x7a, 0x7f, 0xe9, 0xe2, 0xf7, 0xfd, 0x82, 0xad, 0xb8, 0xf8,
+	0x1d, 0x2a, 0xab, 0xfa, 0x31, 0xe1, 0x42, 0x5f, 0xa8, 0x2c, 0xd6, 0xca, 0xf5, 0xed, 0x3c, 0x29,
+	0x3b, 0x79, 0x2a, 0xad, 0x71, 0x05, 0xfc, 0x11, 0xad, 0xca, 0xee, 0x1d, 0xd1, 0x33, 0x26, 0x25,
+	0x17, 0xa5, 0xe4, 0xa3, 0x3c, 0xc9, 0xd3, 0x94, 0xa4, 0x44, 0xff, 0x55, 0xc1, 0x21, 0xd2, 0x7d,
+	0x47, 0x00, 0x17, 0x19, 0xee, 0x88, 0xba, 0xd0, 0x95, 0x0e, 0x4b, 0xd2, 0xc1, 0x9c, 0xdb, 0x41,
+	0x32, 0x95, 0xcd, 0x54, 0x55, 0xdc, 0x47, 0x5b, 0x49, 0xed, 0x25, 0xa1, 0x8e, 0x4f, 0xfa, 0xe0,
+	0x2a, 0x50, 0x6a, 0x7b, 0xe3, 0x3f, 0x6c, 0x67, 0x4b, 0xe3, 0x1f, 0x1a, 0xaa, 0x36, 0x7d, 0xd6,
+	0xfa, 0xfa, 0x1a, 0x88, 0x77, 0x2e, 0x3e, 0x30, 0x05, 0x74, 0x04, 0x61, 0xf4, 0x7d, 0x1b, 0xda,
+	0x20, 0x13, 0x14, 0x65, 0x82, 0xe7, 0x79, 0x09, 0xf6, 0x67, 0x2a, 0xa9, 0x44, 0x73, 0xf8, 0xe1,
+	0x2f, 0xe8, 0x56, 0x3a, 0xe8, 0x2f, 0x3a, 0x40, 0x05, 0xd7, 0x97, 0x65, 0x82, 0x9d, 0xbc, 0x04,
+	0xc7, 0xe3, 0x2c, 0x65, 0x78, 0x45, 0x0a, 0x1f, 0xa0, 0xe5, 0x74, 0x0a, 0x6f, 0x4a, 0xd5, 0x07,
+	0x79, 0xaa, 0x7b, 0xd9, 0x04, 0xa6, 0x4c, 0x4c, 0xd0, 0xed, 0x08, 0x3c, 0xc2, 0x05, 0x44, 0xe0,
+	0x1e, 0x02, 0x65, 0x01, 0xd7, 0x4b, 0x52, 0xed, 0xd9, 0x9c, 0x33, 0x6d, 0x5f, 0xa1, 0x2b, 0x87,
+	0x09, 0x59, 0x1c, 0xa0, 0x75, 0x0e, 0xdf, 0xda, 0x40, 0x5b, 0x10, 0x25, 0x6d, 0x3b, 0x71, 0x48,
+	0xc4, 0x75, 0x24, 0xed, 0x76, 0x73, 0xc7, 0x62, 0x92, 0xab, 0xac, 0xae, 0x95, 0xc5, 0x75, 0xb4,
+	0xc1, 0x9a, 0x9c, 0xf9, 0x20, 0xa0, 0xe1, 0x46, 0xbc, 0xd1, 0x81, 0x28, 0xd6, 0xe3, 0x7a, 0xb9,
+	0xb2, 0x58, 0x5b, 0xb5, 0xd7, 0xd2, 0xe2, 0x61, 0xc4, 0x3f, 0xa9, 0x12, 0x0e, 0xd0, 0x46, 0x8b,
+	0x51, 0xde, 0x0e, 0xc0, 0x6d, 0x38, 0x22, 0x1e, 0x38, 0xf9, 0x41, 0xb9, 0xbe, 0x22, 0x33, 0xd6,
+	0xa7, 0x65, 0x4c, 0x96, 0x8f, 0x79, 0xa0, 0xb8, 0x7b, 0x23, 0x6a, 0x1a, 0xb1, 0x35, 0x59, 0xe2,
+	0xd5, 0x37, 0x68, 0xed, 0x9a, 0x5b, 0xe1, 0x7b, 0xa8, 0x94, 0xdd, 0x48, 0xee, 0xaa, 0x92, 0x3d,
+	0x7a, 0x81, 0xef, 0xa0, 0xe2, 0xb9, 0xc4, 0xea, 0x0b, 0x15, 0xad, 0xb6, 0x64, 0xab, 0x53, 0xf5,
+	0x04, 0xdd, 0x9d, 0xf2, 0x45, 0xf0, 0x16, 0x42, 0xaa, 0x8b, 0x0d, 0xe2, 0xa6, 0x8a, 0xea, 0xcd,
+	0x91, 0x1b, 0x2b, 0xba, 0xc9, 0x97, 0x8f, 0xb7, 0x59, 0xc9, 0x56, 0xa7, 0xfd, 0xb7, 0x17, 0x03,
+	0x43, 0xbb, 0x1c, 0x18, 0xda, 0x9f, 0x81, 0xa1, 0x7d, 0x1f, 0x1a, 0x85, 0xcb, 0xa1, 0x51, 0xf8,
+	0x35, 0x34, 0x0a, 0x9f, 0x9f, 0x7a, 0x44, 0x9c, 0xb7, 0x9b, 0xf1, 0xbd, 0xa7, 0xfd, 0x33, 0x74,
+	0x76, 0xad, 0x6e, 0xb6, 0xbe, 0x45, 0x2f, 0x04, 0xde, 0x2c, 0xca, 0xc5, 0xbc, 0xfb, 0x77, 0x00,
+	0x0b, 0xf8, 0x4d, 0xb9, 0x0c, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -325,6 +339,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumedAttestations) > 0 {
+		for iNdEx := len(m.ConsumedAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsumedAttestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ObsoleteDrsVersions) > 0 {
 		dAtA2 := make([]byte, len(m.ObsoleteDrsVersions)*10)
 		var j1 int
@@ -636,6 +664,12 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	if len(m.ConsumedAttestations) > 0 {
+		for _, e := range m.ConsumedAttestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1124,6 +1158,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObsoleteDrsVersions", wireType)
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumedAttestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumedAttestations = append(m.ConsumedAttestations, tee.ConsumedAttestation{})
+			if err := m.ConsumedAttestations[len(m.ConsumedAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
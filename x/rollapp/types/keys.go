@@ -33,3 +33,10 @@ const (
 )
 
 var SeqToUnfinalizedHeightKeyPrefix = collections.NewPrefix("seqToFinalizeHeight/")
+
+// ConsumedAttestationsKeyPrefix holds the digests of consumed TEE attestation
+// tokens and ConsumedAttestationsByExpiryKeyPrefix indexes them by expiry.
+var (
+	ConsumedAttestationsKeyPrefix         = collections.NewPrefix("consumedAttestations/")
+	ConsumedAttestationsByExpiryKeyPrefix = collections.NewPrefix("consumedAttestationsByExpiry/")
+)
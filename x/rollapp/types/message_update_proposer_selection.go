@@ -0,0 +1,28 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgUpdateProposerSelection{}
+
+func NewMsgUpdateProposerSelection(
+	owner,
+	rollappId string,
+	selection ProposerSelection,
+) *MsgUpdateProposerSelection {
+	return &MsgUpdateProposerSelection{
+		Owner:             owner,
+		RollappId:         rollappId,
+		ProposerSelection: selection,
+	}
+}
+
+func (msg *MsgUpdateProposerSelection) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+
+	return errorsmod.Wrap(msg.ProposerSelection.ValidateBasic(), "proposer selection")
+}
@@ -3,6 +3,7 @@ package types
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -26,14 +27,19 @@ const (
 
 	DefaultLivenessSlashBlocks   = uint64(7200) // 12 hours worth of blocks at 1 block per 6 seconds
 	DefaultLivenessSlashInterval = uint64(600)  // 1 hour worth of blocks at 1 block per 6 seconds
+
+	// DefaultMaxAttestationAge rejects attestation tokens issued more than 15
+	// minutes before the block time.
+	DefaultMaxAttestationAge = 15 * time.Minute
 )
 
 var DefaultTeeConfig = TEEConfig{
-	Enabled:         true,
-	Verify:          false, // testing only
-	PolicyValues:    "",
-	PolicyQuery:     "",
-	PolicyStructure: "",
+	Enabled:           true,
+	Verify:            false, // testing only
+	PolicyValues:      "",
+	PolicyQuery:       "",
+	PolicyStructure:   "",
+	MaxAttestationAge: DefaultMaxAttestationAge,
 }
 
 // NewParams creates a new Params instance
@@ -155,12 +161,26 @@ func (v TEEConfig) Policy() tee.Policy {
 		PolicyValues:    v.PolicyValues,
 		PolicyQuery:     v.PolicyQuery,
 		PolicyStructure: v.PolicyStructure,
+		Type:            v.TeeType,
 	}
 }
 
 func validateTeeConfig(v TEEConfig) error {
+	if v.Verify && v.MaxAttestationAge <= 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "max attestation age must be positive")
+	}
+	if v.PolicyId != "" {
+		// the registry validated the policy when it was registered
+		if err := tee.ValidateFingerprint(v.PolicyId); err != nil {
+			return errorsmod.Wrap(err, "policy id")
+		}
+		return nil
+	}
 	if v.Verify {
 		policy := v.Policy()
+		if err := policy.Type.Validate(); err != nil {
+			return errorsmod.Wrap(err, "tee type")
+		}
 		if _, err := policy.PemCert(); err != nil {
 			return errorsmod.Wrap(err, "pem cert")
 		}
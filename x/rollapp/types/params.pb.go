@@ -8,15 +8,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	tee "github.com/dymensionxyz/dymension/v3/x/common/tee"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -132,6 +137,16 @@ type TEEConfig struct {
 	PolicyStructure string `protobuf:"bytes,5,opt,name=policy_structure,json=policyStructure,proto3" json:"policy_structure,omitempty" yaml:"policy_rego_structure"`
 	// gcp_root_cert_pem is the GCP root certificate in PEM format
 	GcpRootCertPem string `protobuf:"bytes,3,opt,name=gcp_root_cert_pem,json=gcpRootCertPem,proto3" json:"gcp_root_cert_pem,omitempty" yaml:"gcp_root_cert_pem"`
+	// tee_type is the attestation backend; see common.TEEType
+	TeeType tee.TEEType `protobuf:"varint,7,opt,name=tee_type,json=teeType,proto3,enum=dymensionxyz.dymension.common.TEEType" json:"tee_type,omitempty" yaml:"tee_type"`
+	// policy_id, if set, verifies against the head of this registered policy
+	// lineage in x/agent instead of the embedded policy fields above. The
+	// lineage must be administered by governance.
+	PolicyId string `protobuf:"bytes,8,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty" yaml:"policy_id"`
+	// max_attestation_age is the maximum age, at block time, of an attestation
+	// token. Accepted tokens are remembered for as long, so none is accepted
+	// twice.
+	MaxAttestationAge time.Duration `protobuf:"bytes,9,opt,name=max_attestation_age,json=maxAttestationAge,proto3,stdduration" json:"max_attestation_age" yaml:"max_attestation_age"`
 }
 
 func (m *TEEConfig) Reset()         { *m = TEEConfig{} }
@@ -209,6 +224,27 @@ func (m *TEEConfig) GetGcpRootCertPem() string {
 	return ""
 }
 
+func (m *TEEConfig) GetTeeType() tee.TEEType {
+	if m != nil {
+		return m.TeeType
+	}
+	return tee.TEEType_TEE_TYPE_GCP_CONFIDENTIAL_SPACE
+}
+
+func (m *TEEConfig) GetPolicyId() string {
+	if m != nil {
+		return m.PolicyId
+	}
+	return ""
+}
+
+func (m *TEEConfig) GetMaxAttestationAge() time.Duration {
+	if m != nil {
+		return m.MaxAttestationAge
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.rollapp.Params")
 	proto.RegisterType((*TEEConfig)(nil), "dymensionxyz.dymension.rollapp.TEEConfig")
@@ -219,49 +255,58 @@ func init() {
 }
 
 var fileDescriptor_75a44aa904ae1ba5 = []byte{
-	// 663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
-	0x14, 0xc6, 0x13, 0x08, 0x21, 0x19, 0xee, 0x9f, 0xe0, 0x0b, 0x5c, 0x87, 0x52, 0x3b, 0x32, 0x9b,
-	0x54, 0x95, 0x6c, 0x51, 0xba, 0x62, 0x53, 0xc9, 0x88, 0x22, 0xa8, 0x54, 0x51, 0x83, 0xba, 0x40,
-	0x95, 0x46, 0xb6, 0x73, 0x30, 0xd3, 0xda, 0x33, 0xc3, 0xcc, 0x24, 0x22, 0x5d, 0x74, 0xdb, 0x6d,
-	0x97, 0x5d, 0xf6, 0x71, 0x58, 0xb2, 0x64, 0x15, 0x55, 0xf0, 0x06, 0x79, 0x82, 0x2a, 0x63, 0x27,
-	0x0d, 0x14, 0xda, 0xdd, 0x7c, 0xdf, 0xf9, 0xe6, 0x77, 0x8e, 0x46, 0x47, 0x83, 0x9e, 0x76, 0xfa,
-	0x19, 0x50, 0x49, 0x18, 0x3d, 0xef, 0x7f, 0xf4, 0x26, 0xc2, 0x13, 0x2c, 0x4d, 0x43, 0xce, 0x3d,
-	0x1e, 0x8a, 0x30, 0x93, 0x2e, 0x17, 0x4c, 0x31, 0xc3, 0x9a, 0x0e, 0xbb, 0x13, 0xe1, 0x16, 0xe1,
-	0x55, 0x2b, 0x66, 0x32, 0x63, 0xd2, 0x8b, 0x42, 0x09, 0x5e, 0x6f, 0x23, 0x02, 0x15, 0x6e, 0x78,
-	0x31, 0x23, 0x34, 0xbf, 0xbf, 0xba, 0x94, 0xb0, 0x84, 0xe9, 0xa3, 0x37, 0x3a, 0xe5, 0xae, 0xf3,
-	0x79, 0x0e, 0x55, 0x0f, 0x74, 0x1b, 0xe3, 0x1d, 0x32, 0x3b, 0x44, 0xf2, 0xae, 0x02, 0xcc, 0x41,
-	0x10, 0xd6, 0xc1, 0x84, 0xe2, 0x28, 0x65, 0xf1, 0x07, 0x69, 0x96, 0x5b, 0xe5, 0x76, 0xc5, 0x5f,
-	0x1f, 0x0e, 0x6c, 0xbb, 0x1f, 0x66, 0xe9, 0x96, 0xf3, 0x50, 0xd2, 0x09, 0x96, 0x8b, 0xd2, 0x81,
-	0xae, 0xec, 0x51, 0x5f, 0xfb, 0xc6, 0x11, 0x5a, 0x4e, 0x49, 0x0f, 0x28, 0x48, 0x89, 0x65, 0x1a,
-	0xca, 0xd3, 0x31, 0xba, 0xa2, 0xd1, 0xad, 0xe1, 0xc0, 0x5e, 0xcb, 0xd1, 0xf7, 0xc6, 0x9c, 0xe0,
-	0xbf, 0xb1, 0x7f, 0x38, 0xb2, 0x0b, 0xea, 0x31, 0xfa, 0xff, 0x4e, 0x9c, 0x50, 0x05, 0xa2, 0x17,
-	0xa6, 0xe6, 0x9c, 0xe6, 0x3a, 0xc3, 0x81, 0x6d, 0xdd, 0xcb, 0x1d, 0x07, 0x9d, 0x60, 0xf9, 0x16,
-	0x79, 0xaf, 0xf0, 0x0d, 0x8e, 0x96, 0x42, 0xce, 0xb1, 0x80, 0x84, 0x48, 0x25, 0x42, 0x45, 0x18,
-	0xc5, 0x27, 0x00, 0xe6, 0x7c, 0xab, 0xdc, 0x5e, 0x78, 0xd6, 0x74, 0xf3, 0xf7, 0x76, 0x47, 0xef,
-	0xed, 0x16, 0xef, 0xed, 0x6e, 0x33, 0x42, 0xfd, 0xf5, 0x8b, 0x81, 0x5d, 0x1a, 0x0e, 0xec, 0x47,
-	0x79, 0xdf, 0xfb, 0x20, 0x4e, 0x60, 0x84, 0x9c, 0x07, 0x53, 0xee, 0x4b, 0x00, 0xe3, 0x13, 0x6a,
-	0x66, 0x84, 0x62, 0x09, 0x67, 0x5d, 0xa0, 0x31, 0x08, 0x1c, 0x31, 0xda, 0xc1, 0x49, 0xca, 0xa2,
-	0x30, 0x35, 0x6b, 0x7f, 0x6a, 0xdb, 0x2e, 0xda, 0xb6, 0xf2, 0xb6, 0x0f, 0x92, 0x9c, 0x60, 0x25,
-	0x23, 0xf4, 0x70, 0x5c, 0xf2, 0x19, 0xed, 0xec, 0xea, 0x82, 0x11, 0x23, 0xa4, 0x00, 0x70, 0xcc,
-	0xe8, 0x09, 0x49, 0xcc, 0xba, 0x6e, 0xf8, 0xc4, 0xfd, 0xfd, 0xde, 0xb9, 0x47, 0x3b, 0x3b, 0xdb,
-	0xfa, 0x82, 0xdf, 0x2c, 0x06, 0x58, 0xcc, 0x07, 0xf8, 0x89, 0x72, 0x82, 0xba, 0x02, 0xc8, 0x53,
-	0x5b, 0x95, 0xaf, 0xdf, 0xec, 0xd2, 0x7e, 0xa5, 0x36, 0xd3, 0x98, 0xdd, 0xaf, 0xd4, 0x66, 0x1b,
-	0x95, 0xfd, 0x4a, 0xad, 0xda, 0x98, 0x77, 0xae, 0x66, 0x50, 0x7d, 0xc2, 0x32, 0x4c, 0x34, 0x0f,
-	0x34, 0x8c, 0x52, 0xe8, 0xe8, 0xdd, 0xab, 0x05, 0x63, 0x69, 0xac, 0xa0, 0x6a, 0x0f, 0x04, 0x39,
-	0xe9, 0x9b, 0x55, 0x5d, 0x28, 0x94, 0xe1, 0xa3, 0xbf, 0x39, 0x4b, 0x49, 0xdc, 0xc7, 0xbd, 0x30,
-	0xed, 0x82, 0x34, 0x67, 0x5a, 0xe5, 0x76, 0xdd, 0x7f, 0x3c, 0x1c, 0xd8, 0xcd, 0x7c, 0xa0, 0xa2,
-	0xfc, 0x5e, 0x32, 0x5a, 0x64, 0x9c, 0xe0, 0xaf, 0xdc, 0x7c, 0xab, 0xa5, 0xf1, 0x02, 0x15, 0x1a,
-	0x9f, 0x75, 0x41, 0xf4, 0xf5, 0x6e, 0xd6, 0xfd, 0xb5, 0xe1, 0xc0, 0x36, 0x6f, 0x21, 0x04, 0x24,
-	0x2c, 0x8f, 0x38, 0xc1, 0x42, 0xee, 0xbd, 0x19, 0x29, 0xe3, 0x15, 0x6a, 0x14, 0x11, 0xa9, 0x44,
-	0x37, 0x56, 0x5d, 0x01, 0x7a, 0x11, 0xeb, 0xd3, 0x0b, 0x3e, 0x0d, 0x99, 0xc4, 0x9c, 0xe0, 0xdf,
-	0xdc, 0x3f, 0x1c, 0x3b, 0xc6, 0x2e, 0x5a, 0x4c, 0x62, 0x8e, 0x05, 0x63, 0x0a, 0xc7, 0x20, 0x14,
-	0xe6, 0x90, 0x99, 0xb3, 0x77, 0x47, 0xfa, 0x25, 0xe2, 0x04, 0xff, 0x24, 0x31, 0x0f, 0x18, 0x53,
-	0xdb, 0x20, 0xd4, 0x01, 0x64, 0xfe, 0xeb, 0x8b, 0x6b, 0xab, 0x7c, 0x79, 0x6d, 0x95, 0xbf, 0x5f,
-	0x5b, 0xe5, 0x2f, 0x37, 0x56, 0xe9, 0xf2, 0xc6, 0x2a, 0x5d, 0xdd, 0x58, 0xa5, 0xe3, 0xe7, 0x09,
-	0x51, 0xa7, 0xdd, 0xc8, 0x8d, 0x59, 0xe6, 0x3d, 0xf0, 0x19, 0xf5, 0x36, 0xbd, 0xf3, 0xc9, 0x8f,
-	0xa4, 0xfa, 0x1c, 0x64, 0x54, 0xd5, 0x7f, 0xc7, 0xe6, 0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd8,
-	0x3d, 0x92, 0xc4, 0xc0, 0x04, 0x00, 0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0xce, 0x36, 0xdb, 0xcd, 0xee, 0x14, 0xda, 0x8d, 0x93, 0x14, 0x6f, 0x28, 0xf6, 0xca, 0x95,
+	0x4a, 0x10, 0x92, 0xad, 0xb4, 0x9c, 0x7a, 0x41, 0x75, 0x28, 0x55, 0x82, 0x84, 0x82, 0x13, 0xf5,
+	0x50, 0x21, 0x59, 0x63, 0xfb, 0xc5, 0x1d, 0xb0, 0x67, 0x26, 0x9e, 0xf1, 0x2a, 0xcb, 0x81, 0x2b,
+	0x57, 0x8e, 0x3d, 0xf2, 0x73, 0x7a, 0xec, 0x09, 0x71, 0x32, 0x28, 0xf9, 0x07, 0xfb, 0x0b, 0x90,
+	0x67, 0x66, 0x37, 0x49, 0x9b, 0x85, 0xdb, 0xbc, 0xef, 0xfb, 0xfc, 0xcd, 0x9b, 0x7d, 0xdf, 0x3e,
+	0xf4, 0x65, 0x36, 0x2d, 0x81, 0x0a, 0xc2, 0xe8, 0xd9, 0xf4, 0x97, 0x60, 0x51, 0x04, 0x15, 0x2b,
+	0x0a, 0xcc, 0x79, 0xc0, 0x71, 0x85, 0x4b, 0xe1, 0xf3, 0x8a, 0x49, 0x66, 0x39, 0x57, 0xc5, 0xfe,
+	0xa2, 0xf0, 0x8d, 0x78, 0xdb, 0x49, 0x99, 0x28, 0x99, 0x08, 0x12, 0x2c, 0x20, 0x98, 0xec, 0x26,
+	0x20, 0xf1, 0x6e, 0x90, 0x32, 0x42, 0xf5, 0xf7, 0xdb, 0x9b, 0x39, 0xcb, 0x99, 0x3a, 0x06, 0xed,
+	0xc9, 0xa0, 0x4e, 0xce, 0x58, 0x5e, 0x40, 0xa0, 0xaa, 0xa4, 0x3e, 0x09, 0xb2, 0xba, 0xc2, 0xb2,
+	0xf5, 0xd5, 0xfc, 0xe7, 0x4b, 0x5a, 0x4c, 0x59, 0x59, 0x32, 0x1a, 0x48, 0x00, 0x2d, 0xf4, 0x7e,
+	0xbb, 0x8d, 0x7a, 0x87, 0xaa, 0x5f, 0xeb, 0x47, 0x64, 0x67, 0x44, 0xf0, 0x5a, 0x42, 0xcc, 0xa1,
+	0x22, 0x2c, 0x8b, 0x09, 0x8d, 0x93, 0x82, 0xa5, 0x3f, 0x0b, 0xbb, 0x33, 0xee, 0xec, 0x74, 0xc3,
+	0x87, 0xb3, 0xc6, 0x75, 0xa7, 0xb8, 0x2c, 0x9e, 0x7a, 0xcb, 0x94, 0x5e, 0xb4, 0x65, 0xa8, 0x43,
+	0xc5, 0xec, 0xd3, 0x50, 0xe1, 0xd6, 0x31, 0xda, 0x2a, 0xc8, 0x04, 0x28, 0x08, 0x11, 0x8b, 0x02,
+	0x8b, 0xd7, 0x73, 0xeb, 0xae, 0xb2, 0x1e, 0xcf, 0x1a, 0xf7, 0x81, 0xb6, 0xbe, 0x51, 0xe6, 0x45,
+	0x1b, 0x73, 0xfc, 0xa8, 0x85, 0x8d, 0xeb, 0x2b, 0xf4, 0xc9, 0x7b, 0x72, 0x42, 0x25, 0x54, 0x13,
+	0x5c, 0xd8, 0xb7, 0x95, 0xaf, 0x37, 0x6b, 0x5c, 0xe7, 0x46, 0xdf, 0xb9, 0xd0, 0x8b, 0xb6, 0xae,
+	0x39, 0xef, 0x1b, 0xdc, 0xe2, 0x68, 0x13, 0x73, 0x1e, 0x57, 0x90, 0x13, 0x21, 0xf5, 0xaf, 0x1b,
+	0x9f, 0x00, 0xd8, 0x6b, 0xe3, 0xce, 0xce, 0x9d, 0xc7, 0x23, 0x5f, 0x0f, 0xce, 0x6f, 0x07, 0xe7,
+	0x9b, 0xc1, 0xf9, 0x7b, 0x8c, 0xd0, 0xf0, 0xe1, 0xdb, 0xc6, 0x5d, 0x99, 0x35, 0xee, 0xa7, 0xfa,
+	0xde, 0x9b, 0x4c, 0xbc, 0xc8, 0xc2, 0x9c, 0x47, 0x57, 0xd0, 0x6f, 0x01, 0xac, 0x5f, 0xd1, 0xa8,
+	0x24, 0x34, 0x16, 0x70, 0x5a, 0x03, 0x4d, 0xa1, 0x8a, 0x13, 0x46, 0xb3, 0x38, 0x2f, 0x58, 0x82,
+	0x0b, 0xbb, 0xff, 0x7f, 0xd7, 0xee, 0x98, 0x6b, 0xc7, 0xfa, 0xda, 0xa5, 0x4e, 0x5e, 0x74, 0xbf,
+	0x24, 0xf4, 0x68, 0x4e, 0x85, 0x8c, 0x66, 0x2f, 0x14, 0x61, 0xa5, 0x08, 0x49, 0x80, 0x38, 0x65,
+	0xf4, 0x84, 0xe4, 0xf6, 0x40, 0x5d, 0xf8, 0x85, 0xff, 0xdf, 0x01, 0xf6, 0x8f, 0x9f, 0x3f, 0xdf,
+	0x53, 0x1f, 0x84, 0x23, 0xd3, 0xc0, 0xba, 0x6e, 0xe0, 0xd2, 0xca, 0x8b, 0x06, 0x12, 0x40, 0xab,
+	0x9e, 0x76, 0xdf, 0xfc, 0xe1, 0xae, 0x1c, 0x74, 0xfb, 0xb7, 0x86, 0xab, 0x07, 0xdd, 0xfe, 0xea,
+	0xb0, 0x7b, 0xd0, 0xed, 0xf7, 0x86, 0x6b, 0xde, 0x9f, 0x5d, 0x34, 0x58, 0x78, 0x59, 0x36, 0x5a,
+	0x03, 0x8a, 0x93, 0x02, 0x32, 0x95, 0xbd, 0x7e, 0x34, 0x2f, 0xad, 0xfb, 0xa8, 0x37, 0x81, 0x8a,
+	0x9c, 0x4c, 0xed, 0x9e, 0x22, 0x4c, 0x65, 0x85, 0xe8, 0x63, 0xce, 0x0a, 0x92, 0x4e, 0xe3, 0x09,
+	0x2e, 0x6a, 0x10, 0xf6, 0xad, 0x71, 0x67, 0x67, 0x10, 0x7e, 0x36, 0x6b, 0xdc, 0x91, 0x6e, 0xc8,
+	0xd0, 0x3f, 0x09, 0x46, 0x8d, 0xc6, 0x8b, 0x3e, 0xd2, 0xe0, 0x4b, 0x55, 0x5a, 0x5f, 0x23, 0x53,
+	0xc7, 0xa7, 0x35, 0x54, 0x53, 0x95, 0xcd, 0x41, 0xf8, 0x60, 0xd6, 0xb8, 0xf6, 0x35, 0x8b, 0x0a,
+	0x72, 0xa6, 0x25, 0x5e, 0x74, 0x47, 0x63, 0x3f, 0xb4, 0x95, 0xf5, 0x1d, 0x1a, 0x1a, 0x89, 0x90,
+	0x55, 0x9d, 0xca, 0xba, 0x02, 0x15, 0xc4, 0xc1, 0xd5, 0x80, 0x5f, 0x35, 0x59, 0xc8, 0xbc, 0xe8,
+	0x9e, 0xc6, 0x8f, 0xe6, 0x88, 0xf5, 0x02, 0xad, 0xe7, 0x29, 0x8f, 0x2b, 0xc6, 0x64, 0x9c, 0x42,
+	0x25, 0x63, 0x0e, 0xa5, 0xbd, 0xfa, 0x7e, 0x4b, 0x1f, 0x48, 0xbc, 0xe8, 0x6e, 0x9e, 0xf2, 0x88,
+	0x31, 0xb9, 0x07, 0x95, 0x3c, 0x84, 0xd2, 0x7a, 0x89, 0xfa, 0xed, 0x30, 0xe4, 0x94, 0xeb, 0xf4,
+	0xde, 0x7d, 0xfc, 0x68, 0xd9, 0x54, 0xf5, 0x82, 0x68, 0x87, 0x7a, 0x3c, 0xe5, 0x10, 0x6e, 0xcc,
+	0x1a, 0xf7, 0xde, 0xe5, 0x38, 0x5b, 0x07, 0x2f, 0x5a, 0x93, 0x00, 0x2d, 0x6b, 0xed, 0xa2, 0x81,
+	0x79, 0x0b, 0xc9, 0x54, 0x3e, 0x07, 0xe1, 0xe6, 0xac, 0x71, 0x87, 0xd7, 0x9e, 0x49, 0x32, 0x2f,
+	0xea, 0xeb, 0xf3, 0x7e, 0x66, 0x9d, 0xa2, 0x8d, 0x12, 0x9f, 0xc5, 0x58, 0x4a, 0x10, 0x52, 0xff,
+	0x1d, 0x70, 0x0e, 0x26, 0x6b, 0x23, 0x5f, 0xaf, 0x35, 0x7f, 0xbe, 0xd6, 0xfc, 0x6f, 0xcc, 0x5a,
+	0x0b, 0x1f, 0x99, 0x6c, 0x6d, 0x9b, 0x70, 0x7f, 0xe8, 0xe1, 0xbd, 0xf9, 0xdb, 0xed, 0x44, 0xeb,
+	0x25, 0x3e, 0x7b, 0x76, 0x49, 0x3c, 0xcb, 0x21, 0xfc, 0xfe, 0xed, 0xb9, 0xd3, 0x79, 0x77, 0xee,
+	0x74, 0xfe, 0x39, 0x77, 0x3a, 0xbf, 0x5f, 0x38, 0x2b, 0xef, 0x2e, 0x9c, 0x95, 0xbf, 0x2e, 0x9c,
+	0x95, 0x57, 0x5f, 0xe5, 0x44, 0xbe, 0xae, 0x93, 0xf6, 0xd1, 0xc1, 0x92, 0x85, 0x39, 0x79, 0x12,
+	0x9c, 0x2d, 0x16, 0x7b, 0xfb, 0x7c, 0x91, 0xf4, 0x54, 0x77, 0x4f, 0xfe, 0x1d, 0x00, 0x3e, 0x70,
+	0x1e, 0xfa, 0x07, 0x06, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -352,6 +397,26 @@ func (m *TEEConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxAttestationAge, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAttestationAge):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintParams(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.PolicyId) > 0 {
+		i -= len(m.PolicyId)
+		copy(dAtA[i:], m.PolicyId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PolicyId)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TeeType != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TeeType))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.Verify {
 		i--
 		if m.Verify {
@@ -466,6 +531,15 @@ func (m *TEEConfig) Size() (n int) {
 	if m.Verify {
 		n += 2
 	}
+	if m.TeeType != 0 {
+		n += 1 + sovParams(uint64(m.TeeType))
+	}
+	l = len(m.PolicyId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxAttestationAge)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -878,6 +952,90 @@ func (m *TEEConfig) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Verify = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TeeType", wireType)
+			}
+			m.TeeType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TeeType |= tee.TEEType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttestationAge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxAttestationAge, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
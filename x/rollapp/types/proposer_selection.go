@@ -0,0 +1,41 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+const maxPriorityListLength = 32
+
+func (s ProposerSelection) ValidateBasic() error {
+	if _, ok := ProposerSelection_Strategy_name[int32(s.Strategy)]; !ok {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "unknown strategy: %d", s.Strategy)
+	}
+	if s.RotationPeriod < 0 {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "negative rotation period: %s", s.RotationPeriod)
+	}
+	if s.Strategy != ProposerSelection_PriorityList {
+		if len(s.PriorityList) != 0 {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "priority list only allowed with %s strategy", ProposerSelection_PriorityList)
+		}
+		return nil
+	}
+	if len(s.PriorityList) == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "priority list is empty")
+	}
+	if maxPriorityListLength < len(s.PriorityList) {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "priority list too long: max: %d", maxPriorityListLength)
+	}
+	seen := make(map[string]struct{}, len(s.PriorityList))
+	for _, addr := range s.PriorityList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "priority list: %s", err)
+		}
+		if _, ok := seen[addr]; ok {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "priority list: duplicate: %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+	return nil
+}
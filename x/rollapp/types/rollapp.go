@@ -69,6 +69,7 @@ func NewRollapp(creator, rollappId, initialSequencer string, minSequencerBond sd
 // - GenesisInfo must pass basic validation
 // - VmType must be non-zero
 // - Metadata (if present) must be valid
+// - ProposerSelection must be valid
 // - If rollapp is launched, GenesisInfo must be sealed
 //
 // Returns:
@@ -111,6 +112,10 @@ func (r Rollapp) ValidateBasic() error {
 		}
 	}
 
+	if err = r.ProposerSelection.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(err, "proposer selection")
+	}
+
 	// if rollapp is started, genesis info must be sealed
 	if r.Launched && !r.GenesisInfo.Sealed {
 		return fmt.Errorf("genesis info needs to be sealed if rollapp is started")
@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -57,6 +58,45 @@ func (Rollapp_VMType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d4ef2bec3aea5528, []int{1, 0}
 }
 
+type ProposerSelection_Strategy int32
+
+const (
+	// the potential proposer with the most stake, delegations included
+	ProposerSelection_MostStake ProposerSelection_Strategy = 0
+	// a random potential proposer, with probability proportional to its
+	// stake, drawn from the app hash of the previous hub block. A hub
+	// validator can bias the draw when it proposes the block before a change
+	// of proposer.
+	ProposerSelection_WeightedRandom ProposerSelection_Strategy = 1
+	// the potential proposers take turns, in address order
+	ProposerSelection_RoundRobin ProposerSelection_Strategy = 2
+	// the first potential proposer of priority_list, falling back to the most
+	// stake
+	ProposerSelection_PriorityList ProposerSelection_Strategy = 3
+)
+
+var ProposerSelection_Strategy_name = map[int32]string{
+	0: "MostStake",
+	1: "WeightedRandom",
+	2: "RoundRobin",
+	3: "PriorityList",
+}
+
+var ProposerSelection_Strategy_value = map[string]int32{
+	"MostStake":      0,
+	"WeightedRandom": 1,
+	"RoundRobin":     2,
+	"PriorityList":   3,
+}
+
+func (x ProposerSelection_Strategy) String() string {
+	return proto.EnumName(ProposerSelection_Strategy_name, int32(x))
+}
+
+func (ProposerSelection_Strategy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{2, 0}
+}
+
 // RollappGenesisState is a partial repr of the state the hub can expect the
 // rollapp to be in upon genesis
 type RollappGenesisState struct {
@@ -154,6 +194,9 @@ type Rollapp struct {
 	Revisions []Revision `protobuf:"bytes,19,rep,name=revisions,proto3" json:"revisions"`
 	// togglable by owner: enable fast finalization via TEE nodes (note: global gov param required too)
 	EnableTee bool `protobuf:"varint,21,opt,name=enable_tee,json=enableTee,proto3" json:"enable_tee,omitempty"`
+	// proposer_selection is how the next proposer is chosen among the potential
+	// proposers. Set by the owner.
+	ProposerSelection ProposerSelection `protobuf:"bytes,22,opt,name=proposer_selection,json=proposerSelection,proto3" json:"proposer_selection"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -294,6 +337,81 @@ func (m *Rollapp) GetEnableTee() bool {
 	return false
 }
 
+func (m *Rollapp) GetProposerSelection() ProposerSelection {
+	if m != nil {
+		return m.ProposerSelection
+	}
+	return ProposerSelection{}
+}
+
+// ProposerSelection configures how the hub picks the proposer of a rollapp
+// when the current one rotates out or the rollapp recovers from a halt. The
+// sentinel is only chosen if there is no potential proposer.
+type ProposerSelection struct {
+	Strategy ProposerSelection_Strategy `protobuf:"varint,1,opt,name=strategy,proto3,enum=dymensionxyz.dymension.rollapp.ProposerSelection_Strategy" json:"strategy,omitempty"`
+	// priority_list of sequencer addresses, only used with the PriorityList
+	// strategy
+	PriorityList []string `protobuf:"bytes,2,rep,name=priority_list,json=priorityList,proto3" json:"priority_list,omitempty"`
+	// rotation_period is how long a proposer serves before a rotation is
+	// started, with the usual notice period and last block handover. Zero
+	// disables it: the proposer serves until it unbonds or is kicked.
+	RotationPeriod time.Duration `protobuf:"bytes,3,opt,name=rotation_period,json=rotationPeriod,proto3,stdduration" json:"rotation_period"`
+}
+
+func (m *ProposerSelection) Reset()         { *m = ProposerSelection{} }
+func (m *ProposerSelection) String() string { return proto.CompactTextString(m) }
+func (*ProposerSelection) ProtoMessage()    {}
+func (*ProposerSelection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+}
+func (m *ProposerSelection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerSelection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerSelection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerSelection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerSelection.Merge(m, src)
+}
+func (m *ProposerSelection) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerSelection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerSelection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerSelection proto.InternalMessageInfo
+
+func (m *ProposerSelection) GetStrategy() ProposerSelection_Strategy {
+	if m != nil {
+		return m.Strategy
+	}
+	return ProposerSelection_MostStake
+}
+
+func (m *ProposerSelection) GetPriorityList() []string {
+	if m != nil {
+		return m.PriorityList
+	}
+	return nil
+}
+
+func (m *ProposerSelection) GetRotationPeriod() time.Duration {
+	if m != nil {
+		return m.RotationPeriod
+	}
+	return 0
+}
+
 // Revision is a representation of the rollapp revision.
 type Revision struct {
 	// Number is the revision number of the rollapp. Always start with 0 revision.
@@ -306,7 +424,7 @@ func (m *Revision) Reset()         { *m = Revision{} }
 func (m *Revision) String() string { return proto.CompactTextString(m) }
 func (*Revision) ProtoMessage()    {}
 func (*Revision) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{2}
+	return fileDescriptor_d4ef2bec3aea5528, []int{3}
 }
 func (m *Revision) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -366,7 +484,7 @@ func (m *RollappSummary) Reset()         { *m = RollappSummary{} }
 func (m *RollappSummary) String() string { return proto.CompactTextString(m) }
 func (*RollappSummary) ProtoMessage()    {}
 func (*RollappSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d4ef2bec3aea5528, []int{3}
+	return fileDescriptor_d4ef2bec3aea5528, []int{4}
 }
 func (m *RollappSummary) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -432,8 +550,10 @@ func (m *RollappSummary) GetLatestFinalizedHeight() uint64 {
 
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.rollapp.Rollapp_VMType", Rollapp_VMType_name, Rollapp_VMType_value)
+	proto.RegisterEnum("dymensionxyz.dymension.rollapp.ProposerSelection_Strategy", ProposerSelection_Strategy_name, ProposerSelection_Strategy_value)
 	proto.RegisterType((*RollappGenesisState)(nil), "dymensionxyz.dymension.rollapp.RollappGenesisState")
 	proto.RegisterType((*Rollapp)(nil), "dymensionxyz.dymension.rollapp.Rollapp")
+	proto.RegisterType((*ProposerSelection)(nil), "dymensionxyz.dymension.rollapp.ProposerSelection")
 	proto.RegisterType((*Revision)(nil), "dymensionxyz.dymension.rollapp.Revision")
 	proto.RegisterType((*RollappSummary)(nil), "dymensionxyz.dymension.rollapp.RollappSummary")
 }
@@ -443,63 +563,75 @@ func init() {
 }
 
 var fileDescriptor_d4ef2bec3aea5528 = []byte{
-	// 892 bytes of a gzipped FileDescriptorProto
+	// 1074 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x2d, 0xc5, 0xa2, 0x56, 0xb2, 0xcd, 0xac, 0xec, 0x82, 0x16, 0x12, 0x49, 0xd5, 0x89,
-	0x40, 0x12, 0x12, 0xb6, 0x73, 0xea, 0xad, 0x0a, 0xdc, 0xc4, 0x6e, 0x54, 0x14, 0x94, 0x93, 0x02,
-	0x39, 0x94, 0xa0, 0xc4, 0x11, 0xb5, 0x28, 0xb9, 0xcb, 0x72, 0x57, 0x8a, 0x95, 0xaf, 0xc8, 0xa9,
-	0x1f, 0xd1, 0x2f, 0xc9, 0x31, 0xe8, 0xa9, 0xa7, 0xa4, 0xb0, 0xff, 0xa0, 0xf7, 0x02, 0x05, 0x97,
-	0x4b, 0x49, 0xa9, 0x93, 0x2a, 0xc8, 0x89, 0x9a, 0x79, 0x33, 0x6f, 0x67, 0x66, 0xe7, 0xad, 0xd0,
-	0xfd, 0x60, 0x11, 0x03, 0xe5, 0x84, 0xd1, 0xcb, 0xc5, 0x2b, 0x67, 0x69, 0x38, 0x29, 0x8b, 0x22,
-	0x3f, 0x49, 0x8a, 0xaf, 0x9d, 0xa4, 0x4c, 0x30, 0xdc, 0x5e, 0x8f, 0xb6, 0x97, 0x86, 0xad, 0xa2,
-	0x5a, 0xfb, 0x21, 0x0b, 0x99, 0x0c, 0x75, 0xb2, 0x5f, 0x79, 0x56, 0xab, 0x13, 0x32, 0x16, 0x46,
-	0xe0, 0x48, 0x6b, 0x34, 0x9b, 0x38, 0x82, 0xc4, 0xc0, 0x85, 0x1f, 0x2b, 0xda, 0x96, 0xb3, 0xa1,
-	0x08, 0x2e, 0x7c, 0x01, 0x1e, 0xa1, 0x93, 0x82, 0xf1, 0xc1, 0x86, 0x84, 0x18, 0x84, 0x1f, 0xf8,
-	0xc2, 0x57, 0xe1, 0xed, 0x31, 0xe3, 0x31, 0xe3, 0xce, 0xc8, 0xe7, 0xe0, 0xcc, 0x8f, 0x46, 0x20,
-	0xfc, 0x23, 0x67, 0xcc, 0x08, 0x55, 0xf8, 0xd1, 0x06, 0xba, 0x10, 0x28, 0x70, 0xc2, 0xd7, 0x2a,
-	0xe8, 0x3d, 0x43, 0x4d, 0x37, 0x47, 0x1f, 0xe7, 0xe0, 0x30, 0xab, 0x11, 0x1f, 0xa3, 0x03, 0x91,
-	0xfa, 0x94, 0x4f, 0x20, 0xf5, 0x92, 0x94, 0xb1, 0x89, 0x37, 0x05, 0x12, 0x4e, 0x85, 0x59, 0xee,
-	0x6a, 0x56, 0xc5, 0x6d, 0x16, 0xe0, 0x8f, 0x19, 0xf6, 0x44, 0x42, 0xe7, 0x15, 0x5d, 0x33, 0xb6,
-	0xce, 0x2b, 0xfa, 0x96, 0x51, 0xee, 0xfd, 0x53, 0x45, 0x55, 0xc5, 0x8b, 0xef, 0x22, 0xa4, 0x0a,
-	0xf0, 0x48, 0x60, 0x6a, 0x5d, 0xcd, 0xaa, 0xb9, 0x35, 0xe5, 0x39, 0x0b, 0xf0, 0x3e, 0xba, 0xc5,
-	0x5e, 0x52, 0x48, 0xcd, 0x2d, 0x89, 0xe4, 0x06, 0xfe, 0x19, 0xed, 0x14, 0xd5, 0xca, 0xa9, 0x99,
-	0xd5, 0xae, 0x66, 0xd5, 0x8f, 0x4f, 0xec, 0xff, 0xbf, 0x39, 0xfb, 0x23, 0xcd, 0xf4, 0x2b, 0x6f,
-	0xde, 0x75, 0x4a, 0x6e, 0x23, 0x5c, 0x6f, 0xf0, 0x2e, 0x42, 0xe3, 0xa9, 0x4f, 0x29, 0x44, 0x59,
-	0x51, 0x7a, 0x5e, 0x94, 0xf2, 0x9c, 0x05, 0xf8, 0x7b, 0xa4, 0x17, 0xb3, 0x37, 0xeb, 0xf2, 0x64,
-	0xe7, 0x33, 0x4f, 0x1e, 0xa8, 0x34, 0x77, 0x49, 0x80, 0x2f, 0x50, 0x63, 0x7d, 0xf2, 0x66, 0x43,
-	0x12, 0xde, 0xdb, 0x44, 0xa8, 0x7a, 0x38, 0xa3, 0x13, 0xa6, 0x5a, 0xa8, 0x87, 0x2b, 0x17, 0xbe,
-	0x87, 0x6e, 0x13, 0x4a, 0x04, 0xf1, 0x23, 0x8f, 0xc3, 0xaf, 0x33, 0xa0, 0x63, 0x48, 0xcd, 0x1d,
-	0xd9, 0x88, 0xa1, 0x80, 0x61, 0xe1, 0xc7, 0xbf, 0x69, 0x08, 0xc7, 0x84, 0xae, 0x22, 0xbd, 0x11,
-	0xa3, 0x81, 0xb9, 0xdf, 0x2d, 0x5b, 0xf5, 0xe3, 0x43, 0x3b, 0xdf, 0x2b, 0x3b, 0xdb, 0x2b, 0x5b,
-	0xed, 0x95, 0xfd, 0x88, 0x11, 0xda, 0x1f, 0x64, 0xe7, 0xfe, 0xfd, 0xae, 0x73, 0xb8, 0xf0, 0xe3,
-	0xe8, 0x9b, 0xde, 0x4d, 0x8a, 0xde, 0xef, 0xef, 0x3b, 0x56, 0x48, 0xc4, 0x74, 0x36, 0xb2, 0xc7,
-	0x2c, 0x76, 0xd4, 0x86, 0xe6, 0x9f, 0x07, 0x3c, 0xf8, 0xc5, 0x11, 0x8b, 0x04, 0xb8, 0x64, 0xe3,
-	0xae, 0x11, 0x13, 0xba, 0x2c, 0xaa, 0xcf, 0x68, 0x80, 0x1f, 0xa3, 0xea, 0x3c, 0xf6, 0xb2, 0x18,
-	0x73, 0xb7, 0xab, 0x59, 0xbb, 0xc7, 0xf6, 0x67, 0xce, 0xd9, 0x7e, 0x3e, 0xb8, 0x58, 0x24, 0xe0,
-	0x6e, 0xcf, 0xe3, 0xec, 0x8b, 0x5b, 0x48, 0x8f, 0xfc, 0x19, 0x1d, 0x4f, 0x21, 0x30, 0xf7, 0xba,
-	0x9a, 0xa5, 0xbb, 0x4b, 0x1b, 0x3f, 0x41, 0x7b, 0x49, 0x0a, 0x5e, 0x6e, 0x7b, 0x99, 0x6a, 0x4d,
-	0x43, 0xde, 0x41, 0xcb, 0xce, 0x25, 0x6d, 0x17, 0x92, 0xb6, 0x2f, 0x0a, 0x49, 0xf7, 0x2b, 0xaf,
-	0xdf, 0x77, 0x34, 0x77, 0x27, 0x49, 0xe1, 0xa9, 0xcc, 0xcb, 0x90, 0x4c, 0x17, 0x11, 0x99, 0x67,
-	0xb7, 0xc0, 0x3d, 0x98, 0x03, 0x15, 0x85, 0x2e, 0x6e, 0x77, 0x35, 0xab, 0xec, 0x36, 0x0b, 0xf0,
-	0x34, 0xc3, 0x72, 0x5d, 0xe0, 0x53, 0xd4, 0x59, 0xe6, 0x8c, 0xd9, 0x8c, 0x8a, 0x80, 0xbd, 0xa4,
-	0xd9, 0x56, 0xa7, 0xcb, 0x6c, 0x2c, 0xb3, 0xef, 0x14, 0x61, 0x8f, 0x8a, 0xa8, 0x61, 0x16, 0xa4,
-	0x68, 0x9e, 0xa2, 0x5a, 0x0a, 0x73, 0x92, 0xcd, 0x82, 0x9b, 0x4d, 0x79, 0x71, 0xd6, 0xc6, 0x59,
-	0xa9, 0x04, 0xb5, 0x3f, 0x2b, 0x82, 0x6c, 0xff, 0x81, 0xfa, 0xa3, 0x08, 0x3c, 0x01, 0x60, 0x1e,
-	0xc8, 0x81, 0xd5, 0x72, 0xcf, 0x05, 0x40, 0xef, 0x3e, 0xda, 0xce, 0xe7, 0x8b, 0xf7, 0x50, 0xfd,
-	0x19, 0xe5, 0x09, 0x8c, 0xc9, 0x84, 0x40, 0x60, 0x94, 0x70, 0x15, 0x95, 0x4f, 0x9f, 0x0f, 0x0c,
-	0x0d, 0xeb, 0xa8, 0xf2, 0xd3, 0xb7, 0xc3, 0x81, 0xd4, 0x7c, 0xd9, 0xa8, 0x9e, 0x57, 0xf4, 0x9a,
-	0x81, 0xce, 0x2b, 0x3a, 0x32, 0xea, 0xbd, 0x53, 0xa4, 0x17, 0x67, 0xe3, 0xaf, 0xd0, 0x36, 0x9d,
-	0xc5, 0x23, 0x48, 0xcd, 0xa6, 0x7c, 0x3c, 0x94, 0x85, 0xbf, 0x46, 0x8d, 0x0f, 0x86, 0xb0, 0x2f,
-	0xd1, 0x3a, 0x5f, 0xf5, 0xdc, 0xfb, 0x63, 0x0b, 0xed, 0xaa, 0xfb, 0x1e, 0xce, 0xe2, 0xd8, 0x4f,
-	0x17, 0xf8, 0x0e, 0x5a, 0xbd, 0x1d, 0x37, 0x1f, 0x93, 0x17, 0xc8, 0x88, 0x7c, 0x01, 0x5c, 0x48,
-	0x95, 0x9f, 0xd1, 0x00, 0x2e, 0xe5, 0xbb, 0x52, 0xdf, 0xbc, 0x57, 0x2a, 0x63, 0xc2, 0x64, 0x96,
-	0x7b, 0x83, 0x07, 0x47, 0xe8, 0x30, 0xf7, 0x7d, 0x47, 0xa8, 0x1f, 0x91, 0x57, 0x10, 0xac, 0x1d,
-	0x52, 0xfe, 0xa2, 0x43, 0x3e, 0x4d, 0x88, 0x7b, 0xa8, 0x91, 0x83, 0xf9, 0x28, 0xcc, 0x8a, 0x9c,
-	0xce, 0x07, 0x3e, 0xfc, 0x10, 0x1d, 0xfc, 0x87, 0x40, 0x05, 0xdf, 0x92, 0xc1, 0x1f, 0x07, 0xfb,
-	0x3f, 0xbc, 0xb9, 0x6a, 0x6b, 0x6f, 0xaf, 0xda, 0xda, 0x5f, 0x57, 0x6d, 0xed, 0xf5, 0x75, 0xbb,
-	0xf4, 0xf6, 0xba, 0x5d, 0xfa, 0xf3, 0xba, 0x5d, 0x7a, 0xf1, 0x70, 0x4d, 0xc8, 0x9f, 0xf8, 0x2b,
-	0x99, 0x9f, 0x38, 0x97, 0xcb, 0xff, 0x13, 0x29, 0xed, 0xd1, 0xb6, 0x14, 0xcf, 0xc9, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x2e, 0x23, 0xe7, 0xaa, 0x83, 0x07, 0x00, 0x00,
+	0x13, 0x16, 0x25, 0xc5, 0xa6, 0x56, 0xb2, 0x4c, 0xaf, 0x9d, 0x80, 0x31, 0x12, 0x49, 0xbf, 0xfe,
+	0x8b, 0x80, 0x24, 0x24, 0xec, 0xe4, 0x94, 0x5b, 0x95, 0xba, 0x89, 0xdd, 0xa8, 0x35, 0x28, 0xc7,
+	0x01, 0x72, 0x28, 0x41, 0x89, 0x23, 0x79, 0x11, 0x72, 0x97, 0xe5, 0xae, 0x14, 0x2b, 0x4f, 0x91,
+	0x53, 0xd1, 0x67, 0xe8, 0xa5, 0xaf, 0x91, 0x63, 0xd0, 0x53, 0x4f, 0x49, 0x61, 0xa3, 0x2f, 0xd0,
+	0x27, 0x28, 0x76, 0xb9, 0x94, 0x15, 0x3b, 0xa9, 0xd2, 0x9e, 0xc8, 0x99, 0x6f, 0xe6, 0x9b, 0xd9,
+	0xd9, 0x99, 0x59, 0x74, 0x37, 0x9c, 0xc5, 0x40, 0x39, 0x61, 0xf4, 0x74, 0xf6, 0xda, 0x9d, 0x0b,
+	0x6e, 0xca, 0xa2, 0x28, 0x48, 0x92, 0xfc, 0xeb, 0x24, 0x29, 0x13, 0x0c, 0x37, 0x16, 0xad, 0x9d,
+	0xb9, 0xe0, 0x68, 0xab, 0xed, 0xad, 0x31, 0x1b, 0x33, 0x65, 0xea, 0xca, 0xbf, 0xcc, 0x6b, 0xbb,
+	0x39, 0x66, 0x6c, 0x1c, 0x81, 0xab, 0xa4, 0xc1, 0x64, 0xe4, 0x0a, 0x12, 0x03, 0x17, 0x41, 0xac,
+	0x69, 0xb7, 0x1b, 0x97, 0x0d, 0xc2, 0x49, 0x1a, 0x08, 0x49, 0x9c, 0xe1, 0xee, 0x92, 0x24, 0xb9,
+	0x08, 0x04, 0xf8, 0x84, 0x8e, 0xf2, 0x88, 0xf7, 0x96, 0x38, 0xc4, 0x20, 0x82, 0x30, 0x10, 0x41,
+	0x1e, 0x7f, 0xc8, 0x78, 0xcc, 0xb8, 0x3b, 0x08, 0x38, 0xb8, 0xd3, 0x9d, 0x01, 0x88, 0x60, 0xc7,
+	0x1d, 0x32, 0x92, 0xc7, 0xdf, 0x59, 0x42, 0x37, 0x06, 0x0a, 0x9c, 0xf0, 0x85, 0x0c, 0xda, 0xcf,
+	0xd0, 0xa6, 0x97, 0xa1, 0x8f, 0x33, 0xb0, 0x2f, 0x73, 0xc4, 0xbb, 0xe8, 0xba, 0x48, 0x03, 0xca,
+	0x47, 0x90, 0xfa, 0x49, 0xca, 0xd8, 0xc8, 0x3f, 0x01, 0x32, 0x3e, 0x11, 0x76, 0xa9, 0x65, 0x74,
+	0xca, 0xde, 0x66, 0x0e, 0x1e, 0x4a, 0xec, 0x89, 0x82, 0x0e, 0xca, 0xa6, 0x61, 0x15, 0x0f, 0xca,
+	0x66, 0xd1, 0x2a, 0xb5, 0xff, 0x34, 0xd1, 0xaa, 0xe6, 0xc5, 0xb7, 0x11, 0xd2, 0x09, 0xf8, 0x24,
+	0xb4, 0x8d, 0x96, 0xd1, 0xa9, 0x78, 0x15, 0xad, 0xd9, 0x0f, 0xf1, 0x16, 0xba, 0xc6, 0x5e, 0x51,
+	0x48, 0xed, 0xa2, 0x42, 0x32, 0x01, 0xff, 0x80, 0xd6, 0xf2, 0x6c, 0x55, 0xd5, 0xec, 0xd5, 0x96,
+	0xd1, 0xa9, 0xee, 0xde, 0x77, 0xfe, 0xf9, 0x66, 0x9d, 0x4f, 0x1c, 0xa6, 0x5b, 0x7e, 0xfb, 0xbe,
+	0x59, 0xf0, 0x6a, 0xe3, 0xc5, 0x03, 0xde, 0x46, 0x68, 0x78, 0x12, 0x50, 0x0a, 0x91, 0x4c, 0xca,
+	0xcc, 0x92, 0xd2, 0x9a, 0xfd, 0x10, 0x7f, 0x8b, 0xcc, 0xbc, 0xf6, 0x76, 0x55, 0x45, 0x76, 0xbf,
+	0x30, 0x72, 0x4f, 0xbb, 0x79, 0x73, 0x02, 0x7c, 0x84, 0x6a, 0x8b, 0x95, 0xb7, 0x6b, 0x8a, 0xf0,
+	0xce, 0x32, 0x42, 0x7d, 0x86, 0x7d, 0x3a, 0x62, 0xfa, 0x08, 0xd5, 0xf1, 0x85, 0x0a, 0xdf, 0x41,
+	0x1b, 0x84, 0x12, 0x41, 0x82, 0xc8, 0xe7, 0xf0, 0xe3, 0x04, 0xe8, 0x10, 0x52, 0x7b, 0x4d, 0x1d,
+	0xc4, 0xd2, 0x40, 0x3f, 0xd7, 0xe3, 0x9f, 0x0c, 0x84, 0x63, 0x42, 0x2f, 0x2c, 0xfd, 0x01, 0xa3,
+	0xa1, 0xbd, 0xd5, 0x2a, 0x75, 0xaa, 0xbb, 0x37, 0x9d, 0xac, 0xaf, 0x1c, 0xd9, 0x57, 0x8e, 0xee,
+	0x2b, 0xe7, 0x11, 0x23, 0xb4, 0xdb, 0x93, 0x71, 0xff, 0x7a, 0xdf, 0xbc, 0x39, 0x0b, 0xe2, 0xe8,
+	0x61, 0xfb, 0x2a, 0x45, 0xfb, 0x97, 0x0f, 0xcd, 0xce, 0x98, 0x88, 0x93, 0xc9, 0xc0, 0x19, 0xb2,
+	0xd8, 0xd5, 0x1d, 0x9a, 0x7d, 0xee, 0xf1, 0xf0, 0xa5, 0x2b, 0x66, 0x09, 0x70, 0xc5, 0xc6, 0x3d,
+	0x2b, 0x26, 0x74, 0x9e, 0x54, 0x97, 0xd1, 0x10, 0x3f, 0x46, 0xab, 0xd3, 0xd8, 0x97, 0x36, 0x76,
+	0xbd, 0x65, 0x74, 0xea, 0xbb, 0xce, 0x17, 0xd6, 0xd9, 0x39, 0xee, 0x1d, 0xcd, 0x12, 0xf0, 0x56,
+	0xa6, 0xb1, 0xfc, 0xe2, 0x6d, 0x64, 0x46, 0xc1, 0x84, 0x0e, 0x4f, 0x20, 0xb4, 0xd7, 0x5b, 0x46,
+	0xc7, 0xf4, 0xe6, 0x32, 0x7e, 0x82, 0xd6, 0x93, 0x14, 0xfc, 0x4c, 0xf6, 0xe5, 0x54, 0xdb, 0x96,
+	0xba, 0x83, 0x6d, 0x27, 0x9b, 0x68, 0x27, 0x9f, 0x68, 0xe7, 0x28, 0x1f, 0xf9, 0x6e, 0xf9, 0xcd,
+	0x87, 0xa6, 0xe1, 0xad, 0x25, 0x29, 0x3c, 0x55, 0x7e, 0x12, 0x91, 0x73, 0x11, 0x91, 0xa9, 0xbc,
+	0x05, 0xee, 0xc3, 0x14, 0xa8, 0xc8, 0xe7, 0x62, 0xa3, 0x65, 0x74, 0x4a, 0xde, 0x66, 0x0e, 0xee,
+	0x49, 0x2c, 0x9b, 0x0b, 0xbc, 0x87, 0x9a, 0x73, 0x9f, 0x21, 0x9b, 0x50, 0x11, 0xb2, 0x57, 0x54,
+	0x76, 0x75, 0x3a, 0xf7, 0xc6, 0xca, 0xfb, 0x56, 0x6e, 0xf6, 0x28, 0xb7, 0xea, 0x4b, 0x23, 0x4d,
+	0xf3, 0x14, 0x55, 0x52, 0x98, 0x12, 0x59, 0x0b, 0x6e, 0x6f, 0xaa, 0x8b, 0xeb, 0x2c, 0xad, 0x95,
+	0x76, 0xd0, 0xfd, 0x73, 0x41, 0x20, 0xfb, 0x1f, 0x68, 0x30, 0x88, 0xc0, 0x17, 0x00, 0xf6, 0x75,
+	0x55, 0xb0, 0x4a, 0xa6, 0x39, 0x02, 0xc0, 0x23, 0x84, 0x93, 0x94, 0x25, 0x8c, 0x43, 0xea, 0x73,
+	0x88, 0x60, 0x28, 0x08, 0xa3, 0xf6, 0x0d, 0x55, 0xb4, 0x9d, 0x65, 0x51, 0x0f, 0xb5, 0x67, 0x3f,
+	0x77, 0xd4, 0xe1, 0x37, 0x92, 0xcb, 0x40, 0xfb, 0x2e, 0x5a, 0xc9, 0xee, 0x11, 0xaf, 0xa3, 0xea,
+	0x33, 0xca, 0x13, 0x18, 0x92, 0x11, 0x81, 0xd0, 0x2a, 0xe0, 0x55, 0x54, 0xda, 0x3b, 0xee, 0x59,
+	0x06, 0x36, 0x51, 0xf9, 0xf9, 0x57, 0xfd, 0x9e, 0xda, 0x2d, 0x25, 0x6b, 0xf5, 0xa0, 0x6c, 0x56,
+	0x2c, 0x74, 0x50, 0x36, 0x91, 0x55, 0x6d, 0xff, 0x5a, 0x44, 0x1b, 0x57, 0xc2, 0xe1, 0x63, 0x64,
+	0x72, 0x91, 0x06, 0x02, 0xc6, 0x33, 0xb5, 0x6f, 0xea, 0xbb, 0x0f, 0xff, 0x75, 0xce, 0x4e, 0x5f,
+	0x33, 0x78, 0x73, 0x2e, 0xfc, 0x7f, 0xb4, 0x96, 0xa4, 0x84, 0xa5, 0x44, 0xcc, 0xfc, 0x88, 0x70,
+	0x61, 0x17, 0x5b, 0xa5, 0x4e, 0xc5, 0xab, 0xe5, 0xca, 0xa7, 0x84, 0xcb, 0x7b, 0x5a, 0x4f, 0x99,
+	0x50, 0xcf, 0x82, 0x9f, 0x40, 0x4a, 0x58, 0xa8, 0x96, 0xa6, 0x1c, 0xb3, 0xcb, 0xcd, 0xf6, 0xb5,
+	0x7e, 0x3e, 0xba, 0xa6, 0xac, 0xcf, 0xcf, 0xb2, 0xdf, 0xea, 0xb9, 0xef, 0xa1, 0x72, 0x6d, 0x7f,
+	0x8f, 0xcc, 0x3c, 0x11, 0xbc, 0x86, 0x2a, 0x3d, 0xc6, 0x45, 0x5f, 0x04, 0x2f, 0xc1, 0x2a, 0x60,
+	0x8c, 0xea, 0xcf, 0x55, 0x6b, 0x40, 0xe8, 0x05, 0x34, 0x64, 0xb1, 0x65, 0xe0, 0x3a, 0x42, 0x1e,
+	0x9b, 0xd0, 0xd0, 0x63, 0x03, 0x42, 0xad, 0x22, 0xb6, 0x50, 0xed, 0x70, 0x21, 0x39, 0xab, 0xd4,
+	0xde, 0x43, 0x66, 0xde, 0x15, 0xf8, 0x06, 0x5a, 0xa1, 0x93, 0x78, 0x00, 0xa9, 0xbd, 0xa9, 0xd6,
+	0xba, 0x96, 0xf0, 0xff, 0x50, 0xed, 0xa3, 0xf6, 0xdc, 0x52, 0x68, 0x95, 0x5f, 0x74, 0x63, 0xfb,
+	0xb7, 0x22, 0xaa, 0xeb, 0x49, 0xec, 0x4f, 0xe2, 0x38, 0x48, 0x67, 0xf8, 0x16, 0xba, 0xd8, 0xea,
+	0x57, 0xd7, 0xfc, 0x0b, 0x64, 0x45, 0x81, 0x00, 0x95, 0xbe, 0x80, 0x7d, 0x1a, 0xc2, 0xa9, 0xda,
+	0xf8, 0xd5, 0xe5, 0x13, 0xaf, 0x3d, 0x46, 0x4c, 0x79, 0x79, 0x57, 0x78, 0x70, 0x84, 0x6e, 0x66,
+	0xba, 0x6f, 0x08, 0x0d, 0x22, 0xf2, 0x1a, 0xc2, 0x85, 0x20, 0xa5, 0xff, 0x14, 0xe4, 0xf3, 0x84,
+	0xb8, 0x8d, 0x6a, 0x19, 0x98, 0x95, 0xc2, 0x2e, 0xab, 0xea, 0x7c, 0xa4, 0xc3, 0x0f, 0xd0, 0xf5,
+	0x4b, 0x04, 0xda, 0xf8, 0x9a, 0x32, 0xfe, 0x34, 0xd8, 0xfd, 0xee, 0xed, 0x59, 0xc3, 0x78, 0x77,
+	0xd6, 0x30, 0xfe, 0x38, 0x6b, 0x18, 0x6f, 0xce, 0x1b, 0x85, 0x77, 0xe7, 0x8d, 0xc2, 0xef, 0xe7,
+	0x8d, 0xc2, 0x8b, 0x07, 0x0b, 0x2b, 0xf6, 0x33, 0x8f, 0xfc, 0xf4, 0xbe, 0x7b, 0x3a, 0x7f, 0xe9,
+	0xd5, 0xd2, 0x1d, 0xac, 0xa8, 0x4e, 0xbb, 0xff, 0xf7, 0x00, 0xd6, 0xd5, 0xd4, 0xb1, 0x3d, 0x09,
+	0x00, 0x00,
 }
 
 func (m *RollappGenesisState) Marshal() (dAtA []byte, err error) {
@@ -550,6 +682,18 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.ProposerSelection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRollapp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
 	if m.EnableTee {
 		i--
 		if m.EnableTee {
@@ -609,12 +753,12 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x88
 	}
 	if m.PreLaunchTime != nil {
-		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreLaunchTime):])
-		if err1 != nil {
-			return 0, err1
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.PreLaunchTime):])
+		if err2 != nil {
+			return 0, err2
 		}
-		i -= n1
-		i = encodeVarintRollapp(dAtA, i, uint64(n1))
+		i -= n2
+		i = encodeVarintRollapp(dAtA, i, uint64(n2))
 		i--
 		dAtA[i] = 0x1
 		i--
@@ -698,6 +842,51 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposerSelection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerSelection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerSelection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RotationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RotationPeriod):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintRollapp(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.PriorityList) > 0 {
+		for iNdEx := len(m.PriorityList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PriorityList[iNdEx])
+			copy(dAtA[i:], m.PriorityList[iNdEx])
+			i = encodeVarintRollapp(dAtA, i, uint64(len(m.PriorityList[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Strategy != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.Strategy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Revision) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -883,6 +1072,28 @@ func (m *Rollapp) Size() (n int) {
 	if m.EnableTee {
 		n += 3
 	}
+	l = m.ProposerSelection.Size()
+	n += 2 + l + sovRollapp(uint64(l))
+	return n
+}
+
+func (m *ProposerSelection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strategy != 0 {
+		n += 1 + sovRollapp(uint64(m.Strategy))
+	}
+	if len(m.PriorityList) > 0 {
+		for _, s := range m.PriorityList {
+			l = len(s)
+			n += 1 + l + sovRollapp(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RotationPeriod)
+	n += 1 + l + sovRollapp(uint64(l))
 	return n
 }
 
@@ -1463,6 +1674,173 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableTee = bool(v != 0)
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposerSelection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRollapp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProposerSelection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRollapp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerSelection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerSelection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			m.Strategy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strategy |= ProposerSelection_Strategy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriorityList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriorityList = append(m.PriorityList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RotationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
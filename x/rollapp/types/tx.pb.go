@@ -1204,7 +1204,7 @@ type TEENonce struct {
 	FinalizedHeight uint64 `protobuf:"varint,3,opt,name=finalized_height,json=finalizedHeight,proto3" json:"finalized_height,omitempty"`
 	// height TEE recently validated
 	CurrHeight uint64 `protobuf:"varint,4,opt,name=curr_height,json=currHeight,proto3" json:"curr_height,omitempty"`
-	// // stateRoot/appHash is a 32 byte array of the hash of the block
+	//// stateRoot/appHash is a 32 byte array of the hash of the block
 	StateRoot []byte `protobuf:"bytes,5,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
 }
 
@@ -1412,6 +1412,104 @@ func (m *MsgToggleTEEResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgToggleTEEResponse proto.InternalMessageInfo
 
+// MsgUpdateProposerSelection sets how the proposers of a rollapp are chosen
+type MsgUpdateProposerSelection struct {
+	// owner is the bech32-encoded address of the rollapp owner
+	Owner             string            `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	RollappId         string            `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	ProposerSelection ProposerSelection `protobuf:"bytes,3,opt,name=proposer_selection,json=proposerSelection,proto3" json:"proposer_selection"`
+}
+
+func (m *MsgUpdateProposerSelection) Reset()         { *m = MsgUpdateProposerSelection{} }
+func (m *MsgUpdateProposerSelection) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProposerSelection) ProtoMessage()    {}
+func (*MsgUpdateProposerSelection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{23}
+}
+func (m *MsgUpdateProposerSelection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProposerSelection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProposerSelection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateProposerSelection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProposerSelection.Merge(m, src)
+}
+func (m *MsgUpdateProposerSelection) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProposerSelection) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProposerSelection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProposerSelection proto.InternalMessageInfo
+
+func (m *MsgUpdateProposerSelection) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgUpdateProposerSelection) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgUpdateProposerSelection) GetProposerSelection() ProposerSelection {
+	if m != nil {
+		return m.ProposerSelection
+	}
+	return ProposerSelection{}
+}
+
+type MsgUpdateProposerSelectionResponse struct {
+}
+
+func (m *MsgUpdateProposerSelectionResponse) Reset()         { *m = MsgUpdateProposerSelectionResponse{} }
+func (m *MsgUpdateProposerSelectionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProposerSelectionResponse) ProtoMessage()    {}
+func (*MsgUpdateProposerSelectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1a86300fb8647ecb, []int{24}
+}
+func (m *MsgUpdateProposerSelectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateProposerSelectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateProposerSelectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateProposerSelectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProposerSelectionResponse.Merge(m, src)
+}
+func (m *MsgUpdateProposerSelectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateProposerSelectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProposerSelectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateProposerSelectionResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateParamsResponse")
@@ -1436,6 +1534,8 @@ func init() {
 	proto.RegisterType((*MsgFastFinalizeWithTEEResponse)(nil), "dymensionxyz.dymension.rollapp.MsgFastFinalizeWithTEEResponse")
 	proto.RegisterType((*MsgToggleTEE)(nil), "dymensionxyz.dymension.rollapp.MsgToggleTEE")
 	proto.RegisterType((*MsgToggleTEEResponse)(nil), "dymensionxyz.dymension.rollapp.MsgToggleTEEResponse")
+	proto.RegisterType((*MsgUpdateProposerSelection)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateProposerSelection")
+	proto.RegisterType((*MsgUpdateProposerSelectionResponse)(nil), "dymensionxyz.dymension.rollapp.MsgUpdateProposerSelectionResponse")
 }
 
 func init() {
@@ -1443,101 +1543,105 @@ func init() {
 }
 
 var fileDescriptor_1a86300fb8647ecb = []byte{
-	// 1494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xc6, 0x8e, 0x63, 0x3f, 0x3b, 0x89, 0xb3, 0x8d, 0xd2, 0x8d, 0xdb, 0x3a, 0xa9, 0xab,
-	0xef, 0x97, 0xb4, 0x69, 0xed, 0x26, 0x2d, 0x05, 0x05, 0x04, 0xca, 0xaf, 0xfe, 0x00, 0xb9, 0x2d,
-	0xdb, 0x50, 0x24, 0x2e, 0xd6, 0xda, 0x3b, 0x59, 0x6f, 0xeb, 0x9d, 0x31, 0x3b, 0x63, 0x37, 0x29,
-	0x17, 0xe0, 0xc2, 0x01, 0x21, 0xf5, 0xc6, 0x05, 0x09, 0xfe, 0x84, 0x1e, 0x10, 0x37, 0xae, 0xa8,
-	0x17, 0xa4, 0x8a, 0x13, 0x5c, 0x2a, 0xd4, 0x1e, 0x7a, 0xe7, 0x2f, 0x40, 0x33, 0x3b, 0x3b, 0x76,
-	0x6c, 0x27, 0xde, 0x04, 0x4e, 0xde, 0x79, 0xf3, 0x7e, 0xbf, 0xcf, 0xbc, 0xf7, 0x64, 0x78, 0xc3,
-	0xde, 0xf3, 0x10, 0xa6, 0x2e, 0xc1, 0xbb, 0x7b, 0x8f, 0x4b, 0xea, 0x50, 0xf2, 0x49, 0xa3, 0x61,
-	0x35, 0x9b, 0x25, 0xb6, 0x5b, 0x6c, 0xfa, 0x84, 0x11, 0x3d, 0xdf, 0xcd, 0x58, 0x54, 0x87, 0xa2,
-	0x64, 0xcc, 0xe5, 0x6b, 0x84, 0x7a, 0x84, 0x96, 0xaa, 0x16, 0x45, 0xa5, 0xf6, 0x72, 0x15, 0x31,
-	0x6b, 0xb9, 0x54, 0x23, 0x2e, 0x0e, 0xe4, 0x73, 0x27, 0xe5, 0xbd, 0x47, 0x9d, 0x52, 0x7b, 0x99,
-	0xff, 0xc8, 0x8b, 0xb9, 0xe0, 0xa2, 0x22, 0x4e, 0xa5, 0xe0, 0x20, 0xaf, 0xde, 0x1c, 0xe2, 0x5c,
-	0xb5, 0x41, 0x6a, 0x0f, 0x2b, 0x36, 0xa2, 0x35, 0xdf, 0x6d, 0x32, 0xe2, 0x4b, 0xb1, 0xe5, 0x21,
-	0x62, 0x0e, 0xc2, 0x88, 0xba, 0xb4, 0xe2, 0xe2, 0x1d, 0x22, 0x45, 0x2e, 0x0d, 0x11, 0xf1, 0x10,
-	0xb3, 0x6c, 0x8b, 0x59, 0x92, 0x7d, 0x69, 0x08, 0x7b, 0xd3, 0xf2, 0x2d, 0x2f, 0x8c, 0xe2, 0xe2,
-	0x10, 0x66, 0xf9, 0x2b, 0xb9, 0x67, 0x1c, 0xe2, 0x90, 0x20, 0x17, 0xfc, 0x2b, 0xa0, 0x16, 0x7e,
-	0xd0, 0x60, 0xaa, 0x4c, 0x9d, 0x8f, 0x9b, 0xb6, 0xc5, 0xd0, 0x5d, 0xa1, 0x5d, 0xbf, 0x06, 0x29,
-	0xab, 0xc5, 0xea, 0xc4, 0x77, 0xd9, 0x9e, 0xa1, 0x2d, 0x68, 0x8b, 0xa9, 0x75, 0xe3, 0xf7, 0x9f,
-	0x2e, 0xcd, 0xc8, 0x14, 0xae, 0xd9, 0xb6, 0x8f, 0x28, 0xbd, 0xc7, 0x7c, 0x17, 0x3b, 0x66, 0x87,
-	0x55, 0xdf, 0x84, 0x44, 0xe0, 0x9f, 0x31, 0xba, 0xa0, 0x2d, 0xa6, 0x57, 0xfe, 0x5f, 0x3c, 0xbc,
-	0xb4, 0xc5, 0xc0, 0xde, 0x7a, 0xfc, 0xd9, 0x8b, 0xf9, 0x11, 0x53, 0xca, 0xae, 0x4e, 0x7e, 0xf5,
-	0xfa, 0xe9, 0x85, 0x8e, 0xd6, 0xc2, 0x1c, 0x9c, 0xec, 0x71, 0xd0, 0x44, 0xb4, 0x49, 0x30, 0x45,
-	0x85, 0xef, 0xe2, 0x90, 0x2d, 0x53, 0x67, 0xc3, 0x47, 0x16, 0x43, 0x66, 0xa0, 0x54, 0x37, 0x60,
-	0xbc, 0xc6, 0x09, 0xc4, 0x0f, 0x7c, 0x37, 0xc3, 0xa3, 0x7e, 0x06, 0x40, 0x5a, 0xae, 0xb8, 0xb6,
-	0xf0, 0x31, 0x65, 0xa6, 0x24, 0xe5, 0x96, 0xad, 0x2f, 0xc1, 0xb4, 0x8b, 0x5d, 0xe6, 0x5a, 0x8d,
-	0x0a, 0x45, 0x9f, 0xb5, 0x10, 0xae, 0x21, 0xdf, 0x48, 0x0b, 0xae, 0xac, 0xbc, 0xb8, 0x17, 0xd2,
-	0xf5, 0x07, 0xa0, 0x7b, 0x2e, 0xee, 0x30, 0x56, 0xaa, 0x04, 0xdb, 0x46, 0x56, 0xc4, 0x3d, 0x57,
-	0x94, 0x99, 0xe2, 0x90, 0x2d, 0x4a, 0xc8, 0x16, 0x37, 0x88, 0x8b, 0xd7, 0xcf, 0xf2, 0x50, 0xff,
-	0x7e, 0x31, 0x3f, 0xb7, 0x67, 0x79, 0x8d, 0xd5, 0x42, 0xbf, 0x8a, 0x82, 0x99, 0xf5, 0x5c, 0xac,
-	0xec, 0xac, 0x13, 0x6c, 0xeb, 0x33, 0x30, 0x66, 0x35, 0x5c, 0x8b, 0x1a, 0x19, 0xe1, 0x4c, 0x70,
-	0xd0, 0x3f, 0x84, 0x64, 0x08, 0x1e, 0x63, 0x42, 0xd8, 0x2d, 0x0d, 0xcb, 0xb7, 0x4c, 0x51, 0x59,
-	0x8a, 0x99, 0x4a, 0x81, 0xbe, 0x0d, 0x99, 0x6e, 0xf0, 0x1a, 0x93, 0x42, 0xe1, 0xd2, 0x30, 0x85,
-	0x37, 0x02, 0x99, 0x5b, 0x78, 0x87, 0x88, 0x2a, 0x6a, 0x66, 0xda, 0xe9, 0x90, 0xf4, 0x1b, 0x30,
-	0xde, 0xf6, 0x2a, 0x6c, 0xaf, 0x89, 0x8c, 0xa9, 0x05, 0x6d, 0x71, 0x72, 0xa5, 0x18, 0xd1, 0xc3,
-	0xe2, 0xfd, 0xf2, 0xf6, 0x5e, 0x13, 0x99, 0x89, 0xb6, 0xc7, 0x7f, 0xf5, 0x53, 0x90, 0xda, 0x41,
-	0xa8, 0x62, 0x23, 0x4c, 0x3c, 0x63, 0x5a, 0x64, 0x21, 0xb9, 0x83, 0xd0, 0x26, 0x3f, 0xaf, 0x66,
-	0x38, 0x60, 0xc2, 0x22, 0x7f, 0x10, 0x4f, 0xc6, 0xb2, 0xe9, 0x42, 0x0e, 0x8c, 0x5e, 0x60, 0x28,
-	0xd4, 0xfc, 0x18, 0x83, 0x53, 0x0a, 0x51, 0xf2, 0x92, 0xbb, 0xeb, 0x7b, 0x16, 0x73, 0x09, 0xe6,
-	0xe9, 0x26, 0x8f, 0x30, 0x0a, 0xe1, 0x13, 0x1c, 0x8e, 0x05, 0x9e, 0xd8, 0x91, 0xc0, 0x33, 0x1e,
-	0x05, 0x3c, 0xda, 0x51, 0xc1, 0xf3, 0x51, 0x17, 0x4c, 0xc6, 0x8e, 0x05, 0x13, 0x59, 0xd9, 0x83,
-	0xc1, 0x92, 0xf8, 0x2f, 0xc0, 0xb2, 0x0a, 0xbc, 0x8c, 0x41, 0xb2, 0x0b, 0xff, 0x83, 0x73, 0x87,
-	0x54, 0x48, 0x55, 0xf2, 0x97, 0x51, 0x98, 0x54, 0x7c, 0xf7, 0x98, 0xc5, 0xd0, 0x21, 0xaf, 0xff,
-	0x34, 0x74, 0xca, 0xd5, 0x5f, 0xbf, 0x05, 0x48, 0x53, 0x66, 0xf9, 0xec, 0x26, 0x72, 0x9d, 0x3a,
-	0x13, 0x95, 0x8b, 0x9b, 0xdd, 0x24, 0x2e, 0x8f, 0x5b, 0xde, 0x3a, 0x9f, 0x0c, 0xd4, 0x88, 0x8b,
-	0xfb, 0x0e, 0x41, 0x9f, 0x85, 0xc4, 0xe6, 0xda, 0x5d, 0x8b, 0xd5, 0x45, 0x92, 0x53, 0xa6, 0x3c,
-	0xe9, 0x37, 0x21, 0xb6, 0xbe, 0x49, 0x65, 0x6d, 0x2f, 0x0f, 0x4b, 0x91, 0x50, 0xb6, 0xa9, 0xc6,
-	0x4e, 0xd8, 0x1a, 0xb9, 0x0a, 0x5d, 0x87, 0x78, 0xc3, 0xa2, 0xcc, 0x48, 0x2e, 0x68, 0x8b, 0x49,
-	0x53, 0x7c, 0xeb, 0xe7, 0x21, 0x1b, 0x82, 0xd2, 0x47, 0x6d, 0x97, 0xeb, 0x32, 0x52, 0xc2, 0xb5,
-	0x29, 0x3f, 0x44, 0x7d, 0x40, 0xee, 0x7b, 0x25, 0x89, 0xec, 0x78, 0xc1, 0x80, 0xd9, 0xfd, 0xe9,
-	0x53, 0x99, 0xfd, 0x46, 0x83, 0x99, 0x32, 0x75, 0xb6, 0x7d, 0x0b, 0xd3, 0x1d, 0xe4, 0xdf, 0xe1,
-	0x55, 0xa1, 0x75, 0xb7, 0xa9, 0x9f, 0x83, 0x89, 0x5a, 0xcb, 0xf7, 0x11, 0x66, 0x95, 0xee, 0x47,
-	0x92, 0x91, 0x44, 0xc1, 0xc8, 0x9f, 0x2b, 0x46, 0x8f, 0x24, 0x43, 0x90, 0xea, 0x24, 0x46, 0x8f,
-	0xee, 0x0c, 0x78, 0x48, 0xb1, 0x9e, 0x42, 0xac, 0xea, 0xdc, 0xcf, 0xfd, 0x36, 0x0a, 0x79, 0x38,
-	0x3d, 0xc8, 0x19, 0xe5, 0xed, 0xaf, 0x1a, 0xa4, 0xca, 0xd4, 0x59, 0xb3, 0xed, 0xb5, 0x43, 0x07,
-	0x80, 0x0e, 0x71, 0x6c, 0x79, 0x48, 0xba, 0x24, 0xbe, 0x87, 0xb8, 0xc3, 0x71, 0x11, 0xae, 0x01,
-	0x3c, 0xb9, 0x71, 0x71, 0xdf, 0x4d, 0xe2, 0xed, 0xc2, 0xf5, 0x2c, 0x07, 0xc9, 0xc2, 0x07, 0x07,
-	0x3d, 0x0b, 0xb1, 0x96, 0xdf, 0x10, 0x4f, 0x23, 0x65, 0xf2, 0x4f, 0xd1, 0x56, 0x7c, 0x1b, 0xf9,
-	0x02, 0x0b, 0x63, 0x66, 0x70, 0xd8, 0x5f, 0x96, 0xc2, 0x09, 0x98, 0x56, 0x71, 0xa8, 0xe8, 0xfe,
-	0xd4, 0x20, 0xa3, 0xca, 0x74, 0x78, 0x80, 0x93, 0x30, 0x2a, 0x9b, 0x53, 0xdc, 0x1c, 0x75, 0x6d,
-	0x15, 0x70, 0xec, 0xc0, 0x80, 0xe3, 0x43, 0x02, 0x1e, 0x3b, 0x24, 0xe0, 0xc4, 0x80, 0x80, 0xc7,
-	0x07, 0x04, 0x9c, 0x3c, 0x38, 0xe0, 0x59, 0x01, 0x33, 0x15, 0x9a, 0x8a, 0x19, 0x89, 0x90, 0x4d,
-	0xe4, 0x91, 0xf6, 0x11, 0x43, 0x1e, 0x02, 0xaf, 0x41, 0xe6, 0x95, 0x19, 0x65, 0xfe, 0x81, 0xd8,
-	0x39, 0xca, 0x96, 0xff, 0xf0, 0x4e, 0x95, 0x92, 0x06, 0x52, 0x5d, 0x88, 0xf2, 0x36, 0xd0, 0xb3,
-	0x1c, 0x75, 0xaf, 0x40, 0x67, 0x21, 0x63, 0xfb, 0xb4, 0xd2, 0x46, 0x3e, 0x7f, 0x74, 0x7c, 0x11,
-	0x8a, 0x2d, 0x4e, 0x98, 0x69, 0xdb, 0xa7, 0xf7, 0x25, 0xa9, 0x6f, 0xbf, 0x39, 0x0b, 0xf3, 0x07,
-	0xd8, 0x52, 0xee, 0xfc, 0xa6, 0x89, 0x87, 0x7a, 0xdd, 0xa2, 0xec, 0xba, 0x8b, 0xad, 0x86, 0xfb,
-	0x18, 0x7d, 0xe2, 0xb2, 0xfa, 0xf6, 0xd6, 0x96, 0xbe, 0xd2, 0x93, 0x98, 0x43, 0x36, 0x35, 0x95,
-	0xb2, 0x25, 0x98, 0xb6, 0x18, 0x43, 0x94, 0x89, 0x6e, 0x5a, 0x61, 0xe4, 0x21, 0x0a, 0x91, 0x9d,
-	0xed, 0xba, 0xd8, 0xe6, 0x74, 0x7d, 0x13, 0xc6, 0x30, 0xc1, 0x35, 0x24, 0x87, 0xc7, 0xe2, 0xb0,
-	0x16, 0xb6, 0xbd, 0xb5, 0x75, 0x9b, 0xf3, 0xcb, 0xd6, 0x15, 0x08, 0xf7, 0xa4, 0xfd, 0x67, 0x0d,
-	0x92, 0x21, 0x5f, 0x4f, 0xc1, 0xb4, 0x7e, 0x3c, 0x66, 0xea, 0xad, 0x6a, 0xa5, 0x56, 0xb7, 0x5c,
-	0xdc, 0x99, 0xbc, 0x50, 0x6f, 0x55, 0x37, 0x38, 0xe9, 0x96, 0xcd, 0x9b, 0xe0, 0x8e, 0xcc, 0x8a,
-	0x5d, 0xa9, 0x77, 0xf7, 0xef, 0x29, 0x45, 0x97, 0x3d, 0x7c, 0x1e, 0xd2, 0xbc, 0xb3, 0x84, 0x5c,
-	0x41, 0x17, 0x07, 0x4e, 0x92, 0x0c, 0x67, 0x00, 0x78, 0xf4, 0xa8, 0xe2, 0x13, 0xc2, 0x44, 0xc8,
-	0x19, 0x33, 0x25, 0x28, 0x26, 0x21, 0xac, 0xb0, 0x00, 0xf9, 0xc1, 0x75, 0x50, 0xa5, 0x72, 0x04,
-	0x70, 0xb7, 0x89, 0xe3, 0x34, 0x10, 0xaf, 0xcf, 0xb1, 0x96, 0x89, 0x59, 0x48, 0x20, 0x6c, 0x55,
-	0x1b, 0xc1, 0xc3, 0x4d, 0x9a, 0xf2, 0xb4, 0x6f, 0x44, 0x06, 0xd0, 0x55, 0x86, 0x42, 0x07, 0x56,
-	0xbe, 0x4c, 0x43, 0xac, 0x4c, 0x1d, 0x7d, 0x17, 0x32, 0xfb, 0x96, 0xfa, 0xa1, 0x53, 0xbf, 0x67,
-	0xc9, 0xce, 0xbd, 0x75, 0x44, 0x81, 0xd0, 0x03, 0xfd, 0x73, 0x98, 0xd8, 0xbf, 0x91, 0x5f, 0x8e,
-	0xa0, 0x69, 0x9f, 0x44, 0xee, 0xed, 0xa3, 0x4a, 0x28, 0xe3, 0xdf, 0x6b, 0x60, 0x1c, 0xb8, 0xd9,
-	0xbd, 0x13, 0x39, 0xa4, 0x7e, 0xe1, 0xdc, 0xc6, 0xbf, 0x10, 0x56, 0xee, 0xb5, 0x20, 0xdd, 0xbd,
-	0xad, 0x14, 0x23, 0xeb, 0x14, 0xfc, 0xb9, 0x6b, 0x47, 0xe3, 0x57, 0x66, 0xbf, 0xd6, 0x60, 0xba,
-	0x7f, 0x96, 0x5f, 0x8d, 0xa0, 0xad, 0x4f, 0x2a, 0xf7, 0xee, 0x71, 0xa4, 0x94, 0x27, 0x3b, 0x90,
-	0x90, 0x63, 0xfa, 0x7c, 0x04, 0x3d, 0x01, 0x6b, 0x6e, 0x39, 0x32, 0xab, 0xb2, 0x43, 0x20, 0xd5,
-	0x19, 0x98, 0x17, 0x23, 0xa7, 0x8d, 0x5b, 0xbb, 0x7a, 0x14, 0xee, 0x6e, 0x83, 0x9d, 0x71, 0x15,
-	0xc5, 0xa0, 0xe2, 0x8e, 0x64, 0xb0, 0x6f, 0x46, 0xe9, 0x4f, 0xf8, 0x8a, 0x36, 0x68, 0x42, 0x45,
-	0x79, 0xb8, 0x83, 0x04, 0x73, 0xef, 0x1f, 0x53, 0x50, 0xb9, 0xf4, 0xad, 0x06, 0x27, 0x06, 0x0d,
-	0xa9, 0x28, 0xb0, 0x1d, 0x20, 0x97, 0x7b, 0xef, 0x78, 0x72, 0xdd, 0x35, 0xe9, 0x74, 0xe2, 0x28,
-	0x35, 0x51, 0xdc, 0x91, 0x6a, 0xd2, 0xd7, 0x7c, 0x73, 0x63, 0x5f, 0xbc, 0x7e, 0x7a, 0x41, 0x5b,
-	0xbf, 0xfd, 0xec, 0x65, 0x5e, 0x7b, 0xfe, 0x32, 0xaf, 0xfd, 0xf5, 0x32, 0xaf, 0x3d, 0x79, 0x95,
-	0x1f, 0x79, 0xfe, 0x2a, 0x3f, 0xf2, 0xc7, 0xab, 0xfc, 0xc8, 0xa7, 0x57, 0x1d, 0x97, 0xd5, 0x5b,
-	0xd5, 0x62, 0x8d, 0x78, 0xa5, 0x03, 0xfe, 0xbd, 0x69, 0x5f, 0x29, 0xed, 0x76, 0xfe, 0x25, 0xdb,
-	0x6b, 0x22, 0x5a, 0x4d, 0x88, 0xff, 0x6a, 0xae, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0xde, 0xb0,
-	0xd4, 0xfe, 0x54, 0x13, 0x00, 0x00,
+	// 1563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x8e, 0x63, 0x3f, 0x3b, 0x89, 0xb3, 0x8d, 0xd2, 0xcd, 0xb6, 0x75, 0x52, 0x97,
+	0x8f, 0xb4, 0x69, 0xed, 0x26, 0x0d, 0x05, 0x05, 0x04, 0x8a, 0x93, 0xf4, 0x03, 0xe4, 0xb6, 0x6c,
+	0x42, 0x91, 0xb8, 0x58, 0x6b, 0xef, 0x78, 0xbd, 0xad, 0x77, 0xc7, 0xec, 0xac, 0xdd, 0xa4, 0x5c,
+	0x10, 0x17, 0x0e, 0x08, 0xa9, 0x37, 0x84, 0x04, 0x82, 0x3f, 0xa1, 0x07, 0xc4, 0x8d, 0x23, 0xa8,
+	0x17, 0xa4, 0x8a, 0x13, 0x5c, 0x2a, 0xd4, 0x1e, 0x7a, 0xe7, 0x2f, 0x40, 0x33, 0x3b, 0x3b, 0xfe,
+	0x8e, 0x3f, 0xe0, 0xb4, 0x3b, 0x6f, 0xde, 0xf7, 0xfb, 0xcd, 0x9b, 0xb7, 0x0b, 0xaf, 0x1b, 0x47,
+	0x36, 0x72, 0x88, 0x85, 0x9d, 0xc3, 0xa3, 0x87, 0x59, 0xb1, 0xc8, 0xba, 0xb8, 0x5a, 0xd5, 0x6b,
+	0xb5, 0xac, 0x77, 0x98, 0xa9, 0xb9, 0xd8, 0xc3, 0x72, 0xaa, 0x95, 0x31, 0x23, 0x16, 0x19, 0xce,
+	0xa8, 0xa6, 0x4a, 0x98, 0xd8, 0x98, 0x64, 0x8b, 0x3a, 0x41, 0xd9, 0xc6, 0x7a, 0x11, 0x79, 0xfa,
+	0x7a, 0xb6, 0x84, 0x2d, 0xc7, 0x97, 0x57, 0x4f, 0xf2, 0x7d, 0x9b, 0x98, 0xd9, 0xc6, 0x3a, 0x7d,
+	0xf0, 0x8d, 0x25, 0x7f, 0xa3, 0xc0, 0x56, 0x59, 0x7f, 0xc1, 0xb7, 0xde, 0x18, 0xe0, 0x5c, 0xb1,
+	0x8a, 0x4b, 0xf7, 0x0b, 0x06, 0x22, 0x25, 0xd7, 0xaa, 0x79, 0xd8, 0xe5, 0x62, 0xeb, 0x03, 0xc4,
+	0x4c, 0xe4, 0x20, 0x62, 0x91, 0x82, 0xe5, 0x94, 0x31, 0x17, 0xb9, 0x34, 0x40, 0xc4, 0x46, 0x9e,
+	0x6e, 0xe8, 0x9e, 0xce, 0xd9, 0xd7, 0x06, 0xb0, 0xd7, 0x74, 0x57, 0xb7, 0x83, 0x28, 0x2e, 0x0e,
+	0x60, 0xe6, 0x4f, 0xce, 0xbd, 0x60, 0x62, 0x13, 0xfb, 0xb9, 0xa0, 0x6f, 0x3e, 0x35, 0xfd, 0x83,
+	0x04, 0x73, 0x79, 0x62, 0x7e, 0x54, 0x33, 0x74, 0x0f, 0xdd, 0x61, 0xda, 0xe5, 0xab, 0x10, 0xd3,
+	0xeb, 0x5e, 0x05, 0xbb, 0x96, 0x77, 0xa4, 0x48, 0x2b, 0xd2, 0x6a, 0x2c, 0xa7, 0xfc, 0xf1, 0xd3,
+	0xa5, 0x05, 0x9e, 0xc2, 0x6d, 0xc3, 0x70, 0x11, 0x21, 0xfb, 0x9e, 0x6b, 0x39, 0xa6, 0xd6, 0x64,
+	0x95, 0x77, 0x21, 0xe2, 0xfb, 0xa7, 0x4c, 0xae, 0x48, 0xab, 0xf1, 0x8d, 0xd7, 0x32, 0xc7, 0x97,
+	0x36, 0xe3, 0xdb, 0xcb, 0x85, 0x9f, 0x3c, 0x5b, 0x9e, 0xd0, 0xb8, 0xec, 0xd6, 0xec, 0x17, 0x2f,
+	0x1f, 0x5f, 0x68, 0x6a, 0x4d, 0x2f, 0xc1, 0xc9, 0x0e, 0x07, 0x35, 0x44, 0x6a, 0xd8, 0x21, 0x28,
+	0xfd, 0x4d, 0x18, 0x92, 0x79, 0x62, 0xee, 0xb8, 0x48, 0xf7, 0x90, 0xe6, 0x2b, 0x95, 0x15, 0x98,
+	0x2e, 0x51, 0x02, 0x76, 0x7d, 0xdf, 0xb5, 0x60, 0x29, 0x9f, 0x01, 0xe0, 0x96, 0x0b, 0x96, 0xc1,
+	0x7c, 0x8c, 0x69, 0x31, 0x4e, 0xb9, 0x69, 0xc8, 0x6b, 0x30, 0x6f, 0x39, 0x96, 0x67, 0xe9, 0xd5,
+	0x02, 0x41, 0x9f, 0xd6, 0x91, 0x53, 0x42, 0xae, 0x12, 0x67, 0x5c, 0x49, 0xbe, 0xb1, 0x1f, 0xd0,
+	0xe5, 0x7b, 0x20, 0xdb, 0x96, 0xd3, 0x64, 0x2c, 0x14, 0xb1, 0x63, 0x28, 0x49, 0x16, 0xf7, 0x52,
+	0x86, 0x67, 0x8a, 0x42, 0x36, 0xc3, 0x21, 0x9b, 0xd9, 0xc1, 0x96, 0x93, 0x3b, 0x4b, 0x43, 0xfd,
+	0xe7, 0xd9, 0xf2, 0xd2, 0x91, 0x6e, 0x57, 0xb7, 0xd2, 0xdd, 0x2a, 0xd2, 0x5a, 0xd2, 0xb6, 0x1c,
+	0x61, 0x27, 0x87, 0x1d, 0x43, 0x5e, 0x80, 0x29, 0xbd, 0x6a, 0xe9, 0x44, 0x49, 0x30, 0x67, 0xfc,
+	0x85, 0xfc, 0x01, 0x44, 0x03, 0xf0, 0x28, 0x33, 0xcc, 0x6e, 0x76, 0x50, 0xbe, 0x79, 0x8a, 0xf2,
+	0x5c, 0x4c, 0x13, 0x0a, 0xe4, 0x03, 0x48, 0xb4, 0x82, 0x57, 0x99, 0x65, 0x0a, 0xd7, 0x06, 0x29,
+	0xbc, 0xee, 0xcb, 0xdc, 0x74, 0xca, 0x98, 0x55, 0x51, 0xd2, 0xe2, 0x66, 0x93, 0x24, 0x5f, 0x87,
+	0xe9, 0x86, 0x5d, 0xf0, 0x8e, 0x6a, 0x48, 0x99, 0x5b, 0x91, 0x56, 0x67, 0x37, 0x32, 0x43, 0x7a,
+	0x98, 0xb9, 0x9b, 0x3f, 0x38, 0xaa, 0x21, 0x2d, 0xd2, 0xb0, 0xe9, 0x53, 0x3e, 0x05, 0xb1, 0x32,
+	0x42, 0x05, 0x03, 0x39, 0xd8, 0x56, 0xe6, 0x59, 0x16, 0xa2, 0x65, 0x84, 0x76, 0xe9, 0x7a, 0x2b,
+	0x41, 0x01, 0x13, 0x14, 0xf9, 0xfd, 0x70, 0x34, 0x94, 0x8c, 0xa7, 0x55, 0x50, 0x3a, 0x81, 0x21,
+	0x50, 0xf3, 0x63, 0x08, 0x4e, 0x09, 0x44, 0xf1, 0x4d, 0xea, 0xae, 0x6b, 0xeb, 0x9e, 0x85, 0x1d,
+	0x9a, 0x6e, 0xfc, 0xc0, 0x41, 0x01, 0x7c, 0xfc, 0xc5, 0x58, 0xe0, 0x09, 0x8d, 0x04, 0x9e, 0xe9,
+	0x61, 0xc0, 0x23, 0x8d, 0x0a, 0x9e, 0x0f, 0x5b, 0x60, 0x32, 0x35, 0x16, 0x4c, 0x78, 0x65, 0xfb,
+	0x83, 0x25, 0xf2, 0x7f, 0x80, 0x65, 0x0b, 0x68, 0x19, 0xfd, 0x64, 0xa7, 0x5f, 0x85, 0x73, 0xc7,
+	0x54, 0x48, 0x54, 0xf2, 0x97, 0x49, 0x98, 0x15, 0x7c, 0xfb, 0x9e, 0xee, 0xa1, 0x63, 0x4e, 0xff,
+	0x69, 0x68, 0x96, 0xab, 0xbb, 0x7e, 0x2b, 0x10, 0x27, 0x9e, 0xee, 0x7a, 0x37, 0x90, 0x65, 0x56,
+	0x3c, 0x56, 0xb9, 0xb0, 0xd6, 0x4a, 0xa2, 0xf2, 0x4e, 0xdd, 0xce, 0xd1, 0x9b, 0x81, 0x28, 0x61,
+	0xb6, 0xdf, 0x24, 0xc8, 0x8b, 0x10, 0xd9, 0xdd, 0xbe, 0xa3, 0x7b, 0x15, 0x96, 0xe4, 0x98, 0xc6,
+	0x57, 0xf2, 0x0d, 0x08, 0xe5, 0x76, 0x09, 0xaf, 0xed, 0xe5, 0x41, 0x29, 0x62, 0xca, 0x76, 0xc5,
+	0xb5, 0x13, 0xb4, 0x46, 0xaa, 0x42, 0x96, 0x21, 0x5c, 0xd5, 0x89, 0xa7, 0x44, 0x57, 0xa4, 0xd5,
+	0xa8, 0xc6, 0xde, 0xe5, 0xf3, 0x90, 0x0c, 0x40, 0xe9, 0xa2, 0x86, 0x45, 0x75, 0x29, 0x31, 0xe6,
+	0xda, 0x9c, 0x1b, 0xa0, 0xde, 0x27, 0x77, 0x9d, 0x92, 0x48, 0x72, 0x3a, 0xad, 0xc0, 0x62, 0x7b,
+	0xfa, 0x44, 0x66, 0xbf, 0x92, 0x60, 0x21, 0x4f, 0xcc, 0x03, 0x57, 0x77, 0x48, 0x19, 0xb9, 0xb7,
+	0x69, 0x55, 0x48, 0xc5, 0xaa, 0xc9, 0xe7, 0x60, 0xa6, 0x54, 0x77, 0x5d, 0xe4, 0x78, 0x85, 0xd6,
+	0x43, 0x92, 0xe0, 0x44, 0xc6, 0x48, 0x8f, 0xab, 0x83, 0x1e, 0x70, 0x06, 0x3f, 0xd5, 0x51, 0x07,
+	0x3d, 0xb8, 0xdd, 0xe3, 0x20, 0x85, 0x3a, 0x0a, 0xb1, 0x25, 0x53, 0x3f, 0xdb, 0x6d, 0xa4, 0x53,
+	0x70, 0xba, 0x97, 0x33, 0xc2, 0xdb, 0xdf, 0x24, 0x88, 0xe5, 0x89, 0xb9, 0x6d, 0x18, 0xdb, 0xc7,
+	0x5e, 0x00, 0x32, 0x84, 0x1d, 0xdd, 0x46, 0xdc, 0x25, 0xf6, 0x3e, 0xc0, 0x1d, 0x8a, 0x8b, 0x60,
+	0x0c, 0xa0, 0xc9, 0x0d, 0xb3, 0xfd, 0x56, 0x12, 0x6d, 0x17, 0x96, 0xad, 0x9b, 0x88, 0x17, 0xde,
+	0x5f, 0xc8, 0x49, 0x08, 0xd5, 0xdd, 0x2a, 0x3b, 0x1a, 0x31, 0x8d, 0xbe, 0x52, 0x3e, 0xec, 0x1a,
+	0xc8, 0x65, 0x58, 0x98, 0xd2, 0xfc, 0x45, 0x7b, 0x59, 0xd2, 0x27, 0x60, 0x5e, 0xc4, 0x21, 0xa2,
+	0xfb, 0x4b, 0x82, 0x84, 0x28, 0xd3, 0xf1, 0x01, 0xce, 0xc2, 0x24, 0x6f, 0x4e, 0x61, 0x6d, 0xd2,
+	0x32, 0x44, 0xc0, 0xa1, 0xbe, 0x01, 0x87, 0x07, 0x04, 0x3c, 0x75, 0x4c, 0xc0, 0x91, 0x1e, 0x01,
+	0x4f, 0xf7, 0x08, 0x38, 0xda, 0x3f, 0xe0, 0x45, 0x58, 0x68, 0x0d, 0x4d, 0xc4, 0x8c, 0x58, 0xc8,
+	0x1a, 0xb2, 0x71, 0x63, 0xc4, 0x90, 0x07, 0xc0, 0xab, 0x97, 0x79, 0x61, 0x46, 0x98, 0xbf, 0xc7,
+	0x66, 0x8e, 0xbc, 0xee, 0xde, 0xbf, 0x5d, 0x24, 0xb8, 0x8a, 0x44, 0x17, 0x22, 0xb4, 0x0d, 0x74,
+	0x0c, 0x47, 0xad, 0x23, 0xd0, 0x59, 0x48, 0x18, 0x2e, 0x29, 0x34, 0x90, 0x4b, 0x0f, 0x1d, 0x1d,
+	0x84, 0x42, 0xab, 0x33, 0x5a, 0xdc, 0x70, 0xc9, 0x5d, 0x4e, 0xea, 0x9a, 0x6f, 0xce, 0xc2, 0x72,
+	0x1f, 0x5b, 0xc2, 0x9d, 0xdf, 0x25, 0x76, 0x50, 0xaf, 0xe9, 0xc4, 0xbb, 0x66, 0x39, 0x7a, 0xd5,
+	0x7a, 0x88, 0x3e, 0xb6, 0xbc, 0xca, 0xc1, 0xde, 0x9e, 0xbc, 0xd1, 0x91, 0x98, 0x63, 0x26, 0x35,
+	0x91, 0xb2, 0x35, 0x98, 0xd7, 0x3d, 0x0f, 0x11, 0x8f, 0x75, 0xd3, 0x82, 0x87, 0xef, 0xa3, 0x00,
+	0xd9, 0xc9, 0x96, 0x8d, 0x03, 0x4a, 0x97, 0x77, 0x61, 0xca, 0xc1, 0x4e, 0x09, 0xf1, 0xcb, 0x63,
+	0x75, 0x50, 0x0b, 0x3b, 0xd8, 0xdb, 0xbb, 0x45, 0xf9, 0x79, 0xeb, 0xf2, 0x85, 0x3b, 0xd2, 0xfe,
+	0xb3, 0x04, 0xd1, 0x80, 0xaf, 0xa3, 0x60, 0x52, 0x37, 0x1e, 0x13, 0x95, 0x7a, 0xb1, 0x50, 0xaa,
+	0xe8, 0x96, 0xd3, 0xbc, 0x79, 0xa1, 0x52, 0x2f, 0xee, 0x50, 0xd2, 0x4d, 0x83, 0x36, 0xc1, 0x32,
+	0xcf, 0x8a, 0x51, 0xa8, 0xb4, 0xf6, 0xef, 0x39, 0x41, 0xe7, 0x3d, 0x7c, 0x19, 0xe2, 0xb4, 0xb3,
+	0x04, 0x5c, 0x7e, 0x17, 0x07, 0x4a, 0xe2, 0x0c, 0x67, 0x00, 0x68, 0xf4, 0xa8, 0xe0, 0x62, 0xec,
+	0xb1, 0x90, 0x13, 0x5a, 0x8c, 0x51, 0x34, 0x8c, 0xbd, 0xf4, 0x0a, 0xa4, 0x7a, 0xd7, 0x41, 0x94,
+	0xca, 0x64, 0xc0, 0x3d, 0xc0, 0xa6, 0x59, 0x45, 0xb4, 0x3e, 0x63, 0x0d, 0x13, 0x8b, 0x10, 0x41,
+	0x8e, 0x5e, 0xac, 0xfa, 0x07, 0x37, 0xaa, 0xf1, 0x55, 0xdb, 0x15, 0xe9, 0x43, 0x57, 0x18, 0x12,
+	0x0e, 0xfc, 0x2a, 0x81, 0xda, 0x9c, 0x97, 0x5d, 0x5c, 0xc3, 0x04, 0xb9, 0xfb, 0xa8, 0x8a, 0x4a,
+	0xe3, 0x0f, 0x37, 0x65, 0x90, 0x6b, 0x5c, 0x53, 0x81, 0x04, 0xaa, 0x98, 0x6f, 0xf1, 0x8d, 0xf5,
+	0x81, 0x43, 0x7e, 0xa7, 0x0f, 0x1c, 0x19, 0xf3, 0xb5, 0xce, 0x8d, 0xb6, 0xf8, 0x5e, 0x81, 0x74,
+	0xff, 0x30, 0x82, 0x68, 0x37, 0xbe, 0x4f, 0x40, 0x28, 0x4f, 0x4c, 0xf9, 0x10, 0x12, 0x6d, 0x9f,
+	0x30, 0x03, 0x67, 0x9c, 0x8e, 0x4f, 0x0a, 0xf5, 0xcd, 0x11, 0x05, 0x02, 0x0f, 0xe4, 0xcf, 0x60,
+	0xa6, 0xfd, 0xfb, 0xe3, 0xf2, 0x10, 0x9a, 0xda, 0x24, 0xd4, 0xb7, 0x46, 0x95, 0x10, 0xc6, 0xbf,
+	0x93, 0x40, 0xe9, 0x3b, 0xc7, 0xbe, 0x3d, 0x74, 0x48, 0xdd, 0xc2, 0xea, 0xce, 0x7f, 0x10, 0x16,
+	0xee, 0xd5, 0x21, 0xde, 0x3a, 0x9b, 0x65, 0x86, 0xd6, 0xc9, 0xf8, 0xd5, 0xab, 0xa3, 0xf1, 0x0b,
+	0xb3, 0x5f, 0x4a, 0x30, 0xdf, 0x3d, 0xb9, 0x6c, 0x0e, 0xa1, 0xad, 0x4b, 0x4a, 0x7d, 0x67, 0x1c,
+	0x29, 0xe1, 0x49, 0x19, 0x22, 0x7c, 0x28, 0x39, 0x3f, 0x84, 0x1e, 0x9f, 0x55, 0x5d, 0x1f, 0x9a,
+	0x55, 0xd8, 0xc1, 0x10, 0x6b, 0x8e, 0x07, 0x17, 0x87, 0x4e, 0x1b, 0xb5, 0xb6, 0x39, 0x0a, 0x77,
+	0xab, 0xc1, 0xe6, 0xe5, 0x3c, 0x8c, 0x41, 0xc1, 0xad, 0x6e, 0x8e, 0xc2, 0x2d, 0x0c, 0x3e, 0xa2,
+	0x03, 0x69, 0xaf, 0xfb, 0x78, 0x98, 0x83, 0xdb, 0x4b, 0x50, 0x7d, 0x6f, 0x4c, 0x41, 0xe1, 0xd2,
+	0xd7, 0x12, 0x9c, 0xe8, 0x75, 0x25, 0x0f, 0x03, 0xdb, 0x1e, 0x72, 0xea, 0xbb, 0xe3, 0xc9, 0xb5,
+	0xd6, 0xa4, 0x79, 0xef, 0x0c, 0x53, 0x13, 0xc1, 0xad, 0x6e, 0x8e, 0xc2, 0x2d, 0x0c, 0x7e, 0x2b,
+	0xc1, 0xc9, 0x7e, 0xf7, 0xcc, 0xd6, 0xf0, 0xfd, 0xb4, 0x53, 0x56, 0xcd, 0x8d, 0x2f, 0x1b, 0xf8,
+	0xa6, 0x4e, 0x7d, 0xfe, 0xf2, 0xf1, 0x05, 0x29, 0x77, 0xeb, 0xc9, 0xf3, 0x94, 0xf4, 0xf4, 0x79,
+	0x4a, 0xfa, 0xfb, 0x79, 0x4a, 0x7a, 0xf4, 0x22, 0x35, 0xf1, 0xf4, 0x45, 0x6a, 0xe2, 0xcf, 0x17,
+	0xa9, 0x89, 0x4f, 0x36, 0x4d, 0xcb, 0xab, 0xd4, 0x8b, 0x99, 0x12, 0xb6, 0xb3, 0x7d, 0xfe, 0xa3,
+	0x35, 0xae, 0x64, 0x0f, 0x9b, 0xff, 0x2b, 0x8f, 0x6a, 0x88, 0x14, 0x23, 0xec, 0xaf, 0xd9, 0x95,
+	0x7f, 0x07, 0x00, 0x4e, 0xb4, 0x25, 0x2d, 0xde, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1564,6 +1668,7 @@ type MsgClient interface {
 	MarkObsoleteRollapps(ctx context.Context, in *MsgMarkObsoleteRollapps, opts ...grpc.CallOption) (*MsgMarkObsoleteRollappsResponse, error)
 	FastFinalizeWithTEE(ctx context.Context, in *MsgFastFinalizeWithTEE, opts ...grpc.CallOption) (*MsgFastFinalizeWithTEEResponse, error)
 	ToggleTEE(ctx context.Context, in *MsgToggleTEE, opts ...grpc.CallOption) (*MsgToggleTEEResponse, error)
+	UpdateProposerSelection(ctx context.Context, in *MsgUpdateProposerSelection, opts ...grpc.CallOption) (*MsgUpdateProposerSelectionResponse, error)
 }
 
 type msgClient struct {
@@ -1673,6 +1778,15 @@ func (c *msgClient) ToggleTEE(ctx context.Context, in *MsgToggleTEE, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) UpdateProposerSelection(ctx context.Context, in *MsgUpdateProposerSelection, opts ...grpc.CallOption) (*MsgUpdateProposerSelectionResponse, error) {
+	out := new(MsgUpdateProposerSelectionResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.rollapp.Msg/UpdateProposerSelection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
@@ -1687,6 +1801,7 @@ type MsgServer interface {
 	MarkObsoleteRollapps(context.Context, *MsgMarkObsoleteRollapps) (*MsgMarkObsoleteRollappsResponse, error)
 	FastFinalizeWithTEE(context.Context, *MsgFastFinalizeWithTEE) (*MsgFastFinalizeWithTEEResponse, error)
 	ToggleTEE(context.Context, *MsgToggleTEE) (*MsgToggleTEEResponse, error)
+	UpdateProposerSelection(context.Context, *MsgUpdateProposerSelection) (*MsgUpdateProposerSelectionResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1726,6 +1841,9 @@ func (*UnimplementedMsgServer) FastFinalizeWithTEE(ctx context.Context, req *Msg
 func (*UnimplementedMsgServer) ToggleTEE(ctx context.Context, req *MsgToggleTEE) (*MsgToggleTEEResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleTEE not implemented")
 }
+func (*UnimplementedMsgServer) UpdateProposerSelection(ctx context.Context, req *MsgUpdateProposerSelection) (*MsgUpdateProposerSelectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProposerSelection not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1929,6 +2047,24 @@ func _Msg_ToggleTEE_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateProposerSelection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProposerSelection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProposerSelection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.rollapp.Msg/UpdateProposerSelection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProposerSelection(ctx, req.(*MsgUpdateProposerSelection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.rollapp.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1977,6 +2113,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleTEE",
 			Handler:    _Msg_ToggleTEE_Handler,
 		},
+		{
+			MethodName: "UpdateProposerSelection",
+			Handler:    _Msg_UpdateProposerSelection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/rollapp/tx.proto",
@@ -2962,6 +3102,76 @@ func (m *MsgToggleTEEResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgUpdateProposerSelection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProposerSelection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProposerSelection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ProposerSelection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProposerSelectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProposerSelectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProposerSelectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -3399,6 +3609,34 @@ func (m *MsgToggleTEEResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdateProposerSelection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ProposerSelection.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateProposerSelectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6359,6 +6597,203 @@ func (m *MsgToggleTEEResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgUpdateProposerSelection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProposerSelection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProposerSelection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerSelection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProposerSelection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProposerSelectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProposerSelectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProposerSelectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,6 +27,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetProposerByRollapp())
 	cmd.AddCommand(CmdGetNextProposerByRollapp())
 	cmd.AddCommand(CmdGetAllProposers())
+	cmd.AddCommand(CmdShowDelegationPool())
+	cmd.AddCommand(CmdListDelegations())
+	cmd.AddCommand(CmdShowDelegation())
+	cmd.AddCommand(CmdListUnbondings())
+	cmd.AddCommand(CmdShowPerformance())
 
 	return cmd
 }
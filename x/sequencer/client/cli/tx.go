@@ -35,6 +35,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDecreaseBond())
 	cmd.AddCommand(CmdKickProposer())
 	cmd.AddCommand(CmdUpdateOptInStatus())
+	cmd.AddCommand(CmdDelegate())
+	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdWithdrawDelegatorRewards())
+	cmd.AddCommand(CmdFundSequencerRewards())
+	cmd.AddCommand(CmdUpdateCommission())
 
 	return cmd
 }
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func CmdDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate [sequencer-address] [amount]",
+		Short: "Delegate tokens to a bonded sequencer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgDelegate{
+				Delegator: clientCtx.GetFromAddress().String(),
+				Sequencer: args[0],
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUndelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate [sequencer-address] [amount]",
+		Short: "Withdraw tokens delegated to a sequencer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUndelegate{
+				Delegator: clientCtx.GetFromAddress().String(),
+				Sequencer: args[0],
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdWithdrawDelegatorRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-delegator-rewards [sequencer-address]",
+		Short: "Withdraw the rewards of a delegation",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgWithdrawDelegatorRewards{
+				Delegator: clientCtx.GetFromAddress().String(),
+				Sequencer: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdFundSequencerRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-sequencer-rewards [sequencer-address] [amount]",
+		Short: "Pay rewards to a sequencer and its delegators",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgFundSequencerRewards{
+				Sender:    clientCtx.GetFromAddress().String(),
+				Sequencer: args[0],
+				Amount:    amount,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateCommission() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-commission [rate]",
+		Short:   "Set the part of the sequencer rewards kept by the operator",
+		Example: "update-commission 0.1 --from foouser",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			rate, err := math.LegacyNewDecFromStr(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateCommission{
+				Creator:        clientCtx.GetFromAddress().String(),
+				CommissionRate: rate,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
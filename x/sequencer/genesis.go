@@ -27,6 +27,44 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.GenesisSuccessors {
 		k.SetSuccessor(ctx, elem.RollappId, elem.Address)
 	}
+
+	for _, elem := range genState.DelegationPools {
+		if err := k.SetDelegationPool(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.Delegations {
+		if err := k.SetDelegation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+
+	var nextUnbondingID uint64
+	for _, elem := range genState.Unbondings {
+		if err := k.SetUnbonding(ctx, elem); err != nil {
+			panic(err)
+		}
+		nextUnbondingID = max(nextUnbondingID, elem.Id+1)
+	}
+	if err := k.SetNextUnbondingID(ctx, nextUnbondingID); err != nil {
+		panic(err)
+	}
+
+	for _, elem := range genState.LastChosenProposers {
+		if err := k.SetLastChosenProposer(ctx, elem.RollappId, elem.Address); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.ProposerTerms {
+		if err := k.SetProposerTerm(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.PerformanceHistories {
+		if err := k.SetPerformanceHistory(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
@@ -58,5 +96,30 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		genesis.NoticeQueue = append(genesis.NoticeQueue, seq.Address)
 	}
 
+	genesis.DelegationPools, err = k.AllDelegationPools(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Delegations, err = k.AllDelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Unbondings, err = k.AllUnbondings(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.LastChosenProposers, err = k.AllLastChosenProposers(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ProposerTerms, err = k.AllProposerTerms(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.PerformanceHistories, err = k.AllPerformanceHistories(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis
 }
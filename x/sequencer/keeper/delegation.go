@@ -0,0 +1,345 @@
+package keeper
+
+import (
+	"time"
+
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// GetDelegationPool returns the delegation pool of the sequencer, empty if
+// nobody delegated to it yet.
+func (k Keeper) GetDelegationPool(ctx sdk.Context, seqAddr string) (types.DelegationPool, error) {
+	pool, err := k.delegationPools.Get(ctx, seqAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewDelegationPool(seqAddr), nil
+	}
+	return pool, err
+}
+
+func (k Keeper) SetDelegationPool(ctx sdk.Context, pool types.DelegationPool) error {
+	return k.delegationPools.Set(ctx, pool.Sequencer, pool)
+}
+
+func (k Keeper) AllDelegationPools(ctx sdk.Context) ([]types.DelegationPool, error) {
+	iter, err := k.delegationPools.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	return iter.Values()
+}
+
+func (k Keeper) GetDelegation(ctx sdk.Context, seqAddr, delegator string) (types.Delegation, error) {
+	d, err := k.delegations.Get(ctx, collections.Join(seqAddr, delegator))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Delegation{}, types.ErrDelegationNotFound
+	}
+	return d, err
+}
+
+func (k Keeper) SetDelegation(ctx sdk.Context, d types.Delegation) error {
+	return k.delegations.Set(ctx, collections.Join(d.Sequencer, d.Delegator), d)
+}
+
+func (k Keeper) AllDelegations(ctx sdk.Context) ([]types.Delegation, error) {
+	iter, err := k.delegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	return iter.Values()
+}
+
+// TotalStake is the sequencer's own bond plus the tokens delegated to it.
+func (k Keeper) TotalStake(ctx sdk.Context, seq types.Sequencer) (sdk.Coin, error) {
+	stake := seq.TokensCoin()
+	if seq.Sentinel() {
+		return stake, nil
+	}
+	pool, err := k.GetDelegationPool(ctx, seq.Address)
+	if err != nil {
+		return stake, err
+	}
+	return stake.Add(pool.Tokens), nil
+}
+
+// Delegate stakes amt behind a bonded sequencer. Rewards pending on an
+// existing delegation are paid out first.
+func (k Keeper) Delegate(ctx sdk.Context, delegator sdk.AccAddress, seqAddr string, amt sdk.Coin) error {
+	seq, err := k.RealSequencer(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	if !seq.Bonded() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "sequencer not bonded")
+	}
+	if err := validBondDenom(amt); err != nil {
+		return err
+	}
+
+	pool, err := k.GetDelegationPool(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	shares, err := pool.SharesFromTokens(amt.Amount)
+	if err != nil {
+		return err
+	}
+	d, err := k.GetDelegation(ctx, seqAddr, delegator.String())
+	if errorsmod.IsOf(err, types.ErrDelegationNotFound) {
+		d = types.NewDelegation(seqAddr, delegator.String())
+	} else if err != nil {
+		return err
+	}
+	if _, err := k.payDelegatorRewards(ctx, pool, &d); err != nil {
+		return errorsmod.Wrap(err, "pay rewards")
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.ModuleName, sdk.NewCoins(amt)); err != nil {
+		return err
+	}
+	pool.Tokens = pool.Tokens.Add(amt)
+	pool.Shares = pool.Shares.Add(shares)
+	d.Shares = d.Shares.Add(shares)
+	if err := k.SetDelegationPool(ctx, pool); err != nil {
+		return err
+	}
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return err
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventDelegated{
+		Delegator:  d.Delegator,
+		Sequencer:  seqAddr,
+		Amount:     amt,
+		PoolTokens: pool.Tokens,
+	})
+}
+
+// Undelegate returns amt of the delegation to the delegator after the unbonding
+// period, and pays its pending rewards at once. Delegations can be withdrawn
+// whatever the sequencer status. A zero amount withdraws all the shares of a
+// delegation worth nothing, so that a pool slashed to zero can be emptied and
+// delegated to again.
+func (k Keeper) Undelegate(ctx sdk.Context, delegator sdk.AccAddress, seqAddr string, amt sdk.Coin) error {
+	if err := validBondDenom(amt); err != nil {
+		return err
+	}
+	d, err := k.GetDelegation(ctx, seqAddr, delegator.String())
+	if err != nil {
+		return err
+	}
+	pool, err := k.GetDelegationPool(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+
+	value := pool.TokensFromShares(d.Shares).TruncateInt()
+	if value.LT(amt.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientDelegation, "delegated: %s, requested: %s", value, amt.Amount)
+	}
+	// a zero amount is only meant to drop shares which are worth nothing, e.g.
+	// after the pool was slashed to zero
+	if amt.IsZero() && value.IsPositive() {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "zero undelegation of a delegation worth: %s", value)
+	}
+	shares := d.Shares
+	if amt.Amount.LT(value) {
+		shares = pool.Shares.MulInt(amt.Amount).QuoRoundUp(math.LegacyNewDecFromInt(pool.Tokens.Amount))
+		shares = math.LegacyMinDec(shares, d.Shares)
+	}
+
+	if _, err := k.payDelegatorRewards(ctx, pool, &d); err != nil {
+		return errorsmod.Wrap(err, "pay rewards")
+	}
+
+	d.Shares = d.Shares.Sub(shares)
+	pool.Shares = pool.Shares.Sub(shares)
+	if pool.Shares.IsZero() {
+		// the last delegator takes the rounding dust
+		amt = pool.Tokens
+	}
+	pool.Tokens = pool.Tokens.Sub(amt)
+
+	if d.Shares.IsZero() {
+		err = k.delegations.Remove(ctx, collections.Join(seqAddr, d.Delegator))
+	} else {
+		err = k.SetDelegation(ctx, d)
+	}
+	if err != nil {
+		return err
+	}
+	if err := k.SetDelegationPool(ctx, pool); err != nil {
+		return err
+	}
+	if err := k.startUnbonding(ctx, seqAddr, delegator, amt); err != nil {
+		return errorsmod.Wrap(err, "start unbonding")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventUndelegated{
+		Delegator:  d.Delegator,
+		Sequencer:  seqAddr,
+		Amount:     amt,
+		PoolTokens: pool.Tokens,
+	})
+}
+
+// WithdrawDelegatorRewards pays out the rewards pending on the delegation.
+func (k Keeper) WithdrawDelegatorRewards(ctx sdk.Context, delegator sdk.AccAddress, seqAddr string) (sdk.Coins, error) {
+	d, err := k.GetDelegation(ctx, seqAddr, delegator.String())
+	if err != nil {
+		return nil, err
+	}
+	pool, err := k.GetDelegationPool(ctx, seqAddr)
+	if err != nil {
+		return nil, err
+	}
+	rewards, err := k.payDelegatorRewards(ctx, pool, &d)
+	if err != nil {
+		return nil, err
+	}
+	return rewards, k.SetDelegation(ctx, d)
+}
+
+// payDelegatorRewards sends the pending rewards of d and marks them paid. The
+// caller must save d.
+func (k Keeper) payDelegatorRewards(ctx sdk.Context, pool types.DelegationPool, d *types.Delegation) (sdk.Coins, error) {
+	rewards := d.PendingRewards(pool)
+	d.RewardsPerShare = pool.RewardsPerShare
+	if rewards.IsZero() {
+		return rewards, nil
+	}
+	delegator := sdk.MustAccAddressFromBech32(d.Delegator)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.DelegatorRewardsPoolName, delegator, rewards); err != nil {
+		return nil, err
+	}
+	return rewards, uevent.EmitTypedEvent(ctx, &types.EventDelegatorRewardsWithdrawn{
+		Delegator: d.Delegator,
+		Sequencer: d.Sequencer,
+		Rewards:   rewards,
+	})
+}
+
+// AllocateRewards pays rewards from sender to the sequencer. The operator
+// keeps its commission, sent to its reward address, and the rest accrues to
+// the delegators. Without delegated stake the operator gets everything.
+//
+// It is only called by MsgFundSequencerRewards: no protocol reward is paid to
+// sequencers on the hub, so the commission applies to the rewards funded
+// through it and to nothing else.
+func (k Keeper) AllocateRewards(ctx sdk.Context, sender sdk.AccAddress, seqAddr string, rewards sdk.Coins) error {
+	seq, err := k.RealSequencer(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	pool, err := k.GetDelegationPool(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	pool.PromotePendingCommission(ctx.BlockTime())
+
+	commission := rewards
+	if pool.HasStake() {
+		commission, _ = sdk.NewDecCoinsFromCoins(rewards...).MulDecTruncate(pool.CommissionRate).TruncateDecimal()
+	}
+	delegatorRewards := rewards.Sub(commission...)
+
+	if !commission.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, sender, seq.RewardAccAddr(), commission); err != nil {
+			return errorsmod.Wrap(err, "send commission")
+		}
+	}
+	if !delegatorRewards.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.DelegatorRewardsPoolName, delegatorRewards); err != nil {
+			return errorsmod.Wrap(err, "send delegator rewards")
+		}
+		pool.AddRewards(delegatorRewards)
+		if err := k.SetDelegationPool(ctx, pool); err != nil {
+			return err
+		}
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventSequencerRewards{
+		Sequencer:        seqAddr,
+		Commission:       commission,
+		DelegatorRewards: delegatorRewards,
+	})
+}
+
+// UpdateCommission sets the part of the rewards the sequencer keeps, and
+// returns when the rate applies. A cut applies at once. A raise waits out the
+// unbonding period, so delegators can undelegate before it applies. A new
+// update supersedes any pending one.
+func (k Keeper) UpdateCommission(ctx sdk.Context, seqAddr string, rate math.LegacyDec) (time.Time, error) {
+	if err := types.ValidateCommissionRate(rate); err != nil {
+		return time.Time{}, err
+	}
+	pool, err := k.GetDelegationPool(ctx, seqAddr)
+	if err != nil {
+		return time.Time{}, err
+	}
+	pool.PromotePendingCommission(ctx.BlockTime())
+	activation := ctx.BlockTime()
+	if rate.LTE(pool.CommissionRate) {
+		pool.CommissionRate = rate
+		pool.PendingCommissionRate = nil
+		pool.PendingCommissionTime = time.Time{}
+	} else {
+		activation = activation.Add(k.GetParams(ctx).UnbondingPeriod)
+		pool.PendingCommissionRate = &rate
+		pool.PendingCommissionTime = activation
+	}
+	if err := k.SetDelegationPool(ctx, pool); err != nil {
+		return time.Time{}, err
+	}
+	return activation, uevent.EmitTypedEvent(ctx, &types.EventUpdateCommission{
+		Sequencer:      seqAddr,
+		CommissionRate: rate,
+		ActivationTime: activation,
+	})
+}
+
+// slashDelegations slashes fraction of the sequencer's delegation pool.
+func (k Keeper) slashDelegations(ctx sdk.Context, seqAddr string, fraction, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+	pool, err := k.GetDelegationPool(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	if pool.Tokens.IsZero() || !fraction.IsPositive() {
+		return nil
+	}
+	slashed := ucoin.MulDec(fraction, pool.Tokens)[0]
+	if slashed.IsZero() {
+		return nil
+	}
+	pool.Tokens = pool.Tokens.Sub(slashed)
+	if err := k.SetDelegationPool(ctx, pool); err != nil {
+		return err
+	}
+
+	rewardCoin := ucoin.MulDec(rewardMul, slashed)[0]
+	if !rewardCoin.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, rewardee, sdk.NewCoins(rewardCoin)); err != nil {
+			return errorsmod.Wrap(err, "send")
+		}
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(slashed.Sub(rewardCoin))); err != nil {
+		return errorsmod.Wrap(err, "burn")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventDelegationsSlashed{
+		Sequencer:  seqAddr,
+		Amount:     slashed,
+		PoolTokens: pool.Tokens,
+	})
+}
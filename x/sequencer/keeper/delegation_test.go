@@ -0,0 +1,279 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) delegate(delegator, seq string, amt sdk.Coin) error {
+	_, err := s.msgServer.Delegate(s.Ctx, &types.MsgDelegate{Delegator: delegator, Sequencer: seq, Amount: amt})
+	return err
+}
+
+func (s *SequencerTestSuite) undelegate(delegator, seq string, amt sdk.Coin) error {
+	_, err := s.msgServer.Undelegate(s.Ctx, &types.MsgUndelegate{Delegator: delegator, Sequencer: seq, Amount: amt})
+	return err
+}
+
+func (s *SequencerTestSuite) pool(seqAddr string) types.DelegationPool {
+	pool, err := s.k().GetDelegationPool(s.Ctx, seqAddr)
+	s.Require().NoError(err)
+	return pool
+}
+
+func (s *SequencerTestSuite) checkInvariants() {
+	msg, broken := keeper.AllInvariants(*s.k())(s.Ctx)
+	s.Require().False(broken, msg)
+}
+
+func (s *SequencerTestSuite) TestDelegateUndelegate() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.fundSequencer(david, ucoin.SimpleMul(bond, 2))
+
+	s.Require().ErrorIs(s.delegate(pkAddr(david), pkAddr(bob), bond), types.ErrSequencerNotFound)
+	s.Require().NoError(s.delegate(pkAddr(david), seq.Address, bond))
+	total, err := s.k().TotalStake(s.Ctx, s.seq(alice))
+	s.Require().NoError(err)
+	s.Require().True(ucoin.SimpleMul(bond, 2).IsEqual(total))
+	s.Require().True(ucoin.SimpleMul(bond, 2).IsEqual(s.moduleBalance()))
+	s.checkInvariants()
+
+	// the operator's own bond is unchanged
+	s.Require().True(bond.Equal(s.seq(alice).TokensCoin()))
+
+	err = s.undelegate(pkAddr(david), seq.Address, ucoin.SimpleMul(bond, 2))
+	utest.IsErr(s.Require(), err, types.ErrInsufficientDelegation)
+
+	half := bond
+	half.Amount = bond.Amount.QuoRaw(2)
+	s.Require().NoError(s.undelegate(pkAddr(david), seq.Address, half))
+	s.Require().NoError(s.undelegate(pkAddr(david), seq.Address, bond.Sub(half)))
+	_, err = s.k().GetDelegation(s.Ctx, seq.Address, pkAddr(david))
+	s.Require().ErrorIs(err, types.ErrDelegationNotFound)
+	s.Require().True(s.pool(seq.Address).Tokens.IsZero())
+	s.Require().True(ucoin.SimpleMul(bond, 2).IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(david), bond.Denom)))
+	s.checkInvariants()
+
+	// no delegating to a sequencer which is not bonded
+	seq = s.seq(alice)
+	seq.Status = types.Unbonded
+	s.k().SetSequencer(s.Ctx, seq)
+	utest.IsErr(s.Require(), s.delegate(pkAddr(david), seq.Address, bond), gerrc.ErrFailedPrecondition)
+}
+
+func (s *SequencerTestSuite) TestDelegatorRewardsAndCommission() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	rewardAddr := seq.RewardAccAddr()
+	funder := pkAcc(faythe)
+	reward := func(mul int64) sdk.Coin {
+		return sdk.NewCoin("urax", bond.Amount.MulRaw(mul))
+	}
+	fund := func(amt sdk.Coin) {
+		s.fundSequencer(faythe, amt)
+		_, err := s.msgServer.FundSequencerRewards(s.Ctx, &types.MsgFundSequencerRewards{
+			Sender:    funder.String(),
+			Sequencer: seq.Address,
+			Amount:    sdk.NewCoins(amt),
+		})
+		s.Require().NoError(err)
+	}
+	balance := func(addr sdk.AccAddress) math.Int {
+		return s.App.BankKeeper.GetBalance(s.Ctx, addr, "urax").Amount
+	}
+
+	// without delegators the operator gets everything
+	fund(reward(1))
+	s.Require().Equal(reward(1).Amount, balance(rewardAddr))
+
+	_, err := s.msgServer.UpdateCommission(s.Ctx, &types.MsgUpdateCommission{Creator: pkAddr(bob), CommissionRate: math.LegacyNewDecWithPrec(1, 1)})
+	s.Require().ErrorIs(err, types.ErrSequencerNotFound)
+	_, err = s.msgServer.UpdateCommission(s.Ctx, &types.MsgUpdateCommission{Creator: seq.Address, CommissionRate: math.LegacyNewDecWithPrec(1, 1)})
+	s.Require().NoError(err)
+
+	s.fundSequencer(david, bond)
+	s.fundSequencer(eve, ucoin.SimpleMul(bond, 3))
+	s.Require().NoError(s.delegate(pkAddr(david), seq.Address, bond))
+	s.Require().NoError(s.delegate(pkAddr(eve), seq.Address, ucoin.SimpleMul(bond, 3)))
+
+	// 10% commission, the rest split 1:3
+	fund(reward(10))
+	s.Require().Equal(reward(2).Amount, balance(rewardAddr))
+
+	res, err := s.k().Delegation(s.Ctx, &types.QueryDelegationRequest{Sequencer: seq.Address, Delegator: pkAddr(eve)})
+	s.Require().NoError(err)
+	s.Require().Equal(reward(10).Amount.MulRaw(9).QuoRaw(10).MulRaw(3).QuoRaw(4), res.PendingRewards.AmountOf("urax"))
+	s.Require().True(ucoin.SimpleMul(bond, 3).IsEqual(res.Tokens))
+
+	w, err := s.msgServer.WithdrawDelegatorRewards(s.Ctx, &types.MsgWithdrawDelegatorRewards{Delegator: pkAddr(david), Sequencer: seq.Address})
+	s.Require().NoError(err)
+	s.Require().Equal(reward(9).Amount.QuoRaw(4), w.Rewards.AmountOf("urax"))
+	s.Require().Equal(w.Rewards.AmountOf("urax"), balance(pkAcc(david)))
+
+	// paid once
+	w, err = s.msgServer.WithdrawDelegatorRewards(s.Ctx, &types.MsgWithdrawDelegatorRewards{Delegator: pkAddr(david), Sequencer: seq.Address})
+	s.Require().NoError(err)
+	s.Require().True(w.Rewards.IsZero())
+
+	// undelegating pays the pending rewards
+	s.Require().NoError(s.undelegate(pkAddr(eve), seq.Address, ucoin.SimpleMul(bond, 3)))
+	s.Require().Equal(res.PendingRewards.AmountOf("urax"), balance(pkAcc(eve)))
+	s.checkInvariants()
+}
+
+// TestCommissionRaiseIsTimelocked checks that delegators have the unbonding
+// period to leave before a higher commission applies.
+func (s *SequencerTestSuite) TestCommissionRaiseIsTimelocked() {
+	s.setUnbondingPeriod(time.Hour)
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	update := func(rate math.LegacyDec) time.Time {
+		res, err := s.msgServer.UpdateCommission(s.Ctx, &types.MsgUpdateCommission{Creator: seq.Address, CommissionRate: rate})
+		s.Require().NoError(err)
+		return res.ActivationTime
+	}
+	rate := func() math.LegacyDec {
+		res, err := s.k().DelegationPool(s.Ctx, &types.QueryDelegationPoolRequest{Sequencer: seq.Address})
+		s.Require().NoError(err)
+		return res.Pool.CommissionRate
+	}
+	now := s.Ctx.BlockTime()
+
+	activation := update(math.LegacyOneDec())
+	s.Require().Equal(now.Add(time.Hour), activation)
+	s.Require().True(rate().IsZero())
+
+	s.Ctx = s.Ctx.WithBlockTime(activation.Add(-time.Second))
+	s.Require().True(rate().IsZero())
+	s.Ctx = s.Ctx.WithBlockTime(activation)
+	s.Require().True(rate().Equal(math.LegacyOneDec()))
+
+	// a cut applies at once and drops the pending raise
+	update(math.LegacyNewDecWithPrec(5, 1))
+	s.Ctx = s.Ctx.WithBlockTime(activation.Add(time.Hour))
+	activation = update(math.LegacyNewDecWithPrec(8, 1))
+	s.Require().Equal(s.Ctx.BlockTime().Add(time.Hour), activation)
+	s.Require().Equal(s.Ctx.BlockTime(), update(math.LegacyNewDecWithPrec(1, 1)))
+	s.Ctx = s.Ctx.WithBlockTime(activation)
+	s.Require().True(rate().Equal(math.LegacyNewDecWithPrec(1, 1)))
+	s.Require().Nil(s.pool(seq.Address).PendingCommissionRate)
+}
+
+func (s *SequencerTestSuite) TestSlashDelegations() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.fundSequencer(david, bond)
+	s.Require().NoError(s.delegate(pkAddr(david), seq.Address, bond))
+
+	// same bond as the delegations, so the same amount is slashed from both
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+	seq = s.seq(alice)
+	pool := s.pool(seq.Address)
+	s.Require().True(seq.TokensCoin().IsLT(bond))
+	s.Require().True(pool.Tokens.Equal(seq.TokensCoin()))
+	s.Require().True(s.moduleBalance().IsEqual(seq.TokensCoin().Add(pool.Tokens)))
+	s.checkInvariants()
+
+	res, err := s.k().Delegation(s.Ctx, &types.QueryDelegationRequest{Sequencer: seq.Address, Delegator: pkAddr(david)})
+	s.Require().NoError(err)
+	s.Require().True(pool.Tokens.Equal(res.Tokens))
+
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, seq.Address, nil))
+	s.Require().True(s.pool(seq.Address).Tokens.IsZero())
+	s.Require().True(s.moduleBalance().IsZero())
+	s.checkInvariants()
+
+	// the slashed pool cannot price new shares
+	s.fundSequencer(eve, bond)
+	utest.IsErr(s.Require(), s.delegate(pkAddr(eve), seq.Address, bond), gerrc.ErrFailedPrecondition)
+}
+
+// TestFraudSlashesPoolWithoutOwnBond checks that fraud slashes the whole
+// delegation pool even when the operator has no bond left to slash.
+func (s *SequencerTestSuite) TestFraudSlashesPoolWithoutOwnBond() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.fundSequencer(david, bond)
+	s.Require().NoError(s.delegate(pkAddr(david), seq.Address, bond))
+
+	// the operator's own bond is gone, the delegations remain
+	seq = s.seq(alice)
+	s.Require().NoError(s.App.BankKeeper.BurnCoins(s.Ctx, types.ModuleName, sdk.NewCoins(seq.TokensCoin())))
+	seq.SetTokensCoin(sdk.NewCoin(bond.Denom, math.ZeroInt()))
+	s.k().SetSequencer(s.Ctx, seq)
+	s.checkInvariants()
+
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, seq.Address, nil))
+	s.Require().True(s.pool(seq.Address).Tokens.IsZero())
+	s.Require().True(s.moduleBalance().IsZero())
+	s.checkInvariants()
+}
+
+func (s *SequencerTestSuite) TestFullSlashUndelegateRedelegate() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.fundSequencer(david, bond)
+	s.Require().NoError(s.delegate(pkAddr(david), seq.Address, bond))
+	zero := sdk.NewCoin(bond.Denom, math.ZeroInt())
+
+	// a zero amount only withdraws a worthless delegation
+	utest.IsErr(s.Require(), s.undelegate(pkAddr(david), seq.Address, zero), gerrc.ErrInvalidArgument)
+
+	reward := sdk.NewCoin("urax", bond.Amount)
+	s.fundSequencer(faythe, reward)
+	_, err := s.msgServer.FundSequencerRewards(s.Ctx, &types.MsgFundSequencerRewards{
+		Sender:    pkAddr(faythe),
+		Sequencer: seq.Address,
+		Amount:    sdk.NewCoins(reward),
+	})
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, seq.Address, nil))
+	s.Require().True(s.pool(seq.Address).Tokens.IsZero())
+	s.Require().False(s.pool(seq.Address).Shares.IsZero())
+
+	// the shares are gone and the rewards earned before the slash are paid
+	utest.IsErr(s.Require(), s.undelegate(pkAddr(david), seq.Address, bond), types.ErrInsufficientDelegation)
+	s.Require().NoError(s.undelegate(pkAddr(david), seq.Address, zero))
+	_, err = s.k().GetDelegation(s.Ctx, seq.Address, pkAddr(david))
+	s.Require().ErrorIs(err, types.ErrDelegationNotFound)
+	s.Require().True(s.pool(seq.Address).Shares.IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(david), "urax").IsPositive())
+	s.checkInvariants()
+
+	// the emptied pool prices shares again
+	s.fundSequencer(eve, bond)
+	s.Require().NoError(s.delegate(pkAddr(eve), seq.Address, bond))
+	s.Require().True(bond.IsEqual(s.pool(seq.Address).Tokens))
+	s.checkInvariants()
+	s.Require().NoError(s.undelegate(pkAddr(eve), seq.Address, bond))
+	s.Require().True(bond.IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(eve), bond.Denom)))
+}
+
+func (s *SequencerTestSuite) TestProposerChoiceCountsDelegations() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, ucoin.SimpleMul(bond, 2))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, bond)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+
+	choose := func() types.Sequencer {
+		s.k().SetProposer(s.Ctx, ra.RollappId, types.SentinelSeqAddr)
+		s.Require().NoError(s.k().ChooseProposerAfterSentinel(s.Ctx, ra.RollappId))
+		return s.k().GetProposer(s.Ctx, ra.RollappId)
+	}
+	s.Require().Equal(pkAddr(bob), choose().Address)
+
+	s.fundSequencer(david, ucoin.SimpleMul(bond, 2))
+	s.Require().NoError(s.delegate(pkAddr(david), pkAddr(charlie), ucoin.SimpleMul(bond, 2)))
+	s.Require().Equal(pkAddr(charlie), choose().Address)
+}
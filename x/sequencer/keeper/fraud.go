@@ -32,6 +32,9 @@ func (k Keeper) TryKickProposer(ctx sdk.Context, kicker types.Sequencer) error {
 
 	// clear the proposer
 	k.abruptRemoveProposer(ctx, ra)
+	if err := k.recordPerformance(ctx, proposer.Address, types.PerformanceRecord_Kicked); err != nil {
+		return errorsmod.Wrap(err, "record kick")
+	}
 
 	// This will call hard fork on the rollapp, which will also optOut all sequencers
 	err := k.hooks.AfterKickProposer(ctx, proposer)
@@ -76,7 +79,7 @@ func (k Keeper) SlashLiveness(ctx sdk.Context, rollappID string) error {
 	}
 	k.increasePenaltyDowntime(ctx, &seq)
 	k.SetSequencer(ctx, seq)
-	return nil
+	return errorsmod.Wrap(k.recordPerformance(ctx, seq.Address, types.PerformanceRecord_MissedLiveness), "record missed liveness")
 }
 
 func (k Keeper) livenessSlash(ctx sdk.Context, seq *types.Sequencer) error {
@@ -85,7 +88,7 @@ func (k Keeper) livenessSlash(ctx sdk.Context, seq *types.Sequencer) error {
 	tokens := seq.TokensCoin()
 	tokensMul := ucoin.MulDec(mul, tokens)
 	amt := ucoin.SimpleMin(tokens, ucoin.SimpleMax(abs, tokensMul[0]))
-	return errorsmod.Wrap(k.slash(ctx, seq, amt, math.LegacyZeroDec(), nil), "slash")
+	return errorsmod.Wrap(k.slash(ctx, seq, amt, bondFraction(*seq, amt), math.LegacyZeroDec(), nil), "slash")
 }
 
 func (k Keeper) reducePenaltyUptime(ctx sdk.Context, seq *types.Sequencer) {
@@ -117,15 +120,26 @@ func (k Keeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.A
 		addr = *rewardee
 	}
 
-	err = k.slash(ctx, &seq, seq.TokensCoin(), rewardMul, addr)
+	// the whole pool is liable, however little the operator has bonded
+	err = k.slash(ctx, &seq, seq.TokensCoin(), math.LegacyOneDec(), rewardMul, addr)
 	if err != nil {
 		return errorsmod.Wrap(err, "slash")
 	}
+	// stake which left before the fraud was found is liable too
+	err = k.slashUnbondings(ctx, seq.Address, math.LegacyOneDec(), rewardMul, addr)
+	if err != nil {
+		return errorsmod.Wrap(err, "slash unbondings")
+	}
 	k.SetSequencer(ctx, seq)
 	return nil
 }
 
-func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+// slash slashes amt of the sequencer's own bond, and poolFraction of the stake
+// delegated to it.
+func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, poolFraction, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+	if err := k.slashDelegations(ctx, seq.Address, poolFraction, rewardMul, rewardee); err != nil {
+		return errorsmod.Wrap(err, "slash delegations")
+	}
 	rewardCoin := ucoin.MulDec(rewardMul, amt)[0]
 	if !rewardCoin.IsZero() {
 		err := k.sendFromModule(ctx, seq, rewardCoin, rewardee)
@@ -145,3 +159,14 @@ func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, rewar
 	)
 	return err
 }
+
+// bondFraction is the fraction amt is of the sequencer's own bond, capped at
+// one, so delegators can bear the same relative loss as the operator. It is
+// zero if the sequencer has no bond.
+func bondFraction(seq types.Sequencer, amt sdk.Coin) math.LegacyDec {
+	tokens := seq.TokensCoin()
+	if tokens.IsZero() {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyMinDec(math.LegacyOneDec(), math.LegacyNewDecFromInt(amt.Amount).QuoInt(tokens.Amount))
+}
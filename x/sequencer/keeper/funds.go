@@ -36,8 +36,10 @@ func (k Keeper) burn(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error
 }
 
 // Refund reduces the sequencer token balance by amt and refunds amt to the addr
+// once the unbonding period elapses
 func (k Keeper) refund(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
-	return errorsmod.Wrap(k.sendFromModule(ctx, seq, amt, seq.AccAddr()), "send tokens")
+	seq.SetTokensCoin(seq.TokensCoin().Sub(amt))
+	return errorsmod.Wrap(k.startUnbonding(ctx, seq.Address, seq.AccAddr(), amt), "start unbonding")
 }
 
 func (k Keeper) sendFromModule(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, recipient sdk.AccAddress) error {
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) DelegationPool(c context.Context, req *types.QueryDelegationPoolRequest) (*types.QueryDelegationPoolResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	seq, err := k.RealSequencer(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := k.GetDelegationPool(ctx, seq.Address)
+	if err != nil {
+		return nil, err
+	}
+	pool.PromotePendingCommission(ctx.BlockTime())
+	total, err := k.TotalStake(ctx, seq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationPoolResponse{
+		Pool:       pool,
+		TotalStake: total,
+	}, nil
+}
+
+func (k Keeper) Delegations(c context.Context, req *types.QueryDelegationsRequest) (*types.QueryDelegationsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	delegations, pageRes, err := query.CollectionPaginate(ctx, k.delegations, req.Pagination,
+		func(_ collections.Pair[string, string], d types.Delegation) (types.Delegation, error) {
+			return d, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](req.Sequencer),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationsResponse{Delegations: delegations, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Delegation(c context.Context, req *types.QueryDelegationRequest) (*types.QueryDelegationResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	d, err := k.GetDelegation(ctx, req.Sequencer, req.Delegator)
+	if err != nil {
+		return nil, err
+	}
+	pool, err := k.GetDelegationPool(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDelegationResponse{
+		Delegation:     d,
+		Tokens:         sdk.NewCoin(pool.Tokens.Denom, pool.TokensFromShares(d.Shares).TruncateInt()),
+		PendingRewards: d.PendingRewards(pool),
+	}, nil
+}
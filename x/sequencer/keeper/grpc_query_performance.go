@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) Performance(c context.Context, req *types.QueryPerformanceRequest) (*types.QueryPerformanceResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	h, err := k.GetPerformanceHistory(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPerformanceResponse{
+		Records:        h.Ordered(),
+		Score:          h.Score(),
+		AverageLatency: h.AverageLatency(),
+	}, nil
+}
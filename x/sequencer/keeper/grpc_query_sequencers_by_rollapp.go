@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
@@ -21,9 +22,18 @@ func (k Keeper) SequencersByRollapp(c context.Context, req *types.QueryGetSequen
 	if err != nil {
 		return nil, err
 	}
+	scores := make([]math.LegacyDec, 0, len(sequencers))
+	for _, seq := range sequencers {
+		h, err := k.GetPerformanceHistory(ctx, seq.Address)
+		if err != nil {
+			return nil, err
+		}
+		scores = append(scores, h.Score())
+	}
 	return &types.QueryGetSequencersByRollappResponse{
 		Sequencers: sequencers,
 		Pagination: pagResp,
+		Scores:     scores,
 	}, nil
 }
 
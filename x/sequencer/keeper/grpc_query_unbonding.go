@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) Unbondings(c context.Context, req *types.QueryUnbondingsRequest) (*types.QueryUnbondingsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	unbondings, pageRes, err := query.CollectionPaginate(ctx, k.unbondings, req.Pagination,
+		func(_ collections.Pair[string, uint64], e types.UnbondingEntry) (types.UnbondingEntry, error) {
+			return e, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Sequencer),
+	)
+	if err != nil {
+		return nil, err
+	}
+	total, err := k.UnbondingTotal(ctx, req.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryUnbondingsResponse{Unbondings: unbondings, Total: total, Pagination: pageRes}, nil
+}
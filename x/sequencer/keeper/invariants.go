@@ -123,7 +123,7 @@ func checkRollappStatus(ctx sdk.Context, k Keeper, ra string) error {
 	return nil
 }
 
-// module balance must correspond to sequencer stakes, and sequencer stakes should be sensible
+// module balance must correspond to sequencer and delegated stakes plus pending unbondings, and stakes should be sensible
 func InvariantTokens(k Keeper) uinv.Func {
 	return uinv.AnyErrorIsBreaking(func(ctx sdk.Context) error {
 		var errs []error
@@ -142,6 +142,23 @@ func InvariantTokens(k Keeper) uinv.Func {
 		for _, seq := range k.AllSequencers(ctx) {
 			total = total.Add(seq.TokensCoin())
 		}
+		pools, err := k.AllDelegationPools(ctx)
+		if err != nil {
+			return err
+		}
+		for _, pool := range pools {
+			if err := pool.ValidateBasic(); err != nil {
+				return errorsmod.Wrapf(err, "delegation pool: %s", pool.Sequencer)
+			}
+			total = total.Add(pool.Tokens)
+		}
+		unbondings, err := k.AllUnbondings(ctx)
+		if err != nil {
+			return err
+		}
+		for _, e := range unbondings {
+			total = total.Add(e.Amount)
+		}
 		// check module balance is equal
 		moduleAcc := k.accountK.GetModuleAccount(ctx, types.ModuleName)
 		balances := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
@@ -152,7 +169,7 @@ func InvariantTokens(k Keeper) uinv.Func {
 			return errors.New("module account has no balance")
 		}
 		if !total.IsZero() && !balances[0].IsEqual(total) {
-			return errors.New("module account balance not equal to sum of sequencer tokens")
+			return errors.New("module account balance not equal to sum of sequencer, delegated and unbonding tokens")
 		}
 		return nil
 	})
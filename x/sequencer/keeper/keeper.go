@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
@@ -26,6 +27,20 @@ type Keeper struct {
 	hooks          types.Hooks
 
 	dymintProposerAddrToAccAddr collections.Map[[]byte, string]
+	delegationPools             collections.Map[string, types.DelegationPool]
+	// (sequencer, delegator) -> delegation
+	delegations collections.Map[collections.Pair[string, string], types.Delegation]
+	// (sequencer, id) -> unbonding
+	unbondings collections.Map[collections.Pair[string, uint64], types.UnbondingEntry]
+	// unbondings by completion time, for the end blocker
+	unbondingQueue collections.KeySet[collections.Triple[time.Time, string, uint64]]
+	unbondingSeq   collections.Sequence
+	// rollapp -> sequencer last chosen as proposer, for round robin selection
+	lastChosenProposer collections.Map[string, string]
+	// rollapp -> when its proposer started serving, for scheduled rotations
+	proposerTerms collections.Map[string, types.ProposerTerm]
+	// sequencer -> its recent records as a proposer
+	performanceHistories collections.Map[string, types.PerformanceHistory]
 }
 
 func NewKeeper(
@@ -59,6 +74,55 @@ func NewKeeper(
 			collections.BytesKey,
 			collections.StringValue,
 		),
+		delegationPools: collections.NewMap(
+			sb,
+			types.DelegationPoolKeyPrefix,
+			"delegationPools",
+			collections.StringKey,
+			codec.CollValue[types.DelegationPool](cdc),
+		),
+		delegations: collections.NewMap(
+			sb,
+			types.DelegationKeyPrefix,
+			"delegations",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.Delegation](cdc),
+		),
+		unbondings: collections.NewMap(
+			sb,
+			types.UnbondingKeyPrefix,
+			"unbondings",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.UnbondingEntry](cdc),
+		),
+		unbondingQueue: collections.NewKeySet(
+			sb,
+			types.UnbondingQueueKeyPrefix,
+			"unbondingQueue",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.Uint64Key),
+		),
+		unbondingSeq: collections.NewSequence(sb, types.UnbondingSeqKey, "unbondingSeq"),
+		lastChosenProposer: collections.NewMap(
+			sb,
+			types.LastChosenProposerKeyPrefix,
+			"lastChosenProposer",
+			collections.StringKey,
+			collections.StringValue,
+		),
+		proposerTerms: collections.NewMap(
+			sb,
+			types.ProposerTermKeyPrefix,
+			"proposerTerms",
+			collections.StringKey,
+			codec.CollValue[types.ProposerTerm](cdc),
+		),
+		performanceHistories: collections.NewMap(
+			sb,
+			types.PerformanceHistoryKeyPrefix,
+			"performanceHistories",
+			collections.StringKey,
+			codec.CollValue[types.PerformanceHistory](cdc),
+		),
 	}
 }
 
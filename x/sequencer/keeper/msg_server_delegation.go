@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	delegator := sdk.MustAccAddressFromBech32(msg.Delegator)
+	if err := k.Keeper.Delegate(ctx, delegator, msg.Sequencer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDelegateResponse{}, nil
+}
+
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	delegator := sdk.MustAccAddressFromBech32(msg.Delegator)
+	if err := k.Keeper.Undelegate(ctx, delegator, msg.Sequencer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUndelegateResponse{}, nil
+}
+
+func (k msgServer) WithdrawDelegatorRewards(goCtx context.Context, msg *types.MsgWithdrawDelegatorRewards) (*types.MsgWithdrawDelegatorRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	delegator := sdk.MustAccAddressFromBech32(msg.Delegator)
+	rewards, err := k.Keeper.WithdrawDelegatorRewards(ctx, delegator, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawDelegatorRewardsResponse{Rewards: rewards}, nil
+}
+
+func (k msgServer) FundSequencerRewards(goCtx context.Context, msg *types.MsgFundSequencerRewards) (*types.MsgFundSequencerRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	if err := k.AllocateRewards(ctx, sender, msg.Sequencer, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundSequencerRewardsResponse{}, nil
+}
+
+func (k msgServer) UpdateCommission(goCtx context.Context, msg *types.MsgUpdateCommission) (*types.MsgUpdateCommissionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	seq, err := k.RealSequencer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	activation, err := k.Keeper.UpdateCommission(ctx, seq.Address, msg.CommissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateCommissionResponse{ActivationTime: activation}, nil
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// GetPerformanceHistory returns the history of the sequencer, empty if it was
+// never proposer.
+func (k Keeper) GetPerformanceHistory(ctx sdk.Context, seqAddr string) (types.PerformanceHistory, error) {
+	h, err := k.performanceHistories.Get(ctx, seqAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.NewPerformanceHistory(seqAddr), nil
+	}
+	return h, err
+}
+
+func (k Keeper) SetPerformanceHistory(ctx sdk.Context, h types.PerformanceHistory) error {
+	return k.performanceHistories.Set(ctx, h.Sequencer, h)
+}
+
+func (k Keeper) AllPerformanceHistories(ctx sdk.Context) ([]types.PerformanceHistory, error) {
+	iter, err := k.performanceHistories.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	return iter.Values()
+}
+
+// recordStateUpdate adds a state update by the proposer to its history.
+func (k Keeper) recordStateUpdate(ctx sdk.Context, seqAddr string) error {
+	h, err := k.GetPerformanceHistory(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	h.AddStateUpdate(ctx)
+	return k.SetPerformanceHistory(ctx, h)
+}
+
+// recordPerformance adds a record of the kind, at the current block, to the
+// sequencer's history.
+func (k Keeper) recordPerformance(ctx sdk.Context, seqAddr string, kind types.PerformanceRecord_Kind) error {
+	h, err := k.GetPerformanceHistory(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	h.Add(types.PerformanceRecord{
+		Kind:   kind,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	})
+	return k.SetPerformanceHistory(ctx, h)
+}
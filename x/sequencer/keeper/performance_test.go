@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) performance(seqAddr string) *types.QueryPerformanceResponse {
+	res, err := s.queryClient.Performance(s.Ctx, &types.QueryPerformanceRequest{Sequencer: seqAddr})
+	s.Require().NoError(err)
+	return res
+}
+
+func (s *SequencerTestSuite) TestPerformanceHistory() {
+	ra := s.createRollapp()
+	seqAlice := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	seqBob := s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+
+	// nothing recorded yet
+	res := s.performance(seqAlice.Address)
+	s.Require().Empty(res.Records)
+	s.Require().Equal(math.LegacyOneDec(), res.Score)
+
+	for range 3 {
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute)).WithBlockHeight(s.Ctx.BlockHeight() + 1)
+		s.submitAFewRollappStates(ra.RollappId)
+	}
+	res = s.performance(seqAlice.Address)
+	s.Require().Len(res.Records, 3)
+	s.Require().Zero(res.Records[0].Latency)
+	s.Require().Equal(time.Minute, res.Records[2].Latency)
+	s.Require().Equal(time.Minute, res.AverageLatency)
+	s.Require().Equal(math.LegacyOneDec(), res.Score)
+
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+	res = s.performance(seqAlice.Address)
+	s.Require().Len(res.Records, 4)
+	s.Require().Equal(types.PerformanceRecord_MissedLiveness, res.Records[3].Kind)
+	s.Require().Equal(s.Ctx.BlockHeight(), res.Records[3].Height)
+	s.Require().Equal(math.LegacyNewDec(3).QuoInt64(13), res.Score)
+
+	seqAlice = s.seq(alice)
+	seqAlice.SetPenalty(types.DefaultDishonorKickThreshold)
+	s.k().SetSequencer(s.Ctx, seqAlice)
+	_, err := s.msgServer.KickProposer(s.Ctx, &types.MsgKickProposer{Creator: seqBob.Address})
+	s.Require().NoError(err)
+	res = s.performance(seqAlice.Address)
+	s.Require().Len(res.Records, 5)
+	s.Require().Equal(types.PerformanceRecord_Kicked, res.Records[4].Kind)
+
+	// the kicker was not proposer before
+	s.Require().Empty(s.performance(seqBob.Address).Records)
+}
+
+// The time between two terms of a proposer is not counted as latency.
+func (s *SequencerTestSuite) TestPerformanceLatencyResetsOnNewTerm() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 2))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.setRotationPeriod(ra.RollappId, time.Hour)
+	notice := s.k().GetParams(s.Ctx).NoticePeriod
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+	s.submitAFewRollappStates(ra.RollappId)
+	for _, handover := range [][2]cryptotypes.PubKey{{alice, bob}, {bob, alice}} {
+		prop, succ := handover[0], handover[1]
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+		s.submitAFewRollappStates(ra.RollappId)
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(notice))
+		s.Require().NoError(s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime()))
+		s.Require().NoError(s.k().OnProposerLastBlock(s.Ctx, s.seq(prop)))
+		s.Require().True(s.k().IsProposer(s.Ctx, s.seq(succ)))
+	}
+	s.Require().Equal(time.Hour, s.performance(pkAddr(alice)).Records[1].Latency)
+
+	// back in office a day later
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(24 * time.Hour))
+	s.submitAFewRollappStates(ra.RollappId)
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+	s.submitAFewRollappStates(ra.RollappId)
+	res := s.performance(pkAddr(alice))
+	s.Require().Len(res.Records, 4)
+	s.Require().Zero(res.Records[2].Latency)
+	s.Require().Equal(time.Minute, res.Records[3].Latency)
+}
+
+func (s *SequencerTestSuite) TestSequencersByRollappScores() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.submitAFewRollappStates(ra.RollappId)
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+
+	res, err := s.queryClient.SequencersByRollapp(s.Ctx, &types.QueryGetSequencersByRollappRequest{RollappId: ra.RollappId})
+	s.Require().NoError(err)
+	s.Require().Len(res.Sequencers, 2)
+	s.Require().Len(res.Scores, 2)
+	for i, seq := range res.Sequencers {
+		expect := math.LegacyOneDec()
+		if seq.Address == pkAddr(alice) {
+			expect = math.LegacyNewDec(1).QuoInt64(11)
+		}
+		s.Require().Equal(expect, res.Scores[i], seq.Address)
+	}
+}
@@ -14,10 +14,13 @@ import (
 func (k Keeper) afterStateUpdate(ctx sdk.Context, prop types.Sequencer, last bool) error {
 	k.reducePenaltyUptime(ctx, &prop)
 	k.SetSequencer(ctx, prop)
+	if err := k.recordStateUpdate(ctx, prop.Address); err != nil {
+		return errorsmod.Wrap(err, "record state update")
+	}
 	if last {
 		return k.OnProposerLastBlock(ctx, prop)
 	}
-	return nil
+	return errorsmod.Wrap(k.tryScheduledRotation(ctx, prop), "scheduled rotation")
 }
 
 func (k Keeper) abruptRemoveProposer(ctx sdk.Context, rollapp string) {
@@ -62,7 +65,7 @@ func (k Keeper) ChooseProposerAfterSentinel(ctx sdk.Context, rollapp string) err
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "proposer is not sentinel")
 	}
 
-	successor, err := ProposerChoiceAlgo(k.RollappPotentialProposers(ctx, rollapp))
+	successor, err := k.chooseProposer(ctx, rollapp, k.RollappPotentialProposers(ctx, rollapp))
 	if err != nil {
 		return err
 	}
@@ -70,6 +73,9 @@ func (k Keeper) ChooseProposerAfterSentinel(ctx sdk.Context, rollapp string) err
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "no valid proposer found")
 	}
 	k.SetProposer(ctx, rollapp, successor.Address)
+	if err := k.startProposerTerm(ctx, rollapp, successor.Address); err != nil {
+		return errorsmod.Wrap(err, "start proposer term")
+	}
 
 	err = k.hooks.AfterSetRealProposer(ctx, rollapp, successor)
 	if err != nil {
@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// chooseProposer picks the next proposer of the rollapp among seqs with the
+// strategy set by the rollapp owner. Stake includes delegations.
+// Requires sentinel to be passed in, as last resort.
+func (k Keeper) chooseProposer(ctx sdk.Context, rollapp string, seqs []types.Sequencer) (types.Sequencer, error) {
+	stakes := make(map[string]sdk.Coin, len(seqs))
+	for _, seq := range seqs {
+		total, err := k.TotalStake(ctx, seq)
+		if err != nil {
+			return types.Sequencer{}, err
+		}
+		stakes[seq.Address] = total
+	}
+	stake := func(seq types.Sequencer) sdk.Coin {
+		return stakes[seq.Address]
+	}
+	var selection rollapptypes.ProposerSelection
+	if ra, ok := k.rollappKeeper.GetRollapp(ctx, rollapp); ok {
+		selection = ra.ProposerSelection
+	}
+
+	var (
+		chosen types.Sequencer
+		err    error
+	)
+	switch selection.Strategy {
+	case rollapptypes.ProposerSelection_WeightedRandom:
+		chosen, err = WeightedRandomChoiceAlgo(seqs, stake, proposerEntropy(ctx, rollapp))
+	case rollapptypes.ProposerSelection_RoundRobin:
+		last, err1 := k.lastChosenProposer.Get(ctx, rollapp)
+		if err1 != nil && !errors.Is(err1, collections.ErrNotFound) {
+			return types.Sequencer{}, err1
+		}
+		chosen, err = RoundRobinChoiceAlgo(seqs, last)
+	case rollapptypes.ProposerSelection_PriorityList:
+		chosen, err = PriorityListChoiceAlgo(seqs, selection.PriorityList, stake)
+	default:
+		chosen, err = ProposerChoiceAlgo(seqs, stake)
+	}
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+
+	if !chosen.Sentinel() {
+		if err := k.lastChosenProposer.Set(ctx, rollapp, chosen.Address); err != nil {
+			return types.Sequencer{}, err
+		}
+	}
+	return chosen, nil
+}
+
+func (k Keeper) AllLastChosenProposers(ctx sdk.Context) ([]types.GenesisProposer, error) {
+	var ret []types.GenesisProposer
+	err := k.lastChosenProposer.Walk(ctx, nil, func(rollapp, seqAddr string) (stop bool, err error) {
+		ret = append(ret, types.GenesisProposer{Address: seqAddr, RollappId: rollapp})
+		return false, nil
+	})
+	return ret, err
+}
+
+func (k Keeper) SetLastChosenProposer(ctx sdk.Context, rollapp, seqAddr string) error {
+	return k.lastChosenProposer.Set(ctx, rollapp, seqAddr)
+}
+
+// proposerEntropy is drawn from the app hash of the previous hub block. The hash
+// of the current block is not used: its proposer, who may include the tx
+// causing the change of proposer, could grind it by varying the block time or
+// the txs. The app hash is fixed before the block is built, but the proposer of
+// the previous block can still bias it if it can tell that a change of proposer
+// is due, e.g. with scheduled rotations. This is why the strategy is opt-in and
+// not meant for rollapps whose sequencers can propose hub blocks. The rollapp
+// and height are mixed in so rollapps rotating in the same block don't draw the
+// same number.
+func proposerEntropy(ctx sdk.Context, rollapp string) []byte {
+	h := sha256.New()
+	_, _ = h.Write(ctx.BlockHeader().AppHash)
+	_, _ = h.Write([]byte(rollapp))
+	_, _ = h.Write(binary.BigEndian.AppendUint64(nil, uint64(ctx.BlockHeight()))) //nolint:gosec
+	return h.Sum(nil)
+}
+
+// realCandidates returns the non sentinel sequencers in address order, and the
+// sentinel.
+func realCandidates(seqs []types.Sequencer) ([]types.Sequencer, types.Sequencer, error) {
+	var (
+		candidates []types.Sequencer
+		sentinel   types.Sequencer
+		found      bool
+	)
+	for _, seq := range seqs {
+		if seq.Sentinel() {
+			sentinel, found = seq, true
+			continue
+		}
+		candidates = append(candidates, seq)
+	}
+	if !found {
+		return nil, types.Sequencer{}, gerrc.ErrInternal.Wrap("seqs must at least include sentinel")
+	}
+	slices.SortFunc(candidates, func(a, b types.Sequencer) int {
+		return strings.Compare(a.Address, b.Address)
+	})
+	return candidates, sentinel, nil
+}
+
+// WeightedRandomChoiceAlgo : choose at random, with probability proportional
+// to stake. The draw is determined by the entropy, so it is only as fair as
+// the entropy is unpredictable: see proposerEntropy.
+// Requires sentinel to be passed in, as last resort.
+func WeightedRandomChoiceAlgo(seqs []types.Sequencer, stake func(types.Sequencer) sdk.Coin, entropy []byte) (types.Sequencer, error) {
+	candidates, sentinel, err := realCandidates(seqs)
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+	if len(candidates) == 0 {
+		return sentinel, nil
+	}
+
+	total := math.ZeroInt()
+	for _, seq := range candidates {
+		total = total.Add(stake(seq).Amount)
+	}
+	if !total.IsPositive() {
+		return candidates[0], nil
+	}
+
+	r := new(big.Int).Mod(new(big.Int).SetBytes(entropy), total.BigInt())
+	for _, seq := range candidates {
+		w := stake(seq).Amount.BigInt()
+		if r.Cmp(w) < 0 {
+			return seq, nil
+		}
+		r.Sub(r, w)
+	}
+	return candidates[len(candidates)-1], nil
+}
+
+// RoundRobinChoiceAlgo : choose the sequencer after last in address order,
+// wrapping around.
+// Requires sentinel to be passed in, as last resort.
+func RoundRobinChoiceAlgo(seqs []types.Sequencer, last string) (types.Sequencer, error) {
+	candidates, sentinel, err := realCandidates(seqs)
+	if err != nil {
+		return types.Sequencer{}, err
+	}
+	if len(candidates) == 0 {
+		return sentinel, nil
+	}
+	for _, seq := range candidates {
+		if last < seq.Address {
+			return seq, nil
+		}
+	}
+	return candidates[0], nil
+}
+
+// PriorityListChoiceAlgo : choose the first sequencer of the list, or the one
+// with most stake if none of the list is available.
+// Requires sentinel to be passed in, as last resort.
+func PriorityListChoiceAlgo(seqs []types.Sequencer, list []string, stake func(types.Sequencer) sdk.Coin) (types.Sequencer, error) {
+	for _, addr := range list {
+		i := slices.IndexFunc(seqs, func(seq types.Sequencer) bool {
+			return seq.Address == addr && !seq.Sentinel()
+		})
+		if 0 <= i {
+			return seqs[i], nil
+		}
+	}
+	return ProposerChoiceAlgo(seqs, stake)
+}
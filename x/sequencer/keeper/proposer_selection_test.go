@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"math/rand"
+	"slices"
+	"testing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/stretchr/testify/require"
+
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func TestProposerSelectionAlgos(t *testing.T) {
+	sentinel := types.Sequencer{Address: types.SentinelSeqAddr, Tokens: sdk.Coins{sdk.NewInt64Coin(bond.Denom, 0)}}
+	seqs := func() []types.Sequencer {
+		return []types.Sequencer{
+			{Address: "c", Tokens: sdk.NewCoins(sdk.NewInt64Coin(bond.Denom, 1))},
+			{Address: "a", Tokens: sdk.NewCoins(sdk.NewInt64Coin(bond.Denom, 3))},
+			{Address: "b", Tokens: sdk.NewCoins(sdk.NewInt64Coin(bond.Denom, 2))},
+			sentinel,
+		}
+	}
+
+	t.Run("sentinel required", func(t *testing.T) {
+		_, err := keeper.RoundRobinChoiceAlgo(seqs()[:3], "")
+		require.Error(t, err)
+		_, err = keeper.WeightedRandomChoiceAlgo(seqs()[:3], types.Sequencer.TokensCoin, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("only sentinel", func(t *testing.T) {
+		got, err := keeper.RoundRobinChoiceAlgo([]types.Sequencer{sentinel}, "a")
+		require.NoError(t, err)
+		require.True(t, got.Sentinel())
+		got, err = keeper.WeightedRandomChoiceAlgo([]types.Sequencer{sentinel}, types.Sequencer.TokensCoin, []byte{1})
+		require.NoError(t, err)
+		require.True(t, got.Sentinel())
+		got, err = keeper.PriorityListChoiceAlgo([]types.Sequencer{sentinel}, []string{"a"}, types.Sequencer.TokensCoin)
+		require.NoError(t, err)
+		require.True(t, got.Sentinel())
+	})
+
+	t.Run("round robin", func(t *testing.T) {
+		for last, want := range map[string]string{"": "a", "a": "b", "b": "c", "c": "a", "bb": "c", "z": "a"} {
+			got, err := keeper.RoundRobinChoiceAlgo(seqs(), last)
+			require.NoError(t, err)
+			require.Equal(t, want, got.Address, "last: %s", last)
+		}
+	})
+
+	t.Run("weighted random", func(t *testing.T) {
+		// stakes are 3, 2, 1 in address order, the draw is taken modulo 6
+		for draw, want := range map[uint64]string{0: "a", 2: "a", 3: "b", 4: "b", 5: "c", 6: "a", 9: "b"} {
+			got, err := keeper.WeightedRandomChoiceAlgo(seqs(), types.Sequencer.TokensCoin, sdk.Uint64ToBigEndian(draw))
+			require.NoError(t, err)
+			require.Equal(t, want, got.Address, "draw: %d", draw)
+		}
+	})
+
+	t.Run("priority list", func(t *testing.T) {
+		for _, tc := range []struct {
+			list []string
+			want string
+		}{
+			{[]string{"c", "a"}, "c"},
+			{[]string{"x", "b"}, "b"},
+			{[]string{"x", types.SentinelSeqAddr}, "a"},
+			{nil, "a"},
+		} {
+			got, err := keeper.PriorityListChoiceAlgo(seqs(), tc.list, types.Sequencer.TokensCoin)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got.Address, "list: %v", tc.list)
+		}
+	})
+}
+
+func (s *SequencerTestSuite) setProposerSelection(rollapp string, selection rollapptypes.ProposerSelection) {
+	ra := s.raK().MustGetRollapp(s.Ctx, rollapp)
+	ra.ProposerSelection = selection
+	s.raK().SetRollapp(s.Ctx, ra)
+}
+
+// TestProposerSelectionSimulation simulates many proposer changes with each
+// strategy, drawing a new previous app hash every time.
+func (s *SequencerTestSuite) TestProposerSelectionSimulation() {
+	const rounds = 600
+	rng := rand.New(rand.NewSource(42))
+
+	ra := s.createRollapp()
+	pks := []cryptotypes.PubKey{alice, bob, charlie, david}
+	stakes := []int64{1, 2, 3, 4}
+	for i, pk := range pks {
+		s.createSequencerWithBond(s.Ctx, ra.RollappId, pk, ucoin.SimpleMul(bond, stakes[i]))
+	}
+	byAddr := slices.Clone(pks)
+	slices.SortFunc(byAddr, func(a, b cryptotypes.PubKey) int {
+		if pkAddr(a) < pkAddr(b) {
+			return -1
+		}
+		return 1
+	})
+
+	simulate := func(selection rollapptypes.ProposerSelection) []string {
+		s.setProposerSelection(ra.RollappId, selection)
+		var chosen []string
+		for range rounds {
+			header := s.Ctx.BlockHeader()
+			header.Height++
+			header.AppHash = make([]byte, 32)
+			rng.Read(header.AppHash)
+			s.Ctx = s.Ctx.WithBlockHeader(header)
+			s.k().SetProposer(s.Ctx, ra.RollappId, types.SentinelSeqAddr)
+			s.Require().NoError(s.k().ChooseProposerAfterSentinel(s.Ctx, ra.RollappId))
+			chosen = append(chosen, s.k().GetProposer(s.Ctx, ra.RollappId).Address)
+		}
+		return chosen
+	}
+
+	s.Run("most stake", func() {
+		for _, addr := range simulate(rollapptypes.ProposerSelection{}) {
+			s.Require().Equal(pkAddr(david), addr)
+		}
+	})
+
+	s.Run("weighted random", func() {
+		counts := make(map[string]int)
+		for _, addr := range simulate(rollapptypes.ProposerSelection{Strategy: rollapptypes.ProposerSelection_WeightedRandom}) {
+			counts[addr]++
+		}
+		// each is chosen about stake/10 of the time
+		for i, pk := range pks {
+			expect := rounds * stakes[i] / 10
+			s.Require().InDelta(expect, counts[pkAddr(pk)], float64(rounds)/20, "sequencer %d", i)
+		}
+	})
+
+	s.Run("weighted random ignores the current block hash", func() {
+		s.setProposerSelection(ra.RollappId, rollapptypes.ProposerSelection{Strategy: rollapptypes.ProposerSelection_WeightedRandom})
+		var chosen []string
+		for range 50 {
+			hash := make([]byte, 32)
+			rng.Read(hash)
+			s.Ctx = s.Ctx.WithHeaderHash(hash)
+			s.k().SetProposer(s.Ctx, ra.RollappId, types.SentinelSeqAddr)
+			s.Require().NoError(s.k().ChooseProposerAfterSentinel(s.Ctx, ra.RollappId))
+			chosen = append(chosen, s.k().GetProposer(s.Ctx, ra.RollappId).Address)
+		}
+		// the block proposer cannot grind the draw
+		for _, addr := range chosen {
+			s.Require().Equal(chosen[0], addr)
+		}
+	})
+
+	s.Run("round robin", func() {
+		chosen := simulate(rollapptypes.ProposerSelection{Strategy: rollapptypes.ProposerSelection_RoundRobin})
+		start := slices.IndexFunc(byAddr, func(pk cryptotypes.PubKey) bool { return pkAddr(pk) == chosen[0] })
+		for i, addr := range chosen {
+			s.Require().Equal(pkAddr(byAddr[(start+i)%len(byAddr)]), addr)
+		}
+	})
+
+	s.Run("priority list", func() {
+		selection := rollapptypes.ProposerSelection{
+			Strategy:     rollapptypes.ProposerSelection_PriorityList,
+			PriorityList: []string{pkAddr(charlie), pkAddr(alice)},
+		}
+		for _, addr := range simulate(selection) {
+			s.Require().Equal(pkAddr(charlie), addr)
+		}
+
+		// charlie is no longer a potential proposer
+		seq := s.seq(charlie)
+		s.Require().NoError(seq.SetOptedIn(s.Ctx, false))
+		s.k().SetSequencer(s.Ctx, seq)
+		for _, addr := range simulate(selection) {
+			s.Require().Equal(pkAddr(alice), addr)
+		}
+
+		// neither is, fall back to most stake
+		seq = s.seq(alice)
+		s.Require().NoError(seq.SetOptedIn(s.Ctx, false))
+		s.k().SetSequencer(s.Ctx, seq)
+		for _, addr := range simulate(selection) {
+			s.Require().Equal(pkAddr(david), addr)
+		}
+	})
+}
+
+// The strategy also picks the successor of a proposer which rotates out.
+func (s *SequencerTestSuite) TestRotationWithRoundRobin() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, ucoin.SimpleMul(bond, 2))
+	s.setProposerSelection(ra.RollappId, rollapptypes.ProposerSelection{Strategy: rollapptypes.ProposerSelection_RoundRobin})
+	s.submitAFewRollappStates(ra.RollappId)
+
+	prop := s.k().GetProposer(s.Ctx, ra.RollappId)
+	res, err := s.msgServer.Unbond(s.Ctx, &types.MsgUnbond{Creator: prop.Address})
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockTime(*res.GetNoticePeriodCompletionTime())
+	s.Require().NoError(s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime()))
+
+	// the sequencer after the proposer in address order, not the one with most stake
+	want, err := keeper.RoundRobinChoiceAlgo(s.k().RollappPotentialProposers(s.Ctx, ra.RollappId), prop.Address)
+	s.Require().NoError(err)
+	s.Require().Equal(want.Address, s.k().GetSuccessor(s.Ctx, ra.RollappId).Address)
+	s.Require().NotEqual(prop.Address, want.Address)
+}
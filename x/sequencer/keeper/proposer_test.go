@@ -75,7 +75,7 @@ func Test_proposerChoiceAlgo(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			want := tt.args.seqs[tt.want]
-			if got, _ := keeper.ProposerChoiceAlgo(tt.args.seqs); !reflect.DeepEqual(got, want) {
+			if got, _ := keeper.ProposerChoiceAlgo(tt.args.seqs, types.Sequencer.TokensCoin); !reflect.DeepEqual(got, want) {
 				t.Errorf("proposerChoiceAlgo() = %v, want %v", got, want)
 			}
 		})
@@ -50,6 +50,15 @@ func (k Keeper) ChooseSuccessorForFinishedNotices(ctx sdk.Context, now time.Time
 			return errorsmod.Wrap(err, "choose successor")
 		}
 		successor := k.GetSuccessor(ctx, seq.RollappId)
+		if successor.Sentinel() && seq.IsPotentialProposer() {
+			// a scheduled rotation but nobody is left to take over: keep serving
+			seq.NoticePeriodTime = time.Time{}
+			k.SetSequencer(ctx, seq)
+			if err := k.restartProposerTerm(ctx, seq.RollappId); err != nil {
+				return errorsmod.Wrap(err, "start proposer term")
+			}
+			continue
+		}
 		ctx.EventManager().EmitEvent(
 			sdk.NewEvent(
 				types.EventTypeRotationStarted,
@@ -87,14 +96,25 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 	k.SetSuccessor(ctx, rollapp, types.SentinelSeqAddr) // clear successor
 	k.SetProposer(ctx, rollapp, successor.Address)
 
+	// rotated out on schedule rather than unbonding: may serve again later
+	if proposer.IsPotentialProposer() {
+		proposer.NoticePeriodTime = time.Time{}
+		k.SetSequencer(ctx, proposer)
+	}
+
 	// if successor is sentinel, prepare new revision for the rollapp
 	if successor.Sentinel() {
 		err := k.rollappKeeper.HardForkToLatest(ctx, rollapp)
 		if err != nil {
 			return errorsmod.Wrap(err, "hard fork to latest")
 		}
-	} else if err := k.hooks.AfterSetRealProposer(ctx, rollapp, successor); err != nil {
-		return errorsmod.Wrap(err, "after set real sequencer")
+	} else {
+		if err := k.startProposerTerm(ctx, rollapp, successor.Address); err != nil {
+			return errorsmod.Wrap(err, "start proposer term")
+		}
+		if err := k.hooks.AfterSetRealProposer(ctx, rollapp, successor); err != nil {
+			return errorsmod.Wrap(err, "after set real sequencer")
+		}
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -108,11 +128,14 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 }
 
 // setSuccessorForRotatingRollapp will assign a successor to the rollapp.
-// It will prioritize non sentinel
+// It will prioritize non sentinel, and never choose the rotating proposer.
 // called when a proposer has finished their notice period.
 func (k Keeper) setSuccessorForRotatingRollapp(ctx sdk.Context, rollapp string) error {
-	seqs := k.RollappPotentialProposers(ctx, rollapp)
-	successor, err := ProposerChoiceAlgo(seqs)
+	proposer := k.GetProposer(ctx, rollapp)
+	seqs := slices.DeleteFunc(k.RollappPotentialProposers(ctx, rollapp), func(seq types.Sequencer) bool {
+		return seq.Address == proposer.Address
+	})
+	successor, err := k.chooseProposer(ctx, rollapp, seqs)
 	if err != nil {
 		return err
 	}
@@ -120,16 +143,16 @@ func (k Keeper) setSuccessorForRotatingRollapp(ctx sdk.Context, rollapp string)
 	return nil
 }
 
-// ProposerChoiceAlgo : choose the one with most bond
+// ProposerChoiceAlgo : choose the one with most stake
 // Requires sentinel to be passed in, as last resort.
-func ProposerChoiceAlgo(seqs []types.Sequencer) (types.Sequencer, error) {
+func ProposerChoiceAlgo(seqs []types.Sequencer, stake func(types.Sequencer) sdk.Coin) (types.Sequencer, error) {
 	if len(seqs) == 0 {
 		return types.Sequencer{}, gerrc.ErrInternal.Wrap("seqs must at least include sentinel")
 	}
 	// slices package is recommended over sort package
 	slices.SortStableFunc(seqs, func(a, b types.Sequencer) int {
-		ca := a.TokensCoin()
-		cb := b.TokensCoin()
+		ca := stake(a)
+		cb := stake(b)
 		if ca.IsEqual(cb) {
 			return 0
 		}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"errors"
+	"slices"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) SetProposerTerm(ctx sdk.Context, term types.ProposerTerm) error {
+	return k.proposerTerms.Set(ctx, term.RollappId, term)
+}
+
+func (k Keeper) AllProposerTerms(ctx sdk.Context) ([]types.ProposerTerm, error) {
+	iter, err := k.proposerTerms.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	return iter.Values()
+}
+
+// startProposerTerm is called when a real proposer is set for the rollapp. The
+// term is left behind when the sentinel takes over, it is only read while a
+// real proposer serves. The proposer's first state update of the term has no
+// latency: the time since its last term is not its fault.
+func (k Keeper) startProposerTerm(ctx sdk.Context, rollapp, proposer string) error {
+	h, err := k.GetPerformanceHistory(ctx, proposer)
+	if err != nil {
+		return err
+	}
+	if !h.LastStateUpdate.IsZero() {
+		h.LastStateUpdate = time.Time{}
+		if err := k.SetPerformanceHistory(ctx, h); err != nil {
+			return err
+		}
+	}
+	return k.restartProposerTerm(ctx, rollapp)
+}
+
+// restartProposerTerm starts a new term for the proposer already serving the
+// rollapp.
+func (k Keeper) restartProposerTerm(ctx sdk.Context, rollapp string) error {
+	return k.SetProposerTerm(ctx, types.ProposerTerm{RollappId: rollapp, Start: ctx.BlockTime()})
+}
+
+// tryScheduledRotation starts the notice period of the proposer if it served
+// the rotation period of its rollapp, so the usual rotation follows: a
+// successor is chosen when the notice elapses and takes over after the
+// proposer's last block. Nothing happens if nobody else could take over.
+// It runs on the proposer's state updates: a rollapp which stops producing
+// blocks is dealt with by liveness slashing instead.
+func (k Keeper) tryScheduledRotation(ctx sdk.Context, prop types.Sequencer) error {
+	ra, ok := k.rollappKeeper.GetRollapp(ctx, prop.RollappId)
+	if !ok {
+		return nil
+	}
+	period := ra.ProposerSelection.RotationPeriod
+	if period == 0 || prop.Sentinel() || k.RotationInProgress(ctx, prop.RollappId) {
+		return nil
+	}
+
+	term, err := k.proposerTerms.Get(ctx, prop.RollappId)
+	if errors.Is(err, collections.ErrNotFound) {
+		// serving since before the rotation period was set
+		return k.restartProposerTerm(ctx, prop.RollappId)
+	}
+	if err != nil {
+		return err
+	}
+	if ctx.BlockTime().Before(term.Start.Add(period)) {
+		return nil
+	}
+	if !slices.ContainsFunc(k.RollappPotentialProposers(ctx, prop.RollappId), func(seq types.Sequencer) bool {
+		return !seq.Sentinel() && seq.Address != prop.Address
+	}) {
+		return nil
+	}
+
+	k.StartNoticePeriod(ctx, &prop)
+	k.SetSequencer(ctx, prop)
+
+	return uevent.EmitTypedEvent(ctx, &types.EventScheduledRotation{
+		Rollapp:   prop.RollappId,
+		Proposer:  prop.Address,
+		TermStart: term.Start,
+	})
+}
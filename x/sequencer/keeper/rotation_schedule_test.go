@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+)
+
+func (s *SequencerTestSuite) setRotationPeriod(rollapp string, period time.Duration) {
+	ra := s.raK().MustGetRollapp(s.Ctx, rollapp)
+	ra.ProposerSelection.RotationPeriod = period
+	s.raK().SetRollapp(s.Ctx, ra)
+}
+
+// The proposers take turns, each serving the rotation period then handing over
+// with the usual notice period and last block.
+func (s *SequencerTestSuite) TestScheduledRotation() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 2))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.setRotationPeriod(ra.RollappId, time.Hour)
+	notice := s.k().GetParams(s.Ctx).NoticePeriod
+
+	prop, succ := alice, bob
+	for range 3 {
+		s.Require().True(s.k().IsProposer(s.Ctx, s.seq(prop)))
+
+		// not served long enough
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour - time.Second))
+		s.submitAFewRollappStates(ra.RollappId)
+		s.Require().False(s.k().RotationInProgress(s.Ctx, ra.RollappId))
+
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+		s.submitAFewRollappStates(ra.RollappId)
+		s.Require().True(s.seq(prop).NoticeInProgress(s.Ctx.BlockTime()))
+		s.checkInvariants()
+
+		// the usual rotation follows
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(notice))
+		s.Require().NoError(s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime()))
+		s.Require().True(s.k().IsSuccessor(s.Ctx, s.seq(succ)))
+		s.Require().NoError(s.k().OnProposerLastBlock(s.Ctx, s.seq(prop)))
+		s.Require().True(s.k().IsProposer(s.Ctx, s.seq(succ)))
+
+		// the old proposer can serve again
+		old := s.seq(prop)
+		s.Require().True(old.IsPotentialProposer())
+		s.Require().False(old.NoticeStarted())
+		s.checkInvariants()
+
+		prop, succ = succ, prop
+	}
+}
+
+func (s *SequencerTestSuite) TestScheduledRotationNobodyToTakeOver() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.setRotationPeriod(ra.RollappId, time.Hour)
+	notice := s.k().GetParams(s.Ctx).NoticePeriod
+
+	// alone: keeps serving
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	s.submitAFewRollappStates(ra.RollappId)
+	s.Require().False(s.k().RotationInProgress(s.Ctx, ra.RollappId))
+
+	// bob shows up, then leaves during the notice
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.submitAFewRollappStates(ra.RollappId)
+	s.Require().True(s.k().RotationInProgress(s.Ctx, ra.RollappId))
+	seq := s.seq(bob)
+	s.Require().NoError(seq.SetOptedIn(s.Ctx, false))
+	s.k().SetSequencer(s.Ctx, seq)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(notice))
+	s.Require().NoError(s.k().ChooseSuccessorForFinishedNotices(s.Ctx, s.Ctx.BlockTime()))
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+	s.Require().False(s.k().RotationInProgress(s.Ctx, ra.RollappId))
+	s.checkInvariants()
+
+	// a new term started
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour - time.Second))
+	s.Require().NoError(seq.SetOptedIn(s.Ctx, true))
+	s.k().SetSequencer(s.Ctx, seq)
+	s.submitAFewRollappStates(ra.RollappId)
+	s.Require().False(s.k().RotationInProgress(s.Ctx, ra.RollappId))
+}
+
+func (s *SequencerTestSuite) TestScheduledRotationDisabled() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 2))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.Require().Zero(s.raK().MustGetRollapp(s.Ctx, ra.RollappId).ProposerSelection.RotationPeriod)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(365 * 24 * time.Hour))
+	s.submitAFewRollappStates(ra.RollappId)
+	s.Require().False(s.k().RotationInProgress(s.Ctx, ra.RollappId))
+
+	// the term counts from when the proposer was chosen
+	s.setRotationPeriod(ra.RollappId, time.Hour)
+	s.submitAFewRollappStates(ra.RollappId)
+	s.Require().True(s.k().RotationInProgress(s.Ctx, ra.RollappId))
+}
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// startUnbonding pays amt, which already left the sequencer's active stake, to
+// the recipient once the unbonding period elapses. Until then the tokens stay
+// in the module and can be slashed. Without an unbonding period they are paid
+// at once.
+func (k Keeper) startUnbonding(ctx sdk.Context, seqAddr string, recipient sdk.AccAddress, amt sdk.Coin) error {
+	period := k.GetParams(ctx).UnbondingPeriod
+	if period == 0 {
+		return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amt))
+	}
+	if amt.IsZero() {
+		return nil
+	}
+
+	id, err := k.unbondingSeq.Next(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "next unbonding id")
+	}
+	entry := types.UnbondingEntry{
+		Id:             id,
+		Sequencer:      seqAddr,
+		Recipient:      recipient.String(),
+		Amount:         amt,
+		CompletionTime: ctx.BlockTime().Add(period),
+	}
+	if err := k.SetUnbonding(ctx, entry); err != nil {
+		return err
+	}
+	return uevent.EmitTypedEvent(ctx, &types.EventUnbondingStarted{Entry: entry})
+}
+
+func (k Keeper) SetUnbonding(ctx sdk.Context, entry types.UnbondingEntry) error {
+	if err := k.unbondings.Set(ctx, collections.Join(entry.Sequencer, entry.Id), entry); err != nil {
+		return err
+	}
+	return k.unbondingQueue.Set(ctx, collections.Join3(entry.CompletionTime, entry.Sequencer, entry.Id))
+}
+
+// SetNextUnbondingID sets the id of the next unbonding, used in genesis.
+func (k Keeper) SetNextUnbondingID(ctx sdk.Context, id uint64) error {
+	return k.unbondingSeq.Set(ctx, id)
+}
+
+func (k Keeper) removeUnbonding(ctx sdk.Context, entry types.UnbondingEntry) error {
+	if err := k.unbondings.Remove(ctx, collections.Join(entry.Sequencer, entry.Id)); err != nil {
+		return err
+	}
+	return k.unbondingQueue.Remove(ctx, collections.Join3(entry.CompletionTime, entry.Sequencer, entry.Id))
+}
+
+// SequencerUnbondings returns the pending unbondings of the sequencer's bond
+// and delegations.
+func (k Keeper) SequencerUnbondings(ctx sdk.Context, seqAddr string) ([]types.UnbondingEntry, error) {
+	iter, err := k.unbondings.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](seqAddr))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	return iter.Values()
+}
+
+func (k Keeper) AllUnbondings(ctx sdk.Context) ([]types.UnbondingEntry, error) {
+	iter, err := k.unbondings.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close() // nolint: errcheck
+	return iter.Values()
+}
+
+// UnbondingTotal is the amount of all the sequencer's pending unbondings.
+func (k Keeper) UnbondingTotal(ctx sdk.Context, seqAddr string) (sdk.Coin, error) {
+	total := sdk.NewCoin(commontypes.DYMCoin.Denom, math.ZeroInt())
+	entries, err := k.SequencerUnbondings(ctx, seqAddr)
+	if err != nil {
+		return total, err
+	}
+	for _, e := range entries {
+		total = total.Add(e.Amount)
+	}
+	return total, nil
+}
+
+// CompleteMatureUnbondings pays out the unbondings whose period elapsed by
+// now. A payout that fails is left in the queue and retried next block.
+func (k Keeper) CompleteMatureUnbondings(ctx sdk.Context, now time.Time) error {
+	var mature []types.UnbondingEntry
+	rng := collections.NewPrefixUntilTripleRange[time.Time, string, uint64](now)
+	err := k.unbondingQueue.Walk(ctx, rng, func(key collections.Triple[time.Time, string, uint64]) (stop bool, err error) {
+		entry, err := k.unbondings.Get(ctx, collections.Join(key.K2(), key.K3()))
+		if err != nil {
+			return true, errorsmod.Wrapf(err, "queued unbonding %d of sequencer %s", key.K3(), key.K2())
+		}
+		mature = append(mature, entry)
+		return false, nil
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "walk unbonding queue")
+	}
+
+	for _, entry := range mature {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			recipient := sdk.MustAccAddressFromBech32(entry.Recipient)
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(entry.Amount)); err != nil {
+				return err
+			}
+			return k.removeUnbonding(ctx, entry)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Complete unbonding.", "id", entry.Id, "sequencer", entry.Sequencer, "err", err)
+			continue
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventUnbondingCompleted{Entry: entry}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// slashUnbondings slashes fraction of every pending unbonding of the
+// sequencer, so stake which left shortly before a fraud was found is still
+// held liable.
+func (k Keeper) slashUnbondings(ctx sdk.Context, seqAddr string, fraction, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+	entries, err := k.SequencerUnbondings(ctx, seqAddr)
+	if err != nil {
+		return err
+	}
+	total := sdk.NewCoin(commontypes.DYMCoin.Denom, math.ZeroInt())
+	for _, entry := range entries {
+		slashed := ucoin.MulDec(fraction, entry.Amount)[0]
+		if slashed.IsZero() {
+			continue
+		}
+		entry.Amount = entry.Amount.Sub(slashed)
+		if entry.Amount.IsZero() {
+			err = k.removeUnbonding(ctx, entry)
+		} else {
+			err = k.SetUnbonding(ctx, entry)
+		}
+		if err != nil {
+			return err
+		}
+		total = total.Add(slashed)
+	}
+	if total.IsZero() {
+		return nil
+	}
+
+	rewardCoin := ucoin.MulDec(rewardMul, total)[0]
+	if !rewardCoin.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, rewardee, sdk.NewCoins(rewardCoin)); err != nil {
+			return errorsmod.Wrap(err, "send")
+		}
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(total.Sub(rewardCoin))); err != nil {
+		return errorsmod.Wrap(err, "burn")
+	}
+
+	return uevent.EmitTypedEvent(ctx, &types.EventUnbondingsSlashed{
+		Sequencer: seqAddr,
+		Amount:    total,
+	})
+}
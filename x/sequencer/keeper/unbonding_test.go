@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"time"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) setUnbondingPeriod(period time.Duration) {
+	params := s.k().GetParams(s.Ctx)
+	params.UnbondingPeriod = period
+	s.k().SetParams(s.Ctx, params)
+}
+
+func (s *SequencerTestSuite) balance(pk cryptotypes.PubKey) sdk.Coin {
+	return s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(pk), bond.Denom)
+}
+
+func (s *SequencerTestSuite) unbondings(seqAddr string) *types.QueryUnbondingsResponse {
+	res, err := s.queryClient.Unbondings(s.Ctx, &types.QueryUnbondingsRequest{Sequencer: seqAddr})
+	s.Require().NoError(err)
+	return res
+}
+
+func (s *SequencerTestSuite) TestUnbondingQueue() {
+	s.setUnbondingPeriod(time.Hour)
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 3))
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey())) // make not proposer so it's allowed
+	s.fundSequencer(david, bond)
+	s.Require().NoError(s.delegate(pkAddr(david), seq.Address, bond))
+
+	_, err := s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{Creator: seq.Address, DecreaseAmount: bond})
+	s.Require().NoError(err)
+	s.Require().NoError(s.undelegate(pkAddr(david), seq.Address, bond))
+
+	// the stake left but the tokens are held
+	s.Require().True(ucoin.SimpleMul(bond, 2).IsEqual(s.seq(alice).TokensCoin()))
+	s.Require().True(ucoin.SimpleMul(bond, 4).IsEqual(s.moduleBalance()))
+	s.Require().True(s.balance(alice).IsZero())
+	s.Require().True(s.balance(david).IsZero())
+	res := s.unbondings(seq.Address)
+	s.Require().Len(res.Unbondings, 2)
+	s.Require().True(ucoin.SimpleMul(bond, 2).IsEqual(res.Total))
+	s.Require().Equal(pkAddr(alice), res.Unbondings[0].Recipient)
+	s.Require().Equal(pkAddr(david), res.Unbondings[1].Recipient)
+	s.checkInvariants()
+
+	// not mature yet
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour - time.Second))
+	s.Require().NoError(s.k().CompleteMatureUnbondings(s.Ctx, s.Ctx.BlockTime()))
+	s.Require().Len(s.unbondings(seq.Address).Unbondings, 2)
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+	s.Require().NoError(s.k().CompleteMatureUnbondings(s.Ctx, s.Ctx.BlockTime()))
+	s.Require().Empty(s.unbondings(seq.Address).Unbondings)
+	s.Require().True(bond.IsEqual(s.balance(alice)))
+	s.Require().True(bond.IsEqual(s.balance(david)))
+	s.Require().True(ucoin.SimpleMul(bond, 2).IsEqual(s.moduleBalance()))
+	s.checkInvariants()
+}
+
+func (s *SequencerTestSuite) TestUnbondingWithoutPeriod() {
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 2))
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey())) // make not proposer so it's allowed
+
+	_, err := s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{Creator: seq.Address, DecreaseAmount: bond})
+	s.Require().NoError(err)
+	s.Require().True(bond.IsEqual(s.balance(alice)))
+	s.Require().Empty(s.unbondings(seq.Address).Unbondings)
+}
+
+func (s *SequencerTestSuite) TestSlashUnbondings() {
+	s.setUnbondingPeriod(time.Hour)
+	ra := s.createRollapp()
+	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, ucoin.SimpleMul(bond, 2))
+	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(randomTMPubKey())) // make not proposer so it's allowed
+	s.fundSequencer(david, bond)
+	s.Require().NoError(s.delegate(pkAddr(david), seq.Address, bond))
+
+	_, err := s.msgServer.DecreaseBond(s.Ctx, &types.MsgDecreaseBond{Creator: seq.Address, DecreaseAmount: bond})
+	s.Require().NoError(err)
+	s.Require().NoError(s.undelegate(pkAddr(david), seq.Address, bond))
+
+	// the bond and the delegation which left are slashed too, half goes to the rewardee
+	rewardee := pkAcc(eve)
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, seq.Address, &rewardee))
+	s.Require().Empty(s.unbondings(seq.Address).Unbondings)
+	s.Require().True(s.moduleBalance().IsZero())
+	s.Require().True(ucoin.SimpleMul(bond, 3).Amount.QuoRaw(2).Equal(s.balance(eve).Amount))
+	s.checkInvariants()
+
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Hour))
+	s.Require().NoError(s.k().CompleteMatureUnbondings(s.Ctx, s.Ctx.BlockTime()))
+	s.Require().True(s.balance(alice).IsZero())
+	s.Require().True(s.balance(david).IsZero())
+}
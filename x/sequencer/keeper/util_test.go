@@ -147,7 +147,10 @@ func (s *SequencerTestSuite) createRollappWithInitialSeqConstraint(initSeq strin
 
 func (s *SequencerTestSuite) submitAFewRollappStates(rollapp string) {
 	p := s.k().GetProposer(s.Ctx, rollapp)
-	h, _ := s.App.RollappKeeper.GetLatestHeight(s.Ctx, rollapp)
+	h, ok := s.App.RollappKeeper.GetLatestHeight(s.Ctx, rollapp)
+	if ok {
+		h++
+	}
 	_, err := s.PostStateUpdate(s.Ctx, rollapp, p.Address, h, 10)
 	s.Require().NoError(err)
 }
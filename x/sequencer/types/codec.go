@@ -21,6 +21,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPunishSequencer{}, "sequencer/PunishSequencer", nil)
 	cdc.RegisterConcrete(&MsgUpdateSequencerInformation{}, "sequencer/UpdateSequencerInformation", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "sequencer/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgDelegate{}, "sequencer/Delegate", nil)
+	cdc.RegisterConcrete(&MsgUndelegate{}, "sequencer/Undelegate", nil)
+	cdc.RegisterConcrete(&MsgWithdrawDelegatorRewards{}, "sequencer/WithdrawDelegatorRewards", nil)
+	cdc.RegisterConcrete(&MsgFundSequencerRewards{}, "sequencer/FundSequencerRewards", nil)
+	cdc.RegisterConcrete(&MsgUpdateCommission{}, "sequencer/UpdateCommission", nil)
 	cdc.RegisterConcrete(Params{}, "sequencer/Params", nil)
 }
 
@@ -37,6 +42,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgPunishSequencer{},
 		&MsgUpdateSequencerInformation{},
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgWithdrawDelegatorRewards{},
+		&MsgFundSequencerRewards{},
+		&MsgUpdateCommission{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,125 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+)
+
+// NewDelegationPool returns the pool of a sequencer nobody delegated to yet.
+func NewDelegationPool(seqAddr string) DelegationPool {
+	return DelegationPool{
+		Sequencer:      seqAddr,
+		Tokens:         sdk.NewCoin(commontypes.DYMCoin.Denom, math.ZeroInt()),
+		Shares:         math.LegacyZeroDec(),
+		CommissionRate: math.LegacyZeroDec(),
+	}
+}
+
+func NewDelegation(seqAddr, delegator string) Delegation {
+	return Delegation{
+		Delegator: delegator,
+		Sequencer: seqAddr,
+		Shares:    math.LegacyZeroDec(),
+	}
+}
+
+// HasStake is false once all delegations are gone or slashed away.
+func (p DelegationPool) HasStake() bool {
+	return p.Tokens.IsPositive() && p.Shares.IsPositive()
+}
+
+// SharesFromTokens returns the shares issued for delegating amt. A pool slashed
+// to zero cannot price new shares.
+func (p DelegationPool) SharesFromTokens(amt math.Int) (math.LegacyDec, error) {
+	if p.Shares.IsZero() {
+		return math.LegacyNewDecFromInt(amt), nil
+	}
+	if p.Tokens.IsZero() {
+		return math.LegacyDec{}, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "delegation pool slashed to zero: its delegations must be withdrawn first")
+	}
+	return p.Shares.MulInt(amt).QuoInt(p.Tokens.Amount), nil
+}
+
+// TokensFromShares returns what shares are worth.
+func (p DelegationPool) TokensFromShares(shares math.LegacyDec) math.LegacyDec {
+	if p.Shares.IsZero() {
+		return math.LegacyZeroDec()
+	}
+	return shares.MulInt(p.Tokens.Amount).Quo(p.Shares)
+}
+
+// AddRewards shares rewards between the current shares of the pool.
+func (p *DelegationPool) AddRewards(rewards sdk.Coins) {
+	perShare := sdk.NewDecCoinsFromCoins(rewards...).QuoDecTruncate(p.Shares)
+	p.RewardsPerShare = p.RewardsPerShare.Add(perShare...)
+}
+
+// PromotePendingCommission applies the pending commission rate once its time
+// has come.
+func (p *DelegationPool) PromotePendingCommission(now time.Time) {
+	if p.PendingCommissionRate == nil || now.Before(p.PendingCommissionTime) {
+		return
+	}
+	p.CommissionRate = *p.PendingCommissionRate
+	p.PendingCommissionRate = nil
+	p.PendingCommissionTime = time.Time{}
+}
+
+func (p DelegationPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "sequencer: %s", err)
+	}
+	if err := p.Tokens.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "tokens: %s", err)
+	}
+	if p.Shares.IsNil() || p.Shares.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "shares")
+	}
+	if p.Shares.IsZero() && !p.Tokens.IsZero() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "tokens without shares")
+	}
+	if err := p.RewardsPerShare.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "rewards per share: %s", err)
+	}
+	if p.PendingCommissionRate != nil {
+		if err := ValidateCommissionRate(*p.PendingCommissionRate); err != nil {
+			return errorsmod.Wrap(err, "pending")
+		}
+	}
+	return ValidateCommissionRate(p.CommissionRate)
+}
+
+// PendingRewards returns the rewards accrued since the delegation was last paid.
+func (d Delegation) PendingRewards(pool DelegationPool) sdk.Coins {
+	rewards, _ := pool.RewardsPerShare.Sub(d.RewardsPerShare).MulDecTruncate(d.Shares).TruncateDecimal()
+	return rewards
+}
+
+func (d Delegation) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(d.Delegator); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "delegator: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(d.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "sequencer: %s", err)
+	}
+	if d.Shares.IsNil() || !d.Shares.IsPositive() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "shares")
+	}
+	if err := d.RewardsPerShare.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "rewards per share: %s", err)
+	}
+	return nil
+}
+
+func ValidateCommissionRate(rate math.LegacyDec) error {
+	if rate.IsNil() || rate.IsNegative() || rate.GT(math.LegacyOneDec()) {
+		return errorsmod.Wrapf(ErrInvalidCommissionRate, "must be in [0, 1]: %s", rate)
+	}
+	return nil
+}
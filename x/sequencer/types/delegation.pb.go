@@ -0,0 +1,1005 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/delegation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelegationPool is the stake delegated to a sequencer by third parties. It is
+// kept apart from the sequencer's own bond: the min bond of the rollapp only
+// applies to the latter.
+type DelegationPool struct {
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// tokens delegated to the sequencer, net of slashing
+	Tokens types.Coin `protobuf:"bytes,2,opt,name=tokens,proto3" json:"tokens"`
+	// shares issued to delegators, each worth tokens/shares
+	Shares cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+	// commission_rate is the part of the sequencer rewards kept by the operator
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// rewards_per_share accumulates the delegators' part of the rewards, per
+	// share
+	RewardsPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=rewards_per_share,json=rewardsPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_share"`
+	// pending_commission_rate, if set, replaces commission_rate at
+	// pending_commission_time. A raise waits out the unbonding period, so
+	// delegators can leave before it applies.
+	PendingCommissionRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=pending_commission_rate,json=pendingCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pending_commission_rate,omitempty"`
+	PendingCommissionTime time.Time                    `protobuf:"bytes,7,opt,name=pending_commission_time,json=pendingCommissionTime,proto3,stdtime" json:"pending_commission_time"`
+}
+
+func (m *DelegationPool) Reset()         { *m = DelegationPool{} }
+func (m *DelegationPool) String() string { return proto.CompactTextString(m) }
+func (*DelegationPool) ProtoMessage()    {}
+func (*DelegationPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60a0c98180ab4a43, []int{0}
+}
+func (m *DelegationPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelegationPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelegationPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelegationPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelegationPool.Merge(m, src)
+}
+func (m *DelegationPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelegationPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelegationPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelegationPool proto.InternalMessageInfo
+
+func (m *DelegationPool) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *DelegationPool) GetTokens() types.Coin {
+	if m != nil {
+		return m.Tokens
+	}
+	return types.Coin{}
+}
+
+func (m *DelegationPool) GetRewardsPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerShare
+	}
+	return nil
+}
+
+func (m *DelegationPool) GetPendingCommissionTime() time.Time {
+	if m != nil {
+		return m.PendingCommissionTime
+	}
+	return time.Time{}
+}
+
+// Delegation is a delegator's shares of a sequencer's delegation pool.
+type Delegation struct {
+	Delegator string                      `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Sequencer string                      `protobuf:"bytes,2,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Shares    cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=shares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"shares"`
+	// rewards_per_share of the pool when the delegation's rewards were last paid
+	RewardsPerShare github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=rewards_per_share,json=rewardsPerShare,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"rewards_per_share"`
+}
+
+func (m *Delegation) Reset()         { *m = Delegation{} }
+func (m *Delegation) String() string { return proto.CompactTextString(m) }
+func (*Delegation) ProtoMessage()    {}
+func (*Delegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_60a0c98180ab4a43, []int{1}
+}
+func (m *Delegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delegation.Merge(m, src)
+}
+func (m *Delegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delegation proto.InternalMessageInfo
+
+func (m *Delegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *Delegation) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *Delegation) GetRewardsPerShare() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.RewardsPerShare
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DelegationPool)(nil), "dymensionxyz.dymension.sequencer.DelegationPool")
+	proto.RegisterType((*Delegation)(nil), "dymensionxyz.dymension.sequencer.Delegation")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/delegation.proto", fileDescriptor_60a0c98180ab4a43)
+}
+
+var fileDescriptor_60a0c98180ab4a43 = []byte{
+	// 529 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xc1, 0x6a, 0xdb, 0x4a,
+	0x14, 0xb5, 0x1c, 0x3f, 0xbf, 0x66, 0x02, 0x09, 0x15, 0x29, 0x55, 0xdc, 0x22, 0x9b, 0xac, 0x0c,
+	0xc5, 0x33, 0x38, 0x86, 0x74, 0x5d, 0xc7, 0x9b, 0x42, 0x17, 0xc6, 0xe9, 0x2a, 0x14, 0xc4, 0x48,
+	0xba, 0x95, 0x07, 0x5b, 0x1a, 0x75, 0x66, 0x9c, 0xc6, 0x85, 0xfe, 0x43, 0xbe, 0xa3, 0xeb, 0x7c,
+	0x43, 0xc9, 0x32, 0x64, 0x55, 0xba, 0x48, 0x8a, 0xfd, 0x03, 0xfd, 0x84, 0x32, 0xd2, 0x58, 0x76,
+	0x8a, 0x0b, 0x69, 0x29, 0x5d, 0x49, 0x97, 0xb9, 0xe7, 0x9c, 0x7b, 0x74, 0x8f, 0x06, 0xb5, 0xc3,
+	0x69, 0x0c, 0x89, 0x64, 0x3c, 0x39, 0x9b, 0x7e, 0x20, 0x45, 0x41, 0x24, 0xbc, 0x9b, 0x40, 0x12,
+	0x80, 0x20, 0x21, 0x8c, 0x21, 0xa2, 0x8a, 0xf1, 0x04, 0xa7, 0x82, 0x2b, 0x6e, 0x37, 0x56, 0x21,
+	0xb8, 0x28, 0x70, 0x01, 0xa9, 0xed, 0x46, 0x3c, 0xe2, 0x59, 0x33, 0xd1, 0x6f, 0x39, 0xae, 0xb6,
+	0x17, 0x70, 0x19, 0x73, 0xe9, 0xe5, 0x07, 0x79, 0x61, 0x8e, 0xdc, 0xbc, 0x22, 0x3e, 0x95, 0x40,
+	0x4e, 0xdb, 0x3e, 0x28, 0xda, 0x26, 0x01, 0x67, 0x46, 0xb2, 0x56, 0x8f, 0x38, 0x8f, 0xc6, 0x40,
+	0xb2, 0xca, 0x9f, 0xbc, 0x25, 0x8a, 0xc5, 0x20, 0x15, 0x8d, 0xd3, 0xbc, 0x61, 0xff, 0x7b, 0x05,
+	0x6d, 0xf7, 0x8a, 0x41, 0xfb, 0x9c, 0x8f, 0xed, 0x43, 0xb4, 0x59, 0x4c, 0xe4, 0x58, 0x0d, 0xab,
+	0xb9, 0xd9, 0x75, 0xae, 0x2f, 0x5a, 0xbb, 0x46, 0xf8, 0x45, 0x18, 0x0a, 0x90, 0xf2, 0x58, 0x09,
+	0x96, 0x44, 0x83, 0x65, 0xab, 0xfd, 0x1c, 0x55, 0x15, 0x1f, 0x41, 0x22, 0x9d, 0x72, 0xc3, 0x6a,
+	0x6e, 0x1d, 0xec, 0x61, 0x83, 0xd0, 0xc3, 0x61, 0x33, 0x1c, 0x3e, 0xe2, 0x2c, 0xe9, 0x56, 0x2e,
+	0x6f, 0xea, 0xa5, 0x81, 0x69, 0xb7, 0x5f, 0xa2, 0xaa, 0x1c, 0x52, 0x01, 0xd2, 0xd9, 0xc8, 0xd4,
+	0xda, 0xfa, 0xf4, 0xeb, 0x4d, 0xfd, 0x49, 0x8e, 0x97, 0xe1, 0x08, 0x33, 0x4e, 0x62, 0xaa, 0x86,
+	0xf8, 0x15, 0x44, 0x34, 0x98, 0xf6, 0x20, 0xb8, 0xbe, 0x68, 0x21, 0x43, 0xdf, 0x83, 0x60, 0x60,
+	0x08, 0xec, 0x13, 0xb4, 0x13, 0xf0, 0x38, 0x66, 0x52, 0x7f, 0x58, 0x4f, 0x50, 0x05, 0x4e, 0xe5,
+	0x4f, 0x39, 0xb7, 0x97, 0x4c, 0x03, 0xaa, 0xc0, 0xfe, 0x88, 0x1e, 0x0a, 0x78, 0x4f, 0x45, 0x28,
+	0xbd, 0x14, 0x84, 0x97, 0x29, 0x3a, 0xff, 0x35, 0x36, 0x9a, 0x5b, 0x07, 0x4f, 0xd7, 0x5a, 0xed,
+	0x41, 0x90, 0xb9, 0xed, 0x68, 0xed, 0x4f, 0xb7, 0xf5, 0x67, 0x11, 0x53, 0xc3, 0x89, 0x8f, 0x03,
+	0x1e, 0x9b, 0x2d, 0x9a, 0x47, 0x4b, 0x86, 0x23, 0xa2, 0xa6, 0x29, 0xc8, 0x05, 0x46, 0x0e, 0x76,
+	0x8c, 0x56, 0x1f, 0xc4, 0xb1, 0x56, 0xb2, 0x19, 0x7a, 0x9c, 0x42, 0x12, 0xb2, 0x24, 0xf2, 0x7e,
+	0xb6, 0x58, 0x2d, 0x2c, 0x5a, 0xbf, 0x67, 0xf1, 0x91, 0x61, 0x3c, 0xba, 0xeb, 0xf4, 0xcd, 0x5a,
+	0x29, 0x1d, 0x1d, 0xe7, 0xff, 0x6c, 0xb5, 0x35, 0x9c, 0xe7, 0x0a, 0x2f, 0x72, 0x85, 0x5f, 0x2f,
+	0x72, 0xd5, 0x7d, 0xa0, 0xdd, 0x9e, 0xdf, 0xd6, 0xad, 0x35, 0xec, 0xba, 0x6b, 0xff, 0x73, 0x19,
+	0xa1, 0x65, 0xe4, 0x74, 0xdc, 0xcc, 0x9f, 0xc2, 0xef, 0x11, 0xb7, 0xa2, 0xf5, 0x6e, 0x4c, 0xcb,
+	0xf7, 0x8f, 0xe9, 0x5f, 0x4c, 0xdb, 0xda, 0x44, 0x54, 0xfe, 0x55, 0x22, 0xba, 0xfd, 0xcb, 0x99,
+	0x6b, 0x5d, 0xcd, 0x5c, 0xeb, 0xdb, 0xcc, 0xb5, 0xce, 0xe7, 0x6e, 0xe9, 0x6a, 0xee, 0x96, 0xbe,
+	0xcc, 0xdd, 0xd2, 0xc9, 0xe1, 0x0a, 0xef, 0x2f, 0xee, 0xa9, 0xd3, 0x0e, 0x39, 0x5b, 0xb9, 0xac,
+	0x32, 0x2d, 0xbf, 0x9a, 0xed, 0xb3, 0xf3, 0x63, 0x00, 0x6f, 0xd2, 0x48, 0xe5, 0xdd, 0x04, 0x00,
+	0x00,
+}
+
+func (m *DelegationPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PendingCommissionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PendingCommissionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintDelegation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x3a
+	if m.PendingCommissionRate != nil {
+		{
+			size := m.PendingCommissionRate.Size()
+			i -= size
+			if _, err := m.PendingCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintDelegation(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RewardsPerShare) > 0 {
+		for iNdEx := len(m.RewardsPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Delegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Delegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Delegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsPerShare) > 0 {
+		for iNdEx := len(m.RewardsPerShare) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsPerShare[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDelegation(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Shares.Size()
+		i -= size
+		if _, err := m.Shares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintDelegation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelegationPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	l = m.Shares.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	if len(m.RewardsPerShare) > 0 {
+		for _, e := range m.RewardsPerShare {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	if m.PendingCommissionRate != nil {
+		l = m.PendingCommissionRate.Size()
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PendingCommissionTime)
+	n += 1 + l + sovDelegation(uint64(l))
+	return n
+}
+
+func (m *Delegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovDelegation(uint64(l))
+	}
+	l = m.Shares.Size()
+	n += 1 + l + sovDelegation(uint64(l))
+	if len(m.RewardsPerShare) > 0 {
+		for _, e := range m.RewardsPerShare {
+			l = e.Size()
+			n += 1 + l + sovDelegation(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovDelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelegation(x uint64) (n int) {
+	return sovDelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelegationPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelegationPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelegationPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerShare = append(m.RewardsPerShare, types.DecCoin{})
+			if err := m.RewardsPerShare[len(m.RewardsPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.PendingCommissionRate = &v
+			if err := m.PendingCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PendingCommissionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Delegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Delegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Delegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Shares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsPerShare", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsPerShare = append(m.RewardsPerShare, types.DecCoin{})
+			if err := m.RewardsPerShare[len(m.RewardsPerShare)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelegation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelegation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelegation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelegation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelegation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelegation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelegation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelegation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func TestDelegationPoolShares(t *testing.T) {
+	pool := types.NewDelegationPool(sample.AccAddress())
+
+	// the first delegation gets one share per token
+	shares, err := pool.SharesFromTokens(math.NewInt(100))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100), shares)
+	pool.Shares = shares
+	pool.Tokens.Amount = math.NewInt(100)
+
+	// slashing halves the value of a share
+	pool.Tokens.Amount = math.NewInt(50)
+	shares, err = pool.SharesFromTokens(math.NewInt(50))
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(100), shares)
+	require.Equal(t, math.LegacyNewDec(25), pool.TokensFromShares(math.LegacyNewDec(50)))
+
+	pool.Tokens.Amount = math.ZeroInt()
+	_, err = pool.SharesFromTokens(math.NewInt(50))
+	require.ErrorIs(t, err, gerrc.ErrFailedPrecondition)
+}
+
+func TestDelegationPendingRewards(t *testing.T) {
+	pool := types.NewDelegationPool(sample.AccAddress())
+	pool.Shares = math.LegacyNewDec(400)
+	d := types.NewDelegation(pool.Sequencer, sample.AccAddress())
+	d.Shares = math.LegacyNewDec(100)
+
+	pool.AddRewards(sdk.NewCoins(sdk.NewInt64Coin("urax", 1000)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("urax", 250)), d.PendingRewards(pool))
+
+	d.RewardsPerShare = pool.RewardsPerShare
+	require.True(t, d.PendingRewards(pool).IsZero())
+}
+
+func TestValidateCommissionRate(t *testing.T) {
+	require.NoError(t, types.ValidateCommissionRate(math.LegacyZeroDec()))
+	require.NoError(t, types.ValidateCommissionRate(math.LegacyOneDec()))
+	require.ErrorIs(t, types.ValidateCommissionRate(math.LegacyNewDecWithPrec(-1, 1)), types.ErrInvalidCommissionRate)
+	require.ErrorIs(t, types.ValidateCommissionRate(math.LegacyNewDecWithPrec(11, 1)), types.ErrInvalidCommissionRate)
+	require.ErrorIs(t, types.ValidateCommissionRate(math.LegacyDec{}), types.ErrInvalidCommissionRate)
+}
@@ -25,4 +25,7 @@ var (
 	ErrInvalidPubKey             = gerrc.ErrInvalidArgument.Wrap("pubkey")
 	ErrUnknownRequest            = gerrc.ErrInvalidArgument.Wrap("unknown request")
 	ErrInvalidFeeDenom           = gerrc.ErrInvalidArgument.Wrap("invalid fee denom")
+	ErrDelegationNotFound        = gerrc.ErrNotFound.Wrap("delegation")
+	ErrInsufficientDelegation    = gerrc.ErrOutOfRange.Wrap("insufficient delegation")
+	ErrInvalidCommissionRate     = gerrc.ErrInvalidArgument.Wrap("commission rate")
 )
@@ -4,21 +4,26 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -396,472 +401,2929 @@ func (m *EventOptInStatusChange) GetAfter() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*EventIncreasedBond)(nil), "dymensionxyz.dymension.sequencer.EventIncreasedBond")
-	proto.RegisterType((*EventUpdateRewardAddress)(nil), "dymensionxyz.dymension.sequencer.EventUpdateRewardAddress")
-	proto.RegisterType((*EventUpdateWhitelistedRelayers)(nil), "dymensionxyz.dymension.sequencer.EventUpdateWhitelistedRelayers")
-	proto.RegisterType((*EventKickedProposer)(nil), "dymensionxyz.dymension.sequencer.EventKickedProposer")
-	proto.RegisterType((*EventProposerChange)(nil), "dymensionxyz.dymension.sequencer.EventProposerChange")
-	proto.RegisterType((*EventOptInStatusChange)(nil), "dymensionxyz.dymension.sequencer.EventOptInStatusChange")
+// When tokens are delegated to a sequencer
+type EventDelegated struct {
+	Delegator string     `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Sequencer string     `protobuf:"bytes,2,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// the delegation pool tokens after the delegation
+	PoolTokens types.Coin `protobuf:"bytes,4,opt,name=pool_tokens,json=poolTokens,proto3" json:"pool_tokens"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/sequencer/events.proto", fileDescriptor_1f8a63d7e7167eb3)
+func (m *EventDelegated) Reset()         { *m = EventDelegated{} }
+func (m *EventDelegated) String() string { return proto.CompactTextString(m) }
+func (*EventDelegated) ProtoMessage()    {}
+func (*EventDelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{6}
+}
+func (m *EventDelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegated.Merge(m, src)
+}
+func (m *EventDelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegated.DiscardUnknown(m)
 }
 
-var fileDescriptor_1f8a63d7e7167eb3 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc6, 0x9b, 0xb5, 0x8c, 0xd6, 0xe5, 0x64, 0xaa, 0x29, 0xeb, 0x21, 0xad, 0x72, 0xea, 0xa5,
-	0xc9, 0xc6, 0xd0, 0xee, 0xeb, 0xc4, 0x61, 0xe2, 0xc0, 0x94, 0x69, 0x20, 0x71, 0xa9, 0x9c, 0xf8,
-	0xbf, 0x34, 0x6a, 0x6b, 0x07, 0xdb, 0x2d, 0x2b, 0x4f, 0x01, 0x27, 0x78, 0x06, 0xce, 0x3c, 0xc4,
-	0x8e, 0x13, 0x27, 0x4e, 0x80, 0xda, 0x27, 0xe0, 0x0d, 0x50, 0x6c, 0x37, 0xf4, 0x42, 0x8b, 0x38,
-	0xb5, 0x9f, 0xfb, 0x7d, 0x5f, 0x7e, 0x7f, 0xd7, 0x31, 0xea, 0xd3, 0xc5, 0x14, 0x98, 0xcc, 0x38,
-	0xbb, 0x5d, 0xbc, 0x0b, 0x4b, 0x11, 0x4a, 0x78, 0x33, 0x03, 0x96, 0x80, 0x08, 0x61, 0x0e, 0x4c,
-	0xc9, 0x20, 0x17, 0x5c, 0x71, 0xdc, 0xdd, 0xb4, 0x07, 0xa5, 0x08, 0x4a, 0x7b, 0xfb, 0x30, 0xe1,
-	0x72, 0xca, 0xe5, 0x50, 0xfb, 0x43, 0x23, 0x4c, 0xb8, 0xdd, 0x4a, 0x79, 0xca, 0xcd, 0x7a, 0xf1,
-	0xcd, 0xae, 0x7a, 0xc6, 0x13, 0xc6, 0x44, 0x42, 0x38, 0x3f, 0x8e, 0x41, 0x91, 0xe3, 0x30, 0xe1,
-	0x19, 0x33, 0xbf, 0xfb, 0xbf, 0x1c, 0x84, 0x9f, 0x15, 0x0c, 0x17, 0x2c, 0x11, 0x40, 0x24, 0xd0,
-	0x01, 0x67, 0x14, 0x9f, 0xa2, 0x46, 0xf9, 0x50, 0xd7, 0xe9, 0x3a, 0xbd, 0xc6, 0xc0, 0xfd, 0xfa,
-	0xa5, 0xdf, 0xb2, 0x4f, 0x3c, 0xa3, 0x54, 0x80, 0x94, 0x57, 0x4a, 0x64, 0x2c, 0x8d, 0xfe, 0x58,
-	0xf1, 0x00, 0x3d, 0x22, 0x94, 0x02, 0x1d, 0x92, 0x29, 0x9f, 0x31, 0xe5, 0xee, 0x75, 0x9d, 0x5e,
-	0xf3, 0xc9, 0x61, 0x60, 0x73, 0x05, 0x45, 0x60, 0x29, 0x82, 0x73, 0x9e, 0xb1, 0x41, 0xed, 0xee,
-	0x7b, 0xa7, 0x12, 0x35, 0x75, 0xe8, 0x4c, 0x67, 0xf0, 0x10, 0xd5, 0x62, 0xce, 0xa8, 0x5b, 0xed,
-	0x56, 0xb7, 0x67, 0x8f, 0x8a, 0xec, 0xe7, 0x1f, 0x9d, 0x5e, 0x9a, 0xa9, 0xd1, 0x2c, 0x0e, 0x12,
-	0x3e, 0xb5, 0x5b, 0x62, 0x3f, 0xfa, 0x92, 0x8e, 0x43, 0xb5, 0xc8, 0x41, 0xea, 0x80, 0x8c, 0x74,
-	0xb1, 0x7f, 0x8d, 0x5c, 0x3d, 0xf2, 0x75, 0x4e, 0x89, 0x82, 0x08, 0xde, 0x12, 0x41, 0xed, 0x44,
-	0xd8, 0x45, 0x0f, 0x8b, 0x7d, 0x50, 0xdc, 0x8e, 0x1d, 0xad, 0x25, 0xee, 0xa0, 0xa6, 0xd0, 0xd6,
-	0x21, 0xa1, 0x54, 0xe8, 0xc9, 0x1a, 0x11, 0x12, 0x65, 0xda, 0x7f, 0x89, 0xbc, 0x8d, 0xda, 0x57,
-	0xa3, 0x4c, 0xc1, 0x24, 0x93, 0x0a, 0x68, 0x04, 0x13, 0xb2, 0x00, 0xb1, 0xad, 0xbc, 0x8d, 0xea,
-	0xc2, 0xba, 0xdc, 0xbd, 0x6e, 0xb5, 0xd7, 0x88, 0x4a, 0xed, 0x7f, 0x74, 0xd0, 0x63, 0x5d, 0xfc,
-	0x3c, 0x4b, 0xc6, 0x40, 0x2f, 0x05, 0xcf, 0xb9, 0x04, 0x51, 0xb4, 0x09, 0x3e, 0x99, 0x90, 0x3c,
-	0x77, 0xab, 0xa6, 0xcd, 0x4a, 0x7c, 0x84, 0xf6, 0xc7, 0x85, 0x77, 0xf7, 0x5f, 0x67, 0x7d, 0xf8,
-	0x29, 0xaa, 0xe7, 0xb6, 0xd7, 0x4c, 0xb6, 0x25, 0x53, 0x3a, 0xfd, 0x0f, 0x6b, 0xb2, 0x35, 0xd3,
-	0xf9, 0x88, 0xb0, 0x14, 0xb6, 0x93, 0xc5, 0x70, 0xc3, 0x05, 0xec, 0x26, 0x33, 0x3e, 0x1c, 0xa0,
-	0x07, 0xe4, 0x46, 0xfd, 0x03, 0x96, 0xb1, 0xf9, 0x9f, 0x1c, 0x74, 0xa0, 0x99, 0x5e, 0xe4, 0xea,
-	0x82, 0x5d, 0x29, 0xa2, 0x66, 0x72, 0x27, 0xd6, 0xff, 0x1e, 0xf7, 0x83, 0x72, 0x9c, 0x82, 0xae,
-	0x5e, 0x42, 0xb7, 0xd6, 0xd0, 0x35, 0xbd, 0x6c, 0xc4, 0xe0, 0xf2, 0x6e, 0xe9, 0x39, 0xf7, 0x4b,
-	0xcf, 0xf9, 0xb9, 0xf4, 0x9c, 0xf7, 0x2b, 0xaf, 0x72, 0xbf, 0xf2, 0x2a, 0xdf, 0x56, 0x5e, 0xe5,
-	0xf5, 0xe9, 0xc6, 0x09, 0xfe, 0xcb, 0x95, 0x31, 0x3f, 0x09, 0x6f, 0x37, 0xee, 0x0d, 0x7d, 0xaa,
-	0xe3, 0x7d, 0xfd, 0x12, 0x9f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0xed, 0xe5, 0x96, 0x73, 0x68,
-	0x04, 0x00, 0x00,
+var xxx_messageInfo_EventDelegated proto.InternalMessageInfo
+
+func (m *EventDelegated) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-func (m *EventIncreasedBond) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDelegated) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventIncreasedBond) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventDelegated) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
 }
 
-func (m *EventIncreasedBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Bond) > 0 {
-		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *EventDelegated) GetPoolTokens() types.Coin {
+	if m != nil {
+		return m.PoolTokens
 	}
-	{
-		size, err := m.AddedAmount.MarshalToSizedBuffer(dAtA[:i])
+	return types.Coin{}
+}
+
+// When delegated tokens are returned to the delegator
+type EventUndelegated struct {
+	Delegator string     `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Sequencer string     `protobuf:"bytes,2,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+	// the delegation pool tokens after the undelegation
+	PoolTokens types.Coin `protobuf:"bytes,4,opt,name=pool_tokens,json=poolTokens,proto3" json:"pool_tokens"`
+}
+
+func (m *EventUndelegated) Reset()         { *m = EventUndelegated{} }
+func (m *EventUndelegated) String() string { return proto.CompactTextString(m) }
+func (*EventUndelegated) ProtoMessage()    {}
+func (*EventUndelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{7}
+}
+func (m *EventUndelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUndelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUndelegated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sequencer) > 0 {
-		i -= len(m.Sequencer)
-		copy(dAtA[i:], m.Sequencer)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventUndelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUndelegated.Merge(m, src)
+}
+func (m *EventUndelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUndelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUndelegated.DiscardUnknown(m)
 }
 
-func (m *EventUpdateRewardAddress) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventUndelegated proto.InternalMessageInfo
+
+func (m *EventUndelegated) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventUpdateRewardAddress) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventUndelegated) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
 }
 
-func (m *EventUpdateRewardAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardAddr) > 0 {
-		i -= len(m.RewardAddr)
-		copy(dAtA[i:], m.RewardAddr)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardAddr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventUndelegated) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *EventUpdateWhitelistedRelayers) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventUndelegated) GetPoolTokens() types.Coin {
+	if m != nil {
+		return m.PoolTokens
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *EventUpdateWhitelistedRelayers) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// When rewards are paid to a sequencer
+type EventSequencerRewards struct {
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// commission is the part sent to the sequencer reward address
+	Commission github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=commission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"commission"`
+	// delegator_rewards is the part shared by the delegators
+	DelegatorRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=delegator_rewards,json=delegatorRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"delegator_rewards"`
 }
 
-func (m *EventUpdateWhitelistedRelayers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Relayers) > 0 {
-		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Relayers[iNdEx])
-			copy(dAtA[i:], m.Relayers[iNdEx])
-			i = encodeVarintEvents(dAtA, i, uint64(len(m.Relayers[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *EventSequencerRewards) Reset()         { *m = EventSequencerRewards{} }
+func (m *EventSequencerRewards) String() string { return proto.CompactTextString(m) }
+func (*EventSequencerRewards) ProtoMessage()    {}
+func (*EventSequencerRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{8}
+}
+func (m *EventSequencerRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSequencerRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSequencerRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *EventSequencerRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSequencerRewards.Merge(m, src)
+}
+func (m *EventSequencerRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSequencerRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSequencerRewards.DiscardUnknown(m)
 }
 
-func (m *EventKickedProposer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_EventSequencerRewards proto.InternalMessageInfo
+
+func (m *EventSequencerRewards) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventKickedProposer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventSequencerRewards) GetCommission() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Commission
+	}
+	return nil
 }
 
-func (m *EventKickedProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rollapp) > 0 {
-		i -= len(m.Rollapp)
-		copy(dAtA[i:], m.Rollapp)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rollapp)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Kicker) > 0 {
-		i -= len(m.Kicker)
-		copy(dAtA[i:], m.Kicker)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Kicker)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventSequencerRewards) GetDelegatorRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DelegatorRewards
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventProposerChange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// When a delegator's rewards are paid out
+type EventDelegatorRewardsWithdrawn struct {
+	Delegator string                                   `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Sequencer string                                   `protobuf:"bytes,2,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Rewards   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards"`
 }
 
-func (m *EventProposerChange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventDelegatorRewardsWithdrawn) Reset()         { *m = EventDelegatorRewardsWithdrawn{} }
+func (m *EventDelegatorRewardsWithdrawn) String() string { return proto.CompactTextString(m) }
+func (*EventDelegatorRewardsWithdrawn) ProtoMessage()    {}
+func (*EventDelegatorRewardsWithdrawn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{9}
+}
+func (m *EventDelegatorRewardsWithdrawn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegatorRewardsWithdrawn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegatorRewardsWithdrawn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDelegatorRewardsWithdrawn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegatorRewardsWithdrawn.Merge(m, src)
+}
+func (m *EventDelegatorRewardsWithdrawn) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegatorRewardsWithdrawn) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegatorRewardsWithdrawn.DiscardUnknown(m)
 }
 
-func (m *EventProposerChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rollapp) > 0 {
-		i -= len(m.Rollapp)
-		copy(dAtA[i:], m.Rollapp)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rollapp)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.After) > 0 {
-		i -= len(m.After)
-		copy(dAtA[i:], m.After)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.After)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_EventDelegatorRewardsWithdrawn proto.InternalMessageInfo
+
+func (m *EventDelegatorRewardsWithdrawn) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
 	}
-	if len(m.Before) > 0 {
-		i -= len(m.Before)
-		copy(dAtA[i:], m.Before)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Before)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *EventDelegatorRewardsWithdrawn) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventOptInStatusChange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventDelegatorRewardsWithdrawn) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *EventOptInStatusChange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// When the slashing of a sequencer reaches its delegation pool
+type EventDelegationsSlashed struct {
+	Sequencer string     `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// the delegation pool tokens after the slash
+	PoolTokens types.Coin `protobuf:"bytes,3,opt,name=pool_tokens,json=poolTokens,proto3" json:"pool_tokens"`
 }
 
-func (m *EventOptInStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.After {
-		i--
-		if m.After {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Rollapp) > 0 {
-		i -= len(m.Rollapp)
-		copy(dAtA[i:], m.Rollapp)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rollapp)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Before {
-		i--
-		if m.Before {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *EventDelegationsSlashed) Reset()         { *m = EventDelegationsSlashed{} }
+func (m *EventDelegationsSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventDelegationsSlashed) ProtoMessage()    {}
+func (*EventDelegationsSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{10}
+}
+func (m *EventDelegationsSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDelegationsSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDelegationsSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Sequencer) > 0 {
-		i -= len(m.Sequencer)
-		copy(dAtA[i:], m.Sequencer)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *EventDelegationsSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDelegationsSlashed.Merge(m, src)
+}
+func (m *EventDelegationsSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDelegationsSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDelegationsSlashed.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_EventDelegationsSlashed proto.InternalMessageInfo
+
+func (m *EventDelegationsSlashed) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *EventIncreasedBond) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *EventDelegationsSlashed) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = len(m.Sequencer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return types.Coin{}
+}
+
+func (m *EventDelegationsSlashed) GetPoolTokens() types.Coin {
+	if m != nil {
+		return m.PoolTokens
 	}
-	l = m.AddedAmount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if len(m.Bond) > 0 {
-		for _, e := range m.Bond {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	return types.Coin{}
+}
+
+// When the sequencer commission rate changes
+type EventUpdateCommission struct {
+	Sequencer      string                      `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// activation_time is when the rate applies
+	ActivationTime time.Time `protobuf:"bytes,3,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time"`
+}
+
+func (m *EventUpdateCommission) Reset()         { *m = EventUpdateCommission{} }
+func (m *EventUpdateCommission) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateCommission) ProtoMessage()    {}
+func (*EventUpdateCommission) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{11}
+}
+func (m *EventUpdateCommission) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateCommission) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateCommission.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventUpdateCommission) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateCommission.Merge(m, src)
+}
+func (m *EventUpdateCommission) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateCommission) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateCommission.DiscardUnknown(m)
 }
 
-func (m *EventUpdateRewardAddress) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.RewardAddr)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+var xxx_messageInfo_EventUpdateCommission proto.InternalMessageInfo
+
+func (m *EventUpdateCommission) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
 	}
-	return n
+	return ""
 }
 
-func (m *EventUpdateWhitelistedRelayers) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+func (m *EventUpdateCommission) GetActivationTime() time.Time {
+	if m != nil {
+		return m.ActivationTime
 	}
-	if len(m.Relayers) > 0 {
-		for _, s := range m.Relayers {
-			l = len(s)
-			n += 1 + l + sovEvents(uint64(l))
+	return time.Time{}
+}
+
+// When stake leaves a sequencer and waits for the unbonding period
+type EventUnbondingStarted struct {
+	Entry UnbondingEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EventUnbondingStarted) Reset()         { *m = EventUnbondingStarted{} }
+func (m *EventUnbondingStarted) String() string { return proto.CompactTextString(m) }
+func (*EventUnbondingStarted) ProtoMessage()    {}
+func (*EventUnbondingStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{12}
+}
+func (m *EventUnbondingStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnbondingStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnbondingStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *EventUnbondingStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnbondingStarted.Merge(m, src)
+}
+func (m *EventUnbondingStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnbondingStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnbondingStarted.DiscardUnknown(m)
 }
 
-func (m *EventKickedProposer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Kicker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Rollapp)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+var xxx_messageInfo_EventUnbondingStarted proto.InternalMessageInfo
+
+func (m *EventUnbondingStarted) GetEntry() UnbondingEntry {
+	if m != nil {
+		return m.Entry
 	}
-	return n
+	return UnbondingEntry{}
 }
 
-func (m *EventProposerChange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Before)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+// When an unbonding entry matures and is paid to its recipient
+type EventUnbondingCompleted struct {
+	Entry UnbondingEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *EventUnbondingCompleted) Reset()         { *m = EventUnbondingCompleted{} }
+func (m *EventUnbondingCompleted) String() string { return proto.CompactTextString(m) }
+func (*EventUnbondingCompleted) ProtoMessage()    {}
+func (*EventUnbondingCompleted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{13}
+}
+func (m *EventUnbondingCompleted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnbondingCompleted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnbondingCompleted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.After)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *EventUnbondingCompleted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnbondingCompleted.Merge(m, src)
+}
+func (m *EventUnbondingCompleted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnbondingCompleted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnbondingCompleted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnbondingCompleted proto.InternalMessageInfo
+
+func (m *EventUnbondingCompleted) GetEntry() UnbondingEntry {
+	if m != nil {
+		return m.Entry
 	}
-	l = len(m.Rollapp)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return UnbondingEntry{}
+}
+
+// When the slashing of a sequencer reaches its pending unbondings
+type EventUnbondingsSlashed struct {
+	Sequencer string     `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *EventUnbondingsSlashed) Reset()         { *m = EventUnbondingsSlashed{} }
+func (m *EventUnbondingsSlashed) String() string { return proto.CompactTextString(m) }
+func (*EventUnbondingsSlashed) ProtoMessage()    {}
+func (*EventUnbondingsSlashed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{14}
+}
+func (m *EventUnbondingsSlashed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUnbondingsSlashed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUnbondingsSlashed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUnbondingsSlashed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUnbondingsSlashed.Merge(m, src)
+}
+func (m *EventUnbondingsSlashed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUnbondingsSlashed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUnbondingsSlashed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUnbondingsSlashed proto.InternalMessageInfo
+
+func (m *EventUnbondingsSlashed) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *EventUnbondingsSlashed) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// When the proposer served the rotation period of its rollapp and its notice
+// period starts
+type EventScheduledRotation struct {
+	Rollapp   string    `protobuf:"bytes,1,opt,name=rollapp,proto3" json:"rollapp,omitempty"`
+	Proposer  string    `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	TermStart time.Time `protobuf:"bytes,3,opt,name=term_start,json=termStart,proto3,stdtime" json:"term_start"`
+}
+
+func (m *EventScheduledRotation) Reset()         { *m = EventScheduledRotation{} }
+func (m *EventScheduledRotation) String() string { return proto.CompactTextString(m) }
+func (*EventScheduledRotation) ProtoMessage()    {}
+func (*EventScheduledRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f8a63d7e7167eb3, []int{15}
+}
+func (m *EventScheduledRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduledRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduledRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduledRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduledRotation.Merge(m, src)
+}
+func (m *EventScheduledRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduledRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduledRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduledRotation proto.InternalMessageInfo
+
+func (m *EventScheduledRotation) GetRollapp() string {
+	if m != nil {
+		return m.Rollapp
+	}
+	return ""
+}
+
+func (m *EventScheduledRotation) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *EventScheduledRotation) GetTermStart() time.Time {
+	if m != nil {
+		return m.TermStart
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*EventIncreasedBond)(nil), "dymensionxyz.dymension.sequencer.EventIncreasedBond")
+	proto.RegisterType((*EventUpdateRewardAddress)(nil), "dymensionxyz.dymension.sequencer.EventUpdateRewardAddress")
+	proto.RegisterType((*EventUpdateWhitelistedRelayers)(nil), "dymensionxyz.dymension.sequencer.EventUpdateWhitelistedRelayers")
+	proto.RegisterType((*EventKickedProposer)(nil), "dymensionxyz.dymension.sequencer.EventKickedProposer")
+	proto.RegisterType((*EventProposerChange)(nil), "dymensionxyz.dymension.sequencer.EventProposerChange")
+	proto.RegisterType((*EventOptInStatusChange)(nil), "dymensionxyz.dymension.sequencer.EventOptInStatusChange")
+	proto.RegisterType((*EventDelegated)(nil), "dymensionxyz.dymension.sequencer.EventDelegated")
+	proto.RegisterType((*EventUndelegated)(nil), "dymensionxyz.dymension.sequencer.EventUndelegated")
+	proto.RegisterType((*EventSequencerRewards)(nil), "dymensionxyz.dymension.sequencer.EventSequencerRewards")
+	proto.RegisterType((*EventDelegatorRewardsWithdrawn)(nil), "dymensionxyz.dymension.sequencer.EventDelegatorRewardsWithdrawn")
+	proto.RegisterType((*EventDelegationsSlashed)(nil), "dymensionxyz.dymension.sequencer.EventDelegationsSlashed")
+	proto.RegisterType((*EventUpdateCommission)(nil), "dymensionxyz.dymension.sequencer.EventUpdateCommission")
+	proto.RegisterType((*EventUnbondingStarted)(nil), "dymensionxyz.dymension.sequencer.EventUnbondingStarted")
+	proto.RegisterType((*EventUnbondingCompleted)(nil), "dymensionxyz.dymension.sequencer.EventUnbondingCompleted")
+	proto.RegisterType((*EventUnbondingsSlashed)(nil), "dymensionxyz.dymension.sequencer.EventUnbondingsSlashed")
+	proto.RegisterType((*EventScheduledRotation)(nil), "dymensionxyz.dymension.sequencer.EventScheduledRotation")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/events.proto", fileDescriptor_1f8a63d7e7167eb3)
+}
+
+var fileDescriptor_1f8a63d7e7167eb3 = []byte{
+	// 948 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x56, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x5e, 0x27, 0xdb, 0x76, 0x33, 0x41, 0xdb, 0x62, 0xb6, 0xc5, 0x5d, 0xa4, 0x24, 0xf2, 0x69,
+	0x2f, 0x6b, 0xef, 0xb6, 0xa8, 0x5c, 0x69, 0xb2, 0x3d, 0x54, 0x14, 0x51, 0x39, 0x2d, 0x95, 0x7a,
+	0xb1, 0x26, 0x9e, 0xb7, 0x8e, 0x15, 0x7b, 0xc6, 0xcc, 0x4c, 0xb6, 0x1b, 0xfe, 0x01, 0xb7, 0x72,
+	0x82, 0xdf, 0x80, 0xc4, 0xad, 0xe2, 0x37, 0xf4, 0xc0, 0xa1, 0xea, 0x09, 0x71, 0x68, 0xd1, 0xee,
+	0x2f, 0xe0, 0x84, 0x04, 0x42, 0x42, 0x33, 0x1e, 0x3b, 0xee, 0x81, 0x4d, 0x1a, 0x5a, 0x38, 0x70,
+	0x4a, 0xc6, 0xfe, 0xbe, 0x37, 0xdf, 0x37, 0x7e, 0xef, 0xcd, 0x43, 0xbb, 0x64, 0x96, 0x01, 0x15,
+	0x09, 0xa3, 0xc7, 0xb3, 0x2f, 0xfd, 0x6a, 0xe1, 0x0b, 0xf8, 0x62, 0x0a, 0x34, 0x02, 0xee, 0xc3,
+	0x11, 0x50, 0x29, 0xbc, 0x9c, 0x33, 0xc9, 0xec, 0x5e, 0x1d, 0xee, 0x55, 0x0b, 0xaf, 0x82, 0x6f,
+	0x5f, 0x8d, 0x98, 0xc8, 0x98, 0x08, 0x35, 0xde, 0x2f, 0x16, 0x05, 0x79, 0x7b, 0x2b, 0x66, 0x31,
+	0x2b, 0x9e, 0xab, 0x7f, 0xe6, 0x69, 0xa7, 0xc0, 0xf8, 0x23, 0x2c, 0xc0, 0x3f, 0xda, 0x1f, 0x81,
+	0xc4, 0xfb, 0x7e, 0xc4, 0x12, 0x6a, 0xde, 0x77, 0x63, 0xc6, 0xe2, 0x14, 0x7c, 0xbd, 0x1a, 0x4d,
+	0x0f, 0x7d, 0x99, 0x64, 0x20, 0x24, 0xce, 0x72, 0x03, 0xd8, 0x5b, 0x68, 0x61, 0x4a, 0x47, 0x8c,
+	0x92, 0x84, 0xc6, 0x05, 0xc3, 0xfd, 0xd5, 0x42, 0xf6, 0x2d, 0x65, 0xeb, 0x36, 0x8d, 0x38, 0x60,
+	0x01, 0xa4, 0xcf, 0x28, 0xb1, 0x6f, 0xa0, 0x56, 0xc5, 0x71, 0xac, 0x9e, 0xb5, 0xd3, 0xea, 0x3b,
+	0xcf, 0x9f, 0xec, 0x6e, 0x19, 0x13, 0x37, 0x09, 0xe1, 0x20, 0xc4, 0x50, 0xf2, 0x84, 0xc6, 0xc1,
+	0x1c, 0x6a, 0xf7, 0xd1, 0x3b, 0x98, 0x10, 0x20, 0x21, 0xce, 0xd8, 0x94, 0x4a, 0xa7, 0xd1, 0xb3,
+	0x76, 0xda, 0xd7, 0xae, 0x7a, 0x86, 0xa7, 0x8c, 0x79, 0xc6, 0x98, 0x37, 0x60, 0x09, 0xed, 0xaf,
+	0x3f, 0x7d, 0xd1, 0x5d, 0x0b, 0xda, 0x9a, 0x74, 0x53, 0x73, 0xec, 0x10, 0xad, 0x2b, 0x8d, 0x4e,
+	0xb3, 0xd7, 0x3c, 0x9b, 0xbb, 0xa7, 0xb8, 0xdf, 0xbd, 0xec, 0xee, 0xc4, 0x89, 0x1c, 0x4f, 0x47,
+	0x5e, 0xc4, 0x32, 0x73, 0xca, 0xe6, 0x67, 0x57, 0x90, 0x89, 0x2f, 0x67, 0x39, 0x08, 0x4d, 0x10,
+	0x81, 0x0e, 0xec, 0xde, 0x47, 0x8e, 0xb6, 0x7c, 0x3f, 0x27, 0x58, 0x42, 0x00, 0x8f, 0x30, 0x27,
+	0xc6, 0x91, 0xed, 0xa0, 0x0b, 0xea, 0x1c, 0x24, 0x33, 0xb6, 0x83, 0x72, 0x69, 0x77, 0x51, 0x9b,
+	0x6b, 0x68, 0x88, 0x09, 0xe1, 0xda, 0x59, 0x2b, 0x40, 0xbc, 0x62, 0xbb, 0x9f, 0xa3, 0x4e, 0x2d,
+	0xec, 0x83, 0x71, 0x22, 0x21, 0x4d, 0x84, 0x04, 0x12, 0x40, 0x8a, 0x67, 0xc0, 0xcf, 0x0a, 0xbe,
+	0x8d, 0x36, 0xb8, 0x41, 0x39, 0x8d, 0x5e, 0x73, 0xa7, 0x15, 0x54, 0x6b, 0xf7, 0x1b, 0x0b, 0xbd,
+	0xa7, 0x03, 0x7f, 0x92, 0x44, 0x13, 0x20, 0x77, 0x39, 0xcb, 0x99, 0x00, 0xae, 0xa2, 0x71, 0x96,
+	0xa6, 0x38, 0xcf, 0x9d, 0x66, 0x11, 0xcd, 0x2c, 0xed, 0x3d, 0x74, 0x7e, 0xa2, 0xb0, 0x8b, 0x3f,
+	0x9d, 0xc1, 0xd9, 0x1f, 0xa2, 0x8d, 0xdc, 0xc4, 0x75, 0x1a, 0x0b, 0x38, 0x15, 0xd2, 0xfd, 0xba,
+	0x54, 0x56, 0x6a, 0x1a, 0x8c, 0x31, 0x8d, 0xe1, 0x6c, 0x65, 0x23, 0x38, 0x64, 0x1c, 0x16, 0x2b,
+	0x2b, 0x70, 0xb6, 0x87, 0xce, 0xe1, 0x43, 0xb9, 0x84, 0xac, 0x02, 0xe6, 0x7e, 0x6b, 0xa1, 0x2b,
+	0x5a, 0xd3, 0x67, 0xb9, 0xbc, 0x4d, 0x87, 0x12, 0xcb, 0xa9, 0x58, 0x28, 0x6b, 0xd5, 0x74, 0xbf,
+	0x52, 0xd9, 0x51, 0xea, 0x36, 0x2a, 0xd1, 0x5b, 0xa5, 0xe8, 0x75, 0xfd, 0xd8, 0x48, 0xfb, 0xc3,
+	0x42, 0x9b, 0x5a, 0xda, 0x01, 0xa4, 0x10, 0x63, 0x09, 0xba, 0xce, 0x48, 0xb1, 0x60, 0x4b, 0x6c,
+	0x5c, 0x41, 0x5f, 0x15, 0xdc, 0x58, 0x5e, 0xf0, 0x47, 0xe8, 0xbc, 0xa9, 0xcc, 0xe6, 0x72, 0x95,
+	0x69, 0xe0, 0xf6, 0xc7, 0xa8, 0x9d, 0x33, 0x96, 0x86, 0x92, 0x4d, 0x80, 0x0a, 0x67, 0x7d, 0x39,
+	0x36, 0x52, 0x9c, 0x7b, 0x9a, 0xe2, 0xfe, 0x69, 0xa1, 0x4b, 0x45, 0x7d, 0x50, 0xf2, 0x7f, 0xf4,
+	0xff, 0x43, 0x03, 0x5d, 0xd6, 0xfe, 0x87, 0xa5, 0x9a, 0xa2, 0xf3, 0x88, 0x95, 0xb3, 0x6f, 0x82,
+	0x50, 0xc4, 0xb2, 0x2c, 0x11, 0xaa, 0xc7, 0xeb, 0xb6, 0xf1, 0x86, 0xdb, 0x65, 0x2d, 0xbc, 0x7d,
+	0x8c, 0xde, 0xad, 0x8e, 0x3f, 0x2c, 0xba, 0x9e, 0x78, 0x1b, 0x2d, 0xfa, 0x52, 0xb5, 0x8b, 0x39,
+	0x1e, 0xf7, 0x37, 0x0b, 0x75, 0xea, 0x65, 0x53, 0xbd, 0x79, 0x90, 0xc8, 0x31, 0xe1, 0xf8, 0x11,
+	0xfd, 0xd7, 0xd3, 0x08, 0xd0, 0x85, 0xb7, 0x78, 0x04, 0x65, 0x6c, 0xf7, 0x47, 0x0b, 0xbd, 0x5f,
+	0x77, 0x9e, 0x30, 0x2a, 0x86, 0x29, 0x16, 0x63, 0x58, 0xfd, 0x86, 0x9e, 0x57, 0x40, 0xe3, 0x1f,
+	0x55, 0x40, 0xf3, 0xf5, 0x2b, 0xe0, 0x77, 0x0b, 0x5d, 0xae, 0xdd, 0x90, 0x83, 0x79, 0x72, 0xad,
+	0x6a, 0xe6, 0x21, 0xba, 0x38, 0x4f, 0xd1, 0x90, 0x63, 0x09, 0xe6, 0x2b, 0xee, 0xab, 0xcd, 0x7f,
+	0x7e, 0xd1, 0xfd, 0xa0, 0x88, 0x20, 0xc8, 0xc4, 0x4b, 0x98, 0x9f, 0x61, 0x39, 0xf6, 0xee, 0x40,
+	0x8c, 0xa3, 0xd9, 0x01, 0x44, 0xcf, 0x9f, 0xec, 0x22, 0xb3, 0xc1, 0x01, 0x44, 0xc1, 0xe6, 0x3c,
+	0x52, 0x80, 0x25, 0xd8, 0x9f, 0xa2, 0x8b, 0x38, 0x92, 0xc9, 0x91, 0x3e, 0xf6, 0x50, 0x4d, 0x5a,
+	0xc6, 0xf3, 0xb6, 0x57, 0x8c, 0x61, 0x5e, 0x39, 0x86, 0x79, 0xf7, 0xca, 0x31, 0xac, 0xbf, 0xa1,
+	0xf6, 0x7d, 0xfc, 0xb2, 0x6b, 0x05, 0x9b, 0x73, 0xb2, 0x7a, 0xed, 0x42, 0xe9, 0xbd, 0x1c, 0xc0,
+	0x86, 0x12, 0x73, 0xd5, 0x02, 0xef, 0xa0, 0x73, 0x40, 0x25, 0x9f, 0x69, 0xdf, 0xed, 0x6b, 0x7b,
+	0xde, 0xa2, 0xb9, 0xd2, 0xab, 0x42, 0xdc, 0x52, 0x3c, 0x73, 0xd0, 0x45, 0x10, 0x37, 0x36, 0x19,
+	0x53, 0x61, 0x06, 0x2c, 0xcb, 0x53, 0x78, 0xf3, 0x1b, 0x7d, 0x55, 0xde, 0xb3, 0x15, 0xe8, 0x3f,
+	0x4b, 0x4d, 0xf7, 0xfb, 0x52, 0xcb, 0x30, 0x1a, 0x03, 0x99, 0xa6, 0x40, 0x02, 0x26, 0xf5, 0xd1,
+	0xd7, 0xef, 0x7c, 0xeb, 0xd5, 0x3b, 0x7f, 0xa5, 0x91, 0xc7, 0x1e, 0x20, 0x24, 0x81, 0x67, 0xa1,
+	0x50, 0x5f, 0xef, 0xb5, 0x12, 0xa2, 0xa5, 0x78, 0xfa, 0xa3, 0xf7, 0xef, 0x3e, 0x3d, 0xe9, 0x58,
+	0xcf, 0x4e, 0x3a, 0xd6, 0x2f, 0x27, 0x1d, 0xeb, 0xf1, 0x69, 0x67, 0xed, 0xd9, 0x69, 0x67, 0xed,
+	0xa7, 0xd3, 0xce, 0xda, 0xc3, 0x1b, 0xb5, 0x26, 0xf1, 0x37, 0xb3, 0xfc, 0xd1, 0x75, 0xff, 0xb8,
+	0x36, 0xd0, 0xeb, 0xc6, 0x31, 0x3a, 0xaf, 0xb7, 0xbe, 0xfe, 0xd7, 0x00, 0xf6, 0xc1, 0xf2, 0x03,
+	0xc4, 0x0c, 0x00, 0x00,
+}
+
+func (m *EventIncreasedBond) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventIncreasedBond) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventIncreasedBond) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bond) > 0 {
+		for iNdEx := len(m.Bond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.AddedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateRewardAddress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateRewardAddress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateRewardAddress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardAddr) > 0 {
+		i -= len(m.RewardAddr)
+		copy(dAtA[i:], m.RewardAddr)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateWhitelistedRelayers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateWhitelistedRelayers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateWhitelistedRelayers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relayers) > 0 {
+		for iNdEx := len(m.Relayers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Relayers[iNdEx])
+			copy(dAtA[i:], m.Relayers[iNdEx])
+			i = encodeVarintEvents(dAtA, i, uint64(len(m.Relayers[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventKickedProposer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventKickedProposer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventKickedProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rollapp) > 0 {
+		i -= len(m.Rollapp)
+		copy(dAtA[i:], m.Rollapp)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rollapp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kicker) > 0 {
+		i -= len(m.Kicker)
+		copy(dAtA[i:], m.Kicker)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Kicker)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventProposerChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventProposerChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventProposerChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rollapp) > 0 {
+		i -= len(m.Rollapp)
+		copy(dAtA[i:], m.Rollapp)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rollapp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.After) > 0 {
+		i -= len(m.After)
+		copy(dAtA[i:], m.After)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.After)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Before) > 0 {
+		i -= len(m.Before)
+		copy(dAtA[i:], m.Before)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Before)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOptInStatusChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOptInStatusChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOptInStatusChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.After {
+		i--
+		if m.After {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rollapp) > 0 {
+		i -= len(m.Rollapp)
+		copy(dAtA[i:], m.Rollapp)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rollapp)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Before {
+		i--
+		if m.Before {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUndelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUndelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUndelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventSequencerRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSequencerRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSequencerRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DelegatorRewards) > 0 {
+		for iNdEx := len(m.DelegatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Commission) > 0 {
+		for iNdEx := len(m.Commission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegatorRewardsWithdrawn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegatorRewardsWithdrawn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegatorRewardsWithdrawn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDelegationsSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDelegationsSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDelegationsSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolTokens.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateCommission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateCommission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateCommission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintEvents(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnbondingStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnbondingStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnbondingStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnbondingCompleted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnbondingCompleted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnbondingCompleted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUnbondingsSlashed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUnbondingsSlashed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUnbondingsSlashed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduledRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduledRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduledRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TermStart, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TermStart):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintEvents(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rollapp) > 0 {
+		i -= len(m.Rollapp)
+		copy(dAtA[i:], m.Rollapp)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Rollapp)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventIncreasedBond) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.AddedAmount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.Bond) > 0 {
+		for _, e := range m.Bond {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventUpdateRewardAddress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RewardAddr)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventUpdateWhitelistedRelayers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Relayers) > 0 {
+		for _, s := range m.Relayers {
+			l = len(s)
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventKickedProposer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kicker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventProposerChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Before)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOptInStatusChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Before {
+		n += 2
+	}
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.After {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventDelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.PoolTokens.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUndelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.PoolTokens.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSequencerRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Commission) > 0 {
+		for _, e := range m.Commission {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if len(m.DelegatorRewards) > 0 {
+		for _, e := range m.DelegatorRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDelegatorRewardsWithdrawn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventDelegationsSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.PoolTokens.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateCommission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ActivationTime)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUnbondingStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUnbondingCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Entry.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUnbondingsSlashed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventScheduledRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TermStart)
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventIncreasedBond: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventIncreasedBond: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AddedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bond = append(m.Bond, types.Coin{})
+			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateRewardAddress) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateRewardAddress: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateRewardAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateWhitelistedRelayers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateWhitelistedRelayers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateWhitelistedRelayers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventKickedProposer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventKickedProposer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventKickedProposer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventProposerChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventProposerChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventProposerChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Before = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.After = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOptInStatusChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOptInStatusChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOptInStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Before = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.After = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDelegated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUndelegated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUndelegated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUndelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *EventOptInStatusChange) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sequencer)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.Before {
-		n += 2
-	}
-	l = len(m.Rollapp)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if m.After {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
+func (m *EventSequencerRewards) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -884,10 +3346,10 @@ func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventIncreasedBond: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSequencerRewards: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventIncreasedBond: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSequencerRewards: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -924,7 +3386,7 @@ func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AddedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -951,13 +3413,14 @@ func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AddedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commission = append(m.Commission, types.Coin{})
+			if err := m.Commission[len(m.Commission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -984,8 +3447,8 @@ func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bond = append(m.Bond, types.Coin{})
-			if err := m.Bond[len(m.Bond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DelegatorRewards = append(m.DelegatorRewards, types.Coin{})
+			if err := m.DelegatorRewards[len(m.DelegatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1010,7 +3473,7 @@ func (m *EventIncreasedBond) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateRewardAddress) Unmarshal(dAtA []byte) error {
+func (m *EventDelegatorRewardsWithdrawn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1033,15 +3496,15 @@ func (m *EventUpdateRewardAddress) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateRewardAddress: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDelegatorRewardsWithdrawn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateRewardAddress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDelegatorRewardsWithdrawn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1069,13 +3532,194 @@ func (m *EventUpdateRewardAddress) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDelegationsSlashed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDelegationsSlashed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDelegationsSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolTokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1085,23 +3729,24 @@ func (m *EventUpdateRewardAddress) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.PoolTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1124,7 +3769,7 @@ func (m *EventUpdateRewardAddress) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventUpdateWhitelistedRelayers) Unmarshal(dAtA []byte) error {
+func (m *EventUpdateCommission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1147,15 +3792,15 @@ func (m *EventUpdateWhitelistedRelayers) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventUpdateWhitelistedRelayers: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUpdateCommission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventUpdateWhitelistedRelayers: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUpdateCommission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1183,11 +3828,11 @@ func (m *EventUpdateWhitelistedRelayers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Relayers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1215,7 +3860,42 @@ func (m *EventUpdateWhitelistedRelayers) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Relayers = append(m.Relayers, string(dAtA[iNdEx:postIndex]))
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1238,7 +3918,7 @@ func (m *EventUpdateWhitelistedRelayers) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventKickedProposer) Unmarshal(dAtA []byte) error {
+func (m *EventUnbondingStarted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1261,17 +3941,17 @@ func (m *EventKickedProposer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventKickedProposer: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnbondingStarted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventKickedProposer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnbondingStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kicker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1281,61 +3961,80 @@ func (m *EventKickedProposer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kicker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUnbondingCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUnbondingCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUnbondingCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1345,23 +4044,24 @@ func (m *EventKickedProposer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1384,7 +4084,7 @@ func (m *EventKickedProposer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventProposerChange) Unmarshal(dAtA []byte) error {
+func (m *EventUnbondingsSlashed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1407,15 +4107,15 @@ func (m *EventProposerChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventProposerChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventUnbondingsSlashed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventProposerChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventUnbondingsSlashed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1443,13 +4143,13 @@ func (m *EventProposerChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Before = string(dAtA[iNdEx:postIndex])
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1459,55 +4159,24 @@ func (m *EventProposerChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.After = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Rollapp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1530,7 +4199,7 @@ func (m *EventProposerChange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOptInStatusChange) Unmarshal(dAtA []byte) error {
+func (m *EventScheduledRotation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1553,15 +4222,15 @@ func (m *EventOptInStatusChange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOptInStatusChange: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventScheduledRotation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOptInStatusChange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventScheduledRotation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1589,31 +4258,11 @@ func (m *EventOptInStatusChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Before", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Before = bool(v != 0)
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1641,13 +4290,13 @@ func (m *EventOptInStatusChange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field After", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TermStart", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -1657,12 +4306,25 @@ func (m *EventOptInStatusChange) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.After = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TermStart, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
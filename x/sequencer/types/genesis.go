@@ -9,6 +9,9 @@ func DefaultGenesis() *GenesisState {
 		GenesisProposers: []GenesisProposer{},
 		Params:           DefaultParams(),
 		NoticeQueue:      []string{},
+		DelegationPools:  []DelegationPool{},
+		Delegations:      []Delegation{},
+		Unbondings:       []UnbondingEntry{},
 	}
 }
 
@@ -35,6 +38,17 @@ func (gs GenesisState) Validate() error {
 	if err := checkSecondIndex(gs.GenesisSuccessors, sequencerIndexMap); err != nil {
 		return err
 	}
+	if err := checkSecondIndex(gs.LastChosenProposers, sequencerIndexMap); err != nil {
+		return err
+	}
+
+	terms := make(map[string]struct{})
+	for _, t := range gs.ProposerTerms {
+		if _, ok := terms[t.RollappId]; ok {
+			return fmt.Errorf("duplicated proposer term: %s", t.RollappId)
+		}
+		terms[t.RollappId] = struct{}{}
+	}
 
 	for _, s := range gs.NoticeQueue {
 		if _, ok := sequencerIndexMap[s]; !ok {
@@ -42,6 +56,63 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	pools := make(map[string]struct{})
+	for _, p := range gs.DelegationPools {
+		if _, ok := sequencerIndexMap[string(SequencerKey(p.Sequencer))]; !ok {
+			return fmt.Errorf("delegation pool of non-existent sequencer: %s", p.Sequencer)
+		}
+		if _, ok := pools[p.Sequencer]; ok {
+			return fmt.Errorf("duplicated delegation pool: %s", p.Sequencer)
+		}
+		if err := p.ValidateBasic(); err != nil {
+			return fmt.Errorf("delegation pool: %s: %w", p.Sequencer, err)
+		}
+		pools[p.Sequencer] = struct{}{}
+	}
+
+	delegations := make(map[string]struct{})
+	for _, d := range gs.Delegations {
+		if _, ok := pools[d.Sequencer]; !ok {
+			return fmt.Errorf("delegation without pool: %s", d.Sequencer)
+		}
+		key := d.Sequencer + KeySeparator + d.Delegator
+		if _, ok := delegations[key]; ok {
+			return fmt.Errorf("duplicated delegation: %s", key)
+		}
+		if err := d.ValidateBasic(); err != nil {
+			return fmt.Errorf("delegation: %s: %w", key, err)
+		}
+		delegations[key] = struct{}{}
+	}
+
+	unbondings := make(map[uint64]struct{})
+	for _, e := range gs.Unbondings {
+		if _, ok := sequencerIndexMap[string(SequencerKey(e.Sequencer))]; !ok {
+			return fmt.Errorf("unbonding of non-existent sequencer: %s", e.Sequencer)
+		}
+		if _, ok := unbondings[e.Id]; ok {
+			return fmt.Errorf("duplicated unbonding: %d", e.Id)
+		}
+		if err := e.ValidateBasic(); err != nil {
+			return fmt.Errorf("unbonding: %d: %w", e.Id, err)
+		}
+		unbondings[e.Id] = struct{}{}
+	}
+
+	histories := make(map[string]struct{})
+	for _, h := range gs.PerformanceHistories {
+		if _, ok := sequencerIndexMap[string(SequencerKey(h.Sequencer))]; !ok {
+			return fmt.Errorf("performance history of non-existent sequencer: %s", h.Sequencer)
+		}
+		if _, ok := histories[h.Sequencer]; ok {
+			return fmt.Errorf("duplicated performance history: %s", h.Sequencer)
+		}
+		if err := h.ValidateBasic(); err != nil {
+			return fmt.Errorf("performance history: %s: %w", h.Sequencer, err)
+		}
+		histories[h.Sequencer] = struct{}{}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
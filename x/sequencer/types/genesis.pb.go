@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -33,7 +37,15 @@ type GenesisState struct {
 	// genesisSuccessor is a list of the defined genesis proposers
 	GenesisSuccessors []GenesisProposer `protobuf:"bytes,5,rep,name=genesisSuccessors,proto3" json:"genesisSuccessors"`
 	// list of sequencers in the notice queue
-	NoticeQueue []string `protobuf:"bytes,4,rep,name=noticeQueue,proto3" json:"noticeQueue,omitempty"`
+	NoticeQueue     []string         `protobuf:"bytes,4,rep,name=noticeQueue,proto3" json:"noticeQueue,omitempty"`
+	DelegationPools []DelegationPool `protobuf:"bytes,6,rep,name=delegation_pools,json=delegationPools,proto3" json:"delegation_pools"`
+	Delegations     []Delegation     `protobuf:"bytes,7,rep,name=delegations,proto3" json:"delegations"`
+	Unbondings      []UnbondingEntry `protobuf:"bytes,8,rep,name=unbondings,proto3" json:"unbondings"`
+	// last sequencer chosen as proposer of each rollapp, where round robin
+	// selection resumes
+	LastChosenProposers  []GenesisProposer    `protobuf:"bytes,9,rep,name=last_chosen_proposers,json=lastChosenProposers,proto3" json:"last_chosen_proposers"`
+	ProposerTerms        []ProposerTerm       `protobuf:"bytes,10,rep,name=proposer_terms,json=proposerTerms,proto3" json:"proposer_terms"`
+	PerformanceHistories []PerformanceHistory `protobuf:"bytes,11,rep,name=performance_histories,json=performanceHistories,proto3" json:"performance_histories"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -104,6 +116,48 @@ func (m *GenesisState) GetNoticeQueue() []string {
 	return nil
 }
 
+func (m *GenesisState) GetDelegationPools() []DelegationPool {
+	if m != nil {
+		return m.DelegationPools
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondings() []UnbondingEntry {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastChosenProposers() []GenesisProposer {
+	if m != nil {
+		return m.LastChosenProposers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProposerTerms() []ProposerTerm {
+	if m != nil {
+		return m.ProposerTerms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPerformanceHistories() []PerformanceHistory {
+	if m != nil {
+		return m.PerformanceHistories
+	}
+	return nil
+}
+
 type GenesisProposer struct {
 	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
@@ -156,9 +210,64 @@ func (m *GenesisProposer) GetRollappId() string {
 	return ""
 }
 
+// ProposerTerm is when the current proposer of a rollapp started serving, for
+// scheduled rotations.
+type ProposerTerm struct {
+	RollappId string    `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Start     time.Time `protobuf:"bytes,2,opt,name=start,proto3,stdtime" json:"start"`
+}
+
+func (m *ProposerTerm) Reset()         { *m = ProposerTerm{} }
+func (m *ProposerTerm) String() string { return proto.CompactTextString(m) }
+func (*ProposerTerm) ProtoMessage()    {}
+func (*ProposerTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3115db717b16c2af, []int{2}
+}
+func (m *ProposerTerm) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposerTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposerTerm.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposerTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposerTerm.Merge(m, src)
+}
+func (m *ProposerTerm) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposerTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposerTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposerTerm proto.InternalMessageInfo
+
+func (m *ProposerTerm) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *ProposerTerm) GetStart() time.Time {
+	if m != nil {
+		return m.Start
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.sequencer.GenesisState")
 	proto.RegisterType((*GenesisProposer)(nil), "dymensionxyz.dymension.sequencer.GenesisProposer")
+	proto.RegisterType((*ProposerTerm)(nil), "dymensionxyz.dymension.sequencer.ProposerTerm")
 }
 
 func init() {
@@ -166,30 +275,45 @@ func init() {
 }
 
 var fileDescriptor_3115db717b16c2af = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x4e, 0xc2, 0x40,
-	0x10, 0x86, 0x5b, 0x8a, 0x18, 0x16, 0x8d, 0xba, 0xf1, 0xb0, 0x21, 0xa6, 0x36, 0x9c, 0x9a, 0x18,
-	0x5b, 0x85, 0xc4, 0x07, 0xe0, 0x20, 0x21, 0xf1, 0x80, 0x70, 0x30, 0xf1, 0x56, 0xda, 0x49, 0x6d,
-	0x02, 0xdd, 0xba, 0xb3, 0x35, 0xe0, 0x53, 0xf8, 0x02, 0xbe, 0x0f, 0x47, 0x8e, 0x9e, 0x8c, 0x81,
-	0x17, 0x31, 0xb6, 0xa5, 0x80, 0xc4, 0xf4, 0xe0, 0x6d, 0x66, 0xfa, 0xff, 0xdf, 0x3f, 0xe9, 0x0e,
-	0xb1, 0xbc, 0xe9, 0x18, 0x42, 0x0c, 0x78, 0x38, 0x99, 0xbe, 0xda, 0x79, 0x63, 0x23, 0x3c, 0xc7,
-	0x10, 0xba, 0x20, 0x6c, 0x1f, 0x42, 0xc0, 0x00, 0xad, 0x48, 0x70, 0xc9, 0xa9, 0xb1, 0xa9, 0x5f,
-	0x9b, 0xad, 0x5c, 0x5f, 0x3f, 0xf5, 0xb9, 0xcf, 0x13, 0xb1, 0xfd, 0x53, 0xa5, 0xbe, 0xfa, 0x65,
-	0x61, 0x4e, 0xe4, 0x08, 0x67, 0x9c, 0xc5, 0xd4, 0xaf, 0x0a, 0xe5, 0x79, 0x95, 0x3a, 0x1a, 0xef,
-	0x1a, 0x39, 0xe8, 0xa4, 0xab, 0x0e, 0xa4, 0x23, 0x81, 0xde, 0x92, 0x4a, 0x8a, 0x64, 0xaa, 0xa1,
-	0x9a, 0xb5, 0xa6, 0x69, 0x15, 0xad, 0x6e, 0xf5, 0x12, 0x7d, 0xbb, 0x3c, 0xfb, 0x3c, 0x57, 0xfa,
-	0x99, 0x9b, 0x3e, 0x90, 0xc3, 0x5c, 0x71, 0x17, 0xa0, 0x64, 0x25, 0x43, 0x33, 0x6b, 0xcd, 0x8b,
-	0x62, 0xdc, 0x60, 0x55, 0x65, 0xc4, 0x6d, 0x0e, 0x75, 0xc9, 0x71, 0xf6, 0x6f, 0x7b, 0x82, 0x47,
-	0x1c, 0x41, 0x20, 0xd3, 0x12, 0xf6, 0x75, 0x31, 0xbb, 0xb3, 0xed, 0xcc, 0x12, 0x76, 0x80, 0x14,
-	0xc8, 0x49, 0x36, 0x1b, 0xc4, 0xae, 0x0b, 0x88, 0x5c, 0x20, 0xdb, 0xfb, 0x5f, 0xca, 0x2e, 0x91,
-	0x1a, 0xa4, 0x16, 0x72, 0x19, 0xb8, 0x70, 0x1f, 0x43, 0x0c, 0xac, 0x6c, 0x68, 0x66, 0xb5, 0xbf,
-	0x39, 0x6a, 0x74, 0xc9, 0xd1, 0x2f, 0x1a, 0x65, 0x64, 0xdf, 0xf1, 0x3c, 0x01, 0x98, 0x3e, 0x51,
-	0xb5, 0xbf, 0x6a, 0xe9, 0x19, 0xa9, 0x0a, 0x3e, 0x1a, 0x39, 0x51, 0xd4, 0xf5, 0x58, 0x29, 0xf9,
-	0xb6, 0x1e, 0xb4, 0x7b, 0xb3, 0x85, 0xae, 0xce, 0x17, 0xba, 0xfa, 0xb5, 0xd0, 0xd5, 0xb7, 0xa5,
-	0xae, 0xcc, 0x97, 0xba, 0xf2, 0xb1, 0xd4, 0x95, 0xc7, 0x1b, 0x3f, 0x90, 0x4f, 0xf1, 0xd0, 0x72,
-	0xf9, 0xd8, 0xfe, 0xe3, 0x82, 0x5e, 0x5a, 0xf6, 0x64, 0xe3, 0x8c, 0xe4, 0x34, 0x02, 0x1c, 0x56,
-	0x92, 0x1b, 0x6a, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x74, 0x53, 0x26, 0xab, 0x0e, 0x03, 0x00,
-	0x00,
+	// 608 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc7, 0xe3, 0x7e, 0x67, 0x53, 0x68, 0x59, 0x5a, 0x69, 0x15, 0x41, 0x1a, 0xf5, 0x14, 0x09,
+	0xb0, 0xdb, 0x14, 0x71, 0xe0, 0x58, 0x3e, 0x4a, 0x25, 0x0e, 0x21, 0x2d, 0x20, 0xc1, 0x21, 0x72,
+	0xec, 0xa9, 0xbb, 0xc2, 0xf6, 0x9a, 0x9d, 0x35, 0x6a, 0x78, 0x8a, 0x3e, 0x56, 0x8f, 0x3d, 0x72,
+	0x02, 0xd4, 0x9e, 0x78, 0x0b, 0x94, 0xf5, 0xda, 0x71, 0x5b, 0x21, 0x07, 0xf5, 0xb6, 0x3b, 0x3b,
+	0xff, 0xdf, 0xcc, 0xec, 0xce, 0x0e, 0xb1, 0xfd, 0x51, 0x04, 0x31, 0x72, 0x11, 0x9f, 0x8c, 0xbe,
+	0x3b, 0xc5, 0xc6, 0x41, 0xf8, 0x9a, 0x42, 0xec, 0x81, 0x74, 0x02, 0x88, 0x01, 0x39, 0xda, 0x89,
+	0x14, 0x4a, 0xd0, 0x76, 0xd9, 0x7f, 0x22, 0xb6, 0x0b, 0xff, 0xe6, 0x5a, 0x20, 0x02, 0xa1, 0x9d,
+	0x9d, 0xf1, 0x2a, 0xd3, 0x35, 0x37, 0x02, 0x21, 0x82, 0x10, 0x1c, 0xbd, 0x1b, 0xa6, 0x47, 0x8e,
+	0xe2, 0x11, 0xa0, 0x72, 0xa3, 0xc4, 0x38, 0x3c, 0xa9, 0x4c, 0x24, 0x71, 0xa5, 0x1b, 0x99, 0x3c,
+	0x9a, 0x5b, 0x95, 0xee, 0xc5, 0xca, 0x28, 0xb6, 0x2b, 0x15, 0x3e, 0x84, 0x10, 0xb8, 0x6a, 0x5c,
+	0xce, 0xb4, 0x41, 0xd2, 0x78, 0x28, 0x62, 0x9f, 0xc7, 0x81, 0x51, 0x74, 0xab, 0xab, 0x00, 0x79,
+	0x24, 0x64, 0xe4, 0xc6, 0x1e, 0x64, 0x9a, 0xcd, 0x3f, 0x8b, 0x64, 0x79, 0x2f, 0xbb, 0xe4, 0x03,
+	0xe5, 0x2a, 0xa0, 0xaf, 0xc9, 0x42, 0x56, 0x2b, 0xb3, 0xda, 0x56, 0xa7, 0xd1, 0xed, 0xd8, 0x55,
+	0x97, 0x6e, 0xf7, 0xb4, 0xff, 0xee, 0xdc, 0xd9, 0xcf, 0x8d, 0x5a, 0xdf, 0xa8, 0xe9, 0x47, 0x72,
+	0xa7, 0xf0, 0x78, 0xcb, 0x51, 0xb1, 0x99, 0xf6, 0x6c, 0xa7, 0xd1, 0x7d, 0x54, 0x8d, 0x3b, 0xc8,
+	0x57, 0x86, 0x78, 0x95, 0x43, 0x3d, 0xb2, 0x6a, 0xba, 0xa2, 0x27, 0x45, 0x22, 0x10, 0x24, 0xb2,
+	0x59, 0xcd, 0xde, 0xae, 0x66, 0xef, 0x5d, 0x55, 0x9a, 0x08, 0x37, 0x80, 0x14, 0xc8, 0x3d, 0x63,
+	0x3b, 0x48, 0x3d, 0x0f, 0x10, 0x85, 0x44, 0x36, 0x7f, 0xbb, 0x28, 0x37, 0x89, 0xb4, 0x4d, 0x1a,
+	0xb1, 0x50, 0xdc, 0x83, 0x77, 0x29, 0xa4, 0xc0, 0xe6, 0xda, 0xb3, 0x9d, 0x7a, 0xbf, 0x6c, 0xa2,
+	0x2e, 0x59, 0x9d, 0x74, 0xc6, 0x20, 0x11, 0x22, 0x44, 0xb6, 0xa0, 0xf3, 0xd8, 0xaa, 0xce, 0xe3,
+	0x65, 0xa1, 0xec, 0x09, 0x11, 0x9a, 0x34, 0x56, 0xfc, 0x2b, 0x56, 0xa4, 0x87, 0xa4, 0x31, 0x31,
+	0x21, 0x5b, 0xd4, 0xf4, 0xc7, 0xff, 0x43, 0x37, 0xe4, 0x32, 0x86, 0x7e, 0x20, 0xa4, 0xe8, 0x4f,
+	0x64, 0x4b, 0xd3, 0xa6, 0xfc, 0x3e, 0xd7, 0xbc, 0x8a, 0x95, 0x1c, 0x19, 0x70, 0x89, 0x44, 0xbf,
+	0x90, 0xf5, 0xd0, 0x45, 0x35, 0xf0, 0x8e, 0x05, 0x42, 0x3c, 0x48, 0x8a, 0x1e, 0xa8, 0xdf, 0xee,
+	0x75, 0xee, 0x8f, 0xa9, 0x2f, 0x34, 0x74, 0xd2, 0x06, 0x9f, 0xc9, 0xdd, 0x3c, 0xc0, 0x40, 0x81,
+	0x8c, 0x90, 0x11, 0x1d, 0xc5, 0x9e, 0xe2, 0x53, 0x18, 0xdd, 0x21, 0xc8, 0x28, 0x6f, 0xe4, 0xa4,
+	0x64, 0x43, 0x2a, 0xc8, 0x7a, 0xe9, 0x3f, 0x0e, 0x8e, 0x39, 0x2a, 0x21, 0x39, 0x20, 0x6b, 0xe8,
+	0x18, 0x4f, 0xa7, 0x88, 0x31, 0x91, 0xbf, 0xd1, 0xea, 0xfc, 0xc2, 0xd6, 0x92, 0xeb, 0x27, 0x1c,
+	0x70, 0x73, 0x9f, 0xac, 0x5c, 0xab, 0x9d, 0x32, 0xb2, 0xe8, 0xfa, 0xbe, 0x04, 0xcc, 0xbe, 0x7b,
+	0xbd, 0x9f, 0x6f, 0xe9, 0x03, 0x52, 0x97, 0x22, 0x0c, 0xdd, 0x24, 0xd9, 0xf7, 0xd9, 0x8c, 0x3e,
+	0x9b, 0x18, 0x36, 0x39, 0x59, 0x2e, 0x17, 0x48, 0x1f, 0x12, 0x62, 0x0e, 0x07, 0xdc, 0x67, 0xd6,
+	0x35, 0x77, 0xfa, 0x9c, 0xcc, 0xa3, 0x72, 0xa5, 0xd2, 0xa0, 0x46, 0xb7, 0x69, 0x67, 0x03, 0xd9,
+	0xce, 0x07, 0xb2, 0x7d, 0x98, 0x0f, 0xe4, 0xdd, 0xa5, 0x71, 0x01, 0xa7, 0xbf, 0x36, 0xac, 0x7e,
+	0x26, 0xd9, 0xed, 0x9d, 0x5d, 0xb4, 0xac, 0xf3, 0x8b, 0x96, 0xf5, 0xfb, 0xa2, 0x65, 0x9d, 0x5e,
+	0xb6, 0x6a, 0xe7, 0x97, 0xad, 0xda, 0x8f, 0xcb, 0x56, 0xed, 0xd3, 0xb3, 0x80, 0xab, 0xe3, 0x74,
+	0x68, 0x7b, 0x22, 0x72, 0xfe, 0x31, 0xfa, 0xbe, 0xed, 0x38, 0x27, 0xa5, 0xf9, 0xa7, 0x46, 0x09,
+	0xe0, 0x70, 0x41, 0x87, 0xdd, 0xf9, 0x3b, 0x00, 0x78, 0x1e, 0xdd, 0x60, 0x7f, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -212,6 +336,90 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PerformanceHistories) > 0 {
+		for iNdEx := len(m.PerformanceHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PerformanceHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ProposerTerms) > 0 {
+		for iNdEx := len(m.ProposerTerms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerTerms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.LastChosenProposers) > 0 {
+		for iNdEx := len(m.LastChosenProposers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LastChosenProposers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.DelegationPools) > 0 {
+		for iNdEx := len(m.DelegationPools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegationPools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenesisSuccessors) > 0 {
 		for iNdEx := len(m.GenesisSuccessors) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -313,6 +521,44 @@ func (m *GenesisProposer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ProposerTerm) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposerTerm) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposerTerm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Start, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintGenesis(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -356,6 +602,42 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DelegationPools) > 0 {
+		for _, e := range m.DelegationPools {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LastChosenProposers) > 0 {
+		for _, e := range m.LastChosenProposers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProposerTerms) > 0 {
+		for _, e := range m.ProposerTerms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PerformanceHistories) > 0 {
+		for _, e := range m.PerformanceHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -376,6 +658,21 @@ func (m *GenesisProposer) Size() (n int) {
 	return n
 }
 
+func (m *ProposerTerm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Start)
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -578,6 +875,210 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegationPools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegationPools = append(m.DelegationPools, DelegationPool{})
+			if err := m.DelegationPools[len(m.DelegationPools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, UnbondingEntry{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastChosenProposers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastChosenProposers = append(m.LastChosenProposers, GenesisProposer{})
+			if err := m.LastChosenProposers[len(m.LastChosenProposers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerTerms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerTerms = append(m.ProposerTerms, ProposerTerm{})
+			if err := m.ProposerTerms[len(m.ProposerTerms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerformanceHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PerformanceHistories = append(m.PerformanceHistories, PerformanceHistory{})
+			if err := m.PerformanceHistories[len(m.PerformanceHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -713,6 +1214,121 @@ func (m *GenesisProposer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ProposerTerm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProposerTerm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProposerTerm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Start, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -23,6 +23,10 @@ const (
 
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
+
+	// DelegatorRewardsPoolName is the module account holding the delegators'
+	// rewards until they are withdrawn
+	DelegatorRewardsPoolName = ModuleName + "_delegator_rewards"
 )
 
 var (
@@ -55,6 +59,18 @@ var (
 
 	DymintProposerAddrToAccAddrKeyPrefix = collections.NewPrefix([]byte{0x43})
 
+	DelegationPoolKeyPrefix = collections.NewPrefix([]byte{0x44}) // prefix/seqAddr
+	DelegationKeyPrefix     = collections.NewPrefix([]byte{0x45}) // prefix/seqAddr/delegator
+
+	UnbondingKeyPrefix      = collections.NewPrefix([]byte{0x46}) // prefix/seqAddr/id
+	UnbondingQueueKeyPrefix = collections.NewPrefix([]byte{0x47}) // prefix/completionTime/seqAddr/id
+	UnbondingSeqKey         = collections.NewPrefix([]byte{0x48})
+
+	LastChosenProposerKeyPrefix = collections.NewPrefix([]byte{0x49}) // prefix/rollapp
+	ProposerTermKeyPrefix       = collections.NewPrefix([]byte{0x4a}) // prefix/rollapp
+
+	PerformanceHistoryKeyPrefix = collections.NewPrefix([]byte{0x4b}) // prefix/seqAddr
+
 	// These keys were already used on mainnet. Don't reuse
 	_ = []byte{0xa3}
 	_ = []byte{0x41}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgWithdrawDelegatorRewards{}
+	_ sdk.Msg = &MsgFundSequencerRewards{}
+	_ sdk.Msg = &MsgUpdateCommission{}
+)
+
+func (msg *MsgDelegate) ValidateBasic() error {
+	if err := validateDelegationAddrs(msg.Delegator, msg.Sequencer); err != nil {
+		return err
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid delegation amount: %s", msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg *MsgUndelegate) ValidateBasic() error {
+	if err := validateDelegationAddrs(msg.Delegator, msg.Sequencer); err != nil {
+		return err
+	}
+	// zero withdraws a delegation slashed to nothing
+	if !msg.Amount.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid undelegation amount: %s", msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg *MsgWithdrawDelegatorRewards) ValidateBasic() error {
+	return validateDelegationAddrs(msg.Delegator, msg.Sequencer)
+}
+
+func (msg *MsgFundSequencerRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sender address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Sequencer)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid rewards: %s", msg.Amount.String())
+	}
+	return nil
+}
+
+func (msg *MsgUpdateCommission) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	return ValidateCommissionRate(msg.CommissionRate)
+}
+
+func validateDelegationAddrs(delegator, sequencer string) error {
+	_, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid delegator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(sequencer)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	return nil
+}
@@ -22,6 +22,9 @@ var (
 	DefaultDishonorStateUpdate   = uint64(1)
 	DefaultDishonorLiveness      = uint64(300)
 	DefaultDishonorKickThreshold = uint64(900)
+
+	// DefaultUnbondingPeriod pays unbonded tokens out at once
+	DefaultUnbondingPeriod = time.Duration(0)
 )
 
 // NewParams creates a new Params instance
@@ -29,6 +32,7 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 	dishonorStateUpdate uint64,
 	dishonorLiveness uint64,
 	dishonorKickThreshold uint64,
+	unbondingPeriod time.Duration,
 ) Params {
 	return Params{
 		NoticePeriod:               noticePeriod,
@@ -37,12 +41,13 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 		DishonorStateUpdate:        dishonorStateUpdate,
 		DishonorLiveness:           dishonorLiveness,
 		DishonorKickThreshold:      dishonorKickThreshold,
+		UnbondingPeriod:            unbondingPeriod,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold)
+	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold, DefaultUnbondingPeriod)
 }
 
 func validateTime(v time.Duration) error {
@@ -81,6 +86,10 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if p.UnbondingPeriod < 0 {
+		return fmt.Errorf("unbonding period must not be negative: %d", p.UnbondingPeriod)
+	}
+
 	return nil
 }
 
@@ -47,6 +47,9 @@ type Params struct {
 	DishonorStateUpdate uint64 `protobuf:"varint,8,opt,name=dishonor_state_update,json=dishonorStateUpdate,proto3" json:"dishonor_state_update,omitempty"`
 	// the minimum dishonor at which a sequencer can be kicked (<=)
 	DishonorKickThreshold uint64 `protobuf:"varint,9,opt,name=dishonor_kick_threshold,json=dishonorKickThreshold,proto3" json:"dishonor_kick_threshold,omitempty"`
+	// unbonding_period is how long unbonded and undelegated tokens stay in the
+	// module, slashable, before they are paid out. Zero pays them out at once.
+	UnbondingPeriod time.Duration `protobuf:"bytes,10,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -116,6 +119,13 @@ func (m *Params) GetDishonorKickThreshold() uint64 {
 	return 0
 }
 
+func (m *Params) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.sequencer.Params")
 }
@@ -125,41 +135,42 @@ func init() {
 }
 
 var fileDescriptor_599b0eefba99ee26 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xad, 0x09, 0xae, 0x01, 0x29, 0x18, 0x10, 0x6e, 0x2a, 0x6c, 0x2b, 0x12, 0x52,
-	0x24, 0xc8, 0x9d, 0xda, 0x4a, 0x1d, 0xba, 0x11, 0x32, 0xa0, 0xd0, 0x4a, 0x51, 0x4a, 0x17, 0x16,
-	0xeb, 0x6c, 0x1f, 0xf6, 0x29, 0xb6, 0xcf, 0xf8, 0xce, 0x51, 0xcd, 0x17, 0x60, 0x65, 0xcc, 0xd8,
-	0x0f, 0xc0, 0xc8, 0x87, 0xe8, 0x58, 0x31, 0x21, 0x86, 0x80, 0x92, 0x05, 0x31, 0xf2, 0x09, 0x90,
-	0xff, 0xaa, 0xaa, 0xa0, 0x5b, 0x9e, 0x7b, 0x7e, 0xcf, 0x93, 0xf7, 0x5e, 0xf9, 0x94, 0x81, 0x9b,
-	0x85, 0x24, 0xe2, 0x94, 0x45, 0x67, 0xd9, 0x07, 0xd4, 0x08, 0xc4, 0xc9, 0xfb, 0x94, 0x44, 0x0e,
-	0x49, 0x50, 0x8c, 0x13, 0x1c, 0x72, 0x18, 0x27, 0x4c, 0x30, 0xd5, 0xbc, 0x8a, 0xc3, 0x46, 0xc0,
-	0x06, 0xef, 0x3e, 0xf4, 0x98, 0xc7, 0x0a, 0x18, 0xe5, 0xbf, 0xca, 0x5c, 0x77, 0xdb, 0x61, 0x3c,
-	0x64, 0xdc, 0x2a, 0x8d, 0x52, 0x54, 0x96, 0x5e, 0x2a, 0x64, 0x63, 0x4e, 0xd0, 0x7c, 0xd7, 0x26,
-	0x02, 0xef, 0x22, 0x87, 0xd1, 0xa8, 0xf6, 0x3d, 0xc6, 0xbc, 0x80, 0xa0, 0x42, 0xd9, 0xe9, 0x3b,
-	0xe4, 0xa6, 0x09, 0x16, 0xf9, 0x9f, 0x16, 0x27, 0xbd, 0xcf, 0x92, 0xd2, 0x9e, 0x14, 0x33, 0xaa,
-	0xaf, 0x94, 0x7b, 0x11, 0x13, 0xd4, 0x21, 0x56, 0x4c, 0x12, 0xca, 0x5c, 0x6d, 0xd3, 0x04, 0xfd,
-	0x3b, 0x7b, 0xdb, 0xb0, 0xac, 0x80, 0x75, 0x05, 0x1c, 0x55, 0x15, 0x43, 0xf9, 0x62, 0x69, 0xb4,
-	0x16, 0x3f, 0x0c, 0x30, 0xbd, 0x5b, 0x26, 0x27, 0x45, 0x50, 0x5d, 0x00, 0xe5, 0x49, 0x40, 0xe7,
-	0x24, 0x22, 0x9c, 0x5b, 0x3c, 0xc0, 0xdc, 0xb7, 0x42, 0x1a, 0x59, 0x61, 0x1a, 0x08, 0x1a, 0x07,
-	0x94, 0x24, 0x9a, 0x64, 0x82, 0xfe, 0xd6, 0xf0, 0x34, 0xcf, 0x7f, 0x5f, 0x1a, 0x3b, 0xe5, 0x25,
-	0xb8, 0x3b, 0x83, 0x94, 0xa1, 0x10, 0x0b, 0x1f, 0x1e, 0x11, 0x0f, 0x3b, 0xd9, 0x88, 0x38, 0x7f,
-	0x96, 0x86, 0x99, 0xe1, 0x30, 0x38, 0xec, 0x5d, 0x6f, 0x6c, 0xda, 0x7a, 0x5f, 0xbf, 0x0c, 0x94,
-	0x6a, 0x2b, 0x23, 0xe2, 0x4c, 0xbb, 0x35, 0x79, 0x92, 0x83, 0xc7, 0x34, 0x3a, 0x6e, 0x50, 0xf5,
-	0x23, 0x50, 0x76, 0xfe, 0x31, 0x1a, 0xb6, 0x39, 0x0b, 0x52, 0x41, 0xb4, 0x76, 0x75, 0xe7, 0xaa,
-	0x2e, 0x5f, 0x2b, 0xac, 0xd6, 0x0a, 0x5f, 0x32, 0x1a, 0x0d, 0x07, 0xf9, 0xcc, 0xbf, 0x97, 0xc6,
-	0xd3, 0x1b, 0x5a, 0x9e, 0xb3, 0x90, 0x0a, 0x12, 0xc6, 0x22, 0x9b, 0x6a, 0xd7, 0x67, 0x79, 0x51,
-	0x31, 0xea, 0x33, 0xe5, 0xbe, 0x4b, 0xb9, 0xcf, 0x22, 0x96, 0x58, 0x35, 0xa4, 0xdd, 0x36, 0x41,
-	0x5f, 0x9a, 0x76, 0x6a, 0xe3, 0xa8, 0x3a, 0x57, 0xf7, 0x94, 0x47, 0x0d, 0xcc, 0x05, 0x16, 0xc4,
-	0x4a, 0x63, 0x17, 0x0b, 0xa2, 0xc9, 0x45, 0xe0, 0x41, 0x6d, 0x9e, 0xe4, 0xde, 0x69, 0x61, 0xa9,
-	0x07, 0xca, 0xe3, 0x26, 0x33, 0xa3, 0xce, 0xcc, 0x12, 0x7e, 0x42, 0xb8, 0xcf, 0x02, 0x57, 0xdb,
-	0x2a, 0x52, 0x4d, 0xe5, 0x6b, 0xea, 0xcc, 0xde, 0xd4, 0xe6, 0xa1, 0xbc, 0x38, 0x37, 0x5a, 0xbf,
-	0xce, 0x0d, 0x30, 0x96, 0x64, 0xd0, 0xd9, 0x18, 0x4b, 0xf2, 0xad, 0x4e, 0x7b, 0x2c, 0xc9, 0x1b,
-	0x9d, 0xcd, 0xe1, 0xe4, 0x62, 0xa5, 0x83, 0xcb, 0x95, 0x0e, 0x7e, 0xae, 0x74, 0xf0, 0x69, 0xad,
-	0xb7, 0x2e, 0xd7, 0x7a, 0xeb, 0xdb, 0x5a, 0x6f, 0xbd, 0x3d, 0xf0, 0xa8, 0xf0, 0x53, 0x1b, 0x3a,
-	0x2c, 0x44, 0xff, 0x79, 0x15, 0xf3, 0x7d, 0x74, 0x76, 0xe5, 0x69, 0x88, 0x2c, 0x26, 0xdc, 0x6e,
-	0x17, 0x9f, 0xd5, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xe4, 0xe4, 0x9d, 0x4b, 0x03,
-	0x00, 0x00,
+	// 545 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x3d, 0x6f, 0xd3, 0x40,
+	0x18, 0x8e, 0x5b, 0x13, 0xdc, 0x03, 0x44, 0x30, 0x20, 0xdc, 0x56, 0xd8, 0x51, 0x25, 0xa4, 0x4a,
+	0x90, 0x3b, 0xb5, 0x95, 0x3a, 0x74, 0x23, 0x64, 0x40, 0xa1, 0x45, 0x51, 0x4a, 0x17, 0x16, 0xeb,
+	0x6c, 0x1f, 0xf6, 0x29, 0xf6, 0x9d, 0xf1, 0x9d, 0xa3, 0x9a, 0x3f, 0xc0, 0xca, 0x98, 0xb1, 0x3f,
+	0x82, 0x1f, 0xd1, 0xb1, 0x62, 0x42, 0x0c, 0x01, 0x25, 0x0b, 0x62, 0x64, 0x66, 0x40, 0xfe, 0x54,
+	0x55, 0x01, 0x62, 0xf3, 0x73, 0xcf, 0x87, 0x9f, 0x7b, 0xf5, 0x1e, 0xe8, 0x79, 0x59, 0x44, 0x98,
+	0xa0, 0x9c, 0x9d, 0x66, 0xef, 0x50, 0x03, 0x90, 0x20, 0x6f, 0x53, 0xc2, 0x5c, 0x92, 0xa0, 0x18,
+	0x27, 0x38, 0x12, 0x30, 0x4e, 0xb8, 0xe4, 0x7a, 0xf7, 0xb2, 0x1c, 0x36, 0x00, 0x36, 0xf2, 0x8d,
+	0x7b, 0x3e, 0xf7, 0x79, 0x21, 0x46, 0xf9, 0x57, 0xe9, 0xdb, 0x58, 0x77, 0xb9, 0x88, 0xb8, 0xb0,
+	0x4b, 0xa2, 0x04, 0x15, 0x65, 0x96, 0x08, 0x39, 0x58, 0x10, 0x34, 0xdd, 0x71, 0x88, 0xc4, 0x3b,
+	0xc8, 0xe5, 0x94, 0xd5, 0xbc, 0xcf, 0xb9, 0x1f, 0x12, 0x54, 0x20, 0x27, 0x7d, 0x83, 0xbc, 0x34,
+	0xc1, 0x32, 0xff, 0x69, 0x71, 0xb2, 0xf5, 0x4b, 0x05, 0xed, 0x51, 0xd1, 0x51, 0x7f, 0x0e, 0x6e,
+	0x31, 0x2e, 0xa9, 0x4b, 0xec, 0x98, 0x24, 0x94, 0x7b, 0xc6, 0x6a, 0x57, 0xd9, 0xbe, 0xb1, 0xbb,
+	0x0e, 0xcb, 0x08, 0x58, 0x47, 0xc0, 0x41, 0x15, 0xd1, 0xd7, 0xce, 0xe7, 0x56, 0x6b, 0xf6, 0xd5,
+	0x52, 0xc6, 0x37, 0x4b, 0xe7, 0xa8, 0x30, 0xea, 0x33, 0x05, 0x3c, 0x0c, 0xe9, 0x94, 0x30, 0x22,
+	0x84, 0x2d, 0x42, 0x2c, 0x02, 0x3b, 0xa2, 0xcc, 0x8e, 0xd2, 0x50, 0xd2, 0x38, 0xa4, 0x24, 0x31,
+	0xd4, 0xae, 0xb2, 0xbd, 0xd6, 0x3f, 0xc9, 0xfd, 0x5f, 0xe6, 0xd6, 0x66, 0x79, 0x09, 0xe1, 0x4d,
+	0x20, 0xe5, 0x28, 0xc2, 0x32, 0x80, 0x87, 0xc4, 0xc7, 0x6e, 0x36, 0x20, 0xee, 0xcf, 0xb9, 0xd5,
+	0xcd, 0x70, 0x14, 0x1e, 0x6c, 0x5d, 0x4d, 0x6c, 0xd2, 0xb6, 0x3e, 0x7d, 0xec, 0x81, 0x6a, 0x2a,
+	0x03, 0xe2, 0x8e, 0x37, 0x6a, 0xe5, 0x71, 0x2e, 0x3c, 0xa2, 0xec, 0xa8, 0x91, 0xea, 0xef, 0x15,
+	0xb0, 0xf9, 0x87, 0x6a, 0xd8, 0x11, 0x3c, 0x4c, 0x25, 0x31, 0xda, 0xd5, 0x9d, 0xab, 0xb8, 0x7c,
+	0xac, 0xb0, 0x1a, 0x2b, 0x7c, 0xc6, 0x29, 0xeb, 0xf7, 0xf2, 0xce, 0x3f, 0xe6, 0xd6, 0xa3, 0x7f,
+	0xa4, 0x3c, 0xe1, 0x11, 0x95, 0x24, 0x8a, 0x65, 0x36, 0x36, 0xae, 0x76, 0x79, 0x5a, 0x69, 0xf4,
+	0xc7, 0xe0, 0x8e, 0x47, 0x45, 0xc0, 0x19, 0x4f, 0xec, 0x5a, 0x64, 0x5c, 0xef, 0x2a, 0xdb, 0xea,
+	0xb8, 0x53, 0x13, 0x87, 0xd5, 0xb9, 0xbe, 0x0b, 0xee, 0x37, 0x62, 0x21, 0xb1, 0x24, 0x76, 0x1a,
+	0x7b, 0x58, 0x12, 0x43, 0x2b, 0x0c, 0x77, 0x6b, 0xf2, 0x38, 0xe7, 0x4e, 0x0a, 0x4a, 0xdf, 0x07,
+	0x0f, 0x1a, 0xcf, 0x84, 0xba, 0x13, 0x5b, 0x06, 0x09, 0x11, 0x01, 0x0f, 0x3d, 0x63, 0xad, 0x70,
+	0x35, 0x91, 0x2f, 0xa8, 0x3b, 0x79, 0x55, 0x93, 0xfa, 0x4b, 0xd0, 0x49, 0x99, 0xc3, 0x99, 0x47,
+	0x99, 0x5f, 0xaf, 0x02, 0xf8, 0xff, 0x55, 0xb8, 0xdd, 0x98, 0xcb, 0x6d, 0x38, 0xd0, 0x66, 0x67,
+	0x56, 0xeb, 0xfb, 0x99, 0xa5, 0x0c, 0x55, 0x4d, 0xe9, 0xac, 0x0c, 0x55, 0xed, 0x5a, 0xa7, 0x3d,
+	0x54, 0xb5, 0x95, 0xce, 0x6a, 0x7f, 0x74, 0xbe, 0x30, 0x95, 0x8b, 0x85, 0xa9, 0x7c, 0x5b, 0x98,
+	0xca, 0x87, 0xa5, 0xd9, 0xba, 0x58, 0x9a, 0xad, 0xcf, 0x4b, 0xb3, 0xf5, 0x7a, 0xdf, 0xa7, 0x32,
+	0x48, 0x1d, 0xe8, 0xf2, 0x08, 0xfd, 0xe5, 0x95, 0x4d, 0xf7, 0xd0, 0xe9, 0xa5, 0xa7, 0x26, 0xb3,
+	0x98, 0x08, 0xa7, 0x5d, 0x74, 0xdb, 0xfb, 0x3d, 0x00, 0xf6, 0x66, 0xe7, 0xb5, 0x9b, 0x03, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -199,6 +210,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DishonorKickThreshold != that1.DishonorKickThreshold {
 		return false
 	}
+	if this.UnbondingPeriod != that1.UnbondingPeriod {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -221,6 +235,14 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
 	if m.DishonorKickThreshold != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DishonorKickThreshold))
 		i--
@@ -256,12 +278,12 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
@@ -299,6 +321,8 @@ func (m *Params) Size() (n int) {
 	if m.DishonorKickThreshold != 0 {
 		n += 1 + sovParams(uint64(m.DishonorKickThreshold))
 	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -494,6 +518,39 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
@@ -38,6 +39,15 @@ func TestValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"negative unbonding period",
+			func() Params {
+				p := params
+				p.UnbondingPeriod = -time.Hour
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
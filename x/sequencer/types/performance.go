@@ -0,0 +1,116 @@
+package types
+
+import (
+	"slices"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+const (
+	// PerformanceHistoryLength is how many records are kept per sequencer
+	PerformanceHistoryLength = 100
+
+	// how many state updates a missed liveness window and a kick outweigh in
+	// the score
+	missedLivenessWeight = 10
+	kickedWeight         = 25
+)
+
+func NewPerformanceHistory(seqAddr string) PerformanceHistory {
+	return PerformanceHistory{Sequencer: seqAddr}
+}
+
+// Add appends the record, overwriting the oldest once the buffer is full.
+func (h *PerformanceHistory) Add(r PerformanceRecord) {
+	if len(h.Records) < PerformanceHistoryLength {
+		h.Records = append(h.Records, r)
+		return
+	}
+	h.Records[h.Next] = r
+	h.Next = (h.Next + 1) % PerformanceHistoryLength
+}
+
+// AddStateUpdate records a state update made at the block time, with the
+// latency since the previous one of the proposer's term.
+func (h *PerformanceHistory) AddStateUpdate(ctx sdk.Context) {
+	r := PerformanceRecord{
+		Kind:   PerformanceRecord_StateUpdate,
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+	}
+	if !h.LastStateUpdate.IsZero() {
+		r.Latency = ctx.BlockTime().Sub(h.LastStateUpdate)
+	}
+	h.LastStateUpdate = ctx.BlockTime()
+	h.Add(r)
+}
+
+// Ordered returns the records oldest first.
+func (h PerformanceHistory) Ordered() []PerformanceRecord {
+	return append(slices.Clone(h.Records[h.Next:]), h.Records[:h.Next]...)
+}
+
+// Score is the share of state updates in the records, where a missed liveness
+// window or a kick weighs as much as many state updates. It is between 0 and 1,
+// and 1 without any record.
+func (h PerformanceHistory) Score() math.LegacyDec {
+	var updates, total int64
+	for _, r := range h.Records {
+		switch r.Kind {
+		case PerformanceRecord_StateUpdate:
+			updates++
+			total++
+		case PerformanceRecord_MissedLiveness:
+			total += missedLivenessWeight
+		case PerformanceRecord_Kicked:
+			total += kickedWeight
+		}
+	}
+	if total == 0 {
+		return math.LegacyOneDec()
+	}
+	return math.LegacyNewDec(updates).QuoInt64(total)
+}
+
+// AverageLatency is the mean latency of the recorded state updates, zero if
+// there is none.
+func (h PerformanceHistory) AverageLatency() time.Duration {
+	var sum time.Duration
+	var n int64
+	for _, r := range h.Records {
+		if r.Kind == PerformanceRecord_StateUpdate && r.Latency != 0 {
+			sum += r.Latency
+			n++
+		}
+	}
+	if n == 0 {
+		return 0
+	}
+	return sum / time.Duration(n)
+}
+
+func (h PerformanceHistory) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(h.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "sequencer: %s", err)
+	}
+	if PerformanceHistoryLength < len(h.Records) {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "too many records: %d", len(h.Records))
+	}
+	// next only moves once the buffer is full
+	if h.Next != 0 && (len(h.Records) < PerformanceHistoryLength || PerformanceHistoryLength <= h.Next) {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "next: %d", h.Next)
+	}
+	for i, r := range h.Records {
+		if _, ok := PerformanceRecord_Kind_name[int32(r.Kind)]; !ok {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "record %d: kind: %d", i, r.Kind)
+		}
+		if r.Latency < 0 {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "record %d: negative latency", i)
+		}
+	}
+	return nil
+}
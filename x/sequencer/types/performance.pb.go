@@ -0,0 +1,821 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/performance.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type PerformanceRecord_Kind int32
+
+const (
+	PerformanceRecord_StateUpdate PerformanceRecord_Kind = 0
+	// the rollapp missed a liveness window while the sequencer was proposer
+	PerformanceRecord_MissedLiveness PerformanceRecord_Kind = 1
+	// the sequencer was kicked from being proposer
+	PerformanceRecord_Kicked PerformanceRecord_Kind = 2
+)
+
+var PerformanceRecord_Kind_name = map[int32]string{
+	0: "StateUpdate",
+	1: "MissedLiveness",
+	2: "Kicked",
+}
+
+var PerformanceRecord_Kind_value = map[string]int32{
+	"StateUpdate":    0,
+	"MissedLiveness": 1,
+	"Kicked":         2,
+}
+
+func (x PerformanceRecord_Kind) String() string {
+	return proto.EnumName(PerformanceRecord_Kind_name, int32(x))
+}
+
+func (PerformanceRecord_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_8b8f787842750ec5, []int{0, 0}
+}
+
+// PerformanceRecord is an event in the sequencer's history as a proposer.
+type PerformanceRecord struct {
+	Kind   PerformanceRecord_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=dymensionxyz.dymension.sequencer.PerformanceRecord_Kind" json:"kind,omitempty"`
+	Height int64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time              `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// latency is the time since the sequencer's previous state update, only set
+	// on state updates after the first
+	Latency time.Duration `protobuf:"bytes,4,opt,name=latency,proto3,stdduration" json:"latency"`
+}
+
+func (m *PerformanceRecord) Reset()         { *m = PerformanceRecord{} }
+func (m *PerformanceRecord) String() string { return proto.CompactTextString(m) }
+func (*PerformanceRecord) ProtoMessage()    {}
+func (*PerformanceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8f787842750ec5, []int{0}
+}
+func (m *PerformanceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerformanceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerformanceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerformanceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerformanceRecord.Merge(m, src)
+}
+func (m *PerformanceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerformanceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerformanceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerformanceRecord proto.InternalMessageInfo
+
+func (m *PerformanceRecord) GetKind() PerformanceRecord_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return PerformanceRecord_StateUpdate
+}
+
+func (m *PerformanceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PerformanceRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *PerformanceRecord) GetLatency() time.Duration {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+// PerformanceHistory is a ring buffer of the most recent records of a
+// sequencer.
+type PerformanceHistory struct {
+	Sequencer string              `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	Records   []PerformanceRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+	// next is the index of the record overwritten next once the buffer is full
+	Next uint32 `protobuf:"varint,3,opt,name=next,proto3" json:"next,omitempty"`
+	// last_state_update is when the sequencer last updated the state, kept to
+	// compute the latency even if the record was overwritten
+	LastStateUpdate time.Time `protobuf:"bytes,4,opt,name=last_state_update,json=lastStateUpdate,proto3,stdtime" json:"last_state_update"`
+}
+
+func (m *PerformanceHistory) Reset()         { *m = PerformanceHistory{} }
+func (m *PerformanceHistory) String() string { return proto.CompactTextString(m) }
+func (*PerformanceHistory) ProtoMessage()    {}
+func (*PerformanceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8b8f787842750ec5, []int{1}
+}
+func (m *PerformanceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PerformanceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PerformanceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PerformanceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PerformanceHistory.Merge(m, src)
+}
+func (m *PerformanceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *PerformanceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_PerformanceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PerformanceHistory proto.InternalMessageInfo
+
+func (m *PerformanceHistory) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *PerformanceHistory) GetRecords() []PerformanceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *PerformanceHistory) GetNext() uint32 {
+	if m != nil {
+		return m.Next
+	}
+	return 0
+}
+
+func (m *PerformanceHistory) GetLastStateUpdate() time.Time {
+	if m != nil {
+		return m.LastStateUpdate
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.sequencer.PerformanceRecord_Kind", PerformanceRecord_Kind_name, PerformanceRecord_Kind_value)
+	proto.RegisterType((*PerformanceRecord)(nil), "dymensionxyz.dymension.sequencer.PerformanceRecord")
+	proto.RegisterType((*PerformanceHistory)(nil), "dymensionxyz.dymension.sequencer.PerformanceHistory")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/performance.proto", fileDescriptor_8b8f787842750ec5)
+}
+
+var fileDescriptor_8b8f787842750ec5 = []byte{
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0xc7, 0x4a, 0xe9, 0x45, 0xb4, 0xe9, 0xa9, 0x42, 0x6e, 0x06, 0xc7, 0xca, 0x94,
+	0x85, 0xb3, 0x94, 0x48, 0xa5, 0x0b, 0x03, 0x11, 0x03, 0x52, 0x8b, 0x14, 0x39, 0xb0, 0xb0, 0x44,
+	0x8e, 0xef, 0xd5, 0x39, 0x35, 0xbe, 0x33, 0x77, 0xe7, 0x2a, 0xe6, 0x0b, 0xb0, 0x76, 0xe4, 0x3b,
+	0xb0, 0xf2, 0x21, 0x3a, 0x56, 0x4c, 0x4c, 0x80, 0x92, 0x2f, 0x82, 0x7c, 0x4e, 0xd2, 0x88, 0x0a,
+	0x21, 0xd8, 0xee, 0xaf, 0xf7, 0x7e, 0x4f, 0xff, 0xf7, 0x3f, 0x3d, 0xd4, 0xa7, 0x45, 0x0a, 0x5c,
+	0x31, 0xc1, 0x17, 0xc5, 0x87, 0x60, 0x2b, 0x02, 0x05, 0xef, 0x73, 0xe0, 0x31, 0xc8, 0x20, 0x03,
+	0x79, 0x29, 0x64, 0x1a, 0xf1, 0x18, 0x48, 0x26, 0x85, 0x16, 0xd8, 0xdf, 0x65, 0xc8, 0x56, 0x90,
+	0x2d, 0xd3, 0x3e, 0x4e, 0x44, 0x22, 0x4c, 0x73, 0x50, 0xbe, 0x2a, 0xae, 0x7d, 0x12, 0x0b, 0x95,
+	0x0a, 0x35, 0xa9, 0x0a, 0x95, 0x58, 0x97, 0xbc, 0x44, 0x88, 0x64, 0x0e, 0x81, 0x51, 0xd3, 0xfc,
+	0x32, 0xa0, 0xb9, 0x8c, 0x74, 0x39, 0xb4, 0xaa, 0x77, 0x7e, 0xaf, 0x6b, 0x96, 0x82, 0xd2, 0x51,
+	0x9a, 0x55, 0x0d, 0xdd, 0xcf, 0x36, 0x3a, 0x1a, 0xdd, 0x3b, 0x0d, 0x21, 0x16, 0x92, 0xe2, 0x0b,
+	0xe4, 0x5c, 0x31, 0x4e, 0x5d, 0xcb, 0xb7, 0x7a, 0x07, 0xfd, 0x33, 0xf2, 0x37, 0xe3, 0xe4, 0xc1,
+	0x08, 0x72, 0xce, 0x38, 0x0d, 0xcd, 0x14, 0xfc, 0x04, 0x35, 0x66, 0xc0, 0x92, 0x99, 0x76, 0x6d,
+	0xdf, 0xea, 0xd5, 0xc3, 0xb5, 0xc2, 0x67, 0xc8, 0x29, 0xed, 0xb8, 0x75, 0xdf, 0xea, 0x35, 0xfb,
+	0x6d, 0x52, 0x79, 0x25, 0x1b, 0xaf, 0xe4, 0xcd, 0xc6, 0xeb, 0xf0, 0xd1, 0xed, 0xf7, 0x4e, 0xed,
+	0xe6, 0x47, 0xc7, 0x0a, 0x0d, 0x81, 0x9f, 0xa3, 0xbd, 0x79, 0xa4, 0x81, 0xc7, 0x85, 0xeb, 0x18,
+	0xf8, 0xe4, 0x01, 0xfc, 0x72, 0x1d, 0x44, 0xc5, 0x7e, 0x2a, 0xd9, 0x0d, 0xd3, 0x7d, 0x86, 0x9c,
+	0xd2, 0x1e, 0x3e, 0x44, 0xcd, 0xb1, 0x8e, 0x34, 0xbc, 0xcd, 0x68, 0xa4, 0xa1, 0x55, 0xc3, 0x18,
+	0x1d, 0xbc, 0x66, 0x4a, 0x01, 0xbd, 0x60, 0xd7, 0xc0, 0x41, 0xa9, 0x96, 0x85, 0x11, 0x6a, 0x9c,
+	0xb3, 0xf8, 0x0a, 0x68, 0xcb, 0xee, 0x7e, 0xb4, 0x11, 0xde, 0x59, 0xf5, 0x15, 0x53, 0x5a, 0xc8,
+	0x02, 0x9f, 0xa2, 0xfd, 0x6d, 0x14, 0x26, 0xb3, 0xfd, 0xa1, 0xfb, 0xf5, 0xcb, 0xd3, 0xe3, 0xf5,
+	0x57, 0xbd, 0xa0, 0x54, 0x82, 0x52, 0x63, 0x2d, 0x19, 0x4f, 0xc2, 0xfb, 0x56, 0x3c, 0x46, 0x7b,
+	0xd2, 0xa4, 0xa5, 0x5c, 0xdb, 0xaf, 0xf7, 0x9a, 0xfd, 0xc1, 0x7f, 0x24, 0x3d, 0x74, 0xca, 0x05,
+	0xc3, 0xcd, 0x24, 0x8c, 0x91, 0xc3, 0x61, 0xa1, 0x4d, 0xaa, 0x8f, 0x43, 0xf3, 0xc6, 0x23, 0x74,
+	0x34, 0x8f, 0x94, 0x9e, 0xa8, 0x72, 0xdb, 0x49, 0x6e, 0xd6, 0x75, 0x9d, 0x7f, 0x88, 0xfd, 0xb0,
+	0xc4, 0x77, 0xb2, 0x1a, 0x8e, 0x6e, 0x97, 0x9e, 0x75, 0xb7, 0xf4, 0xac, 0x9f, 0x4b, 0xcf, 0xba,
+	0x59, 0x79, 0xb5, 0xbb, 0x95, 0x57, 0xfb, 0xb6, 0xf2, 0x6a, 0xef, 0x4e, 0x13, 0xa6, 0x67, 0xf9,
+	0x94, 0xc4, 0x22, 0x0d, 0xfe, 0x70, 0x24, 0xd7, 0x83, 0x60, 0xb1, 0x73, 0x29, 0xba, 0xc8, 0x40,
+	0x4d, 0x1b, 0xc6, 0xc0, 0xe0, 0xd7, 0x00, 0x37, 0x92, 0x07, 0xeb, 0x5a, 0x03, 0x00, 0x00,
+}
+
+func (m *PerformanceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerformanceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerformanceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Latency, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Latency):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPerformance(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPerformance(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Kind != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PerformanceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PerformanceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PerformanceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastStateUpdate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastStateUpdate):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintPerformance(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	if m.Next != 0 {
+		i = encodeVarintPerformance(dAtA, i, uint64(m.Next))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPerformance(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintPerformance(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPerformance(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPerformance(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PerformanceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kind != 0 {
+		n += 1 + sovPerformance(uint64(m.Kind))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPerformance(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovPerformance(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Latency)
+	n += 1 + l + sovPerformance(uint64(l))
+	return n
+}
+
+func (m *PerformanceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovPerformance(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovPerformance(uint64(l))
+		}
+	}
+	if m.Next != 0 {
+		n += 1 + sovPerformance(uint64(m.Next))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastStateUpdate)
+	n += 1 + l + sovPerformance(uint64(l))
+	return n
+}
+
+func sovPerformance(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPerformance(x uint64) (n int) {
+	return sovPerformance(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PerformanceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerformanceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerformanceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= PerformanceRecord_Kind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Latency, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PerformanceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PerformanceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PerformanceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, PerformanceRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			m.Next = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Next |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStateUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastStateUpdate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPerformance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPerformance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPerformance(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPerformance
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPerformance
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPerformance
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPerformance
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPerformance
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPerformance        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPerformance          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPerformance = fmt.Errorf("proto: unexpected end of group")
+)
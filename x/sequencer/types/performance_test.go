@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func TestPerformanceHistoryRingBuffer(t *testing.T) {
+	h := types.NewPerformanceHistory(sample.AccAddress())
+	for i := range types.PerformanceHistoryLength + 5 {
+		h.Add(types.PerformanceRecord{Height: int64(i)})
+		require.NoError(t, h.ValidateBasic())
+	}
+	require.Len(t, h.Records, types.PerformanceHistoryLength)
+	require.Equal(t, uint32(5), h.Next)
+
+	// the oldest records were overwritten
+	ordered := h.Ordered()
+	require.Len(t, ordered, types.PerformanceHistoryLength)
+	for i, r := range ordered {
+		require.Equal(t, int64(i+5), r.Height)
+	}
+}
+
+func TestPerformanceHistoryScore(t *testing.T) {
+	h := types.NewPerformanceHistory(sample.AccAddress())
+	require.Equal(t, math.LegacyOneDec(), h.Score())
+	require.Zero(t, h.AverageLatency())
+
+	for range 15 {
+		h.Add(types.PerformanceRecord{Kind: types.PerformanceRecord_StateUpdate, Latency: time.Minute})
+	}
+	require.Equal(t, math.LegacyOneDec(), h.Score())
+	require.Equal(t, time.Minute, h.AverageLatency())
+
+	h.Add(types.PerformanceRecord{Kind: types.PerformanceRecord_MissedLiveness})
+	h.Add(types.PerformanceRecord{Kind: types.PerformanceRecord_Kicked})
+	require.Equal(t, math.LegacyNewDecWithPrec(3, 1), h.Score()) // 15 / (15 + 10 + 25)
+}
+
+func TestPerformanceHistoryValidateBasic(t *testing.T) {
+	full := types.NewPerformanceHistory(sample.AccAddress())
+	for range types.PerformanceHistoryLength {
+		full.Add(types.PerformanceRecord{})
+	}
+
+	for _, tc := range []struct {
+		name   string
+		modify func(h *types.PerformanceHistory)
+	}{
+		{"bad address", func(h *types.PerformanceHistory) { h.Sequencer = "x" }},
+		{"too many records", func(h *types.PerformanceHistory) { h.Records = append(h.Records, types.PerformanceRecord{}) }},
+		{"next out of range", func(h *types.PerformanceHistory) { h.Next = types.PerformanceHistoryLength }},
+		{"next before full", func(h *types.PerformanceHistory) { h.Records = h.Records[:10]; h.Next = 1 }},
+		{"unknown kind", func(h *types.PerformanceHistory) { h.Records[0].Kind = 7 }},
+		{"negative latency", func(h *types.PerformanceHistory) { h.Records[0].Latency = -time.Second }},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			h := full
+			h.Records = append([]types.PerformanceRecord(nil), full.Records...)
+			tc.modify(&h)
+			require.Error(t, h.ValidateBasic())
+		})
+	}
+}
@@ -77,6 +77,14 @@ func (seq Sequencer) AccAddr() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(seq.Address)
 }
 
+// RewardAccAddr is where the sequencer's commission is paid.
+func (seq Sequencer) RewardAccAddr() sdk.AccAddress {
+	if seq.RewardAddr == "" {
+		return seq.AccAddr()
+	}
+	return sdk.MustAccAddressFromBech32(seq.RewardAddr)
+}
+
 func (seq Sequencer) NoticeInProgress(now time.Time) bool {
 	return seq.NoticeStarted() && !seq.NoticeElapsed(now)
 }
@@ -0,0 +1,26 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+func (e UnbondingEntry) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(e.Sequencer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "sequencer: %s", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(e.Recipient); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "recipient: %s", err)
+	}
+	if err := e.Amount.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidCoins, "amount: %s", err)
+	}
+	if !e.Amount.IsPositive() {
+		return errorsmod.Wrap(ErrInvalidCoins, "amount must be positive")
+	}
+	if e.CompletionTime.IsZero() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "completion time")
+	}
+	return nil
+}
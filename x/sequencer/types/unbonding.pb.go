@@ -0,0 +1,530 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/unbonding.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnbondingEntry is stake leaving a sequencer, either its own bond or a
+// delegation. It can still be slashed for the sequencer's fraud until it
+// completes.
+type UnbondingEntry struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Sequencer string `protobuf:"bytes,2,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// recipient is paid on completion: the operator or the delegator
+	Recipient      string     `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount         types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	CompletionTime time.Time  `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875b33f7887a43fc, []int{0}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+func (m *UnbondingEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *UnbondingEntry) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *UnbondingEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *UnbondingEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*UnbondingEntry)(nil), "dymensionxyz.dymension.sequencer.UnbondingEntry")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/unbonding.proto", fileDescriptor_875b33f7887a43fc)
+}
+
+var fileDescriptor_875b33f7887a43fc = []byte{
+	// 366 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbb, 0x4e, 0xf3, 0x40,
+	0x10, 0x85, 0xbd, 0xfe, 0xf3, 0x47, 0xc4, 0x48, 0x41, 0xb2, 0x52, 0x38, 0x29, 0x1c, 0x8b, 0x2a,
+	0x0d, 0xbb, 0x84, 0x48, 0xa1, 0xc6, 0x88, 0x12, 0x09, 0x19, 0x68, 0x68, 0x22, 0x5f, 0x16, 0xb3,
+	0x52, 0xbc, 0x63, 0xbc, 0xeb, 0x28, 0xe6, 0x29, 0x52, 0xf2, 0x20, 0x3c, 0x44, 0xca, 0x88, 0x8a,
+	0x0a, 0x50, 0xf2, 0x22, 0xc8, 0x97, 0x38, 0x69, 0x10, 0xdd, 0x1e, 0x9d, 0xf3, 0xcd, 0xce, 0x8c,
+	0x46, 0x3b, 0x0d, 0xb2, 0x88, 0x72, 0xc1, 0x80, 0xcf, 0xb3, 0x17, 0x52, 0x0b, 0x22, 0xe8, 0x73,
+	0x4a, 0xb9, 0x4f, 0x13, 0x92, 0x72, 0x0f, 0x78, 0xc0, 0x78, 0x88, 0xe3, 0x04, 0x24, 0xe8, 0xd6,
+	0x3e, 0x81, 0x6b, 0x81, 0x6b, 0xa2, 0xd7, 0x09, 0x21, 0x84, 0x22, 0x4c, 0xf2, 0x57, 0xc9, 0xf5,
+	0xba, 0x3e, 0x88, 0x08, 0xc4, 0xa4, 0x34, 0x4a, 0x51, 0x59, 0x66, 0xa9, 0x88, 0xe7, 0x0a, 0x4a,
+	0x66, 0x43, 0x8f, 0x4a, 0x77, 0x48, 0x7c, 0x60, 0xbc, 0xf2, 0xfb, 0x21, 0x40, 0x38, 0xa5, 0xa4,
+	0x50, 0x5e, 0xfa, 0x48, 0x24, 0x8b, 0xa8, 0x90, 0x6e, 0x14, 0x97, 0x81, 0xe3, 0x57, 0x55, 0x6b,
+	0xdf, 0x6f, 0xfb, 0xbc, 0xe2, 0x32, 0xc9, 0xf4, 0xb6, 0xa6, 0xb2, 0xc0, 0x40, 0x16, 0x1a, 0x34,
+	0x1c, 0x95, 0x05, 0xfa, 0x58, 0x6b, 0xd5, 0x1d, 0x1a, 0xaa, 0x85, 0x06, 0x2d, 0xdb, 0x78, 0x7f,
+	0x3b, 0xe9, 0x54, 0x8d, 0x5c, 0x04, 0x41, 0x42, 0x85, 0xb8, 0x95, 0x09, 0xe3, 0xa1, 0xb3, 0x8b,
+	0xe6, 0x5c, 0x42, 0x7d, 0x16, 0x33, 0xca, 0xa5, 0xf1, 0xef, 0x2f, 0xae, 0x8e, 0xea, 0xe7, 0x5a,
+	0xd3, 0x8d, 0x20, 0xe5, 0xd2, 0x68, 0x58, 0x68, 0x70, 0x78, 0xd6, 0xc5, 0x15, 0x91, 0x0f, 0x89,
+	0xab, 0x21, 0xf1, 0x25, 0x30, 0x6e, 0x37, 0x96, 0x9f, 0x7d, 0xc5, 0xa9, 0xe2, 0xfa, 0xb5, 0x76,
+	0xe4, 0x43, 0x14, 0x4f, 0xa9, 0x64, 0xc0, 0x27, 0xf9, 0xa4, 0xc6, 0xff, 0xa2, 0x42, 0x0f, 0x97,
+	0x6b, 0xc0, 0xdb, 0x35, 0xe0, 0xbb, 0xed, 0x1a, 0xec, 0x83, 0xbc, 0xc4, 0xe2, 0xab, 0x8f, 0x9c,
+	0xf6, 0x0e, 0xce, 0x6d, 0xfb, 0x66, 0xb9, 0x36, 0xd1, 0x6a, 0x6d, 0xa2, 0xef, 0xb5, 0x89, 0x16,
+	0x1b, 0x53, 0x59, 0x6d, 0x4c, 0xe5, 0x63, 0x63, 0x2a, 0x0f, 0xe3, 0x90, 0xc9, 0xa7, 0xd4, 0xc3,
+	0x3e, 0x44, 0xe4, 0x97, 0x2b, 0x98, 0x8d, 0xc8, 0x7c, 0xef, 0x14, 0x64, 0x16, 0x53, 0xe1, 0x35,
+	0x8b, 0xff, 0x47, 0x3f, 0x03, 0x00, 0xd5, 0xce, 0xde, 0x80, 0x3b, 0x02, 0x00, 0x00,
+}
+
+func (m *UnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintUnbonding(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintUnbonding(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUnbonding(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUnbonding(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovUnbonding(uint64(m.Id))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovUnbonding(uint64(l))
+	return n
+}
+
+func sovUnbonding(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUnbonding(x uint64) (n int) {
+	return sovUnbonding(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnbonding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUnbonding(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUnbonding
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUnbonding
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUnbonding
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUnbonding        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUnbonding          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUnbonding = fmt.Errorf("proto: unexpected end of group")
+)